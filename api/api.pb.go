@@ -9,6 +9,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	any "github.com/golang/protobuf/ptypes/any"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -27,6 +28,94 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// AddCollectionPicRequest adds a pic to the end of a collection.  Only the owner of the
+// collection may add pics.
+type AddCollectionPicRequest struct {
+	CollectionId         string   `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PicId                string   `protobuf:"bytes,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCollectionPicRequest) Reset()         { *m = AddCollectionPicRequest{} }
+func (m *AddCollectionPicRequest) String() string { return proto.CompactTextString(m) }
+func (*AddCollectionPicRequest) ProtoMessage()    {}
+func (*AddCollectionPicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+func (m *AddCollectionPicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCollectionPicRequest.Unmarshal(m, b)
+}
+func (m *AddCollectionPicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCollectionPicRequest.Marshal(b, m, deterministic)
+}
+func (m *AddCollectionPicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCollectionPicRequest.Merge(m, src)
+}
+func (m *AddCollectionPicRequest) XXX_Size() int {
+	return xxx_messageInfo_AddCollectionPicRequest.Size(m)
+}
+func (m *AddCollectionPicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCollectionPicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCollectionPicRequest proto.InternalMessageInfo
+
+func (m *AddCollectionPicRequest) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+func (m *AddCollectionPicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type AddCollectionPicResponse struct {
+	Collection           *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *AddCollectionPicResponse) Reset()         { *m = AddCollectionPicResponse{} }
+func (m *AddCollectionPicResponse) String() string { return proto.CompactTextString(m) }
+func (*AddCollectionPicResponse) ProtoMessage()    {}
+func (*AddCollectionPicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+func (m *AddCollectionPicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCollectionPicResponse.Unmarshal(m, b)
+}
+func (m *AddCollectionPicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCollectionPicResponse.Marshal(b, m, deterministic)
+}
+func (m *AddCollectionPicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCollectionPicResponse.Merge(m, src)
+}
+func (m *AddCollectionPicResponse) XXX_Size() int {
+	return xxx_messageInfo_AddCollectionPicResponse.Size(m)
+}
+func (m *AddCollectionPicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCollectionPicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCollectionPicResponse proto.InternalMessageInfo
+
+func (m *AddCollectionPicResponse) GetCollection() *Collection {
+	if m != nil {
+		return m.Collection
+	}
+	return nil
+}
+
 type AddPicCommentRequest struct {
 	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
 	CommentParentId      string   `protobuf:"bytes,2,opt,name=comment_parent_id,json=commentParentId,proto3" json:"comment_parent_id,omitempty"`
@@ -40,7 +129,7 @@ func (m *AddPicCommentRequest) Reset()         { *m = AddPicCommentRequest{} }
 func (m *AddPicCommentRequest) String() string { return proto.CompactTextString(m) }
 func (*AddPicCommentRequest) ProtoMessage()    {}
 func (*AddPicCommentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
 }
 
 func (m *AddPicCommentRequest) XXX_Unmarshal(b []byte) error {
@@ -93,7 +182,7 @@ func (m *AddPicCommentResponse) Reset()         { *m = AddPicCommentResponse{} }
 func (m *AddPicCommentResponse) String() string { return proto.CompactTextString(m) }
 func (*AddPicCommentResponse) ProtoMessage()    {}
 func (*AddPicCommentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
 }
 
 func (m *AddPicCommentResponse) XXX_Unmarshal(b []byte) error {
@@ -121,6 +210,78 @@ func (m *AddPicCommentResponse) GetComment() *PicComment {
 	return nil
 }
 
+// AddPicFavoriteRequest favorites a pic for the current user.  Favoriting a pic more than once
+// has no further effect.
+type AddPicFavoriteRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddPicFavoriteRequest) Reset()         { *m = AddPicFavoriteRequest{} }
+func (m *AddPicFavoriteRequest) String() string { return proto.CompactTextString(m) }
+func (*AddPicFavoriteRequest) ProtoMessage()    {}
+func (*AddPicFavoriteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+func (m *AddPicFavoriteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddPicFavoriteRequest.Unmarshal(m, b)
+}
+func (m *AddPicFavoriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddPicFavoriteRequest.Marshal(b, m, deterministic)
+}
+func (m *AddPicFavoriteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddPicFavoriteRequest.Merge(m, src)
+}
+func (m *AddPicFavoriteRequest) XXX_Size() int {
+	return xxx_messageInfo_AddPicFavoriteRequest.Size(m)
+}
+func (m *AddPicFavoriteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddPicFavoriteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddPicFavoriteRequest proto.InternalMessageInfo
+
+func (m *AddPicFavoriteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type AddPicFavoriteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddPicFavoriteResponse) Reset()         { *m = AddPicFavoriteResponse{} }
+func (m *AddPicFavoriteResponse) String() string { return proto.CompactTextString(m) }
+func (*AddPicFavoriteResponse) ProtoMessage()    {}
+func (*AddPicFavoriteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+}
+
+func (m *AddPicFavoriteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddPicFavoriteResponse.Unmarshal(m, b)
+}
+func (m *AddPicFavoriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddPicFavoriteResponse.Marshal(b, m, deterministic)
+}
+func (m *AddPicFavoriteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddPicFavoriteResponse.Merge(m, src)
+}
+func (m *AddPicFavoriteResponse) XXX_Size() int {
+	return xxx_messageInfo_AddPicFavoriteResponse.Size(m)
+}
+func (m *AddPicFavoriteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddPicFavoriteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddPicFavoriteResponse proto.InternalMessageInfo
+
 type AddPicTagsRequest struct {
 	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
 	Tag                  []string `protobuf:"bytes,2,rep,name=tag,proto3" json:"tag,omitempty"`
@@ -133,7 +294,7 @@ func (m *AddPicTagsRequest) Reset()         { *m = AddPicTagsRequest{} }
 func (m *AddPicTagsRequest) String() string { return proto.CompactTextString(m) }
 func (*AddPicTagsRequest) ProtoMessage()    {}
 func (*AddPicTagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
 func (m *AddPicTagsRequest) XXX_Unmarshal(b []byte) error {
@@ -178,7 +339,7 @@ func (m *AddPicTagsResponse) Reset()         { *m = AddPicTagsResponse{} }
 func (m *AddPicTagsResponse) String() string { return proto.CompactTextString(m) }
 func (*AddPicTagsResponse) ProtoMessage()    {}
 func (*AddPicTagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
 func (m *AddPicTagsResponse) XXX_Unmarshal(b []byte) error {
@@ -199,3505 +360,10463 @@ func (m *AddPicTagsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddPicTagsResponse proto.InternalMessageInfo
 
-type CreateUserRequest struct {
-	// ident is the unique identity of the user being created, usually an email address
-	Ident string `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
-	// secret is the secret string used to authenticate the user, usually a password
-	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+// AddTagFollowRequest follows a tag for the current user.  Pics with the tag appear in the feed.
+// The tag need not be in use yet.  Following a tag more than once has no further effect.
+type AddTagFollowRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
-func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateUserRequest) ProtoMessage()    {}
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+func (m *AddTagFollowRequest) Reset()         { *m = AddTagFollowRequest{} }
+func (m *AddTagFollowRequest) String() string { return proto.CompactTextString(m) }
+func (*AddTagFollowRequest) ProtoMessage()    {}
+func (*AddTagFollowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
-func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateUserRequest.Unmarshal(m, b)
+func (m *AddTagFollowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddTagFollowRequest.Unmarshal(m, b)
 }
-func (m *CreateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateUserRequest.Marshal(b, m, deterministic)
+func (m *AddTagFollowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddTagFollowRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateUserRequest.Merge(m, src)
+func (m *AddTagFollowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTagFollowRequest.Merge(m, src)
 }
-func (m *CreateUserRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateUserRequest.Size(m)
+func (m *AddTagFollowRequest) XXX_Size() int {
+	return xxx_messageInfo_AddTagFollowRequest.Size(m)
 }
-func (m *CreateUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateUserRequest.DiscardUnknown(m)
+func (m *AddTagFollowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTagFollowRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateUserRequest proto.InternalMessageInfo
-
-func (m *CreateUserRequest) GetIdent() string {
-	if m != nil {
-		return m.Ident
-	}
-	return ""
-}
+var xxx_messageInfo_AddTagFollowRequest proto.InternalMessageInfo
 
-func (m *CreateUserRequest) GetSecret() string {
+func (m *AddTagFollowRequest) GetTag() string {
 	if m != nil {
-		return m.Secret
+		return m.Tag
 	}
 	return ""
 }
 
-type CreateUserResponse struct {
+type AddTagFollowResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
-func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateUserResponse) ProtoMessage()    {}
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+func (m *AddTagFollowResponse) Reset()         { *m = AddTagFollowResponse{} }
+func (m *AddTagFollowResponse) String() string { return proto.CompactTextString(m) }
+func (*AddTagFollowResponse) ProtoMessage()    {}
+func (*AddTagFollowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 
-func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateUserResponse.Unmarshal(m, b)
+func (m *AddTagFollowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddTagFollowResponse.Unmarshal(m, b)
 }
-func (m *CreateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateUserResponse.Marshal(b, m, deterministic)
+func (m *AddTagFollowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddTagFollowResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateUserResponse.Merge(m, src)
+func (m *AddTagFollowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTagFollowResponse.Merge(m, src)
 }
-func (m *CreateUserResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateUserResponse.Size(m)
+func (m *AddTagFollowResponse) XXX_Size() int {
+	return xxx_messageInfo_AddTagFollowResponse.Size(m)
 }
-func (m *CreateUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateUserResponse.DiscardUnknown(m)
+func (m *AddTagFollowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTagFollowResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddTagFollowResponse proto.InternalMessageInfo
 
-type DeleteTokenRequest struct {
+// AddUserFollowRequest follows a user for the current user.  Pics uploaded by the user appear in
+// the feed.  Following a user more than once has no further effect.
+type AddUserFollowRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteTokenRequest) Reset()         { *m = DeleteTokenRequest{} }
-func (m *DeleteTokenRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteTokenRequest) ProtoMessage()    {}
-func (*DeleteTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+func (m *AddUserFollowRequest) Reset()         { *m = AddUserFollowRequest{} }
+func (m *AddUserFollowRequest) String() string { return proto.CompactTextString(m) }
+func (*AddUserFollowRequest) ProtoMessage()    {}
+func (*AddUserFollowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 
-func (m *DeleteTokenRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteTokenRequest.Unmarshal(m, b)
+func (m *AddUserFollowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddUserFollowRequest.Unmarshal(m, b)
 }
-func (m *DeleteTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteTokenRequest.Marshal(b, m, deterministic)
+func (m *AddUserFollowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddUserFollowRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteTokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTokenRequest.Merge(m, src)
+func (m *AddUserFollowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddUserFollowRequest.Merge(m, src)
 }
-func (m *DeleteTokenRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteTokenRequest.Size(m)
+func (m *AddUserFollowRequest) XXX_Size() int {
+	return xxx_messageInfo_AddUserFollowRequest.Size(m)
 }
-func (m *DeleteTokenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTokenRequest.DiscardUnknown(m)
+func (m *AddUserFollowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddUserFollowRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTokenRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddUserFollowRequest proto.InternalMessageInfo
 
-type DeleteTokenResponse struct {
+func (m *AddUserFollowRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type AddUserFollowResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteTokenResponse) Reset()         { *m = DeleteTokenResponse{} }
-func (m *DeleteTokenResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteTokenResponse) ProtoMessage()    {}
-func (*DeleteTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+func (m *AddUserFollowResponse) Reset()         { *m = AddUserFollowResponse{} }
+func (m *AddUserFollowResponse) String() string { return proto.CompactTextString(m) }
+func (*AddUserFollowResponse) ProtoMessage()    {}
+func (*AddUserFollowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
 }
 
-func (m *DeleteTokenResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteTokenResponse.Unmarshal(m, b)
+func (m *AddUserFollowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddUserFollowResponse.Unmarshal(m, b)
 }
-func (m *DeleteTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteTokenResponse.Marshal(b, m, deterministic)
+func (m *AddUserFollowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddUserFollowResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteTokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTokenResponse.Merge(m, src)
+func (m *AddUserFollowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddUserFollowResponse.Merge(m, src)
 }
-func (m *DeleteTokenResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteTokenResponse.Size(m)
+func (m *AddUserFollowResponse) XXX_Size() int {
+	return xxx_messageInfo_AddUserFollowResponse.Size(m)
 }
-func (m *DeleteTokenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTokenResponse.DiscardUnknown(m)
+func (m *AddUserFollowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddUserFollowResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTokenResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddUserFollowResponse proto.InternalMessageInfo
 
-type FindIndexPicsRequest struct {
-	StartPicId           string   `protobuf:"bytes,1,opt,name=start_pic_id,json=startPicId,proto3" json:"start_pic_id,omitempty"`
-	Ascending            bool     `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+// AppendUploadSessionRequest appends the next part of the pic data to an upload session.
+type AppendUploadSessionRequest struct {
+	UploadSessionId string `protobuf:"bytes,1,opt,name=upload_session_id,json=uploadSessionId,proto3" json:"upload_session_id,omitempty"`
+	// offset is where data should be written.  It must match the session's current offset, which can
+	// be found with LookupUploadSession if the previous append was interrupted.
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindIndexPicsRequest) Reset()         { *m = FindIndexPicsRequest{} }
-func (m *FindIndexPicsRequest) String() string { return proto.CompactTextString(m) }
-func (*FindIndexPicsRequest) ProtoMessage()    {}
-func (*FindIndexPicsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+func (m *AppendUploadSessionRequest) Reset()         { *m = AppendUploadSessionRequest{} }
+func (m *AppendUploadSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*AppendUploadSessionRequest) ProtoMessage()    {}
+func (*AppendUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
 }
 
-func (m *FindIndexPicsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindIndexPicsRequest.Unmarshal(m, b)
+func (m *AppendUploadSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppendUploadSessionRequest.Unmarshal(m, b)
 }
-func (m *FindIndexPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindIndexPicsRequest.Marshal(b, m, deterministic)
+func (m *AppendUploadSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppendUploadSessionRequest.Marshal(b, m, deterministic)
 }
-func (m *FindIndexPicsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindIndexPicsRequest.Merge(m, src)
+func (m *AppendUploadSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppendUploadSessionRequest.Merge(m, src)
 }
-func (m *FindIndexPicsRequest) XXX_Size() int {
-	return xxx_messageInfo_FindIndexPicsRequest.Size(m)
+func (m *AppendUploadSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_AppendUploadSessionRequest.Size(m)
 }
-func (m *FindIndexPicsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindIndexPicsRequest.DiscardUnknown(m)
+func (m *AppendUploadSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppendUploadSessionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindIndexPicsRequest proto.InternalMessageInfo
+var xxx_messageInfo_AppendUploadSessionRequest proto.InternalMessageInfo
 
-func (m *FindIndexPicsRequest) GetStartPicId() string {
+func (m *AppendUploadSessionRequest) GetUploadSessionId() string {
 	if m != nil {
-		return m.StartPicId
+		return m.UploadSessionId
 	}
 	return ""
 }
 
-func (m *FindIndexPicsRequest) GetAscending() bool {
+func (m *AppendUploadSessionRequest) GetOffset() int64 {
 	if m != nil {
-		return m.Ascending
+		return m.Offset
 	}
-	return false
+	return 0
 }
 
-type FindIndexPicsResponse struct {
-	Pic []*PicAndThumbnail `protobuf:"bytes,4,rep,name=pic,proto3" json:"pic,omitempty"`
-	// if set, this field is the next pic id as a
-	// continuation token.
-	NextPicId string `protobuf:"bytes,2,opt,name=next_pic_id,json=nextPicId,proto3" json:"next_pic_id,omitempty"`
-	// if set, this field is the previous pic id as a
-	// continuation token.
-	PrevPicId            string   `protobuf:"bytes,3,opt,name=prev_pic_id,json=prevPicId,proto3" json:"prev_pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AppendUploadSessionRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *FindIndexPicsResponse) Reset()         { *m = FindIndexPicsResponse{} }
-func (m *FindIndexPicsResponse) String() string { return proto.CompactTextString(m) }
-func (*FindIndexPicsResponse) ProtoMessage()    {}
-func (*FindIndexPicsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+type AppendUploadSessionResponse struct {
+	UploadSession        *UploadSession `protobuf:"bytes,1,opt,name=upload_session,json=uploadSession,proto3" json:"upload_session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *FindIndexPicsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindIndexPicsResponse.Unmarshal(m, b)
+func (m *AppendUploadSessionResponse) Reset()         { *m = AppendUploadSessionResponse{} }
+func (m *AppendUploadSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*AppendUploadSessionResponse) ProtoMessage()    {}
+func (*AppendUploadSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
-func (m *FindIndexPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindIndexPicsResponse.Marshal(b, m, deterministic)
+
+func (m *AppendUploadSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppendUploadSessionResponse.Unmarshal(m, b)
 }
-func (m *FindIndexPicsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindIndexPicsResponse.Merge(m, src)
+func (m *AppendUploadSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppendUploadSessionResponse.Marshal(b, m, deterministic)
 }
-func (m *FindIndexPicsResponse) XXX_Size() int {
-	return xxx_messageInfo_FindIndexPicsResponse.Size(m)
+func (m *AppendUploadSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppendUploadSessionResponse.Merge(m, src)
 }
-func (m *FindIndexPicsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindIndexPicsResponse.DiscardUnknown(m)
+func (m *AppendUploadSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_AppendUploadSessionResponse.Size(m)
+}
+func (m *AppendUploadSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppendUploadSessionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindIndexPicsResponse proto.InternalMessageInfo
+var xxx_messageInfo_AppendUploadSessionResponse proto.InternalMessageInfo
 
-func (m *FindIndexPicsResponse) GetPic() []*PicAndThumbnail {
+func (m *AppendUploadSessionResponse) GetUploadSession() *UploadSession {
 	if m != nil {
-		return m.Pic
+		return m.UploadSession
 	}
 	return nil
 }
 
-func (m *FindIndexPicsResponse) GetNextPicId() string {
-	if m != nil {
-		return m.NextPicId
-	}
-	return ""
-}
-
-func (m *FindIndexPicsResponse) GetPrevPicId() string {
-	if m != nil {
-		return m.PrevPicId
-	}
-	return ""
-}
-
-type FindPicCommentVotesRequest struct {
-	// the pic id of pic comment votes.  Must be present.
-	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	// the comment id of pic comment votes.  If present, pic id must be present too.
-	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// the user_id of the pic votes.  If present, comment id must be present too.
-	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// CreateCollectionRequest creates an empty collection owned by the current user.
+type CreateCollectionRequest struct {
+	// name is the display name of the collection.  Required.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// visibility is who may see the collection.  If unset, the collection is private.
+	Visibility           Collection_Visibility `protobuf:"varint,2,opt,name=visibility,proto3,enum=pixur.api.Collection_Visibility" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *FindPicCommentVotesRequest) Reset()         { *m = FindPicCommentVotesRequest{} }
-func (m *FindPicCommentVotesRequest) String() string { return proto.CompactTextString(m) }
-func (*FindPicCommentVotesRequest) ProtoMessage()    {}
-func (*FindPicCommentVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
+func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCollectionRequest) ProtoMessage()    {}
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 
-func (m *FindPicCommentVotesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindPicCommentVotesRequest.Unmarshal(m, b)
+func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCollectionRequest.Unmarshal(m, b)
 }
-func (m *FindPicCommentVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindPicCommentVotesRequest.Marshal(b, m, deterministic)
+func (m *CreateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *FindPicCommentVotesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindPicCommentVotesRequest.Merge(m, src)
+func (m *CreateCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCollectionRequest.Merge(m, src)
 }
-func (m *FindPicCommentVotesRequest) XXX_Size() int {
-	return xxx_messageInfo_FindPicCommentVotesRequest.Size(m)
+func (m *CreateCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCollectionRequest.Size(m)
 }
-func (m *FindPicCommentVotesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindPicCommentVotesRequest.DiscardUnknown(m)
+func (m *CreateCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindPicCommentVotesRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateCollectionRequest proto.InternalMessageInfo
 
-func (m *FindPicCommentVotesRequest) GetPicId() string {
+func (m *CreateCollectionRequest) GetName() string {
 	if m != nil {
-		return m.PicId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *FindPicCommentVotesRequest) GetCommentId() string {
+func (m *CreateCollectionRequest) GetVisibility() Collection_Visibility {
 	if m != nil {
-		return m.CommentId
+		return m.Visibility
 	}
-	return ""
+	return Collection_UNKNOWN
 }
 
-func (m *FindPicCommentVotesRequest) GetUserId() string {
+type CreateCollectionResponse struct {
+	Collection           *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CreateCollectionResponse) Reset()         { *m = CreateCollectionResponse{} }
+func (m *CreateCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCollectionResponse) ProtoMessage()    {}
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+}
+
+func (m *CreateCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCollectionResponse.Unmarshal(m, b)
+}
+func (m *CreateCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCollectionResponse.Merge(m, src)
+}
+func (m *CreateCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCollectionResponse.Size(m)
+}
+func (m *CreateCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCollectionResponse proto.InternalMessageInfo
+
+func (m *CreateCollectionResponse) GetCollection() *Collection {
 	if m != nil {
-		return m.UserId
+		return m.Collection
 	}
-	return ""
+	return nil
 }
 
-type FindPicCommentVotesResponse struct {
-	// all the votes that matched the prefix, and which the subject user has access to.
-	Vote                 []*PicCommentVote `protobuf:"bytes,1,rep,name=vote,proto3" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// CreateApiKeyRequest creates a long lived key that may be used in place of an auth token.  The key
+// only has the requested capabilities, which the user must also have.  Api keys can't be used to
+// create more api keys.
+type CreateApiKeyRequest struct {
+	// name describes what the key is for.  Required.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// expiry is how long the key may be used for.  If absent, the key doesn't expire.
+	Expiry *duration.Duration `protobuf:"bytes,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// capability is the subset of the user's capabilities the key may use.  Required.
+	Capability           []Capability_Cap `protobuf:"varint,3,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *FindPicCommentVotesResponse) Reset()         { *m = FindPicCommentVotesResponse{} }
-func (m *FindPicCommentVotesResponse) String() string { return proto.CompactTextString(m) }
-func (*FindPicCommentVotesResponse) ProtoMessage()    {}
-func (*FindPicCommentVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+func (m *CreateApiKeyRequest) Reset()         { *m = CreateApiKeyRequest{} }
+func (m *CreateApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyRequest) ProtoMessage()    {}
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 
-func (m *FindPicCommentVotesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindPicCommentVotesResponse.Unmarshal(m, b)
+func (m *CreateApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyRequest.Unmarshal(m, b)
 }
-func (m *FindPicCommentVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindPicCommentVotesResponse.Marshal(b, m, deterministic)
+func (m *CreateApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyRequest.Marshal(b, m, deterministic)
 }
-func (m *FindPicCommentVotesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindPicCommentVotesResponse.Merge(m, src)
+func (m *CreateApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyRequest.Merge(m, src)
 }
-func (m *FindPicCommentVotesResponse) XXX_Size() int {
-	return xxx_messageInfo_FindPicCommentVotesResponse.Size(m)
+func (m *CreateApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyRequest.Size(m)
 }
-func (m *FindPicCommentVotesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindPicCommentVotesResponse.DiscardUnknown(m)
+func (m *CreateApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindPicCommentVotesResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateApiKeyRequest proto.InternalMessageInfo
 
-func (m *FindPicCommentVotesResponse) GetVote() []*PicCommentVote {
+func (m *CreateApiKeyRequest) GetName() string {
 	if m != nil {
-		return m.Vote
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateApiKeyRequest) GetExpiry() *duration.Duration {
+	if m != nil {
+		return m.Expiry
 	}
 	return nil
 }
 
-type FindSchedPicsRequest struct {
+func (m *CreateApiKeyRequest) GetCapability() []Capability_Cap {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+type CreateApiKeyResponse struct {
+	ApiKey *ApiKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// key is sent in the api key header.  It is not shown again.
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindSchedPicsRequest) Reset()         { *m = FindSchedPicsRequest{} }
-func (m *FindSchedPicsRequest) String() string { return proto.CompactTextString(m) }
-func (*FindSchedPicsRequest) ProtoMessage()    {}
-func (*FindSchedPicsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+func (m *CreateApiKeyResponse) Reset()         { *m = CreateApiKeyResponse{} }
+func (m *CreateApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyResponse) ProtoMessage()    {}
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 
-func (m *FindSchedPicsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindSchedPicsRequest.Unmarshal(m, b)
+func (m *CreateApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyResponse.Unmarshal(m, b)
 }
-func (m *FindSchedPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindSchedPicsRequest.Marshal(b, m, deterministic)
+func (m *CreateApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyResponse.Marshal(b, m, deterministic)
 }
-func (m *FindSchedPicsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindSchedPicsRequest.Merge(m, src)
+func (m *CreateApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyResponse.Merge(m, src)
 }
-func (m *FindSchedPicsRequest) XXX_Size() int {
-	return xxx_messageInfo_FindSchedPicsRequest.Size(m)
+func (m *CreateApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyResponse.Size(m)
 }
-func (m *FindSchedPicsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindSchedPicsRequest.DiscardUnknown(m)
+func (m *CreateApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindSchedPicsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateApiKeyResponse proto.InternalMessageInfo
 
-type FindSchedPicsResponse struct {
-	Pic                  []*PicAndThumbnail `protobuf:"bytes,2,rep,name=pic,proto3" json:"pic,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
 }
 
-func (m *FindSchedPicsResponse) Reset()         { *m = FindSchedPicsResponse{} }
-func (m *FindSchedPicsResponse) String() string { return proto.CompactTextString(m) }
-func (*FindSchedPicsResponse) ProtoMessage()    {}
-func (*FindSchedPicsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+func (m *CreateApiKeyResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *FindSchedPicsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindSchedPicsResponse.Unmarshal(m, b)
+// CreateInviteCodeRequest creates a code that can be used to create new users.  Requires the
+// USER_INVITE_CREATE capability.
+type CreateInviteCodeRequest struct {
+	// expiry is how long the code is valid for.  If absent, it doesn't expire.
+	Expiry *duration.Duration `protobuf:"bytes,1,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// max_uses is how many users may be created with the code.  Must be positive.
+	MaxUses int64 `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// capability is granted to users created with the code.  The creator must have each of these.
+	// If empty, the default new user capabilities are granted.
+	Capability           []Capability_Cap `protobuf:"varint,3,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *FindSchedPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindSchedPicsResponse.Marshal(b, m, deterministic)
+
+func (m *CreateInviteCodeRequest) Reset()         { *m = CreateInviteCodeRequest{} }
+func (m *CreateInviteCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateInviteCodeRequest) ProtoMessage()    {}
+func (*CreateInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
-func (m *FindSchedPicsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindSchedPicsResponse.Merge(m, src)
+
+func (m *CreateInviteCodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateInviteCodeRequest.Unmarshal(m, b)
 }
-func (m *FindSchedPicsResponse) XXX_Size() int {
-	return xxx_messageInfo_FindSchedPicsResponse.Size(m)
+func (m *CreateInviteCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateInviteCodeRequest.Marshal(b, m, deterministic)
 }
-func (m *FindSchedPicsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindSchedPicsResponse.DiscardUnknown(m)
+func (m *CreateInviteCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateInviteCodeRequest.Merge(m, src)
+}
+func (m *CreateInviteCodeRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateInviteCodeRequest.Size(m)
+}
+func (m *CreateInviteCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateInviteCodeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindSchedPicsResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateInviteCodeRequest proto.InternalMessageInfo
 
-func (m *FindSchedPicsResponse) GetPic() []*PicAndThumbnail {
+func (m *CreateInviteCodeRequest) GetExpiry() *duration.Duration {
 	if m != nil {
-		return m.Pic
+		return m.Expiry
 	}
 	return nil
 }
 
-type FindSimilarPicsRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateInviteCodeRequest) GetMaxUses() int64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
 }
 
-func (m *FindSimilarPicsRequest) Reset()         { *m = FindSimilarPicsRequest{} }
-func (m *FindSimilarPicsRequest) String() string { return proto.CompactTextString(m) }
-func (*FindSimilarPicsRequest) ProtoMessage()    {}
-func (*FindSimilarPicsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+func (m *CreateInviteCodeRequest) GetCapability() []Capability_Cap {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
 }
 
-func (m *FindSimilarPicsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindSimilarPicsRequest.Unmarshal(m, b)
+type CreateInviteCodeResponse struct {
+	// code is the secret code to give to the invited user.  It can't be retrieved later.
+	Code                 string      `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	InviteCode           *InviteCode `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *FindSimilarPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindSimilarPicsRequest.Marshal(b, m, deterministic)
+
+func (m *CreateInviteCodeResponse) Reset()         { *m = CreateInviteCodeResponse{} }
+func (m *CreateInviteCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateInviteCodeResponse) ProtoMessage()    {}
+func (*CreateInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
-func (m *FindSimilarPicsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindSimilarPicsRequest.Merge(m, src)
+
+func (m *CreateInviteCodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateInviteCodeResponse.Unmarshal(m, b)
 }
-func (m *FindSimilarPicsRequest) XXX_Size() int {
-	return xxx_messageInfo_FindSimilarPicsRequest.Size(m)
+func (m *CreateInviteCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateInviteCodeResponse.Marshal(b, m, deterministic)
 }
-func (m *FindSimilarPicsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindSimilarPicsRequest.DiscardUnknown(m)
+func (m *CreateInviteCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateInviteCodeResponse.Merge(m, src)
+}
+func (m *CreateInviteCodeResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateInviteCodeResponse.Size(m)
+}
+func (m *CreateInviteCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateInviteCodeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindSimilarPicsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateInviteCodeResponse proto.InternalMessageInfo
 
-func (m *FindSimilarPicsRequest) GetPicId() string {
+func (m *CreateInviteCodeResponse) GetCode() string {
 	if m != nil {
-		return m.PicId
+		return m.Code
 	}
 	return ""
 }
 
-type FindSimilarPicsResponse struct {
-	PicId                []string `protobuf:"bytes,1,rep,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+func (m *CreateInviteCodeResponse) GetInviteCode() *InviteCode {
+	if m != nil {
+		return m.InviteCode
+	}
+	return nil
+}
+
+// CreatePoolRequest creates an empty pool.  Requires the PIC_POOL_EDIT capability.
+type CreatePoolRequest struct {
+	// title is the display name of the pool.  Required.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description is shown with the pool.  Optional.
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindSimilarPicsResponse) Reset()         { *m = FindSimilarPicsResponse{} }
-func (m *FindSimilarPicsResponse) String() string { return proto.CompactTextString(m) }
-func (*FindSimilarPicsResponse) ProtoMessage()    {}
-func (*FindSimilarPicsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+func (m *CreatePoolRequest) Reset()         { *m = CreatePoolRequest{} }
+func (m *CreatePoolRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePoolRequest) ProtoMessage()    {}
+func (*CreatePoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 
-func (m *FindSimilarPicsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindSimilarPicsResponse.Unmarshal(m, b)
+func (m *CreatePoolRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePoolRequest.Unmarshal(m, b)
 }
-func (m *FindSimilarPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindSimilarPicsResponse.Marshal(b, m, deterministic)
+func (m *CreatePoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePoolRequest.Marshal(b, m, deterministic)
 }
-func (m *FindSimilarPicsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindSimilarPicsResponse.Merge(m, src)
+func (m *CreatePoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePoolRequest.Merge(m, src)
 }
-func (m *FindSimilarPicsResponse) XXX_Size() int {
-	return xxx_messageInfo_FindSimilarPicsResponse.Size(m)
+func (m *CreatePoolRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePoolRequest.Size(m)
 }
-func (m *FindSimilarPicsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindSimilarPicsResponse.DiscardUnknown(m)
+func (m *CreatePoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePoolRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindSimilarPicsResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreatePoolRequest proto.InternalMessageInfo
 
-func (m *FindSimilarPicsResponse) GetPicId() []string {
+func (m *CreatePoolRequest) GetTitle() string {
 	if m != nil {
-		return m.PicId
+		return m.Title
 	}
-	return nil
+	return ""
 }
 
-type FindUserEventsRequest struct {
-	// Optional.  Uses auth token if not specified.
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Optional.  If present, specifies the starting user event id to scan for
-	StartUserEventId     string   `protobuf:"bytes,2,opt,name=start_user_event_id,json=startUserEventId,proto3" json:"start_user_event_id,omitempty"`
-	Ascending            bool     `protobuf:"varint,3,opt,name=ascending,proto3" json:"ascending,omitempty"`
+func (m *CreatePoolRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type CreatePoolResponse struct {
+	Pool                 *Pool    `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindUserEventsRequest) Reset()         { *m = FindUserEventsRequest{} }
-func (m *FindUserEventsRequest) String() string { return proto.CompactTextString(m) }
-func (*FindUserEventsRequest) ProtoMessage()    {}
-func (*FindUserEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+func (m *CreatePoolResponse) Reset()         { *m = CreatePoolResponse{} }
+func (m *CreatePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*CreatePoolResponse) ProtoMessage()    {}
+func (*CreatePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 
-func (m *FindUserEventsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindUserEventsRequest.Unmarshal(m, b)
+func (m *CreatePoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePoolResponse.Unmarshal(m, b)
 }
-func (m *FindUserEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindUserEventsRequest.Marshal(b, m, deterministic)
+func (m *CreatePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePoolResponse.Marshal(b, m, deterministic)
 }
-func (m *FindUserEventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindUserEventsRequest.Merge(m, src)
+func (m *CreatePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePoolResponse.Merge(m, src)
 }
-func (m *FindUserEventsRequest) XXX_Size() int {
-	return xxx_messageInfo_FindUserEventsRequest.Size(m)
+func (m *CreatePoolResponse) XXX_Size() int {
+	return xxx_messageInfo_CreatePoolResponse.Size(m)
 }
-func (m *FindUserEventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindUserEventsRequest.DiscardUnknown(m)
+func (m *CreatePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindUserEventsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreatePoolResponse proto.InternalMessageInfo
 
-func (m *FindUserEventsRequest) GetUserId() string {
+func (m *CreatePoolResponse) GetPool() *Pool {
 	if m != nil {
-		return m.UserId
+		return m.Pool
 	}
-	return ""
+	return nil
 }
 
-func (m *FindUserEventsRequest) GetStartUserEventId() string {
-	if m != nil {
-		return m.StartUserEventId
-	}
-	return ""
-}
-
-func (m *FindUserEventsRequest) GetAscending() bool {
-	if m != nil {
-		return m.Ascending
-	}
-	return false
-}
-
-type FindUserEventsResponse struct {
-	UserEvent            []*UserEvent `protobuf:"bytes,1,rep,name=user_event,json=userEvent,proto3" json:"user_event,omitempty"`
-	NextUserEventId      string       `protobuf:"bytes,2,opt,name=next_user_event_id,json=nextUserEventId,proto3" json:"next_user_event_id,omitempty"`
-	PrevUserEventId      string       `protobuf:"bytes,3,opt,name=prev_user_event_id,json=prevUserEventId,proto3" json:"prev_user_event_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type CreateUserRequest struct {
+	// ident is the unique identity of the user being created, usually an email address
+	Ident string `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+	// secret is the secret string used to authenticate the user, usually a password
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// invite_code is an optional code from CreateInviteCode.  If present, the user is created even
+	// if the caller can't normally create users.
+	InviteCode           string   `protobuf:"bytes,3,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindUserEventsResponse) Reset()         { *m = FindUserEventsResponse{} }
-func (m *FindUserEventsResponse) String() string { return proto.CompactTextString(m) }
-func (*FindUserEventsResponse) ProtoMessage()    {}
-func (*FindUserEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
+func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateUserRequest) ProtoMessage()    {}
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 
-func (m *FindUserEventsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindUserEventsResponse.Unmarshal(m, b)
+func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateUserRequest.Unmarshal(m, b)
 }
-func (m *FindUserEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindUserEventsResponse.Marshal(b, m, deterministic)
+func (m *CreateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateUserRequest.Marshal(b, m, deterministic)
 }
-func (m *FindUserEventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindUserEventsResponse.Merge(m, src)
+func (m *CreateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserRequest.Merge(m, src)
 }
-func (m *FindUserEventsResponse) XXX_Size() int {
-	return xxx_messageInfo_FindUserEventsResponse.Size(m)
+func (m *CreateUserRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateUserRequest.Size(m)
 }
-func (m *FindUserEventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindUserEventsResponse.DiscardUnknown(m)
+func (m *CreateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindUserEventsResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateUserRequest proto.InternalMessageInfo
 
-func (m *FindUserEventsResponse) GetUserEvent() []*UserEvent {
+func (m *CreateUserRequest) GetIdent() string {
 	if m != nil {
-		return m.UserEvent
+		return m.Ident
 	}
-	return nil
+	return ""
 }
 
-func (m *FindUserEventsResponse) GetNextUserEventId() string {
+func (m *CreateUserRequest) GetSecret() string {
 	if m != nil {
-		return m.NextUserEventId
+		return m.Secret
 	}
 	return ""
 }
 
-func (m *FindUserEventsResponse) GetPrevUserEventId() string {
+func (m *CreateUserRequest) GetInviteCode() string {
 	if m != nil {
-		return m.PrevUserEventId
+		return m.InviteCode
 	}
 	return ""
 }
 
-type GetRefreshTokenRequest struct {
-	// ident is the unique identity of the user being created, usually an email address
-	Ident string `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
-	// secret is the secret string used to authenticate the user, usually a password
-	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
-	PreviousAuthToken    string   `protobuf:"bytes,3,opt,name=previous_auth_token,json=previousAuthToken,proto3" json:"previous_auth_token,omitempty"`
+type CreateUserResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRefreshTokenRequest) Reset()         { *m = GetRefreshTokenRequest{} }
-func (m *GetRefreshTokenRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRefreshTokenRequest) ProtoMessage()    {}
-func (*GetRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
+func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateUserResponse) ProtoMessage()    {}
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 
-func (m *GetRefreshTokenRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRefreshTokenRequest.Unmarshal(m, b)
+func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateUserResponse.Unmarshal(m, b)
 }
-func (m *GetRefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRefreshTokenRequest.Marshal(b, m, deterministic)
+func (m *CreateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateUserResponse.Marshal(b, m, deterministic)
 }
-func (m *GetRefreshTokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRefreshTokenRequest.Merge(m, src)
+func (m *CreateUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserResponse.Merge(m, src)
 }
-func (m *GetRefreshTokenRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRefreshTokenRequest.Size(m)
+func (m *CreateUserResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateUserResponse.Size(m)
 }
-func (m *GetRefreshTokenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRefreshTokenRequest.DiscardUnknown(m)
+func (m *CreateUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRefreshTokenRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateUserResponse proto.InternalMessageInfo
 
-func (m *GetRefreshTokenRequest) GetIdent() string {
-	if m != nil {
-		return m.Ident
-	}
-	return ""
+type DisableTotpRequest struct {
+	// secret is the current secret of the user.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// code is a current two-factor code, or an unused recovery code.
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRefreshTokenRequest) GetSecret() string {
+func (m *DisableTotpRequest) Reset()         { *m = DisableTotpRequest{} }
+func (m *DisableTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableTotpRequest) ProtoMessage()    {}
+func (*DisableTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+}
+
+func (m *DisableTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableTotpRequest.Unmarshal(m, b)
+}
+func (m *DisableTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableTotpRequest.Marshal(b, m, deterministic)
+}
+func (m *DisableTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTotpRequest.Merge(m, src)
+}
+func (m *DisableTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_DisableTotpRequest.Size(m)
+}
+func (m *DisableTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTotpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableTotpRequest proto.InternalMessageInfo
+
+func (m *DisableTotpRequest) GetSecret() string {
 	if m != nil {
 		return m.Secret
 	}
 	return ""
 }
 
-func (m *GetRefreshTokenRequest) GetPreviousAuthToken() string {
+func (m *DisableTotpRequest) GetCode() string {
 	if m != nil {
-		return m.PreviousAuthToken
+		return m.Code
 	}
 	return ""
 }
 
-type GetRefreshTokenResponse struct {
-	AuthToken            string      `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
-	PixToken             string      `protobuf:"bytes,5,opt,name=pix_token,json=pixToken,proto3" json:"pix_token,omitempty"`
-	AuthPayload          *PwtPayload `protobuf:"bytes,4,opt,name=auth_payload,json=authPayload,proto3" json:"auth_payload,omitempty"`
-	PixPayload           *PwtPayload `protobuf:"bytes,6,opt,name=pix_payload,json=pixPayload,proto3" json:"pix_payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type DisableTotpResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRefreshTokenResponse) Reset()         { *m = GetRefreshTokenResponse{} }
-func (m *GetRefreshTokenResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRefreshTokenResponse) ProtoMessage()    {}
-func (*GetRefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+func (m *DisableTotpResponse) Reset()         { *m = DisableTotpResponse{} }
+func (m *DisableTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*DisableTotpResponse) ProtoMessage()    {}
+func (*DisableTotpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 
-func (m *GetRefreshTokenResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRefreshTokenResponse.Unmarshal(m, b)
+func (m *DisableTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableTotpResponse.Unmarshal(m, b)
 }
-func (m *GetRefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRefreshTokenResponse.Marshal(b, m, deterministic)
+func (m *DisableTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableTotpResponse.Marshal(b, m, deterministic)
 }
-func (m *GetRefreshTokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRefreshTokenResponse.Merge(m, src)
+func (m *DisableTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTotpResponse.Merge(m, src)
 }
-func (m *GetRefreshTokenResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRefreshTokenResponse.Size(m)
+func (m *DisableTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_DisableTotpResponse.Size(m)
 }
-func (m *GetRefreshTokenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRefreshTokenResponse.DiscardUnknown(m)
+func (m *DisableTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTotpResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRefreshTokenResponse proto.InternalMessageInfo
+var xxx_messageInfo_DisableTotpResponse proto.InternalMessageInfo
 
-func (m *GetRefreshTokenResponse) GetAuthToken() string {
+type DeleteAccountRequest struct {
+	// secret is the current secret of the user.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// code is a current two-factor code, or an unused recovery code.  Only needed if the user is
+	// enrolled in two-factor authentication.
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAccountRequest) Reset()         { *m = DeleteAccountRequest{} }
+func (m *DeleteAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountRequest) ProtoMessage()    {}
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+}
+
+func (m *DeleteAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountRequest.Unmarshal(m, b)
+}
+func (m *DeleteAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountRequest.Merge(m, src)
+}
+func (m *DeleteAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountRequest.Size(m)
+}
+func (m *DeleteAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAccountRequest proto.InternalMessageInfo
+
+func (m *DeleteAccountRequest) GetSecret() string {
 	if m != nil {
-		return m.AuthToken
+		return m.Secret
 	}
 	return ""
 }
 
-func (m *GetRefreshTokenResponse) GetPixToken() string {
+func (m *DeleteAccountRequest) GetCode() string {
 	if m != nil {
-		return m.PixToken
+		return m.Code
 	}
 	return ""
 }
 
-func (m *GetRefreshTokenResponse) GetAuthPayload() *PwtPayload {
-	if m != nil {
-		return m.AuthPayload
-	}
-	return nil
+type DeleteAccountResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRefreshTokenResponse) GetPixPayload() *PwtPayload {
-	if m != nil {
-		return m.PixPayload
-	}
-	return nil
+func (m *DeleteAccountResponse) Reset()         { *m = DeleteAccountResponse{} }
+func (m *DeleteAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountResponse) ProtoMessage()    {}
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
 
-type IncrementViewCountRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+func (m *DeleteAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountResponse.Unmarshal(m, b)
+}
+func (m *DeleteAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountResponse.Merge(m, src)
+}
+func (m *DeleteAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountResponse.Size(m)
+}
+func (m *DeleteAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAccountResponse proto.InternalMessageInfo
+
+type DeleteApiKeyRequest struct {
+	ApiKeyId             string   `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IncrementViewCountRequest) Reset()         { *m = IncrementViewCountRequest{} }
-func (m *IncrementViewCountRequest) String() string { return proto.CompactTextString(m) }
-func (*IncrementViewCountRequest) ProtoMessage()    {}
-func (*IncrementViewCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+func (m *DeleteApiKeyRequest) Reset()         { *m = DeleteApiKeyRequest{} }
+func (m *DeleteApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteApiKeyRequest) ProtoMessage()    {}
+func (*DeleteApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 
-func (m *IncrementViewCountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IncrementViewCountRequest.Unmarshal(m, b)
+func (m *DeleteApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteApiKeyRequest.Unmarshal(m, b)
 }
-func (m *IncrementViewCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IncrementViewCountRequest.Marshal(b, m, deterministic)
+func (m *DeleteApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteApiKeyRequest.Marshal(b, m, deterministic)
 }
-func (m *IncrementViewCountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncrementViewCountRequest.Merge(m, src)
+func (m *DeleteApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteApiKeyRequest.Merge(m, src)
 }
-func (m *IncrementViewCountRequest) XXX_Size() int {
-	return xxx_messageInfo_IncrementViewCountRequest.Size(m)
+func (m *DeleteApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteApiKeyRequest.Size(m)
 }
-func (m *IncrementViewCountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncrementViewCountRequest.DiscardUnknown(m)
+func (m *DeleteApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteApiKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncrementViewCountRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteApiKeyRequest proto.InternalMessageInfo
 
-func (m *IncrementViewCountRequest) GetPicId() string {
+func (m *DeleteApiKeyRequest) GetApiKeyId() string {
 	if m != nil {
-		return m.PicId
+		return m.ApiKeyId
 	}
 	return ""
 }
 
-type IncrementViewCountResponse struct {
+type DeleteApiKeyResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IncrementViewCountResponse) Reset()         { *m = IncrementViewCountResponse{} }
-func (m *IncrementViewCountResponse) String() string { return proto.CompactTextString(m) }
-func (*IncrementViewCountResponse) ProtoMessage()    {}
-func (*IncrementViewCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+func (m *DeleteApiKeyResponse) Reset()         { *m = DeleteApiKeyResponse{} }
+func (m *DeleteApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteApiKeyResponse) ProtoMessage()    {}
+func (*DeleteApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 
-func (m *IncrementViewCountResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IncrementViewCountResponse.Unmarshal(m, b)
+func (m *DeleteApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteApiKeyResponse.Unmarshal(m, b)
 }
-func (m *IncrementViewCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IncrementViewCountResponse.Marshal(b, m, deterministic)
+func (m *DeleteApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteApiKeyResponse.Marshal(b, m, deterministic)
 }
-func (m *IncrementViewCountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncrementViewCountResponse.Merge(m, src)
+func (m *DeleteApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteApiKeyResponse.Merge(m, src)
 }
-func (m *IncrementViewCountResponse) XXX_Size() int {
-	return xxx_messageInfo_IncrementViewCountResponse.Size(m)
+func (m *DeleteApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteApiKeyResponse.Size(m)
 }
-func (m *IncrementViewCountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncrementViewCountResponse.DiscardUnknown(m)
+func (m *DeleteApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteApiKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncrementViewCountResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteApiKeyResponse proto.InternalMessageInfo
 
-type LookupPicCommentVoteRequest struct {
-	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// if absent, this comes from the auth token.
-	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+// DeleteCollectionRequest deletes a collection.  The pics in it are not affected.
+type DeleteCollectionRequest struct {
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// version is the version of the collection being deleted.
+	Version              int64    `protobuf:"fixed64,2,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicCommentVoteRequest) Reset()         { *m = LookupPicCommentVoteRequest{} }
-func (m *LookupPicCommentVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicCommentVoteRequest) ProtoMessage()    {}
-func (*LookupPicCommentVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+func (m *DeleteCollectionRequest) Reset()         { *m = DeleteCollectionRequest{} }
+func (m *DeleteCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCollectionRequest) ProtoMessage()    {}
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 
-func (m *LookupPicCommentVoteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicCommentVoteRequest.Unmarshal(m, b)
+func (m *DeleteCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCollectionRequest.Unmarshal(m, b)
 }
-func (m *LookupPicCommentVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicCommentVoteRequest.Marshal(b, m, deterministic)
+func (m *DeleteCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupPicCommentVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicCommentVoteRequest.Merge(m, src)
+func (m *DeleteCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCollectionRequest.Merge(m, src)
 }
-func (m *LookupPicCommentVoteRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicCommentVoteRequest.Size(m)
+func (m *DeleteCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCollectionRequest.Size(m)
 }
-func (m *LookupPicCommentVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicCommentVoteRequest.DiscardUnknown(m)
+func (m *DeleteCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicCommentVoteRequest proto.InternalMessageInfo
-
-func (m *LookupPicCommentVoteRequest) GetPicId() string {
-	if m != nil {
-		return m.PicId
-	}
-	return ""
-}
+var xxx_messageInfo_DeleteCollectionRequest proto.InternalMessageInfo
 
-func (m *LookupPicCommentVoteRequest) GetCommentId() string {
+func (m *DeleteCollectionRequest) GetCollectionId() string {
 	if m != nil {
-		return m.CommentId
+		return m.CollectionId
 	}
 	return ""
 }
 
-func (m *LookupPicCommentVoteRequest) GetUserId() string {
+func (m *DeleteCollectionRequest) GetVersion() int64 {
 	if m != nil {
-		return m.UserId
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-type LookupPicCommentVoteResponse struct {
-	Vote                 *PicCommentVote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type DeleteCollectionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicCommentVoteResponse) Reset()         { *m = LookupPicCommentVoteResponse{} }
-func (m *LookupPicCommentVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicCommentVoteResponse) ProtoMessage()    {}
-func (*LookupPicCommentVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+func (m *DeleteCollectionResponse) Reset()         { *m = DeleteCollectionResponse{} }
+func (m *DeleteCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteCollectionResponse) ProtoMessage()    {}
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 
-func (m *LookupPicCommentVoteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicCommentVoteResponse.Unmarshal(m, b)
+func (m *DeleteCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCollectionResponse.Unmarshal(m, b)
 }
-func (m *LookupPicCommentVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicCommentVoteResponse.Marshal(b, m, deterministic)
+func (m *DeleteCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCollectionResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPicCommentVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicCommentVoteResponse.Merge(m, src)
+func (m *DeleteCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCollectionResponse.Merge(m, src)
 }
-func (m *LookupPicCommentVoteResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicCommentVoteResponse.Size(m)
+func (m *DeleteCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteCollectionResponse.Size(m)
 }
-func (m *LookupPicCommentVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicCommentVoteResponse.DiscardUnknown(m)
+func (m *DeleteCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCollectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicCommentVoteResponse proto.InternalMessageInfo
-
-func (m *LookupPicCommentVoteResponse) GetVote() *PicCommentVote {
-	if m != nil {
-		return m.Vote
-	}
-	return nil
-}
+var xxx_messageInfo_DeleteCollectionResponse proto.InternalMessageInfo
 
-type LookupPicDetailsRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+// DeletePicCommentRequest deletes a comment.  The comment is replaced with a placeholder, so
+// replies to it are kept.
+type DeletePicCommentRequest struct {
+	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// version is the version of the comment being deleted.
+	Version int64 `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
+	// reason is why the comment is being deleted.  Optional, and only recorded when deleting the
+	// comment of another user.
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicDetailsRequest) Reset()         { *m = LookupPicDetailsRequest{} }
-func (m *LookupPicDetailsRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicDetailsRequest) ProtoMessage()    {}
-func (*LookupPicDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+func (m *DeletePicCommentRequest) Reset()         { *m = DeletePicCommentRequest{} }
+func (m *DeletePicCommentRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePicCommentRequest) ProtoMessage()    {}
+func (*DeletePicCommentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 
-func (m *LookupPicDetailsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicDetailsRequest.Unmarshal(m, b)
+func (m *DeletePicCommentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePicCommentRequest.Unmarshal(m, b)
 }
-func (m *LookupPicDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicDetailsRequest.Marshal(b, m, deterministic)
+func (m *DeletePicCommentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePicCommentRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupPicDetailsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicDetailsRequest.Merge(m, src)
+func (m *DeletePicCommentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePicCommentRequest.Merge(m, src)
 }
-func (m *LookupPicDetailsRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicDetailsRequest.Size(m)
+func (m *DeletePicCommentRequest) XXX_Size() int {
+	return xxx_messageInfo_DeletePicCommentRequest.Size(m)
 }
-func (m *LookupPicDetailsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicDetailsRequest.DiscardUnknown(m)
+func (m *DeletePicCommentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePicCommentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicDetailsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeletePicCommentRequest proto.InternalMessageInfo
 
-func (m *LookupPicDetailsRequest) GetPicId() string {
+func (m *DeletePicCommentRequest) GetPicId() string {
 	if m != nil {
 		return m.PicId
 	}
 	return ""
 }
 
-type LookupPicDetailsResponse struct {
-	Pic                  *Pic            `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
-	Derived              []*PicFile      `protobuf:"bytes,4,rep,name=derived,proto3" json:"derived,omitempty"`
-	PicTag               []*PicTag       `protobuf:"bytes,2,rep,name=pic_tag,json=picTag,proto3" json:"pic_tag,omitempty"`
-	PicCommentTree       *PicCommentTree `protobuf:"bytes,3,opt,name=pic_comment_tree,json=picCommentTree,proto3" json:"pic_comment_tree,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *DeletePicCommentRequest) GetCommentId() string {
+	if m != nil {
+		return m.CommentId
+	}
+	return ""
 }
 
-func (m *LookupPicDetailsResponse) Reset()         { *m = LookupPicDetailsResponse{} }
-func (m *LookupPicDetailsResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicDetailsResponse) ProtoMessage()    {}
-func (*LookupPicDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+func (m *DeletePicCommentRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *LookupPicDetailsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicDetailsResponse.Unmarshal(m, b)
+func (m *DeletePicCommentRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (m *LookupPicDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicDetailsResponse.Marshal(b, m, deterministic)
+
+type DeletePicCommentResponse struct {
+	Comment              *PicComment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *LookupPicDetailsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicDetailsResponse.Merge(m, src)
+
+func (m *DeletePicCommentResponse) Reset()         { *m = DeletePicCommentResponse{} }
+func (m *DeletePicCommentResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePicCommentResponse) ProtoMessage()    {}
+func (*DeletePicCommentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
-func (m *LookupPicDetailsResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicDetailsResponse.Size(m)
+
+func (m *DeletePicCommentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePicCommentResponse.Unmarshal(m, b)
 }
-func (m *LookupPicDetailsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicDetailsResponse.DiscardUnknown(m)
+func (m *DeletePicCommentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePicCommentResponse.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_LookupPicDetailsResponse proto.InternalMessageInfo
-
-func (m *LookupPicDetailsResponse) GetPic() *Pic {
-	if m != nil {
-		return m.Pic
-	}
-	return nil
+func (m *DeletePicCommentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePicCommentResponse.Merge(m, src)
 }
-
-func (m *LookupPicDetailsResponse) GetDerived() []*PicFile {
-	if m != nil {
-		return m.Derived
-	}
-	return nil
+func (m *DeletePicCommentResponse) XXX_Size() int {
+	return xxx_messageInfo_DeletePicCommentResponse.Size(m)
 }
-
-func (m *LookupPicDetailsResponse) GetPicTag() []*PicTag {
-	if m != nil {
-		return m.PicTag
-	}
-	return nil
+func (m *DeletePicCommentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePicCommentResponse.DiscardUnknown(m)
 }
 
-func (m *LookupPicDetailsResponse) GetPicCommentTree() *PicCommentTree {
+var xxx_messageInfo_DeletePicCommentResponse proto.InternalMessageInfo
+
+func (m *DeletePicCommentResponse) GetComment() *PicComment {
 	if m != nil {
-		return m.PicCommentTree
+		return m.Comment
 	}
 	return nil
 }
 
-type LookupPicExtensionRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+type DeleteTokenRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicExtensionRequest) Reset()         { *m = LookupPicExtensionRequest{} }
-func (m *LookupPicExtensionRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicExtensionRequest) ProtoMessage()    {}
-func (*LookupPicExtensionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+func (m *DeleteTokenRequest) Reset()         { *m = DeleteTokenRequest{} }
+func (m *DeleteTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTokenRequest) ProtoMessage()    {}
+func (*DeleteTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 
-func (m *LookupPicExtensionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicExtensionRequest.Unmarshal(m, b)
+func (m *DeleteTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTokenRequest.Unmarshal(m, b)
 }
-func (m *LookupPicExtensionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicExtensionRequest.Marshal(b, m, deterministic)
+func (m *DeleteTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTokenRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupPicExtensionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicExtensionRequest.Merge(m, src)
+func (m *DeleteTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTokenRequest.Merge(m, src)
 }
-func (m *LookupPicExtensionRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicExtensionRequest.Size(m)
+func (m *DeleteTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTokenRequest.Size(m)
 }
-func (m *LookupPicExtensionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicExtensionRequest.DiscardUnknown(m)
+func (m *DeleteTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicExtensionRequest proto.InternalMessageInfo
-
-func (m *LookupPicExtensionRequest) GetPicId() string {
-	if m != nil {
-		return m.PicId
-	}
-	return ""
-}
+var xxx_messageInfo_DeleteTokenRequest proto.InternalMessageInfo
 
-type LookupPicExtensionResponse struct {
-	Ext                  map[string]*any.Any `protobuf:"bytes,1,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type DeleteTokenResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicExtensionResponse) Reset()         { *m = LookupPicExtensionResponse{} }
-func (m *LookupPicExtensionResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicExtensionResponse) ProtoMessage()    {}
-func (*LookupPicExtensionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+func (m *DeleteTokenResponse) Reset()         { *m = DeleteTokenResponse{} }
+func (m *DeleteTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTokenResponse) ProtoMessage()    {}
+func (*DeleteTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 
-func (m *LookupPicExtensionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicExtensionResponse.Unmarshal(m, b)
+func (m *DeleteTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTokenResponse.Unmarshal(m, b)
 }
-func (m *LookupPicExtensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicExtensionResponse.Marshal(b, m, deterministic)
+func (m *DeleteTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTokenResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPicExtensionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicExtensionResponse.Merge(m, src)
+func (m *DeleteTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTokenResponse.Merge(m, src)
 }
-func (m *LookupPicExtensionResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicExtensionResponse.Size(m)
+func (m *DeleteTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTokenResponse.Size(m)
 }
-func (m *LookupPicExtensionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicExtensionResponse.DiscardUnknown(m)
+func (m *DeleteTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicExtensionResponse proto.InternalMessageInfo
-
-func (m *LookupPicExtensionResponse) GetExt() map[string]*any.Any {
-	if m != nil {
-		return m.Ext
-	}
-	return nil
-}
+var xxx_messageInfo_DeleteTokenResponse proto.InternalMessageInfo
 
-type LookupPicFileRequest struct {
-	PicFileId            string         `protobuf:"bytes,1,opt,name=pic_file_id,json=picFileId,proto3" json:"pic_file_id,omitempty"`
-	Format               PicFile_Format `protobuf:"varint,2,opt,name=format,proto3,enum=pixur.api.PicFile_Format" json:"format,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+// EditPicCommentRequest replaces the text of a comment.  The prior text is kept as a revision.
+type EditPicCommentRequest struct {
+	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// version is the version of the comment being edited.
+	Version              int64    `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
+	Text                 string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicFileRequest) Reset()         { *m = LookupPicFileRequest{} }
-func (m *LookupPicFileRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicFileRequest) ProtoMessage()    {}
-func (*LookupPicFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+func (m *EditPicCommentRequest) Reset()         { *m = EditPicCommentRequest{} }
+func (m *EditPicCommentRequest) String() string { return proto.CompactTextString(m) }
+func (*EditPicCommentRequest) ProtoMessage()    {}
+func (*EditPicCommentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
 }
 
-func (m *LookupPicFileRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicFileRequest.Unmarshal(m, b)
+func (m *EditPicCommentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EditPicCommentRequest.Unmarshal(m, b)
 }
-func (m *LookupPicFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicFileRequest.Marshal(b, m, deterministic)
+func (m *EditPicCommentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EditPicCommentRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupPicFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicFileRequest.Merge(m, src)
+func (m *EditPicCommentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditPicCommentRequest.Merge(m, src)
 }
-func (m *LookupPicFileRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicFileRequest.Size(m)
+func (m *EditPicCommentRequest) XXX_Size() int {
+	return xxx_messageInfo_EditPicCommentRequest.Size(m)
 }
-func (m *LookupPicFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicFileRequest.DiscardUnknown(m)
+func (m *EditPicCommentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditPicCommentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_EditPicCommentRequest proto.InternalMessageInfo
 
-func (m *LookupPicFileRequest) GetPicFileId() string {
+func (m *EditPicCommentRequest) GetPicId() string {
 	if m != nil {
-		return m.PicFileId
+		return m.PicId
 	}
 	return ""
 }
 
-func (m *LookupPicFileRequest) GetFormat() PicFile_Format {
+func (m *EditPicCommentRequest) GetCommentId() string {
 	if m != nil {
-		return m.Format
+		return m.CommentId
 	}
-	return PicFile_UNKNOWN
+	return ""
 }
 
-type LookupPicFileResponse struct {
-	PicFile              *PicFile `protobuf:"bytes,1,opt,name=pic_file,json=picFile,proto3" json:"pic_file,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *EditPicCommentRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *LookupPicFileResponse) Reset()         { *m = LookupPicFileResponse{} }
-func (m *LookupPicFileResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicFileResponse) ProtoMessage()    {}
-func (*LookupPicFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+func (m *EditPicCommentRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
 }
 
-func (m *LookupPicFileResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicFileResponse.Unmarshal(m, b)
+type EditPicCommentResponse struct {
+	Comment              *PicComment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *LookupPicFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicFileResponse.Marshal(b, m, deterministic)
+
+func (m *EditPicCommentResponse) Reset()         { *m = EditPicCommentResponse{} }
+func (m *EditPicCommentResponse) String() string { return proto.CompactTextString(m) }
+func (*EditPicCommentResponse) ProtoMessage()    {}
+func (*EditPicCommentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
-func (m *LookupPicFileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicFileResponse.Merge(m, src)
+
+func (m *EditPicCommentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EditPicCommentResponse.Unmarshal(m, b)
 }
-func (m *LookupPicFileResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicFileResponse.Size(m)
+func (m *EditPicCommentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EditPicCommentResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPicFileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicFileResponse.DiscardUnknown(m)
+func (m *EditPicCommentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditPicCommentResponse.Merge(m, src)
+}
+func (m *EditPicCommentResponse) XXX_Size() int {
+	return xxx_messageInfo_EditPicCommentResponse.Size(m)
+}
+func (m *EditPicCommentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditPicCommentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicFileResponse proto.InternalMessageInfo
+var xxx_messageInfo_EditPicCommentResponse proto.InternalMessageInfo
 
-func (m *LookupPicFileResponse) GetPicFile() *PicFile {
+func (m *EditPicCommentResponse) GetComment() *PicComment {
 	if m != nil {
-		return m.PicFile
+		return m.Comment
 	}
 	return nil
 }
 
-type LookupPicVoteRequest struct {
-	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	// Optional.  Uses auth token if not specified.
-	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type ExportMyDataRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicVoteRequest) Reset()         { *m = LookupPicVoteRequest{} }
-func (m *LookupPicVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicVoteRequest) ProtoMessage()    {}
-func (*LookupPicVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+func (m *ExportMyDataRequest) Reset()         { *m = ExportMyDataRequest{} }
+func (m *ExportMyDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataRequest) ProtoMessage()    {}
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
 
-func (m *LookupPicVoteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicVoteRequest.Unmarshal(m, b)
+func (m *ExportMyDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataRequest.Unmarshal(m, b)
+}
+func (m *ExportMyDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportMyDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataRequest.Merge(m, src)
+}
+func (m *ExportMyDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataRequest.Size(m)
+}
+func (m *ExportMyDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMyDataRequest proto.InternalMessageInfo
+
+// ExportMyDataResponse is one record of the current user's data.  The records are streamed in
+// no particular order.
+type ExportMyDataResponse struct {
+	// Types that are valid to be assigned to Record:
+	//	*ExportMyDataResponse_User
+	//	*ExportMyDataResponse_Session
+	//	*ExportMyDataResponse_Upload_
+	//	*ExportMyDataResponse_PicTag
+	//	*ExportMyDataResponse_PicComment
+	//	*ExportMyDataResponse_PicVote
+	//	*ExportMyDataResponse_PicCommentVote
+	//	*ExportMyDataResponse_UserEvent
+	//	*ExportMyDataResponse_Favorite_
+	//	*ExportMyDataResponse_Collection
+	//	*ExportMyDataResponse_CollectionPic_
+	//	*ExportMyDataResponse_UserFollow_
+	//	*ExportMyDataResponse_TagFollow_
+	//	*ExportMyDataResponse_ApiKey
+	//	*ExportMyDataResponse_UploadSession_
+	Record               isExportMyDataResponse_Record `protobuf_oneof:"record"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ExportMyDataResponse) Reset()         { *m = ExportMyDataResponse{} }
+func (m *ExportMyDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataResponse) ProtoMessage()    {}
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
-func (m *LookupPicVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicVoteRequest.Marshal(b, m, deterministic)
+
+func (m *ExportMyDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataResponse.Unmarshal(m, b)
 }
-func (m *LookupPicVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicVoteRequest.Merge(m, src)
+func (m *ExportMyDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPicVoteRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicVoteRequest.Size(m)
+func (m *ExportMyDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataResponse.Merge(m, src)
 }
-func (m *LookupPicVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicVoteRequest.DiscardUnknown(m)
+func (m *ExportMyDataResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataResponse.Size(m)
+}
+func (m *ExportMyDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportMyDataResponse proto.InternalMessageInfo
 
-func (m *LookupPicVoteRequest) GetPicId() string {
-	if m != nil {
-		return m.PicId
-	}
-	return ""
+type isExportMyDataResponse_Record interface {
+	isExportMyDataResponse_Record()
 }
 
-func (m *LookupPicVoteRequest) GetUserId() string {
-	if m != nil {
-		return m.UserId
-	}
-	return ""
+type ExportMyDataResponse_User struct {
+	User *User `protobuf:"bytes,1,opt,name=user,proto3,oneof"`
 }
 
-type LookupPicVoteResponse struct {
-	Vote                 *PicVote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ExportMyDataResponse_Session struct {
+	Session *Session `protobuf:"bytes,2,opt,name=session,proto3,oneof"`
 }
 
-func (m *LookupPicVoteResponse) Reset()         { *m = LookupPicVoteResponse{} }
-func (m *LookupPicVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicVoteResponse) ProtoMessage()    {}
-func (*LookupPicVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+type ExportMyDataResponse_Upload_ struct {
+	Upload *ExportMyDataResponse_Upload `protobuf:"bytes,3,opt,name=upload,proto3,oneof"`
 }
 
-func (m *LookupPicVoteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicVoteResponse.Unmarshal(m, b)
+type ExportMyDataResponse_PicTag struct {
+	PicTag *PicTag `protobuf:"bytes,4,opt,name=pic_tag,json=picTag,proto3,oneof"`
 }
-func (m *LookupPicVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicVoteResponse.Marshal(b, m, deterministic)
+
+type ExportMyDataResponse_PicComment struct {
+	PicComment *PicComment `protobuf:"bytes,5,opt,name=pic_comment,json=picComment,proto3,oneof"`
 }
-func (m *LookupPicVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicVoteResponse.Merge(m, src)
+
+type ExportMyDataResponse_PicVote struct {
+	PicVote *PicVote `protobuf:"bytes,6,opt,name=pic_vote,json=picVote,proto3,oneof"`
 }
-func (m *LookupPicVoteResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicVoteResponse.Size(m)
+
+type ExportMyDataResponse_PicCommentVote struct {
+	PicCommentVote *PicCommentVote `protobuf:"bytes,7,opt,name=pic_comment_vote,json=picCommentVote,proto3,oneof"`
 }
-func (m *LookupPicVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicVoteResponse.DiscardUnknown(m)
+
+type ExportMyDataResponse_UserEvent struct {
+	UserEvent *UserEvent `protobuf:"bytes,8,opt,name=user_event,json=userEvent,proto3,oneof"`
 }
 
-var xxx_messageInfo_LookupPicVoteResponse proto.InternalMessageInfo
+type ExportMyDataResponse_Favorite_ struct {
+	Favorite *ExportMyDataResponse_Favorite `protobuf:"bytes,9,opt,name=favorite,proto3,oneof"`
+}
 
-func (m *LookupPicVoteResponse) GetVote() *PicVote {
-	if m != nil {
-		return m.Vote
-	}
-	return nil
+type ExportMyDataResponse_Collection struct {
+	Collection *Collection `protobuf:"bytes,10,opt,name=collection,proto3,oneof"`
 }
 
-type LookupPublicUserInfoRequest struct {
-	// if absent, assumed to come from auth token
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ExportMyDataResponse_CollectionPic_ struct {
+	CollectionPic *ExportMyDataResponse_CollectionPic `protobuf:"bytes,11,opt,name=collection_pic,json=collectionPic,proto3,oneof"`
 }
 
-func (m *LookupPublicUserInfoRequest) Reset()         { *m = LookupPublicUserInfoRequest{} }
-func (m *LookupPublicUserInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPublicUserInfoRequest) ProtoMessage()    {}
-func (*LookupPublicUserInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+type ExportMyDataResponse_UserFollow_ struct {
+	UserFollow *ExportMyDataResponse_UserFollow `protobuf:"bytes,12,opt,name=user_follow,json=userFollow,proto3,oneof"`
 }
 
-func (m *LookupPublicUserInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPublicUserInfoRequest.Unmarshal(m, b)
+type ExportMyDataResponse_TagFollow_ struct {
+	TagFollow *ExportMyDataResponse_TagFollow `protobuf:"bytes,13,opt,name=tag_follow,json=tagFollow,proto3,oneof"`
 }
-func (m *LookupPublicUserInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPublicUserInfoRequest.Marshal(b, m, deterministic)
+
+type ExportMyDataResponse_ApiKey struct {
+	ApiKey *ApiKey `protobuf:"bytes,14,opt,name=api_key,json=apiKey,proto3,oneof"`
 }
-func (m *LookupPublicUserInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPublicUserInfoRequest.Merge(m, src)
+
+type ExportMyDataResponse_UploadSession_ struct {
+	UploadSession *ExportMyDataResponse_UploadSession `protobuf:"bytes,15,opt,name=upload_session,json=uploadSession,proto3,oneof"`
 }
-func (m *LookupPublicUserInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPublicUserInfoRequest.Size(m)
+
+func (*ExportMyDataResponse_User) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_Session) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_Upload_) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_PicTag) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_PicComment) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_PicVote) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_PicCommentVote) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_UserEvent) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_Favorite_) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_Collection) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_CollectionPic_) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_UserFollow_) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_TagFollow_) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_ApiKey) isExportMyDataResponse_Record() {}
+
+func (*ExportMyDataResponse_UploadSession_) isExportMyDataResponse_Record() {}
+
+func (m *ExportMyDataResponse) GetRecord() isExportMyDataResponse_Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
 }
-func (m *LookupPublicUserInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPublicUserInfoRequest.DiscardUnknown(m)
+
+func (m *ExportMyDataResponse) GetUser() *User {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_User); ok {
+		return x.User
+	}
+	return nil
 }
 
-var xxx_messageInfo_LookupPublicUserInfoRequest proto.InternalMessageInfo
+func (m *ExportMyDataResponse) GetSession() *Session {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_Session); ok {
+		return x.Session
+	}
+	return nil
+}
 
-func (m *LookupPublicUserInfoRequest) GetUserId() string {
-	if m != nil {
-		return m.UserId
+func (m *ExportMyDataResponse) GetUpload() *ExportMyDataResponse_Upload {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_Upload_); ok {
+		return x.Upload
 	}
-	return ""
+	return nil
 }
 
-type LookupPublicUserInfoResponse struct {
-	UserInfo             *PublicUserInfo `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ExportMyDataResponse) GetPicTag() *PicTag {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_PicTag); ok {
+		return x.PicTag
+	}
+	return nil
 }
 
-func (m *LookupPublicUserInfoResponse) Reset()         { *m = LookupPublicUserInfoResponse{} }
-func (m *LookupPublicUserInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPublicUserInfoResponse) ProtoMessage()    {}
-func (*LookupPublicUserInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
+func (m *ExportMyDataResponse) GetPicComment() *PicComment {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_PicComment); ok {
+		return x.PicComment
+	}
+	return nil
 }
 
-func (m *LookupPublicUserInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPublicUserInfoResponse.Unmarshal(m, b)
+func (m *ExportMyDataResponse) GetPicVote() *PicVote {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_PicVote); ok {
+		return x.PicVote
+	}
+	return nil
 }
-func (m *LookupPublicUserInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPublicUserInfoResponse.Marshal(b, m, deterministic)
+
+func (m *ExportMyDataResponse) GetPicCommentVote() *PicCommentVote {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_PicCommentVote); ok {
+		return x.PicCommentVote
+	}
+	return nil
 }
-func (m *LookupPublicUserInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPublicUserInfoResponse.Merge(m, src)
+
+func (m *ExportMyDataResponse) GetUserEvent() *UserEvent {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_UserEvent); ok {
+		return x.UserEvent
+	}
+	return nil
 }
-func (m *LookupPublicUserInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPublicUserInfoResponse.Size(m)
+
+func (m *ExportMyDataResponse) GetFavorite() *ExportMyDataResponse_Favorite {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_Favorite_); ok {
+		return x.Favorite
+	}
+	return nil
 }
-func (m *LookupPublicUserInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPublicUserInfoResponse.DiscardUnknown(m)
+
+func (m *ExportMyDataResponse) GetCollection() *Collection {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_Collection); ok {
+		return x.Collection
+	}
+	return nil
 }
 
-var xxx_messageInfo_LookupPublicUserInfoResponse proto.InternalMessageInfo
+func (m *ExportMyDataResponse) GetCollectionPic() *ExportMyDataResponse_CollectionPic {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_CollectionPic_); ok {
+		return x.CollectionPic
+	}
+	return nil
+}
 
-func (m *LookupPublicUserInfoResponse) GetUserInfo() *PublicUserInfo {
-	if m != nil {
-		return m.UserInfo
+func (m *ExportMyDataResponse) GetUserFollow() *ExportMyDataResponse_UserFollow {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_UserFollow_); ok {
+		return x.UserFollow
 	}
 	return nil
 }
 
-type LookupUserRequest struct {
-	// if absent, assumed to come from auth token
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ExportMyDataResponse) GetTagFollow() *ExportMyDataResponse_TagFollow {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_TagFollow_); ok {
+		return x.TagFollow
+	}
+	return nil
 }
 
-func (m *LookupUserRequest) Reset()         { *m = LookupUserRequest{} }
-func (m *LookupUserRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupUserRequest) ProtoMessage()    {}
-func (*LookupUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
+func (m *ExportMyDataResponse) GetApiKey() *ApiKey {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_ApiKey); ok {
+		return x.ApiKey
+	}
+	return nil
 }
 
-func (m *LookupUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupUserRequest.Unmarshal(m, b)
+func (m *ExportMyDataResponse) GetUploadSession() *ExportMyDataResponse_UploadSession {
+	if x, ok := m.GetRecord().(*ExportMyDataResponse_UploadSession_); ok {
+		return x.UploadSession
+	}
+	return nil
 }
-func (m *LookupUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupUserRequest.Marshal(b, m, deterministic)
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ExportMyDataResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ExportMyDataResponse_User)(nil),
+		(*ExportMyDataResponse_Session)(nil),
+		(*ExportMyDataResponse_Upload_)(nil),
+		(*ExportMyDataResponse_PicTag)(nil),
+		(*ExportMyDataResponse_PicComment)(nil),
+		(*ExportMyDataResponse_PicVote)(nil),
+		(*ExportMyDataResponse_PicCommentVote)(nil),
+		(*ExportMyDataResponse_UserEvent)(nil),
+		(*ExportMyDataResponse_Favorite_)(nil),
+		(*ExportMyDataResponse_Collection)(nil),
+		(*ExportMyDataResponse_CollectionPic_)(nil),
+		(*ExportMyDataResponse_UserFollow_)(nil),
+		(*ExportMyDataResponse_TagFollow_)(nil),
+		(*ExportMyDataResponse_ApiKey)(nil),
+		(*ExportMyDataResponse_UploadSession_)(nil),
+	}
+}
+
+// Upload is a file source added by the user when uploading a pic.
+type ExportMyDataResponse_Upload struct {
+	PicId                string               `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	Source               *PicSource           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedTime          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *LookupUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupUserRequest.Merge(m, src)
+
+func (m *ExportMyDataResponse_Upload) Reset()         { *m = ExportMyDataResponse_Upload{} }
+func (m *ExportMyDataResponse_Upload) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataResponse_Upload) ProtoMessage()    {}
+func (*ExportMyDataResponse_Upload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39, 0}
 }
-func (m *LookupUserRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupUserRequest.Size(m)
+
+func (m *ExportMyDataResponse_Upload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataResponse_Upload.Unmarshal(m, b)
 }
-func (m *LookupUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupUserRequest.DiscardUnknown(m)
+func (m *ExportMyDataResponse_Upload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataResponse_Upload.Marshal(b, m, deterministic)
+}
+func (m *ExportMyDataResponse_Upload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataResponse_Upload.Merge(m, src)
+}
+func (m *ExportMyDataResponse_Upload) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataResponse_Upload.Size(m)
+}
+func (m *ExportMyDataResponse_Upload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataResponse_Upload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportMyDataResponse_Upload proto.InternalMessageInfo
 
-func (m *LookupUserRequest) GetUserId() string {
+func (m *ExportMyDataResponse_Upload) GetPicId() string {
 	if m != nil {
-		return m.UserId
+		return m.PicId
 	}
 	return ""
 }
 
-type LookupUserResponse struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ExportMyDataResponse_Upload) GetSource() *PicSource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
 }
 
-func (m *LookupUserResponse) Reset()         { *m = LookupUserResponse{} }
-func (m *LookupUserResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupUserResponse) ProtoMessage()    {}
-func (*LookupUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+func (m *ExportMyDataResponse_Upload) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
 }
 
-func (m *LookupUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupUserResponse.Unmarshal(m, b)
+// Favorite is a pic favorited by the user.
+type ExportMyDataResponse_Favorite struct {
+	PicId                string               `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	CreatedTime          *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *LookupUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupUserResponse.Marshal(b, m, deterministic)
+
+func (m *ExportMyDataResponse_Favorite) Reset()         { *m = ExportMyDataResponse_Favorite{} }
+func (m *ExportMyDataResponse_Favorite) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataResponse_Favorite) ProtoMessage()    {}
+func (*ExportMyDataResponse_Favorite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39, 1}
 }
-func (m *LookupUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupUserResponse.Merge(m, src)
+
+func (m *ExportMyDataResponse_Favorite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataResponse_Favorite.Unmarshal(m, b)
 }
-func (m *LookupUserResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupUserResponse.Size(m)
+func (m *ExportMyDataResponse_Favorite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataResponse_Favorite.Marshal(b, m, deterministic)
 }
-func (m *LookupUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupUserResponse.DiscardUnknown(m)
+func (m *ExportMyDataResponse_Favorite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataResponse_Favorite.Merge(m, src)
+}
+func (m *ExportMyDataResponse_Favorite) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataResponse_Favorite.Size(m)
+}
+func (m *ExportMyDataResponse_Favorite) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataResponse_Favorite.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportMyDataResponse_Favorite proto.InternalMessageInfo
 
-func (m *LookupUserResponse) GetUser() *User {
+func (m *ExportMyDataResponse_Favorite) GetPicId() string {
 	if m != nil {
-		return m.User
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *ExportMyDataResponse_Favorite) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
 	}
 	return nil
 }
 
-type PurgePicRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// CollectionPic is a pic in one of the user's collections.
+type ExportMyDataResponse_CollectionPic struct {
+	CollectionId         string               `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PicId                string               `protobuf:"bytes,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	CreatedTime          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *PurgePicRequest) Reset()         { *m = PurgePicRequest{} }
-func (m *PurgePicRequest) String() string { return proto.CompactTextString(m) }
-func (*PurgePicRequest) ProtoMessage()    {}
-func (*PurgePicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+func (m *ExportMyDataResponse_CollectionPic) Reset()         { *m = ExportMyDataResponse_CollectionPic{} }
+func (m *ExportMyDataResponse_CollectionPic) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataResponse_CollectionPic) ProtoMessage()    {}
+func (*ExportMyDataResponse_CollectionPic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39, 2}
 }
 
-func (m *PurgePicRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PurgePicRequest.Unmarshal(m, b)
+func (m *ExportMyDataResponse_CollectionPic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataResponse_CollectionPic.Unmarshal(m, b)
 }
-func (m *PurgePicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PurgePicRequest.Marshal(b, m, deterministic)
+func (m *ExportMyDataResponse_CollectionPic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataResponse_CollectionPic.Marshal(b, m, deterministic)
 }
-func (m *PurgePicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurgePicRequest.Merge(m, src)
+func (m *ExportMyDataResponse_CollectionPic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataResponse_CollectionPic.Merge(m, src)
 }
-func (m *PurgePicRequest) XXX_Size() int {
-	return xxx_messageInfo_PurgePicRequest.Size(m)
+func (m *ExportMyDataResponse_CollectionPic) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataResponse_CollectionPic.Size(m)
 }
-func (m *PurgePicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurgePicRequest.DiscardUnknown(m)
+func (m *ExportMyDataResponse_CollectionPic) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataResponse_CollectionPic.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurgePicRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportMyDataResponse_CollectionPic proto.InternalMessageInfo
 
-func (m *PurgePicRequest) GetPicId() string {
+func (m *ExportMyDataResponse_CollectionPic) GetCollectionId() string {
 	if m != nil {
-		return m.PicId
+		return m.CollectionId
 	}
 	return ""
 }
 
-type PurgePicResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *PurgePicResponse) Reset()         { *m = PurgePicResponse{} }
-func (m *PurgePicResponse) String() string { return proto.CompactTextString(m) }
-func (*PurgePicResponse) ProtoMessage()    {}
-func (*PurgePicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+func (m *ExportMyDataResponse_CollectionPic) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
 }
 
-func (m *PurgePicResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PurgePicResponse.Unmarshal(m, b)
-}
-func (m *PurgePicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PurgePicResponse.Marshal(b, m, deterministic)
-}
-func (m *PurgePicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurgePicResponse.Merge(m, src)
-}
-func (m *PurgePicResponse) XXX_Size() int {
-	return xxx_messageInfo_PurgePicResponse.Size(m)
-}
-func (m *PurgePicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurgePicResponse.DiscardUnknown(m)
+func (m *ExportMyDataResponse_CollectionPic) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
 }
 
-var xxx_messageInfo_PurgePicResponse proto.InternalMessageInfo
-
-type ReadPicFileRequest struct {
-	PicFileId string         `protobuf:"bytes,1,opt,name=pic_file_id,json=picFileId,proto3" json:"pic_file_id,omitempty"`
-	Format    PicFile_Format `protobuf:"varint,2,opt,name=format,proto3,enum=pixur.api.PicFile_Format" json:"format,omitempty"`
-	// The offset into the file to start reading at.  Required
-	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	// The maximum number of bytes to read.   Maybe be 0.
-	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// UserFollow is a user followed by the user.
+type ExportMyDataResponse_UserFollow struct {
+	FolloweeUserId       string               `protobuf:"bytes,1,opt,name=followee_user_id,json=followeeUserId,proto3" json:"followee_user_id,omitempty"`
+	CreatedTime          *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *ReadPicFileRequest) Reset()         { *m = ReadPicFileRequest{} }
-func (m *ReadPicFileRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadPicFileRequest) ProtoMessage()    {}
-func (*ReadPicFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+func (m *ExportMyDataResponse_UserFollow) Reset()         { *m = ExportMyDataResponse_UserFollow{} }
+func (m *ExportMyDataResponse_UserFollow) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataResponse_UserFollow) ProtoMessage()    {}
+func (*ExportMyDataResponse_UserFollow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39, 3}
 }
 
-func (m *ReadPicFileRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadPicFileRequest.Unmarshal(m, b)
+func (m *ExportMyDataResponse_UserFollow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataResponse_UserFollow.Unmarshal(m, b)
 }
-func (m *ReadPicFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadPicFileRequest.Marshal(b, m, deterministic)
+func (m *ExportMyDataResponse_UserFollow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataResponse_UserFollow.Marshal(b, m, deterministic)
 }
-func (m *ReadPicFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadPicFileRequest.Merge(m, src)
+func (m *ExportMyDataResponse_UserFollow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataResponse_UserFollow.Merge(m, src)
 }
-func (m *ReadPicFileRequest) XXX_Size() int {
-	return xxx_messageInfo_ReadPicFileRequest.Size(m)
+func (m *ExportMyDataResponse_UserFollow) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataResponse_UserFollow.Size(m)
 }
-func (m *ReadPicFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadPicFileRequest.DiscardUnknown(m)
+func (m *ExportMyDataResponse_UserFollow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataResponse_UserFollow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadPicFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportMyDataResponse_UserFollow proto.InternalMessageInfo
 
-func (m *ReadPicFileRequest) GetPicFileId() string {
+func (m *ExportMyDataResponse_UserFollow) GetFolloweeUserId() string {
 	if m != nil {
-		return m.PicFileId
+		return m.FolloweeUserId
 	}
 	return ""
 }
 
-func (m *ReadPicFileRequest) GetFormat() PicFile_Format {
-	if m != nil {
-		return m.Format
-	}
-	return PicFile_UNKNOWN
-}
-
-func (m *ReadPicFileRequest) GetOffset() int64 {
-	if m != nil {
-		return m.Offset
-	}
-	return 0
-}
-
-func (m *ReadPicFileRequest) GetLimit() int64 {
+func (m *ExportMyDataResponse_UserFollow) GetCreatedTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.Limit
+		return m.CreatedTime
 	}
-	return 0
+	return nil
 }
 
-type ReadPicFileResponse struct {
-	// may be empty
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// indicates that the end of the pic has been reached.
-	Eof                  bool     `protobuf:"varint,2,opt,name=eof,proto3" json:"eof,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// TagFollow is a tag followed by the user.
+type ExportMyDataResponse_TagFollow struct {
+	Tag                  string               `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	CreatedTime          *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *ReadPicFileResponse) Reset()         { *m = ReadPicFileResponse{} }
-func (m *ReadPicFileResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadPicFileResponse) ProtoMessage()    {}
-func (*ReadPicFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+func (m *ExportMyDataResponse_TagFollow) Reset()         { *m = ExportMyDataResponse_TagFollow{} }
+func (m *ExportMyDataResponse_TagFollow) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataResponse_TagFollow) ProtoMessage()    {}
+func (*ExportMyDataResponse_TagFollow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39, 4}
 }
 
-func (m *ReadPicFileResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadPicFileResponse.Unmarshal(m, b)
+func (m *ExportMyDataResponse_TagFollow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataResponse_TagFollow.Unmarshal(m, b)
 }
-func (m *ReadPicFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadPicFileResponse.Marshal(b, m, deterministic)
+func (m *ExportMyDataResponse_TagFollow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataResponse_TagFollow.Marshal(b, m, deterministic)
 }
-func (m *ReadPicFileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadPicFileResponse.Merge(m, src)
+func (m *ExportMyDataResponse_TagFollow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataResponse_TagFollow.Merge(m, src)
 }
-func (m *ReadPicFileResponse) XXX_Size() int {
-	return xxx_messageInfo_ReadPicFileResponse.Size(m)
+func (m *ExportMyDataResponse_TagFollow) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataResponse_TagFollow.Size(m)
 }
-func (m *ReadPicFileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadPicFileResponse.DiscardUnknown(m)
+func (m *ExportMyDataResponse_TagFollow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataResponse_TagFollow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadPicFileResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportMyDataResponse_TagFollow proto.InternalMessageInfo
 
-func (m *ReadPicFileResponse) GetData() []byte {
+func (m *ExportMyDataResponse_TagFollow) GetTag() string {
 	if m != nil {
-		return m.Data
+		return m.Tag
 	}
-	return nil
+	return ""
 }
 
-func (m *ReadPicFileResponse) GetEof() bool {
+func (m *ExportMyDataResponse_TagFollow) GetCreatedTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.Eof
+		return m.CreatedTime
 	}
-	return false
+	return nil
 }
 
-type SoftDeletePicRequest struct {
-	PicId                string               `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	Details              string               `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
-	Reason               DeletionReason       `protobuf:"varint,3,opt,name=reason,proto3,enum=pixur.api.DeletionReason" json:"reason,omitempty"`
-	DeletionTime         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+// UploadSession is an unfinished upload started by the user.  The data received so far is not
+// included.
+type ExportMyDataResponse_UploadSession struct {
+	UploadSessionId      string               `protobuf:"bytes,1,opt,name=upload_session_id,json=uploadSessionId,proto3" json:"upload_session_id,omitempty"`
+	Source               *PicSource           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedTime          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	ModifiedTime         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *SoftDeletePicRequest) Reset()         { *m = SoftDeletePicRequest{} }
-func (m *SoftDeletePicRequest) String() string { return proto.CompactTextString(m) }
-func (*SoftDeletePicRequest) ProtoMessage()    {}
-func (*SoftDeletePicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+func (m *ExportMyDataResponse_UploadSession) Reset()         { *m = ExportMyDataResponse_UploadSession{} }
+func (m *ExportMyDataResponse_UploadSession) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataResponse_UploadSession) ProtoMessage()    {}
+func (*ExportMyDataResponse_UploadSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39, 5}
 }
 
-func (m *SoftDeletePicRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SoftDeletePicRequest.Unmarshal(m, b)
+func (m *ExportMyDataResponse_UploadSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataResponse_UploadSession.Unmarshal(m, b)
 }
-func (m *SoftDeletePicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SoftDeletePicRequest.Marshal(b, m, deterministic)
+func (m *ExportMyDataResponse_UploadSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataResponse_UploadSession.Marshal(b, m, deterministic)
 }
-func (m *SoftDeletePicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SoftDeletePicRequest.Merge(m, src)
+func (m *ExportMyDataResponse_UploadSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataResponse_UploadSession.Merge(m, src)
 }
-func (m *SoftDeletePicRequest) XXX_Size() int {
-	return xxx_messageInfo_SoftDeletePicRequest.Size(m)
+func (m *ExportMyDataResponse_UploadSession) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataResponse_UploadSession.Size(m)
 }
-func (m *SoftDeletePicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SoftDeletePicRequest.DiscardUnknown(m)
+func (m *ExportMyDataResponse_UploadSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataResponse_UploadSession.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SoftDeletePicRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportMyDataResponse_UploadSession proto.InternalMessageInfo
 
-func (m *SoftDeletePicRequest) GetPicId() string {
+func (m *ExportMyDataResponse_UploadSession) GetUploadSessionId() string {
 	if m != nil {
-		return m.PicId
+		return m.UploadSessionId
 	}
 	return ""
 }
 
-func (m *SoftDeletePicRequest) GetDetails() string {
+func (m *ExportMyDataResponse_UploadSession) GetSource() *PicSource {
 	if m != nil {
-		return m.Details
+		return m.Source
 	}
-	return ""
+	return nil
 }
 
-func (m *SoftDeletePicRequest) GetReason() DeletionReason {
+func (m *ExportMyDataResponse_UploadSession) GetCreatedTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.Reason
+		return m.CreatedTime
 	}
-	return DeletionReason_UNKNOWN
+	return nil
 }
 
-func (m *SoftDeletePicRequest) GetDeletionTime() *timestamp.Timestamp {
+func (m *ExportMyDataResponse_UploadSession) GetModifiedTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.DeletionTime
+		return m.ModifiedTime
 	}
 	return nil
 }
 
-type SoftDeletePicResponse struct {
+type FindApiKeysRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SoftDeletePicResponse) Reset()         { *m = SoftDeletePicResponse{} }
-func (m *SoftDeletePicResponse) String() string { return proto.CompactTextString(m) }
-func (*SoftDeletePicResponse) ProtoMessage()    {}
-func (*SoftDeletePicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+func (m *FindApiKeysRequest) Reset()         { *m = FindApiKeysRequest{} }
+func (m *FindApiKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*FindApiKeysRequest) ProtoMessage()    {}
+func (*FindApiKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 
-func (m *SoftDeletePicResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SoftDeletePicResponse.Unmarshal(m, b)
+func (m *FindApiKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindApiKeysRequest.Unmarshal(m, b)
 }
-func (m *SoftDeletePicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SoftDeletePicResponse.Marshal(b, m, deterministic)
+func (m *FindApiKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindApiKeysRequest.Marshal(b, m, deterministic)
 }
-func (m *SoftDeletePicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SoftDeletePicResponse.Merge(m, src)
+func (m *FindApiKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindApiKeysRequest.Merge(m, src)
 }
-func (m *SoftDeletePicResponse) XXX_Size() int {
-	return xxx_messageInfo_SoftDeletePicResponse.Size(m)
+func (m *FindApiKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_FindApiKeysRequest.Size(m)
 }
-func (m *SoftDeletePicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SoftDeletePicResponse.DiscardUnknown(m)
+func (m *FindApiKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindApiKeysRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SoftDeletePicResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindApiKeysRequest proto.InternalMessageInfo
 
-type UpdateUserRequest struct {
-	UserId               string                              `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Version              int64                               `protobuf:"fixed64,2,opt,name=version,proto3" json:"version,omitempty"`
-	Ident                *UpdateUserRequest_ChangeIdent      `protobuf:"bytes,3,opt,name=ident,proto3" json:"ident,omitempty"`
-	Secret               *UpdateUserRequest_ChangeSecret     `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
-	Capability           *UpdateUserRequest_ChangeCapability `protobuf:"bytes,5,opt,name=capability,proto3" json:"capability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
-	XXX_unrecognized     []byte                              `json:"-"`
-	XXX_sizecache        int32                               `json:"-"`
+type FindApiKeysResponse struct {
+	ApiKey               []*ApiKey `protobuf:"bytes,1,rep,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
-func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest) ProtoMessage()    {}
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+func (m *FindApiKeysResponse) Reset()         { *m = FindApiKeysResponse{} }
+func (m *FindApiKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*FindApiKeysResponse) ProtoMessage()    {}
+func (*FindApiKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
 }
 
-func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserRequest.Unmarshal(m, b)
+func (m *FindApiKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindApiKeysResponse.Unmarshal(m, b)
 }
-func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserRequest.Marshal(b, m, deterministic)
+func (m *FindApiKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindApiKeysResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest.Merge(m, src)
+func (m *FindApiKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindApiKeysResponse.Merge(m, src)
 }
-func (m *UpdateUserRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserRequest.Size(m)
+func (m *FindApiKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_FindApiKeysResponse.Size(m)
 }
-func (m *UpdateUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest.DiscardUnknown(m)
+func (m *FindApiKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindApiKeysResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindApiKeysResponse proto.InternalMessageInfo
 
-func (m *UpdateUserRequest) GetUserId() string {
+func (m *FindApiKeysResponse) GetApiKey() []*ApiKey {
 	if m != nil {
-		return m.UserId
+		return m.ApiKey
+	}
+	return nil
+}
+
+// FindAuditLogRequest finds audit log entries, newest first.  The filters are combined.
+type FindAuditLogRequest struct {
+	// Optional filters.
+	ActorUserId  string          `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	TargetUserId string          `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	TargetPicId  string          `protobuf:"bytes,3,opt,name=target_pic_id,json=targetPicId,proto3" json:"target_pic_id,omitempty"`
+	Action       AuditLog_Action `protobuf:"varint,4,opt,name=action,proto3,enum=pixur.api.AuditLog_Action" json:"action,omitempty"`
+	// Optional.  If present, the entry to start scanning at, as returned in next_audit_log_id.
+	StartAuditLogId string `protobuf:"bytes,5,opt,name=start_audit_log_id,json=startAuditLogId,proto3" json:"start_audit_log_id,omitempty"`
+	// Optional.  The max number of entries to return.
+	MaxAuditLogs         int64    `protobuf:"varint,6,opt,name=max_audit_logs,json=maxAuditLogs,proto3" json:"max_audit_logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindAuditLogRequest) Reset()         { *m = FindAuditLogRequest{} }
+func (m *FindAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*FindAuditLogRequest) ProtoMessage()    {}
+func (*FindAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+}
+
+func (m *FindAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindAuditLogRequest.Unmarshal(m, b)
+}
+func (m *FindAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindAuditLogRequest.Marshal(b, m, deterministic)
+}
+func (m *FindAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindAuditLogRequest.Merge(m, src)
+}
+func (m *FindAuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_FindAuditLogRequest.Size(m)
+}
+func (m *FindAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindAuditLogRequest proto.InternalMessageInfo
+
+func (m *FindAuditLogRequest) GetActorUserId() string {
+	if m != nil {
+		return m.ActorUserId
 	}
 	return ""
 }
 
-func (m *UpdateUserRequest) GetVersion() int64 {
+func (m *FindAuditLogRequest) GetTargetUserId() string {
 	if m != nil {
-		return m.Version
+		return m.TargetUserId
 	}
-	return 0
+	return ""
 }
 
-func (m *UpdateUserRequest) GetIdent() *UpdateUserRequest_ChangeIdent {
+func (m *FindAuditLogRequest) GetTargetPicId() string {
 	if m != nil {
-		return m.Ident
+		return m.TargetPicId
 	}
-	return nil
+	return ""
 }
 
-func (m *UpdateUserRequest) GetSecret() *UpdateUserRequest_ChangeSecret {
+func (m *FindAuditLogRequest) GetAction() AuditLog_Action {
 	if m != nil {
-		return m.Secret
+		return m.Action
 	}
-	return nil
+	return AuditLog_UNKNOWN
 }
 
-func (m *UpdateUserRequest) GetCapability() *UpdateUserRequest_ChangeCapability {
+func (m *FindAuditLogRequest) GetStartAuditLogId() string {
 	if m != nil {
-		return m.Capability
+		return m.StartAuditLogId
 	}
-	return nil
+	return ""
 }
 
-type UpdateUserRequest_ChangeIdent struct {
-	Ident                string   `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+func (m *FindAuditLogRequest) GetMaxAuditLogs() int64 {
+	if m != nil {
+		return m.MaxAuditLogs
+	}
+	return 0
+}
+
+type FindAuditLogResponse struct {
+	AuditLog []*AuditLog `protobuf:"bytes,1,rep,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+	// next_audit_log_id is the start of the next page, if there are more entries.
+	NextAuditLogId       string   `protobuf:"bytes,2,opt,name=next_audit_log_id,json=nextAuditLogId,proto3" json:"next_audit_log_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateUserRequest_ChangeIdent) Reset()         { *m = UpdateUserRequest_ChangeIdent{} }
-func (m *UpdateUserRequest_ChangeIdent) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest_ChangeIdent) ProtoMessage()    {}
-func (*UpdateUserRequest_ChangeIdent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42, 0}
+func (m *FindAuditLogResponse) Reset()         { *m = FindAuditLogResponse{} }
+func (m *FindAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*FindAuditLogResponse) ProtoMessage()    {}
+func (*FindAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
 }
 
-func (m *UpdateUserRequest_ChangeIdent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Unmarshal(m, b)
+func (m *FindAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindAuditLogResponse.Unmarshal(m, b)
 }
-func (m *UpdateUserRequest_ChangeIdent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Marshal(b, m, deterministic)
+func (m *FindAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindAuditLogResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserRequest_ChangeIdent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest_ChangeIdent.Merge(m, src)
+func (m *FindAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindAuditLogResponse.Merge(m, src)
 }
-func (m *UpdateUserRequest_ChangeIdent) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Size(m)
+func (m *FindAuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_FindAuditLogResponse.Size(m)
 }
-func (m *UpdateUserRequest_ChangeIdent) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest_ChangeIdent.DiscardUnknown(m)
+func (m *FindAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindAuditLogResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserRequest_ChangeIdent proto.InternalMessageInfo
+var xxx_messageInfo_FindAuditLogResponse proto.InternalMessageInfo
 
-func (m *UpdateUserRequest_ChangeIdent) GetIdent() string {
+func (m *FindAuditLogResponse) GetAuditLog() []*AuditLog {
 	if m != nil {
-		return m.Ident
+		return m.AuditLog
+	}
+	return nil
+}
+
+func (m *FindAuditLogResponse) GetNextAuditLogId() string {
+	if m != nil {
+		return m.NextAuditLogId
 	}
 	return ""
 }
 
-type UpdateUserRequest_ChangeSecret struct {
-	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+// FindCollectionsRequest finds the collections of a user, oldest first.  Private collections are
+// only found for the owner.
+type FindCollectionsRequest struct {
+	// Optional.  Uses auth token if not specified.
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateUserRequest_ChangeSecret) Reset()         { *m = UpdateUserRequest_ChangeSecret{} }
-func (m *UpdateUserRequest_ChangeSecret) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest_ChangeSecret) ProtoMessage()    {}
-func (*UpdateUserRequest_ChangeSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42, 1}
+func (m *FindCollectionsRequest) Reset()         { *m = FindCollectionsRequest{} }
+func (m *FindCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindCollectionsRequest) ProtoMessage()    {}
+func (*FindCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
 }
 
-func (m *UpdateUserRequest_ChangeSecret) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Unmarshal(m, b)
+func (m *FindCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindCollectionsRequest.Unmarshal(m, b)
 }
-func (m *UpdateUserRequest_ChangeSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Marshal(b, m, deterministic)
+func (m *FindCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindCollectionsRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserRequest_ChangeSecret) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest_ChangeSecret.Merge(m, src)
+func (m *FindCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindCollectionsRequest.Merge(m, src)
 }
-func (m *UpdateUserRequest_ChangeSecret) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Size(m)
+func (m *FindCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindCollectionsRequest.Size(m)
 }
-func (m *UpdateUserRequest_ChangeSecret) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest_ChangeSecret.DiscardUnknown(m)
+func (m *FindCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindCollectionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserRequest_ChangeSecret proto.InternalMessageInfo
+var xxx_messageInfo_FindCollectionsRequest proto.InternalMessageInfo
 
-func (m *UpdateUserRequest_ChangeSecret) GetSecret() string {
+func (m *FindCollectionsRequest) GetUserId() string {
 	if m != nil {
-		return m.Secret
+		return m.UserId
 	}
 	return ""
 }
 
-type UpdateUserRequest_ChangeCapability struct {
-	SetCapability        []Capability_Cap `protobuf:"varint,1,rep,packed,name=set_capability,json=setCapability,proto3,enum=pixur.api.Capability_Cap" json:"set_capability,omitempty"`
-	ClearCapability      []Capability_Cap `protobuf:"varint,2,rep,packed,name=clear_capability,json=clearCapability,proto3,enum=pixur.api.Capability_Cap" json:"clear_capability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type FindCollectionsResponse struct {
+	Collection           []*Collection `protobuf:"bytes,1,rep,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *UpdateUserRequest_ChangeCapability) Reset()         { *m = UpdateUserRequest_ChangeCapability{} }
-func (m *UpdateUserRequest_ChangeCapability) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest_ChangeCapability) ProtoMessage()    {}
-func (*UpdateUserRequest_ChangeCapability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42, 2}
+func (m *FindCollectionsResponse) Reset()         { *m = FindCollectionsResponse{} }
+func (m *FindCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindCollectionsResponse) ProtoMessage()    {}
+func (*FindCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
 }
 
-func (m *UpdateUserRequest_ChangeCapability) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Unmarshal(m, b)
+func (m *FindCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindCollectionsResponse.Unmarshal(m, b)
 }
-func (m *UpdateUserRequest_ChangeCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Marshal(b, m, deterministic)
+func (m *FindCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindCollectionsResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserRequest_ChangeCapability) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest_ChangeCapability.Merge(m, src)
+func (m *FindCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindCollectionsResponse.Merge(m, src)
 }
-func (m *UpdateUserRequest_ChangeCapability) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Size(m)
+func (m *FindCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindCollectionsResponse.Size(m)
 }
-func (m *UpdateUserRequest_ChangeCapability) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest_ChangeCapability.DiscardUnknown(m)
+func (m *FindCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindCollectionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserRequest_ChangeCapability proto.InternalMessageInfo
-
-func (m *UpdateUserRequest_ChangeCapability) GetSetCapability() []Capability_Cap {
-	if m != nil {
-		return m.SetCapability
-	}
-	return nil
-}
+var xxx_messageInfo_FindCollectionsResponse proto.InternalMessageInfo
 
-func (m *UpdateUserRequest_ChangeCapability) GetClearCapability() []Capability_Cap {
+func (m *FindCollectionsResponse) GetCollection() []*Collection {
 	if m != nil {
-		return m.ClearCapability
+		return m.Collection
 	}
 	return nil
 }
 
-type UpdateUserResponse struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+// FindFeedPicsRequest finds the pics uploaded by the users, or tagged with the tags, that the
+// current user follows.  Pics are in the same order as FindIndexPics.
+type FindFeedPicsRequest struct {
+	StartPicId           string   `protobuf:"bytes,1,opt,name=start_pic_id,json=startPicId,proto3" json:"start_pic_id,omitempty"`
+	Ascending            bool     `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateUserResponse) Reset()         { *m = UpdateUserResponse{} }
-func (m *UpdateUserResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserResponse) ProtoMessage()    {}
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+func (m *FindFeedPicsRequest) Reset()         { *m = FindFeedPicsRequest{} }
+func (m *FindFeedPicsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindFeedPicsRequest) ProtoMessage()    {}
+func (*FindFeedPicsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
 }
 
-func (m *UpdateUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserResponse.Unmarshal(m, b)
+func (m *FindFeedPicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindFeedPicsRequest.Unmarshal(m, b)
 }
-func (m *UpdateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserResponse.Marshal(b, m, deterministic)
+func (m *FindFeedPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindFeedPicsRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserResponse.Merge(m, src)
+func (m *FindFeedPicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindFeedPicsRequest.Merge(m, src)
 }
-func (m *UpdateUserResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserResponse.Size(m)
+func (m *FindFeedPicsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindFeedPicsRequest.Size(m)
 }
-func (m *UpdateUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserResponse.DiscardUnknown(m)
+func (m *FindFeedPicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindFeedPicsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindFeedPicsRequest proto.InternalMessageInfo
 
-func (m *UpdateUserResponse) GetUser() *User {
+func (m *FindFeedPicsRequest) GetStartPicId() string {
 	if m != nil {
-		return m.User
+		return m.StartPicId
 	}
-	return nil
+	return ""
 }
 
-type UpsertPicCommentVoteRequest struct {
-	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// Optional.   Not necessary when creating for the first time.
-	Version              int64               `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
-	Vote                 PicCommentVote_Vote `protobuf:"varint,4,opt,name=vote,proto3,enum=pixur.api.PicCommentVote_Vote" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *FindFeedPicsRequest) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
 }
 
-func (m *UpsertPicCommentVoteRequest) Reset()         { *m = UpsertPicCommentVoteRequest{} }
-func (m *UpsertPicCommentVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicCommentVoteRequest) ProtoMessage()    {}
-func (*UpsertPicCommentVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+type FindFeedPicsResponse struct {
+	Pic []*PicAndThumbnail `protobuf:"bytes,1,rep,name=pic,proto3" json:"pic,omitempty"`
+	// if set, this field is the next pic id as a continuation token.  Since only a bounded number of
+	// pics are checked per request, it may be set even if pic is empty.
+	NextPicId string `protobuf:"bytes,2,opt,name=next_pic_id,json=nextPicId,proto3" json:"next_pic_id,omitempty"`
+	// if set, this field is the previous pic id as a continuation token.
+	PrevPicId            string   `protobuf:"bytes,3,opt,name=prev_pic_id,json=prevPicId,proto3" json:"prev_pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicCommentVoteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicCommentVoteRequest.Unmarshal(m, b)
+func (m *FindFeedPicsResponse) Reset()         { *m = FindFeedPicsResponse{} }
+func (m *FindFeedPicsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindFeedPicsResponse) ProtoMessage()    {}
+func (*FindFeedPicsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
 }
-func (m *UpsertPicCommentVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicCommentVoteRequest.Marshal(b, m, deterministic)
+
+func (m *FindFeedPicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindFeedPicsResponse.Unmarshal(m, b)
 }
-func (m *UpsertPicCommentVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicCommentVoteRequest.Merge(m, src)
+func (m *FindFeedPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindFeedPicsResponse.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicCommentVoteRequest) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicCommentVoteRequest.Size(m)
+func (m *FindFeedPicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindFeedPicsResponse.Merge(m, src)
 }
-func (m *UpsertPicCommentVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicCommentVoteRequest.DiscardUnknown(m)
+func (m *FindFeedPicsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindFeedPicsResponse.Size(m)
+}
+func (m *FindFeedPicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindFeedPicsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicCommentVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindFeedPicsResponse proto.InternalMessageInfo
 
-func (m *UpsertPicCommentVoteRequest) GetPicId() string {
+func (m *FindFeedPicsResponse) GetPic() []*PicAndThumbnail {
 	if m != nil {
-		return m.PicId
+		return m.Pic
 	}
-	return ""
+	return nil
 }
 
-func (m *UpsertPicCommentVoteRequest) GetCommentId() string {
+func (m *FindFeedPicsResponse) GetNextPicId() string {
 	if m != nil {
-		return m.CommentId
+		return m.NextPicId
 	}
 	return ""
 }
 
-func (m *UpsertPicCommentVoteRequest) GetVersion() int64 {
-	if m != nil {
-		return m.Version
-	}
-	return 0
-}
-
-func (m *UpsertPicCommentVoteRequest) GetVote() PicCommentVote_Vote {
+func (m *FindFeedPicsResponse) GetPrevPicId() string {
 	if m != nil {
-		return m.Vote
+		return m.PrevPicId
 	}
-	return PicCommentVote_UNKNOWN
+	return ""
 }
 
-type UpsertPicCommentVoteResponse struct {
+// FindFollowsRequest finds the users and tags the current user follows.
+type FindFollowsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicCommentVoteResponse) Reset()         { *m = UpsertPicCommentVoteResponse{} }
-func (m *UpsertPicCommentVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicCommentVoteResponse) ProtoMessage()    {}
-func (*UpsertPicCommentVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+func (m *FindFollowsRequest) Reset()         { *m = FindFollowsRequest{} }
+func (m *FindFollowsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindFollowsRequest) ProtoMessage()    {}
+func (*FindFollowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
 }
 
-func (m *UpsertPicCommentVoteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicCommentVoteResponse.Unmarshal(m, b)
+func (m *FindFollowsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindFollowsRequest.Unmarshal(m, b)
 }
-func (m *UpsertPicCommentVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicCommentVoteResponse.Marshal(b, m, deterministic)
+func (m *FindFollowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindFollowsRequest.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicCommentVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicCommentVoteResponse.Merge(m, src)
+func (m *FindFollowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindFollowsRequest.Merge(m, src)
 }
-func (m *UpsertPicCommentVoteResponse) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicCommentVoteResponse.Size(m)
+func (m *FindFollowsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindFollowsRequest.Size(m)
 }
-func (m *UpsertPicCommentVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicCommentVoteResponse.DiscardUnknown(m)
+func (m *FindFollowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindFollowsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicCommentVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindFollowsRequest proto.InternalMessageInfo
 
-// UpsertPicRequest is a request to upload and possibly merge with another
-// picture.
-type UpsertPicRequest struct {
-	// url is an optional field for the Pixur backend to download the pic from.
-	// Either url and/or data must be set.   If the data field is set along
-	// with the URL, the url is treated as regular metadata and not fetched.
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	// referrer is the referrer url used to fetch the resource.  If no data is
-	// set, the url will be used to retrieve the data, along with the referrer
-	// being set in the request.  If data is set, referrer is treated as
-	// additional metadata.  May not be used if url is not set.
-	Referrer string `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`
-	// name is an optional field indicating a reasonable file name for the pic.
-	// If name and url are set, the name field will override the name
-	// automatically derived from the URL.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// data is the file contents of the upload.  It must contain a valid pic
-	// if present.  It may be left absent if there is a valid url instead.  This
-	// field takes precedence over the url field if both are specified.
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	// md5_hash is the hash of the pic contents.  It is used to verify the pic
-	// is valid when downloading from url, or when set in data.  If the Pixur
-	// backend already has a pic with a matching md5_hash, it may not use
-	// either data or url (at least one must still be specified, though).
-	Md5Hash []byte `protobuf:"bytes,4,opt,name=md5_hash,json=md5Hash,proto3" json:"md5_hash,omitempty"`
-	// Optional extension data.  You must have the correct permissions to set this.
-	Ext                  map[string]*any.Any `protobuf:"bytes,6,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type FindFollowsResponse struct {
+	// the followed user ids, in id order.
+	UserId []string `protobuf:"bytes,1,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// the followed tag names, in the order of their unique names.
+	Tag                  []string `protobuf:"bytes,2,rep,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicRequest) Reset()         { *m = UpsertPicRequest{} }
-func (m *UpsertPicRequest) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicRequest) ProtoMessage()    {}
-func (*UpsertPicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+func (m *FindFollowsResponse) Reset()         { *m = FindFollowsResponse{} }
+func (m *FindFollowsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindFollowsResponse) ProtoMessage()    {}
+func (*FindFollowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
 }
 
-func (m *UpsertPicRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicRequest.Unmarshal(m, b)
+func (m *FindFollowsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindFollowsResponse.Unmarshal(m, b)
 }
-func (m *UpsertPicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicRequest.Marshal(b, m, deterministic)
+func (m *FindFollowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindFollowsResponse.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicRequest.Merge(m, src)
+func (m *FindFollowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindFollowsResponse.Merge(m, src)
 }
-func (m *UpsertPicRequest) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicRequest.Size(m)
+func (m *FindFollowsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindFollowsResponse.Size(m)
 }
-func (m *UpsertPicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicRequest.DiscardUnknown(m)
+func (m *FindFollowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindFollowsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindFollowsResponse proto.InternalMessageInfo
 
-func (m *UpsertPicRequest) GetUrl() string {
+func (m *FindFollowsResponse) GetUserId() []string {
 	if m != nil {
-		return m.Url
+		return m.UserId
 	}
-	return ""
+	return nil
 }
 
-func (m *UpsertPicRequest) GetReferrer() string {
+func (m *FindFollowsResponse) GetTag() []string {
 	if m != nil {
-		return m.Referrer
+		return m.Tag
 	}
-	return ""
+	return nil
 }
 
-func (m *UpsertPicRequest) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+type FindIndexPicsRequest struct {
+	StartPicId           string   `protobuf:"bytes,1,opt,name=start_pic_id,json=startPicId,proto3" json:"start_pic_id,omitempty"`
+	Ascending            bool     `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicRequest) GetData() []byte {
-	if m != nil {
-		return m.Data
-	}
-	return nil
+func (m *FindIndexPicsRequest) Reset()         { *m = FindIndexPicsRequest{} }
+func (m *FindIndexPicsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindIndexPicsRequest) ProtoMessage()    {}
+func (*FindIndexPicsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
 }
 
-func (m *UpsertPicRequest) GetMd5Hash() []byte {
+func (m *FindIndexPicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindIndexPicsRequest.Unmarshal(m, b)
+}
+func (m *FindIndexPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindIndexPicsRequest.Marshal(b, m, deterministic)
+}
+func (m *FindIndexPicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindIndexPicsRequest.Merge(m, src)
+}
+func (m *FindIndexPicsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindIndexPicsRequest.Size(m)
+}
+func (m *FindIndexPicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindIndexPicsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindIndexPicsRequest proto.InternalMessageInfo
+
+func (m *FindIndexPicsRequest) GetStartPicId() string {
 	if m != nil {
-		return m.Md5Hash
+		return m.StartPicId
 	}
-	return nil
+	return ""
 }
 
-func (m *UpsertPicRequest) GetExt() map[string]*any.Any {
+func (m *FindIndexPicsRequest) GetAscending() bool {
 	if m != nil {
-		return m.Ext
+		return m.Ascending
 	}
-	return nil
+	return false
 }
 
-type UpsertPicResponse struct {
-	// pic is the newly created or updated picture.
-	Pic                  *Pic     `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+type FindIndexPicsResponse struct {
+	Pic []*PicAndThumbnail `protobuf:"bytes,4,rep,name=pic,proto3" json:"pic,omitempty"`
+	// if set, this field is the next pic id as a
+	// continuation token.
+	NextPicId string `protobuf:"bytes,2,opt,name=next_pic_id,json=nextPicId,proto3" json:"next_pic_id,omitempty"`
+	// if set, this field is the previous pic id as a
+	// continuation token.
+	PrevPicId            string   `protobuf:"bytes,3,opt,name=prev_pic_id,json=prevPicId,proto3" json:"prev_pic_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicResponse) Reset()         { *m = UpsertPicResponse{} }
-func (m *UpsertPicResponse) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicResponse) ProtoMessage()    {}
-func (*UpsertPicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+func (m *FindIndexPicsResponse) Reset()         { *m = FindIndexPicsResponse{} }
+func (m *FindIndexPicsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindIndexPicsResponse) ProtoMessage()    {}
+func (*FindIndexPicsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
 }
 
-func (m *UpsertPicResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicResponse.Unmarshal(m, b)
+func (m *FindIndexPicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindIndexPicsResponse.Unmarshal(m, b)
 }
-func (m *UpsertPicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicResponse.Marshal(b, m, deterministic)
+func (m *FindIndexPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindIndexPicsResponse.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicResponse.Merge(m, src)
+func (m *FindIndexPicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindIndexPicsResponse.Merge(m, src)
 }
-func (m *UpsertPicResponse) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicResponse.Size(m)
+func (m *FindIndexPicsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindIndexPicsResponse.Size(m)
 }
-func (m *UpsertPicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicResponse.DiscardUnknown(m)
+func (m *FindIndexPicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindIndexPicsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindIndexPicsResponse proto.InternalMessageInfo
 
-func (m *UpsertPicResponse) GetPic() *Pic {
+func (m *FindIndexPicsResponse) GetPic() []*PicAndThumbnail {
 	if m != nil {
 		return m.Pic
 	}
 	return nil
 }
 
-type UpsertPicVoteRequest struct {
+func (m *FindIndexPicsResponse) GetNextPicId() string {
+	if m != nil {
+		return m.NextPicId
+	}
+	return ""
+}
+
+func (m *FindIndexPicsResponse) GetPrevPicId() string {
+	if m != nil {
+		return m.PrevPicId
+	}
+	return ""
+}
+
+type FindPicCommentVotesRequest struct {
+	// the pic id of pic comment votes.  Must be present.
 	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	// Optional.   Not necessary when creating for the first time.
-	Version              int64        `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
-	Vote                 PicVote_Vote `protobuf:"varint,2,opt,name=vote,proto3,enum=pixur.api.PicVote_Vote" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	// the comment id of pic comment votes.  If present, pic id must be present too.
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// the user_id of the pic votes.  If present, comment id must be present too.
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicVoteRequest) Reset()         { *m = UpsertPicVoteRequest{} }
-func (m *UpsertPicVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicVoteRequest) ProtoMessage()    {}
-func (*UpsertPicVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+func (m *FindPicCommentVotesRequest) Reset()         { *m = FindPicCommentVotesRequest{} }
+func (m *FindPicCommentVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*FindPicCommentVotesRequest) ProtoMessage()    {}
+func (*FindPicCommentVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
 }
 
-func (m *UpsertPicVoteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicVoteRequest.Unmarshal(m, b)
+func (m *FindPicCommentVotesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicCommentVotesRequest.Unmarshal(m, b)
 }
-func (m *UpsertPicVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicVoteRequest.Marshal(b, m, deterministic)
+func (m *FindPicCommentVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicCommentVotesRequest.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicVoteRequest.Merge(m, src)
+func (m *FindPicCommentVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicCommentVotesRequest.Merge(m, src)
 }
-func (m *UpsertPicVoteRequest) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicVoteRequest.Size(m)
+func (m *FindPicCommentVotesRequest) XXX_Size() int {
+	return xxx_messageInfo_FindPicCommentVotesRequest.Size(m)
 }
-func (m *UpsertPicVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicVoteRequest.DiscardUnknown(m)
+func (m *FindPicCommentVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicCommentVotesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindPicCommentVotesRequest proto.InternalMessageInfo
 
-func (m *UpsertPicVoteRequest) GetPicId() string {
+func (m *FindPicCommentVotesRequest) GetPicId() string {
 	if m != nil {
 		return m.PicId
 	}
 	return ""
 }
 
-func (m *UpsertPicVoteRequest) GetVersion() int64 {
+func (m *FindPicCommentVotesRequest) GetCommentId() string {
 	if m != nil {
-		return m.Version
+		return m.CommentId
 	}
-	return 0
+	return ""
 }
 
-func (m *UpsertPicVoteRequest) GetVote() PicVote_Vote {
+func (m *FindPicCommentVotesRequest) GetUserId() string {
 	if m != nil {
-		return m.Vote
+		return m.UserId
 	}
-	return PicVote_UNKNOWN
+	return ""
 }
 
-type UpsertPicVoteResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FindPicCommentVotesResponse struct {
+	// all the votes that matched the prefix, and which the subject user has access to.
+	Vote                 []*PicCommentVote `protobuf:"bytes,1,rep,name=vote,proto3" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *UpsertPicVoteResponse) Reset()         { *m = UpsertPicVoteResponse{} }
-func (m *UpsertPicVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicVoteResponse) ProtoMessage()    {}
-func (*UpsertPicVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+func (m *FindPicCommentVotesResponse) Reset()         { *m = FindPicCommentVotesResponse{} }
+func (m *FindPicCommentVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*FindPicCommentVotesResponse) ProtoMessage()    {}
+func (*FindPicCommentVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
 }
 
-func (m *UpsertPicVoteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicVoteResponse.Unmarshal(m, b)
+func (m *FindPicCommentVotesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicCommentVotesResponse.Unmarshal(m, b)
 }
-func (m *UpsertPicVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicVoteResponse.Marshal(b, m, deterministic)
+func (m *FindPicCommentVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicCommentVotesResponse.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicVoteResponse.Merge(m, src)
+func (m *FindPicCommentVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicCommentVotesResponse.Merge(m, src)
 }
-func (m *UpsertPicVoteResponse) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicVoteResponse.Size(m)
+func (m *FindPicCommentVotesResponse) XXX_Size() int {
+	return xxx_messageInfo_FindPicCommentVotesResponse.Size(m)
 }
-func (m *UpsertPicVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicVoteResponse.DiscardUnknown(m)
+func (m *FindPicCommentVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicCommentVotesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindPicCommentVotesResponse proto.InternalMessageInfo
 
-type WatchBackendConfigurationRequest struct {
+func (m *FindPicCommentVotesResponse) GetVote() []*PicCommentVote {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+// FindPicFavoritesRequest finds the pics the current user has favorited, most recent first.
+type FindPicFavoritesRequest struct {
+	// Optional.  If present, the favorite to start scanning at, as returned in
+	// next_pic_favorite_id.
+	StartPicFavoriteId   string   `protobuf:"bytes,1,opt,name=start_pic_favorite_id,json=startPicFavoriteId,proto3" json:"start_pic_favorite_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchBackendConfigurationRequest) Reset()         { *m = WatchBackendConfigurationRequest{} }
-func (m *WatchBackendConfigurationRequest) String() string { return proto.CompactTextString(m) }
-func (*WatchBackendConfigurationRequest) ProtoMessage()    {}
-func (*WatchBackendConfigurationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+func (m *FindPicFavoritesRequest) Reset()         { *m = FindPicFavoritesRequest{} }
+func (m *FindPicFavoritesRequest) String() string { return proto.CompactTextString(m) }
+func (*FindPicFavoritesRequest) ProtoMessage()    {}
+func (*FindPicFavoritesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
 }
 
-func (m *WatchBackendConfigurationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchBackendConfigurationRequest.Unmarshal(m, b)
+func (m *FindPicFavoritesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicFavoritesRequest.Unmarshal(m, b)
 }
-func (m *WatchBackendConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchBackendConfigurationRequest.Marshal(b, m, deterministic)
+func (m *FindPicFavoritesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicFavoritesRequest.Marshal(b, m, deterministic)
 }
-func (m *WatchBackendConfigurationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchBackendConfigurationRequest.Merge(m, src)
+func (m *FindPicFavoritesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicFavoritesRequest.Merge(m, src)
 }
-func (m *WatchBackendConfigurationRequest) XXX_Size() int {
-	return xxx_messageInfo_WatchBackendConfigurationRequest.Size(m)
+func (m *FindPicFavoritesRequest) XXX_Size() int {
+	return xxx_messageInfo_FindPicFavoritesRequest.Size(m)
 }
-func (m *WatchBackendConfigurationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchBackendConfigurationRequest.DiscardUnknown(m)
+func (m *FindPicFavoritesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicFavoritesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchBackendConfigurationRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindPicFavoritesRequest proto.InternalMessageInfo
 
-type WatchBackendConfigurationResponse struct {
-	Version              int64                 `protobuf:"fixed64,1,opt,name=version,proto3" json:"version,omitempty"`
-	BackendConfiguration *BackendConfiguration `protobuf:"bytes,2,opt,name=backend_configuration,json=backendConfiguration,proto3" json:"backend_configuration,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+func (m *FindPicFavoritesRequest) GetStartPicFavoriteId() string {
+	if m != nil {
+		return m.StartPicFavoriteId
+	}
+	return ""
 }
 
-func (m *WatchBackendConfigurationResponse) Reset()         { *m = WatchBackendConfigurationResponse{} }
-func (m *WatchBackendConfigurationResponse) String() string { return proto.CompactTextString(m) }
-func (*WatchBackendConfigurationResponse) ProtoMessage()    {}
-func (*WatchBackendConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+type FindPicFavoritesResponse struct {
+	Pic []*PicAndThumbnail `protobuf:"bytes,1,rep,name=pic,proto3" json:"pic,omitempty"`
+	// next_pic_favorite_id is the start of the next page, if there are more favorites.
+	NextPicFavoriteId    string   `protobuf:"bytes,2,opt,name=next_pic_favorite_id,json=nextPicFavoriteId,proto3" json:"next_pic_favorite_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchBackendConfigurationResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchBackendConfigurationResponse.Unmarshal(m, b)
+func (m *FindPicFavoritesResponse) Reset()         { *m = FindPicFavoritesResponse{} }
+func (m *FindPicFavoritesResponse) String() string { return proto.CompactTextString(m) }
+func (*FindPicFavoritesResponse) ProtoMessage()    {}
+func (*FindPicFavoritesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
 }
-func (m *WatchBackendConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchBackendConfigurationResponse.Marshal(b, m, deterministic)
+
+func (m *FindPicFavoritesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicFavoritesResponse.Unmarshal(m, b)
 }
-func (m *WatchBackendConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchBackendConfigurationResponse.Merge(m, src)
+func (m *FindPicFavoritesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicFavoritesResponse.Marshal(b, m, deterministic)
 }
-func (m *WatchBackendConfigurationResponse) XXX_Size() int {
-	return xxx_messageInfo_WatchBackendConfigurationResponse.Size(m)
+func (m *FindPicFavoritesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicFavoritesResponse.Merge(m, src)
 }
-func (m *WatchBackendConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchBackendConfigurationResponse.DiscardUnknown(m)
+func (m *FindPicFavoritesResponse) XXX_Size() int {
+	return xxx_messageInfo_FindPicFavoritesResponse.Size(m)
+}
+func (m *FindPicFavoritesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicFavoritesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchBackendConfigurationResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindPicFavoritesResponse proto.InternalMessageInfo
 
-func (m *WatchBackendConfigurationResponse) GetVersion() int64 {
+func (m *FindPicFavoritesResponse) GetPic() []*PicAndThumbnail {
 	if m != nil {
-		return m.Version
+		return m.Pic
 	}
-	return 0
+	return nil
 }
 
-func (m *WatchBackendConfigurationResponse) GetBackendConfiguration() *BackendConfiguration {
+func (m *FindPicFavoritesResponse) GetNextPicFavoriteId() string {
 	if m != nil {
-		return m.BackendConfiguration
+		return m.NextPicFavoriteId
 	}
-	return nil
+	return ""
 }
 
-type ServiceOpts struct {
-	// a vaguely defined, but monotonically increasing "version".
-	ApiVersion int64 `protobuf:"varint,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	// The header key used to when using an auth token.
-	AuthTokenHeaderKey string `protobuf:"bytes,2,opt,name=auth_token_header_key,json=authTokenHeaderKey,proto3" json:"auth_token_header_key,omitempty"`
-	// The header key used to when using a pix token.
-	PixTokenHeaderKey string `protobuf:"bytes,3,opt,name=pix_token_header_key,json=pixTokenHeaderKey,proto3" json:"pix_token_header_key,omitempty"`
-	// Used in responses (e.g. ReadPicFile) to indicate suggested http headers.
-	// See pixur.api.HttpHeader
-	HttpHeaderKey        string   `protobuf:"bytes,4,opt,name=http_header_key,json=httpHeaderKey,proto3" json:"http_header_key,omitempty"`
+type FindPicsByColorRequest struct {
+	// the target color, as 0xRRGGBB.
+	Color uint32 `protobuf:"varint,1,opt,name=color,proto3" json:"color,omitempty"`
+	// how far each of the red, green, and blue values may be from the target
+	// color.  Must be at most 255.
+	Tolerance            uint32   `protobuf:"varint,2,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServiceOpts) Reset()         { *m = ServiceOpts{} }
-func (m *ServiceOpts) String() string { return proto.CompactTextString(m) }
-func (*ServiceOpts) ProtoMessage()    {}
-func (*ServiceOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+func (m *FindPicsByColorRequest) Reset()         { *m = FindPicsByColorRequest{} }
+func (m *FindPicsByColorRequest) String() string { return proto.CompactTextString(m) }
+func (*FindPicsByColorRequest) ProtoMessage()    {}
+func (*FindPicsByColorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
 }
 
-func (m *ServiceOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ServiceOpts.Unmarshal(m, b)
+func (m *FindPicsByColorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicsByColorRequest.Unmarshal(m, b)
 }
-func (m *ServiceOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ServiceOpts.Marshal(b, m, deterministic)
+func (m *FindPicsByColorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicsByColorRequest.Marshal(b, m, deterministic)
 }
-func (m *ServiceOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServiceOpts.Merge(m, src)
+func (m *FindPicsByColorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicsByColorRequest.Merge(m, src)
 }
-func (m *ServiceOpts) XXX_Size() int {
-	return xxx_messageInfo_ServiceOpts.Size(m)
+func (m *FindPicsByColorRequest) XXX_Size() int {
+	return xxx_messageInfo_FindPicsByColorRequest.Size(m)
 }
-func (m *ServiceOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServiceOpts.DiscardUnknown(m)
+func (m *FindPicsByColorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicsByColorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ServiceOpts proto.InternalMessageInfo
+var xxx_messageInfo_FindPicsByColorRequest proto.InternalMessageInfo
 
-func (m *ServiceOpts) GetApiVersion() int64 {
+func (m *FindPicsByColorRequest) GetColor() uint32 {
 	if m != nil {
-		return m.ApiVersion
+		return m.Color
 	}
 	return 0
 }
 
-func (m *ServiceOpts) GetAuthTokenHeaderKey() string {
+func (m *FindPicsByColorRequest) GetTolerance() uint32 {
 	if m != nil {
-		return m.AuthTokenHeaderKey
+		return m.Tolerance
 	}
-	return ""
+	return 0
 }
 
-func (m *ServiceOpts) GetPixTokenHeaderKey() string {
+type FindPicsByColorResponse struct {
+	// the matching pics, closest first.
+	Pic                  []*PicAndThumbnail `protobuf:"bytes,1,rep,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *FindPicsByColorResponse) Reset()         { *m = FindPicsByColorResponse{} }
+func (m *FindPicsByColorResponse) String() string { return proto.CompactTextString(m) }
+func (*FindPicsByColorResponse) ProtoMessage()    {}
+func (*FindPicsByColorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+
+func (m *FindPicsByColorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicsByColorResponse.Unmarshal(m, b)
+}
+func (m *FindPicsByColorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicsByColorResponse.Marshal(b, m, deterministic)
+}
+func (m *FindPicsByColorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicsByColorResponse.Merge(m, src)
+}
+func (m *FindPicsByColorResponse) XXX_Size() int {
+	return xxx_messageInfo_FindPicsByColorResponse.Size(m)
+}
+func (m *FindPicsByColorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicsByColorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindPicsByColorResponse proto.InternalMessageInfo
+
+func (m *FindPicsByColorResponse) GetPic() []*PicAndThumbnail {
 	if m != nil {
-		return m.PixTokenHeaderKey
+		return m.Pic
 	}
-	return ""
+	return nil
 }
 
-func (m *ServiceOpts) GetHttpHeaderKey() string {
+// FindPoolsRequest finds pools, most recently created first.
+type FindPoolsRequest struct {
+	// Optional.  If present, the pool to start scanning at, as returned in next_pool_id.
+	StartPoolId          string   `protobuf:"bytes,1,opt,name=start_pool_id,json=startPoolId,proto3" json:"start_pool_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindPoolsRequest) Reset()         { *m = FindPoolsRequest{} }
+func (m *FindPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindPoolsRequest) ProtoMessage()    {}
+func (*FindPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+
+func (m *FindPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPoolsRequest.Unmarshal(m, b)
+}
+func (m *FindPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPoolsRequest.Marshal(b, m, deterministic)
+}
+func (m *FindPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPoolsRequest.Merge(m, src)
+}
+func (m *FindPoolsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindPoolsRequest.Size(m)
+}
+func (m *FindPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindPoolsRequest proto.InternalMessageInfo
+
+func (m *FindPoolsRequest) GetStartPoolId() string {
 	if m != nil {
-		return m.HttpHeaderKey
+		return m.StartPoolId
 	}
 	return ""
 }
 
-// HttpHeader is a message included as a header on some responses.  It is only a suggestion.
-// See pixur.api.ServiceOpts.http_header_key
-type HttpHeader struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+type FindPoolsResponse struct {
+	Pool []*Pool `protobuf:"bytes,1,rep,name=pool,proto3" json:"pool,omitempty"`
+	// next_pool_id is the start of the next page, if there are more pools.
+	NextPoolId           string   `protobuf:"bytes,2,opt,name=next_pool_id,json=nextPoolId,proto3" json:"next_pool_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HttpHeader) Reset()         { *m = HttpHeader{} }
-func (m *HttpHeader) String() string { return proto.CompactTextString(m) }
-func (*HttpHeader) ProtoMessage()    {}
-func (*HttpHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+func (m *FindPoolsResponse) Reset()         { *m = FindPoolsResponse{} }
+func (m *FindPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindPoolsResponse) ProtoMessage()    {}
+func (*FindPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
 }
 
-func (m *HttpHeader) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HttpHeader.Unmarshal(m, b)
+func (m *FindPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPoolsResponse.Unmarshal(m, b)
 }
-func (m *HttpHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HttpHeader.Marshal(b, m, deterministic)
+func (m *FindPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPoolsResponse.Marshal(b, m, deterministic)
 }
-func (m *HttpHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HttpHeader.Merge(m, src)
+func (m *FindPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPoolsResponse.Merge(m, src)
 }
-func (m *HttpHeader) XXX_Size() int {
-	return xxx_messageInfo_HttpHeader.Size(m)
+func (m *FindPoolsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindPoolsResponse.Size(m)
 }
-func (m *HttpHeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_HttpHeader.DiscardUnknown(m)
+func (m *FindPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPoolsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HttpHeader proto.InternalMessageInfo
+var xxx_messageInfo_FindPoolsResponse proto.InternalMessageInfo
 
-func (m *HttpHeader) GetKey() string {
+func (m *FindPoolsResponse) GetPool() []*Pool {
 	if m != nil {
-		return m.Key
+		return m.Pool
 	}
-	return ""
+	return nil
 }
 
-func (m *HttpHeader) GetValue() string {
+func (m *FindPoolsResponse) GetNextPoolId() string {
 	if m != nil {
-		return m.Value
+		return m.NextPoolId
 	}
 	return ""
 }
 
-var E_PixurServiceOpts = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.ServiceOptions)(nil),
-	ExtensionType: (*ServiceOpts)(nil),
-	Field:         65537,
-	Name:          "pixur.api.pixur_service_opts",
-	Tag:           "bytes,65537,opt,name=pixur_service_opts",
-	Filename:      "api.proto",
+type FindSchedPicsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterType((*AddPicCommentRequest)(nil), "pixur.api.AddPicCommentRequest")
-	proto.RegisterType((*AddPicCommentResponse)(nil), "pixur.api.AddPicCommentResponse")
-	proto.RegisterType((*AddPicTagsRequest)(nil), "pixur.api.AddPicTagsRequest")
-	proto.RegisterType((*AddPicTagsResponse)(nil), "pixur.api.AddPicTagsResponse")
-	proto.RegisterType((*CreateUserRequest)(nil), "pixur.api.CreateUserRequest")
-	proto.RegisterType((*CreateUserResponse)(nil), "pixur.api.CreateUserResponse")
-	proto.RegisterType((*DeleteTokenRequest)(nil), "pixur.api.DeleteTokenRequest")
-	proto.RegisterType((*DeleteTokenResponse)(nil), "pixur.api.DeleteTokenResponse")
-	proto.RegisterType((*FindIndexPicsRequest)(nil), "pixur.api.FindIndexPicsRequest")
-	proto.RegisterType((*FindIndexPicsResponse)(nil), "pixur.api.FindIndexPicsResponse")
-	proto.RegisterType((*FindPicCommentVotesRequest)(nil), "pixur.api.FindPicCommentVotesRequest")
-	proto.RegisterType((*FindPicCommentVotesResponse)(nil), "pixur.api.FindPicCommentVotesResponse")
-	proto.RegisterType((*FindSchedPicsRequest)(nil), "pixur.api.FindSchedPicsRequest")
-	proto.RegisterType((*FindSchedPicsResponse)(nil), "pixur.api.FindSchedPicsResponse")
-	proto.RegisterType((*FindSimilarPicsRequest)(nil), "pixur.api.FindSimilarPicsRequest")
-	proto.RegisterType((*FindSimilarPicsResponse)(nil), "pixur.api.FindSimilarPicsResponse")
-	proto.RegisterType((*FindUserEventsRequest)(nil), "pixur.api.FindUserEventsRequest")
-	proto.RegisterType((*FindUserEventsResponse)(nil), "pixur.api.FindUserEventsResponse")
-	proto.RegisterType((*GetRefreshTokenRequest)(nil), "pixur.api.GetRefreshTokenRequest")
-	proto.RegisterType((*GetRefreshTokenResponse)(nil), "pixur.api.GetRefreshTokenResponse")
-	proto.RegisterType((*IncrementViewCountRequest)(nil), "pixur.api.IncrementViewCountRequest")
-	proto.RegisterType((*IncrementViewCountResponse)(nil), "pixur.api.IncrementViewCountResponse")
-	proto.RegisterType((*LookupPicCommentVoteRequest)(nil), "pixur.api.LookupPicCommentVoteRequest")
-	proto.RegisterType((*LookupPicCommentVoteResponse)(nil), "pixur.api.LookupPicCommentVoteResponse")
-	proto.RegisterType((*LookupPicDetailsRequest)(nil), "pixur.api.LookupPicDetailsRequest")
-	proto.RegisterType((*LookupPicDetailsResponse)(nil), "pixur.api.LookupPicDetailsResponse")
-	proto.RegisterType((*LookupPicExtensionRequest)(nil), "pixur.api.LookupPicExtensionRequest")
-	proto.RegisterType((*LookupPicExtensionResponse)(nil), "pixur.api.LookupPicExtensionResponse")
-	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.api.LookupPicExtensionResponse.ExtEntry")
-	proto.RegisterType((*LookupPicFileRequest)(nil), "pixur.api.LookupPicFileRequest")
-	proto.RegisterType((*LookupPicFileResponse)(nil), "pixur.api.LookupPicFileResponse")
-	proto.RegisterType((*LookupPicVoteRequest)(nil), "pixur.api.LookupPicVoteRequest")
-	proto.RegisterType((*LookupPicVoteResponse)(nil), "pixur.api.LookupPicVoteResponse")
-	proto.RegisterType((*LookupPublicUserInfoRequest)(nil), "pixur.api.LookupPublicUserInfoRequest")
-	proto.RegisterType((*LookupPublicUserInfoResponse)(nil), "pixur.api.LookupPublicUserInfoResponse")
-	proto.RegisterType((*LookupUserRequest)(nil), "pixur.api.LookupUserRequest")
-	proto.RegisterType((*LookupUserResponse)(nil), "pixur.api.LookupUserResponse")
-	proto.RegisterType((*PurgePicRequest)(nil), "pixur.api.PurgePicRequest")
-	proto.RegisterType((*PurgePicResponse)(nil), "pixur.api.PurgePicResponse")
-	proto.RegisterType((*ReadPicFileRequest)(nil), "pixur.api.ReadPicFileRequest")
-	proto.RegisterType((*ReadPicFileResponse)(nil), "pixur.api.ReadPicFileResponse")
-	proto.RegisterType((*SoftDeletePicRequest)(nil), "pixur.api.SoftDeletePicRequest")
-	proto.RegisterType((*SoftDeletePicResponse)(nil), "pixur.api.SoftDeletePicResponse")
-	proto.RegisterType((*UpdateUserRequest)(nil), "pixur.api.UpdateUserRequest")
-	proto.RegisterType((*UpdateUserRequest_ChangeIdent)(nil), "pixur.api.UpdateUserRequest.ChangeIdent")
-	proto.RegisterType((*UpdateUserRequest_ChangeSecret)(nil), "pixur.api.UpdateUserRequest.ChangeSecret")
-	proto.RegisterType((*UpdateUserRequest_ChangeCapability)(nil), "pixur.api.UpdateUserRequest.ChangeCapability")
-	proto.RegisterType((*UpdateUserResponse)(nil), "pixur.api.UpdateUserResponse")
-	proto.RegisterType((*UpsertPicCommentVoteRequest)(nil), "pixur.api.UpsertPicCommentVoteRequest")
-	proto.RegisterType((*UpsertPicCommentVoteResponse)(nil), "pixur.api.UpsertPicCommentVoteResponse")
-	proto.RegisterType((*UpsertPicRequest)(nil), "pixur.api.UpsertPicRequest")
-	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.api.UpsertPicRequest.ExtEntry")
-	proto.RegisterType((*UpsertPicResponse)(nil), "pixur.api.UpsertPicResponse")
-	proto.RegisterType((*UpsertPicVoteRequest)(nil), "pixur.api.UpsertPicVoteRequest")
-	proto.RegisterType((*UpsertPicVoteResponse)(nil), "pixur.api.UpsertPicVoteResponse")
-	proto.RegisterType((*WatchBackendConfigurationRequest)(nil), "pixur.api.WatchBackendConfigurationRequest")
-	proto.RegisterType((*WatchBackendConfigurationResponse)(nil), "pixur.api.WatchBackendConfigurationResponse")
-	proto.RegisterType((*ServiceOpts)(nil), "pixur.api.ServiceOpts")
-	proto.RegisterType((*HttpHeader)(nil), "pixur.api.HttpHeader")
-	proto.RegisterExtension(E_PixurServiceOpts)
+func (m *FindSchedPicsRequest) Reset()         { *m = FindSchedPicsRequest{} }
+func (m *FindSchedPicsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindSchedPicsRequest) ProtoMessage()    {}
+func (*FindSchedPicsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
 }
 
-func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
-
-var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 2336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x19, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x15, 0x2b, 0xd2, 0x12, 0xf9, 0x28, 0x99, 0xd4, 0x88, 0xb4, 0xe8, 0x95, 0xac, 0xc8, 0xeb, 0xc6,
-	0x55, 0x6d, 0x8b, 0x72, 0x94, 0xda, 0x48, 0x93, 0xa2, 0x8e, 0x22, 0x5b, 0x35, 0x53, 0xa7, 0x15,
-	0xd6, 0xb2, 0x53, 0x04, 0x28, 0xd8, 0x11, 0x77, 0x48, 0x0e, 0x4c, 0xee, 0x6e, 0x77, 0x97, 0x8a,
-	0x74, 0x08, 0x90, 0x06, 0x28, 0x8a, 0xf6, 0x54, 0xa0, 0xe8, 0xa5, 0xb7, 0x9e, 0x7a, 0xe9, 0x2f,
-	0x68, 0xff, 0x44, 0x8f, 0x05, 0xfa, 0x33, 0x7a, 0xe8, 0xb5, 0x98, 0x8f, 0xdd, 0x9d, 0xd9, 0x5d,
-	0x8a, 0x0a, 0x50, 0x9f, 0xb8, 0x33, 0xef, 0x73, 0xde, 0x7b, 0xf3, 0xe6, 0xbd, 0x47, 0xa8, 0x62,
-	0x9f, 0x76, 0xfc, 0xc0, 0x8b, 0x3c, 0x54, 0xf5, 0xe9, 0xf9, 0x34, 0xe8, 0x60, 0x9f, 0x9a, 0x37,
-	0x87, 0x9e, 0x37, 0x1c, 0x93, 0x3d, 0x0e, 0x38, 0x9d, 0x0e, 0xf6, 0xb0, 0x7b, 0x21, 0xb0, 0xcc,
-	0xed, 0x2c, 0xc8, 0x21, 0x61, 0x3f, 0xa0, 0x7e, 0xe4, 0x05, 0x12, 0xe3, 0x9d, 0x2c, 0x46, 0x44,
-	0x27, 0x24, 0x8c, 0xf0, 0xc4, 0x97, 0x08, 0x5b, 0x42, 0x90, 0x17, 0x0c, 0xf7, 0xf8, 0xd7, 0x1e,
-	0xf6, 0xe9, 0x9e, 0x83, 0x23, 0x2c, 0xe0, 0xd6, 0x04, 0x9a, 0x07, 0x8e, 0x73, 0x4c, 0xfb, 0x87,
-	0xde, 0x64, 0x42, 0xdc, 0xc8, 0x26, 0xbf, 0x9a, 0x92, 0x30, 0x42, 0x2d, 0x58, 0xf4, 0x69, 0xbf,
-	0x47, 0x9d, 0xb6, 0xb1, 0x6d, 0xec, 0x54, 0xed, 0x6b, 0x3e, 0xed, 0x77, 0x1d, 0x74, 0x0f, 0x56,
-	0xfb, 0x02, 0xb1, 0xe7, 0xe3, 0x80, 0xfd, 0x50, 0xa7, 0xbd, 0xc0, 0x31, 0xea, 0x12, 0x70, 0xcc,
-	0xf7, 0xbb, 0x0e, 0x42, 0x50, 0x8e, 0xc8, 0x79, 0xd4, 0x2e, 0x71, 0x30, 0xff, 0xb6, 0x9e, 0x43,
-	0x2b, 0x23, 0x2e, 0xf4, 0x3d, 0x37, 0x24, 0x68, 0x0f, 0x96, 0x24, 0x3d, 0x17, 0x58, 0xdb, 0x6f,
-	0x75, 0x12, 0x13, 0x75, 0x14, 0xfc, 0x18, 0xcb, 0xfa, 0x21, 0xac, 0x0a, 0x4e, 0x27, 0x78, 0x18,
-	0xce, 0xd1, 0xba, 0x01, 0xa5, 0x08, 0x0f, 0xdb, 0x0b, 0xdb, 0xa5, 0x9d, 0xaa, 0xcd, 0x3e, 0xad,
-	0x26, 0x20, 0x95, 0x5a, 0x28, 0x61, 0x1d, 0xc0, 0xea, 0x61, 0x40, 0x70, 0x44, 0x5e, 0x85, 0x24,
-	0x88, 0x79, 0x36, 0xe1, 0x1a, 0x75, 0x62, 0xbd, 0xaa, 0xb6, 0x58, 0xa0, 0x1b, 0xb0, 0x18, 0x92,
-	0x7e, 0x40, 0x22, 0x79, 0x7a, 0xb9, 0x62, 0x8c, 0x55, 0x16, 0x92, 0x71, 0x13, 0xd0, 0x53, 0x32,
-	0x26, 0x11, 0x39, 0xf1, 0xde, 0x10, 0x57, 0x72, 0xb6, 0x5a, 0xb0, 0xa6, 0xed, 0x4a, 0xe4, 0xd7,
-	0xd0, 0x3c, 0xa2, 0xae, 0xd3, 0x75, 0x1d, 0x72, 0x7e, 0x4c, 0xfb, 0xc9, 0xe1, 0xb6, 0x61, 0x39,
-	0x8c, 0x70, 0x10, 0xf5, 0xb4, 0x23, 0x02, 0xdf, 0x3b, 0xe6, 0xe7, 0xdc, 0x84, 0x2a, 0x0e, 0xfb,
-	0xc4, 0x75, 0xa8, 0x3b, 0xe4, 0x7a, 0x55, 0xec, 0x74, 0xc3, 0xfa, 0x8d, 0x01, 0xad, 0x0c, 0x63,
-	0x69, 0xfc, 0x07, 0x50, 0xf2, 0x69, 0xbf, 0x5d, 0xde, 0x2e, 0xed, 0xd4, 0xf6, 0x4d, 0xdd, 0xf0,
-	0x07, 0xae, 0x73, 0x32, 0x9a, 0x4e, 0x4e, 0x5d, 0x4c, 0xc7, 0x36, 0x43, 0x43, 0x5b, 0x50, 0x73,
-	0xc9, 0x79, 0xa2, 0x86, 0x38, 0x7f, 0x95, 0x6d, 0x09, 0x2d, 0xb6, 0xa0, 0xe6, 0x07, 0xe4, 0x2c,
-	0x86, 0x0b, 0xf7, 0x57, 0xd9, 0x16, 0x87, 0x5b, 0x6f, 0xc0, 0x64, 0x6a, 0xa4, 0x4e, 0x7d, 0xed,
-	0x45, 0x64, 0x9e, 0x0b, 0x6f, 0x01, 0xc4, 0x81, 0x97, 0xca, 0x94, 0x3b, 0x5d, 0x07, 0xad, 0xc3,
-	0xd2, 0x34, 0x24, 0x41, 0x2a, 0x6f, 0x91, 0x2d, 0xbb, 0x8e, 0xf5, 0x02, 0x36, 0x0a, 0x85, 0xc9,
-	0x93, 0xef, 0x42, 0xf9, 0xcc, 0x8b, 0x48, 0xdb, 0xe0, 0x47, 0xbf, 0x59, 0x18, 0x73, 0x8c, 0xc2,
-	0xe6, 0x68, 0xd6, 0x0d, 0xe1, 0x9a, 0x97, 0xfd, 0x11, 0x71, 0x14, 0xd7, 0x58, 0xcf, 0x84, 0x65,
-	0x95, 0x7d, 0xdd, 0xb2, 0x0b, 0x57, 0xb2, 0xac, 0xb5, 0x07, 0x37, 0x38, 0x1b, 0x3a, 0xa1, 0x63,
-	0x1c, 0xa8, 0xbe, 0x2f, 0xb6, 0x8a, 0xf5, 0x10, 0xd6, 0x73, 0x04, 0x52, 0xb2, 0x4a, 0x51, 0x4a,
-	0x29, 0xbe, 0x12, 0x9a, 0xb2, 0xe8, 0x7c, 0x76, 0x46, 0xdc, 0x28, 0x91, 0xa0, 0x58, 0xd0, 0x50,
-	0x2d, 0x88, 0x76, 0x61, 0x4d, 0x84, 0x1d, 0x07, 0x93, 0x33, 0xcd, 0x05, 0x0d, 0x0e, 0x4a, 0xb8,
-	0x65, 0x63, 0xb0, 0x94, 0x8d, 0xc1, 0xbf, 0x1a, 0xe2, 0x88, 0xaa, 0x7c, 0xa9, 0xf0, 0xfb, 0x00,
-	0xa9, 0x04, 0xe9, 0x90, 0xa6, 0x62, 0xb1, 0x84, 0xc4, 0xae, 0x4e, 0xe3, 0x4f, 0x74, 0x1f, 0x10,
-	0x8f, 0xc5, 0x22, 0xdd, 0xea, 0x0c, 0xa2, 0xaa, 0x76, 0x1f, 0x10, 0x0f, 0x4c, 0x1d, 0x59, 0xc4,
-	0x4b, 0x9d, 0x41, 0x14, 0x64, 0xeb, 0x0c, 0x6e, 0xfc, 0x98, 0x44, 0x36, 0x19, 0x04, 0x24, 0x1c,
-	0xa9, 0xd7, 0xf6, 0xdb, 0x25, 0x04, 0xd4, 0x81, 0x35, 0xc6, 0x9a, 0x7a, 0xd3, 0xb0, 0x87, 0xa7,
-	0xd1, 0xa8, 0x17, 0x31, 0x5e, 0x52, 0xea, 0x6a, 0x0c, 0x3a, 0x98, 0x46, 0x42, 0x88, 0xf5, 0x1f,
-	0x03, 0xd6, 0x73, 0x82, 0xa5, 0x89, 0x6e, 0x01, 0x28, 0x2c, 0xe4, 0x25, 0xc0, 0x31, 0x29, 0xda,
-	0x00, 0xf6, 0xac, 0x48, 0xe8, 0x35, 0x0e, 0xad, 0xf8, 0xf4, 0x5c, 0x00, 0x3f, 0x80, 0x65, 0x4e,
-	0xeb, 0xe3, 0x8b, 0xb1, 0x87, 0x9d, 0x76, 0x39, 0x9f, 0x65, 0xbf, 0x8c, 0x8e, 0x05, 0xd0, 0xae,
-	0x31, 0x54, 0xb9, 0x40, 0x8f, 0xa1, 0xc6, 0xd8, 0xc6, 0x84, 0x8b, 0x97, 0x11, 0x82, 0x4f, 0xcf,
-	0xe5, 0xf7, 0xa7, 0xe5, 0x8a, 0xd1, 0x58, 0xf8, 0xb4, 0x5c, 0x29, 0x35, 0xca, 0xf6, 0x4a, 0x20,
-	0xce, 0x23, 0x94, 0xb3, 0xeb, 0xf1, 0x52, 0x32, 0xb5, 0xf6, 0xe1, 0x66, 0xd7, 0xed, 0x07, 0x84,
-	0xdf, 0x37, 0x4a, 0xbe, 0x3c, 0xf4, 0xa6, 0xf3, 0xde, 0x22, 0x6b, 0x13, 0xcc, 0x22, 0x1a, 0x99,
-	0x45, 0xc7, 0xb0, 0xf1, 0xc2, 0xf3, 0xde, 0x4c, 0xfd, 0xcc, 0x45, 0x7e, 0x3b, 0x69, 0xe6, 0x33,
-	0xd8, 0x2c, 0x96, 0x96, 0xcb, 0x33, 0xc6, 0x55, 0xf2, 0xcc, 0x43, 0x58, 0x4f, 0xd8, 0x3d, 0x25,
-	0x11, 0xa6, 0xe3, 0x79, 0x99, 0xe0, 0xdf, 0x06, 0xb4, 0xf3, 0x24, 0x52, 0xfa, 0xb6, 0xc8, 0x42,
-	0x42, 0xf8, 0x75, 0x5d, 0xb8, 0xc8, 0xe9, 0x0f, 0x60, 0xc9, 0x21, 0x01, 0x3d, 0x23, 0x8e, 0x7c,
-	0x05, 0x90, 0x8e, 0x75, 0x44, 0xc7, 0xc4, 0x8e, 0x51, 0xd0, 0x3d, 0x58, 0x62, 0x3a, 0xc4, 0x6f,
-	0x6a, 0x6d, 0x7f, 0x55, 0xc7, 0x3e, 0xc1, 0x43, 0x9b, 0x69, 0x79, 0x82, 0x87, 0xe8, 0x10, 0x1a,
-	0x0c, 0x37, 0xb6, 0x6a, 0x14, 0x10, 0xc2, 0x6d, 0x37, 0xcb, 0x0a, 0x27, 0x01, 0x21, 0xf6, 0x75,
-	0x5f, 0x5b, 0xb3, 0xf0, 0x48, 0x0e, 0xf7, 0xec, 0x3c, 0x22, 0x6e, 0x48, 0x3d, 0x77, 0x8e, 0x45,
-	0xfe, 0x66, 0x80, 0x59, 0x44, 0x24, 0x6d, 0xf2, 0x31, 0x94, 0x58, 0x71, 0x22, 0xf2, 0x4c, 0x47,
-	0x51, 0x65, 0x36, 0x4d, 0xe7, 0xd9, 0x79, 0xf4, 0xcc, 0x8d, 0x82, 0x0b, 0x9b, 0x91, 0x9a, 0x2f,
-	0xa0, 0x12, 0x6f, 0xb0, 0x0a, 0xe3, 0x0d, 0xb9, 0x90, 0x0a, 0xb0, 0x4f, 0x74, 0x0f, 0xae, 0x9d,
-	0xe1, 0xf1, 0x94, 0xf0, 0x20, 0x62, 0x99, 0x4c, 0x54, 0x6a, 0x9d, 0xb8, 0x52, 0xeb, 0x1c, 0xb8,
-	0x17, 0xb6, 0x40, 0xf9, 0x70, 0xe1, 0x03, 0xc3, 0xa2, 0xd0, 0x4c, 0x24, 0x73, 0x6b, 0xcb, 0xd3,
-	0xb1, 0xd7, 0x94, 0xf6, 0x7b, 0x03, 0x3a, 0x26, 0xe9, 0x11, 0xab, 0xbe, 0x40, 0xea, 0x3a, 0xe8,
-	0x3d, 0x58, 0x1c, 0x78, 0xc1, 0x04, 0x8b, 0xbc, 0x73, 0x3d, 0x6b, 0x55, 0x86, 0xd5, 0x39, 0xe2,
-	0x08, 0xb6, 0x44, 0xb4, 0x8e, 0xa0, 0x95, 0x11, 0x95, 0x44, 0x69, 0x25, 0x96, 0x25, 0x83, 0xa5,
-	0x30, 0x0c, 0xa4, 0x70, 0xeb, 0x48, 0x51, 0xf9, 0x0a, 0x77, 0x4b, 0xb9, 0x3c, 0x0b, 0xda, 0xe5,
-	0x79, 0xa2, 0xe8, 0xa3, 0xdd, 0x9a, 0xbb, 0xda, 0xad, 0xc9, 0xe8, 0xa2, 0x5c, 0x97, 0xc7, 0xc9,
-	0x5d, 0x9f, 0x9e, 0x8e, 0x69, 0x9f, 0xa5, 0xf1, 0xae, 0x3b, 0xf0, 0xe6, 0x3d, 0x6d, 0xd6, 0xeb,
-	0xe4, 0xd6, 0x66, 0xe8, 0xa4, 0xfc, 0xc7, 0x50, 0x15, 0x84, 0xee, 0xc0, 0x2b, 0xba, 0xba, 0x3a,
-	0x55, 0x65, 0x2a, 0xbf, 0xac, 0x07, 0xb0, 0x2a, 0xf8, 0xaa, 0x75, 0xe4, 0x4c, 0x2d, 0x7e, 0x00,
-	0x48, 0xc5, 0x96, 0xb2, 0xef, 0x40, 0x99, 0xc1, 0xa5, 0xd8, 0x7a, 0xe6, 0x21, 0xb4, 0x39, 0xd0,
-	0xda, 0x81, 0xfa, 0xf1, 0x34, 0x18, 0x12, 0x76, 0x8f, 0x2f, 0xbf, 0x0d, 0x08, 0x1a, 0x29, 0xa6,
-	0x4c, 0x91, 0x7f, 0x32, 0x00, 0xd9, 0x04, 0x3b, 0x6f, 0x3d, 0xe2, 0xd8, 0xe3, 0xe8, 0x0d, 0x06,
-	0x21, 0x11, 0xcd, 0x40, 0xc9, 0x96, 0x2b, 0xf6, 0x94, 0x8e, 0xe9, 0x84, 0x46, 0xfc, 0x35, 0x2a,
-	0xd9, 0x62, 0x61, 0x7d, 0x04, 0x6b, 0x9a, 0x5a, 0xd2, 0x22, 0x08, 0xca, 0xac, 0x71, 0xe1, 0x0a,
-	0x2d, 0xdb, 0xfc, 0x9b, 0xdd, 0x3b, 0xe2, 0x0d, 0x64, 0xad, 0xcb, 0x3e, 0xad, 0x7f, 0x18, 0xd0,
-	0x7c, 0xe9, 0x0d, 0x22, 0x51, 0x59, 0xcf, 0x35, 0x0c, 0x6a, 0xb3, 0xcc, 0xc7, 0xd3, 0xa5, 0x8c,
-	0xca, 0x78, 0xc9, 0xce, 0x19, 0x10, 0x1c, 0x7a, 0xe2, 0xb1, 0xd6, 0xcf, 0xc9, 0xb9, 0xf3, 0xd4,
-	0xc0, 0x10, 0x6c, 0x89, 0x88, 0x9e, 0xc0, 0x8a, 0x23, 0x21, 0x3d, 0xd6, 0x89, 0xc9, 0x57, 0xd6,
-	0xcc, 0x5d, 0xfe, 0x93, 0xb8, 0x4d, 0xb3, 0x97, 0x63, 0x02, 0xb6, 0x65, 0xad, 0x43, 0x2b, 0xa3,
-	0xbc, 0xf4, 0xd5, 0x37, 0x65, 0x58, 0x7d, 0xe5, 0x3b, 0x99, 0xde, 0x64, 0x66, 0xd1, 0xd6, 0x86,
-	0xa5, 0x33, 0x12, 0xb0, 0xe4, 0xc5, 0x4f, 0xd5, 0xb0, 0xe3, 0x25, 0xfa, 0x51, 0x5c, 0xbd, 0x88,
-	0x24, 0xbc, 0xa3, 0x06, 0x56, 0x96, 0x7f, 0xe7, 0x70, 0x84, 0xdd, 0x21, 0xe9, 0x32, 0xfc, 0xb8,
-	0xce, 0x39, 0x48, 0xea, 0x1c, 0x71, 0xb6, 0xef, 0x5d, 0x81, 0xc1, 0x4b, 0x4e, 0x90, 0x94, 0x44,
-	0x9f, 0x01, 0xf4, 0xb1, 0x8f, 0x4f, 0xe9, 0x98, 0x46, 0x17, 0xbc, 0x50, 0xa9, 0xed, 0xef, 0x5e,
-	0x81, 0xcd, 0x61, 0x42, 0x64, 0x2b, 0x0c, 0xcc, 0x3b, 0x50, 0x53, 0xf4, 0x2c, 0x2e, 0xcf, 0xcc,
-	0xbb, 0xb0, 0xac, 0xea, 0xa2, 0x94, 0x6b, 0x86, 0x5a, 0xae, 0x99, 0x7f, 0x36, 0xa0, 0x91, 0x95,
-	0x86, 0x3e, 0x86, 0xeb, 0x21, 0x89, 0x7a, 0x8a, 0xd2, 0xec, 0xd9, 0xd0, 0x23, 0x22, 0x45, 0x67,
-	0x9f, 0xf6, 0x4a, 0x48, 0x22, 0x85, 0xc3, 0x53, 0x68, 0xf4, 0xc7, 0x04, 0x07, 0x2a, 0x8f, 0x85,
-	0x79, 0x3c, 0xea, 0x9c, 0x24, 0xdd, 0x64, 0x99, 0x42, 0xb5, 0xcd, 0xb7, 0xc9, 0x14, 0x7f, 0x31,
-	0x60, 0xe3, 0x95, 0x1f, 0x12, 0xde, 0x2a, 0xfe, 0xdf, 0xea, 0x21, 0x25, 0xcc, 0x4a, 0x7a, 0x98,
-	0xed, 0xcb, 0xd4, 0x5d, 0xe6, 0x57, 0x67, 0x6b, 0x66, 0xc1, 0xd3, 0x51, 0xd2, 0xf8, 0x16, 0x6c,
-	0x16, 0xab, 0x28, 0xef, 0xc0, 0xef, 0x16, 0xa0, 0x91, 0x20, 0xc4, 0x8a, 0x37, 0xa0, 0x34, 0x0d,
-	0xc6, 0xf1, 0xcb, 0x3b, 0x0d, 0xc6, 0xc8, 0x84, 0x4a, 0x40, 0x06, 0x24, 0x08, 0x48, 0x10, 0x57,
-	0xc1, 0xf1, 0x9a, 0xe5, 0x10, 0x17, 0x4f, 0x88, 0x3c, 0x09, 0xff, 0x4e, 0xf2, 0x4a, 0x49, 0xc9,
-	0x2b, 0x37, 0xa1, 0x32, 0x71, 0x1e, 0xf5, 0x46, 0x38, 0x1c, 0xf1, 0x23, 0x2c, 0xdb, 0x4b, 0x13,
-	0xe7, 0xd1, 0x73, 0x1c, 0x8e, 0xd0, 0x63, 0x51, 0x38, 0x2c, 0xf2, 0xc2, 0xe1, 0x3b, 0x5a, 0xd8,
-	0xea, 0xaa, 0xbd, 0xd5, 0x72, 0xe1, 0x11, 0x4b, 0x07, 0x89, 0xbc, 0xab, 0xd6, 0x79, 0x56, 0x04,
-	0xcd, 0x84, 0xec, 0x0a, 0xee, 0x9f, 0xed, 0xdf, 0xfb, 0xd2, 0xbf, 0xe2, 0x09, 0x58, 0xcf, 0x3f,
-	0xcd, 0xaa, 0x63, 0xd7, 0xa1, 0x95, 0x91, 0x2a, 0x3d, 0x6a, 0xc1, 0xf6, 0xe7, 0x38, 0xea, 0x8f,
-	0x3e, 0xc1, 0xfd, 0x37, 0xc4, 0x75, 0x0e, 0x3d, 0x77, 0x40, 0x87, 0xd3, 0x00, 0x47, 0x69, 0x79,
-	0x67, 0xfd, 0xd1, 0x80, 0xdb, 0x97, 0x20, 0xc9, 0xa3, 0x2b, 0x9a, 0x1a, 0xba, 0xa6, 0x27, 0xd0,
-	0x3a, 0x15, 0x94, 0xbd, 0xbe, 0x4a, 0x2a, 0x2d, 0xfd, 0x8e, 0xa2, 0x7a, 0xa1, 0x84, 0xe6, 0x69,
-	0xc1, 0xae, 0xf5, 0x77, 0x03, 0x6a, 0x2f, 0x49, 0x70, 0x46, 0xfb, 0xe4, 0x67, 0x7e, 0x14, 0xa2,
-	0x77, 0xa0, 0x86, 0x7d, 0xda, 0x53, 0x75, 0x28, 0xd9, 0x80, 0x7d, 0xfa, 0x5a, 0xaa, 0xf1, 0x1e,
-	0xb4, 0xd2, 0xde, 0xad, 0x37, 0x22, 0xd8, 0x21, 0x41, 0x8f, 0x05, 0x81, 0x08, 0x45, 0x94, 0xb4,
-	0x71, 0xcf, 0x39, 0xe8, 0x27, 0xe4, 0x02, 0xed, 0x41, 0x33, 0xe9, 0xe7, 0x54, 0x8a, 0xb8, 0x77,
-	0x94, 0xad, 0x5d, 0x4a, 0x70, 0x17, 0xea, 0xa3, 0x28, 0xf2, 0x55, 0xdc, 0x32, 0xc7, 0x5d, 0x61,
-	0xdb, 0x09, 0x9e, 0xf5, 0x7d, 0x80, 0xe7, 0xc9, 0x46, 0x41, 0x30, 0x36, 0xd5, 0x60, 0xac, 0xca,
-	0xb0, 0xdb, 0xff, 0xed, 0x1a, 0x2c, 0x1f, 0x33, 0x5b, 0xc9, 0x73, 0x23, 0x1b, 0x56, 0xb4, 0x61,
-	0x1e, 0x52, 0x6d, 0x59, 0x34, 0x55, 0x34, 0xb7, 0x67, 0x23, 0x48, 0x3f, 0x76, 0x01, 0xd2, 0xc1,
-	0x1c, 0xda, 0xcc, 0xe1, 0x2b, 0xd3, 0x3e, 0xf3, 0xd6, 0x0c, 0x68, 0xca, 0x2a, 0x1d, 0xc5, 0x69,
-	0xac, 0x72, 0x43, 0x3e, 0x8d, 0x55, 0x7e, 0x7e, 0x87, 0x5e, 0x40, 0x4d, 0x99, 0xd4, 0xa1, 0x5b,
-	0xd9, 0x4a, 0x40, 0x9b, 0xeb, 0x99, 0x5b, 0xb3, 0xc0, 0x92, 0xdb, 0xe7, 0xb0, 0xa2, 0xcd, 0xe1,
-	0x34, 0xbb, 0x15, 0x8d, 0xfe, 0x34, 0xbb, 0x15, 0x8e, 0xf0, 0xac, 0xd2, 0x1f, 0x16, 0x0c, 0x44,
-	0x61, 0xad, 0x60, 0xd8, 0x85, 0xde, 0xcd, 0x50, 0x17, 0x4f, 0xde, 0xcc, 0xbb, 0xf3, 0xd0, 0x54,
-	0x51, 0xf2, 0x0c, 0xc9, 0xc4, 0x2b, 0x77, 0x86, 0xec, 0x8c, 0x2c, 0x77, 0x86, 0xdc, 0xb0, 0x4c,
-	0x30, 0xfe, 0x05, 0xd4, 0x33, 0x23, 0x2d, 0x74, 0x3b, 0x4b, 0x99, 0x9b, 0x8f, 0x99, 0xd6, 0x65,
-	0x28, 0x2a, 0xfb, 0x2f, 0xe0, 0xba, 0x3e, 0x7f, 0x42, 0x59, 0xbd, 0x72, 0xa3, 0x31, 0xf3, 0xf6,
-	0x25, 0x18, 0x2a, 0xef, 0x9f, 0x43, 0x3d, 0x33, 0xb9, 0xd1, 0x54, 0x2f, 0x1e, 0x27, 0x69, 0xaa,
-	0xcf, 0x1a, 0xfc, 0x60, 0x40, 0xf9, 0x51, 0x07, 0x52, 0x1f, 0x9f, 0x99, 0xd3, 0x13, 0xf3, 0xdd,
-	0x39, 0x58, 0x52, 0xc4, 0x58, 0x69, 0xe6, 0x14, 0xb7, 0xa3, 0xbb, 0x45, 0xad, 0x71, 0xbe, 0x80,
-	0x30, 0xbf, 0x3b, 0x17, 0x4f, 0x35, 0xd5, 0x2f, 0xa1, 0x91, 0x9d, 0x56, 0x20, 0xab, 0x88, 0x83,
-	0x3e, 0xfd, 0x30, 0xef, 0x5c, 0x8a, 0xa3, 0x4a, 0x18, 0xc4, 0x5d, 0x95, 0xda, 0xc9, 0x6b, 0x26,
-	0x9b, 0x39, 0x51, 0xd0, 0x4c, 0x36, 0x7b, 0x1c, 0x90, 0x5c, 0x04, 0xad, 0x99, 0xd6, 0x2e, 0x42,
-	0x51, 0x47, 0xaf, 0x5d, 0x84, 0xc2, 0x3e, 0x3c, 0xcf, 0x98, 0x7b, 0xa2, 0x90, 0xb1, 0xea, 0x82,
-	0xed, 0xd9, 0x08, 0x2a, 0xe3, 0xd4, 0xd3, 0x5a, 0xff, 0x5a, 0xe4, 0xe9, 0xa2, 0x76, 0xba, 0xc8,
-	0xd3, 0x85, 0xed, 0xb3, 0x90, 0xf6, 0x53, 0x80, 0xb4, 0xbb, 0xd5, 0xb2, 0x70, 0xae, 0x45, 0xd6,
-	0xb2, 0x70, 0xbe, 0x25, 0x16, 0xfc, 0x0e, 0xa1, 0x12, 0x37, 0xb2, 0x48, 0x1b, 0xa8, 0xeb, 0x7d,
-	0xb0, 0xb9, 0x51, 0x08, 0x93, 0xc1, 0xfe, 0x0a, 0x6a, 0x4a, 0x87, 0xa9, 0xe5, 0xf3, 0x7c, 0x43,
-	0xac, 0xe5, 0xf3, 0x82, 0xc6, 0x94, 0xeb, 0xb5, 0x63, 0x3c, 0x34, 0xd8, 0x83, 0xa8, 0x75, 0x6f,
-	0x9a, 0xcb, 0x8a, 0x9a, 0x52, 0xcd, 0x65, 0x85, 0x8d, 0x1f, 0x7b, 0xc5, 0xd2, 0x9a, 0x5f, 0xb3,
-	0x5f, 0xae, 0x4d, 0xd2, 0xec, 0x57, 0xd0, 0x28, 0x1c, 0x41, 0x35, 0x29, 0xc3, 0xd0, 0xc6, 0x25,
-	0x95, 0xab, 0xb9, 0x59, 0x0c, 0x94, 0x7c, 0x86, 0x4a, 0x11, 0x39, 0x2b, 0x55, 0x5c, 0xd2, 0x6b,
-	0x68, 0x01, 0x74, 0x59, 0xc1, 0xcf, 0xec, 0xa9, 0xd5, 0x8d, 0x9a, 0x3d, 0x8b, 0xea, 0x58, 0xcd,
-	0x9e, 0x85, 0x25, 0x27, 0xfa, 0x0a, 0x6e, 0xce, 0xac, 0x26, 0xd1, 0x7d, 0x85, 0x7c, 0x5e, 0x61,
-	0x6a, 0x3e, 0xb8, 0x1a, 0xb2, 0x12, 0x24, 0x0f, 0x0d, 0xf3, 0xf0, 0xf7, 0x5f, 0x6f, 0x3f, 0xa9,
-	0x7c, 0xf3, 0xdf, 0x7f, 0x56, 0x51, 0x83, 0x93, 0xef, 0xb2, 0xc2, 0x6f, 0x97, 0xd7, 0x78, 0x66,
-	0x5d, 0xec, 0xf8, 0xf4, 0x5c, 0x6c, 0x58, 0x2d, 0xb1, 0xc1, 0xaa, 0xb7, 0x5d, 0x51, 0xd4, 0xed,
-	0x9e, 0x52, 0xf7, 0xc3, 0x21, 0x20, 0x0e, 0xe8, 0x85, 0xa2, 0x12, 0xeb, 0x79, 0xbc, 0x04, 0xcd,
-	0xf5, 0x0c, 0x69, 0x81, 0x4a, 0x3d, 0x37, 0x6c, 0xff, 0xfa, 0x6b, 0xd1, 0xb2, 0xdf, 0x50, 0x83,
-	0x2e, 0xad, 0x61, 0x6d, 0xa1, 0x90, 0xb2, 0xf3, 0xc9, 0x2e, 0xac, 0x78, 0xc1, 0x30, 0x45, 0x3f,
-	0x36, 0xbe, 0x58, 0x2f, 0xf8, 0x43, 0xf9, 0x23, 0xec, 0xd3, 0x7f, 0x19, 0xc6, 0xe9, 0x22, 0x97,
-	0xfc, 0xfe, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x88, 0x05, 0x5e, 0xe9, 0x1e, 0x00, 0x00,
+func (m *FindSchedPicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindSchedPicsRequest.Unmarshal(m, b)
+}
+func (m *FindSchedPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindSchedPicsRequest.Marshal(b, m, deterministic)
+}
+func (m *FindSchedPicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindSchedPicsRequest.Merge(m, src)
+}
+func (m *FindSchedPicsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindSchedPicsRequest.Size(m)
+}
+func (m *FindSchedPicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindSchedPicsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_FindSchedPicsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type FindSchedPicsResponse struct {
+	Pic                  []*PicAndThumbnail `protobuf:"bytes,2,rep,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
 
-// PixurServiceClient is the client API for PixurService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PixurServiceClient interface {
-	AddPicComment(ctx context.Context, in *AddPicCommentRequest, opts ...grpc.CallOption) (*AddPicCommentResponse, error)
-	AddPicTags(ctx context.Context, in *AddPicTagsRequest, opts ...grpc.CallOption) (*AddPicTagsResponse, error)
-	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
-	DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*DeleteTokenResponse, error)
-	FindIndexPics(ctx context.Context, in *FindIndexPicsRequest, opts ...grpc.CallOption) (*FindIndexPicsResponse, error)
-	FindPicCommentVotes(ctx context.Context, in *FindPicCommentVotesRequest, opts ...grpc.CallOption) (*FindPicCommentVotesResponse, error)
+func (m *FindSchedPicsResponse) Reset()         { *m = FindSchedPicsResponse{} }
+func (m *FindSchedPicsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindSchedPicsResponse) ProtoMessage()    {}
+func (*FindSchedPicsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+
+func (m *FindSchedPicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindSchedPicsResponse.Unmarshal(m, b)
+}
+func (m *FindSchedPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindSchedPicsResponse.Marshal(b, m, deterministic)
+}
+func (m *FindSchedPicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindSchedPicsResponse.Merge(m, src)
+}
+func (m *FindSchedPicsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindSchedPicsResponse.Size(m)
+}
+func (m *FindSchedPicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindSchedPicsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindSchedPicsResponse proto.InternalMessageInfo
+
+func (m *FindSchedPicsResponse) GetPic() []*PicAndThumbnail {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+type FindSimilarPicsRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindSimilarPicsRequest) Reset()         { *m = FindSimilarPicsRequest{} }
+func (m *FindSimilarPicsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindSimilarPicsRequest) ProtoMessage()    {}
+func (*FindSimilarPicsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+
+func (m *FindSimilarPicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindSimilarPicsRequest.Unmarshal(m, b)
+}
+func (m *FindSimilarPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindSimilarPicsRequest.Marshal(b, m, deterministic)
+}
+func (m *FindSimilarPicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindSimilarPicsRequest.Merge(m, src)
+}
+func (m *FindSimilarPicsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindSimilarPicsRequest.Size(m)
+}
+func (m *FindSimilarPicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindSimilarPicsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindSimilarPicsRequest proto.InternalMessageInfo
+
+func (m *FindSimilarPicsRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type FindSimilarPicsResponse struct {
+	PicId                []string `protobuf:"bytes,1,rep,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindSimilarPicsResponse) Reset()         { *m = FindSimilarPicsResponse{} }
+func (m *FindSimilarPicsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindSimilarPicsResponse) ProtoMessage()    {}
+func (*FindSimilarPicsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+
+func (m *FindSimilarPicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindSimilarPicsResponse.Unmarshal(m, b)
+}
+func (m *FindSimilarPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindSimilarPicsResponse.Marshal(b, m, deterministic)
+}
+func (m *FindSimilarPicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindSimilarPicsResponse.Merge(m, src)
+}
+func (m *FindSimilarPicsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindSimilarPicsResponse.Size(m)
+}
+func (m *FindSimilarPicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindSimilarPicsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindSimilarPicsResponse proto.InternalMessageInfo
+
+func (m *FindSimilarPicsResponse) GetPicId() []string {
+	if m != nil {
+		return m.PicId
+	}
+	return nil
+}
+
+type FindUserEventsRequest struct {
+	// Optional.  Uses auth token if not specified.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional.  If present, specifies the starting user event id to scan for
+	StartUserEventId     string   `protobuf:"bytes,2,opt,name=start_user_event_id,json=startUserEventId,proto3" json:"start_user_event_id,omitempty"`
+	Ascending            bool     `protobuf:"varint,3,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindUserEventsRequest) Reset()         { *m = FindUserEventsRequest{} }
+func (m *FindUserEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindUserEventsRequest) ProtoMessage()    {}
+func (*FindUserEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+
+func (m *FindUserEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindUserEventsRequest.Unmarshal(m, b)
+}
+func (m *FindUserEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindUserEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *FindUserEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindUserEventsRequest.Merge(m, src)
+}
+func (m *FindUserEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindUserEventsRequest.Size(m)
+}
+func (m *FindUserEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindUserEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindUserEventsRequest proto.InternalMessageInfo
+
+func (m *FindUserEventsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *FindUserEventsRequest) GetStartUserEventId() string {
+	if m != nil {
+		return m.StartUserEventId
+	}
+	return ""
+}
+
+func (m *FindUserEventsRequest) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
+}
+
+type FindUserEventsResponse struct {
+	UserEvent            []*UserEvent `protobuf:"bytes,1,rep,name=user_event,json=userEvent,proto3" json:"user_event,omitempty"`
+	NextUserEventId      string       `protobuf:"bytes,2,opt,name=next_user_event_id,json=nextUserEventId,proto3" json:"next_user_event_id,omitempty"`
+	PrevUserEventId      string       `protobuf:"bytes,3,opt,name=prev_user_event_id,json=prevUserEventId,proto3" json:"prev_user_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FindUserEventsResponse) Reset()         { *m = FindUserEventsResponse{} }
+func (m *FindUserEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindUserEventsResponse) ProtoMessage()    {}
+func (*FindUserEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+
+func (m *FindUserEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindUserEventsResponse.Unmarshal(m, b)
+}
+func (m *FindUserEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindUserEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *FindUserEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindUserEventsResponse.Merge(m, src)
+}
+func (m *FindUserEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindUserEventsResponse.Size(m)
+}
+func (m *FindUserEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindUserEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindUserEventsResponse proto.InternalMessageInfo
+
+func (m *FindUserEventsResponse) GetUserEvent() []*UserEvent {
+	if m != nil {
+		return m.UserEvent
+	}
+	return nil
+}
+
+func (m *FindUserEventsResponse) GetNextUserEventId() string {
+	if m != nil {
+		return m.NextUserEventId
+	}
+	return ""
+}
+
+func (m *FindUserEventsResponse) GetPrevUserEventId() string {
+	if m != nil {
+		return m.PrevUserEventId
+	}
+	return ""
+}
+
+// FinishTotpEnrollmentRequest confirms a pending two-factor enrollment started by
+// StartTotpEnrollmentRequest.  Once finished, a code is required at login.
+type FinishTotpEnrollmentRequest struct {
+	// code is a current two-factor code generated from the pending secret.
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinishTotpEnrollmentRequest) Reset()         { *m = FinishTotpEnrollmentRequest{} }
+func (m *FinishTotpEnrollmentRequest) String() string { return proto.CompactTextString(m) }
+func (*FinishTotpEnrollmentRequest) ProtoMessage()    {}
+func (*FinishTotpEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+
+func (m *FinishTotpEnrollmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinishTotpEnrollmentRequest.Unmarshal(m, b)
+}
+func (m *FinishTotpEnrollmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinishTotpEnrollmentRequest.Marshal(b, m, deterministic)
+}
+func (m *FinishTotpEnrollmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinishTotpEnrollmentRequest.Merge(m, src)
+}
+func (m *FinishTotpEnrollmentRequest) XXX_Size() int {
+	return xxx_messageInfo_FinishTotpEnrollmentRequest.Size(m)
+}
+func (m *FinishTotpEnrollmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinishTotpEnrollmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinishTotpEnrollmentRequest proto.InternalMessageInfo
+
+func (m *FinishTotpEnrollmentRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type FinishTotpEnrollmentResponse struct {
+	// recovery_code can each be used once in place of a two-factor code.  They are not shown again.
+	RecoveryCode         []string `protobuf:"bytes,1,rep,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinishTotpEnrollmentResponse) Reset()         { *m = FinishTotpEnrollmentResponse{} }
+func (m *FinishTotpEnrollmentResponse) String() string { return proto.CompactTextString(m) }
+func (*FinishTotpEnrollmentResponse) ProtoMessage()    {}
+func (*FinishTotpEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+
+func (m *FinishTotpEnrollmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinishTotpEnrollmentResponse.Unmarshal(m, b)
+}
+func (m *FinishTotpEnrollmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinishTotpEnrollmentResponse.Marshal(b, m, deterministic)
+}
+func (m *FinishTotpEnrollmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinishTotpEnrollmentResponse.Merge(m, src)
+}
+func (m *FinishTotpEnrollmentResponse) XXX_Size() int {
+	return xxx_messageInfo_FinishTotpEnrollmentResponse.Size(m)
+}
+func (m *FinishTotpEnrollmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinishTotpEnrollmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinishTotpEnrollmentResponse proto.InternalMessageInfo
+
+func (m *FinishTotpEnrollmentResponse) GetRecoveryCode() []string {
+	if m != nil {
+		return m.RecoveryCode
+	}
+	return nil
+}
+
+// FinishUploadSessionRequest upserts the pic from a completely uploaded session.  The data
+// must match the md5_hash from when the session was started.  On success, the session is removed.
+type FinishUploadSessionRequest struct {
+	UploadSessionId      string   `protobuf:"bytes,1,opt,name=upload_session_id,json=uploadSessionId,proto3" json:"upload_session_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinishUploadSessionRequest) Reset()         { *m = FinishUploadSessionRequest{} }
+func (m *FinishUploadSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*FinishUploadSessionRequest) ProtoMessage()    {}
+func (*FinishUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+
+func (m *FinishUploadSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinishUploadSessionRequest.Unmarshal(m, b)
+}
+func (m *FinishUploadSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinishUploadSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *FinishUploadSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinishUploadSessionRequest.Merge(m, src)
+}
+func (m *FinishUploadSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_FinishUploadSessionRequest.Size(m)
+}
+func (m *FinishUploadSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinishUploadSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinishUploadSessionRequest proto.InternalMessageInfo
+
+func (m *FinishUploadSessionRequest) GetUploadSessionId() string {
+	if m != nil {
+		return m.UploadSessionId
+	}
+	return ""
+}
+
+type FinishUploadSessionResponse struct {
+	// pic is the newly created or updated picture.
+	Pic                  *Pic     `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinishUploadSessionResponse) Reset()         { *m = FinishUploadSessionResponse{} }
+func (m *FinishUploadSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*FinishUploadSessionResponse) ProtoMessage()    {}
+func (*FinishUploadSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
+}
+
+func (m *FinishUploadSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinishUploadSessionResponse.Unmarshal(m, b)
+}
+func (m *FinishUploadSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinishUploadSessionResponse.Marshal(b, m, deterministic)
+}
+func (m *FinishUploadSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinishUploadSessionResponse.Merge(m, src)
+}
+func (m *FinishUploadSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_FinishUploadSessionResponse.Size(m)
+}
+func (m *FinishUploadSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinishUploadSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinishUploadSessionResponse proto.InternalMessageInfo
+
+func (m *FinishUploadSessionResponse) GetPic() *Pic {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+// FinishUserSecretResetRequest sets a new secret for a user who has forgotten theirs.  The
+// reset_token is the code sent by StartUserSecretReset, and may only be used once.  On success,
+// all existing auth tokens of the user are revoked.
+type FinishUserSecretResetRequest struct {
+	Ident                string   `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+	ResetToken           string   `protobuf:"bytes,2,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	NewSecret            string   `protobuf:"bytes,3,opt,name=new_secret,json=newSecret,proto3" json:"new_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinishUserSecretResetRequest) Reset()         { *m = FinishUserSecretResetRequest{} }
+func (m *FinishUserSecretResetRequest) String() string { return proto.CompactTextString(m) }
+func (*FinishUserSecretResetRequest) ProtoMessage()    {}
+func (*FinishUserSecretResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
+}
+
+func (m *FinishUserSecretResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinishUserSecretResetRequest.Unmarshal(m, b)
+}
+func (m *FinishUserSecretResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinishUserSecretResetRequest.Marshal(b, m, deterministic)
+}
+func (m *FinishUserSecretResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinishUserSecretResetRequest.Merge(m, src)
+}
+func (m *FinishUserSecretResetRequest) XXX_Size() int {
+	return xxx_messageInfo_FinishUserSecretResetRequest.Size(m)
+}
+func (m *FinishUserSecretResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinishUserSecretResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinishUserSecretResetRequest proto.InternalMessageInfo
+
+func (m *FinishUserSecretResetRequest) GetIdent() string {
+	if m != nil {
+		return m.Ident
+	}
+	return ""
+}
+
+func (m *FinishUserSecretResetRequest) GetResetToken() string {
+	if m != nil {
+		return m.ResetToken
+	}
+	return ""
+}
+
+func (m *FinishUserSecretResetRequest) GetNewSecret() string {
+	if m != nil {
+		return m.NewSecret
+	}
+	return ""
+}
+
+type FinishUserSecretResetResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinishUserSecretResetResponse) Reset()         { *m = FinishUserSecretResetResponse{} }
+func (m *FinishUserSecretResetResponse) String() string { return proto.CompactTextString(m) }
+func (*FinishUserSecretResetResponse) ProtoMessage()    {}
+func (*FinishUserSecretResetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
+}
+
+func (m *FinishUserSecretResetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinishUserSecretResetResponse.Unmarshal(m, b)
+}
+func (m *FinishUserSecretResetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinishUserSecretResetResponse.Marshal(b, m, deterministic)
+}
+func (m *FinishUserSecretResetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinishUserSecretResetResponse.Merge(m, src)
+}
+func (m *FinishUserSecretResetResponse) XXX_Size() int {
+	return xxx_messageInfo_FinishUserSecretResetResponse.Size(m)
+}
+func (m *FinishUserSecretResetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinishUserSecretResetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinishUserSecretResetResponse proto.InternalMessageInfo
+
+type GetRefreshTokenRequest struct {
+	// ident is the unique identity of the user being created, usually an email address
+	Ident string `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+	// secret is the secret string used to authenticate the user, usually a password
+	Secret            string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	PreviousAuthToken string `protobuf:"bytes,3,opt,name=previous_auth_token,json=previousAuthToken,proto3" json:"previous_auth_token,omitempty"`
+	// totp_code is required with ident and secret if the user has enabled two-factor
+	// authentication.  It may also be an unused recovery code.
+	TotpCode string `protobuf:"bytes,4,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	// user_agent describes the client logging in, as seen by the caller.  It is recorded with the
+	// session so it can be recognized later.
+	UserAgent string `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// external_provider and external_subject name an identity vouched for by the caller, such as
+	// one from an OpenID Connect provider.  The caller must have the USER_AUTH_EXTERNAL capability.
+	// If the identity isn't linked yet, previous_auth_token must be provided, and the identity is
+	// linked to its user.
+	ExternalProvider     string   `protobuf:"bytes,7,opt,name=external_provider,json=externalProvider,proto3" json:"external_provider,omitempty"`
+	ExternalSubject      string   `protobuf:"bytes,8,opt,name=external_subject,json=externalSubject,proto3" json:"external_subject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRefreshTokenRequest) Reset()         { *m = GetRefreshTokenRequest{} }
+func (m *GetRefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRefreshTokenRequest) ProtoMessage()    {}
+func (*GetRefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+}
+
+func (m *GetRefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRefreshTokenRequest.Unmarshal(m, b)
+}
+func (m *GetRefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRefreshTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRefreshTokenRequest.Merge(m, src)
+}
+func (m *GetRefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRefreshTokenRequest.Size(m)
+}
+func (m *GetRefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRefreshTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRefreshTokenRequest proto.InternalMessageInfo
+
+func (m *GetRefreshTokenRequest) GetIdent() string {
+	if m != nil {
+		return m.Ident
+	}
+	return ""
+}
+
+func (m *GetRefreshTokenRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *GetRefreshTokenRequest) GetPreviousAuthToken() string {
+	if m != nil {
+		return m.PreviousAuthToken
+	}
+	return ""
+}
+
+func (m *GetRefreshTokenRequest) GetTotpCode() string {
+	if m != nil {
+		return m.TotpCode
+	}
+	return ""
+}
+
+func (m *GetRefreshTokenRequest) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *GetRefreshTokenRequest) GetExternalProvider() string {
+	if m != nil {
+		return m.ExternalProvider
+	}
+	return ""
+}
+
+func (m *GetRefreshTokenRequest) GetExternalSubject() string {
+	if m != nil {
+		return m.ExternalSubject
+	}
+	return ""
+}
+
+type GetRefreshTokenResponse struct {
+	AuthToken            string      `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	PixToken             string      `protobuf:"bytes,5,opt,name=pix_token,json=pixToken,proto3" json:"pix_token,omitempty"`
+	AuthPayload          *PwtPayload `protobuf:"bytes,4,opt,name=auth_payload,json=authPayload,proto3" json:"auth_payload,omitempty"`
+	PixPayload           *PwtPayload `protobuf:"bytes,6,opt,name=pix_payload,json=pixPayload,proto3" json:"pix_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetRefreshTokenResponse) Reset()         { *m = GetRefreshTokenResponse{} }
+func (m *GetRefreshTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRefreshTokenResponse) ProtoMessage()    {}
+func (*GetRefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
+}
+
+func (m *GetRefreshTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRefreshTokenResponse.Unmarshal(m, b)
+}
+func (m *GetRefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRefreshTokenResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRefreshTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRefreshTokenResponse.Merge(m, src)
+}
+func (m *GetRefreshTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRefreshTokenResponse.Size(m)
+}
+func (m *GetRefreshTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRefreshTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRefreshTokenResponse proto.InternalMessageInfo
+
+func (m *GetRefreshTokenResponse) GetAuthToken() string {
+	if m != nil {
+		return m.AuthToken
+	}
+	return ""
+}
+
+func (m *GetRefreshTokenResponse) GetPixToken() string {
+	if m != nil {
+		return m.PixToken
+	}
+	return ""
+}
+
+func (m *GetRefreshTokenResponse) GetAuthPayload() *PwtPayload {
+	if m != nil {
+		return m.AuthPayload
+	}
+	return nil
+}
+
+func (m *GetRefreshTokenResponse) GetPixPayload() *PwtPayload {
+	if m != nil {
+		return m.PixPayload
+	}
+	return nil
+}
+
+type IncrementViewCountRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IncrementViewCountRequest) Reset()         { *m = IncrementViewCountRequest{} }
+func (m *IncrementViewCountRequest) String() string { return proto.CompactTextString(m) }
+func (*IncrementViewCountRequest) ProtoMessage()    {}
+func (*IncrementViewCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
+}
+
+func (m *IncrementViewCountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IncrementViewCountRequest.Unmarshal(m, b)
+}
+func (m *IncrementViewCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IncrementViewCountRequest.Marshal(b, m, deterministic)
+}
+func (m *IncrementViewCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementViewCountRequest.Merge(m, src)
+}
+func (m *IncrementViewCountRequest) XXX_Size() int {
+	return xxx_messageInfo_IncrementViewCountRequest.Size(m)
+}
+func (m *IncrementViewCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementViewCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncrementViewCountRequest proto.InternalMessageInfo
+
+func (m *IncrementViewCountRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type IncrementViewCountResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IncrementViewCountResponse) Reset()         { *m = IncrementViewCountResponse{} }
+func (m *IncrementViewCountResponse) String() string { return proto.CompactTextString(m) }
+func (*IncrementViewCountResponse) ProtoMessage()    {}
+func (*IncrementViewCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
+}
+
+func (m *IncrementViewCountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IncrementViewCountResponse.Unmarshal(m, b)
+}
+func (m *IncrementViewCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IncrementViewCountResponse.Marshal(b, m, deterministic)
+}
+func (m *IncrementViewCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementViewCountResponse.Merge(m, src)
+}
+func (m *IncrementViewCountResponse) XXX_Size() int {
+	return xxx_messageInfo_IncrementViewCountResponse.Size(m)
+}
+func (m *IncrementViewCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementViewCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncrementViewCountResponse proto.InternalMessageInfo
+
+// InsertPoolPicRequest adds a pic to a pool.  Requires the PIC_POOL_EDIT capability.
+type InsertPoolPicRequest struct {
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PicId  string `protobuf:"bytes,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// before_pic_id is the pic in the pool to insert in front of.  If empty, the pic is added to
+	// the end of the pool.
+	BeforePicId          string   `protobuf:"bytes,3,opt,name=before_pic_id,json=beforePicId,proto3" json:"before_pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsertPoolPicRequest) Reset()         { *m = InsertPoolPicRequest{} }
+func (m *InsertPoolPicRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertPoolPicRequest) ProtoMessage()    {}
+func (*InsertPoolPicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
+}
+
+func (m *InsertPoolPicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertPoolPicRequest.Unmarshal(m, b)
+}
+func (m *InsertPoolPicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertPoolPicRequest.Marshal(b, m, deterministic)
+}
+func (m *InsertPoolPicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertPoolPicRequest.Merge(m, src)
+}
+func (m *InsertPoolPicRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertPoolPicRequest.Size(m)
+}
+func (m *InsertPoolPicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertPoolPicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertPoolPicRequest proto.InternalMessageInfo
+
+func (m *InsertPoolPicRequest) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *InsertPoolPicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *InsertPoolPicRequest) GetBeforePicId() string {
+	if m != nil {
+		return m.BeforePicId
+	}
+	return ""
+}
+
+type InsertPoolPicResponse struct {
+	Pool                 *Pool    `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsertPoolPicResponse) Reset()         { *m = InsertPoolPicResponse{} }
+func (m *InsertPoolPicResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertPoolPicResponse) ProtoMessage()    {}
+func (*InsertPoolPicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+}
+
+func (m *InsertPoolPicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertPoolPicResponse.Unmarshal(m, b)
+}
+func (m *InsertPoolPicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertPoolPicResponse.Marshal(b, m, deterministic)
+}
+func (m *InsertPoolPicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertPoolPicResponse.Merge(m, src)
+}
+func (m *InsertPoolPicResponse) XXX_Size() int {
+	return xxx_messageInfo_InsertPoolPicResponse.Size(m)
+}
+func (m *InsertPoolPicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertPoolPicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertPoolPicResponse proto.InternalMessageInfo
+
+func (m *InsertPoolPicResponse) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+type ListSessionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSessionsRequest) Reset()         { *m = ListSessionsRequest{} }
+func (m *ListSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSessionsRequest) ProtoMessage()    {}
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *ListSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionsRequest.Unmarshal(m, b)
+}
+func (m *ListSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsRequest.Merge(m, src)
+}
+func (m *ListSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSessionsRequest.Size(m)
+}
+func (m *ListSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSessionsRequest proto.InternalMessageInfo
+
+type ListSessionsResponse struct {
+	Session              []*Session `protobuf:"bytes,1,rep,name=session,proto3" json:"session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListSessionsResponse) Reset()         { *m = ListSessionsResponse{} }
+func (m *ListSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSessionsResponse) ProtoMessage()    {}
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+
+func (m *ListSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionsResponse.Unmarshal(m, b)
+}
+func (m *ListSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsResponse.Merge(m, src)
+}
+func (m *ListSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSessionsResponse.Size(m)
+}
+func (m *ListSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSessionsResponse proto.InternalMessageInfo
+
+func (m *ListSessionsResponse) GetSession() []*Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+// LookupCollectionRequest looks up a collection and its pics, in order.  Private collections can
+// only be looked up by the owner.
+type LookupCollectionRequest struct {
+	CollectionId         string   `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupCollectionRequest) Reset()         { *m = LookupCollectionRequest{} }
+func (m *LookupCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupCollectionRequest) ProtoMessage()    {}
+func (*LookupCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *LookupCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupCollectionRequest.Unmarshal(m, b)
+}
+func (m *LookupCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupCollectionRequest.Merge(m, src)
+}
+func (m *LookupCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupCollectionRequest.Size(m)
+}
+func (m *LookupCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupCollectionRequest proto.InternalMessageInfo
+
+func (m *LookupCollectionRequest) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+type LookupCollectionResponse struct {
+	Collection           *Collection        `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Pic                  []*PicAndThumbnail `protobuf:"bytes,2,rep,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *LookupCollectionResponse) Reset()         { *m = LookupCollectionResponse{} }
+func (m *LookupCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupCollectionResponse) ProtoMessage()    {}
+func (*LookupCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+
+func (m *LookupCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupCollectionResponse.Unmarshal(m, b)
+}
+func (m *LookupCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupCollectionResponse.Merge(m, src)
+}
+func (m *LookupCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupCollectionResponse.Size(m)
+}
+func (m *LookupCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupCollectionResponse proto.InternalMessageInfo
+
+func (m *LookupCollectionResponse) GetCollection() *Collection {
+	if m != nil {
+		return m.Collection
+	}
+	return nil
+}
+
+func (m *LookupCollectionResponse) GetPic() []*PicAndThumbnail {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+type LookupPicCommentVoteRequest struct {
+	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// if absent, this comes from the auth token.
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicCommentVoteRequest) Reset()         { *m = LookupPicCommentVoteRequest{} }
+func (m *LookupPicCommentVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicCommentVoteRequest) ProtoMessage()    {}
+func (*LookupPicCommentVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *LookupPicCommentVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicCommentVoteRequest.Unmarshal(m, b)
+}
+func (m *LookupPicCommentVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicCommentVoteRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPicCommentVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicCommentVoteRequest.Merge(m, src)
+}
+func (m *LookupPicCommentVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicCommentVoteRequest.Size(m)
+}
+func (m *LookupPicCommentVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicCommentVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicCommentVoteRequest proto.InternalMessageInfo
+
+func (m *LookupPicCommentVoteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *LookupPicCommentVoteRequest) GetCommentId() string {
+	if m != nil {
+		return m.CommentId
+	}
+	return ""
+}
+
+func (m *LookupPicCommentVoteRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type LookupPicCommentVoteResponse struct {
+	Vote                 *PicCommentVote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LookupPicCommentVoteResponse) Reset()         { *m = LookupPicCommentVoteResponse{} }
+func (m *LookupPicCommentVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicCommentVoteResponse) ProtoMessage()    {}
+func (*LookupPicCommentVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+
+func (m *LookupPicCommentVoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicCommentVoteResponse.Unmarshal(m, b)
+}
+func (m *LookupPicCommentVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicCommentVoteResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPicCommentVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicCommentVoteResponse.Merge(m, src)
+}
+func (m *LookupPicCommentVoteResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicCommentVoteResponse.Size(m)
+}
+func (m *LookupPicCommentVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicCommentVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicCommentVoteResponse proto.InternalMessageInfo
+
+func (m *LookupPicCommentVoteResponse) GetVote() *PicCommentVote {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+type LookupPicDetailsRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicDetailsRequest) Reset()         { *m = LookupPicDetailsRequest{} }
+func (m *LookupPicDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicDetailsRequest) ProtoMessage()    {}
+func (*LookupPicDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *LookupPicDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicDetailsRequest.Unmarshal(m, b)
+}
+func (m *LookupPicDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicDetailsRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPicDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicDetailsRequest.Merge(m, src)
+}
+func (m *LookupPicDetailsRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicDetailsRequest.Size(m)
+}
+func (m *LookupPicDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicDetailsRequest proto.InternalMessageInfo
+
+func (m *LookupPicDetailsRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type LookupPicDetailsResponse struct {
+	Pic            *Pic            `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	Derived        []*PicFile      `protobuf:"bytes,4,rep,name=derived,proto3" json:"derived,omitempty"`
+	PicTag         []*PicTag       `protobuf:"bytes,2,rep,name=pic_tag,json=picTag,proto3" json:"pic_tag,omitempty"`
+	PicCommentTree *PicCommentTree `protobuf:"bytes,3,opt,name=pic_comment_tree,json=picCommentTree,proto3" json:"pic_comment_tree,omitempty"`
+	// favorite is true if the current user has favorited the pic.
+	Favorite             bool                                `protobuf:"varint,5,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	PoolNav              []*LookupPicDetailsResponse_PoolNav `protobuf:"bytes,6,rep,name=pool_nav,json=poolNav,proto3" json:"pool_nav,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *LookupPicDetailsResponse) Reset()         { *m = LookupPicDetailsResponse{} }
+func (m *LookupPicDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicDetailsResponse) ProtoMessage()    {}
+func (*LookupPicDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+
+func (m *LookupPicDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicDetailsResponse.Unmarshal(m, b)
+}
+func (m *LookupPicDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicDetailsResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPicDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicDetailsResponse.Merge(m, src)
+}
+func (m *LookupPicDetailsResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicDetailsResponse.Size(m)
+}
+func (m *LookupPicDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicDetailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicDetailsResponse proto.InternalMessageInfo
+
+func (m *LookupPicDetailsResponse) GetPic() *Pic {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+func (m *LookupPicDetailsResponse) GetDerived() []*PicFile {
+	if m != nil {
+		return m.Derived
+	}
+	return nil
+}
+
+func (m *LookupPicDetailsResponse) GetPicTag() []*PicTag {
+	if m != nil {
+		return m.PicTag
+	}
+	return nil
+}
+
+func (m *LookupPicDetailsResponse) GetPicCommentTree() *PicCommentTree {
+	if m != nil {
+		return m.PicCommentTree
+	}
+	return nil
+}
+
+func (m *LookupPicDetailsResponse) GetFavorite() bool {
+	if m != nil {
+		return m.Favorite
+	}
+	return false
+}
+
+func (m *LookupPicDetailsResponse) GetPoolNav() []*LookupPicDetailsResponse_PoolNav {
+	if m != nil {
+		return m.PoolNav
+	}
+	return nil
+}
+
+// PoolNav is a pool the pic is in, along with its neighbors in the pool.
+type LookupPicDetailsResponse_PoolNav struct {
+	Pool *Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// prev_pic_id and next_pic_id are empty at the start and end of the pool.
+	PrevPicId            string   `protobuf:"bytes,2,opt,name=prev_pic_id,json=prevPicId,proto3" json:"prev_pic_id,omitempty"`
+	NextPicId            string   `protobuf:"bytes,3,opt,name=next_pic_id,json=nextPicId,proto3" json:"next_pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicDetailsResponse_PoolNav) Reset()         { *m = LookupPicDetailsResponse_PoolNav{} }
+func (m *LookupPicDetailsResponse_PoolNav) String() string { return proto.CompactTextString(m) }
+func (*LookupPicDetailsResponse_PoolNav) ProtoMessage()    {}
+func (*LookupPicDetailsResponse_PoolNav) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85, 0}
+}
+
+func (m *LookupPicDetailsResponse_PoolNav) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicDetailsResponse_PoolNav.Unmarshal(m, b)
+}
+func (m *LookupPicDetailsResponse_PoolNav) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicDetailsResponse_PoolNav.Marshal(b, m, deterministic)
+}
+func (m *LookupPicDetailsResponse_PoolNav) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicDetailsResponse_PoolNav.Merge(m, src)
+}
+func (m *LookupPicDetailsResponse_PoolNav) XXX_Size() int {
+	return xxx_messageInfo_LookupPicDetailsResponse_PoolNav.Size(m)
+}
+func (m *LookupPicDetailsResponse_PoolNav) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicDetailsResponse_PoolNav.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicDetailsResponse_PoolNav proto.InternalMessageInfo
+
+func (m *LookupPicDetailsResponse_PoolNav) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+func (m *LookupPicDetailsResponse_PoolNav) GetPrevPicId() string {
+	if m != nil {
+		return m.PrevPicId
+	}
+	return ""
+}
+
+func (m *LookupPicDetailsResponse_PoolNav) GetNextPicId() string {
+	if m != nil {
+		return m.NextPicId
+	}
+	return ""
+}
+
+type LookupPicExtensionRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicExtensionRequest) Reset()         { *m = LookupPicExtensionRequest{} }
+func (m *LookupPicExtensionRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicExtensionRequest) ProtoMessage()    {}
+func (*LookupPicExtensionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
+}
+
+func (m *LookupPicExtensionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicExtensionRequest.Unmarshal(m, b)
+}
+func (m *LookupPicExtensionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicExtensionRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPicExtensionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicExtensionRequest.Merge(m, src)
+}
+func (m *LookupPicExtensionRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicExtensionRequest.Size(m)
+}
+func (m *LookupPicExtensionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicExtensionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicExtensionRequest proto.InternalMessageInfo
+
+func (m *LookupPicExtensionRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type LookupPicExtensionResponse struct {
+	Ext                  map[string]*any.Any `protobuf:"bytes,1,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *LookupPicExtensionResponse) Reset()         { *m = LookupPicExtensionResponse{} }
+func (m *LookupPicExtensionResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicExtensionResponse) ProtoMessage()    {}
+func (*LookupPicExtensionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+}
+
+func (m *LookupPicExtensionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicExtensionResponse.Unmarshal(m, b)
+}
+func (m *LookupPicExtensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicExtensionResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPicExtensionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicExtensionResponse.Merge(m, src)
+}
+func (m *LookupPicExtensionResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicExtensionResponse.Size(m)
+}
+func (m *LookupPicExtensionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicExtensionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicExtensionResponse proto.InternalMessageInfo
+
+func (m *LookupPicExtensionResponse) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+type LookupPicFileRequest struct {
+	PicFileId            string         `protobuf:"bytes,1,opt,name=pic_file_id,json=picFileId,proto3" json:"pic_file_id,omitempty"`
+	Format               PicFile_Format `protobuf:"varint,2,opt,name=format,proto3,enum=pixur.api.PicFile_Format" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LookupPicFileRequest) Reset()         { *m = LookupPicFileRequest{} }
+func (m *LookupPicFileRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicFileRequest) ProtoMessage()    {}
+func (*LookupPicFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+}
+
+func (m *LookupPicFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicFileRequest.Unmarshal(m, b)
+}
+func (m *LookupPicFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicFileRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPicFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicFileRequest.Merge(m, src)
+}
+func (m *LookupPicFileRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicFileRequest.Size(m)
+}
+func (m *LookupPicFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicFileRequest proto.InternalMessageInfo
+
+func (m *LookupPicFileRequest) GetPicFileId() string {
+	if m != nil {
+		return m.PicFileId
+	}
+	return ""
+}
+
+func (m *LookupPicFileRequest) GetFormat() PicFile_Format {
+	if m != nil {
+		return m.Format
+	}
+	return PicFile_UNKNOWN
+}
+
+type LookupPicFileResponse struct {
+	PicFile              *PicFile `protobuf:"bytes,1,opt,name=pic_file,json=picFile,proto3" json:"pic_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicFileResponse) Reset()         { *m = LookupPicFileResponse{} }
+func (m *LookupPicFileResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicFileResponse) ProtoMessage()    {}
+func (*LookupPicFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
+}
+
+func (m *LookupPicFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicFileResponse.Unmarshal(m, b)
+}
+func (m *LookupPicFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicFileResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPicFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicFileResponse.Merge(m, src)
+}
+func (m *LookupPicFileResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicFileResponse.Size(m)
+}
+func (m *LookupPicFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicFileResponse proto.InternalMessageInfo
+
+func (m *LookupPicFileResponse) GetPicFile() *PicFile {
+	if m != nil {
+		return m.PicFile
+	}
+	return nil
+}
+
+type LookupPicVoteRequest struct {
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// Optional.  Uses auth token if not specified.
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicVoteRequest) Reset()         { *m = LookupPicVoteRequest{} }
+func (m *LookupPicVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicVoteRequest) ProtoMessage()    {}
+func (*LookupPicVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
+}
+
+func (m *LookupPicVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicVoteRequest.Unmarshal(m, b)
+}
+func (m *LookupPicVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicVoteRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPicVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicVoteRequest.Merge(m, src)
+}
+func (m *LookupPicVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicVoteRequest.Size(m)
+}
+func (m *LookupPicVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicVoteRequest proto.InternalMessageInfo
+
+func (m *LookupPicVoteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *LookupPicVoteRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type LookupPicVoteResponse struct {
+	Vote                 *PicVote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicVoteResponse) Reset()         { *m = LookupPicVoteResponse{} }
+func (m *LookupPicVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicVoteResponse) ProtoMessage()    {}
+func (*LookupPicVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{91}
+}
+
+func (m *LookupPicVoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicVoteResponse.Unmarshal(m, b)
+}
+func (m *LookupPicVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicVoteResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPicVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicVoteResponse.Merge(m, src)
+}
+func (m *LookupPicVoteResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicVoteResponse.Size(m)
+}
+func (m *LookupPicVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicVoteResponse proto.InternalMessageInfo
+
+func (m *LookupPicVoteResponse) GetVote() *PicVote {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+// LookupPoolRequest finds a pool and its pics, in order.
+type LookupPoolRequest struct {
+	PoolId               string   `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPoolRequest) Reset()         { *m = LookupPoolRequest{} }
+func (m *LookupPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPoolRequest) ProtoMessage()    {}
+func (*LookupPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{92}
+}
+
+func (m *LookupPoolRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPoolRequest.Unmarshal(m, b)
+}
+func (m *LookupPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPoolRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPoolRequest.Merge(m, src)
+}
+func (m *LookupPoolRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPoolRequest.Size(m)
+}
+func (m *LookupPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPoolRequest proto.InternalMessageInfo
+
+func (m *LookupPoolRequest) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+type LookupPoolResponse struct {
+	Pool                 *Pool              `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Pic                  []*PicAndThumbnail `protobuf:"bytes,2,rep,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *LookupPoolResponse) Reset()         { *m = LookupPoolResponse{} }
+func (m *LookupPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPoolResponse) ProtoMessage()    {}
+func (*LookupPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{93}
+}
+
+func (m *LookupPoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPoolResponse.Unmarshal(m, b)
+}
+func (m *LookupPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPoolResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPoolResponse.Merge(m, src)
+}
+func (m *LookupPoolResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPoolResponse.Size(m)
+}
+func (m *LookupPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPoolResponse proto.InternalMessageInfo
+
+func (m *LookupPoolResponse) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+func (m *LookupPoolResponse) GetPic() []*PicAndThumbnail {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+type LookupPublicUserInfoRequest struct {
+	// if absent, assumed to come from auth token
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPublicUserInfoRequest) Reset()         { *m = LookupPublicUserInfoRequest{} }
+func (m *LookupPublicUserInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPublicUserInfoRequest) ProtoMessage()    {}
+func (*LookupPublicUserInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{94}
+}
+
+func (m *LookupPublicUserInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPublicUserInfoRequest.Unmarshal(m, b)
+}
+func (m *LookupPublicUserInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPublicUserInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPublicUserInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPublicUserInfoRequest.Merge(m, src)
+}
+func (m *LookupPublicUserInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPublicUserInfoRequest.Size(m)
+}
+func (m *LookupPublicUserInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPublicUserInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPublicUserInfoRequest proto.InternalMessageInfo
+
+func (m *LookupPublicUserInfoRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type LookupPublicUserInfoResponse struct {
+	UserInfo             *PublicUserInfo `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LookupPublicUserInfoResponse) Reset()         { *m = LookupPublicUserInfoResponse{} }
+func (m *LookupPublicUserInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPublicUserInfoResponse) ProtoMessage()    {}
+func (*LookupPublicUserInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{95}
+}
+
+func (m *LookupPublicUserInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPublicUserInfoResponse.Unmarshal(m, b)
+}
+func (m *LookupPublicUserInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPublicUserInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPublicUserInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPublicUserInfoResponse.Merge(m, src)
+}
+func (m *LookupPublicUserInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPublicUserInfoResponse.Size(m)
+}
+func (m *LookupPublicUserInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPublicUserInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPublicUserInfoResponse proto.InternalMessageInfo
+
+func (m *LookupPublicUserInfoResponse) GetUserInfo() *PublicUserInfo {
+	if m != nil {
+		return m.UserInfo
+	}
+	return nil
+}
+
+// LookupUploadSessionRequest finds the current state of an upload session, such as the offset
+// to resume from.
+type LookupUploadSessionRequest struct {
+	UploadSessionId      string   `protobuf:"bytes,1,opt,name=upload_session_id,json=uploadSessionId,proto3" json:"upload_session_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupUploadSessionRequest) Reset()         { *m = LookupUploadSessionRequest{} }
+func (m *LookupUploadSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupUploadSessionRequest) ProtoMessage()    {}
+func (*LookupUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{96}
+}
+
+func (m *LookupUploadSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupUploadSessionRequest.Unmarshal(m, b)
+}
+func (m *LookupUploadSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupUploadSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupUploadSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupUploadSessionRequest.Merge(m, src)
+}
+func (m *LookupUploadSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupUploadSessionRequest.Size(m)
+}
+func (m *LookupUploadSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupUploadSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupUploadSessionRequest proto.InternalMessageInfo
+
+func (m *LookupUploadSessionRequest) GetUploadSessionId() string {
+	if m != nil {
+		return m.UploadSessionId
+	}
+	return ""
+}
+
+type LookupUploadSessionResponse struct {
+	UploadSession        *UploadSession `protobuf:"bytes,1,opt,name=upload_session,json=uploadSession,proto3" json:"upload_session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LookupUploadSessionResponse) Reset()         { *m = LookupUploadSessionResponse{} }
+func (m *LookupUploadSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupUploadSessionResponse) ProtoMessage()    {}
+func (*LookupUploadSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{97}
+}
+
+func (m *LookupUploadSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupUploadSessionResponse.Unmarshal(m, b)
+}
+func (m *LookupUploadSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupUploadSessionResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupUploadSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupUploadSessionResponse.Merge(m, src)
+}
+func (m *LookupUploadSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupUploadSessionResponse.Size(m)
+}
+func (m *LookupUploadSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupUploadSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupUploadSessionResponse proto.InternalMessageInfo
+
+func (m *LookupUploadSessionResponse) GetUploadSession() *UploadSession {
+	if m != nil {
+		return m.UploadSession
+	}
+	return nil
+}
+
+type LookupUserRequest struct {
+	// if absent, assumed to come from auth token
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupUserRequest) Reset()         { *m = LookupUserRequest{} }
+func (m *LookupUserRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupUserRequest) ProtoMessage()    {}
+func (*LookupUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98}
+}
+
+func (m *LookupUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupUserRequest.Unmarshal(m, b)
+}
+func (m *LookupUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupUserRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupUserRequest.Merge(m, src)
+}
+func (m *LookupUserRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupUserRequest.Size(m)
+}
+func (m *LookupUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupUserRequest proto.InternalMessageInfo
+
+func (m *LookupUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type LookupUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupUserResponse) Reset()         { *m = LookupUserResponse{} }
+func (m *LookupUserResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupUserResponse) ProtoMessage()    {}
+func (*LookupUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{99}
+}
+
+func (m *LookupUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupUserResponse.Unmarshal(m, b)
+}
+func (m *LookupUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupUserResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupUserResponse.Merge(m, src)
+}
+func (m *LookupUserResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupUserResponse.Size(m)
+}
+func (m *LookupUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupUserResponse proto.InternalMessageInfo
+
+func (m *LookupUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+// MovePoolPicRequest moves a pic within a pool.  Requires the PIC_POOL_EDIT capability.
+type MovePoolPicRequest struct {
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PicId  string `protobuf:"bytes,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// before_pic_id is the pic in the pool to move in front of.  If empty, the pic is moved to the
+	// end of the pool.
+	BeforePicId          string   `protobuf:"bytes,3,opt,name=before_pic_id,json=beforePicId,proto3" json:"before_pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MovePoolPicRequest) Reset()         { *m = MovePoolPicRequest{} }
+func (m *MovePoolPicRequest) String() string { return proto.CompactTextString(m) }
+func (*MovePoolPicRequest) ProtoMessage()    {}
+func (*MovePoolPicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{100}
+}
+
+func (m *MovePoolPicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MovePoolPicRequest.Unmarshal(m, b)
+}
+func (m *MovePoolPicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MovePoolPicRequest.Marshal(b, m, deterministic)
+}
+func (m *MovePoolPicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MovePoolPicRequest.Merge(m, src)
+}
+func (m *MovePoolPicRequest) XXX_Size() int {
+	return xxx_messageInfo_MovePoolPicRequest.Size(m)
+}
+func (m *MovePoolPicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MovePoolPicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MovePoolPicRequest proto.InternalMessageInfo
+
+func (m *MovePoolPicRequest) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *MovePoolPicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *MovePoolPicRequest) GetBeforePicId() string {
+	if m != nil {
+		return m.BeforePicId
+	}
+	return ""
+}
+
+type MovePoolPicResponse struct {
+	Pool                 *Pool    `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MovePoolPicResponse) Reset()         { *m = MovePoolPicResponse{} }
+func (m *MovePoolPicResponse) String() string { return proto.CompactTextString(m) }
+func (*MovePoolPicResponse) ProtoMessage()    {}
+func (*MovePoolPicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{101}
+}
+
+func (m *MovePoolPicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MovePoolPicResponse.Unmarshal(m, b)
+}
+func (m *MovePoolPicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MovePoolPicResponse.Marshal(b, m, deterministic)
+}
+func (m *MovePoolPicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MovePoolPicResponse.Merge(m, src)
+}
+func (m *MovePoolPicResponse) XXX_Size() int {
+	return xxx_messageInfo_MovePoolPicResponse.Size(m)
+}
+func (m *MovePoolPicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MovePoolPicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MovePoolPicResponse proto.InternalMessageInfo
+
+func (m *MovePoolPicResponse) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+type PurgePicRequest struct {
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// reason is why the pic is purged.  It is recorded in the audit log.
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgePicRequest) Reset()         { *m = PurgePicRequest{} }
+func (m *PurgePicRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgePicRequest) ProtoMessage()    {}
+func (*PurgePicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{102}
+}
+
+func (m *PurgePicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgePicRequest.Unmarshal(m, b)
+}
+func (m *PurgePicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgePicRequest.Marshal(b, m, deterministic)
+}
+func (m *PurgePicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgePicRequest.Merge(m, src)
+}
+func (m *PurgePicRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgePicRequest.Size(m)
+}
+func (m *PurgePicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgePicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgePicRequest proto.InternalMessageInfo
+
+func (m *PurgePicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *PurgePicRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type PurgePicResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgePicResponse) Reset()         { *m = PurgePicResponse{} }
+func (m *PurgePicResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgePicResponse) ProtoMessage()    {}
+func (*PurgePicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{103}
+}
+
+func (m *PurgePicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgePicResponse.Unmarshal(m, b)
+}
+func (m *PurgePicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgePicResponse.Marshal(b, m, deterministic)
+}
+func (m *PurgePicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgePicResponse.Merge(m, src)
+}
+func (m *PurgePicResponse) XXX_Size() int {
+	return xxx_messageInfo_PurgePicResponse.Size(m)
+}
+func (m *PurgePicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgePicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgePicResponse proto.InternalMessageInfo
+
+type ReadPicFileRequest struct {
+	PicFileId string         `protobuf:"bytes,1,opt,name=pic_file_id,json=picFileId,proto3" json:"pic_file_id,omitempty"`
+	Format    PicFile_Format `protobuf:"varint,2,opt,name=format,proto3,enum=pixur.api.PicFile_Format" json:"format,omitempty"`
+	// The offset into the file to start reading at.  Required
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The maximum number of bytes to read.   Maybe be 0.
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadPicFileRequest) Reset()         { *m = ReadPicFileRequest{} }
+func (m *ReadPicFileRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadPicFileRequest) ProtoMessage()    {}
+func (*ReadPicFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{104}
+}
+
+func (m *ReadPicFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadPicFileRequest.Unmarshal(m, b)
+}
+func (m *ReadPicFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadPicFileRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadPicFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadPicFileRequest.Merge(m, src)
+}
+func (m *ReadPicFileRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadPicFileRequest.Size(m)
+}
+func (m *ReadPicFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadPicFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadPicFileRequest proto.InternalMessageInfo
+
+func (m *ReadPicFileRequest) GetPicFileId() string {
+	if m != nil {
+		return m.PicFileId
+	}
+	return ""
+}
+
+func (m *ReadPicFileRequest) GetFormat() PicFile_Format {
+	if m != nil {
+		return m.Format
+	}
+	return PicFile_UNKNOWN
+}
+
+func (m *ReadPicFileRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ReadPicFileRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ReadPicFileResponse struct {
+	// may be empty
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// indicates that the end of the pic has been reached.
+	Eof                  bool     `protobuf:"varint,2,opt,name=eof,proto3" json:"eof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadPicFileResponse) Reset()         { *m = ReadPicFileResponse{} }
+func (m *ReadPicFileResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadPicFileResponse) ProtoMessage()    {}
+func (*ReadPicFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{105}
+}
+
+func (m *ReadPicFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadPicFileResponse.Unmarshal(m, b)
+}
+func (m *ReadPicFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadPicFileResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadPicFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadPicFileResponse.Merge(m, src)
+}
+func (m *ReadPicFileResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadPicFileResponse.Size(m)
+}
+func (m *ReadPicFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadPicFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadPicFileResponse proto.InternalMessageInfo
+
+func (m *ReadPicFileResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ReadPicFileResponse) GetEof() bool {
+	if m != nil {
+		return m.Eof
+	}
+	return false
+}
+
+// RemoveCollectionPicRequest removes a pic from a collection.  Only the owner of the collection
+// may remove pics.
+type RemoveCollectionPicRequest struct {
+	CollectionId         string   `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PicId                string   `protobuf:"bytes,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveCollectionPicRequest) Reset()         { *m = RemoveCollectionPicRequest{} }
+func (m *RemoveCollectionPicRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveCollectionPicRequest) ProtoMessage()    {}
+func (*RemoveCollectionPicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{106}
+}
+
+func (m *RemoveCollectionPicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveCollectionPicRequest.Unmarshal(m, b)
+}
+func (m *RemoveCollectionPicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveCollectionPicRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveCollectionPicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCollectionPicRequest.Merge(m, src)
+}
+func (m *RemoveCollectionPicRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveCollectionPicRequest.Size(m)
+}
+func (m *RemoveCollectionPicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCollectionPicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCollectionPicRequest proto.InternalMessageInfo
+
+func (m *RemoveCollectionPicRequest) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+func (m *RemoveCollectionPicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type RemoveCollectionPicResponse struct {
+	Collection           *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RemoveCollectionPicResponse) Reset()         { *m = RemoveCollectionPicResponse{} }
+func (m *RemoveCollectionPicResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveCollectionPicResponse) ProtoMessage()    {}
+func (*RemoveCollectionPicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{107}
+}
+
+func (m *RemoveCollectionPicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveCollectionPicResponse.Unmarshal(m, b)
+}
+func (m *RemoveCollectionPicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveCollectionPicResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveCollectionPicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCollectionPicResponse.Merge(m, src)
+}
+func (m *RemoveCollectionPicResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveCollectionPicResponse.Size(m)
+}
+func (m *RemoveCollectionPicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCollectionPicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCollectionPicResponse proto.InternalMessageInfo
+
+func (m *RemoveCollectionPicResponse) GetCollection() *Collection {
+	if m != nil {
+		return m.Collection
+	}
+	return nil
+}
+
+// RemovePicFavoriteRequest unfavorites a pic for the current user.
+type RemovePicFavoriteRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePicFavoriteRequest) Reset()         { *m = RemovePicFavoriteRequest{} }
+func (m *RemovePicFavoriteRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePicFavoriteRequest) ProtoMessage()    {}
+func (*RemovePicFavoriteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{108}
+}
+
+func (m *RemovePicFavoriteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePicFavoriteRequest.Unmarshal(m, b)
+}
+func (m *RemovePicFavoriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePicFavoriteRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePicFavoriteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePicFavoriteRequest.Merge(m, src)
+}
+func (m *RemovePicFavoriteRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePicFavoriteRequest.Size(m)
+}
+func (m *RemovePicFavoriteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePicFavoriteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePicFavoriteRequest proto.InternalMessageInfo
+
+func (m *RemovePicFavoriteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type RemovePicFavoriteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePicFavoriteResponse) Reset()         { *m = RemovePicFavoriteResponse{} }
+func (m *RemovePicFavoriteResponse) String() string { return proto.CompactTextString(m) }
+func (*RemovePicFavoriteResponse) ProtoMessage()    {}
+func (*RemovePicFavoriteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{109}
+}
+
+func (m *RemovePicFavoriteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePicFavoriteResponse.Unmarshal(m, b)
+}
+func (m *RemovePicFavoriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePicFavoriteResponse.Marshal(b, m, deterministic)
+}
+func (m *RemovePicFavoriteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePicFavoriteResponse.Merge(m, src)
+}
+func (m *RemovePicFavoriteResponse) XXX_Size() int {
+	return xxx_messageInfo_RemovePicFavoriteResponse.Size(m)
+}
+func (m *RemovePicFavoriteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePicFavoriteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePicFavoriteResponse proto.InternalMessageInfo
+
+// RemovePoolPicRequest removes a pic from a pool.  Requires the PIC_POOL_EDIT capability.
+type RemovePoolPicRequest struct {
+	PoolId               string   `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PicId                string   `protobuf:"bytes,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePoolPicRequest) Reset()         { *m = RemovePoolPicRequest{} }
+func (m *RemovePoolPicRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePoolPicRequest) ProtoMessage()    {}
+func (*RemovePoolPicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{110}
+}
+
+func (m *RemovePoolPicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePoolPicRequest.Unmarshal(m, b)
+}
+func (m *RemovePoolPicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePoolPicRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePoolPicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePoolPicRequest.Merge(m, src)
+}
+func (m *RemovePoolPicRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePoolPicRequest.Size(m)
+}
+func (m *RemovePoolPicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePoolPicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePoolPicRequest proto.InternalMessageInfo
+
+func (m *RemovePoolPicRequest) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *RemovePoolPicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type RemovePoolPicResponse struct {
+	Pool                 *Pool    `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePoolPicResponse) Reset()         { *m = RemovePoolPicResponse{} }
+func (m *RemovePoolPicResponse) String() string { return proto.CompactTextString(m) }
+func (*RemovePoolPicResponse) ProtoMessage()    {}
+func (*RemovePoolPicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{111}
+}
+
+func (m *RemovePoolPicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePoolPicResponse.Unmarshal(m, b)
+}
+func (m *RemovePoolPicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePoolPicResponse.Marshal(b, m, deterministic)
+}
+func (m *RemovePoolPicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePoolPicResponse.Merge(m, src)
+}
+func (m *RemovePoolPicResponse) XXX_Size() int {
+	return xxx_messageInfo_RemovePoolPicResponse.Size(m)
+}
+func (m *RemovePoolPicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePoolPicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePoolPicResponse proto.InternalMessageInfo
+
+func (m *RemovePoolPicResponse) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+// RemoveTagFollowRequest unfollows a tag for the current user.  Unfollowing a tag that isn't
+// followed has no effect.
+type RemoveTagFollowRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveTagFollowRequest) Reset()         { *m = RemoveTagFollowRequest{} }
+func (m *RemoveTagFollowRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveTagFollowRequest) ProtoMessage()    {}
+func (*RemoveTagFollowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{112}
+}
+
+func (m *RemoveTagFollowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveTagFollowRequest.Unmarshal(m, b)
+}
+func (m *RemoveTagFollowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveTagFollowRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveTagFollowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTagFollowRequest.Merge(m, src)
+}
+func (m *RemoveTagFollowRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveTagFollowRequest.Size(m)
+}
+func (m *RemoveTagFollowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTagFollowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTagFollowRequest proto.InternalMessageInfo
+
+func (m *RemoveTagFollowRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type RemoveTagFollowResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveTagFollowResponse) Reset()         { *m = RemoveTagFollowResponse{} }
+func (m *RemoveTagFollowResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveTagFollowResponse) ProtoMessage()    {}
+func (*RemoveTagFollowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{113}
+}
+
+func (m *RemoveTagFollowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveTagFollowResponse.Unmarshal(m, b)
+}
+func (m *RemoveTagFollowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveTagFollowResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveTagFollowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTagFollowResponse.Merge(m, src)
+}
+func (m *RemoveTagFollowResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveTagFollowResponse.Size(m)
+}
+func (m *RemoveTagFollowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTagFollowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTagFollowResponse proto.InternalMessageInfo
+
+// RemoveUserFollowRequest unfollows a user for the current user.  Unfollowing a user that isn't
+// followed has no effect.
+type RemoveUserFollowRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveUserFollowRequest) Reset()         { *m = RemoveUserFollowRequest{} }
+func (m *RemoveUserFollowRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveUserFollowRequest) ProtoMessage()    {}
+func (*RemoveUserFollowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{114}
+}
+
+func (m *RemoveUserFollowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveUserFollowRequest.Unmarshal(m, b)
+}
+func (m *RemoveUserFollowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveUserFollowRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveUserFollowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveUserFollowRequest.Merge(m, src)
+}
+func (m *RemoveUserFollowRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveUserFollowRequest.Size(m)
+}
+func (m *RemoveUserFollowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveUserFollowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveUserFollowRequest proto.InternalMessageInfo
+
+func (m *RemoveUserFollowRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type RemoveUserFollowResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveUserFollowResponse) Reset()         { *m = RemoveUserFollowResponse{} }
+func (m *RemoveUserFollowResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveUserFollowResponse) ProtoMessage()    {}
+func (*RemoveUserFollowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{115}
+}
+
+func (m *RemoveUserFollowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveUserFollowResponse.Unmarshal(m, b)
+}
+func (m *RemoveUserFollowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveUserFollowResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveUserFollowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveUserFollowResponse.Merge(m, src)
+}
+func (m *RemoveUserFollowResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveUserFollowResponse.Size(m)
+}
+func (m *RemoveUserFollowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveUserFollowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveUserFollowResponse proto.InternalMessageInfo
+
+// ReorderCollectionRequest changes the order of the pics in a collection.  Only the owner of the
+// collection may reorder it.
+type ReorderCollectionRequest struct {
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// pic_id is the new order of the pics.  Pics in the collection that aren't listed keep their
+	// relative order, after the listed pics.
+	PicId                []string `protobuf:"bytes,2,rep,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReorderCollectionRequest) Reset()         { *m = ReorderCollectionRequest{} }
+func (m *ReorderCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReorderCollectionRequest) ProtoMessage()    {}
+func (*ReorderCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{116}
+}
+
+func (m *ReorderCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReorderCollectionRequest.Unmarshal(m, b)
+}
+func (m *ReorderCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReorderCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *ReorderCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorderCollectionRequest.Merge(m, src)
+}
+func (m *ReorderCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReorderCollectionRequest.Size(m)
+}
+func (m *ReorderCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorderCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorderCollectionRequest proto.InternalMessageInfo
+
+func (m *ReorderCollectionRequest) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+func (m *ReorderCollectionRequest) GetPicId() []string {
+	if m != nil {
+		return m.PicId
+	}
+	return nil
+}
+
+type ReorderCollectionResponse struct {
+	Collection           *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReorderCollectionResponse) Reset()         { *m = ReorderCollectionResponse{} }
+func (m *ReorderCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*ReorderCollectionResponse) ProtoMessage()    {}
+func (*ReorderCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{117}
+}
+
+func (m *ReorderCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReorderCollectionResponse.Unmarshal(m, b)
+}
+func (m *ReorderCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReorderCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *ReorderCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorderCollectionResponse.Merge(m, src)
+}
+func (m *ReorderCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_ReorderCollectionResponse.Size(m)
+}
+func (m *ReorderCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorderCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorderCollectionResponse proto.InternalMessageInfo
+
+func (m *ReorderCollectionResponse) GetCollection() *Collection {
+	if m != nil {
+		return m.Collection
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	// session_id is the session to log out.  Required unless all is set.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// all logs out every session of the subject user, including the current one.
+	All                  bool     `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionRequest) Reset()         { *m = RevokeSessionRequest{} }
+func (m *RevokeSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionRequest) ProtoMessage()    {}
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{118}
+}
+
+func (m *RevokeSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionRequest.Unmarshal(m, b)
+}
+func (m *RevokeSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionRequest.Merge(m, src)
+}
+func (m *RevokeSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionRequest.Size(m)
+}
+func (m *RevokeSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionRequest proto.InternalMessageInfo
+
+func (m *RevokeSessionRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *RevokeSessionRequest) GetAll() bool {
+	if m != nil {
+		return m.All
+	}
+	return false
+}
+
+type RevokeSessionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionResponse) Reset()         { *m = RevokeSessionResponse{} }
+func (m *RevokeSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionResponse) ProtoMessage()    {}
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{119}
+}
+
+func (m *RevokeSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionResponse.Unmarshal(m, b)
+}
+func (m *RevokeSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionResponse.Merge(m, src)
+}
+func (m *RevokeSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionResponse.Size(m)
+}
+func (m *RevokeSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionResponse proto.InternalMessageInfo
+
+type SoftDeletePicRequest struct {
+	PicId                string               `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	Details              string               `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	Reason               DeletionReason       `protobuf:"varint,3,opt,name=reason,proto3,enum=pixur.api.DeletionReason" json:"reason,omitempty"`
+	DeletionTime         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SoftDeletePicRequest) Reset()         { *m = SoftDeletePicRequest{} }
+func (m *SoftDeletePicRequest) String() string { return proto.CompactTextString(m) }
+func (*SoftDeletePicRequest) ProtoMessage()    {}
+func (*SoftDeletePicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{120}
+}
+
+func (m *SoftDeletePicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SoftDeletePicRequest.Unmarshal(m, b)
+}
+func (m *SoftDeletePicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SoftDeletePicRequest.Marshal(b, m, deterministic)
+}
+func (m *SoftDeletePicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoftDeletePicRequest.Merge(m, src)
+}
+func (m *SoftDeletePicRequest) XXX_Size() int {
+	return xxx_messageInfo_SoftDeletePicRequest.Size(m)
+}
+func (m *SoftDeletePicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoftDeletePicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SoftDeletePicRequest proto.InternalMessageInfo
+
+func (m *SoftDeletePicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *SoftDeletePicRequest) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+func (m *SoftDeletePicRequest) GetReason() DeletionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return DeletionReason_UNKNOWN
+}
+
+func (m *SoftDeletePicRequest) GetDeletionTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeletionTime
+	}
+	return nil
+}
+
+type SoftDeletePicResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SoftDeletePicResponse) Reset()         { *m = SoftDeletePicResponse{} }
+func (m *SoftDeletePicResponse) String() string { return proto.CompactTextString(m) }
+func (*SoftDeletePicResponse) ProtoMessage()    {}
+func (*SoftDeletePicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{121}
+}
+
+func (m *SoftDeletePicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SoftDeletePicResponse.Unmarshal(m, b)
+}
+func (m *SoftDeletePicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SoftDeletePicResponse.Marshal(b, m, deterministic)
+}
+func (m *SoftDeletePicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoftDeletePicResponse.Merge(m, src)
+}
+func (m *SoftDeletePicResponse) XXX_Size() int {
+	return xxx_messageInfo_SoftDeletePicResponse.Size(m)
+}
+func (m *SoftDeletePicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoftDeletePicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SoftDeletePicResponse proto.InternalMessageInfo
+
+type StartTotpEnrollmentRequest struct {
+	// secret is the current secret of the user.
+	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartTotpEnrollmentRequest) Reset()         { *m = StartTotpEnrollmentRequest{} }
+func (m *StartTotpEnrollmentRequest) String() string { return proto.CompactTextString(m) }
+func (*StartTotpEnrollmentRequest) ProtoMessage()    {}
+func (*StartTotpEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{122}
+}
+
+func (m *StartTotpEnrollmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartTotpEnrollmentRequest.Unmarshal(m, b)
+}
+func (m *StartTotpEnrollmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartTotpEnrollmentRequest.Marshal(b, m, deterministic)
+}
+func (m *StartTotpEnrollmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartTotpEnrollmentRequest.Merge(m, src)
+}
+func (m *StartTotpEnrollmentRequest) XXX_Size() int {
+	return xxx_messageInfo_StartTotpEnrollmentRequest.Size(m)
+}
+func (m *StartTotpEnrollmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartTotpEnrollmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartTotpEnrollmentRequest proto.InternalMessageInfo
+
+func (m *StartTotpEnrollmentRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type StartTotpEnrollmentResponse struct {
+	// secret is the base32 encoded shared secret to enter into an authenticator app.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// key_uri is an otpauth:// URI containing the secret, suitable for a QR code.
+	KeyUri               string   `protobuf:"bytes,2,opt,name=key_uri,json=keyUri,proto3" json:"key_uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartTotpEnrollmentResponse) Reset()         { *m = StartTotpEnrollmentResponse{} }
+func (m *StartTotpEnrollmentResponse) String() string { return proto.CompactTextString(m) }
+func (*StartTotpEnrollmentResponse) ProtoMessage()    {}
+func (*StartTotpEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{123}
+}
+
+func (m *StartTotpEnrollmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartTotpEnrollmentResponse.Unmarshal(m, b)
+}
+func (m *StartTotpEnrollmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartTotpEnrollmentResponse.Marshal(b, m, deterministic)
+}
+func (m *StartTotpEnrollmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartTotpEnrollmentResponse.Merge(m, src)
+}
+func (m *StartTotpEnrollmentResponse) XXX_Size() int {
+	return xxx_messageInfo_StartTotpEnrollmentResponse.Size(m)
+}
+func (m *StartTotpEnrollmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartTotpEnrollmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartTotpEnrollmentResponse proto.InternalMessageInfo
+
+func (m *StartTotpEnrollmentResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *StartTotpEnrollmentResponse) GetKeyUri() string {
+	if m != nil {
+		return m.KeyUri
+	}
+	return ""
+}
+
+// StartUploadSessionRequest begins an upload of a pic in multiple parts.  This allows large pics
+// to be resumed if the connection is interrupted.  The fields are the same as UpsertPicRequest,
+// except that the data is sent using AppendUploadSession.  Only logged in users may start
+// sessions.
+type StartUploadSessionRequest struct {
+	// url is optional metadata for where the pic came from.  It is not fetched.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// referrer is the optional referrer url of the pic.  May not be used if url
+	// is not set.
+	Referrer string `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// name is an optional field indicating a reasonable file name for the pic.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// md5_hash is the hash of the complete pic contents.  It is required, and is
+	// used to verify the pic when the session is finished.
+	Md5Hash []byte `protobuf:"bytes,4,opt,name=md5_hash,json=md5Hash,proto3" json:"md5_hash,omitempty"`
+	// Optional extension data.  You must have the correct permissions to set this.
+	Ext                  map[string]*any.Any `protobuf:"bytes,5,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *StartUploadSessionRequest) Reset()         { *m = StartUploadSessionRequest{} }
+func (m *StartUploadSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*StartUploadSessionRequest) ProtoMessage()    {}
+func (*StartUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{124}
+}
+
+func (m *StartUploadSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartUploadSessionRequest.Unmarshal(m, b)
+}
+func (m *StartUploadSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartUploadSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *StartUploadSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartUploadSessionRequest.Merge(m, src)
+}
+func (m *StartUploadSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_StartUploadSessionRequest.Size(m)
+}
+func (m *StartUploadSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartUploadSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartUploadSessionRequest proto.InternalMessageInfo
+
+func (m *StartUploadSessionRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *StartUploadSessionRequest) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *StartUploadSessionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StartUploadSessionRequest) GetMd5Hash() []byte {
+	if m != nil {
+		return m.Md5Hash
+	}
+	return nil
+}
+
+func (m *StartUploadSessionRequest) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+type StartUploadSessionResponse struct {
+	UploadSession        *UploadSession `protobuf:"bytes,1,opt,name=upload_session,json=uploadSession,proto3" json:"upload_session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StartUploadSessionResponse) Reset()         { *m = StartUploadSessionResponse{} }
+func (m *StartUploadSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*StartUploadSessionResponse) ProtoMessage()    {}
+func (*StartUploadSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{125}
+}
+
+func (m *StartUploadSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartUploadSessionResponse.Unmarshal(m, b)
+}
+func (m *StartUploadSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartUploadSessionResponse.Marshal(b, m, deterministic)
+}
+func (m *StartUploadSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartUploadSessionResponse.Merge(m, src)
+}
+func (m *StartUploadSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_StartUploadSessionResponse.Size(m)
+}
+func (m *StartUploadSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartUploadSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartUploadSessionResponse proto.InternalMessageInfo
+
+func (m *StartUploadSessionResponse) GetUploadSession() *UploadSession {
+	if m != nil {
+		return m.UploadSession
+	}
+	return nil
+}
+
+// StartUserSecretResetRequest sends a single use, expiring reset token to the user with the given
+// ident.  The response is the same whether or not the ident exists.
+type StartUserSecretResetRequest struct {
+	Ident                string   `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartUserSecretResetRequest) Reset()         { *m = StartUserSecretResetRequest{} }
+func (m *StartUserSecretResetRequest) String() string { return proto.CompactTextString(m) }
+func (*StartUserSecretResetRequest) ProtoMessage()    {}
+func (*StartUserSecretResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{126}
+}
+
+func (m *StartUserSecretResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartUserSecretResetRequest.Unmarshal(m, b)
+}
+func (m *StartUserSecretResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartUserSecretResetRequest.Marshal(b, m, deterministic)
+}
+func (m *StartUserSecretResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartUserSecretResetRequest.Merge(m, src)
+}
+func (m *StartUserSecretResetRequest) XXX_Size() int {
+	return xxx_messageInfo_StartUserSecretResetRequest.Size(m)
+}
+func (m *StartUserSecretResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartUserSecretResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartUserSecretResetRequest proto.InternalMessageInfo
+
+func (m *StartUserSecretResetRequest) GetIdent() string {
+	if m != nil {
+		return m.Ident
+	}
+	return ""
+}
+
+type StartUserSecretResetResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartUserSecretResetResponse) Reset()         { *m = StartUserSecretResetResponse{} }
+func (m *StartUserSecretResetResponse) String() string { return proto.CompactTextString(m) }
+func (*StartUserSecretResetResponse) ProtoMessage()    {}
+func (*StartUserSecretResetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{127}
+}
+
+func (m *StartUserSecretResetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartUserSecretResetResponse.Unmarshal(m, b)
+}
+func (m *StartUserSecretResetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartUserSecretResetResponse.Marshal(b, m, deterministic)
+}
+func (m *StartUserSecretResetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartUserSecretResetResponse.Merge(m, src)
+}
+func (m *StartUserSecretResetResponse) XXX_Size() int {
+	return xxx_messageInfo_StartUserSecretResetResponse.Size(m)
+}
+func (m *StartUserSecretResetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartUserSecretResetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartUserSecretResetResponse proto.InternalMessageInfo
+
+// SuspendUserRequest suspends a user, leaving them only able to read.  Requires the USER_SUSPEND
+// capability.  Suspending an already suspended user replaces the suspension.
+type SuspendUserRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// reason is why the user was suspended.  It is shown to the user.  Required.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// duration is how long the suspension lasts.  If absent, it lasts until the user is
+	// unsuspended.
+	Duration             *duration.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SuspendUserRequest) Reset()         { *m = SuspendUserRequest{} }
+func (m *SuspendUserRequest) String() string { return proto.CompactTextString(m) }
+func (*SuspendUserRequest) ProtoMessage()    {}
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{128}
+}
+
+func (m *SuspendUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendUserRequest.Unmarshal(m, b)
+}
+func (m *SuspendUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendUserRequest.Marshal(b, m, deterministic)
+}
+func (m *SuspendUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendUserRequest.Merge(m, src)
+}
+func (m *SuspendUserRequest) XXX_Size() int {
+	return xxx_messageInfo_SuspendUserRequest.Size(m)
+}
+func (m *SuspendUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendUserRequest proto.InternalMessageInfo
+
+func (m *SuspendUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *SuspendUserRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SuspendUserRequest) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+type SuspendUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuspendUserResponse) Reset()         { *m = SuspendUserResponse{} }
+func (m *SuspendUserResponse) String() string { return proto.CompactTextString(m) }
+func (*SuspendUserResponse) ProtoMessage()    {}
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{129}
+}
+
+func (m *SuspendUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendUserResponse.Unmarshal(m, b)
+}
+func (m *SuspendUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendUserResponse.Marshal(b, m, deterministic)
+}
+func (m *SuspendUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendUserResponse.Merge(m, src)
+}
+func (m *SuspendUserResponse) XXX_Size() int {
+	return xxx_messageInfo_SuspendUserResponse.Size(m)
+}
+func (m *SuspendUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendUserResponse proto.InternalMessageInfo
+
+func (m *SuspendUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type UnlockLoginRequest struct {
+	// The user whose logins to unlock.  Optional.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The address whose logins to unlock.  Optional.
+	RemoteAddr           string   `protobuf:"bytes,2,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockLoginRequest) Reset()         { *m = UnlockLoginRequest{} }
+func (m *UnlockLoginRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockLoginRequest) ProtoMessage()    {}
+func (*UnlockLoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{130}
+}
+
+func (m *UnlockLoginRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockLoginRequest.Unmarshal(m, b)
+}
+func (m *UnlockLoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockLoginRequest.Marshal(b, m, deterministic)
+}
+func (m *UnlockLoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockLoginRequest.Merge(m, src)
+}
+func (m *UnlockLoginRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockLoginRequest.Size(m)
+}
+func (m *UnlockLoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockLoginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockLoginRequest proto.InternalMessageInfo
+
+func (m *UnlockLoginRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UnlockLoginRequest) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
+}
+
+type UnlockLoginResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockLoginResponse) Reset()         { *m = UnlockLoginResponse{} }
+func (m *UnlockLoginResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockLoginResponse) ProtoMessage()    {}
+func (*UnlockLoginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{131}
+}
+
+func (m *UnlockLoginResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockLoginResponse.Unmarshal(m, b)
+}
+func (m *UnlockLoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockLoginResponse.Marshal(b, m, deterministic)
+}
+func (m *UnlockLoginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockLoginResponse.Merge(m, src)
+}
+func (m *UnlockLoginResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockLoginResponse.Size(m)
+}
+func (m *UnlockLoginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockLoginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockLoginResponse proto.InternalMessageInfo
+
+// UnsuspendUserRequest ends the suspension of a user.  Requires the USER_SUSPEND capability.
+type UnsuspendUserRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsuspendUserRequest) Reset()         { *m = UnsuspendUserRequest{} }
+func (m *UnsuspendUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsuspendUserRequest) ProtoMessage()    {}
+func (*UnsuspendUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{132}
+}
+
+func (m *UnsuspendUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsuspendUserRequest.Unmarshal(m, b)
+}
+func (m *UnsuspendUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsuspendUserRequest.Marshal(b, m, deterministic)
+}
+func (m *UnsuspendUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsuspendUserRequest.Merge(m, src)
+}
+func (m *UnsuspendUserRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsuspendUserRequest.Size(m)
+}
+func (m *UnsuspendUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsuspendUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsuspendUserRequest proto.InternalMessageInfo
+
+func (m *UnsuspendUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type UnsuspendUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsuspendUserResponse) Reset()         { *m = UnsuspendUserResponse{} }
+func (m *UnsuspendUserResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsuspendUserResponse) ProtoMessage()    {}
+func (*UnsuspendUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{133}
+}
+
+func (m *UnsuspendUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsuspendUserResponse.Unmarshal(m, b)
+}
+func (m *UnsuspendUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsuspendUserResponse.Marshal(b, m, deterministic)
+}
+func (m *UnsuspendUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsuspendUserResponse.Merge(m, src)
+}
+func (m *UnsuspendUserResponse) XXX_Size() int {
+	return xxx_messageInfo_UnsuspendUserResponse.Size(m)
+}
+func (m *UnsuspendUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsuspendUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsuspendUserResponse proto.InternalMessageInfo
+
+func (m *UnsuspendUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+// UpdateCollectionRequest changes the name or visibility of a collection.
+type UpdateCollectionRequest struct {
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// version is the version of the collection being updated.
+	Version int64 `protobuf:"fixed64,2,opt,name=version,proto3" json:"version,omitempty"`
+	// name is the new name of the collection.  Required.
+	Name                 string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Visibility           Collection_Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=pixur.api.Collection_Visibility" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateCollectionRequest) Reset()         { *m = UpdateCollectionRequest{} }
+func (m *UpdateCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCollectionRequest) ProtoMessage()    {}
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{134}
+}
+
+func (m *UpdateCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCollectionRequest.Unmarshal(m, b)
+}
+func (m *UpdateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCollectionRequest.Merge(m, src)
+}
+func (m *UpdateCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCollectionRequest.Size(m)
+}
+func (m *UpdateCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCollectionRequest proto.InternalMessageInfo
+
+func (m *UpdateCollectionRequest) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+func (m *UpdateCollectionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpdateCollectionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateCollectionRequest) GetVisibility() Collection_Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return Collection_UNKNOWN
+}
+
+type UpdateCollectionResponse struct {
+	Collection           *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *UpdateCollectionResponse) Reset()         { *m = UpdateCollectionResponse{} }
+func (m *UpdateCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateCollectionResponse) ProtoMessage()    {}
+func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{135}
+}
+
+func (m *UpdateCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCollectionResponse.Unmarshal(m, b)
+}
+func (m *UpdateCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCollectionResponse.Merge(m, src)
+}
+func (m *UpdateCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateCollectionResponse.Size(m)
+}
+func (m *UpdateCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCollectionResponse proto.InternalMessageInfo
+
+func (m *UpdateCollectionResponse) GetCollection() *Collection {
+	if m != nil {
+		return m.Collection
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	UserId     string                              `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Version    int64                               `protobuf:"fixed64,2,opt,name=version,proto3" json:"version,omitempty"`
+	Ident      *UpdateUserRequest_ChangeIdent      `protobuf:"bytes,3,opt,name=ident,proto3" json:"ident,omitempty"`
+	Secret     *UpdateUserRequest_ChangeSecret     `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Capability *UpdateUserRequest_ChangeCapability `protobuf:"bytes,5,opt,name=capability,proto3" json:"capability,omitempty"`
+	Role       *UpdateUserRequest_ChangeRole       `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	Profile    *UpdateUserRequest_ChangeProfile    `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+	// reason is why the capabilities or roles are changed.  It is recorded in the audit log.
+	Reason               string   `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
+func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest) ProtoMessage()    {}
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{136}
+}
+
+func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest.Merge(m, src)
+}
+func (m *UpdateUserRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest.Size(m)
+}
+func (m *UpdateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest proto.InternalMessageInfo
+
+func (m *UpdateUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UpdateUserRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpdateUserRequest) GetIdent() *UpdateUserRequest_ChangeIdent {
+	if m != nil {
+		return m.Ident
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest) GetSecret() *UpdateUserRequest_ChangeSecret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest) GetCapability() *UpdateUserRequest_ChangeCapability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest) GetRole() *UpdateUserRequest_ChangeRole {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest) GetProfile() *UpdateUserRequest_ChangeProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type UpdateUserRequest_ChangeIdent struct {
+	Ident                string   `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserRequest_ChangeIdent) Reset()         { *m = UpdateUserRequest_ChangeIdent{} }
+func (m *UpdateUserRequest_ChangeIdent) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest_ChangeIdent) ProtoMessage()    {}
+func (*UpdateUserRequest_ChangeIdent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{136, 0}
+}
+
+func (m *UpdateUserRequest_ChangeIdent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest_ChangeIdent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest_ChangeIdent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest_ChangeIdent.Merge(m, src)
+}
+func (m *UpdateUserRequest_ChangeIdent) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Size(m)
+}
+func (m *UpdateUserRequest_ChangeIdent) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest_ChangeIdent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest_ChangeIdent proto.InternalMessageInfo
+
+func (m *UpdateUserRequest_ChangeIdent) GetIdent() string {
+	if m != nil {
+		return m.Ident
+	}
+	return ""
+}
+
+type UpdateUserRequest_ChangeSecret struct {
+	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserRequest_ChangeSecret) Reset()         { *m = UpdateUserRequest_ChangeSecret{} }
+func (m *UpdateUserRequest_ChangeSecret) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest_ChangeSecret) ProtoMessage()    {}
+func (*UpdateUserRequest_ChangeSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{136, 1}
+}
+
+func (m *UpdateUserRequest_ChangeSecret) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest_ChangeSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest_ChangeSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest_ChangeSecret.Merge(m, src)
+}
+func (m *UpdateUserRequest_ChangeSecret) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Size(m)
+}
+func (m *UpdateUserRequest_ChangeSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest_ChangeSecret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest_ChangeSecret proto.InternalMessageInfo
+
+func (m *UpdateUserRequest_ChangeSecret) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type UpdateUserRequest_ChangeCapability struct {
+	SetCapability        []Capability_Cap `protobuf:"varint,1,rep,packed,name=set_capability,json=setCapability,proto3,enum=pixur.api.Capability_Cap" json:"set_capability,omitempty"`
+	ClearCapability      []Capability_Cap `protobuf:"varint,2,rep,packed,name=clear_capability,json=clearCapability,proto3,enum=pixur.api.Capability_Cap" json:"clear_capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateUserRequest_ChangeCapability) Reset()         { *m = UpdateUserRequest_ChangeCapability{} }
+func (m *UpdateUserRequest_ChangeCapability) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest_ChangeCapability) ProtoMessage()    {}
+func (*UpdateUserRequest_ChangeCapability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{136, 2}
+}
+
+func (m *UpdateUserRequest_ChangeCapability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest_ChangeCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest_ChangeCapability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest_ChangeCapability.Merge(m, src)
+}
+func (m *UpdateUserRequest_ChangeCapability) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Size(m)
+}
+func (m *UpdateUserRequest_ChangeCapability) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest_ChangeCapability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest_ChangeCapability proto.InternalMessageInfo
+
+func (m *UpdateUserRequest_ChangeCapability) GetSetCapability() []Capability_Cap {
+	if m != nil {
+		return m.SetCapability
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest_ChangeCapability) GetClearCapability() []Capability_Cap {
+	if m != nil {
+		return m.ClearCapability
+	}
+	return nil
+}
+
+type UpdateUserRequest_ChangeRole struct {
+	SetRole              []string `protobuf:"bytes,1,rep,name=set_role,json=setRole,proto3" json:"set_role,omitempty"`
+	ClearRole            []string `protobuf:"bytes,2,rep,name=clear_role,json=clearRole,proto3" json:"clear_role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserRequest_ChangeRole) Reset()         { *m = UpdateUserRequest_ChangeRole{} }
+func (m *UpdateUserRequest_ChangeRole) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest_ChangeRole) ProtoMessage()    {}
+func (*UpdateUserRequest_ChangeRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{136, 3}
+}
+
+func (m *UpdateUserRequest_ChangeRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest_ChangeRole.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest_ChangeRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest_ChangeRole.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest_ChangeRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest_ChangeRole.Merge(m, src)
+}
+func (m *UpdateUserRequest_ChangeRole) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest_ChangeRole.Size(m)
+}
+func (m *UpdateUserRequest_ChangeRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest_ChangeRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest_ChangeRole proto.InternalMessageInfo
+
+func (m *UpdateUserRequest_ChangeRole) GetSetRole() []string {
+	if m != nil {
+		return m.SetRole
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest_ChangeRole) GetClearRole() []string {
+	if m != nil {
+		return m.ClearRole
+	}
+	return nil
+}
+
+// ChangeProfile replaces the profile of the user.  Users may change their own profile with the
+// USER_UPDATE_PROFILE capability, or others' with USER_UPDATE_CAPABILITY.
+type UpdateUserRequest_ChangeProfile struct {
+	Profile              *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *UpdateUserRequest_ChangeProfile) Reset()         { *m = UpdateUserRequest_ChangeProfile{} }
+func (m *UpdateUserRequest_ChangeProfile) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest_ChangeProfile) ProtoMessage()    {}
+func (*UpdateUserRequest_ChangeProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{136, 4}
+}
+
+func (m *UpdateUserRequest_ChangeProfile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest_ChangeProfile.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest_ChangeProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest_ChangeProfile.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest_ChangeProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest_ChangeProfile.Merge(m, src)
+}
+func (m *UpdateUserRequest_ChangeProfile) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest_ChangeProfile.Size(m)
+}
+func (m *UpdateUserRequest_ChangeProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest_ChangeProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest_ChangeProfile proto.InternalMessageInfo
+
+func (m *UpdateUserRequest_ChangeProfile) GetProfile() *UserProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type UpdateUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserResponse) Reset()         { *m = UpdateUserResponse{} }
+func (m *UpdateUserResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserResponse) ProtoMessage()    {}
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{137}
+}
+
+func (m *UpdateUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserResponse.Unmarshal(m, b)
+}
+func (m *UpdateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserResponse.Merge(m, src)
+}
+func (m *UpdateUserResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserResponse.Size(m)
+}
+func (m *UpdateUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserResponse proto.InternalMessageInfo
+
+func (m *UpdateUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+// UpdateUserSecretRequest changes the secret of the current user.  Other auth tokens of the user
+// are revoked.
+type UpdateUserSecretRequest struct {
+	// secret is the current secret of the user.
+	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	NewSecret            string   `protobuf:"bytes,2,opt,name=new_secret,json=newSecret,proto3" json:"new_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserSecretRequest) Reset()         { *m = UpdateUserSecretRequest{} }
+func (m *UpdateUserSecretRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserSecretRequest) ProtoMessage()    {}
+func (*UpdateUserSecretRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{138}
+}
+
+func (m *UpdateUserSecretRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserSecretRequest.Unmarshal(m, b)
+}
+func (m *UpdateUserSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserSecretRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserSecretRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserSecretRequest.Merge(m, src)
+}
+func (m *UpdateUserSecretRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserSecretRequest.Size(m)
+}
+func (m *UpdateUserSecretRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserSecretRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserSecretRequest proto.InternalMessageInfo
+
+func (m *UpdateUserSecretRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *UpdateUserSecretRequest) GetNewSecret() string {
+	if m != nil {
+		return m.NewSecret
+	}
+	return ""
+}
+
+type UpdateUserSecretResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserSecretResponse) Reset()         { *m = UpdateUserSecretResponse{} }
+func (m *UpdateUserSecretResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserSecretResponse) ProtoMessage()    {}
+func (*UpdateUserSecretResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{139}
+}
+
+func (m *UpdateUserSecretResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserSecretResponse.Unmarshal(m, b)
+}
+func (m *UpdateUserSecretResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserSecretResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserSecretResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserSecretResponse.Merge(m, src)
+}
+func (m *UpdateUserSecretResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserSecretResponse.Size(m)
+}
+func (m *UpdateUserSecretResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserSecretResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserSecretResponse proto.InternalMessageInfo
+
+type UpsertPicCommentVoteRequest struct {
+	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// Optional.   Not necessary when creating for the first time.
+	Version              int64               `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
+	Vote                 PicCommentVote_Vote `protobuf:"varint,4,opt,name=vote,proto3,enum=pixur.api.PicCommentVote_Vote" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UpsertPicCommentVoteRequest) Reset()         { *m = UpsertPicCommentVoteRequest{} }
+func (m *UpsertPicCommentVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicCommentVoteRequest) ProtoMessage()    {}
+func (*UpsertPicCommentVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{140}
+}
+
+func (m *UpsertPicCommentVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicCommentVoteRequest.Unmarshal(m, b)
+}
+func (m *UpsertPicCommentVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicCommentVoteRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicCommentVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicCommentVoteRequest.Merge(m, src)
+}
+func (m *UpsertPicCommentVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicCommentVoteRequest.Size(m)
+}
+func (m *UpsertPicCommentVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicCommentVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicCommentVoteRequest proto.InternalMessageInfo
+
+func (m *UpsertPicCommentVoteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *UpsertPicCommentVoteRequest) GetCommentId() string {
+	if m != nil {
+		return m.CommentId
+	}
+	return ""
+}
+
+func (m *UpsertPicCommentVoteRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpsertPicCommentVoteRequest) GetVote() PicCommentVote_Vote {
+	if m != nil {
+		return m.Vote
+	}
+	return PicCommentVote_UNKNOWN
+}
+
+type UpsertPicCommentVoteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertPicCommentVoteResponse) Reset()         { *m = UpsertPicCommentVoteResponse{} }
+func (m *UpsertPicCommentVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicCommentVoteResponse) ProtoMessage()    {}
+func (*UpsertPicCommentVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{141}
+}
+
+func (m *UpsertPicCommentVoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicCommentVoteResponse.Unmarshal(m, b)
+}
+func (m *UpsertPicCommentVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicCommentVoteResponse.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicCommentVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicCommentVoteResponse.Merge(m, src)
+}
+func (m *UpsertPicCommentVoteResponse) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicCommentVoteResponse.Size(m)
+}
+func (m *UpsertPicCommentVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicCommentVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicCommentVoteResponse proto.InternalMessageInfo
+
+// UpsertPicRequest is a request to upload and possibly merge with another
+// picture.
+type UpsertPicRequest struct {
+	// url is an optional field for the Pixur backend to download the pic from.
+	// Either url and/or data must be set.   If the data field is set along
+	// with the URL, the url is treated as regular metadata and not fetched.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// referrer is the referrer url used to fetch the resource.  If no data is
+	// set, the url will be used to retrieve the data, along with the referrer
+	// being set in the request.  If data is set, referrer is treated as
+	// additional metadata.  May not be used if url is not set.
+	Referrer string `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// name is an optional field indicating a reasonable file name for the pic.
+	// If name and url are set, the name field will override the name
+	// automatically derived from the URL.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// data is the file contents of the upload.  It must contain a valid pic
+	// if present.  It may be left absent if there is a valid url instead.  This
+	// field takes precedence over the url field if both are specified.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// md5_hash is the hash of the pic contents.  It is used to verify the pic
+	// is valid when downloading from url, or when set in data.  If the Pixur
+	// backend already has a pic with a matching md5_hash, it may not use
+	// either data or url (at least one must still be specified, though).
+	Md5Hash []byte `protobuf:"bytes,4,opt,name=md5_hash,json=md5Hash,proto3" json:"md5_hash,omitempty"`
+	// Optional extension data.  You must have the correct permissions to set this.
+	Ext                  map[string]*any.Any `protobuf:"bytes,6,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UpsertPicRequest) Reset()         { *m = UpsertPicRequest{} }
+func (m *UpsertPicRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicRequest) ProtoMessage()    {}
+func (*UpsertPicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{142}
+}
+
+func (m *UpsertPicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicRequest.Unmarshal(m, b)
+}
+func (m *UpsertPicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicRequest.Merge(m, src)
+}
+func (m *UpsertPicRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicRequest.Size(m)
+}
+func (m *UpsertPicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicRequest proto.InternalMessageInfo
+
+func (m *UpsertPicRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *UpsertPicRequest) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *UpsertPicRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpsertPicRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UpsertPicRequest) GetMd5Hash() []byte {
+	if m != nil {
+		return m.Md5Hash
+	}
+	return nil
+}
+
+func (m *UpsertPicRequest) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+type UpsertPicResponse struct {
+	// pic is the newly created or updated picture.
+	Pic                  *Pic     `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertPicResponse) Reset()         { *m = UpsertPicResponse{} }
+func (m *UpsertPicResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicResponse) ProtoMessage()    {}
+func (*UpsertPicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{143}
+}
+
+func (m *UpsertPicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicResponse.Unmarshal(m, b)
+}
+func (m *UpsertPicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicResponse.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicResponse.Merge(m, src)
+}
+func (m *UpsertPicResponse) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicResponse.Size(m)
+}
+func (m *UpsertPicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicResponse proto.InternalMessageInfo
+
+func (m *UpsertPicResponse) GetPic() *Pic {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+// UpsertPicStreamRequest is one message of a client streamed pic upload.  The
+// first message must contain the metadata, and every following message
+// contains the next chunk of the pic data.  Unlike UpsertPicRequest, the pic
+// data must be sent; the url is only used as metadata.
+type UpsertPicStreamRequest struct {
+	// Types that are valid to be assigned to Part:
+	//	*UpsertPicStreamRequest_Metadata_
+	//	*UpsertPicStreamRequest_Chunk
+	Part                 isUpsertPicStreamRequest_Part `protobuf_oneof:"part"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *UpsertPicStreamRequest) Reset()         { *m = UpsertPicStreamRequest{} }
+func (m *UpsertPicStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicStreamRequest) ProtoMessage()    {}
+func (*UpsertPicStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{144}
+}
+
+func (m *UpsertPicStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicStreamRequest.Unmarshal(m, b)
+}
+func (m *UpsertPicStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicStreamRequest.Merge(m, src)
+}
+func (m *UpsertPicStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicStreamRequest.Size(m)
+}
+func (m *UpsertPicStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicStreamRequest proto.InternalMessageInfo
+
+type isUpsertPicStreamRequest_Part interface {
+	isUpsertPicStreamRequest_Part()
+}
+
+type UpsertPicStreamRequest_Metadata_ struct {
+	Metadata *UpsertPicStreamRequest_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UpsertPicStreamRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UpsertPicStreamRequest_Metadata_) isUpsertPicStreamRequest_Part() {}
+
+func (*UpsertPicStreamRequest_Chunk) isUpsertPicStreamRequest_Part() {}
+
+func (m *UpsertPicStreamRequest) GetPart() isUpsertPicStreamRequest_Part {
+	if m != nil {
+		return m.Part
+	}
+	return nil
+}
+
+func (m *UpsertPicStreamRequest) GetMetadata() *UpsertPicStreamRequest_Metadata {
+	if x, ok := m.GetPart().(*UpsertPicStreamRequest_Metadata_); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (m *UpsertPicStreamRequest) GetChunk() []byte {
+	if x, ok := m.GetPart().(*UpsertPicStreamRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*UpsertPicStreamRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*UpsertPicStreamRequest_Metadata_)(nil),
+		(*UpsertPicStreamRequest_Chunk)(nil),
+	}
+}
+
+type UpsertPicStreamRequest_Metadata struct {
+	// url is optional metadata for where the pic came from.  It is not fetched.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// referrer is the optional referrer url of the pic.  May not be used if url
+	// is not set.
+	Referrer string `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// name is an optional field indicating a reasonable file name for the pic.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// md5_hash is the hash of the complete pic contents.  It is required, and
+	// is used to verify the stream was received completely.
+	Md5Hash []byte `protobuf:"bytes,4,opt,name=md5_hash,json=md5Hash,proto3" json:"md5_hash,omitempty"`
+	// Optional extension data.  You must have the correct permissions to set this.
+	Ext                  map[string]*any.Any `protobuf:"bytes,5,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UpsertPicStreamRequest_Metadata) Reset()         { *m = UpsertPicStreamRequest_Metadata{} }
+func (m *UpsertPicStreamRequest_Metadata) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicStreamRequest_Metadata) ProtoMessage()    {}
+func (*UpsertPicStreamRequest_Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{144, 0}
+}
+
+func (m *UpsertPicStreamRequest_Metadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicStreamRequest_Metadata.Unmarshal(m, b)
+}
+func (m *UpsertPicStreamRequest_Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicStreamRequest_Metadata.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicStreamRequest_Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicStreamRequest_Metadata.Merge(m, src)
+}
+func (m *UpsertPicStreamRequest_Metadata) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicStreamRequest_Metadata.Size(m)
+}
+func (m *UpsertPicStreamRequest_Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicStreamRequest_Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicStreamRequest_Metadata proto.InternalMessageInfo
+
+func (m *UpsertPicStreamRequest_Metadata) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *UpsertPicStreamRequest_Metadata) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *UpsertPicStreamRequest_Metadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpsertPicStreamRequest_Metadata) GetMd5Hash() []byte {
+	if m != nil {
+		return m.Md5Hash
+	}
+	return nil
+}
+
+func (m *UpsertPicStreamRequest_Metadata) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+type UpsertPicStreamResponse struct {
+	// pic is the newly created or updated picture.
+	Pic                  *Pic     `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertPicStreamResponse) Reset()         { *m = UpsertPicStreamResponse{} }
+func (m *UpsertPicStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicStreamResponse) ProtoMessage()    {}
+func (*UpsertPicStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{145}
+}
+
+func (m *UpsertPicStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicStreamResponse.Unmarshal(m, b)
+}
+func (m *UpsertPicStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicStreamResponse.Merge(m, src)
+}
+func (m *UpsertPicStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicStreamResponse.Size(m)
+}
+func (m *UpsertPicStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicStreamResponse proto.InternalMessageInfo
+
+func (m *UpsertPicStreamResponse) GetPic() *Pic {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+type UpsertPicVoteRequest struct {
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// Optional.   Not necessary when creating for the first time.
+	Version              int64        `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
+	Vote                 PicVote_Vote `protobuf:"varint,2,opt,name=vote,proto3,enum=pixur.api.PicVote_Vote" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *UpsertPicVoteRequest) Reset()         { *m = UpsertPicVoteRequest{} }
+func (m *UpsertPicVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicVoteRequest) ProtoMessage()    {}
+func (*UpsertPicVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{146}
+}
+
+func (m *UpsertPicVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicVoteRequest.Unmarshal(m, b)
+}
+func (m *UpsertPicVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicVoteRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicVoteRequest.Merge(m, src)
+}
+func (m *UpsertPicVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicVoteRequest.Size(m)
+}
+func (m *UpsertPicVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicVoteRequest proto.InternalMessageInfo
+
+func (m *UpsertPicVoteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *UpsertPicVoteRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpsertPicVoteRequest) GetVote() PicVote_Vote {
+	if m != nil {
+		return m.Vote
+	}
+	return PicVote_UNKNOWN
+}
+
+type UpsertPicVoteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertPicVoteResponse) Reset()         { *m = UpsertPicVoteResponse{} }
+func (m *UpsertPicVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicVoteResponse) ProtoMessage()    {}
+func (*UpsertPicVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{147}
+}
+
+func (m *UpsertPicVoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicVoteResponse.Unmarshal(m, b)
+}
+func (m *UpsertPicVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicVoteResponse.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicVoteResponse.Merge(m, src)
+}
+func (m *UpsertPicVoteResponse) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicVoteResponse.Size(m)
+}
+func (m *UpsertPicVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicVoteResponse proto.InternalMessageInfo
+
+type WatchBackendConfigurationRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchBackendConfigurationRequest) Reset()         { *m = WatchBackendConfigurationRequest{} }
+func (m *WatchBackendConfigurationRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchBackendConfigurationRequest) ProtoMessage()    {}
+func (*WatchBackendConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{148}
+}
+
+func (m *WatchBackendConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchBackendConfigurationRequest.Unmarshal(m, b)
+}
+func (m *WatchBackendConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchBackendConfigurationRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchBackendConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBackendConfigurationRequest.Merge(m, src)
+}
+func (m *WatchBackendConfigurationRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchBackendConfigurationRequest.Size(m)
+}
+func (m *WatchBackendConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBackendConfigurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBackendConfigurationRequest proto.InternalMessageInfo
+
+type WatchBackendConfigurationResponse struct {
+	Version              int64                 `protobuf:"fixed64,1,opt,name=version,proto3" json:"version,omitempty"`
+	BackendConfiguration *BackendConfiguration `protobuf:"bytes,2,opt,name=backend_configuration,json=backendConfiguration,proto3" json:"backend_configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WatchBackendConfigurationResponse) Reset()         { *m = WatchBackendConfigurationResponse{} }
+func (m *WatchBackendConfigurationResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchBackendConfigurationResponse) ProtoMessage()    {}
+func (*WatchBackendConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{149}
+}
+
+func (m *WatchBackendConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchBackendConfigurationResponse.Unmarshal(m, b)
+}
+func (m *WatchBackendConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchBackendConfigurationResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchBackendConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBackendConfigurationResponse.Merge(m, src)
+}
+func (m *WatchBackendConfigurationResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchBackendConfigurationResponse.Size(m)
+}
+func (m *WatchBackendConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBackendConfigurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBackendConfigurationResponse proto.InternalMessageInfo
+
+func (m *WatchBackendConfigurationResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *WatchBackendConfigurationResponse) GetBackendConfiguration() *BackendConfiguration {
+	if m != nil {
+		return m.BackendConfiguration
+	}
+	return nil
+}
+
+type ServiceOpts struct {
+	// a vaguely defined, but monotonically increasing "version".
+	ApiVersion int64 `protobuf:"varint,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// The header key used to when using an auth token.
+	AuthTokenHeaderKey string `protobuf:"bytes,2,opt,name=auth_token_header_key,json=authTokenHeaderKey,proto3" json:"auth_token_header_key,omitempty"`
+	// The header key used to when using a pix token.
+	PixTokenHeaderKey string `protobuf:"bytes,3,opt,name=pix_token_header_key,json=pixTokenHeaderKey,proto3" json:"pix_token_header_key,omitempty"`
+	// Used in responses (e.g. ReadPicFile) to indicate suggested http headers.
+	// See pixur.api.HttpHeader
+	HttpHeaderKey string `protobuf:"bytes,4,opt,name=http_header_key,json=httpHeaderKey,proto3" json:"http_header_key,omitempty"`
+	// The header key used when using an api key instead of an auth token.
+	ApiKeyHeaderKey string `protobuf:"bytes,5,opt,name=api_key_header_key,json=apiKeyHeaderKey,proto3" json:"api_key_header_key,omitempty"`
+	// The header key used by frontends to forward the address of the client they are acting for.
+	// It is used to rate limit anonymous users.
+	ClientAddrHeaderKey  string   `protobuf:"bytes,6,opt,name=client_addr_header_key,json=clientAddrHeaderKey,proto3" json:"client_addr_header_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceOpts) Reset()         { *m = ServiceOpts{} }
+func (m *ServiceOpts) String() string { return proto.CompactTextString(m) }
+func (*ServiceOpts) ProtoMessage()    {}
+func (*ServiceOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{150}
+}
+
+func (m *ServiceOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceOpts.Unmarshal(m, b)
+}
+func (m *ServiceOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceOpts.Marshal(b, m, deterministic)
+}
+func (m *ServiceOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceOpts.Merge(m, src)
+}
+func (m *ServiceOpts) XXX_Size() int {
+	return xxx_messageInfo_ServiceOpts.Size(m)
+}
+func (m *ServiceOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceOpts proto.InternalMessageInfo
+
+func (m *ServiceOpts) GetApiVersion() int64 {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return 0
+}
+
+func (m *ServiceOpts) GetAuthTokenHeaderKey() string {
+	if m != nil {
+		return m.AuthTokenHeaderKey
+	}
+	return ""
+}
+
+func (m *ServiceOpts) GetPixTokenHeaderKey() string {
+	if m != nil {
+		return m.PixTokenHeaderKey
+	}
+	return ""
+}
+
+func (m *ServiceOpts) GetHttpHeaderKey() string {
+	if m != nil {
+		return m.HttpHeaderKey
+	}
+	return ""
+}
+
+func (m *ServiceOpts) GetApiKeyHeaderKey() string {
+	if m != nil {
+		return m.ApiKeyHeaderKey
+	}
+	return ""
+}
+
+func (m *ServiceOpts) GetClientAddrHeaderKey() string {
+	if m != nil {
+		return m.ClientAddrHeaderKey
+	}
+	return ""
+}
+
+// HttpHeader is a message included as a header on some responses.  It is only a suggestion.
+// See pixur.api.ServiceOpts.http_header_key
+type HttpHeader struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HttpHeader) Reset()         { *m = HttpHeader{} }
+func (m *HttpHeader) String() string { return proto.CompactTextString(m) }
+func (*HttpHeader) ProtoMessage()    {}
+func (*HttpHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{151}
+}
+
+func (m *HttpHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpHeader.Unmarshal(m, b)
+}
+func (m *HttpHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpHeader.Marshal(b, m, deterministic)
+}
+func (m *HttpHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpHeader.Merge(m, src)
+}
+func (m *HttpHeader) XXX_Size() int {
+	return xxx_messageInfo_HttpHeader.Size(m)
+}
+func (m *HttpHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpHeader proto.InternalMessageInfo
+
+func (m *HttpHeader) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HttpHeader) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+var E_PixurServiceOpts = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.ServiceOptions)(nil),
+	ExtensionType: (*ServiceOpts)(nil),
+	Field:         65537,
+	Name:          "pixur.api.pixur_service_opts",
+	Tag:           "bytes,65537,opt,name=pixur_service_opts",
+	Filename:      "api.proto",
+}
+
+func init() {
+	proto.RegisterType((*AddCollectionPicRequest)(nil), "pixur.api.AddCollectionPicRequest")
+	proto.RegisterType((*AddCollectionPicResponse)(nil), "pixur.api.AddCollectionPicResponse")
+	proto.RegisterType((*AddPicCommentRequest)(nil), "pixur.api.AddPicCommentRequest")
+	proto.RegisterType((*AddPicCommentResponse)(nil), "pixur.api.AddPicCommentResponse")
+	proto.RegisterType((*AddPicFavoriteRequest)(nil), "pixur.api.AddPicFavoriteRequest")
+	proto.RegisterType((*AddPicFavoriteResponse)(nil), "pixur.api.AddPicFavoriteResponse")
+	proto.RegisterType((*AddPicTagsRequest)(nil), "pixur.api.AddPicTagsRequest")
+	proto.RegisterType((*AddPicTagsResponse)(nil), "pixur.api.AddPicTagsResponse")
+	proto.RegisterType((*AddTagFollowRequest)(nil), "pixur.api.AddTagFollowRequest")
+	proto.RegisterType((*AddTagFollowResponse)(nil), "pixur.api.AddTagFollowResponse")
+	proto.RegisterType((*AddUserFollowRequest)(nil), "pixur.api.AddUserFollowRequest")
+	proto.RegisterType((*AddUserFollowResponse)(nil), "pixur.api.AddUserFollowResponse")
+	proto.RegisterType((*AppendUploadSessionRequest)(nil), "pixur.api.AppendUploadSessionRequest")
+	proto.RegisterType((*AppendUploadSessionResponse)(nil), "pixur.api.AppendUploadSessionResponse")
+	proto.RegisterType((*CreateCollectionRequest)(nil), "pixur.api.CreateCollectionRequest")
+	proto.RegisterType((*CreateCollectionResponse)(nil), "pixur.api.CreateCollectionResponse")
+	proto.RegisterType((*CreateApiKeyRequest)(nil), "pixur.api.CreateApiKeyRequest")
+	proto.RegisterType((*CreateApiKeyResponse)(nil), "pixur.api.CreateApiKeyResponse")
+	proto.RegisterType((*CreateInviteCodeRequest)(nil), "pixur.api.CreateInviteCodeRequest")
+	proto.RegisterType((*CreateInviteCodeResponse)(nil), "pixur.api.CreateInviteCodeResponse")
+	proto.RegisterType((*CreatePoolRequest)(nil), "pixur.api.CreatePoolRequest")
+	proto.RegisterType((*CreatePoolResponse)(nil), "pixur.api.CreatePoolResponse")
+	proto.RegisterType((*CreateUserRequest)(nil), "pixur.api.CreateUserRequest")
+	proto.RegisterType((*CreateUserResponse)(nil), "pixur.api.CreateUserResponse")
+	proto.RegisterType((*DisableTotpRequest)(nil), "pixur.api.DisableTotpRequest")
+	proto.RegisterType((*DisableTotpResponse)(nil), "pixur.api.DisableTotpResponse")
+	proto.RegisterType((*DeleteAccountRequest)(nil), "pixur.api.DeleteAccountRequest")
+	proto.RegisterType((*DeleteAccountResponse)(nil), "pixur.api.DeleteAccountResponse")
+	proto.RegisterType((*DeleteApiKeyRequest)(nil), "pixur.api.DeleteApiKeyRequest")
+	proto.RegisterType((*DeleteApiKeyResponse)(nil), "pixur.api.DeleteApiKeyResponse")
+	proto.RegisterType((*DeleteCollectionRequest)(nil), "pixur.api.DeleteCollectionRequest")
+	proto.RegisterType((*DeleteCollectionResponse)(nil), "pixur.api.DeleteCollectionResponse")
+	proto.RegisterType((*DeletePicCommentRequest)(nil), "pixur.api.DeletePicCommentRequest")
+	proto.RegisterType((*DeletePicCommentResponse)(nil), "pixur.api.DeletePicCommentResponse")
+	proto.RegisterType((*DeleteTokenRequest)(nil), "pixur.api.DeleteTokenRequest")
+	proto.RegisterType((*DeleteTokenResponse)(nil), "pixur.api.DeleteTokenResponse")
+	proto.RegisterType((*EditPicCommentRequest)(nil), "pixur.api.EditPicCommentRequest")
+	proto.RegisterType((*EditPicCommentResponse)(nil), "pixur.api.EditPicCommentResponse")
+	proto.RegisterType((*ExportMyDataRequest)(nil), "pixur.api.ExportMyDataRequest")
+	proto.RegisterType((*ExportMyDataResponse)(nil), "pixur.api.ExportMyDataResponse")
+	proto.RegisterType((*ExportMyDataResponse_Upload)(nil), "pixur.api.ExportMyDataResponse.Upload")
+	proto.RegisterType((*ExportMyDataResponse_Favorite)(nil), "pixur.api.ExportMyDataResponse.Favorite")
+	proto.RegisterType((*ExportMyDataResponse_CollectionPic)(nil), "pixur.api.ExportMyDataResponse.CollectionPic")
+	proto.RegisterType((*ExportMyDataResponse_UserFollow)(nil), "pixur.api.ExportMyDataResponse.UserFollow")
+	proto.RegisterType((*ExportMyDataResponse_TagFollow)(nil), "pixur.api.ExportMyDataResponse.TagFollow")
+	proto.RegisterType((*ExportMyDataResponse_UploadSession)(nil), "pixur.api.ExportMyDataResponse.UploadSession")
+	proto.RegisterType((*FindApiKeysRequest)(nil), "pixur.api.FindApiKeysRequest")
+	proto.RegisterType((*FindApiKeysResponse)(nil), "pixur.api.FindApiKeysResponse")
+	proto.RegisterType((*FindAuditLogRequest)(nil), "pixur.api.FindAuditLogRequest")
+	proto.RegisterType((*FindAuditLogResponse)(nil), "pixur.api.FindAuditLogResponse")
+	proto.RegisterType((*FindCollectionsRequest)(nil), "pixur.api.FindCollectionsRequest")
+	proto.RegisterType((*FindCollectionsResponse)(nil), "pixur.api.FindCollectionsResponse")
+	proto.RegisterType((*FindFeedPicsRequest)(nil), "pixur.api.FindFeedPicsRequest")
+	proto.RegisterType((*FindFeedPicsResponse)(nil), "pixur.api.FindFeedPicsResponse")
+	proto.RegisterType((*FindFollowsRequest)(nil), "pixur.api.FindFollowsRequest")
+	proto.RegisterType((*FindFollowsResponse)(nil), "pixur.api.FindFollowsResponse")
+	proto.RegisterType((*FindIndexPicsRequest)(nil), "pixur.api.FindIndexPicsRequest")
+	proto.RegisterType((*FindIndexPicsResponse)(nil), "pixur.api.FindIndexPicsResponse")
+	proto.RegisterType((*FindPicCommentVotesRequest)(nil), "pixur.api.FindPicCommentVotesRequest")
+	proto.RegisterType((*FindPicCommentVotesResponse)(nil), "pixur.api.FindPicCommentVotesResponse")
+	proto.RegisterType((*FindPicFavoritesRequest)(nil), "pixur.api.FindPicFavoritesRequest")
+	proto.RegisterType((*FindPicFavoritesResponse)(nil), "pixur.api.FindPicFavoritesResponse")
+	proto.RegisterType((*FindPicsByColorRequest)(nil), "pixur.api.FindPicsByColorRequest")
+	proto.RegisterType((*FindPicsByColorResponse)(nil), "pixur.api.FindPicsByColorResponse")
+	proto.RegisterType((*FindPoolsRequest)(nil), "pixur.api.FindPoolsRequest")
+	proto.RegisterType((*FindPoolsResponse)(nil), "pixur.api.FindPoolsResponse")
+	proto.RegisterType((*FindSchedPicsRequest)(nil), "pixur.api.FindSchedPicsRequest")
+	proto.RegisterType((*FindSchedPicsResponse)(nil), "pixur.api.FindSchedPicsResponse")
+	proto.RegisterType((*FindSimilarPicsRequest)(nil), "pixur.api.FindSimilarPicsRequest")
+	proto.RegisterType((*FindSimilarPicsResponse)(nil), "pixur.api.FindSimilarPicsResponse")
+	proto.RegisterType((*FindUserEventsRequest)(nil), "pixur.api.FindUserEventsRequest")
+	proto.RegisterType((*FindUserEventsResponse)(nil), "pixur.api.FindUserEventsResponse")
+	proto.RegisterType((*FinishTotpEnrollmentRequest)(nil), "pixur.api.FinishTotpEnrollmentRequest")
+	proto.RegisterType((*FinishTotpEnrollmentResponse)(nil), "pixur.api.FinishTotpEnrollmentResponse")
+	proto.RegisterType((*FinishUploadSessionRequest)(nil), "pixur.api.FinishUploadSessionRequest")
+	proto.RegisterType((*FinishUploadSessionResponse)(nil), "pixur.api.FinishUploadSessionResponse")
+	proto.RegisterType((*FinishUserSecretResetRequest)(nil), "pixur.api.FinishUserSecretResetRequest")
+	proto.RegisterType((*FinishUserSecretResetResponse)(nil), "pixur.api.FinishUserSecretResetResponse")
+	proto.RegisterType((*GetRefreshTokenRequest)(nil), "pixur.api.GetRefreshTokenRequest")
+	proto.RegisterType((*GetRefreshTokenResponse)(nil), "pixur.api.GetRefreshTokenResponse")
+	proto.RegisterType((*IncrementViewCountRequest)(nil), "pixur.api.IncrementViewCountRequest")
+	proto.RegisterType((*IncrementViewCountResponse)(nil), "pixur.api.IncrementViewCountResponse")
+	proto.RegisterType((*InsertPoolPicRequest)(nil), "pixur.api.InsertPoolPicRequest")
+	proto.RegisterType((*InsertPoolPicResponse)(nil), "pixur.api.InsertPoolPicResponse")
+	proto.RegisterType((*ListSessionsRequest)(nil), "pixur.api.ListSessionsRequest")
+	proto.RegisterType((*ListSessionsResponse)(nil), "pixur.api.ListSessionsResponse")
+	proto.RegisterType((*LookupCollectionRequest)(nil), "pixur.api.LookupCollectionRequest")
+	proto.RegisterType((*LookupCollectionResponse)(nil), "pixur.api.LookupCollectionResponse")
+	proto.RegisterType((*LookupPicCommentVoteRequest)(nil), "pixur.api.LookupPicCommentVoteRequest")
+	proto.RegisterType((*LookupPicCommentVoteResponse)(nil), "pixur.api.LookupPicCommentVoteResponse")
+	proto.RegisterType((*LookupPicDetailsRequest)(nil), "pixur.api.LookupPicDetailsRequest")
+	proto.RegisterType((*LookupPicDetailsResponse)(nil), "pixur.api.LookupPicDetailsResponse")
+	proto.RegisterType((*LookupPicDetailsResponse_PoolNav)(nil), "pixur.api.LookupPicDetailsResponse.PoolNav")
+	proto.RegisterType((*LookupPicExtensionRequest)(nil), "pixur.api.LookupPicExtensionRequest")
+	proto.RegisterType((*LookupPicExtensionResponse)(nil), "pixur.api.LookupPicExtensionResponse")
+	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.api.LookupPicExtensionResponse.ExtEntry")
+	proto.RegisterType((*LookupPicFileRequest)(nil), "pixur.api.LookupPicFileRequest")
+	proto.RegisterType((*LookupPicFileResponse)(nil), "pixur.api.LookupPicFileResponse")
+	proto.RegisterType((*LookupPicVoteRequest)(nil), "pixur.api.LookupPicVoteRequest")
+	proto.RegisterType((*LookupPicVoteResponse)(nil), "pixur.api.LookupPicVoteResponse")
+	proto.RegisterType((*LookupPoolRequest)(nil), "pixur.api.LookupPoolRequest")
+	proto.RegisterType((*LookupPoolResponse)(nil), "pixur.api.LookupPoolResponse")
+	proto.RegisterType((*LookupPublicUserInfoRequest)(nil), "pixur.api.LookupPublicUserInfoRequest")
+	proto.RegisterType((*LookupPublicUserInfoResponse)(nil), "pixur.api.LookupPublicUserInfoResponse")
+	proto.RegisterType((*LookupUploadSessionRequest)(nil), "pixur.api.LookupUploadSessionRequest")
+	proto.RegisterType((*LookupUploadSessionResponse)(nil), "pixur.api.LookupUploadSessionResponse")
+	proto.RegisterType((*LookupUserRequest)(nil), "pixur.api.LookupUserRequest")
+	proto.RegisterType((*LookupUserResponse)(nil), "pixur.api.LookupUserResponse")
+	proto.RegisterType((*MovePoolPicRequest)(nil), "pixur.api.MovePoolPicRequest")
+	proto.RegisterType((*MovePoolPicResponse)(nil), "pixur.api.MovePoolPicResponse")
+	proto.RegisterType((*PurgePicRequest)(nil), "pixur.api.PurgePicRequest")
+	proto.RegisterType((*PurgePicResponse)(nil), "pixur.api.PurgePicResponse")
+	proto.RegisterType((*ReadPicFileRequest)(nil), "pixur.api.ReadPicFileRequest")
+	proto.RegisterType((*ReadPicFileResponse)(nil), "pixur.api.ReadPicFileResponse")
+	proto.RegisterType((*RemoveCollectionPicRequest)(nil), "pixur.api.RemoveCollectionPicRequest")
+	proto.RegisterType((*RemoveCollectionPicResponse)(nil), "pixur.api.RemoveCollectionPicResponse")
+	proto.RegisterType((*RemovePicFavoriteRequest)(nil), "pixur.api.RemovePicFavoriteRequest")
+	proto.RegisterType((*RemovePicFavoriteResponse)(nil), "pixur.api.RemovePicFavoriteResponse")
+	proto.RegisterType((*RemovePoolPicRequest)(nil), "pixur.api.RemovePoolPicRequest")
+	proto.RegisterType((*RemovePoolPicResponse)(nil), "pixur.api.RemovePoolPicResponse")
+	proto.RegisterType((*RemoveTagFollowRequest)(nil), "pixur.api.RemoveTagFollowRequest")
+	proto.RegisterType((*RemoveTagFollowResponse)(nil), "pixur.api.RemoveTagFollowResponse")
+	proto.RegisterType((*RemoveUserFollowRequest)(nil), "pixur.api.RemoveUserFollowRequest")
+	proto.RegisterType((*RemoveUserFollowResponse)(nil), "pixur.api.RemoveUserFollowResponse")
+	proto.RegisterType((*ReorderCollectionRequest)(nil), "pixur.api.ReorderCollectionRequest")
+	proto.RegisterType((*ReorderCollectionResponse)(nil), "pixur.api.ReorderCollectionResponse")
+	proto.RegisterType((*RevokeSessionRequest)(nil), "pixur.api.RevokeSessionRequest")
+	proto.RegisterType((*RevokeSessionResponse)(nil), "pixur.api.RevokeSessionResponse")
+	proto.RegisterType((*SoftDeletePicRequest)(nil), "pixur.api.SoftDeletePicRequest")
+	proto.RegisterType((*SoftDeletePicResponse)(nil), "pixur.api.SoftDeletePicResponse")
+	proto.RegisterType((*StartTotpEnrollmentRequest)(nil), "pixur.api.StartTotpEnrollmentRequest")
+	proto.RegisterType((*StartTotpEnrollmentResponse)(nil), "pixur.api.StartTotpEnrollmentResponse")
+	proto.RegisterType((*StartUploadSessionRequest)(nil), "pixur.api.StartUploadSessionRequest")
+	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.api.StartUploadSessionRequest.ExtEntry")
+	proto.RegisterType((*StartUploadSessionResponse)(nil), "pixur.api.StartUploadSessionResponse")
+	proto.RegisterType((*StartUserSecretResetRequest)(nil), "pixur.api.StartUserSecretResetRequest")
+	proto.RegisterType((*StartUserSecretResetResponse)(nil), "pixur.api.StartUserSecretResetResponse")
+	proto.RegisterType((*SuspendUserRequest)(nil), "pixur.api.SuspendUserRequest")
+	proto.RegisterType((*SuspendUserResponse)(nil), "pixur.api.SuspendUserResponse")
+	proto.RegisterType((*UnlockLoginRequest)(nil), "pixur.api.UnlockLoginRequest")
+	proto.RegisterType((*UnlockLoginResponse)(nil), "pixur.api.UnlockLoginResponse")
+	proto.RegisterType((*UnsuspendUserRequest)(nil), "pixur.api.UnsuspendUserRequest")
+	proto.RegisterType((*UnsuspendUserResponse)(nil), "pixur.api.UnsuspendUserResponse")
+	proto.RegisterType((*UpdateCollectionRequest)(nil), "pixur.api.UpdateCollectionRequest")
+	proto.RegisterType((*UpdateCollectionResponse)(nil), "pixur.api.UpdateCollectionResponse")
+	proto.RegisterType((*UpdateUserRequest)(nil), "pixur.api.UpdateUserRequest")
+	proto.RegisterType((*UpdateUserRequest_ChangeIdent)(nil), "pixur.api.UpdateUserRequest.ChangeIdent")
+	proto.RegisterType((*UpdateUserRequest_ChangeSecret)(nil), "pixur.api.UpdateUserRequest.ChangeSecret")
+	proto.RegisterType((*UpdateUserRequest_ChangeCapability)(nil), "pixur.api.UpdateUserRequest.ChangeCapability")
+	proto.RegisterType((*UpdateUserRequest_ChangeRole)(nil), "pixur.api.UpdateUserRequest.ChangeRole")
+	proto.RegisterType((*UpdateUserRequest_ChangeProfile)(nil), "pixur.api.UpdateUserRequest.ChangeProfile")
+	proto.RegisterType((*UpdateUserResponse)(nil), "pixur.api.UpdateUserResponse")
+	proto.RegisterType((*UpdateUserSecretRequest)(nil), "pixur.api.UpdateUserSecretRequest")
+	proto.RegisterType((*UpdateUserSecretResponse)(nil), "pixur.api.UpdateUserSecretResponse")
+	proto.RegisterType((*UpsertPicCommentVoteRequest)(nil), "pixur.api.UpsertPicCommentVoteRequest")
+	proto.RegisterType((*UpsertPicCommentVoteResponse)(nil), "pixur.api.UpsertPicCommentVoteResponse")
+	proto.RegisterType((*UpsertPicRequest)(nil), "pixur.api.UpsertPicRequest")
+	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.api.UpsertPicRequest.ExtEntry")
+	proto.RegisterType((*UpsertPicResponse)(nil), "pixur.api.UpsertPicResponse")
+	proto.RegisterType((*UpsertPicStreamRequest)(nil), "pixur.api.UpsertPicStreamRequest")
+	proto.RegisterType((*UpsertPicStreamRequest_Metadata)(nil), "pixur.api.UpsertPicStreamRequest.Metadata")
+	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.api.UpsertPicStreamRequest.Metadata.ExtEntry")
+	proto.RegisterType((*UpsertPicStreamResponse)(nil), "pixur.api.UpsertPicStreamResponse")
+	proto.RegisterType((*UpsertPicVoteRequest)(nil), "pixur.api.UpsertPicVoteRequest")
+	proto.RegisterType((*UpsertPicVoteResponse)(nil), "pixur.api.UpsertPicVoteResponse")
+	proto.RegisterType((*WatchBackendConfigurationRequest)(nil), "pixur.api.WatchBackendConfigurationRequest")
+	proto.RegisterType((*WatchBackendConfigurationResponse)(nil), "pixur.api.WatchBackendConfigurationResponse")
+	proto.RegisterType((*ServiceOpts)(nil), "pixur.api.ServiceOpts")
+	proto.RegisterType((*HttpHeader)(nil), "pixur.api.HttpHeader")
+	proto.RegisterExtension(E_PixurServiceOpts)
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 5466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x5b, 0x6f, 0x1c, 0x47,
+	0x76, 0xbf, 0x86, 0x33, 0x22, 0x67, 0xce, 0x70, 0x78, 0x29, 0x0e, 0x2f, 0x6a, 0xdd, 0xe8, 0x96,
+	0x2d, 0x6b, 0x25, 0x8b, 0xb2, 0xa4, 0x95, 0xfe, 0x6b, 0x7b, 0xff, 0x2b, 0x51, 0x17, 0x9a, 0xb4,
+	0x25, 0x2f, 0xb7, 0x49, 0x6a, 0x0d, 0x3b, 0xeb, 0xd9, 0xe6, 0x74, 0x71, 0xd8, 0xe6, 0x70, 0xba,
+	0xd3, 0xdd, 0x43, 0x93, 0x48, 0x16, 0x59, 0x03, 0x09, 0x82, 0x35, 0xf2, 0xb0, 0x40, 0x10, 0x04,
+	0x48, 0xf2, 0x92, 0xbc, 0x24, 0x0f, 0x41, 0xf2, 0x01, 0xf2, 0x29, 0x02, 0xe4, 0x21, 0x9f, 0x20,
+	0x40, 0x9e, 0xf3, 0x9a, 0x87, 0xa0, 0x6e, 0xdd, 0x55, 0x5d, 0xd5, 0x33, 0x43, 0xd1, 0xf2, 0x13,
+	0xa7, 0xab, 0xce, 0x39, 0x75, 0xea, 0xd4, 0xa9, 0x53, 0xa7, 0xaa, 0x7e, 0x45, 0xa8, 0xb9, 0xa1,
+	0xbf, 0x12, 0x46, 0x41, 0x12, 0xa0, 0x5a, 0xe8, 0x1f, 0xf7, 0xa3, 0x15, 0x37, 0xf4, 0xad, 0x0b,
+	0x9d, 0x20, 0xe8, 0x74, 0xf1, 0x1d, 0x5a, 0xb1, 0xdb, 0xdf, 0xbb, 0xe3, 0xf6, 0x4e, 0x18, 0x95,
+	0xb5, 0x9c, 0xaf, 0xf2, 0x70, 0xdc, 0x8e, 0xfc, 0x30, 0x09, 0x22, 0x4e, 0x71, 0x45, 0xa3, 0xe8,
+	0x47, 0x6e, 0xe2, 0x07, 0x3d, 0x5e, 0x7f, 0x35, 0x5f, 0x9f, 0xf8, 0x87, 0x38, 0x4e, 0xdc, 0xc3,
+	0x50, 0x08, 0x60, 0x8a, 0x04, 0x51, 0xe7, 0x0e, 0xfd, 0x75, 0xc7, 0x0d, 0xfd, 0x3b, 0x9e, 0x9b,
+	0xb8, 0xac, 0xde, 0xde, 0x81, 0xc5, 0x55, 0xcf, 0x7b, 0x1a, 0x74, 0xbb, 0xb8, 0x4d, 0xe4, 0x6e,
+	0xfa, 0x6d, 0x07, 0xff, 0x61, 0x1f, 0xc7, 0x09, 0xba, 0x06, 0x8d, 0x76, 0x5a, 0xde, 0xf2, 0xbd,
+	0xa5, 0xd2, 0x72, 0xe9, 0x46, 0xcd, 0x99, 0xcc, 0x0a, 0x37, 0x3c, 0x34, 0x0f, 0xe3, 0xa1, 0xdf,
+	0x26, 0xb5, 0x63, 0xb4, 0xf6, 0x7c, 0xe8, 0xb7, 0x37, 0x3c, 0xfb, 0x17, 0xb0, 0xa4, 0x8b, 0x8d,
+	0xc3, 0xa0, 0x17, 0x63, 0xf4, 0x00, 0x20, 0x13, 0x41, 0x85, 0xd6, 0xef, 0xcd, 0xaf, 0xa4, 0x06,
+	0x5b, 0xc9, 0xb8, 0x1c, 0x89, 0xd0, 0x3e, 0x84, 0xe6, 0xaa, 0xe7, 0x6d, 0xfa, 0xed, 0xa7, 0xc1,
+	0xe1, 0x21, 0xee, 0x25, 0x42, 0xcd, 0x4c, 0x83, 0x92, 0xa4, 0x01, 0xba, 0x09, 0xb3, 0x6d, 0x46,
+	0xd8, 0x0a, 0xdd, 0x88, 0xfc, 0x49, 0x75, 0x9c, 0xe6, 0x15, 0x9b, 0xb4, 0x7c, 0xc3, 0x43, 0x08,
+	0x2a, 0x09, 0x3e, 0x4e, 0x96, 0xca, 0xb4, 0x9a, 0xfe, 0xb6, 0xd7, 0x61, 0x3e, 0xd7, 0x1c, 0x57,
+	0xff, 0x0e, 0x4c, 0x70, 0x7e, 0x83, 0xee, 0x12, 0xbd, 0xa0, 0xb2, 0x57, 0x84, 0xa4, 0x35, 0xf7,
+	0x28, 0x88, 0xfc, 0x04, 0x0f, 0xd6, 0xdc, 0x5e, 0x82, 0x85, 0x3c, 0x3d, 0x6b, 0xda, 0xfe, 0x29,
+	0xcc, 0xb2, 0x9a, 0x6d, 0xb7, 0x13, 0x0f, 0xe9, 0xff, 0x0c, 0x94, 0x13, 0xb7, 0xb3, 0x34, 0xb6,
+	0x5c, 0xbe, 0x51, 0x73, 0xc8, 0x4f, 0xbb, 0x09, 0x48, 0xe6, 0xe6, 0x32, 0xdf, 0x85, 0xb9, 0x55,
+	0xcf, 0xdb, 0x76, 0x3b, 0x6b, 0x41, 0xb7, 0x1b, 0x7c, 0x23, 0xa4, 0x72, 0x76, 0x26, 0x92, 0xb2,
+	0x2f, 0x50, 0xfb, 0x4b, 0x84, 0x5c, 0xc0, 0x1d, 0x5a, 0xbe, 0x13, 0xe3, 0x48, 0x95, 0xb0, 0x08,
+	0x13, 0xfd, 0x18, 0x47, 0x99, 0x62, 0xe3, 0xe4, 0x73, 0xc3, 0xb3, 0x17, 0xa9, 0x3d, 0x64, 0x06,
+	0x2e, 0x29, 0x01, 0x6b, 0x35, 0x0c, 0x71, 0xcf, 0xdb, 0x09, 0xbb, 0x81, 0xeb, 0x6d, 0xe1, 0x38,
+	0x26, 0x4e, 0xc0, 0xe5, 0xdd, 0x84, 0xd9, 0x3e, 0x2d, 0x6f, 0xc5, 0xac, 0x22, 0x93, 0x3c, 0xdd,
+	0x97, 0x19, 0x36, 0x3c, 0xb4, 0x00, 0xe3, 0xc1, 0xde, 0x5e, 0x8c, 0x13, 0x3a, 0xe2, 0x65, 0x87,
+	0x7f, 0x91, 0x81, 0x26, 0xbe, 0x4f, 0x07, 0x7a, 0xd2, 0xa1, 0xbf, 0xed, 0xaf, 0xe0, 0xa2, 0xb1,
+	0x55, 0x3e, 0xdc, 0x8f, 0x60, 0x4a, 0x6d, 0x96, 0x8f, 0xfa, 0x92, 0x34, 0xea, 0x2a, 0x67, 0x43,
+	0xd1, 0xc6, 0x0e, 0x60, 0xf1, 0x69, 0x84, 0xdd, 0x04, 0x4b, 0x7e, 0xcd, 0xbb, 0x84, 0xa0, 0xd2,
+	0x73, 0x0f, 0x31, 0xef, 0x05, 0xfd, 0x8d, 0x1e, 0x03, 0x1c, 0xf9, 0xb1, 0xbf, 0xeb, 0x77, 0xfd,
+	0xe4, 0x84, 0xaa, 0x3f, 0x75, 0x6f, 0xd9, 0x38, 0x3b, 0x56, 0x5e, 0xa5, 0x74, 0x8e, 0xc4, 0x43,
+	0xe6, 0x9e, 0xde, 0xe0, 0xd9, 0xe6, 0xde, 0x5f, 0x97, 0x60, 0x8e, 0xc9, 0x5c, 0x0d, 0xfd, 0x4f,
+	0xf1, 0xc9, 0xa0, 0x0e, 0xdc, 0x85, 0x71, 0x7c, 0x1c, 0xfa, 0x11, 0x53, 0xbe, 0x7e, 0xef, 0xc2,
+	0x0a, 0x8b, 0x51, 0x2b, 0x22, 0x46, 0xad, 0x3c, 0xe3, 0x31, 0xcc, 0xe1, 0x84, 0xe8, 0x03, 0x80,
+	0xb6, 0x1b, 0xba, 0xbc, 0xcf, 0xe5, 0xe5, 0xf2, 0x8d, 0xa9, 0x7b, 0x17, 0x64, 0xad, 0xd2, 0x4a,
+	0xf2, 0xd3, 0x91, 0x88, 0xed, 0x6d, 0x68, 0xaa, 0x8a, 0xf1, 0x8e, 0xde, 0x84, 0x09, 0x37, 0xf4,
+	0x5b, 0x07, 0xf8, 0x84, 0xf7, 0x72, 0x56, 0x92, 0xc7, 0x69, 0xc7, 0x5d, 0xfa, 0x97, 0xf8, 0x3a,
+	0xa1, 0x63, 0xc1, 0x81, 0xfc, 0xb4, 0xff, 0xa1, 0x24, 0x06, 0x6d, 0xa3, 0x77, 0xe4, 0x13, 0x4b,
+	0x7a, 0xe9, 0xac, 0xcd, 0xfa, 0x57, 0x1a, 0xb5, 0x7f, 0x17, 0xa0, 0x7a, 0xe8, 0x1e, 0xb7, 0xfa,
+	0x31, 0x8e, 0xb9, 0x43, 0x4e, 0x1c, 0xba, 0xc7, 0x3b, 0x31, 0x8e, 0xcf, 0xd2, 0xf5, 0x3d, 0x31,
+	0xce, 0xb2, 0x8e, 0xbc, 0xfb, 0x08, 0x2a, 0xed, 0xc0, 0x4b, 0x07, 0x86, 0xfc, 0x46, 0x0f, 0xa1,
+	0xee, 0x53, 0xca, 0x16, 0xad, 0x1a, 0xd3, 0x06, 0x5f, 0x92, 0x03, 0x7e, 0xfa, 0xdb, 0xfe, 0x14,
+	0x66, 0x59, 0x3b, 0x9b, 0x41, 0xd0, 0x15, 0x56, 0x68, 0xc2, 0xf9, 0xc4, 0x4f, 0xba, 0xa2, 0x05,
+	0xf6, 0x81, 0x96, 0xa1, 0x2e, 0x96, 0x30, 0xe2, 0x5f, 0xcc, 0xa2, 0x72, 0x91, 0xfd, 0x01, 0x20,
+	0x59, 0x18, 0x57, 0xf7, 0x1a, 0x54, 0xc2, 0x20, 0xe8, 0x72, 0x8b, 0x4e, 0xcb, 0x01, 0x95, 0x90,
+	0xd1, 0x4a, 0x7b, 0x57, 0xe8, 0x41, 0x42, 0x87, 0xa4, 0x87, 0xef, 0x89, 0x58, 0x5c, 0x73, 0xd8,
+	0x07, 0x99, 0xff, 0x31, 0x6e, 0x47, 0x7c, 0xfe, 0xd7, 0x1c, 0xfe, 0x85, 0xae, 0xaa, 0x26, 0x60,
+	0xf1, 0x5e, 0xee, 0x6b, 0x53, 0xa8, 0xc7, 0xda, 0xe0, 0x81, 0xe9, 0x31, 0xa0, 0x67, 0x7e, 0xec,
+	0xee, 0x76, 0xf1, 0x76, 0x90, 0x84, 0xa2, 0xe9, 0xac, 0x91, 0x92, 0xd2, 0x88, 0xb0, 0xfd, 0x58,
+	0x66, 0x7b, 0x7b, 0x1e, 0xe6, 0x14, 0x09, 0x5c, 0xf0, 0x13, 0x68, 0x3e, 0xc3, 0x5d, 0x9c, 0xe0,
+	0xd5, 0x76, 0x3b, 0xe8, 0x67, 0x6b, 0xda, 0x69, 0x44, 0x2f, 0xc2, 0x7c, 0x4e, 0x06, 0x17, 0x7e,
+	0x1f, 0xe6, 0x78, 0x85, 0x32, 0x67, 0x2f, 0x01, 0xf0, 0x99, 0x91, 0x05, 0xd0, 0x2a, 0x9b, 0x09,
+	0x1b, 0x1e, 0x89, 0xf2, 0x2a, 0x13, 0x17, 0xf6, 0x39, 0x2c, 0xb2, 0x72, 0x3d, 0x8a, 0x8d, 0x94,
+	0x27, 0x2c, 0xc1, 0xc4, 0x11, 0x8e, 0x62, 0xe1, 0x15, 0x33, 0x8e, 0xf8, 0xb4, 0x2d, 0x58, 0xd2,
+	0x25, 0xf3, 0x56, 0xbf, 0x2d, 0x89, 0x66, 0x47, 0x5e, 0xf7, 0x2f, 0x93, 0x08, 0xc7, 0xd6, 0xfd,
+	0x74, 0xc1, 0xaf, 0xf1, 0x12, 0x55, 0x8f, 0xb2, 0xa2, 0x07, 0xb1, 0x79, 0x84, 0xdd, 0x38, 0xe8,
+	0x2d, 0x55, 0x98, 0xcd, 0xd9, 0x97, 0xfd, 0xa9, 0xd0, 0xef, 0xfb, 0xc8, 0x05, 0x9a, 0x80, 0x98,
+	0xb0, 0xed, 0xe0, 0x00, 0x0b, 0x0b, 0x52, 0xef, 0x90, 0x4b, 0x79, 0xef, 0xff, 0x08, 0xe6, 0x9f,
+	0x7b, 0x7e, 0xf2, 0xe6, 0xbb, 0x2e, 0xf2, 0x9f, 0x8a, 0x94, 0xff, 0x6c, 0xc0, 0x42, 0xbe, 0xf1,
+	0xd7, 0xed, 0xf4, 0x3c, 0xcc, 0x3d, 0x3f, 0x0e, 0x83, 0x28, 0x79, 0x79, 0xf2, 0xcc, 0x4d, 0x5c,
+	0xd1, 0xeb, 0xdf, 0x4d, 0x41, 0x53, 0x2d, 0xe7, 0x0d, 0xbc, 0x03, 0x15, 0x92, 0x2a, 0x18, 0xa2,
+	0x01, 0x99, 0x95, 0xeb, 0xe7, 0x1c, 0x5a, 0x8d, 0x56, 0x60, 0x42, 0x2c, 0xc9, 0x2c, 0x96, 0x21,
+	0x89, 0x92, 0xaf, 0xbe, 0xeb, 0xe7, 0x1c, 0x41, 0x84, 0x1e, 0xc3, 0x38, 0x5b, 0x99, 0x69, 0xf7,
+	0xeb, 0xf7, 0xae, 0x4b, 0xe4, 0x26, 0x3d, 0xf8, 0xb2, 0xbe, 0x7e, 0xce, 0xe1, 0x7c, 0xe8, 0x3d,
+	0x98, 0x20, 0x76, 0x27, 0x89, 0x51, 0x45, 0x5b, 0x54, 0x58, 0x62, 0x45, 0xa8, 0x43, 0xfa, 0x0b,
+	0xfd, 0x04, 0xea, 0x84, 0x5a, 0xd8, 0xea, 0xfc, 0x00, 0x5b, 0xad, 0x9f, 0x73, 0x20, 0x4c, 0xbf,
+	0xd0, 0x1d, 0xa8, 0x12, 0xce, 0xa3, 0x20, 0xc1, 0x4b, 0xe3, 0x5a, 0xd7, 0x36, 0xfd, 0xf6, 0xab,
+	0x20, 0xc1, 0xa4, 0x6b, 0x21, 0xfb, 0x89, 0x9e, 0xc3, 0x8c, 0xd4, 0x14, 0x63, 0x9c, 0xe0, 0xab,
+	0x93, 0xa9, 0x3d, 0xce, 0x3f, 0x15, 0x2a, 0x25, 0x24, 0x3b, 0xa0, 0x29, 0x1b, 0x3e, 0x22, 0x0a,
+	0x57, 0xa9, 0x80, 0x66, 0xce, 0xfc, 0xcf, 0x8f, 0x98, 0xbe, 0xb5, 0xbe, 0xf8, 0x40, 0x6b, 0x50,
+	0xdd, 0xe3, 0xa9, 0xea, 0x52, 0x8d, 0x32, 0xdd, 0x18, 0x66, 0x5a, 0x91, 0xda, 0xae, 0x9f, 0x73,
+	0x52, 0x5e, 0xf4, 0xff, 0x94, 0xe4, 0x04, 0x06, 0x24, 0x27, 0xc4, 0x5e, 0x19, 0x29, 0x7a, 0x05,
+	0x53, 0x52, 0x04, 0x0a, 0xfd, 0xf6, 0x52, 0x9d, 0x32, 0xdf, 0x1e, 0xa6, 0x86, 0xb2, 0x41, 0x59,
+	0x3f, 0xe7, 0x48, 0x81, 0x6c, 0xd3, 0x6f, 0xa3, 0x97, 0x50, 0xa7, 0xf6, 0xd8, 0xa3, 0x79, 0xea,
+	0xd2, 0x24, 0x15, 0x7a, 0x73, 0xa8, 0xdb, 0xa4, 0x99, 0x2d, 0x51, 0xb3, 0x9f, 0x7e, 0xa1, 0x4f,
+	0x00, 0x12, 0xb7, 0x23, 0xa4, 0x35, 0xa8, 0xb4, 0x1f, 0x0d, 0x93, 0x96, 0x26, 0xdc, 0xc4, 0xe6,
+	0x89, 0xf8, 0x20, 0xae, 0x28, 0xf2, 0x9b, 0xa9, 0x82, 0xfc, 0x86, 0xb8, 0x22, 0xcf, 0x70, 0x5e,
+	0x69, 0x49, 0xec, 0xf4, 0x68, 0x06, 0x52, 0x32, 0x5b, 0x62, 0x20, 0x25, 0xb7, 0xb5, 0xfe, 0xa2,
+	0x04, 0xe3, 0x8c, 0xa4, 0x28, 0x26, 0xbd, 0x07, 0xe3, 0x71, 0xd0, 0x8f, 0xda, 0x22, 0xdf, 0x68,
+	0xaa, 0xfe, 0xb8, 0x45, 0xeb, 0x1c, 0x4e, 0x83, 0xfe, 0x3f, 0x4c, 0xb6, 0xe9, 0xf2, 0xeb, 0xb5,
+	0xc8, 0x46, 0x96, 0x4f, 0x54, 0x4b, 0xcb, 0xb0, 0xb6, 0xc5, 0x2e, 0xd7, 0xa9, 0x73, 0x7a, 0x52,
+	0x62, 0xfd, 0x1a, 0xaa, 0xc2, 0xb1, 0x8a, 0xf4, 0xc9, 0xb7, 0x30, 0x76, 0xba, 0x16, 0xbe, 0x2b,
+	0x41, 0x43, 0x71, 0x9a, 0xb3, 0xec, 0x92, 0xcf, 0xda, 0xdd, 0x3e, 0x40, 0xe6, 0x6b, 0xe8, 0x06,
+	0xcc, 0x30, 0xcf, 0xc2, 0xb8, 0xa5, 0x6e, 0xbc, 0xa6, 0x44, 0xf9, 0x0e, 0xdd, 0x80, 0x9d, 0xd5,
+	0x06, 0x7f, 0x00, 0xb5, 0xd4, 0x29, 0xf5, 0x7d, 0xe2, 0x59, 0xa5, 0xff, 0x77, 0x09, 0x1a, 0x8a,
+	0xd7, 0x9d, 0x6a, 0xe3, 0xf7, 0x43, 0xba, 0x1b, 0x7a, 0x04, 0x8d, 0xc3, 0xc0, 0xf3, 0xf7, 0x7c,
+	0xc1, 0x5f, 0x19, 0xca, 0x3f, 0x29, 0x18, 0x48, 0xd1, 0x93, 0x2a, 0x49, 0x39, 0xda, 0x41, 0xe4,
+	0x91, 0xbc, 0x60, 0xcd, 0xef, 0x79, 0x6c, 0xe2, 0x8a, 0xad, 0xbd, 0xbd, 0x0a, 0x73, 0x4a, 0xa9,
+	0x69, 0x6f, 0x53, 0x1e, 0xb8, 0xb7, 0xb1, 0xbf, 0x1b, 0xe3, 0x32, 0xfa, 0x9e, 0x9f, 0xbc, 0x08,
+	0x3a, 0x22, 0x85, 0xb0, 0xa1, 0xe1, 0xb6, 0x93, 0x20, 0xca, 0xb9, 0x4a, 0x9d, 0x16, 0x72, 0x3f,
+	0x79, 0x1b, 0xa6, 0x12, 0x37, 0xea, 0xe0, 0x24, 0x25, 0x62, 0xde, 0x3b, 0xc9, 0x4a, 0x39, 0x95,
+	0x0d, 0x0d, 0x4e, 0xc5, 0x5d, 0x9c, 0x65, 0xd5, 0x75, 0x56, 0xb8, 0x49, 0x1d, 0xfd, 0x1e, 0x8c,
+	0xbb, 0x2c, 0xaa, 0x57, 0xe8, 0x86, 0xd6, 0x92, 0x15, 0xe6, 0x9a, 0xad, 0xac, 0xb2, 0xdc, 0x8f,
+	0x53, 0xa2, 0x5b, 0x80, 0xe2, 0xc4, 0x8d, 0x92, 0x96, 0x4b, 0x08, 0x5a, 0xdd, 0xa0, 0x43, 0x84,
+	0x9f, 0x67, 0xe3, 0x4e, 0x6b, 0x04, 0x27, 0x53, 0x95, 0xec, 0xb0, 0x52, 0xd2, 0x98, 0xae, 0x9b,
+	0x65, 0x67, 0xf2, 0xd0, 0x3d, 0x16, 0x64, 0xb1, 0x1d, 0x43, 0x53, 0xb5, 0x05, 0x37, 0xe8, 0xfb,
+	0x50, 0x4b, 0x39, 0xb9, 0x49, 0xe7, 0x0c, 0x1a, 0x3a, 0x55, 0x97, 0xff, 0x42, 0x3f, 0x82, 0xd9,
+	0x1e, 0x3e, 0xce, 0xe9, 0xc6, 0xac, 0x33, 0x45, 0x2a, 0x32, 0xd5, 0xec, 0xbb, 0xb0, 0x40, 0x1a,
+	0xcd, 0xa2, 0x46, 0x3c, 0xf4, 0x84, 0x64, 0x13, 0x16, 0x35, 0x96, 0x82, 0x0d, 0x7c, 0x79, 0xb4,
+	0x0d, 0xfc, 0x0e, 0xf3, 0x82, 0x35, 0x8c, 0xbd, 0x4d, 0xbf, 0x9d, 0x6a, 0xb0, 0x0c, 0x93, 0xcc,
+	0xc6, 0x4a, 0xa8, 0x04, 0x5a, 0xc6, 0x46, 0xee, 0x12, 0xd4, 0xdc, 0xb8, 0x8d, 0x7b, 0x9e, 0xdf,
+	0xeb, 0xd0, 0x0e, 0x56, 0x9d, 0xac, 0xc0, 0xfe, 0xd3, 0x12, 0xb3, 0x68, 0x26, 0x97, 0xab, 0xf9,
+	0x1e, 0x94, 0xc9, 0x32, 0xcc, 0xf4, 0xb3, 0xd4, 0x49, 0xb8, 0xda, 0xf3, 0xb6, 0xf7, 0xfb, 0x87,
+	0xbb, 0x3d, 0xd7, 0xef, 0x3a, 0x84, 0x0c, 0x5d, 0x81, 0x3a, 0xb5, 0xa6, 0x12, 0x23, 0x6b, 0xa4,
+	0x88, 0x29, 0x71, 0x05, 0xea, 0x61, 0x84, 0x8f, 0x54, 0x07, 0xab, 0x91, 0x22, 0x5a, 0x2f, 0x66,
+	0x0f, 0x0b, 0x49, 0xe9, 0xec, 0x79, 0xcc, 0xfb, 0x2c, 0x4a, 0xb9, 0x6a, 0x8a, 0xd5, 0xcb, 0x99,
+	0xd5, 0x0d, 0x27, 0x66, 0xaf, 0x58, 0xef, 0x36, 0x7a, 0x1e, 0x3e, 0xfe, 0x3e, 0xcd, 0xf6, 0x67,
+	0x25, 0x98, 0xcf, 0x09, 0x56, 0xed, 0x56, 0xf9, 0x61, 0xec, 0x76, 0x00, 0x16, 0x51, 0x43, 0xcd,
+	0x0b, 0xe3, 0xb3, 0xed, 0x32, 0x24, 0xf3, 0x96, 0x15, 0xa7, 0x7e, 0x01, 0x17, 0x8d, 0x8d, 0xf1,
+	0x9e, 0xdf, 0x86, 0x0a, 0x4d, 0x5b, 0x99, 0xcb, 0x14, 0xa7, 0xad, 0x0e, 0x25, 0xb3, 0x5f, 0xb0,
+	0x29, 0x22, 0x9d, 0x92, 0xc6, 0xd9, 0x01, 0xcd, 0x7c, 0x36, 0x3a, 0x22, 0xb9, 0xcc, 0xba, 0x81,
+	0xc4, 0x30, 0x09, 0xc6, 0x0d, 0xcf, 0x3e, 0x81, 0x25, 0x5d, 0xda, 0x6b, 0xb9, 0xf2, 0x1d, 0x68,
+	0xa6, 0x43, 0x22, 0xb7, 0xcd, 0xec, 0x34, 0xcb, 0xc7, 0x46, 0x6a, 0xfa, 0x05, 0x0b, 0x0f, 0xc4,
+	0x0b, 0x9e, 0x9c, 0x3c, 0x0d, 0xba, 0x81, 0x7c, 0xb4, 0xd1, 0x26, 0xdf, 0x54, 0xef, 0x86, 0xc3,
+	0x3e, 0x88, 0x67, 0x25, 0x41, 0x17, 0x47, 0x6e, 0x8f, 0x2f, 0x72, 0x0d, 0x27, 0x2b, 0xb0, 0x3f,
+	0x4e, 0xcd, 0x92, 0x49, 0x7b, 0x9d, 0x7e, 0xd8, 0x0f, 0x61, 0x86, 0x0a, 0x0a, 0x82, 0x6e, 0x2c,
+	0xad, 0x19, 0xdc, 0xb0, 0x41, 0xd0, 0x95, 0xd6, 0x0c, 0x66, 0xd0, 0x20, 0xe8, 0x6e, 0x78, 0xf6,
+	0x17, 0x30, 0x2b, 0xf1, 0x69, 0xc7, 0x3b, 0xe5, 0xc2, 0xe3, 0x1d, 0x32, 0xa9, 0x98, 0xe5, 0xb8,
+	0x70, 0x66, 0x31, 0xa0, 0x16, 0x63, 0xb2, 0x17, 0xd8, 0x74, 0xdc, 0x6a, 0xef, 0x2b, 0x51, 0xcc,
+	0x7e, 0xce, 0x66, 0x93, 0x54, 0xae, 0x76, 0x79, 0x6c, 0xb4, 0x2e, 0xdf, 0x61, 0x23, 0xb1, 0xe5,
+	0x1f, 0xfa, 0x5d, 0x37, 0x92, 0xe7, 0x7b, 0xc1, 0x41, 0xfd, 0xfb, 0xcc, 0xd8, 0x0a, 0x03, 0x6f,
+	0x59, 0xe6, 0x28, 0x67, 0x1c, 0xbf, 0x61, 0x9a, 0xa6, 0xfb, 0xa8, 0xa1, 0x4b, 0x01, 0xba, 0x0d,
+	0x73, 0xcc, 0xe6, 0xd9, 0xc6, 0x2c, 0x33, 0xce, 0x0c, 0xad, 0x4a, 0xa5, 0xe5, 0xe3, 0x4e, 0x39,
+	0x1f, 0x77, 0xfe, 0xb1, 0xc4, 0xba, 0x28, 0xb7, 0xcf, 0x15, 0xbe, 0xaf, 0x6c, 0xfd, 0xd8, 0x40,
+	0x19, 0xb7, 0x7e, 0xf2, 0xc6, 0xef, 0x16, 0x20, 0x3a, 0x64, 0x26, 0xdd, 0xa6, 0x49, 0x8d, 0xac,
+	0xda, 0x2d, 0x40, 0x34, 0x18, 0xa9, 0xc4, 0x2c, 0x46, 0x4c, 0x93, 0x1a, 0x89, 0xd8, 0xbe, 0x4b,
+	0x83, 0x85, 0x1f, 0xef, 0x6f, 0x07, 0x49, 0xf8, 0xbc, 0x17, 0x05, 0xdd, 0xae, 0x7c, 0x00, 0x62,
+	0x38, 0xde, 0xb4, 0x9f, 0xc2, 0x25, 0x33, 0x4b, 0xea, 0x84, 0x0d, 0x92, 0x6c, 0x1d, 0xe1, 0xe8,
+	0xa4, 0xc5, 0x99, 0xc9, 0xc8, 0x4c, 0x8a, 0x42, 0x7a, 0xfe, 0xb7, 0x4e, 0x23, 0xa2, 0x1f, 0xef,
+	0x9f, 0xf5, 0x0a, 0xc2, 0x7e, 0x24, 0x7a, 0x60, 0xbe, 0x56, 0x58, 0x16, 0xb3, 0x91, 0x64, 0x8c,
+	0x53, 0xaa, 0x6b, 0x32, 0x77, 0x4c, 0x44, 0x7f, 0x88, 0x5d, 0xb6, 0xe8, 0xf9, 0x9f, 0x83, 0x63,
+	0x9c, 0x0c, 0x3e, 0xf9, 0xbc, 0x0a, 0xf5, 0x88, 0x50, 0xb5, 0x92, 0xe0, 0x00, 0x8b, 0x13, 0x58,
+	0xa0, 0x45, 0xf4, 0x70, 0x89, 0x84, 0xef, 0x1e, 0xfe, 0xa6, 0xc5, 0x8f, 0x17, 0xcb, 0x62, 0xc9,
+	0xf8, 0x86, 0xb5, 0x60, 0x5f, 0x85, 0xcb, 0x05, 0xad, 0xf2, 0x43, 0xa9, 0xdf, 0x8f, 0xc1, 0xc2,
+	0xc7, 0xe4, 0x7b, 0x2f, 0xc2, 0xc4, 0xd6, 0xd9, 0x31, 0xd6, 0x29, 0xcf, 0x62, 0x57, 0x60, 0x8e,
+	0x8c, 0xba, 0x1f, 0xf4, 0xe3, 0x96, 0xdb, 0x4f, 0xf6, 0xb9, 0xc6, 0x4c, 0xa3, 0x59, 0x51, 0xb5,
+	0xda, 0x4f, 0x58, 0x23, 0xe8, 0x22, 0x09, 0x7c, 0x49, 0xc8, 0xc6, 0x8e, 0x9d, 0x54, 0x55, 0x49,
+	0x01, 0x19, 0x37, 0xd2, 0x2b, 0xea, 0x57, 0x6e, 0x47, 0x1c, 0xb5, 0xd4, 0x98, 0xa3, 0xae, 0x76,
+	0x98, 0xa3, 0xce, 0xe2, 0xe3, 0x04, 0x47, 0x3d, 0xb7, 0xdb, 0x0a, 0xa3, 0xe0, 0xc8, 0xf7, 0x70,
+	0x44, 0x0f, 0x48, 0x6a, 0xce, 0x8c, 0xa8, 0xd8, 0xe4, 0xe5, 0xe8, 0x47, 0x90, 0x96, 0xb5, 0xe2,
+	0xfe, 0xee, 0xd7, 0xb8, 0xcd, 0xce, 0x42, 0x6a, 0xce, 0xb4, 0x28, 0xdf, 0x62, 0xc5, 0x9f, 0x54,
+	0xaa, 0xe3, 0x33, 0x13, 0xf6, 0xff, 0x94, 0x60, 0x51, 0x33, 0x09, 0x1f, 0xe7, 0xcb, 0x00, 0x52,
+	0xe7, 0xf8, 0x6a, 0xe9, 0xca, 0x9d, 0x0a, 0xfd, 0x63, 0x5e, 0xcb, 0xd4, 0xae, 0x86, 0xfe, 0x31,
+	0xab, 0xfc, 0x09, 0x4c, 0x52, 0xde, 0xd0, 0x3d, 0xa1, 0xc7, 0x56, 0x15, 0xfd, 0x04, 0xe9, 0x9b,
+	0x64, 0x93, 0x55, 0x3a, 0x75, 0x42, 0xca, 0x3f, 0xd0, 0x43, 0xa8, 0x13, 0xb1, 0x82, 0x71, 0x7c,
+	0x10, 0x23, 0x84, 0xfe, 0x31, 0xff, 0xfd, 0x49, 0xa5, 0x5a, 0x9a, 0x19, 0xfb, 0xa4, 0x52, 0x2d,
+	0xcf, 0x54, 0x9c, 0x46, 0xc4, 0xfa, 0xc3, 0x94, 0x73, 0xa6, 0xc5, 0x27, 0x17, 0x6a, 0xdf, 0x83,
+	0x0b, 0x1b, 0xbd, 0x76, 0x84, 0xe9, 0xc2, 0xec, 0xe3, 0x6f, 0x9e, 0xca, 0x07, 0xd8, 0x05, 0x11,
+	0xf3, 0x12, 0x58, 0x26, 0x1e, 0xee, 0x5a, 0x5f, 0x43, 0x73, 0xa3, 0x17, 0x63, 0xb6, 0x96, 0x48,
+	0x17, 0xd1, 0x8b, 0x30, 0xa1, 0xae, 0x38, 0xe3, 0x21, 0x5d, 0x10, 0x8a, 0xb6, 0xd5, 0x36, 0x34,
+	0x76, 0xf1, 0x5e, 0x10, 0xe1, 0xdc, 0x8e, 0x84, 0x15, 0xb2, 0xd4, 0xe7, 0xa7, 0x30, 0x9f, 0x6b,
+	0xeb, 0x34, 0x57, 0x11, 0xf3, 0x30, 0xf7, 0xc2, 0x8f, 0x13, 0x3e, 0xa9, 0xd3, 0x85, 0xe8, 0x19,
+	0x34, 0xd5, 0xe2, 0x74, 0x1d, 0x9a, 0xc8, 0x2e, 0x0f, 0xcb, 0xe6, 0x93, 0xca, 0xf4, 0x9c, 0xd2,
+	0xfe, 0x19, 0x2c, 0xbe, 0x08, 0x82, 0x83, 0x7e, 0xf8, 0x7a, 0x47, 0xed, 0xf6, 0x9f, 0xc0, 0x92,
+	0xce, 0x7f, 0xa6, 0xfb, 0xbf, 0x53, 0x2e, 0xa4, 0x5d, 0xb8, 0xc8, 0x14, 0xc8, 0x65, 0x6e, 0x6f,
+	0x26, 0xaf, 0x7c, 0x09, 0x97, 0xcc, 0xad, 0x69, 0x89, 0x65, 0x69, 0x94, 0xc4, 0xf2, 0x7d, 0x61,
+	0xfd, 0x4d, 0xbf, 0xfd, 0x0c, 0x27, 0xae, 0xdf, 0x1d, 0x96, 0x06, 0xfc, 0x6b, 0x59, 0x18, 0x5c,
+	0x66, 0x19, 0x35, 0xce, 0x13, 0xe7, 0xf0, 0x70, 0xe4, 0x1f, 0x61, 0x8f, 0xa7, 0xfd, 0xb9, 0xb3,
+	0xde, 0x35, 0xbf, 0x8b, 0x1d, 0x41, 0x42, 0xf6, 0xfe, 0xe2, 0x08, 0x7a, 0x4c, 0xdb, 0xfb, 0xb3,
+	0x23, 0xe8, 0xf4, 0x00, 0xfa, 0xa9, 0x7a, 0x2a, 0x9c, 0x44, 0x58, 0x1c, 0x71, 0x98, 0xad, 0xb0,
+	0x1d, 0x61, 0x2c, 0x9f, 0x09, 0x93, 0x6f, 0x64, 0x49, 0x87, 0xbb, 0xe7, 0x69, 0x42, 0x91, 0x1d,
+	0xd8, 0xae, 0x41, 0x95, 0x4e, 0xcc, 0x9e, 0x7b, 0xb4, 0x34, 0x4e, 0xb5, 0xb9, 0x25, 0x09, 0x2e,
+	0xb2, 0x09, 0x9d, 0x48, 0x9f, 0xb9, 0x47, 0x0e, 0x9d, 0xd5, 0x9f, 0xb9, 0x47, 0x56, 0x0f, 0x26,
+	0x78, 0xd9, 0x48, 0xd3, 0x2f, 0xbf, 0xaf, 0x19, 0xcb, 0xed, 0x6b, 0xf2, 0xfb, 0xa2, 0x72, 0x6e,
+	0x5f, 0x44, 0x42, 0x57, 0xaa, 0xdc, 0xf3, 0xe3, 0x04, 0xf7, 0xe4, 0x45, 0xbe, 0x60, 0x94, 0xff,
+	0xb9, 0x04, 0x96, 0x89, 0x89, 0x8f, 0xf3, 0x63, 0x28, 0xe3, 0x63, 0x91, 0x38, 0xad, 0x98, 0xac,
+	0xa0, 0xf1, 0xac, 0x3c, 0x3f, 0x4e, 0x9e, 0xf7, 0x92, 0xe8, 0xc4, 0x21, 0xac, 0xd6, 0x0b, 0xa8,
+	0x8a, 0x02, 0x71, 0x23, 0x5d, 0x4a, 0x6f, 0xa4, 0xd1, 0x4d, 0x38, 0x7f, 0xe4, 0x76, 0xfb, 0xd9,
+	0xb9, 0x56, 0xfe, 0x8c, 0x69, 0xb5, 0x77, 0xe2, 0x30, 0x92, 0x0f, 0xc7, 0x7e, 0x52, 0xb2, 0x7d,
+	0x68, 0xa6, 0x2d, 0x53, 0x0f, 0xe2, 0xbd, 0xbb, 0xc2, 0x2e, 0x25, 0xf6, 0xfc, 0xae, 0xb4, 0x25,
+	0xaa, 0x85, 0x8c, 0x68, 0xc3, 0x43, 0x77, 0x61, 0x7c, 0x2f, 0x88, 0x0e, 0xdd, 0x84, 0x43, 0x0f,
+	0x2e, 0xe8, 0xce, 0xb8, 0xb2, 0x46, 0x09, 0x1c, 0x4e, 0x68, 0xaf, 0xc1, 0x7c, 0xae, 0xa9, 0x74,
+	0xe6, 0x55, 0x45, 0x5b, 0x7c, 0x3c, 0x8d, 0xae, 0xcd, 0x1b, 0xb7, 0xd7, 0x24, 0x95, 0x47, 0x88,
+	0x17, 0x52, 0x40, 0x18, 0x53, 0x02, 0xc2, 0x23, 0x49, 0x1f, 0x25, 0x12, 0x5c, 0x57, 0x22, 0x81,
+	0xe1, 0x4a, 0x85, 0x87, 0x80, 0xf7, 0x60, 0x96, 0x0b, 0x90, 0x2e, 0xbc, 0x8b, 0x16, 0x21, 0xbb,
+	0x03, 0x48, 0xa6, 0x3e, 0xc5, 0x32, 0x72, 0xca, 0xb0, 0xfa, 0x30, 0x0d, 0xab, 0xfd, 0xdd, 0xae,
+	0xdf, 0xa6, 0xc7, 0x6f, 0xbd, 0xbd, 0x60, 0xe8, 0x69, 0xd2, 0xab, 0x34, 0x40, 0xe6, 0xf8, 0xb8,
+	0xaa, 0x0f, 0xa1, 0xc6, 0x18, 0x7b, 0x7b, 0x81, 0x29, 0x4a, 0xaa, 0x5c, 0xd5, 0x3e, 0xff, 0x45,
+	0x72, 0x65, 0x26, 0xf7, 0xcc, 0xb9, 0xf2, 0x57, 0xa2, 0x67, 0x6f, 0x08, 0x82, 0x93, 0x0e, 0xa8,
+	0x8c, 0x1c, 0x28, 0xb4, 0xd7, 0x07, 0x62, 0x40, 0x65, 0x0c, 0x00, 0x19, 0xd0, 0x01, 0x97, 0x92,
+	0xec, 0x4a, 0xd2, 0xde, 0x07, 0xf4, 0x32, 0x38, 0xc2, 0x3f, 0x40, 0xfe, 0xf2, 0x21, 0xcc, 0x29,
+	0x2d, 0x9d, 0x26, 0x7b, 0x79, 0x0c, 0xd3, 0x9b, 0xfd, 0xa8, 0x83, 0x25, 0x15, 0x0b, 0xe6, 0x58,
+	0x76, 0x27, 0x3e, 0xa6, 0xdc, 0x89, 0x23, 0x98, 0xc9, 0x24, 0xf0, 0xec, 0xed, 0xaf, 0x4a, 0x80,
+	0x1c, 0xec, 0x7a, 0x6f, 0x3c, 0xe0, 0x48, 0xe8, 0xae, 0xb2, 0x82, 0xee, 0x6a, 0xc2, 0xf9, 0xae,
+	0x7f, 0xe8, 0xb3, 0x7b, 0xec, 0xb2, 0xc3, 0x3e, 0xec, 0x8f, 0x60, 0x4e, 0x51, 0x2b, 0x43, 0xc8,
+	0x50, 0x28, 0x58, 0x29, 0x83, 0x82, 0x91, 0xb0, 0x8b, 0x83, 0x3d, 0x7e, 0x5e, 0x47, 0x7e, 0xda,
+	0x9f, 0x83, 0xe5, 0xe0, 0xc3, 0xe0, 0x08, 0x7f, 0xef, 0x08, 0xc9, 0x6d, 0xb8, 0x68, 0x94, 0x7c,
+	0x36, 0xa0, 0xd6, 0x5d, 0x58, 0x62, 0x52, 0x47, 0x87, 0x1b, 0x5e, 0x84, 0x0b, 0x06, 0x16, 0x3e,
+	0xa8, 0x6b, 0xd0, 0xe4, 0x95, 0x67, 0x72, 0x69, 0x92, 0x6e, 0xe7, 0xe4, 0x9c, 0xc6, 0x61, 0x6f,
+	0xc2, 0x02, 0xe3, 0x1e, 0x01, 0xa6, 0x78, 0x01, 0x16, 0x35, 0x5a, 0xde, 0x99, 0x7b, 0xa2, 0xea,
+	0x14, 0x60, 0x45, 0x4b, 0x18, 0xd4, 0x80, 0x57, 0x7c, 0x45, 0xea, 0x82, 0xc8, 0xc3, 0xd1, 0x6b,
+	0x82, 0x62, 0x64, 0x63, 0x49, 0xa7, 0x44, 0x0e, 0x19, 0x11, 0x4d, 0xee, 0xd9, 0x1c, 0xe3, 0x63,
+	0x32, 0x90, 0x47, 0xc1, 0x01, 0xce, 0x85, 0xe9, 0xcb, 0x00, 0x5a, 0x7c, 0xae, 0xc5, 0xe9, 0x7d,
+	0xda, 0x0c, 0x94, 0xdd, 0x6e, 0x57, 0xcc, 0x08, 0xb7, 0xdb, 0xb5, 0x17, 0xc9, 0x48, 0x2a, 0x82,
+	0xb8, 0x35, 0xfe, 0xad, 0x04, 0xcd, 0xad, 0x60, 0x2f, 0x49, 0xc1, 0x32, 0x43, 0x62, 0xcb, 0x12,
+	0xc9, 0x7b, 0x69, 0x62, 0xc8, 0x5d, 0x45, 0x7c, 0x92, 0x90, 0xc0, 0xa3, 0x4e, 0x59, 0x0b, 0x09,
+	0x54, 0x3a, 0x6d, 0x96, 0x10, 0x88, 0x80, 0x84, 0x1e, 0x41, 0xc3, 0xe3, 0x35, 0x23, 0x5f, 0xc5,
+	0x09, 0x06, 0x52, 0x44, 0xba, 0x95, 0x53, 0x9e, 0x77, 0xeb, 0xc7, 0x60, 0x6d, 0x25, 0x6e, 0x94,
+	0x98, 0x0f, 0xa2, 0x0a, 0x80, 0x5a, 0xf6, 0x67, 0x70, 0xd1, 0xc8, 0xc5, 0x07, 0xb1, 0x08, 0xdf,
+	0xb5, 0x08, 0x13, 0x07, 0xf8, 0xa4, 0xd5, 0x8f, 0x7c, 0x11, 0x70, 0x0f, 0xf0, 0xc9, 0x4e, 0xe4,
+	0xdb, 0x7f, 0x3e, 0x06, 0x17, 0xa8, 0x40, 0xe3, 0x5a, 0x3b, 0x03, 0xe5, 0x7e, 0xd4, 0x15, 0xb3,
+	0xa0, 0x1f, 0x75, 0x49, 0xd6, 0x1e, 0xe1, 0x3d, 0x1c, 0x45, 0x38, 0xe2, 0x92, 0xd2, 0xef, 0x14,
+	0xb4, 0x59, 0x96, 0x40, 0x9b, 0x17, 0xa0, 0x7a, 0xe8, 0x3d, 0x68, 0xed, 0xbb, 0xf1, 0x3e, 0x35,
+	0xdd, 0xa4, 0x33, 0x71, 0xe8, 0x3d, 0x58, 0x77, 0xe3, 0x7d, 0xf4, 0x88, 0x65, 0xb6, 0xe7, 0x69,
+	0x92, 0x22, 0x03, 0x06, 0x0a, 0xf5, 0x79, 0xa3, 0x89, 0xed, 0xaf, 0xf8, 0x78, 0xbc, 0xa1, 0x54,
+	0xe1, 0x3e, 0x1f, 0xb8, 0xd3, 0x1c, 0xba, 0xd9, 0x57, 0xe0, 0x92, 0x99, 0x89, 0xfb, 0xd0, 0x1f,
+	0x03, 0xda, 0xea, 0xc7, 0x14, 0x63, 0x3c, 0x42, 0x02, 0x52, 0xb4, 0xea, 0xa2, 0x07, 0x50, 0x15,
+	0xf0, 0xff, 0x74, 0x1f, 0x57, 0x88, 0x3d, 0x4d, 0x49, 0x49, 0xaa, 0xa0, 0xb4, 0x7e, 0x9a, 0x84,
+	0xe6, 0x33, 0x40, 0x3b, 0xbd, 0x6e, 0xd0, 0x3e, 0x78, 0x11, 0x74, 0xfc, 0xde, 0x50, 0xcd, 0xe9,
+	0xe9, 0xe3, 0x61, 0x90, 0xe0, 0x96, 0xeb, 0x79, 0x51, 0x76, 0xfa, 0x48, 0x8a, 0x56, 0x3d, 0x2f,
+	0xb2, 0xe7, 0x61, 0x4e, 0x91, 0x97, 0x61, 0xc8, 0x77, 0x7a, 0xf1, 0xe8, 0x26, 0x22, 0xeb, 0x49,
+	0x8e, 0xe1, 0x34, 0xbd, 0xfa, 0x97, 0x12, 0x2c, 0xee, 0x84, 0x9e, 0xfb, 0xfd, 0xa3, 0x19, 0x8d,
+	0x93, 0x4b, 0x85, 0x74, 0x57, 0x5e, 0x0f, 0xd2, 0xad, 0xeb, 0x7b, 0xb6, 0x05, 0xe1, 0x9f, 0xc6,
+	0x61, 0x96, 0xc9, 0x1c, 0xc9, 0x27, 0x8b, 0x7b, 0xfc, 0x33, 0x31, 0x25, 0xca, 0x1a, 0xf4, 0x4b,
+	0x93, 0xbf, 0xf2, 0x74, 0xdf, 0xed, 0x75, 0xf0, 0x06, 0xa1, 0x17, 0xe7, 0xc3, 0xab, 0x69, 0x2c,
+	0xac, 0x68, 0x88, 0xa8, 0x22, 0x01, 0x7c, 0x92, 0x89, 0xb0, 0xf9, 0x52, 0x01, 0x51, 0x9f, 0xd7,
+	0xa0, 0x4d, 0x45, 0x62, 0x32, 0x70, 0xb5, 0x0c, 0xac, 0x46, 0x1f, 0x41, 0x25, 0x0a, 0xba, 0x02,
+	0x7a, 0xf7, 0xee, 0x08, 0x82, 0x9c, 0xa0, 0x8b, 0x1d, 0xca, 0x84, 0x9e, 0xc1, 0x44, 0x18, 0x05,
+	0x74, 0xcf, 0x3b, 0xa1, 0xe1, 0xc5, 0x8a, 0xf8, 0x37, 0x19, 0x87, 0x23, 0x58, 0xa5, 0x10, 0x50,
+	0x95, 0x43, 0x80, 0x75, 0x0d, 0xea, 0x92, 0x09, 0xcd, 0xe1, 0xc8, 0xba, 0x0e, 0x93, 0xb2, 0x99,
+	0x8a, 0x56, 0x1b, 0xeb, 0x6f, 0x4a, 0x30, 0x93, 0x37, 0x04, 0x7a, 0x0c, 0x53, 0x31, 0x4e, 0x5a,
+	0x92, 0x3d, 0x4b, 0xc3, 0x40, 0xe9, 0x8d, 0x18, 0x27, 0x92, 0x84, 0x67, 0x30, 0xd3, 0xee, 0x62,
+	0x37, 0x92, 0x65, 0x8c, 0x0d, 0x93, 0x31, 0x4d, 0x59, 0xb2, 0x42, 0x6b, 0x0d, 0x20, 0xb3, 0x2d,
+	0x59, 0x9f, 0x88, 0x56, 0x74, 0x58, 0xd8, 0xbd, 0xcd, 0x04, 0x09, 0xb0, 0xa4, 0xea, 0x32, 0x00,
+	0x6b, 0x8e, 0x56, 0xb2, 0x44, 0xaa, 0x46, 0x4b, 0x48, 0xb5, 0xb5, 0x0a, 0x0d, 0xc5, 0xc6, 0xe8,
+	0xfd, 0x6c, 0x80, 0xd8, 0x64, 0x59, 0xc8, 0x05, 0x89, 0xfc, 0x60, 0x90, 0x0d, 0xa1, 0x3c, 0x70,
+	0xa7, 0x89, 0x34, 0x9b, 0x22, 0xd0, 0xc8, 0x4b, 0xc3, 0x60, 0x8c, 0xb7, 0x7a, 0x41, 0x33, 0x96,
+	0xbf, 0xa0, 0xb1, 0x44, 0x28, 0x50, 0x16, 0x1b, 0x16, 0x46, 0xff, 0xbe, 0x04, 0x17, 0x77, 0x42,
+	0x7a, 0xaa, 0xfd, 0x3d, 0x9e, 0xbc, 0x16, 0xe3, 0x86, 0xef, 0xf1, 0x03, 0x15, 0x16, 0xd2, 0xae,
+	0x14, 0x1e, 0xad, 0xae, 0x48, 0x87, 0x2b, 0x57, 0xe0, 0x92, 0x59, 0x45, 0xde, 0x87, 0xdf, 0x8d,
+	0xc1, 0x4c, 0x4a, 0x30, 0x5a, 0x82, 0x73, 0xbe, 0x20, 0xc1, 0x19, 0x93, 0x62, 0xb0, 0xe1, 0xe5,
+	0xcf, 0xa0, 0xa4, 0xe7, 0x21, 0x4b, 0x7a, 0xd8, 0xa1, 0xe6, 0xdb, 0xca, 0x0c, 0x56, 0x55, 0x7b,
+	0xa3, 0xb9, 0xce, 0x03, 0x12, 0xa2, 0xd3, 0xf6, 0x46, 0xbe, 0x39, 0xfc, 0xb6, 0x0c, 0x0b, 0x29,
+	0xdf, 0x56, 0x12, 0x61, 0xf7, 0x50, 0x18, 0x72, 0x1d, 0xaa, 0x87, 0x38, 0x71, 0xd3, 0x9d, 0x6f,
+	0x3e, 0x3c, 0x99, 0x98, 0x56, 0x5e, 0x72, 0x8e, 0xf5, 0x73, 0x4e, 0xca, 0x8d, 0x16, 0xe0, 0x7c,
+	0x7b, 0xbf, 0xdf, 0x3b, 0xa0, 0x7d, 0x99, 0x5c, 0x3f, 0xe7, 0xb0, 0x4f, 0xeb, 0x7f, 0x4b, 0x50,
+	0x15, 0x0c, 0x6f, 0x36, 0x31, 0x7d, 0x2e, 0x27, 0xa6, 0xf7, 0x47, 0xef, 0xc6, 0x9b, 0x1c, 0xb2,
+	0x27, 0xe3, 0x50, 0x09, 0xdd, 0x28, 0xb1, 0x3f, 0x22, 0x13, 0x3f, 0xa7, 0xc6, 0x29, 0xae, 0x7e,
+	0x9b, 0x29, 0xf3, 0x08, 0xf3, 0xb7, 0x78, 0x82, 0xde, 0xe2, 0x13, 0x94, 0x1d, 0xad, 0x2c, 0xea,
+	0x27, 0x9e, 0xf2, 0xcc, 0x5c, 0x84, 0xf9, 0x5c, 0xab, 0x7c, 0x4a, 0xda, 0xb0, 0xfc, 0x4b, 0x37,
+	0x69, 0xef, 0x3f, 0x71, 0xdb, 0x07, 0xb8, 0xe7, 0x3d, 0x0d, 0x7a, 0x7b, 0x7e, 0x47, 0xe4, 0x99,
+	0xfc, 0xea, 0xeb, 0x2f, 0x4b, 0xf0, 0xd6, 0x00, 0x22, 0xde, 0x75, 0x49, 0xd3, 0x92, 0xaa, 0xe9,
+	0x36, 0xcc, 0xef, 0x32, 0xce, 0x56, 0x5b, 0x66, 0xe5, 0x76, 0xbf, 0x2a, 0xa9, 0x6e, 0x6c, 0xa1,
+	0xb9, 0x6b, 0x28, 0xb5, 0xff, 0x6e, 0x0c, 0xea, 0x5b, 0x38, 0x3a, 0xf2, 0xdb, 0xf8, 0xe7, 0x61,
+	0x12, 0x93, 0xfc, 0xd4, 0x0d, 0xfd, 0x96, 0xac, 0x43, 0xd9, 0x01, 0x37, 0xf4, 0x5f, 0x71, 0x35,
+	0xee, 0xc2, 0x7c, 0x76, 0x5d, 0xdb, 0xda, 0xc7, 0xae, 0x87, 0xa3, 0x56, 0xf6, 0x38, 0x0c, 0xa5,
+	0x37, 0xb7, 0xeb, 0xb4, 0xea, 0x53, 0x7c, 0x82, 0xee, 0x40, 0x33, 0xbd, 0xc2, 0x95, 0x39, 0xc4,
+	0x45, 0x36, 0xbf, 0xcd, 0xcd, 0x18, 0xae, 0xc3, 0xf4, 0x7e, 0x92, 0x84, 0x32, 0x2d, 0xbb, 0xce,
+	0x6e, 0x90, 0xe2, 0x8c, 0xee, 0x16, 0x20, 0xf1, 0x50, 0x47, 0x22, 0xe5, 0x00, 0x48, 0x06, 0xef,
+	0xcc, 0x88, 0xef, 0xc3, 0x42, 0xbb, 0xeb, 0x93, 0x10, 0x4e, 0x32, 0x6f, 0x99, 0x61, 0x9c, 0x32,
+	0xcc, 0xb1, 0x5a, 0x92, 0x84, 0xa7, 0x4c, 0xf6, 0x8f, 0x01, 0xd6, 0xd3, 0x26, 0x0d, 0xce, 0xdf,
+	0x94, 0x9d, 0xbf, 0xc6, 0xdd, 0xfc, 0xde, 0x7f, 0x3d, 0x80, 0xc9, 0x4d, 0x32, 0x1a, 0xdc, 0xb2,
+	0xe8, 0x4b, 0x98, 0xc9, 0x3f, 0xf6, 0x45, 0xb6, 0x8c, 0x9f, 0x34, 0x3f, 0x30, 0xb6, 0xae, 0x0d,
+	0xa4, 0xe1, 0x2e, 0xe3, 0x40, 0x43, 0x79, 0x87, 0x8b, 0xae, 0xaa, 0x5c, 0xda, 0xeb, 0x18, 0x6b,
+	0xb9, 0x98, 0x80, 0xcb, 0xdc, 0x81, 0x29, 0xf5, 0x85, 0x2d, 0xd2, 0x79, 0x72, 0xa7, 0x67, 0xd6,
+	0x5b, 0x03, 0x28, 0xb8, 0xd8, 0x0d, 0x80, 0xec, 0x81, 0x2d, 0xba, 0xa4, 0x31, 0x48, 0xaf, 0x76,
+	0xad, 0xcb, 0x05, 0xb5, 0x5c, 0xd4, 0xcf, 0x61, 0x52, 0x7e, 0x6c, 0x8b, 0xae, 0xa8, 0xe4, 0xf9,
+	0x73, 0x30, 0xeb, 0x6a, 0x61, 0xbd, 0x62, 0x46, 0x09, 0x2e, 0x9e, 0xe3, 0xd0, 0x8e, 0xc4, 0xf2,
+	0x66, 0xd4, 0xcf, 0xbf, 0x90, 0x07, 0x73, 0x86, 0x97, 0xb3, 0xe8, 0x1d, 0x05, 0x8d, 0x5c, 0xf4,
+	0x9e, 0xd7, 0xba, 0x3e, 0x8c, 0x2c, 0x33, 0x85, 0xfc, 0xc2, 0x53, 0x31, 0x85, 0xe1, 0x4d, 0xaa,
+	0x62, 0x0a, 0xe3, 0xd3, 0xd0, 0x2f, 0x61, 0x26, 0xff, 0x3e, 0x56, 0x71, 0xd7, 0x82, 0xd7, 0xba,
+	0x8a, 0xbb, 0x16, 0x3e, 0xb0, 0x4d, 0x85, 0x67, 0x8f, 0x29, 0x0d, 0xc2, 0xb5, 0x57, 0xa5, 0x06,
+	0xe1, 0x86, 0x57, 0x9d, 0x1b, 0x00, 0xd9, 0xe3, 0x49, 0xc5, 0xc1, 0xb4, 0x07, 0x9a, 0x8a, 0x83,
+	0x19, 0x5e, 0x5c, 0xa6, 0xa2, 0xc8, 0xb8, 0x1a, 0x44, 0x49, 0x7b, 0x14, 0x83, 0x28, 0x25, 0x11,
+	0x76, 0xa0, 0xa1, 0x3c, 0x40, 0x54, 0x5c, 0xcb, 0xf4, 0xbc, 0x51, 0x71, 0x2d, 0xe3, 0xdb, 0x45,
+	0x32, 0xe8, 0xf2, 0x33, 0x44, 0x65, 0xd0, 0x0d, 0x8f, 0x1a, 0xad, 0xab, 0x85, 0xf5, 0xd9, 0xb8,
+	0xe4, 0x5f, 0x19, 0x2a, 0xe3, 0x52, 0xf0, 0xb8, 0x51, 0x19, 0x97, 0xa2, 0x67, 0x8a, 0x99, 0x70,
+	0x29, 0x4c, 0xe9, 0xc2, 0xf5, 0x48, 0x75, 0x6d, 0x20, 0x0d, 0x17, 0xfe, 0x02, 0xea, 0xd2, 0xe3,
+	0x40, 0x74, 0x59, 0xe3, 0x91, 0x31, 0x58, 0xd6, 0x95, 0xa2, 0x6a, 0x49, 0x5a, 0xf6, 0x10, 0x55,
+	0x95, 0xa6, 0x3d, 0x71, 0x55, 0xa5, 0xe9, 0xef, 0x57, 0x49, 0x20, 0x55, 0x1f, 0x09, 0x2a, 0x81,
+	0xd4, 0xf8, 0x78, 0x51, 0x09, 0xa4, 0x05, 0x2f, 0x0c, 0x5f, 0xc1, 0xa4, 0xfc, 0x1a, 0x49, 0x19,
+	0x7d, 0xc3, 0x4b, 0x42, 0x65, 0xf4, 0x4d, 0xcf, 0x98, 0xec, 0xf2, 0xef, 0xc7, 0x4a, 0xef, 0x97,
+	0xd0, 0x2f, 0xa0, 0x2e, 0xbd, 0xa7, 0x50, 0x3a, 0xaf, 0xbf, 0xbe, 0x50, 0x3a, 0x6f, 0x78, 0x86,
+	0x41, 0x85, 0xa2, 0x6d, 0x98, 0x94, 0x9f, 0x14, 0x20, 0x8d, 0x49, 0x7d, 0x77, 0xa1, 0xa8, 0x6a,
+	0x7a, 0x8b, 0xc0, 0xa4, 0xfe, 0x0a, 0xa6, 0x73, 0x0f, 0x00, 0xd0, 0x5b, 0x39, 0x46, 0xfd, 0x3d,
+	0x81, 0x65, 0x0f, 0x22, 0x31, 0x28, 0x2d, 0x50, 0xfb, 0x9a, 0xd2, 0xb9, 0x67, 0x02, 0x9a, 0xd2,
+	0x79, 0xb8, 0x3f, 0x93, 0xca, 0xad, 0xcb, 0xf1, 0xf6, 0x9a, 0x75, 0x55, 0x74, 0xbe, 0x66, 0xdd,
+	0x1c, 0x4c, 0x9f, 0x89, 0xfc, 0x25, 0x34, 0x14, 0x9c, 0x3c, 0xca, 0x6b, 0x92, 0x87, 0xe6, 0x2b,
+	0xa1, 0xc5, 0x08, 0xb1, 0x67, 0x82, 0x7d, 0xf6, 0x36, 0x20, 0x07, 0x46, 0x57, 0x96, 0xae, 0x62,
+	0x64, 0xbc, 0xb2, 0x74, 0x0d, 0xc0, 0xb4, 0xb3, 0xa6, 0x7e, 0xcd, 0x91, 0xd4, 0x12, 0xb6, 0x1c,
+	0xd9, 0xba, 0x80, 0x3c, 0x8c, 0x5d, 0x09, 0x0e, 0x45, 0xe0, 0x74, 0xc5, 0x5b, 0x24, 0xd0, 0xb7,
+	0xe6, 0x2d, 0x3a, 0xbc, 0xdc, 0xb2, 0x07, 0x91, 0xc8, 0xe2, 0x3f, 0x85, 0x5a, 0x0a, 0xe9, 0x46,
+	0x17, 0xf3, 0x5c, 0x12, 0x40, 0xdc, 0xba, 0x64, 0xae, 0x34, 0x8c, 0x68, 0x8a, 0xd5, 0xd6, 0x46,
+	0x34, 0x8f, 0xee, 0xd6, 0x46, 0x54, 0x83, 0x79, 0x2b, 0x46, 0x90, 0xc0, 0xd8, 0x9a, 0x11, 0x74,
+	0x64, 0xb7, 0x66, 0x04, 0x03, 0x96, 0x9b, 0x89, 0xff, 0x02, 0xa6, 0x54, 0xe4, 0x34, 0xca, 0xeb,
+	0xa5, 0x81, 0xba, 0xad, 0xb7, 0x06, 0x50, 0xc8, 0xb2, 0x3b, 0x14, 0xd7, 0xae, 0x21, 0x97, 0x51,
+	0xce, 0xcd, 0x8a, 0xd0, 0xd0, 0xd6, 0xbb, 0x43, 0xe9, 0xb2, 0x84, 0xcd, 0x80, 0x49, 0xce, 0x7b,
+	0x7d, 0x01, 0xfa, 0xd9, 0xba, 0x3e, 0x8c, 0x8c, 0xb7, 0xf2, 0x35, 0x05, 0xb9, 0xeb, 0x10, 0x62,
+	0xa4, 0xeb, 0x69, 0xbe, 0x65, 0xb1, 0x6e, 0x0c, 0x27, 0xe4, 0x6d, 0x7d, 0x0e, 0xd3, 0x39, 0xe4,
+	0xad, 0x32, 0xea, 0x66, 0xa0, 0xb2, 0x32, 0xea, 0x45, 0xc0, 0x5d, 0x17, 0x90, 0x0e, 0x55, 0x45,
+	0x6f, 0x2b, 0xff, 0x2e, 0xa3, 0x00, 0xfd, 0x6a, 0xbd, 0x33, 0x84, 0x2a, 0x4b, 0x9c, 0x14, 0x0c,
+	0xaa, 0x32, 0x17, 0x4c, 0x48, 0x58, 0x65, 0x2e, 0x98, 0xe1, 0xab, 0xdb, 0x30, 0x29, 0x43, 0x50,
+	0x95, 0xd0, 0x6e, 0x80, 0xac, 0x2a, 0xa1, 0xdd, 0x84, 0x5d, 0x4d, 0x63, 0x58, 0x1e, 0x52, 0xaa,
+	0xc4, 0xb0, 0x02, 0xbc, 0xaa, 0x12, 0xc3, 0x8a, 0x30, 0xa9, 0xac, 0x85, 0xae, 0x04, 0xfe, 0x92,
+	0x9f, 0xa4, 0x5f, 0x37, 0x41, 0xe9, 0xf4, 0xa3, 0x4d, 0x65, 0x0e, 0x0c, 0x82, 0x83, 0xe6, 0xfa,
+	0x93, 0xa1, 0x13, 0x0d, 0xfd, 0xd1, 0x10, 0xa0, 0x86, 0xfe, 0xe8, 0xf0, 0x46, 0xd6, 0xc2, 0x5e,
+	0x8a, 0x0a, 0x93, 0x90, 0x7f, 0x8a, 0xfb, 0x14, 0x22, 0x10, 0x15, 0xf7, 0x29, 0x86, 0x0f, 0xa6,
+	0xf1, 0x54, 0x01, 0xdf, 0x29, 0x3e, 0x64, 0x42, 0x00, 0x2a, 0x3e, 0x64, 0xc4, 0xed, 0xe9, 0x82,
+	0xe9, 0x48, 0x18, 0x05, 0xcb, 0x43, 0xb0, 0x5c, 0x4c, 0x20, 0x0b, 0xfe, 0x0c, 0x20, 0xc3, 0xcb,
+	0x29, 0x3b, 0x0f, 0x0d, 0x74, 0xa7, 0xec, 0x3c, 0x74, 0x90, 0x5d, 0xde, 0x73, 0x14, 0xa0, 0x9a,
+	0xc9, 0x73, 0x4c, 0xb8, 0x39, 0x93, 0xe7, 0x18, 0x71, 0x72, 0x69, 0xe2, 0x60, 0x80, 0xaa, 0x21,
+	0x7d, 0xc8, 0x86, 0x86, 0xd0, 0x01, 0x88, 0xb7, 0x9c, 0xa1, 0xb4, 0x2d, 0x9a, 0x06, 0x66, 0x33,
+	0x18, 0x4a, 0xf9, 0x07, 0x36, 0x54, 0xde, 0x0b, 0xa8, 0x4b, 0x90, 0x31, 0x25, 0x3f, 0xd3, 0x41,
+	0x6b, 0x4a, 0x7e, 0x66, 0x42, 0x9a, 0x3d, 0x85, 0xaa, 0x80, 0x80, 0x21, 0x05, 0xba, 0xa8, 0x22,
+	0xcb, 0xac, 0x8b, 0xc6, 0xba, 0x74, 0xff, 0x50, 0x97, 0xb0, 0x59, 0x8a, 0x4a, 0x3a, 0x94, 0x4c,
+	0x51, 0xc9, 0x00, 0xe9, 0xa2, 0xbd, 0xbc, 0x41, 0xf2, 0x7c, 0x0f, 0xe6, 0x0c, 0xd8, 0x2a, 0x65,
+	0x90, 0x8a, 0x51, 0x5d, 0xca, 0x20, 0x0d, 0x82, 0x68, 0x7d, 0x05, 0xb3, 0x1a, 0x70, 0x0a, 0x5d,
+	0xd3, 0x98, 0x0d, 0x67, 0x49, 0x6f, 0x0f, 0x26, 0xca, 0x96, 0x07, 0x05, 0x33, 0xa5, 0xcc, 0x40,
+	0x13, 0x2a, 0x4b, 0x99, 0x81, 0x66, 0xb8, 0xd5, 0xe7, 0x30, 0x9d, 0x43, 0x47, 0x29, 0xeb, 0xa5,
+	0x19, 0x65, 0xa5, 0xac, 0x97, 0x05, 0xe0, 0x2a, 0xb2, 0x07, 0xce, 0x03, 0xa5, 0x90, 0xce, 0xa7,
+	0x1f, 0x33, 0x5d, 0x1b, 0x48, 0x23, 0x9b, 0x3a, 0x87, 0x88, 0xca, 0x99, 0xda, 0x8c, 0xc3, 0xca,
+	0x99, 0xba, 0x08, 0x54, 0x45, 0x4d, 0x2d, 0x81, 0x9a, 0x72, 0xa6, 0xd6, 0x71, 0x53, 0x39, 0x53,
+	0x1b, 0xf0, 0x50, 0x44, 0xa6, 0x82, 0x28, 0x52, 0x64, 0x9a, 0x80, 0x52, 0x8a, 0x4c, 0x23, 0x18,
+	0x89, 0x38, 0xb6, 0x01, 0x56, 0xa4, 0x38, 0x76, 0x31, 0x58, 0x49, 0x71, 0xec, 0x41, 0xe8, 0x24,
+	0x17, 0x90, 0x0e, 0xb1, 0x51, 0xd6, 0xae, 0x42, 0xe8, 0x8f, 0xf5, 0xce, 0x10, 0x2a, 0xde, 0x44,
+	0x07, 0x9a, 0x26, 0xc4, 0x0c, 0xd2, 0x54, 0x2c, 0xc8, 0x10, 0xdf, 0x1d, 0x4a, 0x97, 0x9d, 0x77,
+	0x48, 0xe0, 0x17, 0x25, 0xc2, 0xe8, 0x90, 0x1c, 0x25, 0xc2, 0x98, 0x30, 0x33, 0x2f, 0xa0, 0x2e,
+	0xc1, 0x57, 0x14, 0x69, 0x3a, 0x4c, 0x46, 0x91, 0x66, 0x40, 0xbd, 0x10, 0x0f, 0x51, 0x40, 0x2c,
+	0x8a, 0x87, 0x98, 0xf0, 0x30, 0x8a, 0x87, 0x98, 0xf1, 0x2f, 0x5f, 0xc2, 0x4c, 0x1e, 0x29, 0xa2,
+	0x4c, 0xc3, 0x02, 0xd8, 0x8b, 0x32, 0x0d, 0x0b, 0xa1, 0x26, 0x1b, 0x00, 0xd9, 0xdd, 0xb3, 0xb2,
+	0x22, 0x69, 0xc0, 0x06, 0x65, 0x45, 0x32, 0xdc, 0x9e, 0xa7, 0x7a, 0x66, 0x03, 0x67, 0xd0, 0x53,
+	0xbb, 0x35, 0x37, 0xe8, 0xa9, 0xdf, 0x83, 0xa3, 0x35, 0xa8, 0xa5, 0x37, 0x59, 0xca, 0x8e, 0x35,
+	0x7f, 0x7b, 0x6b, 0x5d, 0x32, 0x57, 0x66, 0x5e, 0x6a, 0xba, 0xab, 0x56, 0xbc, 0x74, 0xc0, 0x7d,
+	0xbb, 0xf5, 0xee, 0x50, 0x3a, 0xde, 0xd0, 0x17, 0x30, 0x9d, 0xbb, 0x2d, 0x54, 0xc2, 0xb2, 0xf9,
+	0x42, 0xd3, 0xb2, 0x07, 0x91, 0x30, 0xc9, 0x37, 0x4a, 0xd4, 0xcb, 0xe4, 0x6b, 0x3d, 0xd5, 0xcb,
+	0x0c, 0xd7, 0x8c, 0xaa, 0x97, 0x99, 0x6e, 0x04, 0xd1, 0x6f, 0xe0, 0x42, 0xe1, 0x65, 0x1f, 0x92,
+	0xdf, 0x00, 0x0d, 0xbb, 0x37, 0xb4, 0xde, 0x1b, 0x8d, 0x58, 0x39, 0xc7, 0xb3, 0xf0, 0x77, 0xbf,
+	0x5d, 0x76, 0xab, 0x7f, 0xfb, 0xef, 0xff, 0x51, 0x43, 0x33, 0x94, 0xfd, 0xb6, 0xdb, 0x4f, 0xf6,
+	0x6f, 0xd3, 0x2b, 0x38, 0x6b, 0x9a, 0x95, 0x84, 0xfe, 0x31, 0x2b, 0xb0, 0xe7, 0x59, 0xc1, 0x7e,
+	0x92, 0x84, 0xb7, 0xd9, 0xbd, 0xd8, 0xed, 0x5d, 0xbf, 0x77, 0xb3, 0xc1, 0x39, 0x43, 0xff, 0xf6,
+	0x01, 0x3e, 0xb9, 0x37, 0xcb, 0x3e, 0xd9, 0x35, 0xd9, 0x6d, 0xd7, 0xf3, 0xa2, 0x0f, 0x3b, 0x80,
+	0x68, 0x61, 0x2b, 0x66, 0x17, 0x5d, 0xad, 0x80, 0xde, 0x21, 0x6a, 0x57, 0xc0, 0xd9, 0x0d, 0x23,
+	0xd9, 0x39, 0x2d, 0x7d, 0xfb, 0xdb, 0x8a, 0x86, 0x2b, 0x91, 0x2e, 0x21, 0x1d, 0xa6, 0xb2, 0x54,
+	0xf2, 0xe4, 0x36, 0x34, 0x82, 0xa8, 0x93, 0x91, 0x6f, 0x96, 0xbe, 0x58, 0x34, 0xfc, 0xdf, 0xde,
+	0x8f, 0xdc, 0xd0, 0xff, 0xcf, 0x52, 0x69, 0x77, 0x9c, 0xb6, 0x7c, 0xff, 0xff, 0x02, 0x00, 0x00,
+	0xff, 0xff, 0x83, 0x10, 0x2e, 0x10, 0x70, 0x58, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PixurServiceClient is the client API for PixurService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PixurServiceClient interface {
+	AddCollectionPic(ctx context.Context, in *AddCollectionPicRequest, opts ...grpc.CallOption) (*AddCollectionPicResponse, error)
+	AddPicComment(ctx context.Context, in *AddPicCommentRequest, opts ...grpc.CallOption) (*AddPicCommentResponse, error)
+	AddPicFavorite(ctx context.Context, in *AddPicFavoriteRequest, opts ...grpc.CallOption) (*AddPicFavoriteResponse, error)
+	AddPicTags(ctx context.Context, in *AddPicTagsRequest, opts ...grpc.CallOption) (*AddPicTagsResponse, error)
+	AddTagFollow(ctx context.Context, in *AddTagFollowRequest, opts ...grpc.CallOption) (*AddTagFollowResponse, error)
+	AddUserFollow(ctx context.Context, in *AddUserFollowRequest, opts ...grpc.CallOption) (*AddUserFollowResponse, error)
+	AppendUploadSession(ctx context.Context, in *AppendUploadSessionRequest, opts ...grpc.CallOption) (*AppendUploadSessionResponse, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
+	CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*CreateInviteCodeResponse, error)
+	CreatePool(ctx context.Context, in *CreatePoolRequest, opts ...grpc.CallOption) (*CreatePoolResponse, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	DeleteApiKey(ctx context.Context, in *DeleteApiKeyRequest, opts ...grpc.CallOption) (*DeleteApiKeyResponse, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	DeletePicComment(ctx context.Context, in *DeletePicCommentRequest, opts ...grpc.CallOption) (*DeletePicCommentResponse, error)
+	DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*DeleteTokenResponse, error)
+	DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*DisableTotpResponse, error)
+	EditPicComment(ctx context.Context, in *EditPicCommentRequest, opts ...grpc.CallOption) (*EditPicCommentResponse, error)
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (PixurService_ExportMyDataClient, error)
+	FindApiKeys(ctx context.Context, in *FindApiKeysRequest, opts ...grpc.CallOption) (*FindApiKeysResponse, error)
+	FindAuditLog(ctx context.Context, in *FindAuditLogRequest, opts ...grpc.CallOption) (*FindAuditLogResponse, error)
+	FindCollections(ctx context.Context, in *FindCollectionsRequest, opts ...grpc.CallOption) (*FindCollectionsResponse, error)
+	FindFeedPics(ctx context.Context, in *FindFeedPicsRequest, opts ...grpc.CallOption) (*FindFeedPicsResponse, error)
+	FindFollows(ctx context.Context, in *FindFollowsRequest, opts ...grpc.CallOption) (*FindFollowsResponse, error)
+	FindIndexPics(ctx context.Context, in *FindIndexPicsRequest, opts ...grpc.CallOption) (*FindIndexPicsResponse, error)
+	FindPicCommentVotes(ctx context.Context, in *FindPicCommentVotesRequest, opts ...grpc.CallOption) (*FindPicCommentVotesResponse, error)
+	FindPicFavorites(ctx context.Context, in *FindPicFavoritesRequest, opts ...grpc.CallOption) (*FindPicFavoritesResponse, error)
+	FindPicsByColor(ctx context.Context, in *FindPicsByColorRequest, opts ...grpc.CallOption) (*FindPicsByColorResponse, error)
+	FindPools(ctx context.Context, in *FindPoolsRequest, opts ...grpc.CallOption) (*FindPoolsResponse, error)
 	FindSchedPics(ctx context.Context, in *FindSchedPicsRequest, opts ...grpc.CallOption) (*FindSchedPicsResponse, error)
 	FindSimilarPics(ctx context.Context, in *FindSimilarPicsRequest, opts ...grpc.CallOption) (*FindSimilarPicsResponse, error)
 	FindUserEvents(ctx context.Context, in *FindUserEventsRequest, opts ...grpc.CallOption) (*FindUserEventsResponse, error)
+	FinishTotpEnrollment(ctx context.Context, in *FinishTotpEnrollmentRequest, opts ...grpc.CallOption) (*FinishTotpEnrollmentResponse, error)
+	FinishUploadSession(ctx context.Context, in *FinishUploadSessionRequest, opts ...grpc.CallOption) (*FinishUploadSessionResponse, error)
+	FinishUserSecretReset(ctx context.Context, in *FinishUserSecretResetRequest, opts ...grpc.CallOption) (*FinishUserSecretResetResponse, error)
 	GetRefreshToken(ctx context.Context, in *GetRefreshTokenRequest, opts ...grpc.CallOption) (*GetRefreshTokenResponse, error)
 	IncrementViewCount(ctx context.Context, in *IncrementViewCountRequest, opts ...grpc.CallOption) (*IncrementViewCountResponse, error)
+	InsertPoolPic(ctx context.Context, in *InsertPoolPicRequest, opts ...grpc.CallOption) (*InsertPoolPicResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	LookupCollection(ctx context.Context, in *LookupCollectionRequest, opts ...grpc.CallOption) (*LookupCollectionResponse, error)
 	LookupPicCommentVote(ctx context.Context, in *LookupPicCommentVoteRequest, opts ...grpc.CallOption) (*LookupPicCommentVoteResponse, error)
 	LookupPicDetails(ctx context.Context, in *LookupPicDetailsRequest, opts ...grpc.CallOption) (*LookupPicDetailsResponse, error)
 	LookupPicExtension(ctx context.Context, in *LookupPicExtensionRequest, opts ...grpc.CallOption) (*LookupPicExtensionResponse, error)
 	LookupPicFile(ctx context.Context, in *LookupPicFileRequest, opts ...grpc.CallOption) (*LookupPicFileResponse, error)
 	LookupPicVote(ctx context.Context, in *LookupPicVoteRequest, opts ...grpc.CallOption) (*LookupPicVoteResponse, error)
+	LookupPool(ctx context.Context, in *LookupPoolRequest, opts ...grpc.CallOption) (*LookupPoolResponse, error)
 	LookupPublicUserInfo(ctx context.Context, in *LookupPublicUserInfoRequest, opts ...grpc.CallOption) (*LookupPublicUserInfoResponse, error)
+	LookupUploadSession(ctx context.Context, in *LookupUploadSessionRequest, opts ...grpc.CallOption) (*LookupUploadSessionResponse, error)
 	LookupUser(ctx context.Context, in *LookupUserRequest, opts ...grpc.CallOption) (*LookupUserResponse, error)
+	MovePoolPic(ctx context.Context, in *MovePoolPicRequest, opts ...grpc.CallOption) (*MovePoolPicResponse, error)
 	PurgePic(ctx context.Context, in *PurgePicRequest, opts ...grpc.CallOption) (*PurgePicResponse, error)
 	ReadPicFile(ctx context.Context, opts ...grpc.CallOption) (PixurService_ReadPicFileClient, error)
+	RemoveCollectionPic(ctx context.Context, in *RemoveCollectionPicRequest, opts ...grpc.CallOption) (*RemoveCollectionPicResponse, error)
+	RemovePicFavorite(ctx context.Context, in *RemovePicFavoriteRequest, opts ...grpc.CallOption) (*RemovePicFavoriteResponse, error)
+	RemovePoolPic(ctx context.Context, in *RemovePoolPicRequest, opts ...grpc.CallOption) (*RemovePoolPicResponse, error)
+	RemoveTagFollow(ctx context.Context, in *RemoveTagFollowRequest, opts ...grpc.CallOption) (*RemoveTagFollowResponse, error)
+	RemoveUserFollow(ctx context.Context, in *RemoveUserFollowRequest, opts ...grpc.CallOption) (*RemoveUserFollowResponse, error)
+	ReorderCollection(ctx context.Context, in *ReorderCollectionRequest, opts ...grpc.CallOption) (*ReorderCollectionResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
 	SoftDeletePic(ctx context.Context, in *SoftDeletePicRequest, opts ...grpc.CallOption) (*SoftDeletePicResponse, error)
+	StartTotpEnrollment(ctx context.Context, in *StartTotpEnrollmentRequest, opts ...grpc.CallOption) (*StartTotpEnrollmentResponse, error)
+	StartUploadSession(ctx context.Context, in *StartUploadSessionRequest, opts ...grpc.CallOption) (*StartUploadSessionResponse, error)
+	StartUserSecretReset(ctx context.Context, in *StartUserSecretResetRequest, opts ...grpc.CallOption) (*StartUserSecretResetResponse, error)
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error)
+	UnlockLogin(ctx context.Context, in *UnlockLoginRequest, opts ...grpc.CallOption) (*UnlockLoginResponse, error)
+	UnsuspendUser(ctx context.Context, in *UnsuspendUserRequest, opts ...grpc.CallOption) (*UnsuspendUserResponse, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	UpdateUserSecret(ctx context.Context, in *UpdateUserSecretRequest, opts ...grpc.CallOption) (*UpdateUserSecretResponse, error)
 	UpsertPic(ctx context.Context, in *UpsertPicRequest, opts ...grpc.CallOption) (*UpsertPicResponse, error)
 	UpsertPicCommentVote(ctx context.Context, in *UpsertPicCommentVoteRequest, opts ...grpc.CallOption) (*UpsertPicCommentVoteResponse, error)
+	UpsertPicStream(ctx context.Context, opts ...grpc.CallOption) (PixurService_UpsertPicStreamClient, error)
 	UpsertPicVote(ctx context.Context, in *UpsertPicVoteRequest, opts ...grpc.CallOption) (*UpsertPicVoteResponse, error)
 	WatchBackendConfiguration(ctx context.Context, in *WatchBackendConfigurationRequest, opts ...grpc.CallOption) (PixurService_WatchBackendConfigurationClient, error)
 }
 
-type pixurServiceClient struct {
-	cc *grpc.ClientConn
+type pixurServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPixurServiceClient(cc *grpc.ClientConn) PixurServiceClient {
+	return &pixurServiceClient{cc}
+}
+
+func (c *pixurServiceClient) AddCollectionPic(ctx context.Context, in *AddCollectionPicRequest, opts ...grpc.CallOption) (*AddCollectionPicResponse, error) {
+	out := new(AddCollectionPicResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/AddCollectionPic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) AddPicComment(ctx context.Context, in *AddPicCommentRequest, opts ...grpc.CallOption) (*AddPicCommentResponse, error) {
+	out := new(AddPicCommentResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/AddPicComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) AddPicFavorite(ctx context.Context, in *AddPicFavoriteRequest, opts ...grpc.CallOption) (*AddPicFavoriteResponse, error) {
+	out := new(AddPicFavoriteResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/AddPicFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) AddPicTags(ctx context.Context, in *AddPicTagsRequest, opts ...grpc.CallOption) (*AddPicTagsResponse, error) {
+	out := new(AddPicTagsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/AddPicTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) AddTagFollow(ctx context.Context, in *AddTagFollowRequest, opts ...grpc.CallOption) (*AddTagFollowResponse, error) {
+	out := new(AddTagFollowResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/AddTagFollow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) AddUserFollow(ctx context.Context, in *AddUserFollowRequest, opts ...grpc.CallOption) (*AddUserFollowResponse, error) {
+	out := new(AddUserFollowResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/AddUserFollow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) AppendUploadSession(ctx context.Context, in *AppendUploadSessionRequest, opts ...grpc.CallOption) (*AppendUploadSessionResponse, error) {
+	out := new(AppendUploadSessionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/AppendUploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
+	out := new(CreateCollectionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*CreateInviteCodeResponse, error) {
+	out := new(CreateInviteCodeResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CreateInviteCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) CreatePool(ctx context.Context, in *CreatePoolRequest, opts ...grpc.CallOption) (*CreatePoolResponse, error) {
+	out := new(CreatePoolResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CreatePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) DeleteApiKey(ctx context.Context, in *DeleteApiKeyRequest, opts ...grpc.CallOption) (*DeleteApiKeyResponse, error) {
+	out := new(DeleteApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DeleteApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DeleteCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) DeletePicComment(ctx context.Context, in *DeletePicCommentRequest, opts ...grpc.CallOption) (*DeletePicCommentResponse, error) {
+	out := new(DeletePicCommentResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DeletePicComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*DeleteTokenResponse, error) {
+	out := new(DeleteTokenResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DeleteToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*DisableTotpResponse, error) {
+	out := new(DisableTotpResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DisableTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) EditPicComment(ctx context.Context, in *EditPicCommentRequest, opts ...grpc.CallOption) (*EditPicCommentResponse, error) {
+	out := new(EditPicCommentResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/EditPicComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (PixurService_ExportMyDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PixurService_serviceDesc.Streams[0], "/pixur.api.PixurService/ExportMyData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pixurServiceExportMyDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PixurService_ExportMyDataClient interface {
+	Recv() (*ExportMyDataResponse, error)
+	grpc.ClientStream
+}
+
+type pixurServiceExportMyDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *pixurServiceExportMyDataClient) Recv() (*ExportMyDataResponse, error) {
+	m := new(ExportMyDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pixurServiceClient) FindApiKeys(ctx context.Context, in *FindApiKeysRequest, opts ...grpc.CallOption) (*FindApiKeysResponse, error) {
+	out := new(FindApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindAuditLog(ctx context.Context, in *FindAuditLogRequest, opts ...grpc.CallOption) (*FindAuditLogResponse, error) {
+	out := new(FindAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindCollections(ctx context.Context, in *FindCollectionsRequest, opts ...grpc.CallOption) (*FindCollectionsResponse, error) {
+	out := new(FindCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindFeedPics(ctx context.Context, in *FindFeedPicsRequest, opts ...grpc.CallOption) (*FindFeedPicsResponse, error) {
+	out := new(FindFeedPicsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindFeedPics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindFollows(ctx context.Context, in *FindFollowsRequest, opts ...grpc.CallOption) (*FindFollowsResponse, error) {
+	out := new(FindFollowsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindFollows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindIndexPics(ctx context.Context, in *FindIndexPicsRequest, opts ...grpc.CallOption) (*FindIndexPicsResponse, error) {
+	out := new(FindIndexPicsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindIndexPics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindPicCommentVotes(ctx context.Context, in *FindPicCommentVotesRequest, opts ...grpc.CallOption) (*FindPicCommentVotesResponse, error) {
+	out := new(FindPicCommentVotesResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindPicCommentVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindPicFavorites(ctx context.Context, in *FindPicFavoritesRequest, opts ...grpc.CallOption) (*FindPicFavoritesResponse, error) {
+	out := new(FindPicFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindPicFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindPicsByColor(ctx context.Context, in *FindPicsByColorRequest, opts ...grpc.CallOption) (*FindPicsByColorResponse, error) {
+	out := new(FindPicsByColorResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindPicsByColor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindPools(ctx context.Context, in *FindPoolsRequest, opts ...grpc.CallOption) (*FindPoolsResponse, error) {
+	out := new(FindPoolsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindSchedPics(ctx context.Context, in *FindSchedPicsRequest, opts ...grpc.CallOption) (*FindSchedPicsResponse, error) {
+	out := new(FindSchedPicsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindSchedPics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindSimilarPics(ctx context.Context, in *FindSimilarPicsRequest, opts ...grpc.CallOption) (*FindSimilarPicsResponse, error) {
+	out := new(FindSimilarPicsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindSimilarPics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindUserEvents(ctx context.Context, in *FindUserEventsRequest, opts ...grpc.CallOption) (*FindUserEventsResponse, error) {
+	out := new(FindUserEventsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindUserEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FinishTotpEnrollment(ctx context.Context, in *FinishTotpEnrollmentRequest, opts ...grpc.CallOption) (*FinishTotpEnrollmentResponse, error) {
+	out := new(FinishTotpEnrollmentResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FinishTotpEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FinishUploadSession(ctx context.Context, in *FinishUploadSessionRequest, opts ...grpc.CallOption) (*FinishUploadSessionResponse, error) {
+	out := new(FinishUploadSessionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FinishUploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FinishUserSecretReset(ctx context.Context, in *FinishUserSecretResetRequest, opts ...grpc.CallOption) (*FinishUserSecretResetResponse, error) {
+	out := new(FinishUserSecretResetResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FinishUserSecretReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) GetRefreshToken(ctx context.Context, in *GetRefreshTokenRequest, opts ...grpc.CallOption) (*GetRefreshTokenResponse, error) {
+	out := new(GetRefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/GetRefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) IncrementViewCount(ctx context.Context, in *IncrementViewCountRequest, opts ...grpc.CallOption) (*IncrementViewCountResponse, error) {
+	out := new(IncrementViewCountResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/IncrementViewCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) InsertPoolPic(ctx context.Context, in *InsertPoolPicRequest, opts ...grpc.CallOption) (*InsertPoolPicResponse, error) {
+	out := new(InsertPoolPicResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/InsertPoolPic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupCollection(ctx context.Context, in *LookupCollectionRequest, opts ...grpc.CallOption) (*LookupCollectionResponse, error) {
+	out := new(LookupCollectionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupPicCommentVote(ctx context.Context, in *LookupPicCommentVoteRequest, opts ...grpc.CallOption) (*LookupPicCommentVoteResponse, error) {
+	out := new(LookupPicCommentVoteResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicCommentVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupPicDetails(ctx context.Context, in *LookupPicDetailsRequest, opts ...grpc.CallOption) (*LookupPicDetailsResponse, error) {
+	out := new(LookupPicDetailsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupPicExtension(ctx context.Context, in *LookupPicExtensionRequest, opts ...grpc.CallOption) (*LookupPicExtensionResponse, error) {
+	out := new(LookupPicExtensionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupPicFile(ctx context.Context, in *LookupPicFileRequest, opts ...grpc.CallOption) (*LookupPicFileResponse, error) {
+	out := new(LookupPicFileResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupPicVote(ctx context.Context, in *LookupPicVoteRequest, opts ...grpc.CallOption) (*LookupPicVoteResponse, error) {
+	out := new(LookupPicVoteResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupPool(ctx context.Context, in *LookupPoolRequest, opts ...grpc.CallOption) (*LookupPoolResponse, error) {
+	out := new(LookupPoolResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupPublicUserInfo(ctx context.Context, in *LookupPublicUserInfoRequest, opts ...grpc.CallOption) (*LookupPublicUserInfoResponse, error) {
+	out := new(LookupPublicUserInfoResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPublicUserInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupUploadSession(ctx context.Context, in *LookupUploadSessionRequest, opts ...grpc.CallOption) (*LookupUploadSessionResponse, error) {
+	out := new(LookupUploadSessionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupUploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupUser(ctx context.Context, in *LookupUserRequest, opts ...grpc.CallOption) (*LookupUserResponse, error) {
+	out := new(LookupUserResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) MovePoolPic(ctx context.Context, in *MovePoolPicRequest, opts ...grpc.CallOption) (*MovePoolPicResponse, error) {
+	out := new(MovePoolPicResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/MovePoolPic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) PurgePic(ctx context.Context, in *PurgePicRequest, opts ...grpc.CallOption) (*PurgePicResponse, error) {
+	out := new(PurgePicResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/PurgePic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) ReadPicFile(ctx context.Context, opts ...grpc.CallOption) (PixurService_ReadPicFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PixurService_serviceDesc.Streams[1], "/pixur.api.PixurService/ReadPicFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pixurServiceReadPicFileClient{stream}
+	return x, nil
+}
+
+type PixurService_ReadPicFileClient interface {
+	Send(*ReadPicFileRequest) error
+	Recv() (*ReadPicFileResponse, error)
+	grpc.ClientStream
+}
+
+type pixurServiceReadPicFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *pixurServiceReadPicFileClient) Send(m *ReadPicFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pixurServiceReadPicFileClient) Recv() (*ReadPicFileResponse, error) {
+	m := new(ReadPicFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pixurServiceClient) RemoveCollectionPic(ctx context.Context, in *RemoveCollectionPicRequest, opts ...grpc.CallOption) (*RemoveCollectionPicResponse, error) {
+	out := new(RemoveCollectionPicResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RemoveCollectionPic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) RemovePicFavorite(ctx context.Context, in *RemovePicFavoriteRequest, opts ...grpc.CallOption) (*RemovePicFavoriteResponse, error) {
+	out := new(RemovePicFavoriteResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RemovePicFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) RemovePoolPic(ctx context.Context, in *RemovePoolPicRequest, opts ...grpc.CallOption) (*RemovePoolPicResponse, error) {
+	out := new(RemovePoolPicResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RemovePoolPic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) RemoveTagFollow(ctx context.Context, in *RemoveTagFollowRequest, opts ...grpc.CallOption) (*RemoveTagFollowResponse, error) {
+	out := new(RemoveTagFollowResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RemoveTagFollow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) RemoveUserFollow(ctx context.Context, in *RemoveUserFollowRequest, opts ...grpc.CallOption) (*RemoveUserFollowResponse, error) {
+	out := new(RemoveUserFollowResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RemoveUserFollow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) ReorderCollection(ctx context.Context, in *ReorderCollectionRequest, opts ...grpc.CallOption) (*ReorderCollectionResponse, error) {
+	out := new(ReorderCollectionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/ReorderCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) SoftDeletePic(ctx context.Context, in *SoftDeletePicRequest, opts ...grpc.CallOption) (*SoftDeletePicResponse, error) {
+	out := new(SoftDeletePicResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/SoftDeletePic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) StartTotpEnrollment(ctx context.Context, in *StartTotpEnrollmentRequest, opts ...grpc.CallOption) (*StartTotpEnrollmentResponse, error) {
+	out := new(StartTotpEnrollmentResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/StartTotpEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) StartUploadSession(ctx context.Context, in *StartUploadSessionRequest, opts ...grpc.CallOption) (*StartUploadSessionResponse, error) {
+	out := new(StartUploadSessionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/StartUploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) StartUserSecretReset(ctx context.Context, in *StartUserSecretResetRequest, opts ...grpc.CallOption) (*StartUserSecretResetResponse, error) {
+	out := new(StartUserSecretResetResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/StartUserSecretReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error) {
+	out := new(SuspendUserResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/SuspendUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) UnlockLogin(ctx context.Context, in *UnlockLoginRequest, opts ...grpc.CallOption) (*UnlockLoginResponse, error) {
+	out := new(UnlockLoginResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UnlockLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) UnsuspendUser(ctx context.Context, in *UnsuspendUserRequest, opts ...grpc.CallOption) (*UnsuspendUserResponse, error) {
+	out := new(UnsuspendUserResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UnsuspendUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
+	out := new(UpdateCollectionResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpdateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpdateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) UpdateUserSecret(ctx context.Context, in *UpdateUserSecretRequest, opts ...grpc.CallOption) (*UpdateUserSecretResponse, error) {
+	out := new(UpdateUserSecretResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpdateUserSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) UpsertPic(ctx context.Context, in *UpsertPicRequest, opts ...grpc.CallOption) (*UpsertPicResponse, error) {
+	out := new(UpsertPicResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpsertPic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) UpsertPicCommentVote(ctx context.Context, in *UpsertPicCommentVoteRequest, opts ...grpc.CallOption) (*UpsertPicCommentVoteResponse, error) {
+	out := new(UpsertPicCommentVoteResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpsertPicCommentVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) UpsertPicStream(ctx context.Context, opts ...grpc.CallOption) (PixurService_UpsertPicStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PixurService_serviceDesc.Streams[2], "/pixur.api.PixurService/UpsertPicStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pixurServiceUpsertPicStreamClient{stream}
+	return x, nil
+}
+
+type PixurService_UpsertPicStreamClient interface {
+	Send(*UpsertPicStreamRequest) error
+	CloseAndRecv() (*UpsertPicStreamResponse, error)
+	grpc.ClientStream
+}
+
+type pixurServiceUpsertPicStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *pixurServiceUpsertPicStreamClient) Send(m *UpsertPicStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pixurServiceUpsertPicStreamClient) CloseAndRecv() (*UpsertPicStreamResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UpsertPicStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pixurServiceClient) UpsertPicVote(ctx context.Context, in *UpsertPicVoteRequest, opts ...grpc.CallOption) (*UpsertPicVoteResponse, error) {
+	out := new(UpsertPicVoteResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpsertPicVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) WatchBackendConfiguration(ctx context.Context, in *WatchBackendConfigurationRequest, opts ...grpc.CallOption) (PixurService_WatchBackendConfigurationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PixurService_serviceDesc.Streams[3], "/pixur.api.PixurService/WatchBackendConfiguration", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pixurServiceWatchBackendConfigurationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PixurService_WatchBackendConfigurationClient interface {
+	Recv() (*WatchBackendConfigurationResponse, error)
+	grpc.ClientStream
+}
+
+type pixurServiceWatchBackendConfigurationClient struct {
+	grpc.ClientStream
+}
+
+func (x *pixurServiceWatchBackendConfigurationClient) Recv() (*WatchBackendConfigurationResponse, error) {
+	m := new(WatchBackendConfigurationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PixurServiceServer is the server API for PixurService service.
+type PixurServiceServer interface {
+	AddCollectionPic(context.Context, *AddCollectionPicRequest) (*AddCollectionPicResponse, error)
+	AddPicComment(context.Context, *AddPicCommentRequest) (*AddPicCommentResponse, error)
+	AddPicFavorite(context.Context, *AddPicFavoriteRequest) (*AddPicFavoriteResponse, error)
+	AddPicTags(context.Context, *AddPicTagsRequest) (*AddPicTagsResponse, error)
+	AddTagFollow(context.Context, *AddTagFollowRequest) (*AddTagFollowResponse, error)
+	AddUserFollow(context.Context, *AddUserFollowRequest) (*AddUserFollowResponse, error)
+	AppendUploadSession(context.Context, *AppendUploadSessionRequest) (*AppendUploadSessionResponse, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*CreateInviteCodeResponse, error)
+	CreatePool(context.Context, *CreatePoolRequest) (*CreatePoolResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	DeleteApiKey(context.Context, *DeleteApiKeyRequest) (*DeleteApiKeyResponse, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	DeletePicComment(context.Context, *DeletePicCommentRequest) (*DeletePicCommentResponse, error)
+	DeleteToken(context.Context, *DeleteTokenRequest) (*DeleteTokenResponse, error)
+	DisableTotp(context.Context, *DisableTotpRequest) (*DisableTotpResponse, error)
+	EditPicComment(context.Context, *EditPicCommentRequest) (*EditPicCommentResponse, error)
+	ExportMyData(*ExportMyDataRequest, PixurService_ExportMyDataServer) error
+	FindApiKeys(context.Context, *FindApiKeysRequest) (*FindApiKeysResponse, error)
+	FindAuditLog(context.Context, *FindAuditLogRequest) (*FindAuditLogResponse, error)
+	FindCollections(context.Context, *FindCollectionsRequest) (*FindCollectionsResponse, error)
+	FindFeedPics(context.Context, *FindFeedPicsRequest) (*FindFeedPicsResponse, error)
+	FindFollows(context.Context, *FindFollowsRequest) (*FindFollowsResponse, error)
+	FindIndexPics(context.Context, *FindIndexPicsRequest) (*FindIndexPicsResponse, error)
+	FindPicCommentVotes(context.Context, *FindPicCommentVotesRequest) (*FindPicCommentVotesResponse, error)
+	FindPicFavorites(context.Context, *FindPicFavoritesRequest) (*FindPicFavoritesResponse, error)
+	FindPicsByColor(context.Context, *FindPicsByColorRequest) (*FindPicsByColorResponse, error)
+	FindPools(context.Context, *FindPoolsRequest) (*FindPoolsResponse, error)
+	FindSchedPics(context.Context, *FindSchedPicsRequest) (*FindSchedPicsResponse, error)
+	FindSimilarPics(context.Context, *FindSimilarPicsRequest) (*FindSimilarPicsResponse, error)
+	FindUserEvents(context.Context, *FindUserEventsRequest) (*FindUserEventsResponse, error)
+	FinishTotpEnrollment(context.Context, *FinishTotpEnrollmentRequest) (*FinishTotpEnrollmentResponse, error)
+	FinishUploadSession(context.Context, *FinishUploadSessionRequest) (*FinishUploadSessionResponse, error)
+	FinishUserSecretReset(context.Context, *FinishUserSecretResetRequest) (*FinishUserSecretResetResponse, error)
+	GetRefreshToken(context.Context, *GetRefreshTokenRequest) (*GetRefreshTokenResponse, error)
+	IncrementViewCount(context.Context, *IncrementViewCountRequest) (*IncrementViewCountResponse, error)
+	InsertPoolPic(context.Context, *InsertPoolPicRequest) (*InsertPoolPicResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	LookupCollection(context.Context, *LookupCollectionRequest) (*LookupCollectionResponse, error)
+	LookupPicCommentVote(context.Context, *LookupPicCommentVoteRequest) (*LookupPicCommentVoteResponse, error)
+	LookupPicDetails(context.Context, *LookupPicDetailsRequest) (*LookupPicDetailsResponse, error)
+	LookupPicExtension(context.Context, *LookupPicExtensionRequest) (*LookupPicExtensionResponse, error)
+	LookupPicFile(context.Context, *LookupPicFileRequest) (*LookupPicFileResponse, error)
+	LookupPicVote(context.Context, *LookupPicVoteRequest) (*LookupPicVoteResponse, error)
+	LookupPool(context.Context, *LookupPoolRequest) (*LookupPoolResponse, error)
+	LookupPublicUserInfo(context.Context, *LookupPublicUserInfoRequest) (*LookupPublicUserInfoResponse, error)
+	LookupUploadSession(context.Context, *LookupUploadSessionRequest) (*LookupUploadSessionResponse, error)
+	LookupUser(context.Context, *LookupUserRequest) (*LookupUserResponse, error)
+	MovePoolPic(context.Context, *MovePoolPicRequest) (*MovePoolPicResponse, error)
+	PurgePic(context.Context, *PurgePicRequest) (*PurgePicResponse, error)
+	ReadPicFile(PixurService_ReadPicFileServer) error
+	RemoveCollectionPic(context.Context, *RemoveCollectionPicRequest) (*RemoveCollectionPicResponse, error)
+	RemovePicFavorite(context.Context, *RemovePicFavoriteRequest) (*RemovePicFavoriteResponse, error)
+	RemovePoolPic(context.Context, *RemovePoolPicRequest) (*RemovePoolPicResponse, error)
+	RemoveTagFollow(context.Context, *RemoveTagFollowRequest) (*RemoveTagFollowResponse, error)
+	RemoveUserFollow(context.Context, *RemoveUserFollowRequest) (*RemoveUserFollowResponse, error)
+	ReorderCollection(context.Context, *ReorderCollectionRequest) (*ReorderCollectionResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	SoftDeletePic(context.Context, *SoftDeletePicRequest) (*SoftDeletePicResponse, error)
+	StartTotpEnrollment(context.Context, *StartTotpEnrollmentRequest) (*StartTotpEnrollmentResponse, error)
+	StartUploadSession(context.Context, *StartUploadSessionRequest) (*StartUploadSessionResponse, error)
+	StartUserSecretReset(context.Context, *StartUserSecretResetRequest) (*StartUserSecretResetResponse, error)
+	SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error)
+	UnlockLogin(context.Context, *UnlockLoginRequest) (*UnlockLoginResponse, error)
+	UnsuspendUser(context.Context, *UnsuspendUserRequest) (*UnsuspendUserResponse, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	UpdateUserSecret(context.Context, *UpdateUserSecretRequest) (*UpdateUserSecretResponse, error)
+	UpsertPic(context.Context, *UpsertPicRequest) (*UpsertPicResponse, error)
+	UpsertPicCommentVote(context.Context, *UpsertPicCommentVoteRequest) (*UpsertPicCommentVoteResponse, error)
+	UpsertPicStream(PixurService_UpsertPicStreamServer) error
+	UpsertPicVote(context.Context, *UpsertPicVoteRequest) (*UpsertPicVoteResponse, error)
+	WatchBackendConfiguration(*WatchBackendConfigurationRequest, PixurService_WatchBackendConfigurationServer) error
+}
+
+// UnimplementedPixurServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPixurServiceServer struct {
+}
+
+func (*UnimplementedPixurServiceServer) AddCollectionPic(ctx context.Context, req *AddCollectionPicRequest) (*AddCollectionPicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionPic not implemented")
+}
+func (*UnimplementedPixurServiceServer) AddPicComment(ctx context.Context, req *AddPicCommentRequest) (*AddPicCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPicComment not implemented")
+}
+func (*UnimplementedPixurServiceServer) AddPicFavorite(ctx context.Context, req *AddPicFavoriteRequest) (*AddPicFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPicFavorite not implemented")
+}
+func (*UnimplementedPixurServiceServer) AddPicTags(ctx context.Context, req *AddPicTagsRequest) (*AddPicTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPicTags not implemented")
+}
+func (*UnimplementedPixurServiceServer) AddTagFollow(ctx context.Context, req *AddTagFollowRequest) (*AddTagFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTagFollow not implemented")
+}
+func (*UnimplementedPixurServiceServer) AddUserFollow(ctx context.Context, req *AddUserFollowRequest) (*AddUserFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserFollow not implemented")
+}
+func (*UnimplementedPixurServiceServer) AppendUploadSession(ctx context.Context, req *AppendUploadSessionRequest) (*AppendUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendUploadSession not implemented")
+}
+func (*UnimplementedPixurServiceServer) CreateApiKey(ctx context.Context, req *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (*UnimplementedPixurServiceServer) CreateCollection(ctx context.Context, req *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (*UnimplementedPixurServiceServer) CreateInviteCode(ctx context.Context, req *CreateInviteCodeRequest) (*CreateInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInviteCode not implemented")
+}
+func (*UnimplementedPixurServiceServer) CreatePool(ctx context.Context, req *CreatePoolRequest) (*CreatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+}
+func (*UnimplementedPixurServiceServer) CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (*UnimplementedPixurServiceServer) DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (*UnimplementedPixurServiceServer) DeleteApiKey(ctx context.Context, req *DeleteApiKeyRequest) (*DeleteApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiKey not implemented")
+}
+func (*UnimplementedPixurServiceServer) DeleteCollection(ctx context.Context, req *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (*UnimplementedPixurServiceServer) DeletePicComment(ctx context.Context, req *DeletePicCommentRequest) (*DeletePicCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePicComment not implemented")
+}
+func (*UnimplementedPixurServiceServer) DeleteToken(ctx context.Context, req *DeleteTokenRequest) (*DeleteTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
+}
+func (*UnimplementedPixurServiceServer) DisableTotp(ctx context.Context, req *DisableTotpRequest) (*DisableTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTotp not implemented")
+}
+func (*UnimplementedPixurServiceServer) EditPicComment(ctx context.Context, req *EditPicCommentRequest) (*EditPicCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPicComment not implemented")
+}
+func (*UnimplementedPixurServiceServer) ExportMyData(req *ExportMyDataRequest, srv PixurService_ExportMyDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindApiKeys(ctx context.Context, req *FindApiKeysRequest) (*FindApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindApiKeys not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindAuditLog(ctx context.Context, req *FindAuditLogRequest) (*FindAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAuditLog not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindCollections(ctx context.Context, req *FindCollectionsRequest) (*FindCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindCollections not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindFeedPics(ctx context.Context, req *FindFeedPicsRequest) (*FindFeedPicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFeedPics not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindFollows(ctx context.Context, req *FindFollowsRequest) (*FindFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFollows not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindIndexPics(ctx context.Context, req *FindIndexPicsRequest) (*FindIndexPicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindIndexPics not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindPicCommentVotes(ctx context.Context, req *FindPicCommentVotesRequest) (*FindPicCommentVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPicCommentVotes not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindPicFavorites(ctx context.Context, req *FindPicFavoritesRequest) (*FindPicFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPicFavorites not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindPicsByColor(ctx context.Context, req *FindPicsByColorRequest) (*FindPicsByColorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPicsByColor not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindPools(ctx context.Context, req *FindPoolsRequest) (*FindPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPools not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindSchedPics(ctx context.Context, req *FindSchedPicsRequest) (*FindSchedPicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSchedPics not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindSimilarPics(ctx context.Context, req *FindSimilarPicsRequest) (*FindSimilarPicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSimilarPics not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindUserEvents(ctx context.Context, req *FindUserEventsRequest) (*FindUserEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUserEvents not implemented")
+}
+func (*UnimplementedPixurServiceServer) FinishTotpEnrollment(ctx context.Context, req *FinishTotpEnrollmentRequest) (*FinishTotpEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishTotpEnrollment not implemented")
+}
+func (*UnimplementedPixurServiceServer) FinishUploadSession(ctx context.Context, req *FinishUploadSessionRequest) (*FinishUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishUploadSession not implemented")
+}
+func (*UnimplementedPixurServiceServer) FinishUserSecretReset(ctx context.Context, req *FinishUserSecretResetRequest) (*FinishUserSecretResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishUserSecretReset not implemented")
+}
+func (*UnimplementedPixurServiceServer) GetRefreshToken(ctx context.Context, req *GetRefreshTokenRequest) (*GetRefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefreshToken not implemented")
+}
+func (*UnimplementedPixurServiceServer) IncrementViewCount(ctx context.Context, req *IncrementViewCountRequest) (*IncrementViewCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrementViewCount not implemented")
+}
+func (*UnimplementedPixurServiceServer) InsertPoolPic(ctx context.Context, req *InsertPoolPicRequest) (*InsertPoolPicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertPoolPic not implemented")
+}
+func (*UnimplementedPixurServiceServer) ListSessions(ctx context.Context, req *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupCollection(ctx context.Context, req *LookupCollectionRequest) (*LookupCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupCollection not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupPicCommentVote(ctx context.Context, req *LookupPicCommentVoteRequest) (*LookupPicCommentVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPicCommentVote not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupPicDetails(ctx context.Context, req *LookupPicDetailsRequest) (*LookupPicDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPicDetails not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupPicExtension(ctx context.Context, req *LookupPicExtensionRequest) (*LookupPicExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPicExtension not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupPicFile(ctx context.Context, req *LookupPicFileRequest) (*LookupPicFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPicFile not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupPicVote(ctx context.Context, req *LookupPicVoteRequest) (*LookupPicVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPicVote not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupPool(ctx context.Context, req *LookupPoolRequest) (*LookupPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPool not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupPublicUserInfo(ctx context.Context, req *LookupPublicUserInfoRequest) (*LookupPublicUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPublicUserInfo not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupUploadSession(ctx context.Context, req *LookupUploadSessionRequest) (*LookupUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupUploadSession not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupUser(ctx context.Context, req *LookupUserRequest) (*LookupUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupUser not implemented")
+}
+func (*UnimplementedPixurServiceServer) MovePoolPic(ctx context.Context, req *MovePoolPicRequest) (*MovePoolPicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePoolPic not implemented")
+}
+func (*UnimplementedPixurServiceServer) PurgePic(ctx context.Context, req *PurgePicRequest) (*PurgePicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgePic not implemented")
+}
+func (*UnimplementedPixurServiceServer) ReadPicFile(srv PixurService_ReadPicFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadPicFile not implemented")
+}
+func (*UnimplementedPixurServiceServer) RemoveCollectionPic(ctx context.Context, req *RemoveCollectionPicRequest) (*RemoveCollectionPicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollectionPic not implemented")
+}
+func (*UnimplementedPixurServiceServer) RemovePicFavorite(ctx context.Context, req *RemovePicFavoriteRequest) (*RemovePicFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePicFavorite not implemented")
+}
+func (*UnimplementedPixurServiceServer) RemovePoolPic(ctx context.Context, req *RemovePoolPicRequest) (*RemovePoolPicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePoolPic not implemented")
+}
+func (*UnimplementedPixurServiceServer) RemoveTagFollow(ctx context.Context, req *RemoveTagFollowRequest) (*RemoveTagFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTagFollow not implemented")
+}
+func (*UnimplementedPixurServiceServer) RemoveUserFollow(ctx context.Context, req *RemoveUserFollowRequest) (*RemoveUserFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFollow not implemented")
+}
+func (*UnimplementedPixurServiceServer) ReorderCollection(ctx context.Context, req *ReorderCollectionRequest) (*ReorderCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCollection not implemented")
+}
+func (*UnimplementedPixurServiceServer) RevokeSession(ctx context.Context, req *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (*UnimplementedPixurServiceServer) SoftDeletePic(ctx context.Context, req *SoftDeletePicRequest) (*SoftDeletePicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SoftDeletePic not implemented")
+}
+func (*UnimplementedPixurServiceServer) StartTotpEnrollment(ctx context.Context, req *StartTotpEnrollmentRequest) (*StartTotpEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTotpEnrollment not implemented")
+}
+func (*UnimplementedPixurServiceServer) StartUploadSession(ctx context.Context, req *StartUploadSessionRequest) (*StartUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartUploadSession not implemented")
+}
+func (*UnimplementedPixurServiceServer) StartUserSecretReset(ctx context.Context, req *StartUserSecretResetRequest) (*StartUserSecretResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartUserSecretReset not implemented")
+}
+func (*UnimplementedPixurServiceServer) SuspendUser(ctx context.Context, req *SuspendUserRequest) (*SuspendUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (*UnimplementedPixurServiceServer) UnlockLogin(ctx context.Context, req *UnlockLoginRequest) (*UnlockLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockLogin not implemented")
+}
+func (*UnimplementedPixurServiceServer) UnsuspendUser(ctx context.Context, req *UnsuspendUserRequest) (*UnsuspendUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendUser not implemented")
+}
+func (*UnimplementedPixurServiceServer) UpdateCollection(ctx context.Context, req *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (*UnimplementedPixurServiceServer) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (*UnimplementedPixurServiceServer) UpdateUserSecret(ctx context.Context, req *UpdateUserSecretRequest) (*UpdateUserSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserSecret not implemented")
+}
+func (*UnimplementedPixurServiceServer) UpsertPic(ctx context.Context, req *UpsertPicRequest) (*UpsertPicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPic not implemented")
+}
+func (*UnimplementedPixurServiceServer) UpsertPicCommentVote(ctx context.Context, req *UpsertPicCommentVoteRequest) (*UpsertPicCommentVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPicCommentVote not implemented")
+}
+func (*UnimplementedPixurServiceServer) UpsertPicStream(srv PixurService_UpsertPicStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method UpsertPicStream not implemented")
+}
+func (*UnimplementedPixurServiceServer) UpsertPicVote(ctx context.Context, req *UpsertPicVoteRequest) (*UpsertPicVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPicVote not implemented")
+}
+func (*UnimplementedPixurServiceServer) WatchBackendConfiguration(req *WatchBackendConfigurationRequest, srv PixurService_WatchBackendConfigurationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBackendConfiguration not implemented")
+}
+
+func RegisterPixurServiceServer(s *grpc.Server, srv PixurServiceServer) {
+	s.RegisterService(&_PixurService_serviceDesc, srv)
+}
+
+func _PixurService_AddCollectionPic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionPicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).AddCollectionPic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/AddCollectionPic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).AddCollectionPic(ctx, req.(*AddCollectionPicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_AddPicComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPicCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).AddPicComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/AddPicComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).AddPicComment(ctx, req.(*AddPicCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_AddPicFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPicFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).AddPicFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/AddPicFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).AddPicFavorite(ctx, req.(*AddPicFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_AddPicTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPicTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).AddPicTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/AddPicTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).AddPicTags(ctx, req.(*AddPicTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_AddTagFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTagFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).AddTagFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/AddTagFollow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).AddTagFollow(ctx, req.(*AddTagFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_AddUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).AddUserFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/AddUserFollow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).AddUserFollow(ctx, req.(*AddUserFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_AppendUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).AppendUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/AppendUploadSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).AppendUploadSession(ctx, req.(*AppendUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_CreateInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).CreateInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/CreateInviteCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).CreateInviteCode(ctx, req.(*CreateInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).CreatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/CreatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).CreatePool(ctx, req.(*CreatePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPixurServiceClient(cc *grpc.ClientConn) PixurServiceClient {
-	return &pixurServiceClient{cc}
+func _PixurService_DeleteApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DeleteApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DeleteApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DeleteApiKey(ctx, req.(*DeleteApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) AddPicComment(ctx context.Context, in *AddPicCommentRequest, opts ...grpc.CallOption) (*AddPicCommentResponse, error) {
-	out := new(AddPicCommentResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/AddPicComment", in, out, opts...)
-	if err != nil {
+func _PixurService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DeleteCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) AddPicTags(ctx context.Context, in *AddPicTagsRequest, opts ...grpc.CallOption) (*AddPicTagsResponse, error) {
-	out := new(AddPicTagsResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/AddPicTags", in, out, opts...)
-	if err != nil {
+func _PixurService_DeletePicComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePicCommentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DeletePicComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DeletePicComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DeletePicComment(ctx, req.(*DeletePicCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
-	out := new(CreateUserResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CreateUser", in, out, opts...)
-	if err != nil {
+func _PixurService_DeleteToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DeleteToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DeleteToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DeleteToken(ctx, req.(*DeleteTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*DeleteTokenResponse, error) {
-	out := new(DeleteTokenResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DeleteToken", in, out, opts...)
-	if err != nil {
+func _PixurService_DisableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTotpRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DisableTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DisableTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DisableTotp(ctx, req.(*DisableTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) FindIndexPics(ctx context.Context, in *FindIndexPicsRequest, opts ...grpc.CallOption) (*FindIndexPicsResponse, error) {
-	out := new(FindIndexPicsResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindIndexPics", in, out, opts...)
-	if err != nil {
+func _PixurService_EditPicComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditPicCommentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).EditPicComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/EditPicComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).EditPicComment(ctx, req.(*EditPicCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) FindPicCommentVotes(ctx context.Context, in *FindPicCommentVotesRequest, opts ...grpc.CallOption) (*FindPicCommentVotesResponse, error) {
-	out := new(FindPicCommentVotesResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindPicCommentVotes", in, out, opts...)
-	if err != nil {
+func _PixurService_ExportMyData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMyDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PixurServiceServer).ExportMyData(m, &pixurServiceExportMyDataServer{stream})
+}
+
+type PixurService_ExportMyDataServer interface {
+	Send(*ExportMyDataResponse) error
+	grpc.ServerStream
+}
+
+type pixurServiceExportMyDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *pixurServiceExportMyDataServer) Send(m *ExportMyDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PixurService_FindApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindApiKeysRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindApiKeys(ctx, req.(*FindApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) FindSchedPics(ctx context.Context, in *FindSchedPicsRequest, opts ...grpc.CallOption) (*FindSchedPicsResponse, error) {
-	out := new(FindSchedPicsResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindSchedPics", in, out, opts...)
-	if err != nil {
+func _PixurService_FindAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAuditLogRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindAuditLog(ctx, req.(*FindAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) FindSimilarPics(ctx context.Context, in *FindSimilarPicsRequest, opts ...grpc.CallOption) (*FindSimilarPicsResponse, error) {
-	out := new(FindSimilarPicsResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindSimilarPics", in, out, opts...)
-	if err != nil {
+func _PixurService_FindCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindCollectionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindCollections(ctx, req.(*FindCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) FindUserEvents(ctx context.Context, in *FindUserEventsRequest, opts ...grpc.CallOption) (*FindUserEventsResponse, error) {
-	out := new(FindUserEventsResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindUserEvents", in, out, opts...)
-	if err != nil {
+func _PixurService_FindFeedPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFeedPicsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindFeedPics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindFeedPics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindFeedPics(ctx, req.(*FindFeedPicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindFollows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindFollows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindFollows(ctx, req.(*FindFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindIndexPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindIndexPicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindIndexPics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindIndexPics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindIndexPics(ctx, req.(*FindIndexPicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindPicCommentVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPicCommentVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindPicCommentVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindPicCommentVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindPicCommentVotes(ctx, req.(*FindPicCommentVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindPicFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPicFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindPicFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindPicFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindPicFavorites(ctx, req.(*FindPicFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindPicsByColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPicsByColorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindPicsByColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindPicsByColor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindPicsByColor(ctx, req.(*FindPicsByColorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) GetRefreshToken(ctx context.Context, in *GetRefreshTokenRequest, opts ...grpc.CallOption) (*GetRefreshTokenResponse, error) {
-	out := new(GetRefreshTokenResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/GetRefreshToken", in, out, opts...)
-	if err != nil {
+func _PixurService_FindPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindPools(ctx, req.(*FindPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) IncrementViewCount(ctx context.Context, in *IncrementViewCountRequest, opts ...grpc.CallOption) (*IncrementViewCountResponse, error) {
-	out := new(IncrementViewCountResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/IncrementViewCount", in, out, opts...)
-	if err != nil {
+func _PixurService_FindSchedPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSchedPicsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindSchedPics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindSchedPics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindSchedPics(ctx, req.(*FindSchedPicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) LookupPicCommentVote(ctx context.Context, in *LookupPicCommentVoteRequest, opts ...grpc.CallOption) (*LookupPicCommentVoteResponse, error) {
-	out := new(LookupPicCommentVoteResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicCommentVote", in, out, opts...)
-	if err != nil {
+func _PixurService_FindSimilarPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSimilarPicsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindSimilarPics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindSimilarPics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindSimilarPics(ctx, req.(*FindSimilarPicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) LookupPicDetails(ctx context.Context, in *LookupPicDetailsRequest, opts ...grpc.CallOption) (*LookupPicDetailsResponse, error) {
-	out := new(LookupPicDetailsResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicDetails", in, out, opts...)
-	if err != nil {
+func _PixurService_FindUserEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserEventsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindUserEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindUserEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindUserEvents(ctx, req.(*FindUserEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) LookupPicExtension(ctx context.Context, in *LookupPicExtensionRequest, opts ...grpc.CallOption) (*LookupPicExtensionResponse, error) {
-	out := new(LookupPicExtensionResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicExtension", in, out, opts...)
-	if err != nil {
+func _PixurService_FinishTotpEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishTotpEnrollmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FinishTotpEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FinishTotpEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FinishTotpEnrollment(ctx, req.(*FinishTotpEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) LookupPicFile(ctx context.Context, in *LookupPicFileRequest, opts ...grpc.CallOption) (*LookupPicFileResponse, error) {
-	out := new(LookupPicFileResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicFile", in, out, opts...)
-	if err != nil {
+func _PixurService_FinishUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishUploadSessionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FinishUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FinishUploadSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FinishUploadSession(ctx, req.(*FinishUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) LookupPicVote(ctx context.Context, in *LookupPicVoteRequest, opts ...grpc.CallOption) (*LookupPicVoteResponse, error) {
-	out := new(LookupPicVoteResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicVote", in, out, opts...)
-	if err != nil {
+func _PixurService_FinishUserSecretReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishUserSecretResetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FinishUserSecretReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FinishUserSecretReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FinishUserSecretReset(ctx, req.(*FinishUserSecretResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) LookupPublicUserInfo(ctx context.Context, in *LookupPublicUserInfoRequest, opts ...grpc.CallOption) (*LookupPublicUserInfoResponse, error) {
-	out := new(LookupPublicUserInfoResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPublicUserInfo", in, out, opts...)
-	if err != nil {
+func _PixurService_GetRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefreshTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).GetRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/GetRefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).GetRefreshToken(ctx, req.(*GetRefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) LookupUser(ctx context.Context, in *LookupUserRequest, opts ...grpc.CallOption) (*LookupUserResponse, error) {
-	out := new(LookupUserResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupUser", in, out, opts...)
-	if err != nil {
+func _PixurService_IncrementViewCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementViewCountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).IncrementViewCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/IncrementViewCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).IncrementViewCount(ctx, req.(*IncrementViewCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) PurgePic(ctx context.Context, in *PurgePicRequest, opts ...grpc.CallOption) (*PurgePicResponse, error) {
-	out := new(PurgePicResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/PurgePic", in, out, opts...)
-	if err != nil {
+func _PixurService_InsertPoolPic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertPoolPicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).InsertPoolPic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/InsertPoolPic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).InsertPoolPic(ctx, req.(*InsertPoolPicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) ReadPicFile(ctx context.Context, opts ...grpc.CallOption) (PixurService_ReadPicFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PixurService_serviceDesc.Streams[0], "/pixur.api.PixurService/ReadPicFile", opts...)
-	if err != nil {
+func _PixurService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &pixurServiceReadPicFileClient{stream}
-	return x, nil
-}
-
-type PixurService_ReadPicFileClient interface {
-	Send(*ReadPicFileRequest) error
-	Recv() (*ReadPicFileResponse, error)
-	grpc.ClientStream
-}
-
-type pixurServiceReadPicFileClient struct {
-	grpc.ClientStream
+	if interceptor == nil {
+		return srv.(PixurServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *pixurServiceReadPicFileClient) Send(m *ReadPicFileRequest) error {
-	return x.ClientStream.SendMsg(m)
+func _PixurService_LookupCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).LookupCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/LookupCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).LookupCollection(ctx, req.(*LookupCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *pixurServiceReadPicFileClient) Recv() (*ReadPicFileResponse, error) {
-	m := new(ReadPicFileResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _PixurService_LookupPicCommentVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPicCommentVoteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).LookupPicCommentVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/LookupPicCommentVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).LookupPicCommentVote(ctx, req.(*LookupPicCommentVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) SoftDeletePic(ctx context.Context, in *SoftDeletePicRequest, opts ...grpc.CallOption) (*SoftDeletePicResponse, error) {
-	out := new(SoftDeletePicResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/SoftDeletePic", in, out, opts...)
-	if err != nil {
+func _PixurService_LookupPicDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPicDetailsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).LookupPicDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/LookupPicDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).LookupPicDetails(ctx, req.(*LookupPicDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
-	out := new(UpdateUserResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpdateUser", in, out, opts...)
-	if err != nil {
+func _PixurService_LookupPicExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPicExtensionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).LookupPicExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/LookupPicExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).LookupPicExtension(ctx, req.(*LookupPicExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) UpsertPic(ctx context.Context, in *UpsertPicRequest, opts ...grpc.CallOption) (*UpsertPicResponse, error) {
-	out := new(UpsertPicResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpsertPic", in, out, opts...)
-	if err != nil {
+func _PixurService_LookupPicFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPicFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).LookupPicFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/LookupPicFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).LookupPicFile(ctx, req.(*LookupPicFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) UpsertPicCommentVote(ctx context.Context, in *UpsertPicCommentVoteRequest, opts ...grpc.CallOption) (*UpsertPicCommentVoteResponse, error) {
-	out := new(UpsertPicCommentVoteResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpsertPicCommentVote", in, out, opts...)
-	if err != nil {
+func _PixurService_LookupPicVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPicVoteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).LookupPicVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/LookupPicVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).LookupPicVote(ctx, req.(*LookupPicVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) UpsertPicVote(ctx context.Context, in *UpsertPicVoteRequest, opts ...grpc.CallOption) (*UpsertPicVoteResponse, error) {
-	out := new(UpsertPicVoteResponse)
-	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpsertPicVote", in, out, opts...)
-	if err != nil {
+func _PixurService_LookupPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PixurServiceServer).LookupPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/LookupPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).LookupPool(ctx, req.(*LookupPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pixurServiceClient) WatchBackendConfiguration(ctx context.Context, in *WatchBackendConfigurationRequest, opts ...grpc.CallOption) (PixurService_WatchBackendConfigurationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PixurService_serviceDesc.Streams[1], "/pixur.api.PixurService/WatchBackendConfiguration", opts...)
-	if err != nil {
+func _PixurService_LookupPublicUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPublicUserInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &pixurServiceWatchBackendConfigurationClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(PixurServiceServer).LookupPublicUserInfo(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/LookupPublicUserInfo",
 	}
-	return x, nil
-}
-
-type PixurService_WatchBackendConfigurationClient interface {
-	Recv() (*WatchBackendConfigurationResponse, error)
-	grpc.ClientStream
-}
-
-type pixurServiceWatchBackendConfigurationClient struct {
-	grpc.ClientStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).LookupPublicUserInfo(ctx, req.(*LookupPublicUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *pixurServiceWatchBackendConfigurationClient) Recv() (*WatchBackendConfigurationResponse, error) {
-	m := new(WatchBackendConfigurationResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _PixurService_LookupUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupUploadSessionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-// PixurServiceServer is the server API for PixurService service.
-type PixurServiceServer interface {
-	AddPicComment(context.Context, *AddPicCommentRequest) (*AddPicCommentResponse, error)
-	AddPicTags(context.Context, *AddPicTagsRequest) (*AddPicTagsResponse, error)
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	DeleteToken(context.Context, *DeleteTokenRequest) (*DeleteTokenResponse, error)
-	FindIndexPics(context.Context, *FindIndexPicsRequest) (*FindIndexPicsResponse, error)
-	FindPicCommentVotes(context.Context, *FindPicCommentVotesRequest) (*FindPicCommentVotesResponse, error)
-	FindSchedPics(context.Context, *FindSchedPicsRequest) (*FindSchedPicsResponse, error)
-	FindSimilarPics(context.Context, *FindSimilarPicsRequest) (*FindSimilarPicsResponse, error)
-	FindUserEvents(context.Context, *FindUserEventsRequest) (*FindUserEventsResponse, error)
-	GetRefreshToken(context.Context, *GetRefreshTokenRequest) (*GetRefreshTokenResponse, error)
-	IncrementViewCount(context.Context, *IncrementViewCountRequest) (*IncrementViewCountResponse, error)
-	LookupPicCommentVote(context.Context, *LookupPicCommentVoteRequest) (*LookupPicCommentVoteResponse, error)
-	LookupPicDetails(context.Context, *LookupPicDetailsRequest) (*LookupPicDetailsResponse, error)
-	LookupPicExtension(context.Context, *LookupPicExtensionRequest) (*LookupPicExtensionResponse, error)
-	LookupPicFile(context.Context, *LookupPicFileRequest) (*LookupPicFileResponse, error)
-	LookupPicVote(context.Context, *LookupPicVoteRequest) (*LookupPicVoteResponse, error)
-	LookupPublicUserInfo(context.Context, *LookupPublicUserInfoRequest) (*LookupPublicUserInfoResponse, error)
-	LookupUser(context.Context, *LookupUserRequest) (*LookupUserResponse, error)
-	PurgePic(context.Context, *PurgePicRequest) (*PurgePicResponse, error)
-	ReadPicFile(PixurService_ReadPicFileServer) error
-	SoftDeletePic(context.Context, *SoftDeletePicRequest) (*SoftDeletePicResponse, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
-	UpsertPic(context.Context, *UpsertPicRequest) (*UpsertPicResponse, error)
-	UpsertPicCommentVote(context.Context, *UpsertPicCommentVoteRequest) (*UpsertPicCommentVoteResponse, error)
-	UpsertPicVote(context.Context, *UpsertPicVoteRequest) (*UpsertPicVoteResponse, error)
-	WatchBackendConfiguration(*WatchBackendConfigurationRequest, PixurService_WatchBackendConfigurationServer) error
-}
-
-// UnimplementedPixurServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedPixurServiceServer struct {
-}
-
-func (*UnimplementedPixurServiceServer) AddPicComment(ctx context.Context, req *AddPicCommentRequest) (*AddPicCommentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPicComment not implemented")
-}
-func (*UnimplementedPixurServiceServer) AddPicTags(ctx context.Context, req *AddPicTagsRequest) (*AddPicTagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPicTags not implemented")
-}
-func (*UnimplementedPixurServiceServer) CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
-}
-func (*UnimplementedPixurServiceServer) DeleteToken(ctx context.Context, req *DeleteTokenRequest) (*DeleteTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
-}
-func (*UnimplementedPixurServiceServer) FindIndexPics(ctx context.Context, req *FindIndexPicsRequest) (*FindIndexPicsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindIndexPics not implemented")
-}
-func (*UnimplementedPixurServiceServer) FindPicCommentVotes(ctx context.Context, req *FindPicCommentVotesRequest) (*FindPicCommentVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindPicCommentVotes not implemented")
-}
-func (*UnimplementedPixurServiceServer) FindSchedPics(ctx context.Context, req *FindSchedPicsRequest) (*FindSchedPicsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindSchedPics not implemented")
-}
-func (*UnimplementedPixurServiceServer) FindSimilarPics(ctx context.Context, req *FindSimilarPicsRequest) (*FindSimilarPicsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindSimilarPics not implemented")
-}
-func (*UnimplementedPixurServiceServer) FindUserEvents(ctx context.Context, req *FindUserEventsRequest) (*FindUserEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindUserEvents not implemented")
-}
-func (*UnimplementedPixurServiceServer) GetRefreshToken(ctx context.Context, req *GetRefreshTokenRequest) (*GetRefreshTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRefreshToken not implemented")
-}
-func (*UnimplementedPixurServiceServer) IncrementViewCount(ctx context.Context, req *IncrementViewCountRequest) (*IncrementViewCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncrementViewCount not implemented")
-}
-func (*UnimplementedPixurServiceServer) LookupPicCommentVote(ctx context.Context, req *LookupPicCommentVoteRequest) (*LookupPicCommentVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LookupPicCommentVote not implemented")
-}
-func (*UnimplementedPixurServiceServer) LookupPicDetails(ctx context.Context, req *LookupPicDetailsRequest) (*LookupPicDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LookupPicDetails not implemented")
-}
-func (*UnimplementedPixurServiceServer) LookupPicExtension(ctx context.Context, req *LookupPicExtensionRequest) (*LookupPicExtensionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LookupPicExtension not implemented")
-}
-func (*UnimplementedPixurServiceServer) LookupPicFile(ctx context.Context, req *LookupPicFileRequest) (*LookupPicFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LookupPicFile not implemented")
-}
-func (*UnimplementedPixurServiceServer) LookupPicVote(ctx context.Context, req *LookupPicVoteRequest) (*LookupPicVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LookupPicVote not implemented")
-}
-func (*UnimplementedPixurServiceServer) LookupPublicUserInfo(ctx context.Context, req *LookupPublicUserInfoRequest) (*LookupPublicUserInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LookupPublicUserInfo not implemented")
-}
-func (*UnimplementedPixurServiceServer) LookupUser(ctx context.Context, req *LookupUserRequest) (*LookupUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LookupUser not implemented")
-}
-func (*UnimplementedPixurServiceServer) PurgePic(ctx context.Context, req *PurgePicRequest) (*PurgePicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurgePic not implemented")
-}
-func (*UnimplementedPixurServiceServer) ReadPicFile(srv PixurService_ReadPicFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method ReadPicFile not implemented")
-}
-func (*UnimplementedPixurServiceServer) SoftDeletePic(ctx context.Context, req *SoftDeletePicRequest) (*SoftDeletePicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SoftDeletePic not implemented")
-}
-func (*UnimplementedPixurServiceServer) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
-}
-func (*UnimplementedPixurServiceServer) UpsertPic(ctx context.Context, req *UpsertPicRequest) (*UpsertPicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertPic not implemented")
-}
-func (*UnimplementedPixurServiceServer) UpsertPicCommentVote(ctx context.Context, req *UpsertPicCommentVoteRequest) (*UpsertPicCommentVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertPicCommentVote not implemented")
-}
-func (*UnimplementedPixurServiceServer) UpsertPicVote(ctx context.Context, req *UpsertPicVoteRequest) (*UpsertPicVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertPicVote not implemented")
-}
-func (*UnimplementedPixurServiceServer) WatchBackendConfiguration(req *WatchBackendConfigurationRequest, srv PixurService_WatchBackendConfigurationServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchBackendConfiguration not implemented")
-}
-
-func RegisterPixurServiceServer(s *grpc.Server, srv PixurServiceServer) {
-	s.RegisterService(&_PixurService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(PixurServiceServer).LookupUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/LookupUploadSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).LookupUploadSession(ctx, req.(*LookupUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_AddPicComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPicCommentRequest)
+func _PixurService_LookupUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).AddPicComment(ctx, in)
+		return srv.(PixurServiceServer).LookupUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/AddPicComment",
+		FullMethod: "/pixur.api.PixurService/LookupUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).AddPicComment(ctx, req.(*AddPicCommentRequest))
+		return srv.(PixurServiceServer).LookupUser(ctx, req.(*LookupUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_AddPicTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPicTagsRequest)
+func _PixurService_MovePoolPic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePoolPicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).AddPicTags(ctx, in)
+		return srv.(PixurServiceServer).MovePoolPic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/AddPicTags",
+		FullMethod: "/pixur.api.PixurService/MovePoolPic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).AddPicTags(ctx, req.(*AddPicTagsRequest))
+		return srv.(PixurServiceServer).MovePoolPic(ctx, req.(*MovePoolPicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _PixurService_PurgePic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgePicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).CreateUser(ctx, in)
+		return srv.(PixurServiceServer).PurgePic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/CreateUser",
+		FullMethod: "/pixur.api.PixurService/PurgePic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(PixurServiceServer).PurgePic(ctx, req.(*PurgePicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_DeleteToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTokenRequest)
-	if err := dec(in); err != nil {
+func _PixurService_ReadPicFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PixurServiceServer).ReadPicFile(&pixurServiceReadPicFileServer{stream})
+}
+
+type PixurService_ReadPicFileServer interface {
+	Send(*ReadPicFileResponse) error
+	Recv() (*ReadPicFileRequest, error)
+	grpc.ServerStream
+}
+
+type pixurServiceReadPicFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *pixurServiceReadPicFileServer) Send(m *ReadPicFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pixurServiceReadPicFileServer) Recv() (*ReadPicFileRequest, error) {
+	m := new(ReadPicFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PixurServiceServer).DeleteToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/DeleteToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).DeleteToken(ctx, req.(*DeleteTokenRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _PixurService_FindIndexPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindIndexPicsRequest)
+func _PixurService_RemoveCollectionPic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollectionPicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindIndexPics(ctx, in)
+		return srv.(PixurServiceServer).RemoveCollectionPic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindIndexPics",
+		FullMethod: "/pixur.api.PixurService/RemoveCollectionPic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindIndexPics(ctx, req.(*FindIndexPicsRequest))
+		return srv.(PixurServiceServer).RemoveCollectionPic(ctx, req.(*RemoveCollectionPicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_FindPicCommentVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindPicCommentVotesRequest)
+func _PixurService_RemovePicFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePicFavoriteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindPicCommentVotes(ctx, in)
+		return srv.(PixurServiceServer).RemovePicFavorite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindPicCommentVotes",
+		FullMethod: "/pixur.api.PixurService/RemovePicFavorite",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindPicCommentVotes(ctx, req.(*FindPicCommentVotesRequest))
+		return srv.(PixurServiceServer).RemovePicFavorite(ctx, req.(*RemovePicFavoriteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_FindSchedPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindSchedPicsRequest)
+func _PixurService_RemovePoolPic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePoolPicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindSchedPics(ctx, in)
+		return srv.(PixurServiceServer).RemovePoolPic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindSchedPics",
+		FullMethod: "/pixur.api.PixurService/RemovePoolPic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindSchedPics(ctx, req.(*FindSchedPicsRequest))
+		return srv.(PixurServiceServer).RemovePoolPic(ctx, req.(*RemovePoolPicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_FindSimilarPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindSimilarPicsRequest)
+func _PixurService_RemoveTagFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTagFollowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindSimilarPics(ctx, in)
+		return srv.(PixurServiceServer).RemoveTagFollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindSimilarPics",
+		FullMethod: "/pixur.api.PixurService/RemoveTagFollow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindSimilarPics(ctx, req.(*FindSimilarPicsRequest))
+		return srv.(PixurServiceServer).RemoveTagFollow(ctx, req.(*RemoveTagFollowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_FindUserEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserEventsRequest)
+func _PixurService_RemoveUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserFollowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindUserEvents(ctx, in)
+		return srv.(PixurServiceServer).RemoveUserFollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindUserEvents",
+		FullMethod: "/pixur.api.PixurService/RemoveUserFollow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindUserEvents(ctx, req.(*FindUserEventsRequest))
+		return srv.(PixurServiceServer).RemoveUserFollow(ctx, req.(*RemoveUserFollowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_GetRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRefreshTokenRequest)
+func _PixurService_ReorderCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).GetRefreshToken(ctx, in)
+		return srv.(PixurServiceServer).ReorderCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/GetRefreshToken",
+		FullMethod: "/pixur.api.PixurService/ReorderCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).GetRefreshToken(ctx, req.(*GetRefreshTokenRequest))
+		return srv.(PixurServiceServer).ReorderCollection(ctx, req.(*ReorderCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_IncrementViewCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncrementViewCountRequest)
+func _PixurService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).IncrementViewCount(ctx, in)
+		return srv.(PixurServiceServer).RevokeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/IncrementViewCount",
+		FullMethod: "/pixur.api.PixurService/RevokeSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).IncrementViewCount(ctx, req.(*IncrementViewCountRequest))
+		return srv.(PixurServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_LookupPicCommentVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupPicCommentVoteRequest)
+func _PixurService_SoftDeletePic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SoftDeletePicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).LookupPicCommentVote(ctx, in)
+		return srv.(PixurServiceServer).SoftDeletePic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/LookupPicCommentVote",
+		FullMethod: "/pixur.api.PixurService/SoftDeletePic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).LookupPicCommentVote(ctx, req.(*LookupPicCommentVoteRequest))
+		return srv.(PixurServiceServer).SoftDeletePic(ctx, req.(*SoftDeletePicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_LookupPicDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupPicDetailsRequest)
+func _PixurService_StartTotpEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTotpEnrollmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).LookupPicDetails(ctx, in)
+		return srv.(PixurServiceServer).StartTotpEnrollment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/LookupPicDetails",
+		FullMethod: "/pixur.api.PixurService/StartTotpEnrollment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).LookupPicDetails(ctx, req.(*LookupPicDetailsRequest))
+		return srv.(PixurServiceServer).StartTotpEnrollment(ctx, req.(*StartTotpEnrollmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_LookupPicExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupPicExtensionRequest)
+func _PixurService_StartUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartUploadSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).LookupPicExtension(ctx, in)
+		return srv.(PixurServiceServer).StartUploadSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/LookupPicExtension",
+		FullMethod: "/pixur.api.PixurService/StartUploadSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).LookupPicExtension(ctx, req.(*LookupPicExtensionRequest))
+		return srv.(PixurServiceServer).StartUploadSession(ctx, req.(*StartUploadSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_LookupPicFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupPicFileRequest)
+func _PixurService_StartUserSecretReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartUserSecretResetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).LookupPicFile(ctx, in)
+		return srv.(PixurServiceServer).StartUserSecretReset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/LookupPicFile",
+		FullMethod: "/pixur.api.PixurService/StartUserSecretReset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).LookupPicFile(ctx, req.(*LookupPicFileRequest))
+		return srv.(PixurServiceServer).StartUserSecretReset(ctx, req.(*StartUserSecretResetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_LookupPicVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupPicVoteRequest)
+func _PixurService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).LookupPicVote(ctx, in)
+		return srv.(PixurServiceServer).SuspendUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/LookupPicVote",
+		FullMethod: "/pixur.api.PixurService/SuspendUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).LookupPicVote(ctx, req.(*LookupPicVoteRequest))
+		return srv.(PixurServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_LookupPublicUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupPublicUserInfoRequest)
+func _PixurService_UnlockLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).LookupPublicUserInfo(ctx, in)
+		return srv.(PixurServiceServer).UnlockLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/LookupPublicUserInfo",
+		FullMethod: "/pixur.api.PixurService/UnlockLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).LookupPublicUserInfo(ctx, req.(*LookupPublicUserInfoRequest))
+		return srv.(PixurServiceServer).UnlockLogin(ctx, req.(*UnlockLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_LookupUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupUserRequest)
+func _PixurService_UnsuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).LookupUser(ctx, in)
+		return srv.(PixurServiceServer).UnsuspendUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/LookupUser",
+		FullMethod: "/pixur.api.PixurService/UnsuspendUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).LookupUser(ctx, req.(*LookupUserRequest))
+		return srv.(PixurServiceServer).UnsuspendUser(ctx, req.(*UnsuspendUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_PurgePic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurgePicRequest)
+func _PixurService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).PurgePic(ctx, in)
+		return srv.(PixurServiceServer).UpdateCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/PurgePic",
+		FullMethod: "/pixur.api.PixurService/UpdateCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).PurgePic(ctx, req.(*PurgePicRequest))
+		return srv.(PixurServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_ReadPicFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PixurServiceServer).ReadPicFile(&pixurServiceReadPicFileServer{stream})
-}
-
-type PixurService_ReadPicFileServer interface {
-	Send(*ReadPicFileResponse) error
-	Recv() (*ReadPicFileRequest, error)
-	grpc.ServerStream
-}
-
-type pixurServiceReadPicFileServer struct {
-	grpc.ServerStream
-}
-
-func (x *pixurServiceReadPicFileServer) Send(m *ReadPicFileResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *pixurServiceReadPicFileServer) Recv() (*ReadPicFileRequest, error) {
-	m := new(ReadPicFileRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _PixurService_SoftDeletePic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SoftDeletePicRequest)
+func _PixurService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).SoftDeletePic(ctx, in)
+		return srv.(PixurServiceServer).UpdateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/SoftDeletePic",
+		FullMethod: "/pixur.api.PixurService/UpdateUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).SoftDeletePic(ctx, req.(*SoftDeletePicRequest))
+		return srv.(PixurServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _PixurService_UpdateUserSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).UpdateUser(ctx, in)
+		return srv.(PixurServiceServer).UpdateUserSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/UpdateUser",
+		FullMethod: "/pixur.api.PixurService/UpdateUserSecret",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(PixurServiceServer).UpdateUserSecret(ctx, req.(*UpdateUserSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3738,6 +10857,32 @@ func _PixurService_UpsertPicCommentVote_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PixurService_UpsertPicStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PixurServiceServer).UpsertPicStream(&pixurServiceUpsertPicStreamServer{stream})
+}
+
+type PixurService_UpsertPicStreamServer interface {
+	SendAndClose(*UpsertPicStreamResponse) error
+	Recv() (*UpsertPicStreamRequest, error)
+	grpc.ServerStream
+}
+
+type pixurServiceUpsertPicStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *pixurServiceUpsertPicStreamServer) SendAndClose(m *UpsertPicStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pixurServiceUpsertPicStreamServer) Recv() (*UpsertPicStreamRequest, error) {
+	m := new(UpsertPicStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _PixurService_UpsertPicVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpsertPicVoteRequest)
 	if err := dec(in); err != nil {
@@ -3781,22 +10926,102 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pixur.api.PixurService",
 	HandlerType: (*PixurServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCollectionPic",
+			Handler:    _PixurService_AddCollectionPic_Handler,
+		},
 		{
 			MethodName: "AddPicComment",
 			Handler:    _PixurService_AddPicComment_Handler,
 		},
+		{
+			MethodName: "AddPicFavorite",
+			Handler:    _PixurService_AddPicFavorite_Handler,
+		},
 		{
 			MethodName: "AddPicTags",
 			Handler:    _PixurService_AddPicTags_Handler,
 		},
+		{
+			MethodName: "AddTagFollow",
+			Handler:    _PixurService_AddTagFollow_Handler,
+		},
+		{
+			MethodName: "AddUserFollow",
+			Handler:    _PixurService_AddUserFollow_Handler,
+		},
+		{
+			MethodName: "AppendUploadSession",
+			Handler:    _PixurService_AppendUploadSession_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _PixurService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _PixurService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "CreateInviteCode",
+			Handler:    _PixurService_CreateInviteCode_Handler,
+		},
+		{
+			MethodName: "CreatePool",
+			Handler:    _PixurService_CreatePool_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _PixurService_CreateUser_Handler,
 		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _PixurService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "DeleteApiKey",
+			Handler:    _PixurService_DeleteApiKey_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _PixurService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "DeletePicComment",
+			Handler:    _PixurService_DeletePicComment_Handler,
+		},
 		{
 			MethodName: "DeleteToken",
 			Handler:    _PixurService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "DisableTotp",
+			Handler:    _PixurService_DisableTotp_Handler,
+		},
+		{
+			MethodName: "EditPicComment",
+			Handler:    _PixurService_EditPicComment_Handler,
+		},
+		{
+			MethodName: "FindApiKeys",
+			Handler:    _PixurService_FindApiKeys_Handler,
+		},
+		{
+			MethodName: "FindAuditLog",
+			Handler:    _PixurService_FindAuditLog_Handler,
+		},
+		{
+			MethodName: "FindCollections",
+			Handler:    _PixurService_FindCollections_Handler,
+		},
+		{
+			MethodName: "FindFeedPics",
+			Handler:    _PixurService_FindFeedPics_Handler,
+		},
+		{
+			MethodName: "FindFollows",
+			Handler:    _PixurService_FindFollows_Handler,
+		},
 		{
 			MethodName: "FindIndexPics",
 			Handler:    _PixurService_FindIndexPics_Handler,
@@ -3805,6 +11030,18 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FindPicCommentVotes",
 			Handler:    _PixurService_FindPicCommentVotes_Handler,
 		},
+		{
+			MethodName: "FindPicFavorites",
+			Handler:    _PixurService_FindPicFavorites_Handler,
+		},
+		{
+			MethodName: "FindPicsByColor",
+			Handler:    _PixurService_FindPicsByColor_Handler,
+		},
+		{
+			MethodName: "FindPools",
+			Handler:    _PixurService_FindPools_Handler,
+		},
 		{
 			MethodName: "FindSchedPics",
 			Handler:    _PixurService_FindSchedPics_Handler,
@@ -3817,6 +11054,18 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FindUserEvents",
 			Handler:    _PixurService_FindUserEvents_Handler,
 		},
+		{
+			MethodName: "FinishTotpEnrollment",
+			Handler:    _PixurService_FinishTotpEnrollment_Handler,
+		},
+		{
+			MethodName: "FinishUploadSession",
+			Handler:    _PixurService_FinishUploadSession_Handler,
+		},
+		{
+			MethodName: "FinishUserSecretReset",
+			Handler:    _PixurService_FinishUserSecretReset_Handler,
+		},
 		{
 			MethodName: "GetRefreshToken",
 			Handler:    _PixurService_GetRefreshToken_Handler,
@@ -3825,6 +11074,18 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IncrementViewCount",
 			Handler:    _PixurService_IncrementViewCount_Handler,
 		},
+		{
+			MethodName: "InsertPoolPic",
+			Handler:    _PixurService_InsertPoolPic_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _PixurService_ListSessions_Handler,
+		},
+		{
+			MethodName: "LookupCollection",
+			Handler:    _PixurService_LookupCollection_Handler,
+		},
 		{
 			MethodName: "LookupPicCommentVote",
 			Handler:    _PixurService_LookupPicCommentVote_Handler,
@@ -3845,26 +11106,98 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LookupPicVote",
 			Handler:    _PixurService_LookupPicVote_Handler,
 		},
+		{
+			MethodName: "LookupPool",
+			Handler:    _PixurService_LookupPool_Handler,
+		},
 		{
 			MethodName: "LookupPublicUserInfo",
 			Handler:    _PixurService_LookupPublicUserInfo_Handler,
 		},
+		{
+			MethodName: "LookupUploadSession",
+			Handler:    _PixurService_LookupUploadSession_Handler,
+		},
 		{
 			MethodName: "LookupUser",
 			Handler:    _PixurService_LookupUser_Handler,
 		},
+		{
+			MethodName: "MovePoolPic",
+			Handler:    _PixurService_MovePoolPic_Handler,
+		},
 		{
 			MethodName: "PurgePic",
 			Handler:    _PixurService_PurgePic_Handler,
 		},
+		{
+			MethodName: "RemoveCollectionPic",
+			Handler:    _PixurService_RemoveCollectionPic_Handler,
+		},
+		{
+			MethodName: "RemovePicFavorite",
+			Handler:    _PixurService_RemovePicFavorite_Handler,
+		},
+		{
+			MethodName: "RemovePoolPic",
+			Handler:    _PixurService_RemovePoolPic_Handler,
+		},
+		{
+			MethodName: "RemoveTagFollow",
+			Handler:    _PixurService_RemoveTagFollow_Handler,
+		},
+		{
+			MethodName: "RemoveUserFollow",
+			Handler:    _PixurService_RemoveUserFollow_Handler,
+		},
+		{
+			MethodName: "ReorderCollection",
+			Handler:    _PixurService_ReorderCollection_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _PixurService_RevokeSession_Handler,
+		},
 		{
 			MethodName: "SoftDeletePic",
 			Handler:    _PixurService_SoftDeletePic_Handler,
 		},
+		{
+			MethodName: "StartTotpEnrollment",
+			Handler:    _PixurService_StartTotpEnrollment_Handler,
+		},
+		{
+			MethodName: "StartUploadSession",
+			Handler:    _PixurService_StartUploadSession_Handler,
+		},
+		{
+			MethodName: "StartUserSecretReset",
+			Handler:    _PixurService_StartUserSecretReset_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _PixurService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "UnlockLogin",
+			Handler:    _PixurService_UnlockLogin_Handler,
+		},
+		{
+			MethodName: "UnsuspendUser",
+			Handler:    _PixurService_UnsuspendUser_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _PixurService_UpdateCollection_Handler,
+		},
 		{
 			MethodName: "UpdateUser",
 			Handler:    _PixurService_UpdateUser_Handler,
 		},
+		{
+			MethodName: "UpdateUserSecret",
+			Handler:    _PixurService_UpdateUserSecret_Handler,
+		},
 		{
 			MethodName: "UpsertPic",
 			Handler:    _PixurService_UpsertPic_Handler,
@@ -3879,12 +11212,22 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMyData",
+			Handler:       _PixurService_ExportMyData_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ReadPicFile",
 			Handler:       _PixurService_ReadPicFile_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UpsertPicStream",
+			Handler:       _PixurService_UpsertPicStream_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "WatchBackendConfiguration",
 			Handler:       _PixurService_WatchBackendConfiguration_Handler,
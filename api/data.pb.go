@@ -55,6 +55,34 @@ func (DeletionReason) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_871986018790d2fd, []int{0}
 }
 
+type BackendConfiguration_PasswordHashPolicy_Algorithm int32
+
+const (
+	BackendConfiguration_PasswordHashPolicy_UNKNOWN  BackendConfiguration_PasswordHashPolicy_Algorithm = 0
+	BackendConfiguration_PasswordHashPolicy_BCRYPT   BackendConfiguration_PasswordHashPolicy_Algorithm = 1
+	BackendConfiguration_PasswordHashPolicy_ARGON2ID BackendConfiguration_PasswordHashPolicy_Algorithm = 2
+)
+
+var BackendConfiguration_PasswordHashPolicy_Algorithm_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "BCRYPT",
+	2: "ARGON2ID",
+}
+
+var BackendConfiguration_PasswordHashPolicy_Algorithm_value = map[string]int32{
+	"UNKNOWN":  0,
+	"BCRYPT":   1,
+	"ARGON2ID": 2,
+}
+
+func (x BackendConfiguration_PasswordHashPolicy_Algorithm) String() string {
+	return proto.EnumName(BackendConfiguration_PasswordHashPolicy_Algorithm_name, int32(x))
+}
+
+func (BackendConfiguration_PasswordHashPolicy_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{0, 5, 0}
+}
+
 type Capability_Cap int32
 
 const (
@@ -123,6 +151,32 @@ const (
 	Capability_PIC_COMMENT_VOTE_CREATE Capability_Cap = 28
 	// Can this user create arbitrary extension data on a comment vote?
 	Capability_PIC_COMMENT_VOTE_EXTENSION_CREATE Capability_Cap = 29
+	// Can this user create invite codes?
+	Capability_USER_INVITE_CREATE Capability_Cap = 30
+	// Can this user suspend and unsuspend other users?
+	Capability_USER_SUSPEND Capability_Cap = 31
+	// Can this user edit their own profile?
+	Capability_USER_UPDATE_PROFILE Capability_Cap = 32
+	// Can this user log in as other users by an external identity linked to them?  Only trusted
+	// frontends should have this.
+	Capability_USER_AUTH_EXTERNAL Capability_Cap = 33
+	// Can this user read the audit log of privileged actions?
+	Capability_AUDIT_LOG_READ Capability_Cap = 34
+	// Can this user edit their own comments, within the configured edit window?
+	Capability_PIC_COMMENT_UPDATE Capability_Cap = 35
+	// Can this user delete their own comments?
+	Capability_PIC_COMMENT_DELETE Capability_Cap = 36
+	// Can this user edit or delete any comment at any time, and see the prior revisions of
+	// comments?
+	Capability_PIC_COMMENT_MODERATE Capability_Cap = 37
+	// Can this user favorite pics?
+	Capability_PIC_FAVORITE_CREATE Capability_Cap = 38
+	// Can this user create collections, and change the collections they own?
+	Capability_COLLECTION_CREATE Capability_Cap = 39
+	// Can this user create pools, and add, move, or remove the pics in them?
+	Capability_PIC_POOL_EDIT Capability_Cap = 40
+	// Can this user follow users and tags, and read their feed?
+	Capability_FOLLOW_CREATE Capability_Cap = 41
 )
 
 var Capability_Cap_name = map[int32]string{
@@ -156,6 +210,18 @@ var Capability_Cap_name = map[int32]string{
 	27: "USER_READ_PIC_VOTE",
 	28: "PIC_COMMENT_VOTE_CREATE",
 	29: "PIC_COMMENT_VOTE_EXTENSION_CREATE",
+	30: "USER_INVITE_CREATE",
+	31: "USER_SUSPEND",
+	32: "USER_UPDATE_PROFILE",
+	33: "USER_AUTH_EXTERNAL",
+	34: "AUDIT_LOG_READ",
+	35: "PIC_COMMENT_UPDATE",
+	36: "PIC_COMMENT_DELETE",
+	37: "PIC_COMMENT_MODERATE",
+	38: "PIC_FAVORITE_CREATE",
+	39: "COLLECTION_CREATE",
+	40: "PIC_POOL_EDIT",
+	41: "FOLLOW_CREATE",
 }
 
 var Capability_Cap_value = map[string]int32{
@@ -189,6 +255,18 @@ var Capability_Cap_value = map[string]int32{
 	"USER_READ_PIC_VOTE":                27,
 	"PIC_COMMENT_VOTE_CREATE":           28,
 	"PIC_COMMENT_VOTE_EXTENSION_CREATE": 29,
+	"USER_INVITE_CREATE":                30,
+	"USER_SUSPEND":                      31,
+	"USER_UPDATE_PROFILE":               32,
+	"USER_AUTH_EXTERNAL":                33,
+	"AUDIT_LOG_READ":                    34,
+	"PIC_COMMENT_UPDATE":                35,
+	"PIC_COMMENT_DELETE":                36,
+	"PIC_COMMENT_MODERATE":              37,
+	"PIC_FAVORITE_CREATE":               38,
+	"COLLECTION_CREATE":                 39,
+	"PIC_POOL_EDIT":                     40,
+	"FOLLOW_CREATE":                     41,
 }
 
 func (x Capability_Cap) String() string {
@@ -199,6 +277,91 @@ func (Capability_Cap) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_871986018790d2fd, []int{1, 0}
 }
 
+type AuditLog_Action int32
+
+const (
+	AuditLog_UNKNOWN                AuditLog_Action = 0
+	AuditLog_UPDATE_USER_CAPABILITY AuditLog_Action = 1
+	AuditLog_SOFT_DELETE_PIC        AuditLog_Action = 2
+	AuditLog_HARD_DELETE_PIC        AuditLog_Action = 3
+	AuditLog_PURGE_PIC              AuditLog_Action = 4
+	AuditLog_UPDATE_CONFIGURATION   AuditLog_Action = 5
+	AuditLog_SUSPEND_USER           AuditLog_Action = 6
+	AuditLog_UNSUSPEND_USER         AuditLog_Action = 7
+	AuditLog_UPDATE_PIC_COMMENT     AuditLog_Action = 8
+	AuditLog_DELETE_PIC_COMMENT     AuditLog_Action = 9
+	AuditLog_UNLOCK_LOGIN           AuditLog_Action = 10
+	AuditLog_CREATE_INVITE_CODE     AuditLog_Action = 11
+)
+
+var AuditLog_Action_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "UPDATE_USER_CAPABILITY",
+	2:  "SOFT_DELETE_PIC",
+	3:  "HARD_DELETE_PIC",
+	4:  "PURGE_PIC",
+	5:  "UPDATE_CONFIGURATION",
+	6:  "SUSPEND_USER",
+	7:  "UNSUSPEND_USER",
+	8:  "UPDATE_PIC_COMMENT",
+	9:  "DELETE_PIC_COMMENT",
+	10: "UNLOCK_LOGIN",
+	11: "CREATE_INVITE_CODE",
+}
+
+var AuditLog_Action_value = map[string]int32{
+	"UNKNOWN":                0,
+	"UPDATE_USER_CAPABILITY": 1,
+	"SOFT_DELETE_PIC":        2,
+	"HARD_DELETE_PIC":        3,
+	"PURGE_PIC":              4,
+	"UPDATE_CONFIGURATION":   5,
+	"SUSPEND_USER":           6,
+	"UNSUSPEND_USER":         7,
+	"UPDATE_PIC_COMMENT":     8,
+	"DELETE_PIC_COMMENT":     9,
+	"UNLOCK_LOGIN":           10,
+	"CREATE_INVITE_CODE":     11,
+}
+
+func (x AuditLog_Action) String() string {
+	return proto.EnumName(AuditLog_Action_name, int32(x))
+}
+
+func (AuditLog_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{3, 0}
+}
+
+type Collection_Visibility int32
+
+const (
+	Collection_UNKNOWN Collection_Visibility = 0
+	// Only the owner can see the collection.
+	Collection_PRIVATE Collection_Visibility = 1
+	// Anyone who can read pics can see the collection.
+	Collection_PUBLIC Collection_Visibility = 2
+)
+
+var Collection_Visibility_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PRIVATE",
+	2: "PUBLIC",
+}
+
+var Collection_Visibility_value = map[string]int32{
+	"UNKNOWN": 0,
+	"PRIVATE": 1,
+	"PUBLIC":  2,
+}
+
+func (x Collection_Visibility) String() string {
+	return proto.EnumName(Collection_Visibility_name, int32(x))
+}
+
+func (Collection_Visibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{4, 0}
+}
+
 type PicCommentVote_Vote int32
 
 const (
@@ -227,7 +390,7 @@ func (x PicCommentVote_Vote) String() string {
 }
 
 func (PicCommentVote_Vote) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{6, 0}
+	return fileDescriptor_871986018790d2fd, []int{11, 0}
 }
 
 type PicFile_Format int32
@@ -264,7 +427,7 @@ func (x PicFile_Format) String() string {
 }
 
 func (PicFile_Format) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{7, 0}
+	return fileDescriptor_871986018790d2fd, []int{12, 0}
 }
 
 type PicVote_Vote int32
@@ -295,7 +458,7 @@ func (x PicVote_Vote) String() string {
 }
 
 func (PicVote_Vote) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{10, 0}
+	return fileDescriptor_871986018790d2fd, []int{16, 0}
 }
 
 type PwtHeader_Algorithm int32
@@ -305,6 +468,10 @@ const (
 	PwtHeader_HS256     PwtHeader_Algorithm = 1
 	PwtHeader_RS256     PwtHeader_Algorithm = 2
 	PwtHeader_HS512_256 PwtHeader_Algorithm = 3
+	// ECDSA using P-256 and SHA-256.  The signature is r and s, each padded to 32 bytes.
+	PwtHeader_ES256 PwtHeader_Algorithm = 4
+	// Ed25519
+	PwtHeader_EDDSA PwtHeader_Algorithm = 5
 )
 
 var PwtHeader_Algorithm_name = map[int32]string{
@@ -312,6 +479,8 @@ var PwtHeader_Algorithm_name = map[int32]string{
 	1: "HS256",
 	2: "RS256",
 	3: "HS512_256",
+	4: "ES256",
+	5: "EDDSA",
 }
 
 var PwtHeader_Algorithm_value = map[string]int32{
@@ -319,6 +488,8 @@ var PwtHeader_Algorithm_value = map[string]int32{
 	"HS256":     1,
 	"RS256":     2,
 	"HS512_256": 3,
+	"ES256":     4,
+	"EDDSA":     5,
 }
 
 func (x PwtHeader_Algorithm) String() string {
@@ -326,7 +497,7 @@ func (x PwtHeader_Algorithm) String() string {
 }
 
 func (PwtHeader_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{12, 0}
+	return fileDescriptor_871986018790d2fd, []int{19, 0}
 }
 
 type PwtPayload_Type int32
@@ -354,7 +525,7 @@ func (x PwtPayload_Type) String() string {
 }
 
 func (PwtPayload_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{13, 0}
+	return fileDescriptor_871986018790d2fd, []int{20, 0}
 }
 
 // BackendConfiguration is the backend configuration used by Pixur.  All fields are optional
@@ -397,7 +568,54 @@ type BackendConfiguration struct {
 	// the default number of user events to return
 	DefaultFindUserEvents *wrappers.Int64Value `protobuf:"bytes,18,opt,name=default_find_user_events,json=defaultFindUserEvents,proto3" json:"default_find_user_events,omitempty"`
 	// the max number of user events to return
-	MaxFindUserEvents    *wrappers.Int64Value `protobuf:"bytes,19,opt,name=max_find_user_events,json=maxFindUserEvents,proto3" json:"max_find_user_events,omitempty"`
+	MaxFindUserEvents *wrappers.Int64Value `protobuf:"bytes,19,opt,name=max_find_user_events,json=maxFindUserEvents,proto3" json:"max_find_user_events,omitempty"`
+	// how long an upload session may go unmodified before it expires
+	UploadSessionExpiry *duration.Duration `protobuf:"bytes,20,opt,name=upload_session_expiry,json=uploadSessionExpiry,proto3" json:"upload_session_expiry,omitempty"`
+	// the max number of redirects to follow when fetching a remote pic
+	MaxRemoteFetchRedirects *wrappers.Int64Value `protobuf:"bytes,21,opt,name=max_remote_fetch_redirects,json=maxRemoteFetchRedirects,proto3" json:"max_remote_fetch_redirects,omitempty"`
+	// the max size in bytes of a remote pic
+	MaxRemoteFetchSize *wrappers.Int64Value `protobuf:"bytes,22,opt,name=max_remote_fetch_size,json=maxRemoteFetchSize,proto3" json:"max_remote_fetch_size,omitempty"`
+	// the max time to spend fetching a remote pic
+	RemoteFetchTimeout *duration.Duration `protobuf:"bytes,23,opt,name=remote_fetch_timeout,json=remoteFetchTimeout,proto3" json:"remote_fetch_timeout,omitempty"`
+	// the allowed media types of a remote pic.  If absent, any type is allowed.
+	RemoteFetchContentType *BackendConfiguration_StringSet `protobuf:"bytes,24,opt,name=remote_fetch_content_type,json=remoteFetchContentType,proto3" json:"remote_fetch_content_type,omitempty"`
+	// how long a secret reset token is valid for
+	PasswordResetExpiry *duration.Duration `protobuf:"bytes,25,opt,name=password_reset_expiry,json=passwordResetExpiry,proto3" json:"password_reset_expiry,omitempty"`
+	// users with any of these capabilities must enroll in two-factor authentication to use them
+	TotpRequiredCapability *BackendConfiguration_CapabilitySet `protobuf:"bytes,26,opt,name=totp_required_capability,json=totpRequiredCapability,proto3" json:"totp_required_capability,omitempty"`
+	// named sets of capabilities that users may hold.  Changing a role changes the capabilities of
+	// every user holding it.
+	Role *BackendConfiguration_RoleSet `protobuf:"bytes,27,opt,name=role,proto3" json:"role,omitempty"`
+	// limits on how often each rpc may be called by a single user, or by a single address for
+	// anonymous users.  Rpcs without a limit are unlimited.
+	RateLimit *BackendConfiguration_RateLimitSet `protobuf:"bytes,28,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// how new user secrets are hashed.  Existing secrets hashed some other way, or more weakly, are
+	// rehashed when the user next logs in.
+	PasswordHashPolicy *BackendConfiguration_PasswordHashPolicy `protobuf:"bytes,29,opt,name=password_hash_policy,json=passwordHashPolicy,proto3" json:"password_hash_policy,omitempty"`
+	// the default number of audit log entries to return
+	DefaultFindAuditLogs *wrappers.Int64Value `protobuf:"bytes,30,opt,name=default_find_audit_logs,json=defaultFindAuditLogs,proto3" json:"default_find_audit_logs,omitempty"`
+	// the max number of audit log entries to return
+	MaxFindAuditLogs *wrappers.Int64Value `protobuf:"bytes,31,opt,name=max_find_audit_logs,json=maxFindAuditLogs,proto3" json:"max_find_audit_logs,omitempty"`
+	// how long after creation the author may edit a comment.  If absent, there is no limit.
+	PicCommentEditWindow *duration.Duration `protobuf:"bytes,32,opt,name=pic_comment_edit_window,json=picCommentEditWindow,proto3" json:"pic_comment_edit_window,omitempty"`
+	// the max number of collections a user may own
+	MaxCollections *wrappers.Int64Value `protobuf:"bytes,33,opt,name=max_collections,json=maxCollections,proto3" json:"max_collections,omitempty"`
+	// the max number of pics in a single collection
+	MaxCollectionPics *wrappers.Int64Value `protobuf:"bytes,34,opt,name=max_collection_pics,json=maxCollectionPics,proto3" json:"max_collection_pics,omitempty"`
+	// the max collection name length in bytes.
+	MaxCollectionNameLength *wrappers.Int64Value `protobuf:"bytes,35,opt,name=max_collection_name_length,json=maxCollectionNameLength,proto3" json:"max_collection_name_length,omitempty"`
+	// the max number of pics in a single pool
+	MaxPoolPics *wrappers.Int64Value `protobuf:"bytes,36,opt,name=max_pool_pics,json=maxPoolPics,proto3" json:"max_pool_pics,omitempty"`
+	// the max pool title length in bytes.
+	MaxPoolTitleLength *wrappers.Int64Value `protobuf:"bytes,37,opt,name=max_pool_title_length,json=maxPoolTitleLength,proto3" json:"max_pool_title_length,omitempty"`
+	// the max pool description length in bytes.
+	MaxPoolDescriptionLength *wrappers.Int64Value `protobuf:"bytes,38,opt,name=max_pool_description_length,json=maxPoolDescriptionLength,proto3" json:"max_pool_description_length,omitempty"`
+	// the max number of users and tags, combined, that a user may follow
+	MaxFollows *wrappers.Int64Value `protobuf:"bytes,39,opt,name=max_follows,json=maxFollows,proto3" json:"max_follows,omitempty"`
+	// the max size in bytes of a pic streamed by UpsertPicStream, or sent in an upload session
+	MaxUploadStreamSize *wrappers.Int64Value `protobuf:"bytes,40,opt,name=max_upload_stream_size,json=maxUploadStreamSize,proto3" json:"max_upload_stream_size,omitempty"`
+	// the max number of unfinished upload sessions a user may have
+	MaxUploadSessions    *wrappers.Int64Value `protobuf:"bytes,41,opt,name=max_upload_sessions,json=maxUploadSessions,proto3" json:"max_upload_sessions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -516,120 +734,1044 @@ func (m *BackendConfiguration) GetDefaultFindIndexPics() *wrappers.Int64Value {
 	if m != nil {
 		return m.DefaultFindIndexPics
 	}
-	return nil
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxFindIndexPics() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFindIndexPics
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxVideoDuration() *duration.Duration {
+	if m != nil {
+		return m.MaxVideoDuration
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetEnablePicCommentSelfReply() *wrappers.BoolValue {
+	if m != nil {
+		return m.EnablePicCommentSelfReply
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetEnablePicCommentSiblingReply() *wrappers.BoolValue {
+	if m != nil {
+		return m.EnablePicCommentSiblingReply
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetDefaultFindUserEvents() *wrappers.Int64Value {
+	if m != nil {
+		return m.DefaultFindUserEvents
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxFindUserEvents() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFindUserEvents
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetUploadSessionExpiry() *duration.Duration {
+	if m != nil {
+		return m.UploadSessionExpiry
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxRemoteFetchRedirects() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxRemoteFetchRedirects
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxRemoteFetchSize() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxRemoteFetchSize
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetRemoteFetchTimeout() *duration.Duration {
+	if m != nil {
+		return m.RemoteFetchTimeout
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetRemoteFetchContentType() *BackendConfiguration_StringSet {
+	if m != nil {
+		return m.RemoteFetchContentType
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetPasswordResetExpiry() *duration.Duration {
+	if m != nil {
+		return m.PasswordResetExpiry
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetTotpRequiredCapability() *BackendConfiguration_CapabilitySet {
+	if m != nil {
+		return m.TotpRequiredCapability
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetRole() *BackendConfiguration_RoleSet {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetRateLimit() *BackendConfiguration_RateLimitSet {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetPasswordHashPolicy() *BackendConfiguration_PasswordHashPolicy {
+	if m != nil {
+		return m.PasswordHashPolicy
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetDefaultFindAuditLogs() *wrappers.Int64Value {
+	if m != nil {
+		return m.DefaultFindAuditLogs
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxFindAuditLogs() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFindAuditLogs
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetPicCommentEditWindow() *duration.Duration {
+	if m != nil {
+		return m.PicCommentEditWindow
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxCollections() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxCollections
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxCollectionPics() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxCollectionPics
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxCollectionNameLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxCollectionNameLength
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxPoolPics() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxPoolPics
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxPoolTitleLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxPoolTitleLength
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxPoolDescriptionLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxPoolDescriptionLength
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxFollows() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFollows
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxUploadStreamSize() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxUploadStreamSize
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxUploadSessions() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxUploadSessions
+	}
+	return nil
+}
+
+type BackendConfiguration_CapabilitySet struct {
+	Capability           []Capability_Cap `protobuf:"varint,1,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BackendConfiguration_CapabilitySet) Reset()         { *m = BackendConfiguration_CapabilitySet{} }
+func (m *BackendConfiguration_CapabilitySet) String() string { return proto.CompactTextString(m) }
+func (*BackendConfiguration_CapabilitySet) ProtoMessage()    {}
+func (*BackendConfiguration_CapabilitySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{0, 0}
+}
+
+func (m *BackendConfiguration_CapabilitySet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackendConfiguration_CapabilitySet.Unmarshal(m, b)
+}
+func (m *BackendConfiguration_CapabilitySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackendConfiguration_CapabilitySet.Marshal(b, m, deterministic)
+}
+func (m *BackendConfiguration_CapabilitySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackendConfiguration_CapabilitySet.Merge(m, src)
+}
+func (m *BackendConfiguration_CapabilitySet) XXX_Size() int {
+	return xxx_messageInfo_BackendConfiguration_CapabilitySet.Size(m)
+}
+func (m *BackendConfiguration_CapabilitySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackendConfiguration_CapabilitySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackendConfiguration_CapabilitySet proto.InternalMessageInfo
+
+func (m *BackendConfiguration_CapabilitySet) GetCapability() []Capability_Cap {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+type BackendConfiguration_RoleSet struct {
+	// the capabilities of each role, by name.
+	Role                 map[string]*BackendConfiguration_CapabilitySet `protobuf:"bytes,1,rep,name=role,proto3" json:"role,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *BackendConfiguration_RoleSet) Reset()         { *m = BackendConfiguration_RoleSet{} }
+func (m *BackendConfiguration_RoleSet) String() string { return proto.CompactTextString(m) }
+func (*BackendConfiguration_RoleSet) ProtoMessage()    {}
+func (*BackendConfiguration_RoleSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{0, 1}
+}
+
+func (m *BackendConfiguration_RoleSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackendConfiguration_RoleSet.Unmarshal(m, b)
+}
+func (m *BackendConfiguration_RoleSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackendConfiguration_RoleSet.Marshal(b, m, deterministic)
+}
+func (m *BackendConfiguration_RoleSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackendConfiguration_RoleSet.Merge(m, src)
+}
+func (m *BackendConfiguration_RoleSet) XXX_Size() int {
+	return xxx_messageInfo_BackendConfiguration_RoleSet.Size(m)
+}
+func (m *BackendConfiguration_RoleSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackendConfiguration_RoleSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackendConfiguration_RoleSet proto.InternalMessageInfo
+
+func (m *BackendConfiguration_RoleSet) GetRole() map[string]*BackendConfiguration_CapabilitySet {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type BackendConfiguration_StringSet struct {
+	Value                []string `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackendConfiguration_StringSet) Reset()         { *m = BackendConfiguration_StringSet{} }
+func (m *BackendConfiguration_StringSet) String() string { return proto.CompactTextString(m) }
+func (*BackendConfiguration_StringSet) ProtoMessage()    {}
+func (*BackendConfiguration_StringSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{0, 2}
+}
+
+func (m *BackendConfiguration_StringSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackendConfiguration_StringSet.Unmarshal(m, b)
+}
+func (m *BackendConfiguration_StringSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackendConfiguration_StringSet.Marshal(b, m, deterministic)
+}
+func (m *BackendConfiguration_StringSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackendConfiguration_StringSet.Merge(m, src)
+}
+func (m *BackendConfiguration_StringSet) XXX_Size() int {
+	return xxx_messageInfo_BackendConfiguration_StringSet.Size(m)
+}
+func (m *BackendConfiguration_StringSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackendConfiguration_StringSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackendConfiguration_StringSet proto.InternalMessageInfo
+
+func (m *BackendConfiguration_StringSet) GetValue() []string {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type BackendConfiguration_RateLimit struct {
+	// how many calls may be made at once, before being limited.
+	Burst int64 `protobuf:"varint,1,opt,name=burst,proto3" json:"burst,omitempty"`
+	// how long it takes to earn back one call.
+	Interval             *duration.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BackendConfiguration_RateLimit) Reset()         { *m = BackendConfiguration_RateLimit{} }
+func (m *BackendConfiguration_RateLimit) String() string { return proto.CompactTextString(m) }
+func (*BackendConfiguration_RateLimit) ProtoMessage()    {}
+func (*BackendConfiguration_RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{0, 3}
+}
+
+func (m *BackendConfiguration_RateLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackendConfiguration_RateLimit.Unmarshal(m, b)
+}
+func (m *BackendConfiguration_RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackendConfiguration_RateLimit.Marshal(b, m, deterministic)
+}
+func (m *BackendConfiguration_RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackendConfiguration_RateLimit.Merge(m, src)
+}
+func (m *BackendConfiguration_RateLimit) XXX_Size() int {
+	return xxx_messageInfo_BackendConfiguration_RateLimit.Size(m)
+}
+func (m *BackendConfiguration_RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackendConfiguration_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackendConfiguration_RateLimit proto.InternalMessageInfo
+
+func (m *BackendConfiguration_RateLimit) GetBurst() int64 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+func (m *BackendConfiguration_RateLimit) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+type BackendConfiguration_RateLimitSet struct {
+	// the limit of each rpc, by method name (e.g. "AddPicComment").
+	RateLimit            map[string]*BackendConfiguration_RateLimit `protobuf:"bytes,1,rep,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *BackendConfiguration_RateLimitSet) Reset()         { *m = BackendConfiguration_RateLimitSet{} }
+func (m *BackendConfiguration_RateLimitSet) String() string { return proto.CompactTextString(m) }
+func (*BackendConfiguration_RateLimitSet) ProtoMessage()    {}
+func (*BackendConfiguration_RateLimitSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{0, 4}
+}
+
+func (m *BackendConfiguration_RateLimitSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackendConfiguration_RateLimitSet.Unmarshal(m, b)
+}
+func (m *BackendConfiguration_RateLimitSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackendConfiguration_RateLimitSet.Marshal(b, m, deterministic)
+}
+func (m *BackendConfiguration_RateLimitSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackendConfiguration_RateLimitSet.Merge(m, src)
+}
+func (m *BackendConfiguration_RateLimitSet) XXX_Size() int {
+	return xxx_messageInfo_BackendConfiguration_RateLimitSet.Size(m)
+}
+func (m *BackendConfiguration_RateLimitSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackendConfiguration_RateLimitSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackendConfiguration_RateLimitSet proto.InternalMessageInfo
+
+func (m *BackendConfiguration_RateLimitSet) GetRateLimit() map[string]*BackendConfiguration_RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+type BackendConfiguration_PasswordHashPolicy struct {
+	Algorithm BackendConfiguration_PasswordHashPolicy_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=pixur.api.BackendConfiguration_PasswordHashPolicy_Algorithm" json:"algorithm,omitempty"`
+	// the cost of bcrypt hashes.
+	BcryptCost int64 `protobuf:"varint,2,opt,name=bcrypt_cost,json=bcryptCost,proto3" json:"bcrypt_cost,omitempty"`
+	// the number of passes of argon2id hashes.
+	Argon2Time int64 `protobuf:"varint,3,opt,name=argon2_time,json=argon2Time,proto3" json:"argon2_time,omitempty"`
+	// the memory in KiB of argon2id hashes.
+	Argon2Memory int64 `protobuf:"varint,4,opt,name=argon2_memory,json=argon2Memory,proto3" json:"argon2_memory,omitempty"`
+	// the parallelism of argon2id hashes.
+	Argon2Threads        int64    `protobuf:"varint,5,opt,name=argon2_threads,json=argon2Threads,proto3" json:"argon2_threads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackendConfiguration_PasswordHashPolicy) Reset() {
+	*m = BackendConfiguration_PasswordHashPolicy{}
+}
+func (m *BackendConfiguration_PasswordHashPolicy) String() string { return proto.CompactTextString(m) }
+func (*BackendConfiguration_PasswordHashPolicy) ProtoMessage()    {}
+func (*BackendConfiguration_PasswordHashPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{0, 5}
+}
+
+func (m *BackendConfiguration_PasswordHashPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackendConfiguration_PasswordHashPolicy.Unmarshal(m, b)
+}
+func (m *BackendConfiguration_PasswordHashPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackendConfiguration_PasswordHashPolicy.Marshal(b, m, deterministic)
+}
+func (m *BackendConfiguration_PasswordHashPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackendConfiguration_PasswordHashPolicy.Merge(m, src)
+}
+func (m *BackendConfiguration_PasswordHashPolicy) XXX_Size() int {
+	return xxx_messageInfo_BackendConfiguration_PasswordHashPolicy.Size(m)
+}
+func (m *BackendConfiguration_PasswordHashPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackendConfiguration_PasswordHashPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackendConfiguration_PasswordHashPolicy proto.InternalMessageInfo
+
+func (m *BackendConfiguration_PasswordHashPolicy) GetAlgorithm() BackendConfiguration_PasswordHashPolicy_Algorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return BackendConfiguration_PasswordHashPolicy_UNKNOWN
+}
+
+func (m *BackendConfiguration_PasswordHashPolicy) GetBcryptCost() int64 {
+	if m != nil {
+		return m.BcryptCost
+	}
+	return 0
+}
+
+func (m *BackendConfiguration_PasswordHashPolicy) GetArgon2Time() int64 {
+	if m != nil {
+		return m.Argon2Time
+	}
+	return 0
+}
+
+func (m *BackendConfiguration_PasswordHashPolicy) GetArgon2Memory() int64 {
+	if m != nil {
+		return m.Argon2Memory
+	}
+	return 0
+}
+
+func (m *BackendConfiguration_PasswordHashPolicy) GetArgon2Threads() int64 {
+	if m != nil {
+		return m.Argon2Threads
+	}
+	return 0
+}
+
+type Capability struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Capability) Reset()         { *m = Capability{} }
+func (m *Capability) String() string { return proto.CompactTextString(m) }
+func (*Capability) ProtoMessage()    {}
+func (*Capability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{1}
+}
+
+func (m *Capability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Capability.Unmarshal(m, b)
+}
+func (m *Capability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Capability.Marshal(b, m, deterministic)
+}
+func (m *Capability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Capability.Merge(m, src)
+}
+func (m *Capability) XXX_Size() int {
+	return xxx_messageInfo_Capability.Size(m)
+}
+func (m *Capability) XXX_DiscardUnknown() {
+	xxx_messageInfo_Capability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Capability proto.InternalMessageInfo
+
+// ApiKey allows a program to act as a user without a password.  The key itself is only returned
+// when created.
+type ApiKey struct {
+	ApiKeyId string `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	// user_id is the user the key acts as.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// name describes what the key is for.
+	Name        string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// expire_time is when the key can no longer be used.  If absent, it doesn't expire.
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// last_used_time is approximately when the key was last used, if ever.
+	LastUsedTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	// capability is the subset of the user's capabilities the key may use.
+	Capability           []Capability_Cap `protobuf:"varint,7,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ApiKey) Reset()         { *m = ApiKey{} }
+func (m *ApiKey) String() string { return proto.CompactTextString(m) }
+func (*ApiKey) ProtoMessage()    {}
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{2}
+}
+
+func (m *ApiKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKey.Unmarshal(m, b)
+}
+func (m *ApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKey.Marshal(b, m, deterministic)
+}
+func (m *ApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKey.Merge(m, src)
+}
+func (m *ApiKey) XXX_Size() int {
+	return xxx_messageInfo_ApiKey.Size(m)
+}
+func (m *ApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKey proto.InternalMessageInfo
+
+func (m *ApiKey) GetApiKeyId() string {
+	if m != nil {
+		return m.ApiKeyId
+	}
+	return ""
+}
+
+func (m *ApiKey) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ApiKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiKey) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *ApiKey) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *ApiKey) GetLastUsedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastUsedTime
+	}
+	return nil
+}
+
+func (m *ApiKey) GetCapability() []Capability_Cap {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+// AuditLog is a privileged action, such as changing capabilities or deleting a pic.
+type AuditLog struct {
+	AuditLogId string          `protobuf:"bytes,1,opt,name=audit_log_id,json=auditLogId,proto3" json:"audit_log_id,omitempty"`
+	Action     AuditLog_Action `protobuf:"varint,2,opt,name=action,proto3,enum=pixur.api.AuditLog_Action" json:"action,omitempty"`
+	// actor_user_id is the user who took the action.  If empty, the server took it.
+	ActorUserId string `protobuf:"bytes,3,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	// target_user_id and target_pic_id are what the action was taken on.  At most one is set.
+	TargetUserId string `protobuf:"bytes,4,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	TargetPicId  string `protobuf:"bytes,5,opt,name=target_pic_id,json=targetPicId,proto3" json:"target_pic_id,omitempty"`
+	// target_comment_id is the comment on target_pic_id, for comment actions.
+	TargetCommentId string               `protobuf:"bytes,9,opt,name=target_comment_id,json=targetCommentId,proto3" json:"target_comment_id,omitempty"`
+	CreatedTime     *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// reason is why the action was taken, as given by the actor.
+	Reason               string             `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Change               []*AuditLog_Change `protobuf:"bytes,8,rep,name=change,proto3" json:"change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AuditLog) Reset()         { *m = AuditLog{} }
+func (m *AuditLog) String() string { return proto.CompactTextString(m) }
+func (*AuditLog) ProtoMessage()    {}
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{3}
+}
+
+func (m *AuditLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLog.Unmarshal(m, b)
+}
+func (m *AuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLog.Marshal(b, m, deterministic)
+}
+func (m *AuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLog.Merge(m, src)
+}
+func (m *AuditLog) XXX_Size() int {
+	return xxx_messageInfo_AuditLog.Size(m)
+}
+func (m *AuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLog proto.InternalMessageInfo
+
+func (m *AuditLog) GetAuditLogId() string {
+	if m != nil {
+		return m.AuditLogId
+	}
+	return ""
+}
+
+func (m *AuditLog) GetAction() AuditLog_Action {
+	if m != nil {
+		return m.Action
+	}
+	return AuditLog_UNKNOWN
+}
+
+func (m *AuditLog) GetActorUserId() string {
+	if m != nil {
+		return m.ActorUserId
+	}
+	return ""
+}
+
+func (m *AuditLog) GetTargetUserId() string {
+	if m != nil {
+		return m.TargetUserId
+	}
+	return ""
+}
+
+func (m *AuditLog) GetTargetPicId() string {
+	if m != nil {
+		return m.TargetPicId
+	}
+	return ""
+}
+
+func (m *AuditLog) GetTargetCommentId() string {
+	if m != nil {
+		return m.TargetCommentId
+	}
+	return ""
+}
+
+func (m *AuditLog) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *AuditLog) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AuditLog) GetChange() []*AuditLog_Change {
+	if m != nil {
+		return m.Change
+	}
+	return nil
+}
+
+// Change is a field of the target that the action changed.  The values are in the compact text
+// format, and are empty if the field was unset.
+type AuditLog_Change struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before               string   `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After                string   `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditLog_Change) Reset()         { *m = AuditLog_Change{} }
+func (m *AuditLog_Change) String() string { return proto.CompactTextString(m) }
+func (*AuditLog_Change) ProtoMessage()    {}
+func (*AuditLog_Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{3, 0}
+}
+
+func (m *AuditLog_Change) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLog_Change.Unmarshal(m, b)
+}
+func (m *AuditLog_Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLog_Change.Marshal(b, m, deterministic)
+}
+func (m *AuditLog_Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLog_Change.Merge(m, src)
+}
+func (m *AuditLog_Change) XXX_Size() int {
+	return xxx_messageInfo_AuditLog_Change.Size(m)
+}
+func (m *AuditLog_Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLog_Change.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLog_Change proto.InternalMessageInfo
+
+func (m *AuditLog_Change) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *AuditLog_Change) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *AuditLog_Change) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+// Collection is a named, ordered set of pics made by a user.
+type Collection struct {
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// user_id is the owner of the collection.
+	UserId     string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name       string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Visibility Collection_Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=pixur.api.Collection_Visibility" json:"visibility,omitempty"`
+	// version is the version of the collection.  It is used when updating the collection.
+	Version              int64                `protobuf:"fixed64,5,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedTime          *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	ModifiedTime         *timestamp.Timestamp `protobuf:"bytes,7,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Collection) Reset()         { *m = Collection{} }
+func (m *Collection) String() string { return proto.CompactTextString(m) }
+func (*Collection) ProtoMessage()    {}
+func (*Collection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{4}
+}
+
+func (m *Collection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Collection.Unmarshal(m, b)
+}
+func (m *Collection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Collection.Marshal(b, m, deterministic)
+}
+func (m *Collection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Collection.Merge(m, src)
+}
+func (m *Collection) XXX_Size() int {
+	return xxx_messageInfo_Collection.Size(m)
+}
+func (m *Collection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Collection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Collection proto.InternalMessageInfo
+
+func (m *Collection) GetCollectionId() string {
+	if m != nil {
+		return m.CollectionId
+	}
+	return ""
+}
+
+func (m *Collection) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Collection) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Collection) GetVisibility() Collection_Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return Collection_UNKNOWN
+}
+
+func (m *Collection) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Collection) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *Collection) GetModifiedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTime
+	}
+	return nil
+}
+
+// InviteCode allows a new user to be created.  The code itself is only returned when created.
+type InviteCode struct {
+	InviteCodeId string `protobuf:"bytes,1,opt,name=invite_code_id,json=inviteCodeId,proto3" json:"invite_code_id,omitempty"`
+	// creator_user_id is the user who created the code.
+	CreatorUserId string               `protobuf:"bytes,2,opt,name=creator_user_id,json=creatorUserId,proto3" json:"creator_user_id,omitempty"`
+	CreatedTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// expire_time is when the code can no longer be used.  If absent, it doesn't expire.
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	MaxUses    int64                `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses       int64                `protobuf:"varint,6,opt,name=uses,proto3" json:"uses,omitempty"`
+	// capability is granted to users created with this code.  If empty, the default new user
+	// capabilities are granted.
+	Capability           []Capability_Cap `protobuf:"varint,7,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *InviteCode) Reset()         { *m = InviteCode{} }
+func (m *InviteCode) String() string { return proto.CompactTextString(m) }
+func (*InviteCode) ProtoMessage()    {}
+func (*InviteCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{5}
+}
+
+func (m *InviteCode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteCode.Unmarshal(m, b)
+}
+func (m *InviteCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteCode.Marshal(b, m, deterministic)
+}
+func (m *InviteCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteCode.Merge(m, src)
+}
+func (m *InviteCode) XXX_Size() int {
+	return xxx_messageInfo_InviteCode.Size(m)
+}
+func (m *InviteCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteCode proto.InternalMessageInfo
+
+func (m *InviteCode) GetInviteCodeId() string {
+	if m != nil {
+		return m.InviteCodeId
+	}
+	return ""
 }
 
-func (m *BackendConfiguration) GetMaxFindIndexPics() *wrappers.Int64Value {
+func (m *InviteCode) GetCreatorUserId() string {
 	if m != nil {
-		return m.MaxFindIndexPics
+		return m.CreatorUserId
 	}
-	return nil
+	return ""
 }
 
-func (m *BackendConfiguration) GetMaxVideoDuration() *duration.Duration {
+func (m *InviteCode) GetCreatedTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.MaxVideoDuration
+		return m.CreatedTime
 	}
 	return nil
 }
 
-func (m *BackendConfiguration) GetEnablePicCommentSelfReply() *wrappers.BoolValue {
+func (m *InviteCode) GetExpireTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.EnablePicCommentSelfReply
+		return m.ExpireTime
 	}
 	return nil
 }
 
-func (m *BackendConfiguration) GetEnablePicCommentSiblingReply() *wrappers.BoolValue {
+func (m *InviteCode) GetMaxUses() int64 {
 	if m != nil {
-		return m.EnablePicCommentSiblingReply
+		return m.MaxUses
 	}
-	return nil
+	return 0
 }
 
-func (m *BackendConfiguration) GetDefaultFindUserEvents() *wrappers.Int64Value {
+func (m *InviteCode) GetUses() int64 {
 	if m != nil {
-		return m.DefaultFindUserEvents
+		return m.Uses
 	}
-	return nil
+	return 0
 }
 
-func (m *BackendConfiguration) GetMaxFindUserEvents() *wrappers.Int64Value {
+func (m *InviteCode) GetCapability() []Capability_Cap {
 	if m != nil {
-		return m.MaxFindUserEvents
+		return m.Capability
 	}
 	return nil
 }
 
-type BackendConfiguration_CapabilitySet struct {
-	Capability           []Capability_Cap `protobuf:"varint,1,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// Session is a logged in client of a user, backed by an auth token.
+type Session struct {
+	// session_id identifies the session among the user's sessions.
+	SessionId   string               `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// last_seen_time is approximately when the session was last used.
+	LastSeenTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_seen_time,json=lastSeenTime,proto3" json:"last_seen_time,omitempty"`
+	// user_agent and remote_addr are from the most recent login or refresh of the session.
+	UserAgent  string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RemoteAddr string `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	// current is true if this is the session making the request.
+	Current              bool     `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BackendConfiguration_CapabilitySet) Reset()         { *m = BackendConfiguration_CapabilitySet{} }
-func (m *BackendConfiguration_CapabilitySet) String() string { return proto.CompactTextString(m) }
-func (*BackendConfiguration_CapabilitySet) ProtoMessage()    {}
-func (*BackendConfiguration_CapabilitySet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{0, 0}
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{6}
 }
 
-func (m *BackendConfiguration_CapabilitySet) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BackendConfiguration_CapabilitySet.Unmarshal(m, b)
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
 }
-func (m *BackendConfiguration_CapabilitySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BackendConfiguration_CapabilitySet.Marshal(b, m, deterministic)
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
 }
-func (m *BackendConfiguration_CapabilitySet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BackendConfiguration_CapabilitySet.Merge(m, src)
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
 }
-func (m *BackendConfiguration_CapabilitySet) XXX_Size() int {
-	return xxx_messageInfo_BackendConfiguration_CapabilitySet.Size(m)
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
 }
-func (m *BackendConfiguration_CapabilitySet) XXX_DiscardUnknown() {
-	xxx_messageInfo_BackendConfiguration_CapabilitySet.DiscardUnknown(m)
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BackendConfiguration_CapabilitySet proto.InternalMessageInfo
+var xxx_messageInfo_Session proto.InternalMessageInfo
 
-func (m *BackendConfiguration_CapabilitySet) GetCapability() []Capability_Cap {
+func (m *Session) GetSessionId() string {
 	if m != nil {
-		return m.Capability
+		return m.SessionId
 	}
-	return nil
+	return ""
 }
 
-type Capability struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Session) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
 }
 
-func (m *Capability) Reset()         { *m = Capability{} }
-func (m *Capability) String() string { return proto.CompactTextString(m) }
-func (*Capability) ProtoMessage()    {}
-func (*Capability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{1}
+func (m *Session) GetLastSeenTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSeenTime
+	}
+	return nil
 }
 
-func (m *Capability) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Capability.Unmarshal(m, b)
-}
-func (m *Capability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Capability.Marshal(b, m, deterministic)
-}
-func (m *Capability) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Capability.Merge(m, src)
-}
-func (m *Capability) XXX_Size() int {
-	return xxx_messageInfo_Capability.Size(m)
+func (m *Session) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
 }
-func (m *Capability) XXX_DiscardUnknown() {
-	xxx_messageInfo_Capability.DiscardUnknown(m)
+
+func (m *Session) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
 }
 
-var xxx_messageInfo_Capability proto.InternalMessageInfo
+func (m *Session) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
 
 type Pic struct {
 	// id is the unique identifier for the pic, in varint form
@@ -651,17 +1793,19 @@ type Pic struct {
 	File    *PicFile     `protobuf:"bytes,16,opt,name=file,proto3" json:"file,omitempty"`
 	Source  []*PicSource `protobuf:"bytes,18,rep,name=source,proto3" json:"source,omitempty"`
 	// The user id of the first user who uploading this pic.  May be absent.
-	FirstUserId          *wrappers.StringValue `protobuf:"bytes,19,opt,name=first_user_id,json=firstUserId,proto3" json:"first_user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	FirstUserId *wrappers.StringValue `protobuf:"bytes,19,opt,name=first_user_id,json=firstUserId,proto3" json:"first_user_id,omitempty"`
+	// favorite_count is the number of users who favorited this pic.
+	FavoriteCount        int64    `protobuf:"varint,20,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Pic) Reset()         { *m = Pic{} }
 func (m *Pic) String() string { return proto.CompactTextString(m) }
 func (*Pic) ProtoMessage()    {}
 func (*Pic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{2}
+	return fileDescriptor_871986018790d2fd, []int{7}
 }
 
 func (m *Pic) XXX_Unmarshal(b []byte) error {
@@ -759,19 +1903,28 @@ func (m *Pic) GetFirstUserId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *Pic) GetFavoriteCount() int64 {
+	if m != nil {
+		return m.FavoriteCount
+	}
+	return 0
+}
+
 type PicAndThumbnail struct {
-	Pic                  *Pic       `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
-	Thumbnail            []*PicFile `protobuf:"bytes,2,rep,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Pic       *Pic       `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	Thumbnail []*PicFile `protobuf:"bytes,2,rep,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	// A stand-in to show while the thumbnail loads.  May be absent.
+	Placeholder          *PicPlaceholder `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *PicAndThumbnail) Reset()         { *m = PicAndThumbnail{} }
 func (m *PicAndThumbnail) String() string { return proto.CompactTextString(m) }
 func (*PicAndThumbnail) ProtoMessage()    {}
 func (*PicAndThumbnail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{3}
+	return fileDescriptor_871986018790d2fd, []int{8}
 }
 
 func (m *PicAndThumbnail) XXX_Unmarshal(b []byte) error {
@@ -806,6 +1959,13 @@ func (m *PicAndThumbnail) GetThumbnail() []*PicFile {
 	return nil
 }
 
+func (m *PicAndThumbnail) GetPlaceholder() *PicPlaceholder {
+	if m != nil {
+		return m.Placeholder
+	}
+	return nil
+}
+
 type PicComment struct {
 	// pic_id is the unique identifier for the pic, in varint form
 	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
@@ -821,17 +1981,25 @@ type PicComment struct {
 	// version is the version of the tag.  It is used when updating the tag.
 	Version int64 `protobuf:"fixed64,6,opt,name=version,proto3" json:"version,omitempty"`
 	// The user id of comment author.  May be absent.
-	UserId               *wrappers.StringValue `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	UserId *wrappers.StringValue `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// edited_time is when the text was last edited.  Absent if it never was.
+	EditedTime *timestamp.Timestamp `protobuf:"bytes,9,opt,name=edited_time,json=editedTime,proto3" json:"edited_time,omitempty"`
+	// deleted is true if the comment was deleted.  The text is a placeholder, but the comment is
+	// kept so replies to it still have a parent.
+	Deleted bool `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// revision is the prior texts of the comment, oldest first.  Only present for the author and
+	// moderators.
+	Revision             []*PicComment_Revision `protobuf:"bytes,11,rep,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *PicComment) Reset()         { *m = PicComment{} }
 func (m *PicComment) String() string { return proto.CompactTextString(m) }
 func (*PicComment) ProtoMessage()    {}
 func (*PicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{4}
+	return fileDescriptor_871986018790d2fd, []int{9}
 }
 
 func (m *PicComment) XXX_Unmarshal(b []byte) error {
@@ -908,6 +2076,85 @@ func (m *PicComment) GetUserId() *wrappers.StringValue {
 	return nil
 }
 
+func (m *PicComment) GetEditedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EditedTime
+	}
+	return nil
+}
+
+func (m *PicComment) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *PicComment) GetRevision() []*PicComment_Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+type PicComment_Revision struct {
+	// text is the text before it was replaced.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// replaced_time is when the text was replaced.
+	ReplacedTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=replaced_time,json=replacedTime,proto3" json:"replaced_time,omitempty"`
+	// user_id is the user who replaced the text.  May be absent.
+	UserId               *wrappers.StringValue `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PicComment_Revision) Reset()         { *m = PicComment_Revision{} }
+func (m *PicComment_Revision) String() string { return proto.CompactTextString(m) }
+func (*PicComment_Revision) ProtoMessage()    {}
+func (*PicComment_Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{9, 0}
+}
+
+func (m *PicComment_Revision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicComment_Revision.Unmarshal(m, b)
+}
+func (m *PicComment_Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicComment_Revision.Marshal(b, m, deterministic)
+}
+func (m *PicComment_Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicComment_Revision.Merge(m, src)
+}
+func (m *PicComment_Revision) XXX_Size() int {
+	return xxx_messageInfo_PicComment_Revision.Size(m)
+}
+func (m *PicComment_Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicComment_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PicComment_Revision proto.InternalMessageInfo
+
+func (m *PicComment_Revision) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *PicComment_Revision) GetReplacedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReplacedTime
+	}
+	return nil
+}
+
+func (m *PicComment_Revision) GetUserId() *wrappers.StringValue {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
 type PicCommentTree struct {
 	// All comments, ordered by a post order traversal.  Protobuf does not handle deeply nested
 	// messages well.
@@ -921,7 +2168,7 @@ func (m *PicCommentTree) Reset()         { *m = PicCommentTree{} }
 func (m *PicCommentTree) String() string { return proto.CompactTextString(m) }
 func (*PicCommentTree) ProtoMessage()    {}
 func (*PicCommentTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{5}
+	return fileDescriptor_871986018790d2fd, []int{10}
 }
 
 func (m *PicCommentTree) XXX_Unmarshal(b []byte) error {
@@ -967,7 +2214,7 @@ func (m *PicCommentVote) Reset()         { *m = PicCommentVote{} }
 func (m *PicCommentVote) String() string { return proto.CompactTextString(m) }
 func (*PicCommentVote) ProtoMessage()    {}
 func (*PicCommentVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{6}
+	return fileDescriptor_871986018790d2fd, []int{11}
 }
 
 func (m *PicCommentVote) XXX_Unmarshal(b []byte) error {
@@ -1065,7 +2312,7 @@ func (m *PicFile) Reset()         { *m = PicFile{} }
 func (m *PicFile) String() string { return proto.CompactTextString(m) }
 func (*PicFile) ProtoMessage()    {}
 func (*PicFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{7}
+	return fileDescriptor_871986018790d2fd, []int{12}
 }
 
 func (m *PicFile) XXX_Unmarshal(b []byte) error {
@@ -1149,6 +2396,55 @@ func (m *PicFile) GetSize() int64 {
 	return 0
 }
 
+type PicPlaceholder struct {
+	// The BlurHash encoding of the pic.  See https://blurha.sh/
+	BlurHash string `protobuf:"bytes,1,opt,name=blur_hash,json=blurHash,proto3" json:"blur_hash,omitempty"`
+	// The most common color in the pic, as 0xRRGGBB.
+	DominantColor        uint32   `protobuf:"varint,2,opt,name=dominant_color,json=dominantColor,proto3" json:"dominant_color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PicPlaceholder) Reset()         { *m = PicPlaceholder{} }
+func (m *PicPlaceholder) String() string { return proto.CompactTextString(m) }
+func (*PicPlaceholder) ProtoMessage()    {}
+func (*PicPlaceholder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{13}
+}
+
+func (m *PicPlaceholder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicPlaceholder.Unmarshal(m, b)
+}
+func (m *PicPlaceholder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicPlaceholder.Marshal(b, m, deterministic)
+}
+func (m *PicPlaceholder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicPlaceholder.Merge(m, src)
+}
+func (m *PicPlaceholder) XXX_Size() int {
+	return xxx_messageInfo_PicPlaceholder.Size(m)
+}
+func (m *PicPlaceholder) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicPlaceholder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PicPlaceholder proto.InternalMessageInfo
+
+func (m *PicPlaceholder) GetBlurHash() string {
+	if m != nil {
+		return m.BlurHash
+	}
+	return ""
+}
+
+func (m *PicPlaceholder) GetDominantColor() uint32 {
+	if m != nil {
+		return m.DominantColor
+	}
+	return 0
+}
+
 type PicSource struct {
 	// url is optional and is the location the pic came from.
 	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
@@ -1167,7 +2463,7 @@ func (m *PicSource) Reset()         { *m = PicSource{} }
 func (m *PicSource) String() string { return proto.CompactTextString(m) }
 func (*PicSource) ProtoMessage()    {}
 func (*PicSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{8}
+	return fileDescriptor_871986018790d2fd, []int{14}
 }
 
 func (m *PicSource) XXX_Unmarshal(b []byte) error {
@@ -1231,7 +2527,7 @@ func (m *PicTag) Reset()         { *m = PicTag{} }
 func (m *PicTag) String() string { return proto.CompactTextString(m) }
 func (*PicTag) ProtoMessage()    {}
 func (*PicTag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{9}
+	return fileDescriptor_871986018790d2fd, []int{15}
 }
 
 func (m *PicTag) XXX_Unmarshal(b []byte) error {
@@ -1311,7 +2607,7 @@ func (m *PicVote) Reset()         { *m = PicVote{} }
 func (m *PicVote) String() string { return proto.CompactTextString(m) }
 func (*PicVote) ProtoMessage()    {}
 func (*PicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{10}
+	return fileDescriptor_871986018790d2fd, []int{16}
 }
 
 func (m *PicVote) XXX_Unmarshal(b []byte) error {
@@ -1374,24 +2670,99 @@ func (m *PicVote) GetModifiedTime() *timestamp.Timestamp {
 	return nil
 }
 
+// Pool is an ordered sequence of pics, such as the pages of a comic.
+type Pool struct {
+	PoolId               string               `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Title                string               `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedTime          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	ModifiedTime         *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Pool) Reset()         { *m = Pool{} }
+func (m *Pool) String() string { return proto.CompactTextString(m) }
+func (*Pool) ProtoMessage()    {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{17}
+}
+
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Pool.Unmarshal(m, b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return xxx_messageInfo_Pool.Size(m)
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func (m *Pool) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *Pool) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Pool) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Pool) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *Pool) GetModifiedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTime
+	}
+	return nil
+}
+
 // PublicUserInfo is information about a user
 type PublicUserInfo struct {
 	// user_id is the id of the user.  It is always present.
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// ident is the public name of the user.  It may be absent if the user does not have a name.
+	// ident is the public name of the user.  It may be absent if the user does not have a name, or
+	// if they have a display name in their profile.
 	Ident string `protobuf:"bytes,2,opt,name=ident,proto3" json:"ident,omitempty"`
 	// created_time is when the user was created.
-	CreatedTime          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// profile is the public profile of the user, if any.
+	Profile              *UserProfile `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *PublicUserInfo) Reset()         { *m = PublicUserInfo{} }
 func (m *PublicUserInfo) String() string { return proto.CompactTextString(m) }
 func (*PublicUserInfo) ProtoMessage()    {}
 func (*PublicUserInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{11}
+	return fileDescriptor_871986018790d2fd, []int{18}
 }
 
 func (m *PublicUserInfo) XXX_Unmarshal(b []byte) error {
@@ -1433,19 +2804,29 @@ func (m *PublicUserInfo) GetCreatedTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *PublicUserInfo) GetProfile() *UserProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
 type PwtHeader struct {
-	Algorithm            PwtHeader_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=pixur.api.PwtHeader_Algorithm" json:"algorithm,omitempty"`
-	Version              int64               `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Algorithm PwtHeader_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=pixur.api.PwtHeader_Algorithm" json:"algorithm,omitempty"`
+	Version   int64               `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// key_id identifies the key that signed the token.  If absent, the token was signed by the
+	// legacy token secret.
+	KeyId                string   `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PwtHeader) Reset()         { *m = PwtHeader{} }
 func (m *PwtHeader) String() string { return proto.CompactTextString(m) }
 func (*PwtHeader) ProtoMessage()    {}
 func (*PwtHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{12}
+	return fileDescriptor_871986018790d2fd, []int{19}
 }
 
 func (m *PwtHeader) XXX_Unmarshal(b []byte) error {
@@ -1480,6 +2861,13 @@ func (m *PwtHeader) GetVersion() int64 {
 	return 0
 }
 
+func (m *PwtHeader) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
 type PwtPayload struct {
 	Subject   string               `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
 	NotBefore *timestamp.Timestamp `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
@@ -1498,7 +2886,7 @@ func (m *PwtPayload) Reset()         { *m = PwtPayload{} }
 func (m *PwtPayload) String() string { return proto.CompactTextString(m) }
 func (*PwtPayload) ProtoMessage()    {}
 func (*PwtPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{13}
+	return fileDescriptor_871986018790d2fd, []int{20}
 }
 
 func (m *PwtPayload) XXX_Unmarshal(b []byte) error {
@@ -1568,6 +2956,83 @@ func (m *PwtPayload) GetType() PwtPayload_Type {
 	return PwtPayload_UNKNOWN
 }
 
+// UploadSession is a pic being uploaded in multiple parts.
+type UploadSession struct {
+	// upload_session_id is the identifier of the session.  It is used for each append.
+	UploadSessionId string `protobuf:"bytes,1,opt,name=upload_session_id,json=uploadSessionId,proto3" json:"upload_session_id,omitempty"`
+	// offset is the number of bytes received so far.  The next append must start here.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// created_time is when the session was started.
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// modified_time is when data was last appended to the session.
+	ModifiedTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
+	// expire_time is when the session will be discarded if no more data is appended.
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UploadSession) Reset()         { *m = UploadSession{} }
+func (m *UploadSession) String() string { return proto.CompactTextString(m) }
+func (*UploadSession) ProtoMessage()    {}
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{21}
+}
+
+func (m *UploadSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadSession.Unmarshal(m, b)
+}
+func (m *UploadSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadSession.Marshal(b, m, deterministic)
+}
+func (m *UploadSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadSession.Merge(m, src)
+}
+func (m *UploadSession) XXX_Size() int {
+	return xxx_messageInfo_UploadSession.Size(m)
+}
+func (m *UploadSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadSession proto.InternalMessageInfo
+
+func (m *UploadSession) GetUploadSessionId() string {
+	if m != nil {
+		return m.UploadSessionId
+	}
+	return ""
+}
+
+func (m *UploadSession) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *UploadSession) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *UploadSession) GetModifiedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTime
+	}
+	return nil
+}
+
+func (m *UploadSession) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
 type User struct {
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Ident  string `protobuf:"bytes,2,opt,name=ident,proto3" json:"ident,omitempty"`
@@ -1578,18 +3043,30 @@ type User struct {
 	// modified_time is when the user was last modified.
 	LastSeenTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_seen_time,json=lastSeenTime,proto3" json:"last_seen_time,omitempty"`
 	// version is the version of the user.  It is used when updating the user.
-	Version              int64            `protobuf:"fixed64,6,opt,name=version,proto3" json:"version,omitempty"`
-	Capability           []Capability_Cap `protobuf:"varint,7,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Version    int64            `protobuf:"fixed64,6,opt,name=version,proto3" json:"version,omitempty"`
+	Capability []Capability_Cap `protobuf:"varint,7,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	// inviter_user_id is the user who invited this user, if any.
+	InviterUserId string `protobuf:"bytes,8,opt,name=inviter_user_id,json=inviterUserId,proto3" json:"inviter_user_id,omitempty"`
+	// totp_enrolled is true if the user has enabled two-factor authentication.
+	TotpEnrolled bool `protobuf:"varint,9,opt,name=totp_enrolled,json=totpEnrolled,proto3" json:"totp_enrolled,omitempty"`
+	// role is the names of the roles the user holds.  The capabilities of the roles are not included
+	// in capability.
+	Role []string `protobuf:"bytes,10,rep,name=role,proto3" json:"role,omitempty"`
+	// suspension is the active suspension of the user, if any.  While suspended, the user can only
+	// read.
+	Suspension *User_Suspension `protobuf:"bytes,11,opt,name=suspension,proto3" json:"suspension,omitempty"`
+	// profile is the public profile of the user, if any.
+	Profile              *UserProfile `protobuf:"bytes,12,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{14}
+	return fileDescriptor_871986018790d2fd, []int{22}
 }
 
 func (m *User) XXX_Unmarshal(b []byte) error {
@@ -1659,6 +3136,107 @@ func (m *User) GetCapability() []Capability_Cap {
 	return nil
 }
 
+func (m *User) GetInviterUserId() string {
+	if m != nil {
+		return m.InviterUserId
+	}
+	return ""
+}
+
+func (m *User) GetTotpEnrolled() bool {
+	if m != nil {
+		return m.TotpEnrolled
+	}
+	return false
+}
+
+func (m *User) GetRole() []string {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *User) GetSuspension() *User_Suspension {
+	if m != nil {
+		return m.Suspension
+	}
+	return nil
+}
+
+func (m *User) GetProfile() *UserProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type User_Suspension struct {
+	// reason is why the user was suspended.
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// issuer_user_id is the user who suspended this user.
+	IssuerUserId string               `protobuf:"bytes,2,opt,name=issuer_user_id,json=issuerUserId,proto3" json:"issuer_user_id,omitempty"`
+	StartTime    *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is when the suspension ends.  If absent, it lasts until the user is unsuspended.
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *User_Suspension) Reset()         { *m = User_Suspension{} }
+func (m *User_Suspension) String() string { return proto.CompactTextString(m) }
+func (*User_Suspension) ProtoMessage()    {}
+func (*User_Suspension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{22, 0}
+}
+
+func (m *User_Suspension) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User_Suspension.Unmarshal(m, b)
+}
+func (m *User_Suspension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User_Suspension.Marshal(b, m, deterministic)
+}
+func (m *User_Suspension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User_Suspension.Merge(m, src)
+}
+func (m *User_Suspension) XXX_Size() int {
+	return xxx_messageInfo_User_Suspension.Size(m)
+}
+func (m *User_Suspension) XXX_DiscardUnknown() {
+	xxx_messageInfo_User_Suspension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User_Suspension proto.InternalMessageInfo
+
+func (m *User_Suspension) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *User_Suspension) GetIssuerUserId() string {
+	if m != nil {
+		return m.IssuerUserId
+	}
+	return ""
+}
+
+func (m *User_Suspension) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *User_Suspension) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
 type UserEvent struct {
 	// user_id is the id of the user this event applies to.
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1673,6 +3251,10 @@ type UserEvent struct {
 	//	*UserEvent_OutgoingPicComment_
 	//	*UserEvent_IncomingPicComment_
 	//	*UserEvent_UpsertPic_
+	//	*UserEvent_LoginLockout_
+	//	*UserEvent_Suspend_
+	//	*UserEvent_Unsuspend_
+	//	*UserEvent_IncomingFollow_
 	Evt                  isUserEvent_Evt `protobuf_oneof:"evt"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
@@ -1683,7 +3265,7 @@ func (m *UserEvent) Reset()         { *m = UserEvent{} }
 func (m *UserEvent) String() string { return proto.CompactTextString(m) }
 func (*UserEvent) ProtoMessage()    {}
 func (*UserEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15}
+	return fileDescriptor_871986018790d2fd, []int{23}
 }
 
 func (m *UserEvent) XXX_Unmarshal(b []byte) error {
@@ -1749,6 +3331,22 @@ type UserEvent_UpsertPic_ struct {
 	UpsertPic *UserEvent_UpsertPic `protobuf:"bytes,8,opt,name=upsert_pic,json=upsertPic,proto3,oneof"`
 }
 
+type UserEvent_LoginLockout_ struct {
+	LoginLockout *UserEvent_LoginLockout `protobuf:"bytes,9,opt,name=login_lockout,json=loginLockout,proto3,oneof"`
+}
+
+type UserEvent_Suspend_ struct {
+	Suspend *UserEvent_Suspend `protobuf:"bytes,10,opt,name=suspend,proto3,oneof"`
+}
+
+type UserEvent_Unsuspend_ struct {
+	Unsuspend *UserEvent_Unsuspend `protobuf:"bytes,11,opt,name=unsuspend,proto3,oneof"`
+}
+
+type UserEvent_IncomingFollow_ struct {
+	IncomingFollow *UserEvent_IncomingFollow `protobuf:"bytes,12,opt,name=incoming_follow,json=incomingFollow,proto3,oneof"`
+}
+
 func (*UserEvent_OutgoingUpsertPicVote_) isUserEvent_Evt() {}
 
 func (*UserEvent_IncomingUpsertPicVote_) isUserEvent_Evt() {}
@@ -1759,6 +3357,14 @@ func (*UserEvent_IncomingPicComment_) isUserEvent_Evt() {}
 
 func (*UserEvent_UpsertPic_) isUserEvent_Evt() {}
 
+func (*UserEvent_LoginLockout_) isUserEvent_Evt() {}
+
+func (*UserEvent_Suspend_) isUserEvent_Evt() {}
+
+func (*UserEvent_Unsuspend_) isUserEvent_Evt() {}
+
+func (*UserEvent_IncomingFollow_) isUserEvent_Evt() {}
+
 func (m *UserEvent) GetEvt() isUserEvent_Evt {
 	if m != nil {
 		return m.Evt
@@ -1801,6 +3407,34 @@ func (m *UserEvent) GetUpsertPic() *UserEvent_UpsertPic {
 	return nil
 }
 
+func (m *UserEvent) GetLoginLockout() *UserEvent_LoginLockout {
+	if x, ok := m.GetEvt().(*UserEvent_LoginLockout_); ok {
+		return x.LoginLockout
+	}
+	return nil
+}
+
+func (m *UserEvent) GetSuspend() *UserEvent_Suspend {
+	if x, ok := m.GetEvt().(*UserEvent_Suspend_); ok {
+		return x.Suspend
+	}
+	return nil
+}
+
+func (m *UserEvent) GetUnsuspend() *UserEvent_Unsuspend {
+	if x, ok := m.GetEvt().(*UserEvent_Unsuspend_); ok {
+		return x.Unsuspend
+	}
+	return nil
+}
+
+func (m *UserEvent) GetIncomingFollow() *UserEvent_IncomingFollow {
+	if x, ok := m.GetEvt().(*UserEvent_IncomingFollow_); ok {
+		return x.IncomingFollow
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*UserEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1809,6 +3443,10 @@ func (*UserEvent) XXX_OneofWrappers() []interface{} {
 		(*UserEvent_OutgoingPicComment_)(nil),
 		(*UserEvent_IncomingPicComment_)(nil),
 		(*UserEvent_UpsertPic_)(nil),
+		(*UserEvent_LoginLockout_)(nil),
+		(*UserEvent_Suspend_)(nil),
+		(*UserEvent_Unsuspend_)(nil),
+		(*UserEvent_IncomingFollow_)(nil),
 	}
 }
 
@@ -1825,7 +3463,7 @@ func (m *UserEvent_OutgoingUpsertPicVote) Reset()         { *m = UserEvent_Outgo
 func (m *UserEvent_OutgoingUpsertPicVote) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_OutgoingUpsertPicVote) ProtoMessage()    {}
 func (*UserEvent_OutgoingUpsertPicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 0}
+	return fileDescriptor_871986018790d2fd, []int{23, 0}
 }
 
 func (m *UserEvent_OutgoingUpsertPicVote) XXX_Unmarshal(b []byte) error {
@@ -1868,7 +3506,7 @@ func (m *UserEvent_IncomingUpsertPicVote) Reset()         { *m = UserEvent_Incom
 func (m *UserEvent_IncomingUpsertPicVote) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_IncomingUpsertPicVote) ProtoMessage()    {}
 func (*UserEvent_IncomingUpsertPicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 1}
+	return fileDescriptor_871986018790d2fd, []int{23, 1}
 }
 
 func (m *UserEvent_IncomingUpsertPicVote) XXX_Unmarshal(b []byte) error {
@@ -1918,7 +3556,7 @@ func (m *UserEvent_OutgoingPicComment) Reset()         { *m = UserEvent_Outgoing
 func (m *UserEvent_OutgoingPicComment) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_OutgoingPicComment) ProtoMessage()    {}
 func (*UserEvent_OutgoingPicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 2}
+	return fileDescriptor_871986018790d2fd, []int{23, 2}
 }
 
 func (m *UserEvent_OutgoingPicComment) XXX_Unmarshal(b []byte) error {
@@ -1970,7 +3608,7 @@ func (m *UserEvent_IncomingPicComment) Reset()         { *m = UserEvent_Incoming
 func (m *UserEvent_IncomingPicComment) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_IncomingPicComment) ProtoMessage()    {}
 func (*UserEvent_IncomingPicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 3}
+	return fileDescriptor_871986018790d2fd, []int{23, 3}
 }
 
 func (m *UserEvent_IncomingPicComment) XXX_Unmarshal(b []byte) error {
@@ -2023,7 +3661,7 @@ func (m *UserEvent_UpsertPic) Reset()         { *m = UserEvent_UpsertPic{} }
 func (m *UserEvent_UpsertPic) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_UpsertPic) ProtoMessage()    {}
 func (*UserEvent_UpsertPic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 4}
+	return fileDescriptor_871986018790d2fd, []int{23, 4}
 }
 
 func (m *UserEvent_UpsertPic) XXX_Unmarshal(b []byte) error {
@@ -2051,9 +3689,241 @@ func (m *UserEvent_UpsertPic) GetPicId() string {
 	return ""
 }
 
+// LoginLockout represents logins to this user being locked after too many failed attempts.
+type UserEvent_LoginLockout struct {
+	// The address of the last failed attempt, if known.
+	RemoteAddr           string               `protobuf:"bytes,1,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	LockedUntilTime      *timestamp.Timestamp `protobuf:"bytes,2,opt,name=locked_until_time,json=lockedUntilTime,proto3" json:"locked_until_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserEvent_LoginLockout) Reset()         { *m = UserEvent_LoginLockout{} }
+func (m *UserEvent_LoginLockout) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_LoginLockout) ProtoMessage()    {}
+func (*UserEvent_LoginLockout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{23, 5}
+}
+
+func (m *UserEvent_LoginLockout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_LoginLockout.Unmarshal(m, b)
+}
+func (m *UserEvent_LoginLockout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_LoginLockout.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_LoginLockout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_LoginLockout.Merge(m, src)
+}
+func (m *UserEvent_LoginLockout) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_LoginLockout.Size(m)
+}
+func (m *UserEvent_LoginLockout) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_LoginLockout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_LoginLockout proto.InternalMessageInfo
+
+func (m *UserEvent_LoginLockout) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
+}
+
+func (m *UserEvent_LoginLockout) GetLockedUntilTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LockedUntilTime
+	}
+	return nil
+}
+
+// Suspend represents this user being suspended.
+type UserEvent_Suspend struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// When the suspension ends, if it isn't indefinite.
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserEvent_Suspend) Reset()         { *m = UserEvent_Suspend{} }
+func (m *UserEvent_Suspend) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_Suspend) ProtoMessage()    {}
+func (*UserEvent_Suspend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{23, 6}
+}
+
+func (m *UserEvent_Suspend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_Suspend.Unmarshal(m, b)
+}
+func (m *UserEvent_Suspend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_Suspend.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_Suspend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_Suspend.Merge(m, src)
+}
+func (m *UserEvent_Suspend) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_Suspend.Size(m)
+}
+func (m *UserEvent_Suspend) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_Suspend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_Suspend proto.InternalMessageInfo
+
+func (m *UserEvent_Suspend) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *UserEvent_Suspend) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// Unsuspend represents this user's suspension being lifted early.
+type UserEvent_Unsuspend struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_Unsuspend) Reset()         { *m = UserEvent_Unsuspend{} }
+func (m *UserEvent_Unsuspend) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_Unsuspend) ProtoMessage()    {}
+func (*UserEvent_Unsuspend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{23, 7}
+}
+
+func (m *UserEvent_Unsuspend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_Unsuspend.Unmarshal(m, b)
+}
+func (m *UserEvent_Unsuspend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_Unsuspend.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_Unsuspend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_Unsuspend.Merge(m, src)
+}
+func (m *UserEvent_Unsuspend) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_Unsuspend.Size(m)
+}
+func (m *UserEvent_Unsuspend) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_Unsuspend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_Unsuspend proto.InternalMessageInfo
+
+// IncomingFollow represents another user starting to follow this user.
+type UserEvent_IncomingFollow struct {
+	// The user who followed this user.  May be absent.
+	SubjectUserId        string   `protobuf:"bytes,1,opt,name=subject_user_id,json=subjectUserId,proto3" json:"subject_user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_IncomingFollow) Reset()         { *m = UserEvent_IncomingFollow{} }
+func (m *UserEvent_IncomingFollow) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_IncomingFollow) ProtoMessage()    {}
+func (*UserEvent_IncomingFollow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{23, 8}
+}
+
+func (m *UserEvent_IncomingFollow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_IncomingFollow.Unmarshal(m, b)
+}
+func (m *UserEvent_IncomingFollow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_IncomingFollow.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_IncomingFollow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_IncomingFollow.Merge(m, src)
+}
+func (m *UserEvent_IncomingFollow) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_IncomingFollow.Size(m)
+}
+func (m *UserEvent_IncomingFollow) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_IncomingFollow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_IncomingFollow proto.InternalMessageInfo
+
+func (m *UserEvent_IncomingFollow) GetSubjectUserId() string {
+	if m != nil {
+		return m.SubjectUserId
+	}
+	return ""
+}
+
+// UserProfile is information a user chooses to show to others.
+type UserProfile struct {
+	// display_name is shown to other users instead of the ident.
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio         string `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	// avatar_pic_id is the pic shown next to the user's name, if any.
+	AvatarPicId          string   `protobuf:"bytes,3,opt,name=avatar_pic_id,json=avatarPicId,proto3" json:"avatar_pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserProfile) Reset()         { *m = UserProfile{} }
+func (m *UserProfile) String() string { return proto.CompactTextString(m) }
+func (*UserProfile) ProtoMessage()    {}
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{24}
+}
+
+func (m *UserProfile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserProfile.Unmarshal(m, b)
+}
+func (m *UserProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserProfile.Marshal(b, m, deterministic)
+}
+func (m *UserProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserProfile.Merge(m, src)
+}
+func (m *UserProfile) XXX_Size() int {
+	return xxx_messageInfo_UserProfile.Size(m)
+}
+func (m *UserProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserProfile proto.InternalMessageInfo
+
+func (m *UserProfile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *UserProfile) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *UserProfile) GetAvatarPicId() string {
+	if m != nil {
+		return m.AvatarPicId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("pixur.api.DeletionReason", DeletionReason_name, DeletionReason_value)
+	proto.RegisterEnum("pixur.api.BackendConfiguration_PasswordHashPolicy_Algorithm", BackendConfiguration_PasswordHashPolicy_Algorithm_name, BackendConfiguration_PasswordHashPolicy_Algorithm_value)
 	proto.RegisterEnum("pixur.api.Capability_Cap", Capability_Cap_name, Capability_Cap_value)
+	proto.RegisterEnum("pixur.api.AuditLog_Action", AuditLog_Action_name, AuditLog_Action_value)
+	proto.RegisterEnum("pixur.api.Collection_Visibility", Collection_Visibility_name, Collection_Visibility_value)
 	proto.RegisterEnum("pixur.api.PicCommentVote_Vote", PicCommentVote_Vote_name, PicCommentVote_Vote_value)
 	proto.RegisterEnum("pixur.api.PicFile_Format", PicFile_Format_name, PicFile_Format_value)
 	proto.RegisterEnum("pixur.api.PicVote_Vote", PicVote_Vote_name, PicVote_Vote_value)
@@ -2061,172 +3931,324 @@ func init() {
 	proto.RegisterEnum("pixur.api.PwtPayload_Type", PwtPayload_Type_name, PwtPayload_Type_value)
 	proto.RegisterType((*BackendConfiguration)(nil), "pixur.api.BackendConfiguration")
 	proto.RegisterType((*BackendConfiguration_CapabilitySet)(nil), "pixur.api.BackendConfiguration.CapabilitySet")
+	proto.RegisterType((*BackendConfiguration_RoleSet)(nil), "pixur.api.BackendConfiguration.RoleSet")
+	proto.RegisterMapType((map[string]*BackendConfiguration_CapabilitySet)(nil), "pixur.api.BackendConfiguration.RoleSet.RoleEntry")
+	proto.RegisterType((*BackendConfiguration_StringSet)(nil), "pixur.api.BackendConfiguration.StringSet")
+	proto.RegisterType((*BackendConfiguration_RateLimit)(nil), "pixur.api.BackendConfiguration.RateLimit")
+	proto.RegisterType((*BackendConfiguration_RateLimitSet)(nil), "pixur.api.BackendConfiguration.RateLimitSet")
+	proto.RegisterMapType((map[string]*BackendConfiguration_RateLimit)(nil), "pixur.api.BackendConfiguration.RateLimitSet.RateLimitEntry")
+	proto.RegisterType((*BackendConfiguration_PasswordHashPolicy)(nil), "pixur.api.BackendConfiguration.PasswordHashPolicy")
 	proto.RegisterType((*Capability)(nil), "pixur.api.Capability")
+	proto.RegisterType((*ApiKey)(nil), "pixur.api.ApiKey")
+	proto.RegisterType((*AuditLog)(nil), "pixur.api.AuditLog")
+	proto.RegisterType((*AuditLog_Change)(nil), "pixur.api.AuditLog.Change")
+	proto.RegisterType((*Collection)(nil), "pixur.api.Collection")
+	proto.RegisterType((*InviteCode)(nil), "pixur.api.InviteCode")
+	proto.RegisterType((*Session)(nil), "pixur.api.Session")
 	proto.RegisterType((*Pic)(nil), "pixur.api.Pic")
 	proto.RegisterType((*PicAndThumbnail)(nil), "pixur.api.PicAndThumbnail")
 	proto.RegisterType((*PicComment)(nil), "pixur.api.PicComment")
+	proto.RegisterType((*PicComment_Revision)(nil), "pixur.api.PicComment.Revision")
 	proto.RegisterType((*PicCommentTree)(nil), "pixur.api.PicCommentTree")
 	proto.RegisterType((*PicCommentVote)(nil), "pixur.api.PicCommentVote")
 	proto.RegisterType((*PicFile)(nil), "pixur.api.PicFile")
+	proto.RegisterType((*PicPlaceholder)(nil), "pixur.api.PicPlaceholder")
 	proto.RegisterType((*PicSource)(nil), "pixur.api.PicSource")
 	proto.RegisterType((*PicTag)(nil), "pixur.api.PicTag")
 	proto.RegisterType((*PicVote)(nil), "pixur.api.PicVote")
+	proto.RegisterType((*Pool)(nil), "pixur.api.Pool")
 	proto.RegisterType((*PublicUserInfo)(nil), "pixur.api.PublicUserInfo")
 	proto.RegisterType((*PwtHeader)(nil), "pixur.api.PwtHeader")
 	proto.RegisterType((*PwtPayload)(nil), "pixur.api.PwtPayload")
+	proto.RegisterType((*UploadSession)(nil), "pixur.api.UploadSession")
 	proto.RegisterType((*User)(nil), "pixur.api.User")
+	proto.RegisterType((*User_Suspension)(nil), "pixur.api.User.Suspension")
 	proto.RegisterType((*UserEvent)(nil), "pixur.api.UserEvent")
 	proto.RegisterType((*UserEvent_OutgoingUpsertPicVote)(nil), "pixur.api.UserEvent.OutgoingUpsertPicVote")
 	proto.RegisterType((*UserEvent_IncomingUpsertPicVote)(nil), "pixur.api.UserEvent.IncomingUpsertPicVote")
 	proto.RegisterType((*UserEvent_OutgoingPicComment)(nil), "pixur.api.UserEvent.OutgoingPicComment")
 	proto.RegisterType((*UserEvent_IncomingPicComment)(nil), "pixur.api.UserEvent.IncomingPicComment")
 	proto.RegisterType((*UserEvent_UpsertPic)(nil), "pixur.api.UserEvent.UpsertPic")
+	proto.RegisterType((*UserEvent_LoginLockout)(nil), "pixur.api.UserEvent.LoginLockout")
+	proto.RegisterType((*UserEvent_Suspend)(nil), "pixur.api.UserEvent.Suspend")
+	proto.RegisterType((*UserEvent_Unsuspend)(nil), "pixur.api.UserEvent.Unsuspend")
+	proto.RegisterType((*UserEvent_IncomingFollow)(nil), "pixur.api.UserEvent.IncomingFollow")
+	proto.RegisterType((*UserProfile)(nil), "pixur.api.UserProfile")
 }
 
 func init() { proto.RegisterFile("data.proto", fileDescriptor_871986018790d2fd) }
 
 var fileDescriptor_871986018790d2fd = []byte{
-	// 2264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x72, 0xe3, 0x48,
-	0xf5, 0x1f, 0x5b, 0xb2, 0x2d, 0x1f, 0x27, 0x8e, 0xd2, 0x49, 0x26, 0x8e, 0x37, 0x33, 0xff, 0xfc,
-	0x5d, 0xc5, 0x32, 0x0c, 0xac, 0x87, 0x0d, 0x3b, 0x4b, 0x51, 0xcb, 0xd6, 0x8e, 0x93, 0x28, 0x89,
-	0x8c, 0xc7, 0x51, 0xc9, 0x76, 0x66, 0xf8, 0x2a, 0xa1, 0x58, 0x6d, 0xa7, 0x59, 0x59, 0x72, 0x49,
-	0x72, 0xe2, 0x70, 0xc1, 0x1b, 0xf0, 0x12, 0xdc, 0xf1, 0x12, 0xdc, 0x72, 0xb3, 0x57, 0x70, 0x43,
-	0x15, 0xb7, 0xbc, 0x03, 0x97, 0x50, 0xdd, 0xfa, 0x8e, 0x3c, 0x89, 0x33, 0x53, 0x6c, 0x71, 0xe3,
-	0x72, 0x9f, 0x8f, 0x5f, 0x9f, 0x3e, 0xa7, 0xfb, 0xd7, 0xa7, 0x05, 0x60, 0xe8, 0x9e, 0xde, 0x9c,
-	0x3a, 0xb6, 0x67, 0xa3, 0xf2, 0x94, 0xcc, 0x67, 0x4e, 0x53, 0x9f, 0x92, 0xfa, 0xd3, 0xb1, 0x6d,
-	0x8f, 0x4d, 0xfc, 0x82, 0x29, 0x2e, 0x66, 0xa3, 0x17, 0xc6, 0xcc, 0xd1, 0x3d, 0x62, 0x5b, 0xbe,
-	0x69, 0xfd, 0xff, 0x6e, 0xeb, 0x3d, 0x32, 0xc1, 0xae, 0xa7, 0x4f, 0xa6, 0x81, 0x41, 0x06, 0xe0,
-	0xda, 0xd1, 0xa7, 0x53, 0xec, 0xb8, 0xbe, 0xbe, 0xf1, 0xe7, 0x15, 0xd8, 0x3c, 0xd0, 0x87, 0x5f,
-	0x63, 0xcb, 0x38, 0xb4, 0xad, 0x11, 0x19, 0x07, 0xf8, 0x48, 0x06, 0x34, 0x21, 0x96, 0x36, 0xb4,
-	0x27, 0x13, 0x6c, 0x79, 0x9a, 0x89, 0xad, 0xb1, 0x77, 0x59, 0xcb, 0xed, 0xe5, 0x9e, 0x55, 0xf6,
-	0x3f, 0x6a, 0xfa, 0xa8, 0xcd, 0x10, 0xb5, 0x29, 0x5b, 0xde, 0xe7, 0x9f, 0x9d, 0xeb, 0xe6, 0x0c,
-	0xab, 0xe2, 0x84, 0x58, 0x87, 0xbe, 0x57, 0x87, 0x39, 0x31, 0x28, 0x7d, 0x7e, 0x1b, 0x2a, 0xbf,
-	0x0c, 0x94, 0x3e, 0x4f, 0x43, 0x49, 0x40, 0xe1, 0x35, 0x62, 0x24, 0x80, 0xb8, 0xfb, 0x81, 0xaa,
-	0x13, 0x62, 0xc9, 0x46, 0x1a, 0x46, 0x9f, 0xa7, 0x61, 0xf8, 0x65, 0x60, 0xf4, 0x79, 0x12, 0xa6,
-	0x03, 0x9b, 0x34, 0x9a, 0x11, 0x31, 0xb1, 0x66, 0xe9, 0x13, 0x1c, 0x42, 0x15, 0xee, 0x87, 0x5a,
-	0x9f, 0x10, 0xeb, 0x98, 0x98, 0xb8, 0xab, 0x4f, 0x70, 0x02, 0x4d, 0x9f, 0x67, 0xd1, 0x8a, 0xcb,
-	0xa0, 0xe9, 0xf3, 0x5b, 0x68, 0x2d, 0xa0, 0x8b, 0xd6, 0x66, 0x8e, 0x19, 0xe2, 0x94, 0xee, 0xc7,
-	0x59, 0x99, 0x10, 0x6b, 0xe0, 0x98, 0x09, 0x08, 0x7d, 0x9e, 0x84, 0x10, 0x96, 0x81, 0xd0, 0xe7,
-	0x69, 0x08, 0x62, 0x69, 0x9e, 0x3e, 0x0e, 0x21, 0xca, 0xcb, 0x45, 0xd1, 0xd7, 0xc7, 0xe9, 0x28,
-	0x12, 0x10, 0xb0, 0x5c, 0x14, 0x31, 0xc4, 0x6f, 0x60, 0x53, 0xb7, 0x6c, 0xeb, 0x66, 0x62, 0xcf,
-	0x5c, 0x6d, 0xa8, 0x4f, 0xf5, 0x0b, 0x62, 0x12, 0xef, 0xa6, 0x56, 0x61, 0x40, 0x9f, 0x34, 0xa3,
-	0xf3, 0xd6, 0x5c, 0x74, 0x14, 0x9a, 0x87, 0x91, 0x47, 0x0f, 0x7b, 0xea, 0x46, 0x04, 0x15, 0xcb,
-	0xd1, 0xaf, 0x61, 0xc3, 0xc2, 0xd7, 0xda, 0xcc, 0xc5, 0x4e, 0x72, 0x82, 0x95, 0xf7, 0x99, 0x60,
-	0xdd, 0xc2, 0xd7, 0x03, 0x17, 0x3b, 0x09, 0x78, 0x15, 0xb6, 0x0d, 0x3c, 0xd2, 0x67, 0xa6, 0xa7,
-	0x8d, 0x88, 0x65, 0x68, 0xc4, 0x32, 0xf0, 0x5c, 0x9b, 0x92, 0xa1, 0x5b, 0x5b, 0xbd, 0x3f, 0x19,
-	0x9b, 0x81, 0xef, 0x31, 0xb1, 0x0c, 0x99, 0x7a, 0x2a, 0x64, 0xe8, 0xa2, 0x36, 0x6c, 0xf8, 0xdb,
-	0x2d, 0x8d, 0x57, 0x5d, 0xee, 0x58, 0xa6, 0xb1, 0x4e, 0xfc, 0x13, 0x7e, 0x45, 0x0c, 0x6c, 0x6b,
-	0x21, 0x45, 0xd5, 0xd6, 0x18, 0xd4, 0x4e, 0x06, 0xea, 0x28, 0x30, 0x60, 0x40, 0xe7, 0xd4, 0x27,
-	0x94, 0xa0, 0x5f, 0xc1, 0x13, 0x6c, 0xe9, 0x17, 0x26, 0xa6, 0xc1, 0x44, 0x8c, 0xe1, 0x62, 0x73,
-	0xa4, 0x39, 0x78, 0x6a, 0xde, 0xd4, 0x44, 0x86, 0x59, 0xcf, 0x60, 0x1e, 0xd8, 0xb6, 0xe9, 0x47,
-	0xb7, 0xe3, 0x03, 0x28, 0x64, 0x18, 0x50, 0x47, 0x0f, 0x9b, 0x23, 0x95, 0x3a, 0xa3, 0x0b, 0xd8,
-	0x5b, 0x84, 0x4e, 0x2e, 0x4c, 0x62, 0x8d, 0x83, 0x09, 0xd6, 0xef, 0x9d, 0x60, 0x37, 0x33, 0x81,
-	0x0f, 0xe0, 0xcf, 0xd1, 0x87, 0x5a, 0xaa, 0x54, 0x6c, 0x4b, 0xe0, 0x2b, 0x6c, 0x79, 0x6e, 0x0d,
-	0xdd, 0x9f, 0xdb, 0xad, 0x44, 0xad, 0xe8, 0x26, 0x90, 0x98, 0x67, 0xcc, 0x0d, 0xb7, 0x10, 0x37,
-	0x96, 0xe5, 0x86, 0x24, 0x5a, 0xbd, 0x0d, 0xab, 0xa9, 0x2d, 0x87, 0x7e, 0x02, 0x90, 0xd8, 0xb5,
-	0xb9, 0x3d, 0xee, 0x59, 0x75, 0x7f, 0x27, 0xb1, 0x6b, 0x63, 0x6b, 0xfa, 0x57, 0x4d, 0x18, 0x37,
-	0xfe, 0x51, 0x00, 0x88, 0xd5, 0x8d, 0x6f, 0x0a, 0xc0, 0x1d, 0xea, 0x53, 0x54, 0x81, 0xd2, 0xa0,
-	0xfb, 0xb3, 0xee, 0xd9, 0x9b, 0xae, 0xf8, 0x08, 0x55, 0x01, 0x14, 0xf9, 0x50, 0x3b, 0x54, 0xa5,
-	0x56, 0x5f, 0x12, 0x73, 0x68, 0x05, 0x04, 0x3a, 0x56, 0xa5, 0xd6, 0x91, 0x98, 0x47, 0xab, 0x50,
-	0xa6, 0x23, 0xb9, 0x7b, 0x24, 0xbd, 0x15, 0x39, 0xb4, 0x01, 0x6b, 0x74, 0xd8, 0x3b, 0x3b, 0xee,
-	0x6b, 0x47, 0x52, 0x47, 0xea, 0x4b, 0x62, 0x21, 0x14, 0x9e, 0xb6, 0xd4, 0xa3, 0x50, 0x58, 0x0c,
-	0x1d, 0x95, 0x81, 0x7a, 0x22, 0x89, 0x25, 0xf4, 0x11, 0x6c, 0xd3, 0xe1, 0x40, 0x39, 0x6a, 0xf5,
-	0x25, 0xed, 0x5c, 0x96, 0xde, 0x68, 0x87, 0x67, 0x83, 0x6e, 0x5f, 0x52, 0x45, 0x01, 0x21, 0xa8,
-	0x52, 0x65, 0xbf, 0x75, 0x12, 0x86, 0x51, 0x46, 0x8f, 0x01, 0xb1, 0xb0, 0xce, 0x5e, 0xbf, 0x96,
-	0xba, 0xfd, 0x50, 0x0e, 0xe1, 0x64, 0xe7, 0x67, 0x7d, 0x29, 0x14, 0x56, 0xd0, 0x1a, 0x54, 0x06,
-	0x3d, 0x49, 0x0d, 0x05, 0x3c, 0xaa, 0xc3, 0x63, 0x26, 0x08, 0xe6, 0x3b, 0x6c, 0x29, 0xad, 0x03,
-	0xb9, 0x23, 0xf7, 0x7f, 0x2e, 0xae, 0xd0, 0xd9, 0x98, 0x8e, 0xae, 0x50, 0xeb, 0x49, 0x9d, 0x63,
-	0x71, 0x15, 0xad, 0xc3, 0x6a, 0x2c, 0x6b, 0x75, 0x3a, 0x62, 0x15, 0xd5, 0x60, 0x93, 0x4e, 0x24,
-	0xbd, 0xed, 0x4b, 0xdd, 0x9e, 0x7c, 0xd6, 0x0d, 0xc1, 0xd7, 0xc2, 0xd0, 0x62, 0x0d, 0xcb, 0x95,
-	0x88, 0xf6, 0x60, 0x37, 0x19, 0x72, 0xc6, 0x73, 0x1d, 0x3d, 0x85, 0xfa, 0x62, 0x0b, 0x86, 0x80,
-	0xd0, 0x2e, 0xd4, 0xc2, 0x44, 0x64, 0xbc, 0x37, 0xe8, 0xa2, 0xb2, 0x5a, 0xe6, 0xb9, 0x89, 0x9e,
-	0xc0, 0x4e, 0x94, 0x96, 0x8c, 0xeb, 0x56, 0x98, 0xfe, 0x5b, 0x6a, 0xe6, 0xfb, 0x18, 0x6d, 0x82,
-	0x18, 0x2f, 0x5e, 0x19, 0x1c, 0x74, 0xe4, 0x43, 0x71, 0x3b, 0x9d, 0x26, 0x45, 0x3e, 0xec, 0x89,
-	0x35, 0xb4, 0x05, 0xeb, 0x29, 0x19, 0x8d, 0x45, 0xdc, 0x41, 0x3b, 0xb0, 0x95, 0x16, 0x07, 0x0b,
-	0x14, 0xeb, 0x34, 0x57, 0x69, 0x15, 0x0d, 0x41, 0xfc, 0x28, 0x0c, 0x28, 0xcc, 0x44, 0xb2, 0x9c,
-	0xbb, 0xe8, 0x3b, 0xf0, 0xff, 0x19, 0x65, 0x66, 0x51, 0x4f, 0x1a, 0xff, 0xe2, 0x80, 0x53, 0xc8,
-	0x10, 0x55, 0x21, 0x4f, 0x0c, 0xd6, 0xfd, 0x94, 0xd5, 0x3c, 0x31, 0x50, 0x0d, 0x4a, 0x57, 0xd8,
-	0x71, 0x29, 0xcb, 0xd1, 0xbe, 0x41, 0x54, 0xc3, 0x21, 0xfa, 0x12, 0x56, 0x86, 0x0e, 0xd6, 0x3d,
-	0x6c, 0x68, 0xb4, 0x17, 0x0b, 0xf8, 0x34, 0xcb, 0x27, 0xfd, 0xb0, 0x51, 0x53, 0x2b, 0x81, 0x3d,
-	0x95, 0xa0, 0xaf, 0x60, 0x75, 0x62, 0x1b, 0x64, 0x44, 0x42, 0xff, 0xb5, 0x7b, 0xfd, 0x57, 0x42,
-	0x07, 0x06, 0xf0, 0x3d, 0x10, 0xa7, 0xd8, 0x32, 0x28, 0xa1, 0x19, 0xd8, 0xc4, 0x8c, 0x88, 0xe9,
-	0x9d, 0x2b, 0xa8, 0x6b, 0x81, 0xfc, 0x28, 0x10, 0xa3, 0x27, 0x00, 0x57, 0x04, 0x5f, 0x6b, 0x43,
-	0x7b, 0x66, 0x79, 0xec, 0x56, 0xe5, 0xd4, 0x32, 0x95, 0x1c, 0x52, 0x01, 0xda, 0x01, 0xc1, 0x1d,
-	0xda, 0x0e, 0xd6, 0x4c, 0x9b, 0x5d, 0x64, 0x39, 0xb5, 0xc4, 0xc6, 0x1d, 0x3b, 0x56, 0x5d, 0x12,
-	0x76, 0x01, 0x85, 0xaa, 0x53, 0x82, 0x3e, 0x06, 0x9e, 0x76, 0x30, 0x01, 0x51, 0xa3, 0x04, 0x89,
-	0x28, 0x64, 0x48, 0x7b, 0x14, 0x95, 0xe9, 0xd1, 0x0f, 0xa0, 0xe8, 0xda, 0x33, 0x67, 0x88, 0x6b,
-	0x68, 0x8f, 0x7b, 0x56, 0xd9, 0xdf, 0x4c, 0x5b, 0xf6, 0x98, 0x4e, 0x0d, 0x6c, 0xd0, 0x2b, 0x58,
-	0x1d, 0x11, 0xc7, 0xf5, 0x7c, 0xf2, 0x23, 0x46, 0x40, 0x7c, 0xbb, 0x99, 0xb4, 0xf4, 0x3c, 0x87,
-	0x58, 0x63, 0x9f, 0xf9, 0x2a, 0xcc, 0x85, 0xf2, 0x9e, 0x6c, 0xb4, 0x79, 0x21, 0x2f, 0x72, 0x6d,
-	0x5e, 0xe0, 0x44, 0xbe, 0xcd, 0x0b, 0x05, 0xb1, 0xd8, 0xe6, 0x85, 0xa2, 0x58, 0x6a, 0xf3, 0x42,
-	0x49, 0x14, 0xda, 0xbc, 0x20, 0x88, 0xe5, 0x36, 0x2f, 0x54, 0xc4, 0x95, 0x36, 0x2f, 0xac, 0x8b,
-	0xa8, 0x81, 0x61, 0x4d, 0x21, 0xc3, 0x96, 0x65, 0xf4, 0x2f, 0x67, 0x93, 0x0b, 0x4b, 0x27, 0x26,
-	0xda, 0x03, 0x6e, 0x4a, 0x86, 0x41, 0x0f, 0x5c, 0x4d, 0xc7, 0xab, 0x52, 0x15, 0xfa, 0x21, 0x94,
-	0xbd, 0xd0, 0xbc, 0x96, 0x67, 0xeb, 0x5a, 0x94, 0x81, 0xd8, 0xa8, 0xf1, 0xb7, 0x3c, 0x40, 0x7c,
-	0x93, 0xa0, 0x2d, 0x28, 0xd2, 0xab, 0x29, 0xda, 0x6b, 0x85, 0x29, 0x19, 0xca, 0x06, 0xad, 0x54,
-	0x78, 0x5b, 0x11, 0x83, 0x75, 0xce, 0x65, 0xb5, 0x1c, 0x48, 0x64, 0x03, 0x3d, 0x87, 0xf5, 0x50,
-	0x3d, 0xd5, 0x9d, 0xc0, 0x8a, 0x63, 0x56, 0x6b, 0x81, 0x42, 0x61, 0x72, 0xd9, 0x40, 0x08, 0x78,
-	0x0f, 0xcf, 0x3d, 0xd6, 0x0d, 0x96, 0x55, 0xf6, 0x3f, 0xb3, 0x67, 0xf9, 0x0f, 0xdc, 0xb3, 0x85,
-	0x07, 0xee, 0xd9, 0xc4, 0x69, 0x2a, 0xa6, 0x4f, 0xd3, 0x4b, 0x28, 0x85, 0x15, 0x17, 0x96, 0xa8,
-	0x78, 0x71, 0xc6, 0x8a, 0xdd, 0x68, 0x41, 0x35, 0x4e, 0x6a, 0xdf, 0xc1, 0x18, 0xbd, 0x80, 0x52,
-	0x90, 0x09, 0x76, 0xbd, 0x55, 0xf6, 0xb7, 0xd2, 0x75, 0x09, 0x6c, 0xd5, 0xd0, 0xaa, 0xf1, 0xef,
-	0x7c, 0x12, 0xe3, 0xdc, 0xf6, 0xf0, 0x7b, 0x16, 0x27, 0xb1, 0x04, 0x6e, 0xf9, 0x25, 0xa0, 0x7d,
-	0xe0, 0xaf, 0x6c, 0xcf, 0xaf, 0x45, 0x75, 0xff, 0xe9, 0xc2, 0x68, 0x69, 0x54, 0x4d, 0xfa, 0xa3,
-	0x32, 0xdb, 0x64, 0x1e, 0x0b, 0x77, 0xb3, 0x52, 0xf1, 0x03, 0x2b, 0x5c, 0x7a, 0x58, 0x85, 0x1b,
-	0xfb, 0xc0, 0xb3, 0x14, 0xa6, 0xda, 0x82, 0x22, 0xe4, 0x07, 0x8a, 0x98, 0x43, 0x02, 0xf0, 0x47,
-	0x54, 0x92, 0xa7, 0xea, 0xae, 0x34, 0xe8, 0xab, 0xad, 0x8e, 0xc8, 0x35, 0xfe, 0xc4, 0x41, 0x29,
-	0x38, 0x31, 0x19, 0xfe, 0xfd, 0x14, 0x8a, 0x23, 0xdb, 0x99, 0xe8, 0x1e, 0xcb, 0x77, 0xba, 0x59,
-	0x09, 0x7c, 0x9a, 0xc7, 0xcc, 0x40, 0x0d, 0x0c, 0xd1, 0x26, 0x14, 0xae, 0x89, 0x11, 0xbc, 0x17,
-	0x0b, 0xaa, 0x3f, 0x40, 0x8f, 0xa1, 0x78, 0x89, 0xc9, 0xf8, 0xd2, 0x63, 0x89, 0x2e, 0xa8, 0xc1,
-	0x08, 0xbd, 0x04, 0x21, 0xea, 0x63, 0x0b, 0xf7, 0xf5, 0xb1, 0x91, 0x29, 0xda, 0x4d, 0x12, 0x40,
-	0x91, 0xd1, 0x6e, 0x2c, 0xc8, 0x54, 0xa1, 0xf4, 0x81, 0x55, 0x10, 0x1e, 0x78, 0xce, 0x10, 0xf0,
-	0x2e, 0xf9, 0x1d, 0x66, 0xf7, 0x01, 0xa7, 0xb2, 0xff, 0x8d, 0x23, 0x28, 0xfa, 0x89, 0x4a, 0xd7,
-	0x46, 0x00, 0xbe, 0xad, 0x48, 0x27, 0x62, 0x0e, 0x95, 0x80, 0x3b, 0x91, 0x8f, 0xc5, 0x3c, 0xfd,
-	0xa3, 0x74, 0x4f, 0x44, 0x8e, 0xea, 0xde, 0x48, 0x07, 0xaf, 0x45, 0x9e, 0x8a, 0x5e, 0x2b, 0x9f,
-	0x89, 0x85, 0xc6, 0x6b, 0x28, 0x47, 0xa4, 0x8d, 0x44, 0xe0, 0x66, 0x8e, 0x19, 0x54, 0x8b, 0xfe,
-	0x45, 0x75, 0x10, 0x1c, 0x3c, 0xc2, 0x8e, 0x83, 0x9d, 0x80, 0x97, 0xa2, 0x31, 0x0d, 0x8a, 0xbe,
-	0x76, 0x83, 0x83, 0xc3, 0xfe, 0x37, 0xfe, 0x99, 0x83, 0xa2, 0x42, 0x86, 0x7d, 0x7d, 0xfc, 0xae,
-	0x43, 0xb7, 0x05, 0x45, 0xfa, 0x22, 0x8c, 0x0e, 0x5c, 0xc1, 0xd3, 0xc7, 0x3e, 0xbb, 0x31, 0x30,
-	0x2e, 0x06, 0xfb, 0xdf, 0x65, 0xb7, 0xc6, 0x5f, 0xf3, 0x6c, 0x87, 0xdf, 0x45, 0x2e, 0x09, 0xf6,
-	0x28, 0x3d, 0x80, 0x3d, 0xbe, 0x1f, 0xb0, 0x07, 0xc7, 0x4e, 0xc7, 0x76, 0xfa, 0x74, 0xdc, 0x41,
-	0x1b, 0xf7, 0x34, 0x33, 0x85, 0x0f, 0x4c, 0x5d, 0xf1, 0x5b, 0xa0, 0x8d, 0xdf, 0x43, 0x55, 0x99,
-	0x5d, 0x98, 0x64, 0xc8, 0x2e, 0x7e, 0x6b, 0x64, 0xa3, 0xed, 0x38, 0x87, 0x7e, 0x6e, 0xc3, 0x2c,
-	0x6d, 0x42, 0x81, 0x7d, 0x02, 0x0a, 0xf7, 0x10, 0x1b, 0x64, 0x16, 0xcd, 0x3d, 0x68, 0xd1, 0x8d,
-	0x3f, 0xe6, 0xa0, 0xac, 0x5c, 0x7b, 0xa7, 0x58, 0x37, 0xb0, 0x83, 0x7e, 0x0a, 0x65, 0xdd, 0x1c,
-	0xdb, 0x0e, 0xf1, 0x2e, 0x27, 0x6c, 0xf6, 0x5b, 0x5c, 0x1e, 0x1a, 0x36, 0x5b, 0xa1, 0x95, 0x1a,
-	0x3b, 0x24, 0x2b, 0x93, 0x67, 0x67, 0x36, 0xda, 0x3a, 0x5f, 0x42, 0x39, 0xf2, 0x48, 0xa7, 0xa7,
-	0x0c, 0x85, 0xd3, 0xde, 0xfe, 0xcb, 0xcf, 0xc5, 0x1c, 0xfd, 0xab, 0xb2, 0xbf, 0xec, 0x91, 0x75,
-	0xda, 0x7b, 0xf9, 0xe9, 0xbe, 0x46, 0x87, 0x5c, 0xe3, 0x0f, 0x1c, 0x80, 0x72, 0xed, 0x29, 0xfa,
-	0x8d, 0x69, 0xeb, 0xac, 0x9d, 0x75, 0x67, 0x17, 0xbf, 0xc5, 0x43, 0x2f, 0xc8, 0x50, 0x38, 0xa4,
-	0x2f, 0x43, 0xcb, 0xf6, 0xb4, 0x0b, 0x3c, 0xb2, 0x1d, 0x1c, 0x7c, 0xb3, 0xbb, 0x2b, 0x15, 0x65,
-	0xcb, 0xf6, 0x0e, 0x98, 0x31, 0xfa, 0x31, 0xd0, 0x81, 0xa6, 0x8f, 0xbc, 0xe0, 0xd4, 0xdf, 0xed,
-	0x29, 0x58, 0xb6, 0xd7, 0xa2, 0xb6, 0xe8, 0x15, 0x54, 0x5d, 0x7b, 0xe4, 0x69, 0xb1, 0xf7, 0x12,
-	0xfb, 0x86, 0x7a, 0x74, 0x43, 0x84, 0xc7, 0x50, 0x24, 0xae, 0x3b, 0xc3, 0x0e, 0xdb, 0xd0, 0x65,
-	0x35, 0x18, 0xd1, 0xbe, 0xd5, 0xb3, 0xbf, 0xc6, 0x16, 0xdd, 0x0a, 0x05, 0x3f, 0xa1, 0x6c, 0x2c,
-	0x1b, 0xa8, 0x09, 0xbc, 0x77, 0x33, 0xf5, 0x39, 0xb9, 0xba, 0x5f, 0x4f, 0xd7, 0x28, 0xc8, 0x53,
-	0xb3, 0x7f, 0x33, 0xc5, 0x2a, 0xb3, 0x6b, 0xbc, 0x04, 0x9e, 0x8e, 0x32, 0xac, 0xd9, 0x1a, 0xf4,
-	0x4f, 0x03, 0xb2, 0x94, 0xdf, 0x8a, 0x5c, 0x83, 0x17, 0x72, 0x62, 0xee, 0x79, 0x49, 0x95, 0x8e,
-	0x55, 0xa9, 0x77, 0xea, 0x37, 0x9a, 0xea, 0x9a, 0x1f, 0x45, 0xd4, 0xac, 0x35, 0xbe, 0xc9, 0x03,
-	0x4f, 0xf7, 0xeb, 0xb7, 0xbb, 0x57, 0xb3, 0x07, 0x94, 0x7f, 0x20, 0xb7, 0xbd, 0x82, 0xaa, 0xa9,
-	0xbb, 0x9e, 0xe6, 0x62, 0x6c, 0x2d, 0xcd, 0x8e, 0xd4, 0xa3, 0x87, 0xb1, 0x75, 0x4f, 0xef, 0x97,
-	0xfe, 0x28, 0x51, 0x7a, 0xc8, 0x47, 0x89, 0xbf, 0x94, 0xa0, 0x1c, 0x7d, 0xef, 0x78, 0x77, 0x4e,
-	0x1b, 0xb0, 0x1a, 0x7f, 0x4c, 0x89, 0xef, 0x92, 0xca, 0x2c, 0x74, 0x95, 0x8d, 0x0f, 0xcd, 0x30,
-	0x86, 0x9a, 0x3d, 0xf3, 0xc6, 0x36, 0x7d, 0x8f, 0xcd, 0xa6, 0x2e, 0x76, 0x3c, 0xf6, 0xed, 0x29,
-	0x6a, 0xed, 0x2a, 0xfb, 0xcf, 0x13, 0x4b, 0x8a, 0x62, 0x6e, 0x9e, 0x05, 0x4e, 0x03, 0xe6, 0x13,
-	0x90, 0xf6, 0xe9, 0x23, 0x75, 0xcb, 0x5e, 0xa4, 0xa0, 0xd3, 0x10, 0x6b, 0x68, 0x4f, 0x16, 0x4d,
-	0x53, 0xb8, 0x63, 0x1a, 0x39, 0x70, 0xca, 0x4c, 0x43, 0x16, 0x29, 0xd0, 0x2f, 0x61, 0x33, 0x5a,
-	0x4d, 0xe2, 0x13, 0x5a, 0x70, 0x3e, 0xbf, 0x7b, 0xe7, 0x4a, 0xe2, 0xb6, 0xf5, 0xf4, 0x91, 0x8a,
-	0xec, 0x8c, 0x94, 0x82, 0x47, 0x6b, 0x48, 0x82, 0x97, 0xee, 0x00, 0x0f, 0xe3, 0x4f, 0x83, 0x93,
-	0x8c, 0x14, 0x7d, 0x05, 0x10, 0xe7, 0x25, 0x68, 0x9c, 0x9e, 0x2e, 0x84, 0x8c, 0x56, 0x7c, 0xfa,
-	0x48, 0x2d, 0xcf, 0xc2, 0x41, 0xbd, 0x09, 0x5b, 0x0b, 0x6b, 0xf2, 0x8e, 0x8b, 0xbb, 0x7e, 0x0e,
-	0x5b, 0x0b, 0x93, 0xfb, 0xae, 0x8b, 0xfe, 0x63, 0x58, 0x0b, 0x38, 0x37, 0x7a, 0xe3, 0xfa, 0xbb,
-	0x71, 0x35, 0x10, 0xfb, 0xef, 0xd8, 0x7a, 0x1b, 0x50, 0x36, 0xa3, 0xef, 0xf7, 0x34, 0xa9, 0x5f,
-	0x01, 0xca, 0x26, 0xf0, 0xbf, 0xff, 0x06, 0xad, 0x37, 0xa0, 0x1c, 0xe5, 0xe4, 0x1d, 0xd3, 0x1d,
-	0x14, 0x80, 0xc3, 0x57, 0xde, 0xf3, 0x2f, 0xa0, 0x1a, 0x7e, 0xaf, 0x50, 0xb1, 0xee, 0xda, 0x56,
-	0x86, 0x70, 0xbb, 0x67, 0x5d, 0x49, 0xcc, 0x21, 0x04, 0x55, 0x75, 0xd0, 0x91, 0xb4, 0x73, 0xf9,
-	0xac, 0xd3, 0xea, 0xcb, 0x67, 0x5d, 0x31, 0x7f, 0xf0, 0x09, 0xac, 0xda, 0xce, 0x38, 0xae, 0xb2,
-	0x92, 0xfb, 0xc5, 0xb6, 0x3f, 0xb0, 0x9d, 0xf1, 0x0b, 0xf6, 0xef, 0x85, 0x3e, 0x25, 0x5f, 0xe8,
-	0x53, 0xf2, 0xf7, 0x5c, 0xee, 0xa2, 0xc8, 0x0e, 0xf3, 0x8f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0xc9, 0xe8, 0x69, 0x61, 0x8c, 0x1b, 0x00, 0x00,
+	// 4332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0x23, 0x49,
+	0x56, 0x6f, 0x7d, 0x4b, 0x4f, 0xb2, 0x5c, 0xce, 0xf6, 0x87, 0xac, 0xfe, 0x18, 0xb7, 0x7a, 0xba,
+	0x67, 0xba, 0xd9, 0x75, 0xef, 0x98, 0xed, 0x65, 0x76, 0x7a, 0x60, 0x46, 0x2d, 0xcb, 0x6d, 0xb9,
+	0xd5, 0x92, 0xa2, 0x24, 0xb9, 0x7b, 0x07, 0x88, 0xa2, 0xac, 0x4a, 0xc9, 0x49, 0x97, 0xaa, 0x44,
+	0x55, 0xc9, 0x1f, 0x7b, 0x85, 0x2b, 0x11, 0x9c, 0x38, 0x72, 0xe0, 0xc6, 0x8d, 0xe0, 0xc4, 0x81,
+	0x03, 0x7f, 0x00, 0x11, 0x44, 0xc0, 0x01, 0x62, 0xb9, 0x72, 0xe2, 0xc0, 0x8d, 0x2b, 0x4b, 0xe4,
+	0x57, 0x7d, 0x58, 0x6e, 0x4b, 0x6e, 0xc7, 0x0c, 0x7b, 0xb1, 0x95, 0x2f, 0xdf, 0xfb, 0x65, 0xe6,
+	0xcb, 0xcc, 0xdf, 0xcb, 0x97, 0x59, 0x00, 0x86, 0xee, 0xe9, 0xdb, 0x13, 0xc7, 0xf6, 0x6c, 0x94,
+	0x9b, 0x90, 0xb3, 0xa9, 0xb3, 0xad, 0x4f, 0x48, 0xf9, 0xfe, 0xc8, 0xb6, 0x47, 0x26, 0x7e, 0xc6,
+	0x2a, 0x8e, 0xa6, 0xc3, 0x67, 0xc6, 0xd4, 0xd1, 0x3d, 0x62, 0x5b, 0x5c, 0xb5, 0xfc, 0xc9, 0xc5,
+	0x7a, 0x8f, 0x8c, 0xb1, 0xeb, 0xe9, 0xe3, 0x89, 0x50, 0x98, 0x01, 0x38, 0x75, 0xf4, 0xc9, 0x04,
+	0x3b, 0x2e, 0xaf, 0xaf, 0xfc, 0xef, 0x16, 0xac, 0xbe, 0xd4, 0x07, 0xef, 0xb1, 0x65, 0xd4, 0x6c,
+	0x6b, 0x48, 0x46, 0x02, 0x1f, 0x35, 0x00, 0x8d, 0x89, 0xa5, 0x0d, 0xec, 0xf1, 0x18, 0x5b, 0x9e,
+	0x66, 0x62, 0x6b, 0xe4, 0x1d, 0x97, 0x62, 0x5b, 0xb1, 0xcf, 0xf3, 0x3b, 0x77, 0xb6, 0x39, 0xea,
+	0xb6, 0x44, 0xdd, 0x6e, 0x58, 0xde, 0xcf, 0x7e, 0x7a, 0xa8, 0x9b, 0x53, 0xac, 0x2a, 0x63, 0x62,
+	0xd5, 0xb8, 0x55, 0x93, 0x19, 0x31, 0x28, 0xfd, 0xec, 0x22, 0x54, 0x7c, 0x11, 0x28, 0xfd, 0x2c,
+	0x0a, 0x55, 0x07, 0x0a, 0xaf, 0x11, 0x23, 0x04, 0x94, 0x98, 0x0f, 0x54, 0x1c, 0x13, 0xab, 0x61,
+	0x44, 0x61, 0xf4, 0xb3, 0x28, 0x4c, 0x72, 0x11, 0x18, 0xfd, 0x2c, 0x0c, 0xd3, 0x84, 0x55, 0xda,
+	0x9b, 0x21, 0x31, 0xb1, 0x66, 0xe9, 0x63, 0x2c, 0xa1, 0x52, 0xf3, 0xa1, 0x56, 0xc6, 0xc4, 0xda,
+	0x23, 0x26, 0x6e, 0xe9, 0x63, 0x1c, 0x42, 0xd3, 0xcf, 0x66, 0xd1, 0xd2, 0x8b, 0xa0, 0xe9, 0x67,
+	0x17, 0xd0, 0xaa, 0x40, 0x07, 0xad, 0x4d, 0x1d, 0x53, 0xe2, 0x64, 0xe6, 0xe3, 0x14, 0xc6, 0xc4,
+	0xea, 0x3b, 0x66, 0x08, 0x42, 0x3f, 0x0b, 0x43, 0x64, 0x17, 0x81, 0xd0, 0xcf, 0xa2, 0x10, 0xc4,
+	0xd2, 0x3c, 0x7d, 0x24, 0x21, 0x72, 0x8b, 0xf5, 0xa2, 0xa7, 0x8f, 0xa2, 0xbd, 0x08, 0x41, 0xc0,
+	0x62, 0xbd, 0x08, 0x20, 0xfe, 0x08, 0x56, 0x75, 0xcb, 0xb6, 0xce, 0xc7, 0xf6, 0xd4, 0xd5, 0x06,
+	0xfa, 0x44, 0x3f, 0x22, 0x26, 0xf1, 0xce, 0x4b, 0x79, 0x06, 0xf4, 0xe3, 0x6d, 0x7f, 0xbf, 0x6d,
+	0x5f, 0xb6, 0x15, 0xb6, 0x6b, 0xbe, 0x45, 0x17, 0x7b, 0xea, 0x6d, 0x1f, 0x2a, 0x90, 0xa3, 0x3f,
+	0x84, 0xdb, 0x16, 0x3e, 0xd5, 0xa6, 0x2e, 0x76, 0xc2, 0x0d, 0x14, 0x3e, 0xa6, 0x81, 0x15, 0x0b,
+	0x9f, 0xf6, 0x5d, 0xec, 0x84, 0xe0, 0x55, 0xd8, 0x30, 0xf0, 0x50, 0x9f, 0x9a, 0x9e, 0x36, 0x24,
+	0x96, 0xa1, 0x11, 0xcb, 0xc0, 0x67, 0xda, 0x84, 0x0c, 0xdc, 0xd2, 0xd2, 0x7c, 0x67, 0xac, 0x0a,
+	0xdb, 0x3d, 0x62, 0x19, 0x0d, 0x6a, 0xd9, 0x21, 0x03, 0x17, 0x1d, 0xc0, 0x6d, 0xbe, 0xdc, 0xa2,
+	0x78, 0xc5, 0xc5, 0xb6, 0x65, 0x14, 0xeb, 0x15, 0xdf, 0xe1, 0x27, 0xc4, 0xc0, 0xb6, 0x26, 0x29,
+	0xaa, 0xb4, 0xcc, 0xa0, 0x36, 0x67, 0xa0, 0x76, 0x85, 0x02, 0x03, 0x3a, 0xa4, 0x36, 0x52, 0x82,
+	0xfe, 0x00, 0xee, 0x61, 0x4b, 0x3f, 0x32, 0x31, 0xed, 0x8c, 0xcf, 0x18, 0x2e, 0x36, 0x87, 0x9a,
+	0x83, 0x27, 0xe6, 0x79, 0x49, 0x61, 0x98, 0xe5, 0x19, 0xcc, 0x97, 0xb6, 0x6d, 0xf2, 0xde, 0x6d,
+	0x72, 0x80, 0x0e, 0x19, 0x08, 0xea, 0xe8, 0x62, 0x73, 0xa8, 0x52, 0x63, 0x74, 0x04, 0x5b, 0x97,
+	0xa1, 0x93, 0x23, 0x93, 0x58, 0x23, 0xd1, 0xc0, 0xca, 0xdc, 0x06, 0xee, 0xce, 0x34, 0xc0, 0x01,
+	0x78, 0x1b, 0x3d, 0x28, 0x45, 0xa6, 0x8a, 0x2d, 0x09, 0x7c, 0x82, 0x2d, 0xcf, 0x2d, 0xa1, 0xf9,
+	0xbe, 0x5d, 0x0b, 0xcd, 0x15, 0x5d, 0x04, 0x75, 0x66, 0x19, 0x70, 0xc3, 0x05, 0xc4, 0xdb, 0x8b,
+	0x72, 0x43, 0x04, 0xed, 0x0d, 0xac, 0x4d, 0x27, 0xa6, 0xad, 0x1b, 0x9a, 0x8b, 0x5d, 0x97, 0xd8,
+	0x96, 0x86, 0xcf, 0x26, 0xc4, 0x39, 0x2f, 0xad, 0xce, 0x9b, 0xb1, 0xdb, 0xdc, 0xae, 0xcb, 0xcd,
+	0xea, 0xcc, 0x0a, 0xbd, 0x83, 0x32, 0xed, 0x9c, 0x83, 0xc7, 0xb6, 0x87, 0xb5, 0x21, 0xf6, 0x06,
+	0xc7, 0x9a, 0x83, 0x0d, 0xe2, 0xe0, 0x81, 0xe7, 0x96, 0xd6, 0xe6, 0x77, 0x71, 0x63, 0xac, 0x9f,
+	0xa9, 0xcc, 0x7a, 0x8f, 0x1a, 0xab, 0xd2, 0x16, 0xb5, 0x60, 0x6d, 0x06, 0xd9, 0x25, 0xbf, 0xc4,
+	0xa5, 0xf5, 0xf9, 0xa0, 0x28, 0x0a, 0xda, 0x25, 0xbf, 0xc4, 0xe8, 0x35, 0xac, 0x46, 0xb0, 0x68,
+	0xb4, 0xb4, 0xa7, 0x5e, 0x69, 0x63, 0xde, 0xb8, 0x91, 0x13, 0x20, 0xf5, 0xb8, 0x11, 0x32, 0x60,
+	0x33, 0x02, 0x36, 0xb0, 0x2d, 0x8f, 0xae, 0x27, 0xef, 0x7c, 0x82, 0x4b, 0x25, 0x86, 0xf8, 0x64,
+	0xde, 0xce, 0xef, 0x7a, 0x0e, 0xb1, 0x46, 0x74, 0xd7, 0xaf, 0x87, 0x5a, 0xa8, 0x71, 0xa4, 0xde,
+	0xf9, 0x04, 0xd3, 0xb9, 0x9a, 0xe8, 0xae, 0x7b, 0x6a, 0x3b, 0x86, 0xe6, 0x60, 0x17, 0x7b, 0x72,
+	0xae, 0x36, 0xe7, 0xce, 0x95, 0xb4, 0x53, 0xa9, 0x99, 0x98, 0xab, 0x11, 0x94, 0x3c, 0xdb, 0x9b,
+	0x68, 0x0e, 0xfe, 0x93, 0x29, 0x71, 0xb0, 0x11, 0x66, 0xab, 0xf2, 0xc7, 0xb0, 0xd5, 0x3a, 0x85,
+	0x53, 0x05, 0x5a, 0x88, 0xb2, 0x5e, 0x40, 0xd2, 0xb1, 0x4d, 0x5c, 0xba, 0xc3, 0x40, 0x3f, 0x9b,
+	0x07, 0xaa, 0xda, 0x26, 0xa6, 0x70, 0xcc, 0x08, 0xbd, 0x06, 0x70, 0x74, 0x0f, 0x6b, 0x26, 0x19,
+	0x13, 0xaf, 0x74, 0x97, 0x41, 0xfc, 0x68, 0x2e, 0x84, 0xee, 0xe1, 0x26, 0x35, 0xa0, 0x38, 0x39,
+	0x47, 0x96, 0x90, 0x01, 0xab, 0xbe, 0x07, 0x8f, 0x75, 0xf7, 0x58, 0x9b, 0xd8, 0x26, 0x19, 0x9c,
+	0x97, 0xee, 0x31, 0xd8, 0x9d, 0x79, 0xb0, 0x1d, 0x61, 0xbb, 0xaf, 0xbb, 0xc7, 0x1d, 0x66, 0xa9,
+	0xa2, 0xc9, 0x8c, 0x6c, 0x86, 0xa2, 0xf5, 0xa9, 0x41, 0x3c, 0xcd, 0xb4, 0x47, 0x6e, 0xe9, 0xfe,
+	0xf5, 0x28, 0xba, 0x4a, 0x2d, 0x9b, 0xf6, 0x28, 0x4a, 0xd1, 0x21, 0xbc, 0x4f, 0x16, 0xa7, 0xe8,
+	0x00, 0xab, 0x03, 0x1b, 0x61, 0xd2, 0xc3, 0x14, 0xed, 0x94, 0x58, 0x86, 0x7d, 0x5a, 0xda, 0x9a,
+	0xb7, 0x92, 0x56, 0x27, 0x3e, 0xd7, 0xd5, 0x0d, 0xe2, 0xbd, 0x65, 0x66, 0x68, 0x17, 0x96, 0xf9,
+	0xb1, 0xce, 0x34, 0xf1, 0x80, 0xea, 0xb9, 0xa5, 0x07, 0x8b, 0x9d, 0xa1, 0x6a, 0x81, 0x09, 0x7a,
+	0xcd, 0xc7, 0x18, 0xa0, 0xf0, 0x30, 0x54, 0x59, 0x8c, 0xd8, 0x02, 0x24, 0x16, 0x87, 0x04, 0x13,
+	0x85, 0xc0, 0xc2, 0x07, 0xa9, 0x87, 0x8b, 0x31, 0x51, 0x80, 0x19, 0x3a, 0x4e, 0x7d, 0x03, 0x4b,
+	0x14, 0x79, 0x62, 0xdb, 0x26, 0xef, 0xe0, 0xa7, 0xf3, 0xc1, 0xf2, 0x63, 0xfd, 0xac, 0x63, 0xdb,
+	0x26, 0xeb, 0x9a, 0xa0, 0x32, 0x06, 0xe0, 0x11, 0xcf, 0xf4, 0x7b, 0xf5, 0x68, 0x31, 0x2a, 0xa3,
+	0x40, 0x3d, 0x6a, 0x27, 0x3a, 0xf4, 0x1d, 0xdc, 0xf1, 0xf1, 0x0c, 0xec, 0x0e, 0x1c, 0x32, 0x61,
+	0x03, 0x16, 0xa8, 0x8f, 0xe7, 0xa3, 0x96, 0x04, 0xea, 0x6e, 0x60, 0x2d, 0xb0, 0xbf, 0x86, 0x3c,
+	0x5b, 0x77, 0xb6, 0x69, 0xda, 0xa7, 0x6e, 0xe9, 0xb3, 0xf9, 0x58, 0x40, 0xd7, 0x1b, 0x57, 0x47,
+	0x1d, 0x58, 0x67, 0xc7, 0x46, 0x11, 0x61, 0x3c, 0x07, 0xeb, 0x63, 0xce, 0xda, 0x9f, 0xcf, 0x07,
+	0xa2, 0x8b, 0xa1, 0xcf, 0x63, 0x0c, 0x33, 0x14, 0xb4, 0x7d, 0x3b, 0x8c, 0xc8, 0x83, 0x8f, 0x5b,
+	0x7a, 0xb2, 0xd8, 0x1a, 0xe9, 0x87, 0x43, 0x96, 0x5b, 0x3e, 0x80, 0xa5, 0x08, 0x83, 0xa1, 0x9f,
+	0x03, 0x84, 0x48, 0x30, 0xb6, 0x95, 0xf8, 0xbc, 0xb8, 0xb3, 0x19, 0x62, 0x85, 0x40, 0x9b, 0xfe,
+	0x54, 0x43, 0xca, 0xe5, 0xbf, 0x8f, 0x41, 0x46, 0x30, 0x17, 0xaa, 0x0b, 0xc2, 0xa3, 0x00, 0xf9,
+	0x9d, 0x2f, 0x16, 0x24, 0x3c, 0xf6, 0xbf, 0x6e, 0x79, 0xce, 0x39, 0xa7, 0xbe, 0xf2, 0x10, 0x72,
+	0xbe, 0x08, 0x29, 0x90, 0x78, 0x8f, 0xcf, 0x59, 0xd6, 0x95, 0x53, 0xe9, 0x4f, 0x54, 0x83, 0xd4,
+	0x09, 0x1d, 0x99, 0x48, 0x9f, 0xae, 0x49, 0xd6, 0xdc, 0xf6, 0xab, 0xf8, 0x97, 0xb1, 0xf2, 0x03,
+	0xc8, 0xf9, 0xc1, 0x07, 0xad, 0x4a, 0x54, 0xda, 0xf9, 0x9c, 0x50, 0x2b, 0xbf, 0x83, 0x9c, 0xcf,
+	0xa9, 0x54, 0xe5, 0x68, 0xea, 0xb8, 0x1e, 0xeb, 0x4c, 0x42, 0xe5, 0x05, 0xf4, 0x1c, 0xb2, 0xc4,
+	0xf2, 0xb0, 0x73, 0xa2, 0x9b, 0xa2, 0x47, 0x57, 0xd0, 0x88, 0xaf, 0x5a, 0xfe, 0xd7, 0x18, 0x14,
+	0xc2, 0x74, 0x8d, 0xbe, 0x8b, 0x10, 0x3e, 0x77, 0xe1, 0x8b, 0xeb, 0x10, 0x7e, 0x50, 0xe0, 0xce,
+	0x0c, 0xf8, 0xbf, 0x3c, 0x82, 0x62, 0xb4, 0xf2, 0x12, 0xb7, 0x7e, 0x13, 0x75, 0xeb, 0x93, 0x85,
+	0x9b, 0x0e, 0xbb, 0xf4, 0xef, 0xe2, 0x80, 0x66, 0xa3, 0x05, 0xfa, 0x0e, 0x72, 0xba, 0x39, 0xb2,
+	0x1d, 0xe2, 0x1d, 0x8f, 0x59, 0x9b, 0xc5, 0x9d, 0xaf, 0xaf, 0x1f, 0x74, 0xb6, 0xab, 0x12, 0x43,
+	0x0d, 0xe0, 0xd0, 0x27, 0x90, 0x3f, 0x1a, 0x38, 0xe7, 0x13, 0x4f, 0x1b, 0xd8, 0xae, 0xc7, 0x7a,
+	0x9f, 0x50, 0x81, 0x8b, 0x6a, 0xb6, 0xeb, 0x51, 0x05, 0xdd, 0x19, 0xd9, 0xd6, 0x0e, 0x3b, 0xeb,
+	0xb0, 0x5c, 0x39, 0xa1, 0x02, 0x17, 0xd1, 0x83, 0x0c, 0x7a, 0x08, 0x4b, 0x42, 0x61, 0x8c, 0xc7,
+	0xb6, 0x73, 0xce, 0xf2, 0xe0, 0x84, 0x5a, 0xe0, 0xc2, 0x37, 0x4c, 0x86, 0x1e, 0x41, 0x51, 0xa2,
+	0x1c, 0x3b, 0x58, 0x37, 0x5c, 0x96, 0xe2, 0x26, 0x54, 0x61, 0xda, 0xe3, 0xc2, 0xca, 0x0e, 0xe4,
+	0xfc, 0x5e, 0xa2, 0x3c, 0x64, 0xfa, 0xad, 0xd7, 0xad, 0xf6, 0xdb, 0x96, 0x72, 0x0b, 0x01, 0xa4,
+	0x5f, 0xd6, 0xd4, 0x5f, 0x74, 0x7a, 0x4a, 0x0c, 0x15, 0x20, 0x5b, 0x55, 0x5f, 0xb5, 0x5b, 0x3b,
+	0x8d, 0x5d, 0x25, 0x5e, 0xf9, 0xaf, 0x0c, 0x40, 0xb0, 0x48, 0x2b, 0xff, 0x91, 0x81, 0x44, 0x4d,
+	0x9f, 0x44, 0xad, 0x8b, 0x00, 0x9d, 0x46, 0x4d, 0xab, 0xa9, 0xf5, 0x6a, 0xaf, 0xce, 0x11, 0x68,
+	0x59, 0xad, 0x57, 0x77, 0x95, 0x38, 0x5a, 0x82, 0x1c, 0x2d, 0x35, 0x5a, 0xbb, 0xf5, 0x77, 0x4a,
+	0x02, 0xdd, 0x86, 0x65, 0x5a, 0xec, 0xb6, 0xf7, 0x7a, 0xda, 0x6e, 0xbd, 0x59, 0xef, 0xd5, 0x95,
+	0x94, 0x14, 0xee, 0x57, 0xd5, 0x5d, 0x29, 0x4c, 0x4b, 0xc3, 0x4e, 0x5f, 0x7d, 0x55, 0x57, 0x32,
+	0xe8, 0x0e, 0x6c, 0xd0, 0x62, 0xbf, 0xb3, 0x5b, 0xed, 0xd5, 0xb5, 0xc3, 0x46, 0xfd, 0xad, 0x56,
+	0x6b, 0xf7, 0x5b, 0xbd, 0xba, 0xaa, 0x64, 0x11, 0x82, 0x22, 0xad, 0xec, 0x55, 0x5f, 0xc9, 0x6e,
+	0xe4, 0xd0, 0x3a, 0x20, 0xd6, 0xad, 0xf6, 0x9b, 0x37, 0xf5, 0x56, 0x4f, 0xca, 0x41, 0x36, 0x76,
+	0xd8, 0xee, 0xd5, 0xa5, 0x30, 0x8f, 0x96, 0x21, 0xdf, 0xef, 0xd6, 0x55, 0x29, 0x48, 0xa2, 0x32,
+	0xac, 0x33, 0x81, 0x68, 0xaf, 0x56, 0xed, 0x54, 0x5f, 0x36, 0x9a, 0x8d, 0xde, 0x2f, 0x94, 0x02,
+	0x6d, 0x8d, 0xd5, 0xd1, 0x11, 0x6a, 0xdd, 0x7a, 0x73, 0x4f, 0x59, 0x42, 0x2b, 0xb0, 0x14, 0xc8,
+	0xaa, 0xcd, 0xa6, 0x52, 0x44, 0x25, 0x58, 0xa5, 0x0d, 0xd5, 0xdf, 0xf5, 0xea, 0xad, 0x6e, 0xa3,
+	0xdd, 0x92, 0xe0, 0xcb, 0xb2, 0x6b, 0x41, 0x0d, 0xf3, 0x95, 0x82, 0xb6, 0xe0, 0x6e, 0xb8, 0xcb,
+	0x33, 0x96, 0x2b, 0xe8, 0x3e, 0x94, 0x2f, 0xd7, 0x60, 0x08, 0x08, 0xdd, 0x85, 0x92, 0x74, 0xc4,
+	0x8c, 0xf5, 0x6d, 0x3a, 0xa8, 0xd9, 0x5a, 0x66, 0xb9, 0x8a, 0xee, 0xc1, 0xa6, 0xef, 0x96, 0x19,
+	0xd3, 0x35, 0xe9, 0xfe, 0x0b, 0xd5, 0xcc, 0x76, 0x1d, 0xad, 0x82, 0x12, 0x0c, 0xbe, 0xd3, 0x7f,
+	0xd9, 0x6c, 0xd4, 0x94, 0x8d, 0xa8, 0x9b, 0x3a, 0x8d, 0x5a, 0x57, 0x29, 0xa1, 0x35, 0x58, 0x89,
+	0xc8, 0x68, 0x5f, 0x94, 0x4d, 0xb4, 0x09, 0x6b, 0x51, 0xb1, 0x18, 0xa0, 0x52, 0xa6, 0xbe, 0x8a,
+	0x56, 0xd1, 0x2e, 0x28, 0x77, 0x64, 0x87, 0xa4, 0x27, 0xc2, 0xd3, 0x79, 0x17, 0x3d, 0x82, 0x07,
+	0x33, 0x95, 0x33, 0x83, 0xba, 0xe7, 0x63, 0x37, 0x5a, 0x87, 0x8d, 0xc0, 0xfc, 0x3e, 0x52, 0xa0,
+	0xc0, 0xe4, 0xdd, 0x7e, 0xb7, 0x53, 0x6f, 0xed, 0x2a, 0x9f, 0xa0, 0x0d, 0xb8, 0x1d, 0x5e, 0x0e,
+	0x1d, 0xb5, 0xbd, 0xd7, 0x68, 0xd6, 0x95, 0x2d, 0x1f, 0xa2, 0xda, 0xef, 0xed, 0xb3, 0x26, 0xd4,
+	0x56, 0xb5, 0xa9, 0x3c, 0xa0, 0x83, 0xaf, 0xf6, 0x77, 0x1b, 0x3d, 0xad, 0xd9, 0x7e, 0xc5, 0xdd,
+	0x54, 0xb9, 0xb8, 0x22, 0x39, 0x96, 0xf2, 0xf0, 0xa2, 0x5c, 0xec, 0x80, 0x4f, 0xe5, 0x02, 0x92,
+	0xf2, 0x37, 0xed, 0xdd, 0xba, 0x4a, 0x2d, 0x1e, 0xd1, 0xee, 0xd0, 0x9a, 0xbd, 0xea, 0x61, 0x5b,
+	0x0d, 0xf5, 0xfc, 0x31, 0xf5, 0x6f, 0xad, 0xdd, 0x6c, 0xd6, 0x6b, 0xbd, 0xd0, 0x40, 0x3f, 0xa3,
+	0xab, 0x93, 0xed, 0xa5, 0x76, 0xbb, 0xa9, 0xd5, 0x77, 0x1b, 0x3d, 0xe5, 0x73, 0x2a, 0xda, 0x6b,
+	0x37, 0x9b, 0xed, 0xb7, 0x52, 0xeb, 0x49, 0xe5, 0x9f, 0xe2, 0x90, 0xae, 0x4e, 0xc8, 0x6b, 0x7c,
+	0x8e, 0xee, 0x02, 0xe8, 0x13, 0xa2, 0xbd, 0xc7, 0xe7, 0x1a, 0x31, 0x04, 0x15, 0x67, 0x75, 0x56,
+	0xd7, 0x30, 0xd0, 0x06, 0x64, 0x58, 0x9a, 0x4b, 0x0c, 0xc6, 0x69, 0x39, 0x35, 0x4d, 0x8b, 0x0d,
+	0x03, 0x21, 0x48, 0xd2, 0x23, 0x1d, 0x23, 0xb2, 0x9c, 0xca, 0x7e, 0xa3, 0xdf, 0x85, 0xc2, 0xc0,
+	0xc1, 0xba, 0x87, 0x0d, 0x4e, 0x72, 0xc9, 0x0f, 0xa4, 0xf0, 0x3d, 0x79, 0x37, 0xaa, 0xe6, 0x85,
+	0x3e, 0x63, 0xc0, 0x17, 0x90, 0x67, 0x29, 0x15, 0xe6, 0xd6, 0xa9, 0xb9, 0xd6, 0xc0, 0xd5, 0x99,
+	0xf1, 0xb7, 0x50, 0x34, 0x75, 0xd7, 0xa3, 0x49, 0xb9, 0x68, 0x3d, 0x3d, 0xd7, 0xbe, 0x40, 0x2d,
+	0xfa, 0xae, 0x68, 0x3e, 0x7a, 0xfc, 0xc8, 0x5c, 0xe3, 0xf8, 0x51, 0xf9, 0x55, 0x0a, 0xb2, 0xf2,
+	0x84, 0x8f, 0xb6, 0xa0, 0xe0, 0xe7, 0x08, 0x81, 0x4b, 0x41, 0x17, 0xf5, 0x0d, 0x03, 0xed, 0x40,
+	0x5a, 0x67, 0xe7, 0x5a, 0xe6, 0xd3, 0xe2, 0x4e, 0x39, 0xd4, 0x8a, 0x84, 0xd9, 0xae, 0x32, 0x0d,
+	0x55, 0x68, 0xa2, 0x0a, 0x2c, 0xe9, 0x03, 0xcf, 0x76, 0x34, 0x39, 0x1d, 0xdc, 0xf1, 0x79, 0x26,
+	0xec, 0xf3, 0x39, 0xf9, 0x14, 0x8a, 0x9e, 0xee, 0x8c, 0xb0, 0xe7, 0x2b, 0x25, 0x99, 0x52, 0x81,
+	0x4b, 0x85, 0x56, 0x05, 0x96, 0x84, 0x16, 0xcd, 0x43, 0x88, 0xc1, 0x1c, 0x9d, 0x53, 0xf3, 0x5c,
+	0xd8, 0x21, 0x83, 0x86, 0x81, 0x9e, 0xc2, 0x8a, 0xd0, 0x91, 0x79, 0x0a, 0x31, 0xd8, 0x8d, 0x61,
+	0x4e, 0x5d, 0xe6, 0x15, 0x22, 0x0d, 0x69, 0x18, 0x33, 0xb3, 0x9e, 0xbe, 0xde, 0xac, 0xaf, 0x43,
+	0xda, 0xc1, 0xba, 0x6b, 0x5b, 0xec, 0x5e, 0x34, 0xa7, 0x8a, 0x12, 0x75, 0xd2, 0xe0, 0x58, 0xb7,
+	0x46, 0xb8, 0x94, 0x65, 0xa7, 0x90, 0x4b, 0x9d, 0x54, 0x63, 0x1a, 0xaa, 0xd0, 0x2c, 0x37, 0x21,
+	0xcd, 0x25, 0xf4, 0x94, 0x34, 0x24, 0xd8, 0x94, 0xde, 0xe7, 0x05, 0xda, 0xd6, 0x11, 0x1e, 0xda,
+	0x0e, 0x96, 0x8b, 0x99, 0x97, 0xa8, 0xb6, 0x3e, 0xf4, 0xb0, 0x23, 0x9c, 0xca, 0x0b, 0x95, 0x3f,
+	0xa3, 0x9b, 0x84, 0x7b, 0x3f, 0x12, 0x05, 0x69, 0xc0, 0xe0, 0xe4, 0xc0, 0x03, 0x49, 0x10, 0x30,
+	0x62, 0x34, 0xe4, 0x84, 0x02, 0x1e, 0x65, 0x31, 0x25, 0x4e, 0x85, 0xa1, 0x80, 0xc7, 0x84, 0x09,
+	0x16, 0xf4, 0x68, 0xc0, 0x63, 0xc5, 0x24, 0x65, 0x00, 0x19, 0x80, 0xda, 0xad, 0xbd, 0xc6, 0xab,
+	0xbe, 0x5a, 0xa5, 0x1b, 0x5b, 0x49, 0x51, 0x8a, 0x12, 0xec, 0xc4, 0xda, 0x53, 0xd2, 0x8c, 0x6e,
+	0x5b, 0x11, 0x59, 0x86, 0xb1, 0x93, 0x60, 0xac, 0x10, 0xa9, 0x66, 0xa9, 0x3c, 0x68, 0xd6, 0x97,
+	0xe7, 0x18, 0xf1, 0xb5, 0x9a, 0xed, 0xda, 0x6b, 0x4a, 0x5b, 0x8d, 0x96, 0x02, 0x54, 0x93, 0xf3,
+	0x83, 0x4f, 0x92, 0xed, 0xdd, 0xba, 0x92, 0xaf, 0xfc, 0x77, 0x1c, 0x20, 0x48, 0xc5, 0xe8, 0x39,
+	0x25, 0x94, 0xd6, 0xf9, 0xeb, 0xbb, 0x10, 0x08, 0xaf, 0x4b, 0x1b, 0xdf, 0x02, 0x9c, 0x10, 0x97,
+	0x88, 0x8d, 0x97, 0x64, 0x5b, 0x62, 0x2b, 0xbc, 0xf1, 0x7c, 0xe4, 0xed, 0x43, 0x5f, 0x4f, 0x0d,
+	0xd9, 0xa0, 0x12, 0x64, 0x4e, 0xb0, 0x43, 0xd3, 0x0a, 0xb6, 0x98, 0x15, 0x55, 0x16, 0x6f, 0xba,
+	0x38, 0x69, 0xb6, 0x69, 0x1b, 0x64, 0x48, 0xa4, 0x7d, 0x66, 0x3e, 0xa9, 0x48, 0x03, 0x2a, 0xaa,
+	0xec, 0x00, 0x04, 0x7d, 0x8e, 0x2e, 0xa3, 0x3c, 0x64, 0x3a, 0x6a, 0xe3, 0x90, 0x9f, 0xa4, 0x00,
+	0xd2, 0x22, 0x9a, 0xc6, 0x2b, 0xff, 0x18, 0x07, 0x68, 0x58, 0x27, 0xc4, 0xc3, 0x35, 0xdb, 0xc0,
+	0x74, 0x57, 0x13, 0x56, 0xd2, 0x06, 0xb6, 0x81, 0x43, 0x1e, 0x27, 0xbe, 0x4e, 0xc3, 0x40, 0x8f,
+	0x61, 0x99, 0x75, 0x3c, 0xc4, 0x10, 0xdc, 0xf3, 0x4b, 0x42, 0x2c, 0x76, 0xff, 0x45, 0x87, 0x24,
+	0x6e, 0xc4, 0xd1, 0xc9, 0x6b, 0x71, 0xf4, 0x26, 0x64, 0x59, 0xfa, 0xe8, 0x62, 0x79, 0x6e, 0xcd,
+	0xd0, 0xb4, 0xd0, 0xc5, 0x2e, 0x5d, 0x17, 0x4c, 0x9c, 0x66, 0x62, 0xf6, 0xfb, 0x26, 0x84, 0xfc,
+	0xa7, 0x71, 0xc8, 0x88, 0x44, 0x13, 0xdd, 0x03, 0x90, 0xb7, 0xab, 0xbe, 0xef, 0x72, 0x42, 0x72,
+	0x89, 0x43, 0xe2, 0xd7, 0x73, 0x88, 0x8c, 0x3b, 0x2e, 0xc6, 0xd6, 0xa2, 0x1e, 0x65, 0x71, 0xa7,
+	0x8b, 0xb1, 0xc5, 0x10, 0xee, 0x01, 0xb0, 0x19, 0xd3, 0x47, 0xd8, 0xf2, 0x04, 0x63, 0xe7, 0xa8,
+	0xa4, 0x4a, 0x05, 0x34, 0x71, 0x10, 0xb7, 0x9b, 0xba, 0x61, 0x38, 0x82, 0xac, 0x81, 0x8b, 0xaa,
+	0x86, 0xe1, 0xd0, 0xc5, 0x3f, 0x98, 0x3a, 0x0e, 0x35, 0xa6, 0xde, 0xcb, 0xaa, 0xb2, 0x58, 0xf9,
+	0xab, 0x24, 0x24, 0x3a, 0x64, 0x80, 0x8a, 0x10, 0xf7, 0x47, 0x1e, 0x27, 0x46, 0x78, 0xbb, 0x24,
+	0xaf, 0xde, 0x2e, 0xc5, 0x1b, 0x6e, 0x97, 0xe5, 0xeb, 0x6d, 0x17, 0xf4, 0x04, 0x94, 0x09, 0xb6,
+	0x0c, 0x62, 0x8d, 0x34, 0x03, 0x9b, 0x98, 0xc5, 0xc8, 0x1c, 0x1b, 0xd4, 0xb2, 0x90, 0xef, 0x0a,
+	0x31, 0x75, 0xdb, 0x09, 0xc1, 0xa7, 0xda, 0xc0, 0x9e, 0x5a, 0x1e, 0x7b, 0x8a, 0x4a, 0xa8, 0x39,
+	0x2a, 0xa9, 0x51, 0x01, 0x5d, 0x6b, 0xee, 0xc0, 0x76, 0xb0, 0x66, 0xda, 0xec, 0xf5, 0x27, 0xa6,
+	0x66, 0x58, 0xb9, 0x69, 0x07, 0x55, 0xc7, 0x84, 0xbd, 0xda, 0xc8, 0xaa, 0x7d, 0x82, 0x1e, 0x43,
+	0x72, 0x48, 0x4c, 0x2c, 0x5e, 0x37, 0x50, 0x68, 0xb1, 0x75, 0xc8, 0x60, 0x8f, 0x98, 0x58, 0x65,
+	0xf5, 0xe8, 0x47, 0x90, 0x76, 0xed, 0xa9, 0x33, 0xc0, 0x25, 0xc4, 0x82, 0xd3, 0x6a, 0x54, 0xb3,
+	0xcb, 0xea, 0x54, 0xa1, 0x83, 0xbe, 0x85, 0xa5, 0x21, 0x71, 0xdc, 0x20, 0x2c, 0xf3, 0xd7, 0x82,
+	0xbb, 0x33, 0x6e, 0xe1, 0x17, 0x01, 0xe2, 0xd2, 0x8a, 0x99, 0x88, 0x5d, 0xfb, 0x08, 0x8a, 0x43,
+	0xfd, 0x84, 0x26, 0x74, 0x58, 0x0c, 0x78, 0x95, 0xe7, 0x7d, 0x52, 0xca, 0x06, 0x7d, 0x90, 0xcc,
+	0xc6, 0x95, 0xc4, 0x41, 0x32, 0x9b, 0x50, 0x92, 0x07, 0xc9, 0x6c, 0x4a, 0x49, 0x1f, 0x24, 0xb3,
+	0x69, 0x25, 0x73, 0x90, 0xcc, 0x66, 0x94, 0xec, 0x41, 0x32, 0x9b, 0x55, 0x72, 0x07, 0xc9, 0x6c,
+	0x5e, 0x29, 0x1c, 0x24, 0xb3, 0x2b, 0x0a, 0xaa, 0xfc, 0x75, 0x0c, 0x96, 0x3b, 0x64, 0x50, 0xb5,
+	0x8c, 0xde, 0xf1, 0x74, 0x7c, 0x64, 0xe9, 0xc4, 0x44, 0x5b, 0x90, 0x98, 0x90, 0x81, 0x78, 0x60,
+	0x2e, 0x46, 0xc7, 0xa5, 0xd2, 0x2a, 0xf4, 0x13, 0xc8, 0x79, 0x52, 0xbd, 0x14, 0x67, 0xe3, 0xbf,
+	0xcc, 0x53, 0x81, 0x12, 0x65, 0x8d, 0x89, 0xa9, 0x0f, 0xf0, 0xb1, 0x6d, 0x1a, 0x22, 0xca, 0xe6,
+	0x23, 0x5b, 0xb9, 0x43, 0x06, 0x9d, 0x40, 0x41, 0x0d, 0x6b, 0x57, 0x7e, 0x9d, 0x04, 0x08, 0xde,
+	0x78, 0xd0, 0x1a, 0xa4, 0xc5, 0xb9, 0x45, 0x84, 0xf6, 0x09, 0x3b, 0xb1, 0xdc, 0x03, 0x08, 0x1d,
+	0x55, 0x38, 0xf5, 0xe5, 0x06, 0xfe, 0x21, 0xe5, 0x29, 0xac, 0xc8, 0xea, 0x89, 0xee, 0x08, 0x2d,
+	0x1e, 0x84, 0x96, 0x45, 0x45, 0x87, 0xc9, 0x79, 0x8c, 0xf2, 0xf0, 0x99, 0x27, 0xce, 0x23, 0xec,
+	0xf7, 0x4d, 0x8f, 0xb6, 0x33, 0x1b, 0x23, 0x75, 0xcd, 0x8d, 0x11, 0xda, 0xb2, 0xe9, 0xe8, 0x96,
+	0x7d, 0x1e, 0x84, 0xda, 0xec, 0x02, 0xcb, 0x4a, 0x06, 0x62, 0x4a, 0xe4, 0x06, 0xf1, 0xc7, 0x93,
+	0x5b, 0x80, 0xc8, 0x99, 0xba, 0xec, 0x0d, 0xdb, 0x9e, 0xd8, 0x60, 0x1b, 0x2f, 0xab, 0xca, 0x22,
+	0xfa, 0x0a, 0xb2, 0x0e, 0xa6, 0x91, 0xd9, 0xb6, 0x4a, 0x79, 0xb6, 0x34, 0xee, 0x47, 0xa7, 0x59,
+	0x4c, 0xe3, 0xb6, 0x2a, 0xb4, 0x54, 0x5f, 0xbf, 0xfc, 0x97, 0x31, 0xc8, 0x4a, 0xb1, 0x3f, 0x09,
+	0xb1, 0xd0, 0x24, 0x7c, 0x03, 0x4b, 0x0e, 0x66, 0x4b, 0x63, 0x61, 0xae, 0x2e, 0x48, 0x03, 0xd6,
+	0xef, 0x90, 0xaf, 0x12, 0x8b, 0xfb, 0xaa, 0x52, 0x85, 0x62, 0xd0, 0xf3, 0x9e, 0x83, 0x31, 0x7a,
+	0x06, 0x19, 0xb1, 0x6a, 0xc4, 0x1d, 0xd9, 0xda, 0xa5, 0xa3, 0x54, 0xa5, 0x56, 0xe5, 0xd7, 0xf1,
+	0x30, 0xc6, 0xa1, 0xed, 0xe1, 0x8f, 0x5c, 0xc8, 0x1f, 0x37, 0x04, 0xb4, 0x03, 0xc9, 0x13, 0xdb,
+	0xc3, 0xe2, 0x74, 0x75, 0xf9, 0x9c, 0xd0, 0x5e, 0x6d, 0xd3, 0x3f, 0x2a, 0xd3, 0xfd, 0x8d, 0x3e,
+	0x55, 0x25, 0x99, 0x0b, 0x23, 0xe7, 0xa9, 0x34, 0xc4, 0xfb, 0x1d, 0x25, 0x86, 0xb2, 0x90, 0xdc,
+	0xa5, 0x92, 0x38, 0xad, 0x6e, 0xd5, 0xfb, 0x3d, 0xb5, 0xda, 0x54, 0x12, 0x95, 0xbf, 0x49, 0x40,
+	0x46, 0x50, 0xd3, 0x4c, 0x40, 0xfc, 0x02, 0xd2, 0x43, 0xdb, 0x19, 0xeb, 0x9e, 0x48, 0xc8, 0x36,
+	0x67, 0xe9, 0x6c, 0x7b, 0x8f, 0x29, 0xa8, 0x42, 0x91, 0xa6, 0x0c, 0xa7, 0xc4, 0x10, 0x5f, 0xbd,
+	0xa4, 0x54, 0x5e, 0xa0, 0x09, 0xc6, 0x31, 0x26, 0xa3, 0x63, 0x1e, 0xc7, 0x53, 0xaa, 0x28, 0xa1,
+	0xe7, 0x90, 0xf5, 0x5f, 0xe3, 0x53, 0x73, 0xaf, 0x67, 0xa5, 0x2a, 0xba, 0x1b, 0x66, 0x5a, 0x1e,
+	0xdc, 0x43, 0xac, 0x7a, 0x71, 0x16, 0x32, 0x37, 0x9c, 0x85, 0xec, 0x35, 0x39, 0x09, 0x41, 0x92,
+	0xbd, 0x26, 0xe4, 0xf8, 0x99, 0x8d, 0xfe, 0xae, 0xec, 0x42, 0x9a, 0x3b, 0x2a, 0x3a, 0x37, 0x59,
+	0x48, 0x1e, 0x74, 0xea, 0xaf, 0x94, 0x18, 0xca, 0x40, 0xe2, 0x55, 0x63, 0x4f, 0x89, 0xd3, 0x1f,
+	0x9d, 0xd6, 0x2b, 0x25, 0x41, 0xeb, 0xde, 0xd6, 0x5f, 0xbe, 0x51, 0x92, 0x54, 0xf4, 0xa6, 0xf3,
+	0x53, 0x25, 0x55, 0xe9, 0xb1, 0xcd, 0x12, 0x8a, 0x08, 0xe8, 0x0e, 0xe4, 0x8e, 0xcc, 0xa9, 0xc3,
+	0xde, 0x0d, 0xe5, 0x25, 0x05, 0x15, 0xec, 0xeb, 0xee, 0x31, 0x8d, 0x8e, 0x86, 0x3d, 0x26, 0x96,
+	0x6e, 0xd1, 0x7c, 0xd5, 0xb4, 0x1d, 0x36, 0x8d, 0x4b, 0xea, 0x92, 0x94, 0xd6, 0xa8, 0xb0, 0xf2,
+	0x06, 0x72, 0x7e, 0x6c, 0x46, 0x0a, 0x24, 0xa6, 0x8e, 0x29, 0xaf, 0x9e, 0xa7, 0x8e, 0x89, 0xca,
+	0x94, 0xba, 0x86, 0xd8, 0x71, 0xfc, 0x3c, 0xd0, 0x2f, 0xfb, 0x69, 0x4b, 0x3c, 0x48, 0x5b, 0x2a,
+	0xff, 0x19, 0x83, 0x74, 0x87, 0x0c, 0x7a, 0xfa, 0xe8, 0x43, 0x5b, 0x79, 0x0d, 0xd2, 0x9e, 0x3e,
+	0x0a, 0xb6, 0x71, 0xca, 0xd3, 0x47, 0xdf, 0xcf, 0xd5, 0xc9, 0xf7, 0x17, 0x5f, 0x2a, 0xff, 0x12,
+	0x67, 0xfb, 0xe6, 0x2a, 0xca, 0x0a, 0x71, 0x52, 0xe6, 0x1a, 0x9c, 0xf4, 0x5b, 0x82, 0x93, 0x12,
+	0x6c, 0xcf, 0x6d, 0x44, 0xf7, 0xdc, 0x15, 0x64, 0x34, 0xe7, 0xcc, 0x9a, 0xba, 0xa1, 0xeb, 0xd2,
+	0x3f, 0x00, 0x19, 0xfd, 0x5b, 0x0c, 0x92, 0x1d, 0xdb, 0x36, 0x69, 0xa2, 0xcc, 0x5e, 0x0e, 0x7d,
+	0x97, 0xa6, 0x69, 0xb1, 0x61, 0x50, 0x7e, 0x61, 0xaf, 0x93, 0xfe, 0xd2, 0xa1, 0x05, 0xb4, 0x05,
+	0xf9, 0xd0, 0x1b, 0xa3, 0xbc, 0x03, 0x0a, 0x89, 0xfe, 0xbf, 0x17, 0x52, 0xe5, 0x6f, 0x63, 0x50,
+	0xec, 0x4c, 0x8f, 0x4c, 0x32, 0x60, 0x47, 0x57, 0x6b, 0x68, 0x87, 0x2f, 0x03, 0x62, 0x91, 0xcb,
+	0x80, 0x55, 0x48, 0xb1, 0x2f, 0xff, 0xe4, 0x18, 0x59, 0xe1, 0xa6, 0x19, 0xea, 0x4f, 0x20, 0x33,
+	0x71, 0x6c, 0x76, 0x8a, 0xe7, 0x63, 0x5f, 0x0f, 0x2d, 0x2c, 0xda, 0xa7, 0x0e, 0xaf, 0x55, 0xa5,
+	0x5a, 0xe5, 0x9f, 0x63, 0x90, 0xeb, 0x9c, 0x7a, 0xfb, 0x58, 0xa7, 0x4c, 0xf3, 0xf5, 0xec, 0x2b,
+	0x51, 0x24, 0x5c, 0x4a, 0xc5, 0xcb, 0xdf, 0x81, 0x42, 0xcb, 0x94, 0xbf, 0x01, 0xf9, 0xcb, 0x74,
+	0x0d, 0xd2, 0xe2, 0x8e, 0x55, 0x5c, 0x32, 0xbd, 0xc7, 0xe7, 0x0d, 0xa3, 0xd2, 0xfd, 0xe0, 0x53,
+	0x4d, 0x0e, 0x52, 0xfb, 0xdd, 0x9d, 0xe7, 0x3f, 0x53, 0x62, 0xf4, 0xa7, 0xca, 0x7e, 0xb2, 0x47,
+	0x96, 0xfd, 0xee, 0xf3, 0x2f, 0x76, 0x34, 0x5a, 0x4c, 0xd0, 0x9a, 0x3a, 0xab, 0x49, 0xb2, 0x9f,
+	0xbb, 0xbb, 0xdd, 0xaa, 0x92, 0xaa, 0xfc, 0x79, 0x02, 0xa0, 0x73, 0xea, 0x75, 0xf4, 0x73, 0xd3,
+	0xd6, 0x59, 0xbe, 0xe7, 0x4e, 0x8f, 0xfe, 0x18, 0x0f, 0xe4, 0x71, 0x4a, 0x16, 0x69, 0x8a, 0x6d,
+	0xd9, 0x9e, 0x16, 0xba, 0x14, 0xbb, 0xda, 0xd3, 0x39, 0xcb, 0xf6, 0x5e, 0xf2, 0x3b, 0xb3, 0xdf,
+	0x01, 0x5a, 0xd0, 0x82, 0x7b, 0xb3, 0xab, 0x2d, 0xb3, 0x96, 0xed, 0x55, 0xa9, 0x2e, 0xcd, 0x98,
+	0x5d, 0x7b, 0xe8, 0x69, 0x81, 0xf5, 0x02, 0x3b, 0x8e, 0x5a, 0xb4, 0x24, 0xc2, 0x3a, 0xa4, 0x89,
+	0xeb, 0x4e, 0xb1, 0x23, 0xb2, 0x65, 0x51, 0xa2, 0x89, 0x9d, 0x67, 0xbf, 0xc7, 0x96, 0xbc, 0xd4,
+	0x4c, 0xa8, 0x19, 0x56, 0x6e, 0x18, 0x68, 0x1b, 0x92, 0xec, 0x73, 0xa0, 0xcc, 0xcc, 0x85, 0x6b,
+	0xe0, 0xa7, 0xed, 0xde, 0xf9, 0x04, 0xab, 0x4c, 0xaf, 0xf2, 0x1c, 0x92, 0xec, 0xab, 0x9f, 0x8b,
+	0x51, 0xac, 0xda, 0xef, 0xed, 0x8b, 0xe0, 0xd5, 0x78, 0xa7, 0x24, 0x2a, 0xc9, 0x6c, 0x4c, 0x89,
+	0x3d, 0xcd, 0xa8, 0xf5, 0x3d, 0xb5, 0xde, 0xdd, 0xe7, 0x29, 0x96, 0xba, 0xcc, 0x7b, 0xe1, 0x27,
+	0x1a, 0x95, 0xbf, 0x88, 0xc3, 0x52, 0xe4, 0xf5, 0x9b, 0xe6, 0x23, 0x17, 0xbe, 0xfc, 0xf2, 0x77,
+	0xc7, 0x72, 0xe4, 0xd3, 0xae, 0x06, 0xbb, 0xb5, 0xb4, 0x87, 0x43, 0x17, 0xcb, 0x67, 0x45, 0x51,
+	0xba, 0xe9, 0x46, 0x99, 0xd9, 0xea, 0xc9, 0x6b, 0xc6, 0x8c, 0x9b, 0xdc, 0xd7, 0x57, 0x7e, 0x95,
+	0x82, 0x24, 0xdd, 0x8d, 0x3f, 0x30, 0x3b, 0xdc, 0x78, 0xd0, 0xb3, 0xf7, 0x3d, 0xa9, 0x6b, 0xde,
+	0xf7, 0x7c, 0x38, 0x95, 0xfb, 0xf8, 0x0b, 0x2f, 0xf4, 0x18, 0x96, 0xf9, 0x75, 0x60, 0x70, 0xfd,
+	0x97, 0xe5, 0xd7, 0x7f, 0x42, 0x2c, 0x2e, 0x12, 0x1e, 0xc2, 0x12, 0xfb, 0xec, 0x0c, 0x5b, 0x8e,
+	0x6d, 0x9a, 0xd8, 0x10, 0xb7, 0x2b, 0x05, 0x2a, 0xac, 0x0b, 0x19, 0x3d, 0xa0, 0xb0, 0x2f, 0x28,
+	0x80, 0x7d, 0x84, 0xc0, 0xbf, 0x04, 0xfb, 0x0a, 0xc0, 0x9d, 0xba, 0x13, 0x6c, 0x89, 0xd4, 0x2e,
+	0x76, 0xe1, 0x4a, 0x9e, 0xe2, 0x6f, 0x77, 0x7d, 0x0d, 0x35, 0xa4, 0x1d, 0xa6, 0xe4, 0xc2, 0x42,
+	0x94, 0x5c, 0xfe, 0x87, 0x18, 0x40, 0x00, 0x16, 0x7a, 0x23, 0x88, 0x45, 0xde, 0x08, 0x3e, 0x85,
+	0x22, 0xdf, 0xfa, 0x17, 0xee, 0x3c, 0x0b, 0x5c, 0x2a, 0xc6, 0xfc, 0x73, 0x00, 0xd7, 0xd3, 0x1d,
+	0x6f, 0xd1, 0x05, 0x93, 0x63, 0xda, 0x22, 0x61, 0xcc, 0x62, 0x6b, 0xe1, 0x95, 0x92, 0xc1, 0x16,
+	0x0f, 0x82, 0xff, 0x93, 0x87, 0x9c, 0xff, 0x99, 0xe7, 0x87, 0x57, 0x78, 0x05, 0x96, 0x82, 0x6f,
+	0x48, 0x83, 0xde, 0xe7, 0xa7, 0xd2, 0xf4, 0xe6, 0xf7, 0xb5, 0x18, 0x4a, 0xf6, 0xd4, 0x1b, 0xd9,
+	0xc4, 0x1a, 0x69, 0xd3, 0x89, 0x8b, 0x1d, 0xfe, 0xea, 0xe3, 0xe7, 0x82, 0xf9, 0x9d, 0xa7, 0x17,
+	0xe6, 0x82, 0x35, 0xbc, 0xdd, 0x16, 0x46, 0x7d, 0x66, 0x23, 0xce, 0x63, 0xfb, 0xb7, 0xd4, 0x35,
+	0xfb, 0xb2, 0x0a, 0xda, 0x0c, 0xb1, 0x06, 0xf4, 0xb4, 0x3d, 0xdb, 0x4c, 0xea, 0x8a, 0x66, 0x1a,
+	0xc2, 0x68, 0xa6, 0x19, 0x72, 0x59, 0x05, 0xfa, 0x7d, 0x58, 0xf5, 0x47, 0x13, 0xfa, 0x88, 0x4e,
+	0x04, 0x90, 0xcf, 0xae, 0x1c, 0x49, 0x90, 0xe7, 0xee, 0xdf, 0x52, 0x91, 0x3d, 0x23, 0xa5, 0xe0,
+	0xfe, 0x18, 0xc2, 0xe0, 0x99, 0x2b, 0xc0, 0x65, 0xff, 0xa3, 0xe0, 0x64, 0x46, 0x8a, 0xbe, 0x01,
+	0x08, 0xfc, 0x22, 0x32, 0xad, 0xfb, 0x97, 0x42, 0xfa, 0x23, 0xde, 0xbf, 0xa5, 0xe6, 0xa6, 0xb2,
+	0x80, 0xf6, 0x61, 0xc9, 0xb4, 0x47, 0xc4, 0xd2, 0x4c, 0x7b, 0xf0, 0xde, 0x9e, 0x7a, 0xe2, 0xc6,
+	0xe6, 0xc1, 0xa5, 0x18, 0x4d, 0xaa, 0xd9, 0xe4, 0x8a, 0xfb, 0xb7, 0xd4, 0x82, 0x19, 0x2a, 0xa3,
+	0x2f, 0xe9, 0x69, 0x80, 0x6e, 0x2d, 0x43, 0x7c, 0xc0, 0x7f, 0xf7, 0x52, 0x0c, 0xbe, 0xfd, 0x8c,
+	0xfd, 0x5b, 0xaa, 0x54, 0x47, 0xbf, 0x07, 0xb9, 0xa9, 0x25, 0x6d, 0xf3, 0x57, 0x8d, 0x41, 0x6a,
+	0xb1, 0x31, 0xc8, 0x02, 0x6a, 0x51, 0x92, 0x12, 0x1e, 0xe6, 0xdf, 0xb4, 0x09, 0x3e, 0x78, 0x78,
+	0xa5, 0x73, 0xf9, 0xf7, 0x6c, 0xfb, 0xb7, 0xd4, 0x22, 0x89, 0x48, 0xca, 0xdb, 0xb0, 0x76, 0xe9,
+	0x3a, 0xfd, 0x40, 0x9e, 0x52, 0x3e, 0x84, 0xb5, 0x4b, 0x17, 0xdc, 0x87, 0xf2, 0x9a, 0xc7, 0xb0,
+	0x2c, 0x0e, 0x4a, 0x17, 0xdf, 0x54, 0x84, 0x98, 0x13, 0x4c, 0xf9, 0x00, 0xd0, 0xec, 0x2a, 0xfb,
+	0xb8, 0xfb, 0x9d, 0xf2, 0x09, 0xa0, 0xd9, 0x45, 0xf5, 0xfd, 0x5f, 0x7a, 0x96, 0x2b, 0x90, 0xf3,
+	0x7d, 0xf2, 0x21, 0xff, 0x9d, 0x42, 0x21, 0xbc, 0xb2, 0x2e, 0x3e, 0x4d, 0xc4, 0x66, 0x9e, 0x26,
+	0xf6, 0x60, 0x85, 0x2e, 0x57, 0x6c, 0x68, 0x53, 0xcb, 0x23, 0xe6, 0xa2, 0x97, 0x76, 0xcb, 0xdc,
+	0xa8, 0x4f, 0x6d, 0xa8, 0xb4, 0xfc, 0x0e, 0x32, 0x62, 0x39, 0x7e, 0x30, 0x14, 0x84, 0x99, 0x3a,
+	0xbe, 0x30, 0x53, 0x97, 0x29, 0x51, 0xcb, 0xf5, 0x59, 0xfe, 0x12, 0x8a, 0xd1, 0x35, 0x77, 0xd9,
+	0x0a, 0x88, 0x5d, 0xb2, 0x02, 0x5e, 0xa6, 0x20, 0x81, 0x4f, 0xbc, 0xca, 0x10, 0xf2, 0xa1, 0x70,
+	0x86, 0x1e, 0x40, 0xc1, 0x20, 0xee, 0xc4, 0xd4, 0xcf, 0xd9, 0xe7, 0xaf, 0xc2, 0x34, 0x2f, 0x64,
+	0x2d, 0x9a, 0xf7, 0x2b, 0x90, 0x38, 0x22, 0xb6, 0x98, 0x3a, 0xfa, 0x93, 0x3d, 0xf4, 0x9f, 0xe8,
+	0x9e, 0xee, 0xc8, 0xe7, 0x79, 0xf9, 0xd0, 0xcf, 0x84, 0xec, 0x79, 0xfe, 0xe9, 0x0b, 0x28, 0xca,
+	0x77, 0x10, 0x95, 0x0f, 0xff, 0xe2, 0x39, 0xb5, 0xd5, 0x6e, 0xd5, 0x95, 0x18, 0x42, 0x50, 0x54,
+	0xfb, 0xcd, 0xba, 0x76, 0xd8, 0x68, 0x37, 0xf9, 0x7b, 0x72, 0xfc, 0xe5, 0x8f, 0x61, 0xc9, 0x76,
+	0x46, 0xc1, 0x8e, 0xeb, 0xc4, 0xbe, 0xdb, 0xe0, 0x05, 0xdb, 0x19, 0x3d, 0x63, 0xbf, 0x9e, 0xe9,
+	0x13, 0xf2, 0x42, 0x9f, 0x90, 0x7f, 0x8f, 0xc5, 0x8e, 0xd2, 0xcc, 0x7d, 0xbf, 0xfd, 0x7f, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0xc5, 0xe4, 0x41, 0xed, 0x19, 0x37, 0x00, 0x00,
 }
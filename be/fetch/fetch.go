@@ -0,0 +1,154 @@
+// Package fetch provides an HTTP client for downloading remote pics.  The client refuses to
+// connect to loopback, link-local, private, and other non-public addresses, preventing users from
+// using the server to reach internal services.
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+var blockedNets []*net.IPNet
+
+func init() {
+	for _, cidr := range []string{
+		"0.0.0.0/8",       // "this" network
+		"10.0.0.0/8",      // private
+		"100.64.0.0/10",   // carrier-grade NAT
+		"127.0.0.0/8",     // loopback
+		"169.254.0.0/16",  // link-local
+		"172.16.0.0/12",   // private
+		"192.0.0.0/24",    // IETF protocol assignments
+		"192.0.2.0/24",    // documentation
+		"192.168.0.0/16",  // private
+		"198.18.0.0/15",   // benchmarking
+		"198.51.100.0/24", // documentation
+		"203.0.113.0/24",  // documentation
+		"224.0.0.0/4",     // multicast
+		"240.0.0.0/4",     // reserved, including broadcast
+		"::/128",          // unspecified
+		"::1/128",         // loopback
+		"64:ff9b::/96",    // IPv4/IPv6 translation
+		"100::/64",        // discard
+		"2001:db8::/32",   // documentation
+		"fc00::/7",        // unique local
+		"fe80::/10",       // link-local
+		"ff00::/8",        // multicast
+	} {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		blockedNets = append(blockedNets, ipnet)
+	}
+}
+
+// PublicIP returns true if the ip is a globally routable unicast address.  IPv4-mapped IPv6
+// addresses are checked as IPv4.
+func PublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	if len(ip) != net.IPv4len && len(ip) != net.IPv6len {
+		return false
+	}
+	for _, ipnet := range blockedNets {
+		if ipnet.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// BlockedAddressError is returned when dialing an address that is not allowed.
+type BlockedAddressError struct {
+	Host string
+	IP   net.IP
+}
+
+func (e *BlockedAddressError) Error() string {
+	return fmt.Sprintf("fetch: address %v of %s is not allowed", e.IP, e.Host)
+}
+
+// Dialer connects to remote hosts, checking each resolved address before dialing it.  Addresses
+// are resolved once and dialed directly, so a host can't change its address between the check
+// and the connection.
+type Dialer struct {
+	// LookupIPAddr resolves a host name.  If nil, net.DefaultResolver is used.
+	LookupIPAddr func(ctx context.Context, host string) ([]net.IPAddr, error)
+	// AllowIP reports if an address may be dialed.  If nil, PublicIP is used.
+	AllowIP func(ip net.IP) bool
+	// Dialer makes the actual connection.  If nil, a default dialer is used.
+	Dialer *net.Dialer
+}
+
+// DialContext dials the first allowed address of the host in addr.  It has the same signature as
+// net.Dialer.DialContext so it may be used in an http.Transport.
+func (d *Dialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	lookup := d.LookupIPAddr
+	if lookup == nil {
+		lookup = net.DefaultResolver.LookupIPAddr
+	}
+	allow := d.AllowIP
+	if allow == nil {
+		allow = PublicIP
+	}
+	dialer := d.Dialer
+	if dialer == nil {
+		dialer = &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}
+	}
+
+	var ipaddrs []net.IPAddr
+	if ip := net.ParseIP(host); ip != nil {
+		ipaddrs = []net.IPAddr{{IP: ip}}
+	} else if ipaddrs, err = lookup(ctx, host); err != nil {
+		return nil, err
+	}
+	if len(ipaddrs) == 0 {
+		return nil, &net.DNSError{Err: "no addresses", Name: host}
+	}
+
+	var firstErr error
+	for _, ipaddr := range ipaddrs {
+		if !allow(ipaddr.IP) {
+			if firstErr == nil {
+				firstErr = &BlockedAddressError{Host: host, IP: ipaddr.IP}
+			}
+			continue
+		}
+		conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ipaddr.String(), port))
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		return conn, nil
+	}
+	return nil, firstErr
+}
+
+// NewClient returns an HTTP client that dials using d.  Proxies from the environment are not
+// used, since they would bypass the address checks.
+func NewClient(d *Dialer) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           d.DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestPublicIP(t *testing.T) {
+	cases := []struct {
+		ip   string
+		want bool
+	}{
+		{"8.8.8.8", true},
+		{"2001:4860:4860::8888", true},
+		{"0.0.0.0", false},
+		{"10.1.2.3", false},
+		{"100.64.0.1", false},
+		{"127.0.0.1", false},
+		{"169.254.169.254", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"fc00::1", false},
+		{"fe80::1", false},
+	}
+	for _, c := range cases {
+		if have, want := PublicIP(net.ParseIP(c.ip)), c.want; have != want {
+			t.Error(c.ip, "have", have, "want", want)
+		}
+	}
+}
+
+func TestDialerBlocksLoopback(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("should not connect")
+	}))
+	defer serv.Close()
+	servURL, err := url.Parse(serv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := &Dialer{
+		LookupIPAddr: func(ctx context.Context, host string) ([]net.IPAddr, error) {
+			return []net.IPAddr{{IP: net.ParseIP(servURL.Hostname())}}, nil
+		},
+	}
+	_, err = d.DialContext(context.Background(), "tcp", net.JoinHostPort("pics.example", servURL.Port()))
+	if _, ok := err.(*BlockedAddressError); !ok {
+		t.Fatal("expected blocked address", err)
+	}
+
+	// Literal addresses are checked too.
+	_, err = NewClient(d).Get(serv.URL)
+	var blockedErr *BlockedAddressError
+	if !errors.As(err, &blockedErr) {
+		t.Fatal("expected blocked address", err)
+	}
+}
+
+func TestDialerAllowsCustomIP(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte("good")); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer serv.Close()
+	servURL, err := url.Parse(serv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var lookups []string
+	d := &Dialer{
+		LookupIPAddr: func(ctx context.Context, host string) ([]net.IPAddr, error) {
+			lookups = append(lookups, host)
+			return []net.IPAddr{{IP: net.ParseIP(servURL.Hostname())}}, nil
+		},
+		AllowIP: func(ip net.IP) bool {
+			return ip.IsLoopback()
+		},
+	}
+	resp, err := NewClient(d).Get("http://pics.example:" + servURL.Port() + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(data), "good"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(lookups) != 1 || lookups[0] != "pics.example" {
+		t.Error("bad lookups", lookups)
+	}
+}
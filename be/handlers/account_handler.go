@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"os"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleDeleteAccount(ctx context.Context, req *api.DeleteAccountRequest) (
+	*api.DeleteAccountResponse, status.S) {
+	var task = &tasks.DeleteAccountTask{
+		PixPath: s.pixpath,
+		Beg:     s.db,
+		Now:     s.now,
+		Remove:  os.Remove,
+		Secret:  req.Secret,
+		Code:    req.Code,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.DeleteAccountResponse{}, nil
+}
+
+func (s *serv) handleExportMyData(
+	req *api.ExportMyDataRequest, emds api.PixurService_ExportMyDataServer) status.S {
+	ctx := emds.Context()
+
+	var task = &tasks.ExportMyDataTask{
+		Beg: s.db,
+		Now: s.now,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return sts
+	}
+
+	// Keep the first send error, and skip the rest of the records after it.
+	var sendErr error
+	add := func(resp *api.ExportMyDataResponse) {
+		if sendErr == nil {
+			sendErr = emds.Send(resp)
+		}
+	}
+	add(&api.ExportMyDataResponse{
+		Record: &api.ExportMyDataResponse_User{User: apiUser(task.User)},
+	})
+	for _, ut := range task.User.UserToken {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_Session{Session: apiSession(ut, 0)},
+		})
+	}
+	for _, p := range task.Uploads {
+		for _, src := range p.Source {
+			add(&api.ExportMyDataResponse{
+				Record: &api.ExportMyDataResponse_Upload_{
+					Upload: &api.ExportMyDataResponse_Upload{
+						PicId: p.GetVarPicId(),
+						Source: &api.PicSource{
+							Name:     src.Name,
+							Url:      src.Url,
+							Referrer: src.Referrer,
+						},
+						CreatedTime: src.CreatedTs,
+					},
+				},
+			})
+		}
+	}
+	for _, pt := range task.PicTags {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_PicTag{PicTag: apiPicTag(pt)},
+		})
+	}
+	for _, pc := range task.PicComments {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_PicComment{PicComment: apiPicComment(pc)},
+		})
+	}
+	for _, pv := range task.PicVotes {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_PicVote{PicVote: apiPicVote(pv)},
+		})
+	}
+	for _, pcv := range task.PicCommentVotes {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_PicCommentVote{PicCommentVote: apiPicCommentVote(pcv)},
+		})
+	}
+	for _, ue := range task.UserEvents {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_UserEvent{UserEvent: apiUserEvent(ue, nil)},
+		})
+	}
+	for _, ak := range task.ApiKeys {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_ApiKey{ApiKey: apiApiKey(ak)},
+		})
+	}
+	for _, pf := range task.PicFavorites {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_Favorite_{
+				Favorite: &api.ExportMyDataResponse_Favorite{
+					PicId:       schema.Varint(pf.PicId).Encode(),
+					CreatedTime: pf.CreatedTs,
+				},
+			},
+		})
+	}
+	for _, c := range task.Collections {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_Collection{Collection: apiCollection(c)},
+		})
+	}
+	for _, cp := range task.CollectionPics {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_CollectionPic_{
+				CollectionPic: &api.ExportMyDataResponse_CollectionPic{
+					CollectionId: schema.Varint(cp.CollectionId).Encode(),
+					PicId:        schema.Varint(cp.PicId).Encode(),
+					CreatedTime:  cp.CreatedTs,
+				},
+			},
+		})
+	}
+	for _, uf := range task.UserFollows {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_UserFollow_{
+				UserFollow: &api.ExportMyDataResponse_UserFollow{
+					FolloweeUserId: schema.Varint(uf.FolloweeUserId).Encode(),
+					CreatedTime:    uf.CreatedTs,
+				},
+			},
+		})
+	}
+	for _, tf := range task.TagFollows {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_TagFollow_{
+				TagFollow: &api.ExportMyDataResponse_TagFollow{
+					Tag:         tf.TagName,
+					CreatedTime: tf.CreatedTs,
+				},
+			},
+		})
+	}
+	for _, us := range task.UploadSessions {
+		add(&api.ExportMyDataResponse{
+			Record: &api.ExportMyDataResponse_UploadSession_{
+				UploadSession: &api.ExportMyDataResponse_UploadSession{
+					UploadSessionId: schema.Varint(us.UploadSessionId).Encode(),
+					Source: &api.PicSource{
+						Name:     us.Name,
+						Url:      us.Url,
+						Referrer: us.Referrer,
+					},
+					CreatedTime:  us.CreatedTs,
+					ModifiedTime: us.ModifiedTs,
+				},
+			},
+		})
+	}
+
+	if sendErr != nil {
+		return status.Unavailable(sendErr, "can't send export")
+	}
+	return nil
+}
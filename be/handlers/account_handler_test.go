@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+type testExportMyDataServer struct {
+	grpc.ServerStream
+	ctx   context.Context
+	resps []*api.ExportMyDataResponse
+}
+
+func (s *testExportMyDataServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testExportMyDataServer) Send(resp *api.ExportMyDataResponse) error {
+	s.resps = append(s.resps, resp)
+	return nil
+}
+
+func TestDeleteAccount(t *testing.T) {
+	var taskCap *tasks.DeleteAccountTask
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			taskCap = task.(*tasks.DeleteAccountTask)
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleDeleteAccount(context.Background(), &api.DeleteAccountRequest{
+		Secret: "secret",
+		Code:   "123456",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Secret != "secret" || taskCap.Code != "123456" {
+		t.Error("bad task", taskCap)
+	}
+	if taskCap.Remove == nil {
+		t.Error("deps are nil", taskCap)
+	}
+}
+
+func TestExportMyData(t *testing.T) {
+	now := time.Now()
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			taskCap := task.(*tasks.ExportMyDataTask)
+			taskCap.User = &schema.User{
+				UserId:     1,
+				ModifiedTs: schema.ToTspb(now),
+				UserToken: []*schema.UserToken{{
+					TokenId: 2,
+				}},
+			}
+			taskCap.Uploads = []*schema.Pic{{
+				PicId: 3,
+				Source: []*schema.Pic_FileSource{{
+					UserId: 1,
+					Url:    "http://example.com/cat.png",
+				}},
+			}}
+			taskCap.PicComments = []*schema.PicComment{{
+				PicId:      3,
+				CommentId:  4,
+				UserId:     1,
+				Text:       "meow",
+				CreatedTs:  schema.ToTspb(now),
+				ModifiedTs: schema.ToTspb(now),
+			}}
+			taskCap.ApiKeys = []*schema.ApiKey{{
+				ApiKeyId: 5,
+				UserId:   1,
+				Name:     "bot",
+			}}
+			taskCap.UploadSessions = []*schema.UploadSession{{
+				UploadSessionId: 6,
+				UserId:          1,
+				Name:            "cat.png",
+				CreatedTs:       schema.ToTspb(now),
+				ModifiedTs:      schema.ToTspb(now),
+			}}
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	ss := &testExportMyDataServer{ctx: context.Background()}
+	if sts := s.handleExportMyData(&api.ExportMyDataRequest{}, ss); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(ss.resps) != 6 {
+		t.Fatal("wrong number of records", ss.resps)
+	}
+	if have, want := ss.resps[0].GetUser().GetUserId(), schema.Varint(1).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := ss.resps[1].GetSession().GetSessionId(), schema.Varint(2).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	wantUpload := &api.ExportMyDataResponse_Upload{
+		PicId: schema.Varint(3).Encode(),
+		Source: &api.PicSource{
+			Url: "http://example.com/cat.png",
+		},
+	}
+	if have := ss.resps[2].GetUpload(); !proto.Equal(have, wantUpload) {
+		t.Error("have", have, "want", wantUpload)
+	}
+	if have, want := ss.resps[3].GetPicComment().GetText(), "meow"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	wantApiKey := &api.ApiKey{
+		ApiKeyId: schema.Varint(5).Encode(),
+		UserId:   schema.Varint(1).Encode(),
+		Name:     "bot",
+	}
+	if have := ss.resps[4].GetApiKey(); !proto.Equal(have, wantApiKey) {
+		t.Error("have", have, "want", wantApiKey)
+	}
+	wantUploadSession := &api.ExportMyDataResponse_UploadSession{
+		UploadSessionId: schema.Varint(6).Encode(),
+		Source: &api.PicSource{
+			Name: "cat.png",
+		},
+		CreatedTime:  schema.ToTspb(now),
+		ModifiedTime: schema.ToTspb(now),
+	}
+	if have := ss.resps[5].GetUploadSession(); !proto.Equal(have, wantUploadSession) {
+		t.Error("have", have, "want", wantUploadSession)
+	}
+}
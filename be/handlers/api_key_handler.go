@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleCreateApiKey(ctx context.Context, req *api.CreateApiKeyRequest) (
+	*api.CreateApiKeyResponse, status.S) {
+	var expiry time.Duration
+	if req.Expiry != nil {
+		var err error
+		if expiry, err = ptypes.Duration(req.Expiry); err != nil || expiry <= 0 {
+			return nil, status.InvalidArgument(err, "bad expiry")
+		}
+	}
+	var caps []schema.User_Capability
+	for _, c := range req.Capability {
+		if _, ok := apischemacapmap[c]; !ok || c == api.Capability_UNKNOWN {
+			return nil, status.InvalidArgumentf(nil, "unknown cap %v", c)
+		}
+		caps = append(caps, apischemacapmap[c])
+	}
+
+	var task = &tasks.CreateApiKeyTask{
+		Beg:        s.db,
+		Now:        s.now,
+		Rand:       s.rand,
+		Name:       req.Name,
+		Expiry:     expiry,
+		Capability: caps,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.CreateApiKeyResponse{
+		ApiKey: apiApiKey(task.CreatedApiKey),
+		Key:    task.Key,
+	}, nil
+}
+
+func (s *serv) handleFindApiKeys(ctx context.Context, req *api.FindApiKeysRequest) (
+	*api.FindApiKeysResponse, status.S) {
+	var task = &tasks.FindApiKeysTask{
+		Beg: s.db,
+		Now: s.now,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.FindApiKeysResponse{}
+	for _, ak := range task.ApiKeys {
+		resp.ApiKey = append(resp.ApiKey, apiApiKey(ak))
+	}
+	return resp, nil
+}
+
+func (s *serv) handleDeleteApiKey(ctx context.Context, req *api.DeleteApiKeyRequest) (
+	*api.DeleteApiKeyResponse, status.S) {
+	var apiKeyId schema.Varint
+	if err := apiKeyId.DecodeAll(req.ApiKeyId); err != nil {
+		return nil, status.InvalidArgument(err, "bad api key id")
+	}
+
+	var task = &tasks.DeleteApiKeyTask{
+		Beg:      s.db,
+		Now:      s.now,
+		ApiKeyId: int64(apiKeyId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.DeleteApiKeyResponse{}, nil
+}
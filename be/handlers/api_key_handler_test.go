@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestCreateApiKeyFailsOnBadExpiry(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Error("task should not run")
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleCreateApiKey(context.Background(), &api.CreateApiKeyRequest{
+		Name:   "bot",
+		Expiry: ptypes.DurationProto(-time.Second),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateApiKey(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.CreateApiKeyTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.CreateApiKeyTask)
+		ak := &schema.ApiKey{
+			ApiKeyId:   3,
+			UserId:     4,
+			Name:       taskCap.Name,
+			Capability: taskCap.Capability,
+		}
+		ak.SetCreatedTime(now)
+		ak.SetModifiedTime(now)
+		taskCap.CreatedApiKey = ak
+		taskCap.Key = "key"
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleCreateApiKey(context.Background(), &api.CreateApiKeyRequest{
+		Name:       "bot",
+		Expiry:     ptypes.DurationProto(time.Hour),
+		Capability: []api.Capability_Cap{api.Capability_PIC_CREATE},
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Expiry != time.Hour || taskCap.Name != "bot" {
+		t.Error("bad task", taskCap)
+	}
+
+	want := &api.CreateApiKeyResponse{
+		Key: "key",
+		ApiKey: &api.ApiKey{
+			ApiKeyId:    schema.Varint(3).Encode(),
+			UserId:      schema.Varint(4).Encode(),
+			Name:        "bot",
+			CreatedTime: schema.ToTspb(now),
+			Capability:  []api.Capability_Cap{api.Capability_PIC_CREATE},
+		},
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+}
+
+func TestFindApiKeys(t *testing.T) {
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		task.(*tasks.FindApiKeysTask).ApiKeys = []*schema.ApiKey{{ApiKeyId: 3}}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleFindApiKeys(context.Background(), &api.FindApiKeysRequest{})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if len(resp.ApiKey) != 1 || resp.ApiKey[0].ApiKeyId != schema.Varint(3).Encode() {
+		t.Error("bad response", resp)
+	}
+}
+
+func TestDeleteApiKeyFailsOnBadId(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Error("task should not run")
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleDeleteApiKey(context.Background(), &api.DeleteApiKeyRequest{
+		ApiKeyId: "bogus!",
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeleteApiKey(t *testing.T) {
+	var taskCap *tasks.DeleteApiKeyTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.DeleteApiKeyTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleDeleteApiKey(context.Background(), &api.DeleteApiKeyRequest{
+		ApiKeyId: schema.Varint(3).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.ApiKeyId != 3 {
+		t.Error("bad task", taskCap)
+	}
+}
+
+func TestInterceptAddsApiKey(t *testing.T) {
+	md := metadata.Pairs(apiKeyHeaderKey, "key")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	var key string
+	_, err := (&serverInterceptor{}).intercept(ctx, &api.FindApiKeysRequest{}, nil,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			key, _ = tasks.ApiKeyFromCtx(ctx)
+			return nil, nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "key" {
+		t.Error("missing api key", key)
+	}
+}
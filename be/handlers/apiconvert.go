@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"github.com/golang/protobuf/ptypes"
 	wpb "github.com/golang/protobuf/ptypes/wrappers"
 
 	"pixur.org/pixur/api"
@@ -26,6 +27,7 @@ func apiPic(src *schema.Pic) *api.Pic {
 		CreatedTime:     src.CreatedTs,
 		ModifiedTime:    src.ModifiedTs,
 		File:            apiPicFile(src.PicId, false, src.File),
+		FavoriteCount:   src.FavoriteCount,
 	}
 	// hack to remove the 0 at the end of the id
 	dst.File.Id = dst.File.Id[:len(dst.File.Id)-1]
@@ -76,8 +78,19 @@ func apiPicAndThumbnails(dst []*api.PicAndThumbnail, srcs ...*schema.Pic) []*api
 
 func apiPicAndThumbnail(src *schema.Pic) *api.PicAndThumbnail {
 	return &api.PicAndThumbnail{
-		Pic:       apiPic(src),
-		Thumbnail: apiPicFiles(nil, src.PicId, true, src.Thumbnail...),
+		Pic:         apiPic(src),
+		Thumbnail:   apiPicFiles(nil, src.PicId, true, src.Thumbnail...),
+		Placeholder: apiPicPlaceholder(src.Placeholder),
+	}
+}
+
+func apiPicPlaceholder(src *schema.Pic_Placeholder) *api.PicPlaceholder {
+	if src == nil {
+		return nil
+	}
+	return &api.PicPlaceholder{
+		BlurHash:      src.BlurHash,
+		DominantColor: src.DominantColor,
 	}
 }
 
@@ -117,17 +130,33 @@ func apiPicComment(src *schema.PicComment) *api.PicComment {
 		CreatedTime:     src.CreatedTs,
 		ModifiedTime:    src.ModifiedTs,
 		Version:         src.Version(),
+		Deleted:         src.Deleted(),
 	}
 	if src.UserId != schema.AnonymousUserId {
 		dst.UserId = &wpb.StringValue{
 			Value: schema.Varint(src.UserId).Encode(),
 		}
 	}
+	if edited, ok := src.GetEditedTime(); ok {
+		dst.EditedTime = schema.ToTspb(edited)
+	}
+	for _, r := range src.Revision {
+		rev := &api.PicComment_Revision{
+			Text:         r.Text,
+			ReplacedTime: r.ReplacedTs,
+		}
+		if r.UserId != schema.AnonymousUserId {
+			rev.UserId = &wpb.StringValue{
+				Value: schema.Varint(r.UserId).Encode(),
+			}
+		}
+		dst.Revision = append(dst.Revision, rev)
+	}
 	return dst
 }
 
 func apiUser(src *schema.User) *api.User {
-	return &api.User{
+	dst := &api.User{
 		UserId:       schema.Varint(src.UserId).Encode(),
 		Ident:        src.Ident,
 		CreatedTime:  src.CreatedTs,
@@ -135,15 +164,147 @@ func apiUser(src *schema.User) *api.User {
 		LastSeenTime: src.LastSeenTs,
 		Version:      src.Version(),
 		Capability:   apiCaps(nil, src.Capability),
+		Role:         append([]string(nil), src.Role...),
+	}
+	if src.Invitation != nil {
+		dst.InviterUserId = schema.Varint(src.Invitation.InviterUserId).Encode()
+	}
+	dst.TotpEnrolled = src.TotpEnrolled()
+	if src.Suspension != nil {
+		dst.Suspension = &api.User_Suspension{
+			Reason:       src.Suspension.Reason,
+			IssuerUserId: schema.Varint(src.Suspension.IssuerUserId).Encode(),
+			StartTime:    src.Suspension.StartTs,
+			EndTime:      src.Suspension.EndTs,
+		}
+	}
+	if src.Profile != nil {
+		dst.Profile = apiUserProfile(src.Profile)
+	}
+	return dst
+}
+
+func apiUserProfile(src *schema.User_Profile) *api.UserProfile {
+	dst := &api.UserProfile{
+		DisplayName: src.DisplayName,
+		Bio:         src.Bio,
+	}
+	if src.AvatarPicId != 0 {
+		dst.AvatarPicId = schema.Varint(src.AvatarPicId).Encode()
+	}
+	return dst
+}
+
+func apiApiKey(src *schema.ApiKey) *api.ApiKey {
+	return &api.ApiKey{
+		ApiKeyId:     schema.Varint(src.ApiKeyId).Encode(),
+		UserId:       schema.Varint(src.UserId).Encode(),
+		Name:         src.Name,
+		CreatedTime:  src.CreatedTs,
+		ExpireTime:   src.ExpireTs,
+		LastUsedTime: src.LastUsedTs,
+		Capability:   apiCaps(nil, src.Capability),
+	}
+}
+
+func apiAuditLog(src *schema.AuditLog) *api.AuditLog {
+	dst := &api.AuditLog{
+		AuditLogId:  schema.Varint(src.AuditLogId).Encode(),
+		Action:      api.AuditLog_Action(api.AuditLog_Action_value[src.Action.String()]),
+		CreatedTime: src.CreatedTs,
+		Reason:      src.Reason,
+	}
+	if src.ActorUserId != 0 {
+		dst.ActorUserId = schema.Varint(src.ActorUserId).Encode()
+	}
+	if src.TargetUserId != 0 {
+		dst.TargetUserId = schema.Varint(src.TargetUserId).Encode()
+	}
+	if src.TargetPicId != 0 {
+		dst.TargetPicId = schema.Varint(src.TargetPicId).Encode()
+	}
+	if src.TargetCommentId != 0 {
+		dst.TargetCommentId = schema.Varint(src.TargetCommentId).Encode()
+	}
+	for _, c := range src.Change {
+		dst.Change = append(dst.Change, &api.AuditLog_Change{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+	return dst
+}
+
+func apiCollections(dst []*api.Collection, srcs ...*schema.Collection) []*api.Collection {
+	for _, src := range srcs {
+		dst = append(dst, apiCollection(src))
+	}
+	return dst
+}
+
+func apiCollection(src *schema.Collection) *api.Collection {
+	return &api.Collection{
+		CollectionId: schema.Varint(src.CollectionId).Encode(),
+		UserId:       schema.Varint(src.UserId).Encode(),
+		Name:         src.Name,
+		Visibility:   api.Collection_Visibility(src.Visibility),
+		Version:      src.Version(),
+		CreatedTime:  src.CreatedTs,
+		ModifiedTime: src.ModifiedTs,
+	}
+}
+
+func apiInviteCode(src *schema.InviteCode) *api.InviteCode {
+	return &api.InviteCode{
+		InviteCodeId:  schema.Varint(src.InviteCodeId).Encode(),
+		CreatorUserId: schema.Varint(src.CreatorUserId).Encode(),
+		CreatedTime:   src.CreatedTs,
+		ExpireTime:    src.ExpireTs,
+		MaxUses:       src.MaxUses,
+		Uses:          src.Uses,
+		Capability:    apiCaps(nil, src.Capability),
+	}
+}
+
+func apiPools(dst []*api.Pool, srcs ...*schema.Pool) []*api.Pool {
+	for _, src := range srcs {
+		dst = append(dst, apiPool(src))
+	}
+	return dst
+}
+
+func apiPool(src *schema.Pool) *api.Pool {
+	return &api.Pool{
+		PoolId:       schema.Varint(src.PoolId).Encode(),
+		Title:        src.Title,
+		Description:  src.Description,
+		CreatedTime:  src.CreatedTs,
+		ModifiedTime: src.ModifiedTs,
+	}
+}
+
+func apiSession(src *schema.UserToken, currentTokenId int64) *api.Session {
+	return &api.Session{
+		SessionId:    schema.Varint(src.TokenId).Encode(),
+		CreatedTime:  src.CreatedTs,
+		LastSeenTime: src.LastSeenTs,
+		UserAgent:    src.UserAgent,
+		RemoteAddr:   src.RemoteAddr,
+		Current:      src.TokenId == currentTokenId,
 	}
 }
 
 func apiPublicUserInfo(src *schema.User) *api.PublicUserInfo {
-	return &api.PublicUserInfo{
+	dst := &api.PublicUserInfo{
 		UserId:      schema.Varint(src.UserId).Encode(),
 		Ident:       src.Ident,
 		CreatedTime: src.CreatedTs,
 	}
+	if src.Profile != nil {
+		dst.Profile = apiUserProfile(src.Profile)
+	}
+	return dst
 }
 
 func apiCaps(dst []api.Capability_Cap, srcs []schema.User_Capability) []api.Capability_Cap {
@@ -230,6 +391,34 @@ func apiUserEvent(
 				PicId: schema.Varint(evt.UpsertPic.PicId).Encode(),
 			},
 		}
+	case *schema.UserEvent_LoginLockout_:
+		dst.Evt = &api.UserEvent_LoginLockout_{
+			LoginLockout: &api.UserEvent_LoginLockout{
+				RemoteAddr:      evt.LoginLockout.RemoteAddr,
+				LockedUntilTime: evt.LoginLockout.LockedUntilTs,
+			},
+		}
+	case *schema.UserEvent_Suspend_:
+		dst.Evt = &api.UserEvent_Suspend_{
+			Suspend: &api.UserEvent_Suspend{
+				Reason:  evt.Suspend.Reason,
+				EndTime: evt.Suspend.EndTs,
+			},
+		}
+	case *schema.UserEvent_Unsuspend_:
+		dst.Evt = &api.UserEvent_Unsuspend_{
+			Unsuspend: &api.UserEvent_Unsuspend{},
+		}
+	case *schema.UserEvent_IncomingFollow_:
+		var subjectUserId string
+		if evt.IncomingFollow.SubjectUserId != schema.AnonymousUserId {
+			subjectUserId = schema.Varint(evt.IncomingFollow.SubjectUserId).Encode()
+		}
+		dst.Evt = &api.UserEvent_IncomingFollow_{
+			IncomingFollow: &api.UserEvent_IncomingFollow{
+				SubjectUserId: subjectUserId,
+			},
+		}
 	}
 	return dst
 }
@@ -242,6 +431,22 @@ func apiUserEvents(dst []*api.UserEvent, srcs []*schema.UserEvent,
 	return dst
 }
 
+func apiUploadSession(
+	src *schema.UploadSession, offset int64, conf *schema.Configuration) *api.UploadSession {
+	dst := &api.UploadSession{
+		UploadSessionId: schema.Varint(src.UploadSessionId).Encode(),
+		Offset:          offset,
+		CreatedTime:     src.CreatedTs,
+		ModifiedTime:    src.ModifiedTs,
+	}
+	if conf.UploadSessionExpiry != nil {
+		if expiry, err := ptypes.Duration(conf.UploadSessionExpiry); err == nil {
+			dst.ExpireTime = schema.ToTspb(src.GetModifiedTime().Add(expiry))
+		}
+	}
+	return dst
+}
+
 // TODO: test this
 func apiConfig(src *schema.Configuration) *api.BackendConfiguration {
 	var anonymousCapability, newUserCapability *api.BackendConfiguration_CapabilitySet
@@ -255,6 +460,51 @@ func apiConfig(src *schema.Configuration) *api.BackendConfiguration {
 			Capability: apiCaps(nil, src.NewUserCapability.Capability),
 		}
 	}
+	var totpRequiredCapability *api.BackendConfiguration_CapabilitySet
+	if src.TotpRequiredCapability != nil {
+		totpRequiredCapability = &api.BackendConfiguration_CapabilitySet{
+			Capability: apiCaps(nil, src.TotpRequiredCapability.Capability),
+		}
+	}
+	var role *api.BackendConfiguration_RoleSet
+	if src.Role != nil {
+		role = &api.BackendConfiguration_RoleSet{
+			Role: make(map[string]*api.BackendConfiguration_CapabilitySet, len(src.Role.Role)),
+		}
+		for name, cs := range src.Role.Role {
+			role.Role[name] = &api.BackendConfiguration_CapabilitySet{
+				Capability: apiCaps(nil, cs.GetCapability()),
+			}
+		}
+	}
+	var rateLimit *api.BackendConfiguration_RateLimitSet
+	if src.RateLimit != nil {
+		rateLimit = &api.BackendConfiguration_RateLimitSet{
+			RateLimit: make(map[string]*api.BackendConfiguration_RateLimit, len(src.RateLimit.RateLimit)),
+		}
+		for name, rl := range src.RateLimit.RateLimit {
+			rateLimit.RateLimit[name] = &api.BackendConfiguration_RateLimit{
+				Burst:    rl.GetBurst(),
+				Interval: rl.GetInterval(),
+			}
+		}
+	}
+	var passwordHashPolicy *api.BackendConfiguration_PasswordHashPolicy
+	if src.PasswordHashPolicy != nil {
+		passwordHashPolicy = &api.BackendConfiguration_PasswordHashPolicy{
+			Algorithm:     api.BackendConfiguration_PasswordHashPolicy_Algorithm(src.PasswordHashPolicy.Algorithm),
+			BcryptCost:    src.PasswordHashPolicy.BcryptCost,
+			Argon2Time:    src.PasswordHashPolicy.Argon2Time,
+			Argon2Memory:  src.PasswordHashPolicy.Argon2Memory,
+			Argon2Threads: src.PasswordHashPolicy.Argon2Threads,
+		}
+	}
+	var remoteFetchContentType *api.BackendConfiguration_StringSet
+	if src.RemoteFetchContentType != nil {
+		remoteFetchContentType = &api.BackendConfiguration_StringSet{
+			Value: append([]string(nil), src.RemoteFetchContentType.Value...),
+		}
+	}
 
 	return &api.BackendConfiguration{
 		MinCommentLength:             src.MinCommentLength,
@@ -276,6 +526,28 @@ func apiConfig(src *schema.Configuration) *api.BackendConfiguration {
 		EnablePicCommentSiblingReply: src.EnablePicCommentSiblingReply,
 		DefaultFindUserEvents:        src.DefaultFindUserEvents,
 		MaxFindUserEvents:            src.MaxFindUserEvents,
+		UploadSessionExpiry:          src.UploadSessionExpiry,
+		MaxRemoteFetchRedirects:      src.MaxRemoteFetchRedirects,
+		MaxRemoteFetchSize:           src.MaxRemoteFetchSize,
+		RemoteFetchTimeout:           src.RemoteFetchTimeout,
+		RemoteFetchContentType:       remoteFetchContentType,
+		PasswordResetExpiry:          src.PasswordResetExpiry,
+		TotpRequiredCapability:       totpRequiredCapability,
+		Role:                         role,
+		RateLimit:                    rateLimit,
+		PasswordHashPolicy:           passwordHashPolicy,
+		DefaultFindAuditLogs:         src.DefaultFindAuditLogs,
+		MaxFindAuditLogs:             src.MaxFindAuditLogs,
+		PicCommentEditWindow:         src.PicCommentEditWindow,
+		MaxCollections:               src.MaxCollections,
+		MaxCollectionPics:            src.MaxCollectionPics,
+		MaxCollectionNameLength:      src.MaxCollectionNameLength,
+		MaxPoolPics:                  src.MaxPoolPics,
+		MaxPoolTitleLength:           src.MaxPoolTitleLength,
+		MaxPoolDescriptionLength:     src.MaxPoolDescriptionLength,
+		MaxFollows:                   src.MaxFollows,
+		MaxUploadStreamSize:          src.MaxUploadStreamSize,
+		MaxUploadSessions:            src.MaxUploadSessions,
 	}
 }
 
@@ -292,6 +564,51 @@ func beConfig(src *api.BackendConfiguration) *schema.Configuration {
 			Capability: beCaps(nil, src.NewUserCapability.Capability),
 		}
 	}
+	var totpRequiredCapability *schema.Configuration_CapabilitySet
+	if src.TotpRequiredCapability != nil {
+		totpRequiredCapability = &schema.Configuration_CapabilitySet{
+			Capability: beCaps(nil, src.TotpRequiredCapability.Capability),
+		}
+	}
+	var role *schema.Configuration_RoleSet
+	if src.Role != nil {
+		role = &schema.Configuration_RoleSet{
+			Role: make(map[string]*schema.Configuration_CapabilitySet, len(src.Role.Role)),
+		}
+		for name, cs := range src.Role.Role {
+			role.Role[name] = &schema.Configuration_CapabilitySet{
+				Capability: beCaps(nil, cs.GetCapability()),
+			}
+		}
+	}
+	var rateLimit *schema.Configuration_RateLimitSet
+	if src.RateLimit != nil {
+		rateLimit = &schema.Configuration_RateLimitSet{
+			RateLimit: make(map[string]*schema.Configuration_RateLimit, len(src.RateLimit.RateLimit)),
+		}
+		for name, rl := range src.RateLimit.RateLimit {
+			rateLimit.RateLimit[name] = &schema.Configuration_RateLimit{
+				Burst:    rl.GetBurst(),
+				Interval: rl.GetInterval(),
+			}
+		}
+	}
+	var passwordHashPolicy *schema.Configuration_PasswordHashPolicy
+	if src.PasswordHashPolicy != nil {
+		passwordHashPolicy = &schema.Configuration_PasswordHashPolicy{
+			Algorithm:     schema.Configuration_PasswordHashPolicy_Algorithm(src.PasswordHashPolicy.Algorithm),
+			BcryptCost:    src.PasswordHashPolicy.BcryptCost,
+			Argon2Time:    src.PasswordHashPolicy.Argon2Time,
+			Argon2Memory:  src.PasswordHashPolicy.Argon2Memory,
+			Argon2Threads: src.PasswordHashPolicy.Argon2Threads,
+		}
+	}
+	var remoteFetchContentType *schema.Configuration_StringSet
+	if src.RemoteFetchContentType != nil {
+		remoteFetchContentType = &schema.Configuration_StringSet{
+			Value: append([]string(nil), src.RemoteFetchContentType.Value...),
+		}
+	}
 
 	return &schema.Configuration{
 		MinCommentLength:             src.MinCommentLength,
@@ -313,6 +630,28 @@ func beConfig(src *api.BackendConfiguration) *schema.Configuration {
 		EnablePicCommentSiblingReply: src.EnablePicCommentSiblingReply,
 		DefaultFindUserEvents:        src.DefaultFindUserEvents,
 		MaxFindUserEvents:            src.MaxFindUserEvents,
+		UploadSessionExpiry:          src.UploadSessionExpiry,
+		MaxRemoteFetchRedirects:      src.MaxRemoteFetchRedirects,
+		MaxRemoteFetchSize:           src.MaxRemoteFetchSize,
+		RemoteFetchTimeout:           src.RemoteFetchTimeout,
+		RemoteFetchContentType:       remoteFetchContentType,
+		PasswordResetExpiry:          src.PasswordResetExpiry,
+		TotpRequiredCapability:       totpRequiredCapability,
+		Role:                         role,
+		RateLimit:                    rateLimit,
+		PasswordHashPolicy:           passwordHashPolicy,
+		DefaultFindAuditLogs:         src.DefaultFindAuditLogs,
+		MaxFindAuditLogs:             src.MaxFindAuditLogs,
+		PicCommentEditWindow:         src.PicCommentEditWindow,
+		MaxCollections:               src.MaxCollections,
+		MaxCollectionPics:            src.MaxCollectionPics,
+		MaxCollectionNameLength:      src.MaxCollectionNameLength,
+		MaxPoolPics:                  src.MaxPoolPics,
+		MaxPoolTitleLength:           src.MaxPoolTitleLength,
+		MaxPoolDescriptionLength:     src.MaxPoolDescriptionLength,
+		MaxFollows:                   src.MaxFollows,
+		MaxUploadStreamSize:          src.MaxUploadStreamSize,
+		MaxUploadSessions:            src.MaxUploadSessions,
 	}
 }
 
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleFindAuditLog(ctx context.Context, req *api.FindAuditLogRequest) (
+	*api.FindAuditLogResponse, status.S) {
+	var actorUserId, targetUserId, targetPicId, startAuditLogId schema.Varint
+	if req.ActorUserId != "" {
+		if err := actorUserId.DecodeAll(req.ActorUserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad actor user id")
+		}
+	}
+	if req.TargetUserId != "" {
+		if err := targetUserId.DecodeAll(req.TargetUserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad target user id")
+		}
+	}
+	if req.TargetPicId != "" {
+		if err := targetPicId.DecodeAll(req.TargetPicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad target pic id")
+		}
+	}
+	if req.StartAuditLogId != "" {
+		if err := startAuditLogId.DecodeAll(req.StartAuditLogId); err != nil {
+			return nil, status.InvalidArgument(err, "bad start audit log id")
+		}
+	}
+	action, ok := schema.AuditLog_Action_value[req.Action.String()]
+	if !ok {
+		return nil, status.InvalidArgument(nil, "unknown action", req.Action)
+	}
+
+	var task = &tasks.FindAuditLogTask{
+		Beg:             s.db,
+		Now:             s.now,
+		ActorUserId:     int64(actorUserId),
+		TargetUserId:    int64(targetUserId),
+		TargetPicId:     int64(targetPicId),
+		Action:          schema.AuditLog_Action(action),
+		StartAuditLogId: int64(startAuditLogId),
+		MaxAuditLogs:    req.MaxAuditLogs,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.FindAuditLogResponse{}
+	for _, al := range task.AuditLogs {
+		resp.AuditLog = append(resp.AuditLog, apiAuditLog(al))
+	}
+	if task.NextAuditLogId != 0 {
+		resp.NextAuditLogId = schema.Varint(task.NextAuditLogId).Encode()
+	}
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestFindAuditLogFailsOnBadIds(t *testing.T) {
+	cases := []struct {
+		req *api.FindAuditLogRequest
+		msg string
+	}{
+		{&api.FindAuditLogRequest{ActorUserId: "x"}, "bad actor user id"},
+		{&api.FindAuditLogRequest{TargetUserId: "x"}, "bad target user id"},
+		{&api.FindAuditLogRequest{TargetPicId: "x"}, "bad target pic id"},
+		{&api.FindAuditLogRequest{StartAuditLogId: "x"}, "bad start audit log id"},
+	}
+	for _, c := range cases {
+		s := &serv{}
+		_, sts := s.handleFindAuditLog(context.Background(), c.req)
+		if sts == nil {
+			t.Fatal("nil status", c.req)
+		}
+		if have, want := sts.Code(), codes.InvalidArgument; have != want {
+			t.Error("have", have, "want", want)
+		}
+		if have, want := sts.Message(), c.msg; !strings.Contains(have, want) {
+			t.Error("have", have, "want", want)
+		}
+	}
+}
+
+func TestFindAuditLogFailsOnUnknownAction(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleFindAuditLog(context.Background(), &api.FindAuditLogRequest{
+		Action: api.AuditLog_Action(-1),
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "unknown action"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindAuditLogFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.PermissionDenied(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleFindAuditLog(context.Background(), &api.FindAuditLogRequest{})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindAuditLog(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.FindAuditLogTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FindAuditLogTask)
+		al := &schema.AuditLog{
+			AuditLogId:   7,
+			Action:       schema.AuditLog_SUSPEND_USER,
+			ActorUserId:  2,
+			TargetUserId: 3,
+			Reason:       "spam",
+			Change: []*schema.AuditLog_Change{{
+				Field: "suspension",
+				After: "suspension:<>",
+			}},
+		}
+		al.SetCreatedTime(now)
+		taskCap.AuditLogs = []*schema.AuditLog{al}
+		taskCap.NextAuditLogId = 6
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleFindAuditLog(context.Background(), &api.FindAuditLogRequest{
+		ActorUserId:     schema.Varint(2).Encode(),
+		TargetUserId:    schema.Varint(3).Encode(),
+		TargetPicId:     schema.Varint(4).Encode(),
+		StartAuditLogId: schema.Varint(8).Encode(),
+		Action:          api.AuditLog_SUSPEND_USER,
+		MaxAuditLogs:    10,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.ActorUserId != 2 || taskCap.TargetUserId != 3 || taskCap.TargetPicId != 4 ||
+		taskCap.StartAuditLogId != 8 || taskCap.MaxAuditLogs != 10 ||
+		taskCap.Action != schema.AuditLog_SUSPEND_USER {
+		t.Error("bad task", taskCap)
+	}
+	want := &api.FindAuditLogResponse{
+		AuditLog: []*api.AuditLog{{
+			AuditLogId:   schema.Varint(7).Encode(),
+			Action:       api.AuditLog_SUSPEND_USER,
+			ActorUserId:  schema.Varint(2).Encode(),
+			TargetUserId: schema.Varint(3).Encode(),
+			CreatedTime:  schema.ToTspb(now),
+			Reason:       "spam",
+			Change: []*api.AuditLog_Change{{
+				Field: "suspension",
+				After: "suspension:<>",
+			}},
+		}},
+		NextAuditLogId: schema.Varint(6).Encode(),
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+}
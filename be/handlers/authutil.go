@@ -19,6 +19,14 @@ func authTokenFromMD(md metadata.MD) (string, bool) {
 	return tokens[0], true
 }
 
+func apiKeyFromMD(md metadata.MD) (string, bool) {
+	keys, ok := md[apiKeyHeaderKey]
+	if !ok || len(keys) != 1 {
+		return "", false
+	}
+	return keys[0], true
+}
+
 func fillUserIdAndTokenFromCtx(ctx context.Context) (context.Context, status.S) {
 	if token, ok := tasks.AuthTokenFromCtx(ctx); ok {
 		payload, sts := decodeAuthToken(token)
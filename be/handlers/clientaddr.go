@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// clientAddr is the address of who made a call.
+type clientAddr struct {
+	// host is the address of the client, without the port.  It may be empty if unknown.
+	host string
+	// forwarded is true if host was forwarded by a trusted proxy, such as the frontend, on behalf of
+	// its own client.  Otherwise, host is the peer of the call.
+	forwarded bool
+}
+
+// defaultTrustedProxies are trusted if none are configured.  The frontend usually runs on the same
+// host, or in the same process, and calls on behalf of all its users over loopback.
+var defaultTrustedProxies = []*net.IPNet{
+	{IP: net.IPv4(127, 0, 0, 0).To4(), Mask: net.CIDRMask(8, 8*net.IPv4len)},
+	{IP: net.IPv6loopback, Mask: net.CIDRMask(8*net.IPv6len, 8*net.IPv6len)},
+}
+
+func trustedProxiesOrDefault(trustedProxies []*net.IPNet) []*net.IPNet {
+	if trustedProxies == nil {
+		return defaultTrustedProxies
+	}
+	return trustedProxies
+}
+
+// clientAddrKey is a context key for the clientAddr of a call.
+type clientAddrKey struct{}
+
+func ctxFromClientAddr(ctx context.Context, ca clientAddr) context.Context {
+	return context.WithValue(ctx, clientAddrKey{}, ca)
+}
+
+func clientAddrFromCtx(ctx context.Context) (ca clientAddr, ok bool) {
+	ca, ok = ctx.Value(clientAddrKey{}).(clientAddr)
+	return
+}
+
+// findClientAddr finds who made the call in ctx.  Any caller can set the forwarded address in the
+// metadata, so it is only used if the peer is one of the trusted proxies.
+func findClientAddr(ctx context.Context, trustedProxies []*net.IPNet) clientAddr {
+	var peerHost string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerHost = normalizeHost(p.Addr.String())
+	}
+	if !isTrustedProxy(peerHost, trustedProxies) {
+		return clientAddr{host: peerHost}
+	}
+	if md, present := metadata.FromIncomingContext(ctx); present {
+		if addrs := md[clientAddrHeaderKey]; len(addrs) == 1 {
+			if ip := net.ParseIP(addrs[0]); ip != nil {
+				return clientAddr{host: ip.String(), forwarded: true}
+			}
+		}
+	}
+	return clientAddr{host: peerHost}
+}
+
+func isTrustedProxy(host string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, n := range trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeHost removes the port from addr, and formats it the same way as other ips.
+func normalizeHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String()
+	}
+	return host
+}
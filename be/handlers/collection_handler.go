@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func decodeCollectionId(raw string) (int64, status.S) {
+	var collectionId schema.Varint
+	if raw != "" {
+		if err := collectionId.DecodeAll(raw); err != nil {
+			return 0, status.InvalidArgument(err, "bad collection id")
+		}
+	}
+	return int64(collectionId), nil
+}
+
+func (s *serv) handleCreateCollection(ctx context.Context, req *api.CreateCollectionRequest) (
+	*api.CreateCollectionResponse, status.S) {
+	var task = &tasks.CreateCollectionTask{
+		Beg:        s.db,
+		Now:        s.now,
+		Name:       req.Name,
+		Visibility: schema.Collection_Visibility(req.Visibility),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.CreateCollectionResponse{
+		Collection: apiCollection(task.Collection),
+	}, nil
+}
+
+func (s *serv) handleUpdateCollection(ctx context.Context, req *api.UpdateCollectionRequest) (
+	*api.UpdateCollectionResponse, status.S) {
+	collectionId, sts := decodeCollectionId(req.CollectionId)
+	if sts != nil {
+		return nil, sts
+	}
+
+	var task = &tasks.UpdateCollectionTask{
+		Beg:          s.db,
+		Now:          s.now,
+		CollectionId: collectionId,
+		Version:      req.Version,
+		Name:         req.Name,
+		Visibility:   schema.Collection_Visibility(req.Visibility),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.UpdateCollectionResponse{
+		Collection: apiCollection(task.Collection),
+	}, nil
+}
+
+func (s *serv) handleDeleteCollection(ctx context.Context, req *api.DeleteCollectionRequest) (
+	*api.DeleteCollectionResponse, status.S) {
+	collectionId, sts := decodeCollectionId(req.CollectionId)
+	if sts != nil {
+		return nil, sts
+	}
+
+	var task = &tasks.DeleteCollectionTask{
+		Beg:          s.db,
+		Now:          s.now,
+		CollectionId: collectionId,
+		Version:      req.Version,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.DeleteCollectionResponse{}, nil
+}
+
+func (s *serv) handleFindCollections(ctx context.Context, req *api.FindCollectionsRequest) (
+	*api.FindCollectionsResponse, status.S) {
+	var userId schema.Varint
+	if req.UserId != "" {
+		if err := userId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+
+	var task = &tasks.FindCollectionsTask{
+		Beg:    s.db,
+		Now:    s.now,
+		UserId: int64(userId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.FindCollectionsResponse{
+		Collection: apiCollections(nil, task.Collections...),
+	}, nil
+}
+
+func (s *serv) handleLookupCollection(ctx context.Context, req *api.LookupCollectionRequest) (
+	*api.LookupCollectionResponse, status.S) {
+	collectionId, sts := decodeCollectionId(req.CollectionId)
+	if sts != nil {
+		return nil, sts
+	}
+
+	var task = &tasks.LookupCollectionTask{
+		Beg:          s.db,
+		Now:          s.now,
+		CollectionId: collectionId,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.LookupCollectionResponse{
+		Collection: apiCollection(task.Collection),
+		Pic:        apiPicAndThumbnails(nil, task.Pics...),
+	}, nil
+}
+
+func (s *serv) handleAddCollectionPic(ctx context.Context, req *api.AddCollectionPicRequest) (
+	*api.AddCollectionPicResponse, status.S) {
+	collectionId, sts := decodeCollectionId(req.CollectionId)
+	if sts != nil {
+		return nil, sts
+	}
+	var picId schema.Varint
+	if req.PicId != "" {
+		if err := picId.DecodeAll(req.PicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+
+	var task = &tasks.AddCollectionPicTask{
+		Beg:          s.db,
+		Now:          s.now,
+		CollectionId: collectionId,
+		PicId:        int64(picId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.AddCollectionPicResponse{
+		Collection: apiCollection(task.Collection),
+	}, nil
+}
+
+func (s *serv) handleRemoveCollectionPic(ctx context.Context, req *api.RemoveCollectionPicRequest) (
+	*api.RemoveCollectionPicResponse, status.S) {
+	collectionId, sts := decodeCollectionId(req.CollectionId)
+	if sts != nil {
+		return nil, sts
+	}
+	var picId schema.Varint
+	if req.PicId != "" {
+		if err := picId.DecodeAll(req.PicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+
+	var task = &tasks.RemoveCollectionPicTask{
+		Beg:          s.db,
+		Now:          s.now,
+		CollectionId: collectionId,
+		PicId:        int64(picId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.RemoveCollectionPicResponse{
+		Collection: apiCollection(task.Collection),
+	}, nil
+}
+
+func (s *serv) handleReorderCollection(ctx context.Context, req *api.ReorderCollectionRequest) (
+	*api.ReorderCollectionResponse, status.S) {
+	collectionId, sts := decodeCollectionId(req.CollectionId)
+	if sts != nil {
+		return nil, sts
+	}
+	picIds := make([]int64, 0, len(req.PicId))
+	for _, raw := range req.PicId {
+		var picId schema.Varint
+		if err := picId.DecodeAll(raw); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+		picIds = append(picIds, int64(picId))
+	}
+
+	var task = &tasks.ReorderCollectionTask{
+		Beg:          s.db,
+		Now:          s.now,
+		CollectionId: collectionId,
+		PicIds:       picIds,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.ReorderCollectionResponse{
+		Collection: apiCollection(task.Collection),
+	}, nil
+}
@@ -0,0 +1,459 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func testCollection(now time.Time) *schema.Collection {
+	c := &schema.Collection{
+		CollectionId: 2,
+		UserId:       5,
+		Name:         "cats",
+		Visibility:   schema.Collection_PUBLIC,
+	}
+	c.SetCreatedTime(now)
+	c.SetModifiedTime(now)
+	return c
+}
+
+func TestCreateCollectionFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.ResourceExhausted(nil, "too many collections")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleCreateCollection(context.Background(), &api.CreateCollectionRequest{
+		Name: "cats",
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "too many collections"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateCollection(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.CreateCollectionTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.CreateCollectionTask)
+		taskCap.Collection = testCollection(now)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleCreateCollection(context.Background(), &api.CreateCollectionRequest{
+		Name:       "cats",
+		Visibility: api.Collection_PUBLIC,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.Name != "cats" || taskCap.Visibility != schema.Collection_PUBLIC {
+		t.Error("bad task inputs", taskCap)
+	}
+	if taskCap.Now == nil {
+		t.Error("deps are nil", taskCap)
+	}
+	want := &api.CreateCollectionResponse{
+		Collection: &api.Collection{
+			CollectionId: schema.Varint(2).Encode(),
+			UserId:       schema.Varint(5).Encode(),
+			Name:         "cats",
+			Visibility:   api.Collection_PUBLIC,
+			Version:      taskCap.Collection.Version(),
+			CreatedTime:  taskCap.Collection.CreatedTs,
+			ModifiedTime: taskCap.Collection.ModifiedTs,
+		},
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+}
+
+func TestUpdateCollectionFailsOnBadCollectionId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleUpdateCollection(context.Background(), &api.UpdateCollectionRequest{
+		CollectionId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad collection id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateCollectionFailsOnVersionMismatch(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Aborted(nil, "version mismatch")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleUpdateCollection(context.Background(), &api.UpdateCollectionRequest{
+		CollectionId: schema.Varint(2).Encode(),
+		Version:      3,
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Aborted; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateCollection(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.UpdateCollectionTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.UpdateCollectionTask)
+		taskCap.Collection = testCollection(now)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleUpdateCollection(context.Background(), &api.UpdateCollectionRequest{
+		CollectionId: schema.Varint(2).Encode(),
+		Version:      3,
+		Name:         "cats",
+		Visibility:   api.Collection_PRIVATE,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.CollectionId != 2 || taskCap.Version != 3 || taskCap.Name != "cats" ||
+		taskCap.Visibility != schema.Collection_PRIVATE {
+		t.Error("bad task inputs", taskCap)
+	}
+	if have, want := resp.Collection.CollectionId, schema.Varint(2).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeleteCollectionFailsOnBadCollectionId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleDeleteCollection(context.Background(), &api.DeleteCollectionRequest{
+		CollectionId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeleteCollection(t *testing.T) {
+	var taskCap *tasks.DeleteCollectionTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.DeleteCollectionTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleDeleteCollection(context.Background(), &api.DeleteCollectionRequest{
+		CollectionId: schema.Varint(2).Encode(),
+		Version:      3,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.CollectionId != 2 || taskCap.Version != 3 {
+		t.Error("bad task inputs", taskCap)
+	}
+	if resp == nil {
+		t.Error("bad response")
+	}
+}
+
+func TestFindCollectionsFailsOnBadUserId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleFindCollections(context.Background(), &api.FindCollectionsRequest{
+		UserId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad user id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindCollections(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.FindCollectionsTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FindCollectionsTask)
+		taskCap.Collections = []*schema.Collection{testCollection(now)}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleFindCollections(context.Background(), &api.FindCollectionsRequest{
+		UserId: schema.Varint(5).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.UserId, int64(5); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(resp.Collection) != 1 || resp.Collection[0].Name != "cats" {
+		t.Error("bad collections", resp.Collection)
+	}
+}
+
+func TestLookupCollectionFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.NotFound(nil, "can't find collection")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleLookupCollection(context.Background(), &api.LookupCollectionRequest{
+		CollectionId: schema.Varint(2).Encode(),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLookupCollection(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.LookupCollectionTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.LookupCollectionTask)
+		taskCap.Collection = testCollection(now)
+		var pics []*schema.Pic
+		for _, id := range []int64{4, 3} {
+			p := &schema.Pic{
+				PicId: id,
+				File: &schema.Pic_File{
+					Mime: schema.Pic_File_JPEG,
+				},
+			}
+			p.SetCreatedTime(now)
+			p.SetModifiedTime(now)
+			pics = append(pics, p)
+		}
+		taskCap.Pics = pics
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleLookupCollection(context.Background(), &api.LookupCollectionRequest{
+		CollectionId: schema.Varint(2).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := taskCap.CollectionId, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := resp.Collection.Name, "cats"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	// Pics keep the collection order.
+	if len(resp.Pic) != 2 || resp.Pic[0].Pic.Id != schema.Varint(4).Encode() ||
+		resp.Pic[1].Pic.Id != schema.Varint(3).Encode() {
+		t.Error("bad pics", resp.Pic)
+	}
+}
+
+func TestAddCollectionPicFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleAddCollectionPic(context.Background(), &api.AddCollectionPicRequest{
+		CollectionId: schema.Varint(2).Encode(),
+		PicId:        "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddCollectionPicFailsOnAlreadyPresent(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.AlreadyExists(nil, "pic already in collection")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleAddCollectionPic(context.Background(), &api.AddCollectionPicRequest{
+		CollectionId: schema.Varint(2).Encode(),
+		PicId:        schema.Varint(3).Encode(),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.AlreadyExists; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddCollectionPic(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.AddCollectionPicTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.AddCollectionPicTask)
+		taskCap.Collection = testCollection(now)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleAddCollectionPic(context.Background(), &api.AddCollectionPicRequest{
+		CollectionId: schema.Varint(2).Encode(),
+		PicId:        schema.Varint(3).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.CollectionId != 2 || taskCap.PicId != 3 {
+		t.Error("bad task inputs", taskCap)
+	}
+	if resp.Collection == nil {
+		t.Error("missing collection", resp)
+	}
+}
+
+func TestRemoveCollectionPic(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.RemoveCollectionPicTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RemoveCollectionPicTask)
+		taskCap.Collection = testCollection(now)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleRemoveCollectionPic(context.Background(), &api.RemoveCollectionPicRequest{
+		CollectionId: schema.Varint(2).Encode(),
+		PicId:        schema.Varint(3).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.CollectionId != 2 || taskCap.PicId != 3 {
+		t.Error("bad task inputs", taskCap)
+	}
+	if resp.Collection == nil {
+		t.Error("missing collection", resp)
+	}
+}
+
+func TestReorderCollectionFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleReorderCollection(context.Background(), &api.ReorderCollectionRequest{
+		CollectionId: schema.Varint(2).Encode(),
+		PicId:        []string{schema.Varint(3).Encode(), "x"},
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestReorderCollection(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.ReorderCollectionTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.ReorderCollectionTask)
+		taskCap.Collection = testCollection(now)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleReorderCollection(context.Background(), &api.ReorderCollectionRequest{
+		CollectionId: schema.Varint(2).Encode(),
+		PicId:        []string{schema.Varint(4).Encode(), schema.Varint(3).Encode()},
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.CollectionId, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(taskCap.PicIds) != 2 || taskCap.PicIds[0] != 4 || taskCap.PicIds[1] != 3 {
+		t.Error("bad pic ids", taskCap.PicIds)
+	}
+	if resp.Collection == nil {
+		t.Error("missing collection", resp)
+	}
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleCreateInviteCode(ctx context.Context, req *api.CreateInviteCodeRequest) (
+	*api.CreateInviteCodeResponse, status.S) {
+	var expiry time.Duration
+	if req.Expiry != nil {
+		var err error
+		if expiry, err = ptypes.Duration(req.Expiry); err != nil || expiry <= 0 {
+			return nil, status.InvalidArgument(err, "bad expiry")
+		}
+	}
+	var caps []schema.User_Capability
+	for _, c := range req.Capability {
+		if _, ok := apischemacapmap[c]; !ok || c == api.Capability_UNKNOWN {
+			return nil, status.InvalidArgumentf(nil, "unknown cap %v", c)
+		}
+		caps = append(caps, apischemacapmap[c])
+	}
+
+	var task = &tasks.CreateInviteCodeTask{
+		Beg:        s.db,
+		Now:        s.now,
+		Rand:       s.rand,
+		Expiry:     expiry,
+		MaxUses:    req.MaxUses,
+		Capability: caps,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.CreateInviteCodeResponse{
+		Code:       task.Code,
+		InviteCode: apiInviteCode(task.CreatedInviteCode),
+	}, nil
+}
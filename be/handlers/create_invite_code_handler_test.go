@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestCreateInviteCodeFailsOnBadExpiry(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Error("task should not run")
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleCreateInviteCode(context.Background(), &api.CreateInviteCodeRequest{
+		Expiry:  ptypes.DurationProto(-time.Second),
+		MaxUses: 1,
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateInviteCodeFailsOnBadCap(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Error("task should not run")
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleCreateInviteCode(context.Background(), &api.CreateInviteCodeRequest{
+		MaxUses:    1,
+		Capability: []api.Capability_Cap{api.Capability_UNKNOWN},
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateInviteCode(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.CreateInviteCodeTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.CreateInviteCodeTask)
+		ic := &schema.InviteCode{
+			InviteCodeId:  3,
+			CreatorUserId: 4,
+			MaxUses:       taskCap.MaxUses,
+			Capability:    taskCap.Capability,
+		}
+		ic.SetCreatedTime(now)
+		ic.SetModifiedTime(now)
+		taskCap.CreatedInviteCode = ic
+		taskCap.Code = "code"
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleCreateInviteCode(context.Background(), &api.CreateInviteCodeRequest{
+		Expiry:     ptypes.DurationProto(time.Hour),
+		MaxUses:    2,
+		Capability: []api.Capability_Cap{api.Capability_PIC_CREATE},
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Expiry != time.Hour || taskCap.MaxUses != 2 {
+		t.Error("bad task", taskCap)
+	}
+
+	want := &api.CreateInviteCodeResponse{
+		Code: "code",
+		InviteCode: &api.InviteCode{
+			InviteCodeId:  schema.Varint(3).Encode(),
+			CreatorUserId: schema.Varint(4).Encode(),
+			CreatedTime:   schema.ToTspb(now),
+			MaxUses:       2,
+			Capability:    []api.Capability_Cap{api.Capability_PIC_CREATE},
+		},
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+}
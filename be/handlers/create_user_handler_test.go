@@ -58,8 +58,8 @@ func TestCreateUser(t *testing.T) {
 	if taskCap == nil {
 		t.Fatal("task didn't run")
 	}
-	if taskCap.HashPassword == nil {
-		t.Error("missing hash function")
+	if taskCap.HashPassword != nil {
+		t.Error("expected configured hash policy to be used")
 	}
 	if have, want := taskCap.Ident, "foo@bar.com"; have != want {
 		t.Error("have", have, "want", want)
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleDeletePicComment(ctx context.Context, req *api.DeletePicCommentRequest) (
+	*api.DeletePicCommentResponse, status.S) {
+	var picId schema.Varint
+	if req.PicId != "" {
+		if err := picId.DecodeAll(req.PicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+	var commentId schema.Varint
+	if req.CommentId != "" {
+		if err := commentId.DecodeAll(req.CommentId); err != nil {
+			return nil, status.InvalidArgument(err, "bad comment id")
+		}
+	}
+
+	var task = &tasks.DeletePicCommentTask{
+		Beg: s.db,
+		Now: s.now,
+
+		PicId:     int64(picId),
+		CommentId: int64(commentId),
+		Version:   req.Version,
+		Reason:    req.Reason,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.DeletePicCommentResponse{
+		Comment: apiPicComment(task.PicComment),
+	}, nil
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestDeletePicCommentFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleDeletePicComment(context.Background(), &api.DeletePicCommentRequest{
+		PicId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeletePicCommentFailsOnBadCommentId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleDeletePicComment(context.Background(), &api.DeletePicCommentRequest{
+		CommentId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad comment id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeletePicCommentFailsOnVersionMismatch(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Aborted(nil, "version mismatch")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleDeletePicComment(context.Background(), &api.DeletePicCommentRequest{
+		PicId:     schema.Varint(1).Encode(),
+		CommentId: schema.Varint(2).Encode(),
+		Version:   3,
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Aborted; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "version mismatch"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeletePicComment(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.DeletePicCommentTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.DeletePicCommentTask)
+		pc := &schema.PicComment{
+			PicId:     1,
+			CommentId: 2,
+			UserId:    5,
+			Text:      "[deleted]",
+			Revision: []*schema.PicComment_Revision{{
+				Text:       "meow",
+				ReplacedTs: schema.ToTspb(now),
+				UserId:     5,
+			}},
+			DeletedTs: schema.ToTspb(now),
+		}
+		pc.SetCreatedTime(now)
+		pc.SetModifiedTime(now)
+		taskCap.PicComment = pc
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleDeletePicComment(context.Background(), &api.DeletePicCommentRequest{
+		PicId:     schema.Varint(1).Encode(),
+		CommentId: schema.Varint(2).Encode(),
+		Version:   3,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.PicId != 1 || taskCap.CommentId != 2 || taskCap.Version != 3 ||
+		taskCap.Reason != "" {
+		t.Error("bad task inputs", taskCap)
+	}
+	if taskCap.Now == nil {
+		t.Error("deps are nil", taskCap)
+	}
+	if !resp.Comment.Deleted {
+		t.Error("expected deleted comment", resp.Comment)
+	}
+	// Deleting isn't an edit.
+	if resp.Comment.EditedTime != nil {
+		t.Error("expected no edited time", resp.Comment)
+	}
+}
+
+func TestDeletePicCommentByModerator(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.DeletePicCommentTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.DeletePicCommentTask)
+		// A moderator (user 9) deleted the comment of user 5.
+		pc := &schema.PicComment{
+			PicId:     1,
+			CommentId: 2,
+			UserId:    5,
+			Text:      "[deleted]",
+			Revision: []*schema.PicComment_Revision{{
+				Text:       "meow",
+				ReplacedTs: schema.ToTspb(now),
+				UserId:     9,
+			}},
+			DeletedTs: schema.ToTspb(now),
+		}
+		pc.SetCreatedTime(now)
+		pc.SetModifiedTime(now)
+		taskCap.PicComment = pc
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleDeletePicComment(context.Background(), &api.DeletePicCommentRequest{
+		PicId:     schema.Varint(1).Encode(),
+		CommentId: schema.Varint(2).Encode(),
+		Version:   3,
+		Reason:    "spam",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := taskCap.Reason, "spam"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	c := resp.Comment
+	if !c.Deleted {
+		t.Error("expected deleted comment", c)
+	}
+	if have, want := c.UserId.GetValue(), schema.Varint(5).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(c.Revision) != 1 {
+		t.Fatal("wrong revisions", c.Revision)
+	}
+	if have, want := c.Revision[0].UserId.GetValue(), schema.Varint(9).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeletePicCommentFailsOnMissingModeratorCap(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.PermissionDenied(nil, "can't change comment of another user")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleDeletePicComment(context.Background(), &api.DeletePicCommentRequest{
+		PicId:     schema.Varint(1).Encode(),
+		CommentId: schema.Varint(2).Encode(),
+		Reason:    "spam",
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
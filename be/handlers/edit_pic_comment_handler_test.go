@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestEditPicCommentFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleEditPicComment(context.Background(), &api.EditPicCommentRequest{
+		PicId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestEditPicCommentFailsOnBadCommentId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleEditPicComment(context.Background(), &api.EditPicCommentRequest{
+		CommentId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad comment id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestEditPicCommentFailsOnVersionMismatch(t *testing.T) {
+	var taskCap *tasks.EditPicCommentTask
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.EditPicCommentTask)
+		return status.Aborted(nil, "version mismatch")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleEditPicComment(context.Background(), &api.EditPicCommentRequest{
+		PicId:     schema.Varint(1).Encode(),
+		CommentId: schema.Varint(2).Encode(),
+		Version:   3,
+		Text:      "meow",
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Aborted; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "version mismatch"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.Version, int64(3); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestEditPicCommentFailsOnOtherUser(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.PermissionDenied(nil, "can't change comment of another user")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleEditPicComment(context.Background(), &api.EditPicCommentRequest{
+		PicId:     schema.Varint(1).Encode(),
+		CommentId: schema.Varint(2).Encode(),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestEditPicCommentByModerator(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.EditPicCommentTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.EditPicCommentTask)
+		// A moderator (user 9) edited the comment of user 5.
+		pc := &schema.PicComment{
+			PicId:     1,
+			CommentId: 2,
+			UserId:    5,
+			Text:      "meow",
+			Revision: []*schema.PicComment_Revision{{
+				Text:       "woof",
+				ReplacedTs: schema.ToTspb(now),
+				UserId:     9,
+			}},
+		}
+		pc.SetCreatedTime(now)
+		pc.SetModifiedTime(now)
+		taskCap.PicComment = pc
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleEditPicComment(context.Background(), &api.EditPicCommentRequest{
+		PicId:     schema.Varint(1).Encode(),
+		CommentId: schema.Varint(2).Encode(),
+		Version:   3,
+		Text:      "meow",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.PicId != 1 || taskCap.CommentId != 2 || taskCap.Version != 3 ||
+		taskCap.Text != "meow" {
+		t.Error("bad task inputs", taskCap)
+	}
+	if taskCap.Now == nil {
+		t.Error("deps are nil", taskCap)
+	}
+
+	c := resp.Comment
+	if have, want := c.UserId.GetValue(), schema.Varint(5).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := c.Text, "meow"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if c.EditedTime == nil || c.Deleted {
+		t.Error("expected edited comment", c)
+	}
+	if len(c.Revision) != 1 {
+		t.Fatal("wrong revisions", c.Revision)
+	}
+	if have, want := c.Revision[0].UserId.GetValue(), schema.Varint(9).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := c.Revision[0].Text, "woof"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
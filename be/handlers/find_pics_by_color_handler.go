@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleFindPicsByColor(ctx context.Context, req *api.FindPicsByColorRequest) (
+	*api.FindPicsByColorResponse, status.S) {
+	if req.Color > 0xffffff {
+		return nil, status.InvalidArgument(nil, "bad color")
+	}
+	if req.Tolerance > 0xff {
+		return nil, status.InvalidArgument(nil, "bad tolerance")
+	}
+
+	var task = &tasks.FindPicsByColorTask{
+		Beg:       s.db,
+		Now:       s.now,
+		Color:     req.Color,
+		Tolerance: int64(req.Tolerance),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.FindPicsByColorResponse{
+		Pic: apiPicAndThumbnails(nil, task.Pics...),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestFindPicsByColorFailsOnBadTolerance(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleFindPicsByColor(context.Background(), &api.FindPicsByColorRequest{
+		Tolerance: 256,
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad tolerance"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPicsByColor(t *testing.T) {
+	var taskCap *tasks.FindPicsByColorTask
+	now := time.Now()
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FindPicsByColorTask)
+		p := &schema.Pic{
+			PicId: 2,
+			File: &schema.Pic_File{
+				Mime: schema.Pic_File_JPEG,
+			},
+			Placeholder: &schema.Pic_Placeholder{
+				DominantColor: 0x808080,
+			},
+		}
+		p.SetModifiedTime(now)
+		p.SetCreatedTime(now)
+		taskCap.Pics = append(taskCap.Pics, p)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	res, sts := s.handleFindPicsByColor(context.Background(), &api.FindPicsByColorRequest{
+		Color:     0x818181,
+		Tolerance: 3,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := taskCap.Color, uint32(0x818181); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.Tolerance, int64(3); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(res.Pic) != 1 || res.Pic[0].Placeholder.GetDominantColor() != 0x808080 {
+		t.Error("bad pics", res.Pic)
+	}
+}
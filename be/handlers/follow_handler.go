@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleAddUserFollow(ctx context.Context, req *api.AddUserFollowRequest) (
+	*api.AddUserFollowResponse, status.S) {
+	var userId schema.Varint
+	if req.UserId != "" {
+		if err := userId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+
+	var task = &tasks.AddUserFollowTask{
+		Beg:            s.db,
+		Now:            s.now,
+		FolloweeUserId: int64(userId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.AddUserFollowResponse{}, nil
+}
+
+func (s *serv) handleRemoveUserFollow(ctx context.Context, req *api.RemoveUserFollowRequest) (
+	*api.RemoveUserFollowResponse, status.S) {
+	var userId schema.Varint
+	if req.UserId != "" {
+		if err := userId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+
+	var task = &tasks.RemoveUserFollowTask{
+		Beg:            s.db,
+		Now:            s.now,
+		FolloweeUserId: int64(userId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.RemoveUserFollowResponse{}, nil
+}
+
+func (s *serv) handleAddTagFollow(ctx context.Context, req *api.AddTagFollowRequest) (
+	*api.AddTagFollowResponse, status.S) {
+	var task = &tasks.AddTagFollowTask{
+		Beg:     s.db,
+		Now:     s.now,
+		TagName: req.Tag,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.AddTagFollowResponse{}, nil
+}
+
+func (s *serv) handleRemoveTagFollow(ctx context.Context, req *api.RemoveTagFollowRequest) (
+	*api.RemoveTagFollowResponse, status.S) {
+	var task = &tasks.RemoveTagFollowTask{
+		Beg:     s.db,
+		Now:     s.now,
+		TagName: req.Tag,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.RemoveTagFollowResponse{}, nil
+}
+
+func (s *serv) handleFindFollows(ctx context.Context, req *api.FindFollowsRequest) (
+	*api.FindFollowsResponse, status.S) {
+	var task = &tasks.FindFollowsTask{
+		Beg: s.db,
+		Now: s.now,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.FindFollowsResponse{}
+	for _, uf := range task.UserFollows {
+		resp.UserId = append(resp.UserId, schema.Varint(uf.FolloweeUserId).Encode())
+	}
+	for _, tf := range task.TagFollows {
+		resp.Tag = append(resp.Tag, tf.TagName)
+	}
+	return resp, nil
+}
+
+func (s *serv) handleFindFeedPics(ctx context.Context, req *api.FindFeedPicsRequest) (
+	*api.FindFeedPicsResponse, status.S) {
+	var picId schema.Varint
+	if req.StartPicId != "" {
+		if err := picId.DecodeAll(req.StartPicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+
+	var task = &tasks.FindFeedPicsTask{
+		Beg:       s.db,
+		Now:       s.now,
+		StartId:   int64(picId),
+		Ascending: req.Ascending,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.FindFeedPicsResponse{
+		Pic: apiPicAndThumbnails(nil, task.Pics...),
+	}
+	if task.NextId != 0 {
+		resp.NextPicId = schema.Varint(task.NextId).Encode()
+	}
+	if task.PrevId != 0 {
+		resp.PrevPicId = schema.Varint(task.PrevId).Encode()
+	}
+	return resp, nil
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestAddUserFollowFailsOnBadUserId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleAddUserFollow(context.Background(), &api.AddUserFollowRequest{
+		UserId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad user id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddUserFollowFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.PermissionDenied(nil, "can't follow self")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleAddUserFollow(context.Background(), &api.AddUserFollowRequest{
+		UserId: schema.Varint(1).Encode(),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "can't follow self"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddUserFollow(t *testing.T) {
+	var taskCap *tasks.AddUserFollowTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.AddUserFollowTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleAddUserFollow(context.Background(), &api.AddUserFollowRequest{
+		UserId: schema.Varint(7).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.FolloweeUserId, int64(7); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if taskCap.Now == nil {
+		t.Error("deps are nil", taskCap)
+	}
+	if resp == nil {
+		t.Error("bad response")
+	}
+}
+
+func TestRemoveUserFollowFailsOnBadUserId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleRemoveUserFollow(context.Background(), &api.RemoveUserFollowRequest{
+		UserId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad user id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRemoveUserFollow(t *testing.T) {
+	var taskCap *tasks.RemoveUserFollowTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RemoveUserFollowTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleRemoveUserFollow(context.Background(), &api.RemoveUserFollowRequest{
+		UserId: schema.Varint(7).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.FolloweeUserId, int64(7); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if resp == nil {
+		t.Error("bad response")
+	}
+}
+
+func TestAddTagFollow(t *testing.T) {
+	var taskCap *tasks.AddTagFollowTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.AddTagFollowTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleAddTagFollow(context.Background(), &api.AddTagFollowRequest{
+		Tag: "cat",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.TagName, "cat"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if resp == nil {
+		t.Error("bad response")
+	}
+}
+
+func TestRemoveTagFollowFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.NotFound(nil, "can't find tag follow")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleRemoveTagFollow(context.Background(), &api.RemoveTagFollowRequest{
+		Tag: "cat",
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindFollows(t *testing.T) {
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap := task.(*tasks.FindFollowsTask)
+		taskCap.UserFollows = []*schema.UserFollow{{
+			UserId:         1,
+			FolloweeUserId: 2,
+		}}
+		taskCap.TagFollows = []*schema.TagFollow{{
+			UserId:  1,
+			TagName: "cat",
+		}}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleFindFollows(context.Background(), &api.FindFollowsRequest{})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	want := &api.FindFollowsResponse{
+		UserId: []string{schema.Varint(2).Encode()},
+		Tag:    []string{"cat"},
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+}
+
+func TestFindFeedPicsFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleFindFeedPics(context.Background(), &api.FindFeedPicsRequest{
+		StartPicId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindFeedPicsFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleFindFeedPics(context.Background(), &api.FindFeedPicsRequest{})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindFeedPics(t *testing.T) {
+	var taskCap *tasks.FindFeedPicsTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FindFeedPicsTask)
+		p := &schema.Pic{
+			PicId: 3,
+			File: &schema.Pic_File{
+				Mime: schema.Pic_File_JPEG,
+			},
+		}
+		now := time.Now()
+		p.SetCreatedTime(now)
+		p.SetModifiedTime(now)
+		taskCap.Pics = []*schema.Pic{p}
+		taskCap.NextId = 2
+		taskCap.PrevId = 4
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleFindFeedPics(context.Background(), &api.FindFeedPicsRequest{
+		StartPicId: schema.Varint(3).Encode(),
+		Ascending:  true,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.StartId, int64(3); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if !taskCap.Ascending {
+		t.Error("expected ascending")
+	}
+	if len(resp.Pic) != 1 || resp.Pic[0].Pic.Id != schema.Varint(3).Encode() {
+		t.Error("bad pics", resp.Pic)
+	}
+	if have, want := resp.NextPicId, schema.Varint(2).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := resp.PrevPicId, schema.Varint(4).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindFeedPicsNoMorePages(t *testing.T) {
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleFindFeedPics(context.Background(), &api.FindFeedPicsRequest{})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if resp.NextPicId != "" || resp.PrevPicId != "" {
+		t.Error("expected no pages", resp)
+	}
+}
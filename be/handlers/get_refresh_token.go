@@ -7,7 +7,6 @@ import (
 	"github.com/golang/protobuf/descriptor"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
-	"golang.org/x/crypto/bcrypt"
 
 	"pixur.org/pixur/api"
 	"pixur.org/pixur/be/schema"
@@ -21,9 +20,11 @@ var (
 )
 
 var (
-	authPwtHeaderKey string
-	pixPwtHeaderKey  string
-	httpHeaderKey    string
+	authPwtHeaderKey    string
+	pixPwtHeaderKey     string
+	httpHeaderKey       string
+	apiKeyHeaderKey     string
+	clientAddrHeaderKey string
 )
 
 func init() {
@@ -39,21 +40,26 @@ func init() {
 	authPwtHeaderKey = opts.AuthTokenHeaderKey
 	pixPwtHeaderKey = opts.PixTokenHeaderKey
 	httpHeaderKey = opts.HttpHeaderKey
+	apiKeyHeaderKey = opts.ApiKeyHeaderKey
+	clientAddrHeaderKey = opts.ClientAddrHeaderKey
 }
 
 func (s *serv) handleGetRefreshToken(
 	ctx context.Context, req *api.GetRefreshTokenRequest) (*api.GetRefreshTokenResponse, status.S) {
-
-	compareHashAndPassword := func(hashed, password []byte) error {
-		return bcrypt.CompareHashAndPassword(hashed, password)
-	}
-
+	// Failed logins are counted against the address, so it comes from the connection, or from the
+	// frontend or another trusted proxy, rather than from the caller.
+	ca, _ := clientAddrFromCtx(ctx)
 	var task = &tasks.AuthUserTask{
-		Beg:                    s.db,
-		Now:                    s.now,
-		CompareHashAndPassword: compareHashAndPassword,
-		Ident:                  req.Ident,
-		Secret:                 req.Secret,
+		Beg:        s.db,
+		Now:        s.now,
+		Ident:      req.Ident,
+		Secret:     req.Secret,
+		TotpCode:   req.TotpCode,
+		UserAgent:  req.UserAgent,
+		RemoteAddr: ca.host,
+
+		ExternalProvider: req.ExternalProvider,
+		ExternalSubject:  req.ExternalSubject,
 	}
 
 	if req.PreviousAuthToken != "" {
@@ -111,6 +117,13 @@ func (s *serv) handleGetRefreshToken(
 	var pixPayload *api.PwtPayload
 	var pixToken []byte
 	cshave := schema.CapSetOf(task.User.Capability...)
+	if len(task.User.Role) != 0 {
+		conf, sts := tasks.GetConfiguration(ctx)
+		if sts != nil {
+			return nil, sts
+		}
+		cshave = task.User.CapSet(conf)
+	}
 	cswant := schema.CapSetOf(schema.User_PIC_READ)
 	_, _, missing := schema.CapIntersect(cshave, cswant)
 	if missing.Size() == 0 {
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"net"
 	"strings"
 	"testing"
 	"time"
@@ -9,7 +10,11 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	oldctx "golang.org/x/net/context"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"pixur.org/pixur/api"
 	"pixur.org/pixur/be/schema"
@@ -34,8 +39,9 @@ func TestGetRefreshTokenSucceedsOnIdentSecret(t *testing.T) {
 		now:    time.Now,
 	}
 	resp, sts := s.handleGetRefreshToken(context.Background(), &api.GetRefreshTokenRequest{
-		Ident:  "a",
-		Secret: "b",
+		Ident:    "a",
+		Secret:   "b",
+		TotpCode: "c",
 	})
 
 	if sts != nil {
@@ -50,11 +56,78 @@ func TestGetRefreshTokenSucceedsOnIdentSecret(t *testing.T) {
 	if taskCap == nil {
 		t.Fatal("task didn't run")
 	}
-	if taskCap.CompareHashAndPassword == nil {
-		t.Error("no compare hash function")
+	if taskCap.CompareHashAndPassword != nil || taskCap.HashPassword != nil {
+		t.Error("expected configured hash policy to be used")
 	}
-	if taskCap.Ident != "a" || taskCap.Secret != "b" {
-		t.Error("wrong task input", taskCap.Ident, taskCap.Secret)
+	if taskCap.Ident != "a" || taskCap.Secret != "b" || taskCap.TotpCode != "c" {
+		t.Error("wrong task input", taskCap.Ident, taskCap.Secret, taskCap.TotpCode)
+	}
+}
+
+func TestGetRefreshTokenUsesClientAddr(t *testing.T) {
+	var taskCap *tasks.AuthUserTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.AuthUserTask)
+		taskCap.NewTokenId = 3
+		taskCap.User = &schema.User{UserId: 2}
+		return nil
+	}
+
+	s := serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	ctx := ctxFromClientAddr(context.Background(), clientAddr{host: "10.0.0.1"})
+	_, sts := s.handleGetRefreshToken(ctx, &api.GetRefreshTokenRequest{
+		Ident:  "a",
+		Secret: "b",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	// Failed logins are counted against the address, so the caller can't choose it.
+	if have, want := taskCap.RemoteAddr, "10.0.0.1"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestGetRefreshTokenUsesForwardedAddrByDefault(t *testing.T) {
+	var remoteAddrs []string
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap := task.(*tasks.AuthUserTask)
+		remoteAddrs = append(remoteAddrs, taskCap.RemoteAddr)
+		taskCap.NewTokenId = 3
+		taskCap.User = &schema.User{UserId: 2}
+		return nil
+	}
+	s := serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	// No trusted proxies are configured, as with the default server config.
+	si := &serverInterceptor{
+		trustedProxies: trustedProxiesOrDefault(nil),
+	}
+	handler := grpc.UnaryHandler(func(ctx oldctx.Context, req interface{}) (interface{}, error) {
+		return s.handleGetRefreshToken(ctx, req.(*api.GetRefreshTokenRequest))
+	})
+
+	// The frontend logs in users from loopback.  If their failed logins were counted against the
+	// frontend's address, a few bad passwords would lock out everyone.
+	for _, client := range []string{"172.16.0.1", "172.16.0.2"} {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234},
+		})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(clientAddrHeaderKey, client))
+		if _, err := si.intercept(ctx, &api.GetRefreshTokenRequest{
+			Ident:  "a",
+			Secret: "b",
+		}, nil, handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(remoteAddrs) != 2 || remoteAddrs[0] != "172.16.0.1" || remoteAddrs[1] != "172.16.0.2" {
+		t.Error("wrong remote addrs", remoteAddrs)
 	}
 }
 
@@ -3,9 +3,10 @@ package handlers // import "pixur.org/pixur/be/handlers"
 
 import (
 	"context"
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"io"
+	"net"
 	"time"
 
 	"github.com/golang/glog"
@@ -15,17 +16,24 @@ import (
 	gstatus "google.golang.org/grpc/status"
 
 	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/notify"
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
 	"pixur.org/pixur/be/status"
 	"pixur.org/pixur/be/tasks"
 )
 
-type serverInterceptor struct{}
+type serverInterceptor struct {
+	// limiter rate limits calls, if set.
+	limiter *rateLimiter
+	// trustedProxies are the peers allowed to forward the address of their clients.
+	trustedProxies []*net.IPNet
+}
 
 func (si *serverInterceptor) intercept(
 	ctx oldctx.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
 	interface{}, error) {
+	ctx = ctxFromClientAddr(ctx, findClientAddr(ctx, si.trustedProxies))
 	if md, present := metadata.FromIncomingContext(ctx); present {
 		if token, present := authTokenFromMD(md); present {
 			ctx = tasks.CtxFromAuthToken(ctx, token)
@@ -42,6 +50,16 @@ func (si *serverInterceptor) intercept(
 				}
 			}
 		}
+		// Api keys are checked when the task authenticates, like auth tokens.
+		if key, present := apiKeyFromMD(md); present {
+			ctx = tasks.CtxFromApiKey(ctx, key)
+		}
+	}
+	if si.limiter != nil && info != nil {
+		if sts := si.limiter.check(ctx, info.FullMethod); sts != nil {
+			glog.Info(sts.String())
+			return nil, gstatus.Error(sts.Code(), sts.Message())
+		}
 	}
 
 	resp, err := handler(ctx, req)
@@ -53,36 +71,183 @@ func (si *serverInterceptor) intercept(
 	return resp, err
 }
 
+func (si *serverInterceptor) streamIntercept(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := ctxFromClientAddr(ss.Context(), findClientAddr(ss.Context(), si.trustedProxies))
+	if md, present := metadata.FromIncomingContext(ctx); present {
+		if token, present := authTokenFromMD(md); present {
+			var sts status.S
+			ctx, sts = fillUserIdAndTokenFromCtx(tasks.CtxFromAuthToken(ctx, token))
+			if sts != nil {
+				return gstatus.Error(sts.Code(), sts.Message())
+			}
+		}
+		if key, present := apiKeyFromMD(md); present {
+			ctx = tasks.CtxFromApiKey(ctx, key)
+		}
+	}
+	ss = &ctxServerStream{ServerStream: ss, ctx: ctx}
+	if si.limiter != nil && info != nil {
+		if sts := si.limiter.check(ctx, info.FullMethod); sts != nil {
+			glog.Info(sts.String())
+			return gstatus.Error(sts.Code(), sts.Message())
+		}
+	}
+
+	if err := handler(srv, ss); err != nil {
+		sts := status.From(err)
+		glog.Info(sts.String())
+		return gstatus.Error(sts.Code(), sts.Message())
+	}
+	return nil
+}
+
+// ctxServerStream overrides the context of a stream, since streams can't be given a new one.
+type ctxServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *ctxServerStream) Context() context.Context {
+	return ss.ctx
+}
+
 var _ api.PixurServiceServer = &serv{}
 
 type serv struct {
 	db          db.DB
 	pixpath     string
 	tokenSecret []byte
-	privkey     *rsa.PrivateKey
-	pubkey      *rsa.PublicKey
+	privkey     crypto.Signer
+	pubkey      crypto.PublicKey
 	secure      bool
 	runner      *tasks.TaskRunner
 	now         func() time.Time
 	rand        io.Reader
+	notifier    notify.Notifier
+}
+
+func (s *serv) AddCollectionPic(ctx oldctx.Context, req *api.AddCollectionPicRequest) (
+	*api.AddCollectionPicResponse, error) {
+	return s.handleAddCollectionPic(ctx, req)
 }
 
 func (s *serv) AddPicComment(ctx oldctx.Context, req *api.AddPicCommentRequest) (*api.AddPicCommentResponse, error) {
 	return s.handleAddPicComment(ctx, req)
 }
 
+func (s *serv) AddPicFavorite(ctx oldctx.Context, req *api.AddPicFavoriteRequest) (
+	*api.AddPicFavoriteResponse, error) {
+	return s.handleAddPicFavorite(ctx, req)
+}
+
 func (s *serv) AddPicTags(ctx oldctx.Context, req *api.AddPicTagsRequest) (*api.AddPicTagsResponse, error) {
 	return s.handleAddPicTags(ctx, req)
 }
 
+func (s *serv) AddTagFollow(ctx oldctx.Context, req *api.AddTagFollowRequest) (
+	*api.AddTagFollowResponse, error) {
+	return s.handleAddTagFollow(ctx, req)
+}
+
+func (s *serv) AddUserFollow(ctx oldctx.Context, req *api.AddUserFollowRequest) (
+	*api.AddUserFollowResponse, error) {
+	return s.handleAddUserFollow(ctx, req)
+}
+
+func (s *serv) AppendUploadSession(ctx oldctx.Context, req *api.AppendUploadSessionRequest) (
+	*api.AppendUploadSessionResponse, error) {
+	return s.handleAppendUploadSession(ctx, req)
+}
+
+func (s *serv) CreateApiKey(ctx oldctx.Context, req *api.CreateApiKeyRequest) (
+	*api.CreateApiKeyResponse, error) {
+	return s.handleCreateApiKey(ctx, req)
+}
+
+func (s *serv) CreateCollection(ctx oldctx.Context, req *api.CreateCollectionRequest) (
+	*api.CreateCollectionResponse, error) {
+	return s.handleCreateCollection(ctx, req)
+}
+
+func (s *serv) CreateInviteCode(ctx oldctx.Context, req *api.CreateInviteCodeRequest) (
+	*api.CreateInviteCodeResponse, error) {
+	return s.handleCreateInviteCode(ctx, req)
+}
+
+func (s *serv) CreatePool(ctx oldctx.Context, req *api.CreatePoolRequest) (
+	*api.CreatePoolResponse, error) {
+	return s.handleCreatePool(ctx, req)
+}
+
 func (s *serv) CreateUser(ctx oldctx.Context, req *api.CreateUserRequest) (*api.CreateUserResponse, error) {
 	return s.handleCreateUser(ctx, req)
 }
 
+func (s *serv) DeleteAccount(ctx oldctx.Context, req *api.DeleteAccountRequest) (
+	*api.DeleteAccountResponse, error) {
+	return s.handleDeleteAccount(ctx, req)
+}
+
+func (s *serv) DeleteApiKey(ctx oldctx.Context, req *api.DeleteApiKeyRequest) (
+	*api.DeleteApiKeyResponse, error) {
+	return s.handleDeleteApiKey(ctx, req)
+}
+
+func (s *serv) DeleteCollection(ctx oldctx.Context, req *api.DeleteCollectionRequest) (
+	*api.DeleteCollectionResponse, error) {
+	return s.handleDeleteCollection(ctx, req)
+}
+
+func (s *serv) DeletePicComment(ctx oldctx.Context, req *api.DeletePicCommentRequest) (
+	*api.DeletePicCommentResponse, error) {
+	return s.handleDeletePicComment(ctx, req)
+}
+
 func (s *serv) DeleteToken(ctx oldctx.Context, req *api.DeleteTokenRequest) (*api.DeleteTokenResponse, error) {
 	return s.handleDeleteToken(ctx, req)
 }
 
+func (s *serv) DisableTotp(ctx oldctx.Context, req *api.DisableTotpRequest) (
+	*api.DisableTotpResponse, error) {
+	return s.handleDisableTotp(ctx, req)
+}
+
+func (s *serv) EditPicComment(ctx oldctx.Context, req *api.EditPicCommentRequest) (
+	*api.EditPicCommentResponse, error) {
+	return s.handleEditPicComment(ctx, req)
+}
+
+func (s *serv) ExportMyData(
+	req *api.ExportMyDataRequest, emds api.PixurService_ExportMyDataServer) error {
+	return s.handleExportMyData(req, emds)
+}
+
+func (s *serv) FindApiKeys(ctx oldctx.Context, req *api.FindApiKeysRequest) (
+	*api.FindApiKeysResponse, error) {
+	return s.handleFindApiKeys(ctx, req)
+}
+
+func (s *serv) FindAuditLog(ctx oldctx.Context, req *api.FindAuditLogRequest) (
+	*api.FindAuditLogResponse, error) {
+	return s.handleFindAuditLog(ctx, req)
+}
+
+func (s *serv) FindCollections(ctx oldctx.Context, req *api.FindCollectionsRequest) (
+	*api.FindCollectionsResponse, error) {
+	return s.handleFindCollections(ctx, req)
+}
+
+func (s *serv) FindFeedPics(ctx oldctx.Context, req *api.FindFeedPicsRequest) (
+	*api.FindFeedPicsResponse, error) {
+	return s.handleFindFeedPics(ctx, req)
+}
+
+func (s *serv) FindFollows(ctx oldctx.Context, req *api.FindFollowsRequest) (
+	*api.FindFollowsResponse, error) {
+	return s.handleFindFollows(ctx, req)
+}
+
 func (s *serv) FindIndexPics(ctx oldctx.Context, req *api.FindIndexPicsRequest) (*api.FindIndexPicsResponse, error) {
 	return s.handleFindIndexPics(ctx, req)
 }
@@ -91,6 +256,21 @@ func (s *serv) FindPicCommentVotes(ctx oldctx.Context, req *api.FindPicCommentVo
 	return s.handleFindPicCommentVotes(ctx, req)
 }
 
+func (s *serv) FindPicFavorites(ctx oldctx.Context, req *api.FindPicFavoritesRequest) (
+	*api.FindPicFavoritesResponse, error) {
+	return s.handleFindPicFavorites(ctx, req)
+}
+
+func (s *serv) FindPicsByColor(ctx oldctx.Context, req *api.FindPicsByColorRequest) (
+	*api.FindPicsByColorResponse, error) {
+	return s.handleFindPicsByColor(ctx, req)
+}
+
+func (s *serv) FindPools(ctx oldctx.Context, req *api.FindPoolsRequest) (
+	*api.FindPoolsResponse, error) {
+	return s.handleFindPools(ctx, req)
+}
+
 func (s *serv) FindSchedPics(ctx oldctx.Context, req *api.FindSchedPicsRequest) (*api.FindSchedPicsResponse, error) {
 	return s.handleFindSchedPics(ctx, req)
 }
@@ -103,6 +283,21 @@ func (s *serv) FindUserEvents(ctx oldctx.Context, req *api.FindUserEventsRequest
 	return s.handleFindUserEvents(ctx, req)
 }
 
+func (s *serv) FinishTotpEnrollment(ctx oldctx.Context, req *api.FinishTotpEnrollmentRequest) (
+	*api.FinishTotpEnrollmentResponse, error) {
+	return s.handleFinishTotpEnrollment(ctx, req)
+}
+
+func (s *serv) FinishUploadSession(ctx oldctx.Context, req *api.FinishUploadSessionRequest) (
+	*api.FinishUploadSessionResponse, error) {
+	return s.handleFinishUploadSession(ctx, req)
+}
+
+func (s *serv) FinishUserSecretReset(ctx oldctx.Context, req *api.FinishUserSecretResetRequest) (
+	*api.FinishUserSecretResetResponse, error) {
+	return s.handleFinishUserSecretReset(ctx, req)
+}
+
 func (s *serv) GetRefreshToken(ctx oldctx.Context, req *api.GetRefreshTokenRequest) (*api.GetRefreshTokenResponse, error) {
 	return s.handleGetRefreshToken(ctx, req)
 }
@@ -111,6 +306,21 @@ func (s *serv) IncrementViewCount(ctx oldctx.Context, req *api.IncrementViewCoun
 	return s.handleIncrementViewCount(ctx, req)
 }
 
+func (s *serv) InsertPoolPic(ctx oldctx.Context, req *api.InsertPoolPicRequest) (
+	*api.InsertPoolPicResponse, error) {
+	return s.handleInsertPoolPic(ctx, req)
+}
+
+func (s *serv) ListSessions(ctx oldctx.Context, req *api.ListSessionsRequest) (
+	*api.ListSessionsResponse, error) {
+	return s.handleListSessions(ctx, req)
+}
+
+func (s *serv) LookupCollection(ctx oldctx.Context, req *api.LookupCollectionRequest) (
+	*api.LookupCollectionResponse, error) {
+	return s.handleLookupCollection(ctx, req)
+}
+
 func (s *serv) LookupPicCommentVote(ctx oldctx.Context, req *api.LookupPicCommentVoteRequest) (*api.LookupPicCommentVoteResponse, error) {
 	return s.handleLookupPicCommentVote(ctx, req)
 }
@@ -131,6 +341,16 @@ func (s *serv) LookupPicVote(ctx oldctx.Context, req *api.LookupPicVoteRequest)
 	return s.handleLookupPicVote(ctx, req)
 }
 
+func (s *serv) LookupPool(ctx oldctx.Context, req *api.LookupPoolRequest) (
+	*api.LookupPoolResponse, error) {
+	return s.handleLookupPool(ctx, req)
+}
+
+func (s *serv) LookupUploadSession(ctx oldctx.Context, req *api.LookupUploadSessionRequest) (
+	*api.LookupUploadSessionResponse, error) {
+	return s.handleLookupUploadSession(ctx, req)
+}
+
 func (s *serv) LookupUser(ctx oldctx.Context, req *api.LookupUserRequest) (*api.LookupUserResponse, error) {
 	return s.handleLookupUser(ctx, req)
 }
@@ -140,22 +360,106 @@ func (s *serv) LookupPublicUserInfo(ctx oldctx.Context, req *api.LookupPublicUse
 	return s.handleLookupPublicUserInfo(ctx, req)
 }
 
+func (s *serv) MovePoolPic(ctx oldctx.Context, req *api.MovePoolPicRequest) (
+	*api.MovePoolPicResponse, error) {
+	return s.handleMovePoolPic(ctx, req)
+}
+
 func (s *serv) PurgePic(ctx oldctx.Context, req *api.PurgePicRequest) (*api.PurgePicResponse, error) {
 	return s.handlePurgePic(ctx, req)
 }
 
+func (s *serv) RemoveCollectionPic(ctx oldctx.Context, req *api.RemoveCollectionPicRequest) (
+	*api.RemoveCollectionPicResponse, error) {
+	return s.handleRemoveCollectionPic(ctx, req)
+}
+
+func (s *serv) RemovePicFavorite(ctx oldctx.Context, req *api.RemovePicFavoriteRequest) (
+	*api.RemovePicFavoriteResponse, error) {
+	return s.handleRemovePicFavorite(ctx, req)
+}
+
+func (s *serv) RemovePoolPic(ctx oldctx.Context, req *api.RemovePoolPicRequest) (
+	*api.RemovePoolPicResponse, error) {
+	return s.handleRemovePoolPic(ctx, req)
+}
+
+func (s *serv) RemoveTagFollow(ctx oldctx.Context, req *api.RemoveTagFollowRequest) (
+	*api.RemoveTagFollowResponse, error) {
+	return s.handleRemoveTagFollow(ctx, req)
+}
+
+func (s *serv) RemoveUserFollow(ctx oldctx.Context, req *api.RemoveUserFollowRequest) (
+	*api.RemoveUserFollowResponse, error) {
+	return s.handleRemoveUserFollow(ctx, req)
+}
+
+func (s *serv) ReorderCollection(ctx oldctx.Context, req *api.ReorderCollectionRequest) (
+	*api.ReorderCollectionResponse, error) {
+	return s.handleReorderCollection(ctx, req)
+}
+
+func (s *serv) RevokeSession(ctx oldctx.Context, req *api.RevokeSessionRequest) (
+	*api.RevokeSessionResponse, error) {
+	return s.handleRevokeSession(ctx, req)
+}
+
 func (s *serv) SoftDeletePic(ctx oldctx.Context, req *api.SoftDeletePicRequest) (*api.SoftDeletePicResponse, error) {
 	return s.handleSoftDeletePic(ctx, req)
 }
 
+func (s *serv) StartTotpEnrollment(ctx oldctx.Context, req *api.StartTotpEnrollmentRequest) (
+	*api.StartTotpEnrollmentResponse, error) {
+	return s.handleStartTotpEnrollment(ctx, req)
+}
+
+func (s *serv) StartUploadSession(ctx oldctx.Context, req *api.StartUploadSessionRequest) (
+	*api.StartUploadSessionResponse, error) {
+	return s.handleStartUploadSession(ctx, req)
+}
+
+func (s *serv) StartUserSecretReset(ctx oldctx.Context, req *api.StartUserSecretResetRequest) (
+	*api.StartUserSecretResetResponse, error) {
+	return s.handleStartUserSecretReset(ctx, req)
+}
+
+func (s *serv) SuspendUser(ctx oldctx.Context, req *api.SuspendUserRequest) (
+	*api.SuspendUserResponse, error) {
+	return s.handleSuspendUser(ctx, req)
+}
+
+func (s *serv) UnlockLogin(ctx oldctx.Context, req *api.UnlockLoginRequest) (
+	*api.UnlockLoginResponse, error) {
+	return s.handleUnlockLogin(ctx, req)
+}
+
+func (s *serv) UnsuspendUser(ctx oldctx.Context, req *api.UnsuspendUserRequest) (
+	*api.UnsuspendUserResponse, error) {
+	return s.handleUnsuspendUser(ctx, req)
+}
+
+func (s *serv) UpdateCollection(ctx oldctx.Context, req *api.UpdateCollectionRequest) (
+	*api.UpdateCollectionResponse, error) {
+	return s.handleUpdateCollection(ctx, req)
+}
+
 func (s *serv) UpdateUser(ctx oldctx.Context, req *api.UpdateUserRequest) (*api.UpdateUserResponse, error) {
 	return s.handleUpdateUser(ctx, req)
 }
 
+func (s *serv) UpdateUserSecret(ctx oldctx.Context, req *api.UpdateUserSecretRequest) (
+	*api.UpdateUserSecretResponse, error) {
+	return s.handleUpdateUserSecret(ctx, req)
+}
+
 func (s *serv) UpsertPic(ctx oldctx.Context, req *api.UpsertPicRequest) (*api.UpsertPicResponse, error) {
 	return s.handleUpsertPic(ctx, req)
 }
 
+func (s *serv) UpsertPicStream(ups api.PixurService_UpsertPicStreamServer) error {
+	return s.handleUpsertPicStream(ups)
+}
+
 func (s *serv) UpsertPicCommentVote(ctx oldctx.Context, req *api.UpsertPicCommentVoteRequest) (*api.UpsertPicCommentVoteResponse, error) {
 	return s.handleUpsertPicCommentVote(ctx, req)
 }
@@ -177,10 +481,17 @@ type ServerConfig struct {
 	DB                   db.DB
 	PixPath              string
 	TokenSecret          []byte
-	PrivateKey           *rsa.PrivateKey
-	PublicKey            *rsa.PublicKey
+	PrivateKey           crypto.Signer
+	PublicKey            crypto.PublicKey
 	Secure               bool
 	BackendConfiguration *api.BackendConfiguration
+	// Notifier delivers secret reset tokens.  If nil, secret resets are disabled.
+	Notifier notify.Notifier
+	// PwtKeySet is the initial keys for tokens, if any.  It can be changed with SetPwtKeySet.
+	PwtKeySet *PwtKeySet
+	// TrustedProxies are the peers, such as the frontend, whose forwarded client addresses are used.
+	// The forwarded address from any other peer is ignored.  If nil, loopback peers are trusted.
+	TrustedProxies []*net.IPNet
 }
 
 func HandlersInit(ctx context.Context, c *ServerConfig) ([]grpc.ServerOption, func(*grpc.Server)) {
@@ -196,6 +507,7 @@ func HandlersInit(ctx context.Context, c *ServerConfig) ([]grpc.ServerOption, fu
 	// TODO: don't be so hacky!  This should probably come from a file, or the db itself.
 	task := &tasks.LoadConfigurationTask{
 		Beg: c.DB,
+		Now: now,
 
 		Config: beconf,
 	}
@@ -204,8 +516,13 @@ func HandlersInit(ctx context.Context, c *ServerConfig) ([]grpc.ServerOption, fu
 		panic(sts)
 	}
 
+	si := &serverInterceptor{
+		limiter:        newRateLimiter(now),
+		trustedProxies: trustedProxiesOrDefault(c.TrustedProxies),
+	}
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor((&serverInterceptor{}).intercept),
+		grpc.UnaryInterceptor(si.intercept),
+		grpc.StreamInterceptor(si.streamIntercept),
 		grpc.MaxRecvMsgSize(512 * 1024 * 1024),
 	}
 	return opts, func(s *grpc.Server) {
@@ -219,6 +536,7 @@ func HandlersInit(ctx context.Context, c *ServerConfig) ([]grpc.ServerOption, fu
 			runner:      nil,
 			now:         now,
 			rand:        rand.Reader,
+			notifier:    c.Notifier,
 		})
 	}
 }
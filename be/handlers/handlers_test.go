@@ -124,3 +124,68 @@ func TestServerInterceptor(t *testing.T) {
 		t.Error("have", have, "want", want)
 	}
 }
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *testServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+func TestServerStreamInterceptorFailsOnBadAuth(t *testing.T) {
+	si := &serverInterceptor{}
+	handler := grpc.StreamHandler(func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authPwtHeaderKey, "bogus"))
+
+	err := si.streamIntercept(nil, &testServerStream{ctx: ctx}, nil, handler)
+
+	if err == nil {
+		t.Fatal("expected err")
+	}
+	gsts, ok := gstatus.FromError(err)
+	if !ok {
+		t.Fatal("not a gstatus", gsts)
+	}
+	if have, want := gsts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestServerStreamInterceptor(t *testing.T) {
+	si := &serverInterceptor{}
+	var ctxcap context.Context
+	handler := grpc.StreamHandler(func(srv interface{}, ss grpc.ServerStream) error {
+		ctxcap = ss.Context()
+		return status.Unimplemented(nil, "no go")
+	})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authPwtHeaderKey, testAuthToken))
+
+	err := si.streamIntercept(nil, &testServerStream{ctx: ctx}, nil, handler)
+
+	if err == nil {
+		t.Fatal(err)
+	}
+	gsts, ok := gstatus.FromError(err)
+	if !ok {
+		t.Fatal("not a gstatus", gsts)
+	}
+	if have, want := gsts.Code(), codes.Unimplemented; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if ctxcap == nil {
+		t.Fatal("nil ctx")
+	}
+	tok, present := tasks.UserTokenFromCtx(ctxcap)
+	if !present {
+		t.Fatal("missing user token")
+	}
+	if have, want := tok.UserId, testAuthSubject; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
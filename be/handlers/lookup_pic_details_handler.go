@@ -34,12 +34,26 @@ func (s *serv) handleLookupPicDetails(
 		pcs = pcs[:len(pcs)-1] // Always trim the fakeroot
 	}
 
-	return &api.LookupPicDetailsResponse{
+	resp := &api.LookupPicDetailsResponse{
 		Pic:            apiPic(task.Pic),
 		Derived:        apiPicFiles(nil, task.Pic.PicId, false, task.Pic.Derived...),
 		PicTag:         apiPicTags(nil, task.PicTags...),
 		PicCommentTree: apiPicCommentTree(nil, pcs...),
-	}, nil
+		Favorite:       task.Favorite,
+	}
+	for _, nav := range task.PoolNavs {
+		dst := &api.LookupPicDetailsResponse_PoolNav{
+			Pool: apiPool(nav.Pool),
+		}
+		if nav.PrevPicId != 0 {
+			dst.PrevPicId = schema.Varint(nav.PrevPicId).Encode()
+		}
+		if nav.NextPicId != 0 {
+			dst.NextPicId = schema.Varint(nav.NextPicId).Encode()
+		}
+		resp.PoolNav = append(resp.PoolNav, dst)
+	}
+	return resp, nil
 }
 
 func flattenPicCommentTree(list *[]*schema.PicComment, pct *tasks.PicCommentTree) {
@@ -198,3 +198,77 @@ func TestLookupPicTaskError(t *testing.T) {
 		t.Error("Wrong PicId", taskCap.PicId)
 	}
 }
+
+func TestLookupPicPoolNavBoundaries(t *testing.T) {
+	now := time.Now()
+	newPool := func(id int64) *schema.Pool {
+		p := &schema.Pool{
+			PoolId: id,
+			Title:  "comic",
+		}
+		p.SetCreatedTime(now)
+		p.SetModifiedTime(now)
+		return p
+	}
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap := task.(*tasks.LookupPicTask)
+		taskCap.Pic = &schema.Pic{
+			PicId: 1,
+			File: &schema.Pic_File{
+				Mime: schema.Pic_File_JPEG,
+			},
+		}
+		taskCap.Pic.SetCreatedTime(now)
+		taskCap.Pic.SetModifiedTime(now)
+		taskCap.PoolNavs = []*tasks.PoolNav{{
+			// The start of the pool
+			Pool:      newPool(2),
+			NextPicId: 4,
+		}, {
+			Pool:      newPool(3),
+			PrevPicId: 5,
+			NextPicId: 6,
+		}, {
+			// The end of the pool
+			Pool:      newPool(7),
+			PrevPicId: 8,
+		}, {
+			// The only pic in the pool
+			Pool: newPool(9),
+		}}
+		return nil
+	}
+	s := &serv{
+		now:    time.Now,
+		runner: tasks.TestTaskRunner(successRunner),
+	}
+
+	resp, sts := s.handleLookupPicDetails(context.Background(), &api.LookupPicDetailsRequest{
+		PicId: schema.Varint(1).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+
+	want := []*api.LookupPicDetailsResponse_PoolNav{{
+		Pool:      apiPool(newPool(2)),
+		NextPicId: schema.Varint(4).Encode(),
+	}, {
+		Pool:      apiPool(newPool(3)),
+		PrevPicId: schema.Varint(5).Encode(),
+		NextPicId: schema.Varint(6).Encode(),
+	}, {
+		Pool:      apiPool(newPool(7)),
+		PrevPicId: schema.Varint(8).Encode(),
+	}, {
+		Pool: apiPool(newPool(9)),
+	}}
+	if len(resp.PoolNav) != len(want) {
+		t.Fatal("have", resp.PoolNav, "want", want)
+	}
+	for i := range want {
+		if !proto.Equal(resp.PoolNav[i], want[i]) {
+			t.Error("have", resp.PoolNav[i], "want", want[i])
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleAddPicFavorite(ctx context.Context, req *api.AddPicFavoriteRequest) (
+	*api.AddPicFavoriteResponse, status.S) {
+	var picId schema.Varint
+	if req.PicId != "" {
+		if err := picId.DecodeAll(req.PicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+
+	var task = &tasks.AddPicFavoriteTask{
+		Beg:   s.db,
+		Now:   s.now,
+		PicId: int64(picId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.AddPicFavoriteResponse{}, nil
+}
+
+func (s *serv) handleRemovePicFavorite(ctx context.Context, req *api.RemovePicFavoriteRequest) (
+	*api.RemovePicFavoriteResponse, status.S) {
+	var picId schema.Varint
+	if req.PicId != "" {
+		if err := picId.DecodeAll(req.PicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+
+	var task = &tasks.RemovePicFavoriteTask{
+		Beg:   s.db,
+		Now:   s.now,
+		PicId: int64(picId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.RemovePicFavoriteResponse{}, nil
+}
+
+func (s *serv) handleFindPicFavorites(ctx context.Context, req *api.FindPicFavoritesRequest) (
+	*api.FindPicFavoritesResponse, status.S) {
+	var startId schema.Varint
+	if req.StartPicFavoriteId != "" {
+		if err := startId.DecodeAll(req.StartPicFavoriteId); err != nil {
+			return nil, status.InvalidArgument(err, "bad start pic favorite id")
+		}
+	}
+
+	var task = &tasks.FindPicFavoritesTask{
+		Beg:                s.db,
+		Now:                s.now,
+		StartPicFavoriteId: int64(startId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.FindPicFavoritesResponse{
+		Pic: apiPicAndThumbnails(nil, task.Pics...),
+	}
+	if task.NextPicFavoriteId != 0 {
+		resp.NextPicFavoriteId = schema.Varint(task.NextPicFavoriteId).Encode()
+	}
+	return resp, nil
+}
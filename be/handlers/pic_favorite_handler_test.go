@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestAddPicFavoriteFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleAddPicFavorite(context.Background(), &api.AddPicFavoriteRequest{
+		PicId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddPicFavoriteFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.InvalidArgument(nil, "can't favorite deleted pic")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleAddPicFavorite(context.Background(), &api.AddPicFavoriteRequest{
+		PicId: schema.Varint(1).Encode(),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "can't favorite deleted pic"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddPicFavorite(t *testing.T) {
+	var taskCap *tasks.AddPicFavoriteTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.AddPicFavoriteTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleAddPicFavorite(context.Background(), &api.AddPicFavoriteRequest{
+		PicId: schema.Varint(7).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.PicId, int64(7); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if taskCap.Now == nil {
+		t.Error("deps are nil", taskCap)
+	}
+	if resp == nil {
+		t.Error("bad response")
+	}
+}
+
+func TestRemovePicFavoriteFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleRemovePicFavorite(context.Background(), &api.RemovePicFavoriteRequest{
+		PicId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRemovePicFavorite(t *testing.T) {
+	var taskCap *tasks.RemovePicFavoriteTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RemovePicFavoriteTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleRemovePicFavorite(context.Background(), &api.RemovePicFavoriteRequest{
+		PicId: schema.Varint(7).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.PicId, int64(7); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if resp == nil {
+		t.Error("bad response")
+	}
+}
+
+func TestFindPicFavoritesFailsOnBadStartId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleFindPicFavorites(context.Background(), &api.FindPicFavoritesRequest{
+		StartPicFavoriteId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad start pic favorite id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPicFavoritesFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleFindPicFavorites(context.Background(), &api.FindPicFavoritesRequest{})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPicFavorites(t *testing.T) {
+	var taskCap *tasks.FindPicFavoritesTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FindPicFavoritesTask)
+		p := &schema.Pic{
+			PicId: 3,
+			File: &schema.Pic_File{
+				Mime: schema.Pic_File_JPEG,
+			},
+		}
+		now := time.Now()
+		p.SetCreatedTime(now)
+		p.SetModifiedTime(now)
+		taskCap.Pics = []*schema.Pic{p}
+		taskCap.NextPicFavoriteId = 4
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleFindPicFavorites(context.Background(), &api.FindPicFavoritesRequest{
+		StartPicFavoriteId: schema.Varint(5).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.StartPicFavoriteId, int64(5); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(resp.Pic) != 1 || resp.Pic[0].Pic.Id != schema.Varint(3).Encode() {
+		t.Error("bad pics", resp.Pic)
+	}
+	if have, want := resp.NextPicFavoriteId, schema.Varint(4).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPicFavoritesNoMorePages(t *testing.T) {
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleFindPicFavorites(context.Background(), &api.FindPicFavoritesRequest{})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if resp.NextPicFavoriteId != "" {
+		t.Error("expected no next page", resp)
+	}
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func decodePoolId(raw string) (int64, status.S) {
+	var poolId schema.Varint
+	if raw != "" {
+		if err := poolId.DecodeAll(raw); err != nil {
+			return 0, status.InvalidArgument(err, "bad pool id")
+		}
+	}
+	return int64(poolId), nil
+}
+
+func (s *serv) handleCreatePool(ctx context.Context, req *api.CreatePoolRequest) (
+	*api.CreatePoolResponse, status.S) {
+	var task = &tasks.CreatePoolTask{
+		Beg:         s.db,
+		Now:         s.now,
+		Title:       req.Title,
+		Description: req.Description,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.CreatePoolResponse{
+		Pool: apiPool(task.Pool),
+	}, nil
+}
+
+func (s *serv) handleFindPools(ctx context.Context, req *api.FindPoolsRequest) (
+	*api.FindPoolsResponse, status.S) {
+	startId, sts := decodePoolId(req.StartPoolId)
+	if sts != nil {
+		return nil, sts
+	}
+
+	var task = &tasks.FindPoolsTask{
+		Beg:         s.db,
+		Now:         s.now,
+		StartPoolId: startId,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.FindPoolsResponse{
+		Pool: apiPools(nil, task.Pools...),
+	}
+	if task.NextPoolId != 0 {
+		resp.NextPoolId = schema.Varint(task.NextPoolId).Encode()
+	}
+	return resp, nil
+}
+
+func (s *serv) handleLookupPool(ctx context.Context, req *api.LookupPoolRequest) (
+	*api.LookupPoolResponse, status.S) {
+	poolId, sts := decodePoolId(req.PoolId)
+	if sts != nil {
+		return nil, sts
+	}
+
+	var task = &tasks.LookupPoolTask{
+		Beg:    s.db,
+		Now:    s.now,
+		PoolId: poolId,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.LookupPoolResponse{
+		Pool: apiPool(task.Pool),
+		Pic:  apiPicAndThumbnails(nil, task.Pics...),
+	}, nil
+}
+
+func (s *serv) handleInsertPoolPic(ctx context.Context, req *api.InsertPoolPicRequest) (
+	*api.InsertPoolPicResponse, status.S) {
+	poolId, sts := decodePoolId(req.PoolId)
+	if sts != nil {
+		return nil, sts
+	}
+	var picId, beforePicId schema.Varint
+	if req.PicId != "" {
+		if err := picId.DecodeAll(req.PicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+	if req.BeforePicId != "" {
+		if err := beforePicId.DecodeAll(req.BeforePicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad before pic id")
+		}
+	}
+
+	var task = &tasks.InsertPoolPicTask{
+		Beg:         s.db,
+		Now:         s.now,
+		PoolId:      poolId,
+		PicId:       int64(picId),
+		BeforePicId: int64(beforePicId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.InsertPoolPicResponse{
+		Pool: apiPool(task.Pool),
+	}, nil
+}
+
+func (s *serv) handleMovePoolPic(ctx context.Context, req *api.MovePoolPicRequest) (
+	*api.MovePoolPicResponse, status.S) {
+	poolId, sts := decodePoolId(req.PoolId)
+	if sts != nil {
+		return nil, sts
+	}
+	var picId, beforePicId schema.Varint
+	if req.PicId != "" {
+		if err := picId.DecodeAll(req.PicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+	if req.BeforePicId != "" {
+		if err := beforePicId.DecodeAll(req.BeforePicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad before pic id")
+		}
+	}
+
+	var task = &tasks.MovePoolPicTask{
+		Beg:         s.db,
+		Now:         s.now,
+		PoolId:      poolId,
+		PicId:       int64(picId),
+		BeforePicId: int64(beforePicId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.MovePoolPicResponse{
+		Pool: apiPool(task.Pool),
+	}, nil
+}
+
+func (s *serv) handleRemovePoolPic(ctx context.Context, req *api.RemovePoolPicRequest) (
+	*api.RemovePoolPicResponse, status.S) {
+	poolId, sts := decodePoolId(req.PoolId)
+	if sts != nil {
+		return nil, sts
+	}
+	var picId schema.Varint
+	if req.PicId != "" {
+		if err := picId.DecodeAll(req.PicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+
+	var task = &tasks.RemovePoolPicTask{
+		Beg:    s.db,
+		Now:    s.now,
+		PoolId: poolId,
+		PicId:  int64(picId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.RemovePoolPicResponse{
+		Pool: apiPool(task.Pool),
+	}, nil
+}
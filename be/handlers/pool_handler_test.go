@@ -0,0 +1,433 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func testPool(now time.Time) *schema.Pool {
+	p := &schema.Pool{
+		PoolId:      2,
+		Title:       "comic",
+		Description: "a comic",
+	}
+	p.SetCreatedTime(now)
+	p.SetModifiedTime(now)
+	return p
+}
+
+func TestCreatePool(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.CreatePoolTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.CreatePoolTask)
+		taskCap.Pool = testPool(now)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleCreatePool(context.Background(), &api.CreatePoolRequest{
+		Title:       "comic",
+		Description: "a comic",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.Title != "comic" || taskCap.Description != "a comic" {
+		t.Error("bad task inputs", taskCap)
+	}
+	if taskCap.Now == nil {
+		t.Error("deps are nil", taskCap)
+	}
+	want := &api.CreatePoolResponse{
+		Pool: &api.Pool{
+			PoolId:       schema.Varint(2).Encode(),
+			Title:        "comic",
+			Description:  "a comic",
+			CreatedTime:  taskCap.Pool.CreatedTs,
+			ModifiedTime: taskCap.Pool.ModifiedTs,
+		},
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+}
+
+func TestCreatePoolFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.PermissionDenied(nil, "missing cap PIC_POOL_EDIT")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleCreatePool(context.Background(), &api.CreatePoolRequest{})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPoolsFailsOnBadStartId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleFindPools(context.Background(), &api.FindPoolsRequest{
+		StartPoolId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pool id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPools(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.FindPoolsTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FindPoolsTask)
+		taskCap.Pools = []*schema.Pool{testPool(now)}
+		taskCap.NextPoolId = 1
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleFindPools(context.Background(), &api.FindPoolsRequest{
+		StartPoolId: schema.Varint(2).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.StartPoolId, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(resp.Pool) != 1 || resp.Pool[0].PoolId != schema.Varint(2).Encode() {
+		t.Error("bad pools", resp.Pool)
+	}
+	if have, want := resp.NextPoolId, schema.Varint(1).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPoolsNoMorePages(t *testing.T) {
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleFindPools(context.Background(), &api.FindPoolsRequest{})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if resp.NextPoolId != "" {
+		t.Error("expected no next page", resp)
+	}
+}
+
+func TestLookupPoolFailsOnBadPoolId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleLookupPool(context.Background(), &api.LookupPoolRequest{
+		PoolId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pool id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLookupPoolKeepsPoolOrder(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.LookupPoolTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.LookupPoolTask)
+		taskCap.Pool = testPool(now)
+		// Pool order, not index order.
+		for _, id := range []int64{5, 3, 4} {
+			p := &schema.Pic{
+				PicId: id,
+				File: &schema.Pic_File{
+					Mime: schema.Pic_File_JPEG,
+				},
+			}
+			p.SetCreatedTime(now)
+			p.SetModifiedTime(now)
+			taskCap.Pics = append(taskCap.Pics, p)
+		}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleLookupPool(context.Background(), &api.LookupPoolRequest{
+		PoolId: schema.Varint(2).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := taskCap.PoolId, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := resp.Pool.Title, "comic"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	var have []string
+	for _, p := range resp.Pic {
+		have = append(have, p.Pic.Id)
+	}
+	want := []string{schema.Varint(5).Encode(), schema.Varint(3).Encode(), schema.Varint(4).Encode()}
+	if strings.Join(have, ",") != strings.Join(want, ",") {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestInsertPoolPicFailsOnBadIds(t *testing.T) {
+	for _, c := range []struct {
+		req *api.InsertPoolPicRequest
+		msg string
+	}{
+		{&api.InsertPoolPicRequest{PoolId: "x"}, "bad pool id"},
+		{&api.InsertPoolPicRequest{PicId: "x"}, "bad pic id"},
+		{&api.InsertPoolPicRequest{BeforePicId: "x"}, "bad before pic id"},
+	} {
+		s := &serv{}
+		_, sts := s.handleInsertPoolPic(context.Background(), c.req)
+		if sts == nil {
+			t.Fatal("nil status")
+		}
+		if have, want := sts.Code(), codes.InvalidArgument; have != want {
+			t.Error("have", have, "want", want)
+		}
+		if have, want := sts.Message(), c.msg; !strings.Contains(have, want) {
+			t.Error("have", have, "want", want)
+		}
+	}
+}
+
+func TestInsertPoolPicFailsOnAlreadyPresent(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.AlreadyExists(nil, "pic already in pool")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleInsertPoolPic(context.Background(), &api.InsertPoolPicRequest{
+		PoolId: schema.Varint(2).Encode(),
+		PicId:  schema.Varint(3).Encode(),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.AlreadyExists; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestInsertPoolPicAtEnd(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.InsertPoolPicTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.InsertPoolPicTask)
+		taskCap.Pool = testPool(now)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleInsertPoolPic(context.Background(), &api.InsertPoolPicRequest{
+		PoolId: schema.Varint(2).Encode(),
+		PicId:  schema.Varint(3).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	// No before pic means the end of the pool.
+	if taskCap.PoolId != 2 || taskCap.PicId != 3 || taskCap.BeforePicId != 0 {
+		t.Error("bad task inputs", taskCap)
+	}
+	if resp.Pool == nil {
+		t.Error("missing pool", resp)
+	}
+}
+
+func TestMovePoolPicFailsOnBadIds(t *testing.T) {
+	for _, c := range []struct {
+		req *api.MovePoolPicRequest
+		msg string
+	}{
+		{&api.MovePoolPicRequest{PoolId: "x"}, "bad pool id"},
+		{&api.MovePoolPicRequest{PicId: "x"}, "bad pic id"},
+		{&api.MovePoolPicRequest{BeforePicId: "x"}, "bad before pic id"},
+	} {
+		s := &serv{}
+		_, sts := s.handleMovePoolPic(context.Background(), c.req)
+		if sts == nil {
+			t.Fatal("nil status")
+		}
+		if have, want := sts.Code(), codes.InvalidArgument; have != want {
+			t.Error("have", have, "want", want)
+		}
+		if have, want := sts.Message(), c.msg; !strings.Contains(have, want) {
+			t.Error("have", have, "want", want)
+		}
+	}
+}
+
+func TestMovePoolPicFailsOnSelf(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.InvalidArgument(nil, "can't move pic before itself")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleMovePoolPic(context.Background(), &api.MovePoolPicRequest{
+		PoolId:      schema.Varint(2).Encode(),
+		PicId:       schema.Varint(3).Encode(),
+		BeforePicId: schema.Varint(3).Encode(),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "can't move pic before itself"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestMovePoolPicToStart(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.MovePoolPicTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.MovePoolPicTask)
+		taskCap.Pool = testPool(now)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleMovePoolPic(context.Background(), &api.MovePoolPicRequest{
+		PoolId:      schema.Varint(2).Encode(),
+		PicId:       schema.Varint(4).Encode(),
+		BeforePicId: schema.Varint(5).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.PoolId != 2 || taskCap.PicId != 4 || taskCap.BeforePicId != 5 {
+		t.Error("bad task inputs", taskCap)
+	}
+	if resp.Pool == nil {
+		t.Error("missing pool", resp)
+	}
+}
+
+func TestRemovePoolPicFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleRemovePoolPic(context.Background(), &api.RemovePoolPicRequest{
+		PoolId: schema.Varint(2).Encode(),
+		PicId:  "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRemovePoolPicFailsOnMissingPic(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.NotFound(nil, "can't find pic in pool")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleRemovePoolPic(context.Background(), &api.RemovePoolPicRequest{
+		PoolId: schema.Varint(2).Encode(),
+		PicId:  schema.Varint(3).Encode(),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRemovePoolPic(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.RemovePoolPicTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RemovePoolPicTask)
+		taskCap.Pool = testPool(now)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	resp, sts := s.handleRemovePoolPic(context.Background(), &api.RemovePoolPicRequest{
+		PoolId: schema.Varint(2).Encode(),
+		PicId:  schema.Varint(3).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.PoolId != 2 || taskCap.PicId != 3 {
+		t.Error("bad task inputs", taskCap)
+	}
+	if resp.Pool == nil {
+		t.Error("missing pool", resp)
+	}
+}
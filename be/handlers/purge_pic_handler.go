@@ -26,6 +26,7 @@ func (s *serv) handlePurgePic(
 		PixPath: s.pixpath,
 		Remove:  os.Remove,
 		PicId:   int64(picId),
+		Reason:  req.Reason,
 	}
 	if sts := s.runner.Run(ctx, task); sts != nil {
 		return nil, sts
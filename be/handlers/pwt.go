@@ -2,9 +2,18 @@ package handlers
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/sha512"
+	"encoding/asn1"
 	"encoding/base64"
+	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -19,6 +28,7 @@ var (
 	errPwtUnsupportedMsg = "unsupported pwt"
 	errPwtSignatureMsg   = "pwt signature mismatch"
 	errPwtExpiredMsg     = "expired pwt"
+	errPwtUnknownKeyMsg  = "unknown pwt key"
 	errNotAuthMsg        = "invalid auth token"
 )
 
@@ -30,12 +40,219 @@ func initPwtCoder(c *ServerConfig, now func() time.Time) {
 			now:    now,
 			secret: c.TokenSecret,
 		}
+		// Ed25519 and ECDSA P-256 session keys sign and verify tokens without a key id.  Session keys
+		// of other types, such as RSA, are not used for tokens.
+		if c.PrivateKey != nil && pwtKeyAlg(c.PrivateKey.Public()) != api.PwtHeader_UNKNOWN {
+			defaultPwtCoder.privateKey = c.PrivateKey
+			defaultPwtCoder.publicKey = c.PrivateKey.Public()
+		} else if c.PublicKey != nil && pwtKeyAlg(c.PublicKey) != api.PwtHeader_UNKNOWN {
+			defaultPwtCoder.publicKey = c.PublicKey
+		}
+		if c.PwtKeySet != nil {
+			if err := defaultPwtCoder.setKeys(c.PwtKeySet); err != nil {
+				panic(err)
+			}
+		} else if len(c.TokenSecret) == 0 && defaultPwtCoder.privateKey == nil {
+			// Tokens would be signed with an empty HMAC key, which anyone can forge.
+			panic(status.InvalidArgument(nil, "missing token secret or pwt key set"))
+		}
 	}
 }
 
+// PwtKey is a key used to sign and verify tokens.  Exactly one of Secret or PublicKey is set.
+type PwtKey struct {
+	KeyId string
+	// Secret is the HMAC-SHA512/256 secret of symmetric keys.
+	Secret []byte
+	// PublicKey is an ed25519.PublicKey or P-256 *ecdsa.PublicKey, for asymmetric keys.
+	PublicKey crypto.PublicKey
+	// PrivateKey signs tokens for PublicKey.  It is only needed by the signing key.
+	PrivateKey crypto.Signer
+}
+
+// PwtKeySet is the keys used to sign and verify tokens.  Tokens signed by any of the keys are
+// accepted.  New tokens are signed by the key with SigningKeyId.
+type PwtKeySet struct {
+	SigningKeyId string
+	Keys         []PwtKey
+}
+
+// SetPwtKeySet replaces the keys used for tokens, without restarting the server.  Tokens signed
+// by keys no longer in the set are rejected.
+func SetPwtKeySet(ks *PwtKeySet) error {
+	if defaultPwtCoder == nil {
+		return status.Internal(nil, "pwt coder not initialized")
+	}
+	return defaultPwtCoder.setKeys(ks)
+}
+
+type pwtKeys struct {
+	signing *PwtKey
+	byId    map[string]*PwtKey
+}
+
 type pwtCoder struct {
-	now    func() time.Time
+	now func() time.Time
+	// secret signs and verifies tokens without a key id, if there is no key set.  It continues to
+	// verify such tokens once a key set is added, so that users aren't logged out.
 	secret []byte
+	// privateKey and publicKey sign and verify tokens without a key id, like secret.  If
+	// privateKey is set, it signs new tokens instead of secret.
+	privateKey crypto.Signer
+	publicKey  crypto.PublicKey
+	keys       atomic.Value // *pwtKeys
+}
+
+// pwtKeyAlg finds the signing algorithm for a public key, or UNKNOWN if it is not supported.
+func pwtKeyAlg(pub crypto.PublicKey) api.PwtHeader_Algorithm {
+	switch pub := pub.(type) {
+	case ed25519.PublicKey:
+		return api.PwtHeader_EDDSA
+	case *ecdsa.PublicKey:
+		if pub.Curve == elliptic.P256() {
+			return api.PwtHeader_ES256
+		}
+	}
+	return api.PwtHeader_UNKNOWN
+}
+
+func (c *pwtCoder) setKeys(ks *PwtKeySet) error {
+	keys := &pwtKeys{
+		byId: make(map[string]*PwtKey, len(ks.Keys)),
+	}
+	for i := range ks.Keys {
+		k := ks.Keys[i]
+		if k.KeyId == "" {
+			return status.InvalidArgument(nil, "missing pwt key id")
+		}
+		if k.PublicKey == nil && k.PrivateKey != nil {
+			k.PublicKey = k.PrivateKey.Public()
+		}
+		if len(k.Secret) == 0 && k.PublicKey == nil {
+			return status.InvalidArgument(nil, "missing secret for pwt key", k.KeyId)
+		}
+		if len(k.Secret) != 0 && k.PublicKey != nil {
+			return status.InvalidArgument(nil, "pwt key has both secret and public key", k.KeyId)
+		}
+		if k.PublicKey != nil && pwtKeyAlg(k.PublicKey) == api.PwtHeader_UNKNOWN {
+			return status.InvalidArgumentf(nil, "unsupported pwt key type %T", k.PublicKey)
+		}
+		if k.PrivateKey != nil {
+			if pub, ok := k.PrivateKey.Public().(interface{ Equal(crypto.PublicKey) bool }); !ok ||
+				!pub.Equal(k.PublicKey) {
+				return status.InvalidArgument(nil, "pwt private key doesn't match public key", k.KeyId)
+			}
+		}
+		if _, present := keys.byId[k.KeyId]; present {
+			return status.InvalidArgument(nil, "duplicate pwt key", k.KeyId)
+		}
+		keys.byId[k.KeyId] = &k
+	}
+	signing, present := keys.byId[ks.SigningKeyId]
+	if !present {
+		return status.InvalidArgument(nil, "missing pwt signing key", ks.SigningKeyId)
+	}
+	if signing.PublicKey != nil && signing.PrivateKey == nil {
+		return status.InvalidArgument(nil, "missing private key for pwt signing key", signing.KeyId)
+	}
+	keys.signing = signing
+	c.keys.Store(keys)
+	return nil
+}
+
+// verifyKey finds the key for verifying a token signed by keyId.  Tokens without a key id are
+// only accepted if there is a legacy secret or session public key to verify them.
+func (c *pwtCoder) verifyKey(keyId string) (*PwtKey, bool) {
+	if keyId == "" {
+		if len(c.secret) == 0 && c.publicKey == nil {
+			return nil, false
+		}
+		return &PwtKey{Secret: c.secret, PublicKey: c.publicKey}, true
+	}
+	if keys, ok := c.keys.Load().(*pwtKeys); ok {
+		if k, present := keys.byId[keyId]; present {
+			return k, true
+		}
+	}
+	return nil, false
+}
+
+// signingKey finds the key for signing new tokens.
+func (c *pwtCoder) signingKey() *PwtKey {
+	if keys, ok := c.keys.Load().(*pwtKeys); ok {
+		return keys.signing
+	}
+	return &PwtKey{Secret: c.secret, PublicKey: c.publicKey, PrivateKey: c.privateKey}
+}
+
+// ecdsaSignature is the ASN.1 form of ECDSA signatures, as produced by crypto.Signer.
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// sign signs data with the key, using the algorithm for the type of key.
+func (k *PwtKey) sign(data []byte) ([]byte, error) {
+	if k.PrivateKey == nil {
+		if len(k.Secret) == 0 {
+			return nil, status.Internal(nil, "missing pwt secret")
+		}
+		mac := hmac.New(sha512.New512_256, k.Secret)
+		mac.Write(data)
+		return mac.Sum(nil), nil
+	}
+	switch pwtKeyAlg(k.PrivateKey.Public()) {
+	case api.PwtHeader_EDDSA:
+		sig, err := k.PrivateKey.Sign(rand.Reader, data, crypto.Hash(0))
+		if err != nil {
+			return nil, status.Internal(err, "can't sign pwt")
+		}
+		return sig, nil
+	case api.PwtHeader_ES256:
+		digest := sha256.Sum256(data)
+		der, err := k.PrivateKey.Sign(rand.Reader, digest[:], crypto.SHA256)
+		if err != nil {
+			return nil, status.Internal(err, "can't sign pwt")
+		}
+		var esig ecdsaSignature
+		if _, err := asn1.Unmarshal(der, &esig); err != nil {
+			return nil, status.Internal(err, "can't decode pwt signature")
+		}
+		sig := make([]byte, 64)
+		esig.R.FillBytes(sig[:32])
+		esig.S.FillBytes(sig[32:])
+		return sig, nil
+	default:
+		return nil, status.Internalf(nil, "unsupported pwt key type %T",
+			k.PrivateKey.Public())
+	}
+}
+
+// verify checks that sig is the signature of data by the key, using alg.  Symmetric keys only
+// verify HMAC signatures, and asymmetric keys only verify signatures of their own type.
+func (k *PwtKey) verify(alg api.PwtHeader_Algorithm, data, sig []byte) bool {
+	switch alg {
+	case api.PwtHeader_HS512_256:
+		// An empty secret would verify tokens signed by anyone.
+		if len(k.Secret) == 0 {
+			return false
+		}
+		mac := hmac.New(sha512.New512_256, k.Secret)
+		mac.Write(data)
+		return hmac.Equal(mac.Sum(nil), sig)
+	case api.PwtHeader_EDDSA:
+		pub, ok := k.PublicKey.(ed25519.PublicKey)
+		return ok && ed25519.Verify(pub, data, sig)
+	case api.PwtHeader_ES256:
+		pub, ok := k.PublicKey.(*ecdsa.PublicKey)
+		if !ok || pub.Curve != elliptic.P256() || len(sig) != 64 {
+			return false
+		}
+		digest := sha256.Sum256(data)
+		r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+		return ecdsa.Verify(pub, digest[:], r, s)
+	default:
+		return false
+	}
 }
 
 func (c *pwtCoder) decode(data []byte) (*api.PwtPayload, status.S) {
@@ -63,14 +280,20 @@ func (c *pwtCoder) decode(data []byte) (*api.PwtPayload, status.S) {
 	}
 
 	// Check that it's even feasible to continue.
-	// TODO: suppport more algs and versions
-	if header.Algorithm != api.PwtHeader_HS512_256 {
+	switch header.Algorithm {
+	case api.PwtHeader_HS512_256, api.PwtHeader_ES256, api.PwtHeader_EDDSA:
+	default:
 		return nil, status.Unauthenticated(nil, errPwtUnsupportedMsg)
 	}
 	if header.Version != 0 {
 		return nil, status.Unauthenticated(nil, errPwtUnsupportedMsg)
 	}
 
+	key, ok := c.verifyKey(header.KeyId)
+	if !ok {
+		return nil, status.Unauthenticated(nil, errPwtUnknownKeyMsg)
+	}
+
 	// The algorithm is one we support.  Decode the base64 signature to raw bytes.
 	signature := make([]byte, enc.DecodedLen(len(b64Signature)))
 	if size, err := enc.Decode(signature, b64Signature); err != nil {
@@ -79,11 +302,7 @@ func (c *pwtCoder) decode(data []byte) (*api.PwtPayload, status.S) {
 		signature = signature[:size]
 	}
 
-	mac := hmac.New(sha512.New512_256, c.secret)
-	mac.Write(b64Header)
-	mac.Write(sep)
-	mac.Write(b64Payload)
-	if !hmac.Equal(mac.Sum(nil), signature) {
+	if !key.verify(header.Algorithm, data[:len(b64Header)+len(sep)+len(b64Payload)], signature) {
 		return nil, status.Unauthenticated(nil, errPwtSignatureMsg)
 	}
 
@@ -115,9 +334,15 @@ func (c *pwtCoder) decode(data []byte) (*api.PwtPayload, status.S) {
 }
 
 func (c *pwtCoder) encode(payload *api.PwtPayload) ([]byte, error) {
+	key := c.signingKey()
+	alg := api.PwtHeader_HS512_256
+	if key.PrivateKey != nil {
+		alg = pwtKeyAlg(key.PrivateKey.Public())
+	}
 	header := &api.PwtHeader{
-		Algorithm: api.PwtHeader_HS512_256,
+		Algorithm: alg,
 		Version:   0,
+		KeyId:     key.KeyId,
 	}
 
 	rawHeader, err := proto.Marshal(header)
@@ -141,9 +366,10 @@ func (c *pwtCoder) encode(payload *api.PwtPayload) ([]byte, error) {
 	enc.Encode(b64Payload, rawPayload)
 	token = append(token, b64Payload...)
 
-	mac := hmac.New(sha512.New512_256, c.secret)
-	mac.Write(token)
-	signature := mac.Sum(nil)
+	signature, err := key.sign(token)
+	if err != nil {
+		return nil, err
+	}
 
 	b64Signature := make([]byte, enc.EncodedLen(len(signature)))
 	enc.Encode(b64Signature, signature)
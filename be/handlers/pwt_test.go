@@ -1,13 +1,26 @@
 package handlers
 
 import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"encoding/base64"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
 
 	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
 )
 
 func TestSomething(t *testing.T) {
@@ -43,3 +56,290 @@ func TestSomething(t *testing.T) {
 		t.Error("have", payload, "want", pload)
 	}
 }
+
+func TestPwtKeySetRotation(t *testing.T) {
+	now := time.Now()
+	payload := &api.PwtPayload{
+		Subject:   "billy",
+		NotBefore: schema.ToTspb(now.AddDate(0, 0, -1).Truncate(time.Second)),
+		NotAfter:  schema.ToTspb(now.AddDate(0, 0, 1).Truncate(time.Second)),
+		TokenId:   27,
+	}
+	c := &pwtCoder{
+		secret: []byte("crud"),
+		now:    time.Now,
+	}
+	legacy, err := c.encode(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.setKeys(&PwtKeySet{
+		SigningKeyId: "a",
+		Keys:         []PwtKey{{KeyId: "a", Secret: []byte("aaa")}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	first, err := c.encode(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.setKeys(&PwtKeySet{
+		SigningKeyId: "b",
+		Keys: []PwtKey{
+			{KeyId: "a", Secret: []byte("aaa")},
+			{KeyId: "b", Secret: []byte("bbb")},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	second, err := c.encode(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tok := range [][]byte{legacy, first, second} {
+		if pload, sts := c.decode(tok); sts != nil {
+			t.Fatal(sts)
+		} else if !proto.Equal(pload, payload) {
+			t.Error("have", pload, "want", payload)
+		}
+	}
+
+	if err := c.setKeys(&PwtKeySet{
+		SigningKeyId: "b",
+		Keys:         []PwtKey{{KeyId: "b", Secret: []byte("bbb")}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, sts := c.decode(first); sts == nil || sts.Code() != codes.Unauthenticated {
+		t.Error("expected removed key to be rejected", sts)
+	}
+	if _, sts := c.decode(second); sts != nil {
+		t.Error(sts)
+	}
+	if _, sts := c.decode(legacy); sts != nil {
+		t.Error(sts)
+	}
+}
+
+func TestPwtKeySetInvalid(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &pwtCoder{
+		now: time.Now,
+	}
+	cases := []*PwtKeySet{
+		{SigningKeyId: "a", Keys: []PwtKey{{Secret: []byte("aaa")}}},
+		{SigningKeyId: "a", Keys: []PwtKey{{KeyId: "a"}}},
+		{SigningKeyId: "a", Keys: []PwtKey{{KeyId: "a", Secret: []byte{}}}},
+		{SigningKeyId: "a", Keys: []PwtKey{{KeyId: "a", Secret: []byte("1")}, {KeyId: "a", Secret: []byte("2")}}},
+		{SigningKeyId: "b", Keys: []PwtKey{{KeyId: "a", Secret: []byte("aaa")}}},
+		{SigningKeyId: "a", Keys: []PwtKey{{KeyId: "a", Secret: []byte("aaa"), PublicKey: pub}}},
+		{SigningKeyId: "a", Keys: []PwtKey{{KeyId: "a", PublicKey: pub, PrivateKey: otherPriv}}},
+		{SigningKeyId: "a", Keys: []PwtKey{{KeyId: "a", PublicKey: &rsaKey.PublicKey}}},
+	}
+	for i, ks := range cases {
+		err := c.setKeys(ks)
+		if sts, ok := err.(status.S); !ok || sts.Code() != codes.InvalidArgument {
+			t.Error(i, "expected invalid argument", err)
+		}
+	}
+}
+
+func TestPwtAsymmetricKeys(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	payload := &api.PwtPayload{
+		Subject:   "billy",
+		NotBefore: schema.ToTspb(now.AddDate(0, 0, -1).Truncate(time.Second)),
+		NotAfter:  schema.ToTspb(now.AddDate(0, 0, 1).Truncate(time.Second)),
+		TokenId:   27,
+	}
+	cases := []struct {
+		key crypto.Signer
+		alg api.PwtHeader_Algorithm
+	}{
+		{key: edKey, alg: api.PwtHeader_EDDSA},
+		{key: ecKey, alg: api.PwtHeader_ES256},
+	}
+	for _, cs := range cases {
+		c := &pwtCoder{
+			now: time.Now,
+		}
+		if err := c.setKeys(&PwtKeySet{
+			SigningKeyId: "a",
+			Keys:         []PwtKey{{KeyId: "a", PrivateKey: cs.key}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		tok, err := c.encode(payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		header := &api.PwtHeader{}
+		rawHeader, err := base64.RawURLEncoding.DecodeString(string(bytes.SplitN(tok, []byte{'.'}, 2)[0]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := proto.Unmarshal(rawHeader, header); err != nil {
+			t.Fatal(err)
+		}
+		if header.Algorithm != cs.alg || header.KeyId != "a" {
+			t.Error("bad header", header)
+		}
+		if pload, sts := c.decode(tok); sts != nil {
+			t.Fatal(sts)
+		} else if !proto.Equal(pload, payload) {
+			t.Error("have", pload, "want", payload)
+		}
+
+		// Servers with only the public key can verify, but not sign.
+		verifier := &pwtCoder{
+			now: time.Now,
+		}
+		if err := verifier.setKeys(&PwtKeySet{
+			SigningKeyId: "a",
+			Keys:         []PwtKey{{KeyId: "a", PublicKey: cs.key.Public()}},
+		}); err == nil {
+			t.Error("expected error for signing key without private key")
+		}
+		if err := verifier.setKeys(&PwtKeySet{
+			SigningKeyId: "b",
+			Keys: []PwtKey{
+				{KeyId: "a", PublicKey: cs.key.Public()},
+				{KeyId: "b", Secret: []byte("bbb")},
+			},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if _, sts := verifier.decode(tok); sts != nil {
+			t.Error(sts)
+		}
+
+		// A tampered signature is rejected.
+		bad := append([]byte(nil), tok...)
+		if bad[len(bad)-2] == 'A' {
+			bad[len(bad)-2] = 'B'
+		} else {
+			bad[len(bad)-2] = 'A'
+		}
+		if _, sts := c.decode(bad); sts == nil || sts.Code() != codes.Unauthenticated {
+			t.Error("expected tampered token to be rejected", sts)
+		}
+	}
+}
+
+func TestPwtAsymmetricKeyRejectsHmac(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	payload := &api.PwtPayload{
+		Subject:   "billy",
+		NotBefore: schema.ToTspb(now.AddDate(0, 0, -1).Truncate(time.Second)),
+		NotAfter:  schema.ToTspb(now.AddDate(0, 0, 1).Truncate(time.Second)),
+	}
+	// Forge a token using the public key as an HMAC secret.
+	forger := &pwtCoder{
+		now: time.Now,
+	}
+	if err := forger.setKeys(&PwtKeySet{
+		SigningKeyId: "a",
+		Keys:         []PwtKey{{KeyId: "a", Secret: []byte(pub)}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	tok, err := forger.encode(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &pwtCoder{
+		now:       time.Now,
+		publicKey: pub,
+	}
+	if err := c.setKeys(&PwtKeySet{
+		SigningKeyId: "b",
+		Keys: []PwtKey{
+			{KeyId: "a", PublicKey: pub},
+			{KeyId: "b", Secret: []byte("bbb")},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, sts := c.decode(tok); sts == nil || sts.Code() != codes.Unauthenticated {
+		t.Error("expected forged token to be rejected", sts)
+	}
+}
+
+func TestPwtKeySetRejectsEmptyHmacWithoutKeyId(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	payload := &api.PwtPayload{
+		Subject:   "billy",
+		NotBefore: schema.ToTspb(now.AddDate(0, 0, -1).Truncate(time.Second)),
+		NotAfter:  schema.ToTspb(now.AddDate(0, 0, 1).Truncate(time.Second)),
+	}
+	// Forge a token without a key id, signed with an empty HMAC key.
+	rawHeader, err := proto.Marshal(&api.PwtHeader{Algorithm: api.PwtHeader_HS512_256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawPayload, err := proto.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc := base64.RawURLEncoding
+	tok := []byte(enc.EncodeToString(rawHeader) + "." + enc.EncodeToString(rawPayload))
+	mac := hmac.New(sha512.New512_256, nil)
+	mac.Write(tok)
+	tok = append(tok, '.')
+	tok = append(tok, enc.EncodeToString(mac.Sum(nil))...)
+
+	// Only a key set is configured, with no token secret.
+	c := &pwtCoder{
+		now: time.Now,
+	}
+	if err := c.setKeys(&PwtKeySet{
+		SigningKeyId: "a",
+		Keys:         []PwtKey{{KeyId: "a", PrivateKey: edKey}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, sts := c.decode(tok); sts == nil || sts.Code() != codes.Unauthenticated {
+		t.Error("expected forged token to be rejected", sts)
+	}
+
+	// Nor is it accepted with no keys at all.
+	c = &pwtCoder{
+		now: time.Now,
+	}
+	if _, sts := c.decode(tok); sts == nil || sts.Code() != codes.Unauthenticated {
+		t.Error("expected forged token to be rejected", sts)
+	}
+	if _, err := c.encode(payload); err == nil {
+		t.Error("expected error signing without a secret")
+	}
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+const (
+	// rateLimitSweepInterval is how often idle clients are forgotten.
+	rateLimitSweepInterval = time.Minute
+	// retryAfterTrailerKey is the trailer with the seconds until a rate limited call can be retried.
+	retryAfterTrailerKey = "retry-after"
+)
+
+type rateLimitKey struct {
+	method string
+	client string
+}
+
+// rateLimiter limits how often clients may call each rpc.  Each client and rpc has a "theoretical
+// arrival time", which advances by the limit's interval on each call.  Calls are allowed as long
+// as it is no more than burst intervals ahead of now.
+type rateLimiter struct {
+	now func() time.Time
+
+	lock      sync.Mutex
+	tat       map[rateLimitKey]time.Time
+	lastSweep time.Time
+}
+
+func newRateLimiter(now func() time.Time) *rateLimiter {
+	return &rateLimiter{
+		now: now,
+		tat: make(map[rateLimitKey]time.Time),
+	}
+}
+
+// allow records a call of method by client.  If the call exceeds the limit, it returns how long
+// to wait before trying again.
+func (rl *rateLimiter) allow(
+	method, client string, limit *schema.Configuration_RateLimit) (time.Duration, bool) {
+	interval, err := ptypes.Duration(limit.GetInterval())
+	if err != nil || interval <= 0 || limit.GetBurst() <= 0 {
+		return 0, true
+	}
+	now := rl.now()
+
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	if now.Sub(rl.lastSweep) >= rateLimitSweepInterval {
+		for k, tat := range rl.tat {
+			if !tat.After(now) {
+				delete(rl.tat, k)
+			}
+		}
+		rl.lastSweep = now
+	}
+
+	key := rateLimitKey{method: method, client: client}
+	tat := rl.tat[key]
+	if tat.Before(now) {
+		tat = now
+	}
+	next := tat.Add(interval)
+	if wait := next.Sub(now) - time.Duration(limit.Burst)*interval; wait > 0 {
+		return wait, false
+	}
+	rl.tat[key] = next
+	return 0, true
+}
+
+// check returns ResourceExhausted if the client in ctx has called fullMethod too often.  The
+// number of seconds to wait before retrying is set in the retry-after trailer.
+func (rl *rateLimiter) check(ctx context.Context, fullMethod string) status.S {
+	method := path.Base(fullMethod)
+	conf, sts := tasks.GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	limit, present := conf.GetRateLimit().GetRateLimit()[method]
+	if !present {
+		return nil
+	}
+	if wait, ok := rl.allow(method, rateLimitClient(ctx), limit); !ok {
+		// Round up, so that clients retrying at the hinted time succeed.
+		secs := strconv.FormatInt(int64((wait+time.Second-1)/time.Second), 10)
+		// Trailers can only be set this way for unary calls, so this is best effort.
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(retryAfterTrailerKey, secs))
+		return status.ResourceExhausted(nil, "rate limit exceeded, retry after", secs, "seconds")
+	}
+	return nil
+}
+
+// rateLimitClient identifies who is calling, preferring the user over their address.
+func rateLimitClient(ctx context.Context) string {
+	if ut, ok := tasks.UserTokenFromCtx(ctx); ok {
+		return "user:" + strconv.FormatInt(ut.UserId, 10)
+	}
+	ca, _ := clientAddrFromCtx(ctx)
+	// Api keys aren't checked until the task runs, so they can't be trusted to identify the caller.
+	// Trusted proxies, such as the frontend, forward the address of each of their clients.
+	return "addr:" + ca.host
+}
@@ -0,0 +1,290 @@
+package handlers
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	oldctx "golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	gstatus "google.golang.org/grpc/status"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestRateLimiterAllowsBurst(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rl := newRateLimiter(func() time.Time { return now })
+	limit := &schema.Configuration_RateLimit{
+		Burst:    3,
+		Interval: ptypes.DurationProto(10 * time.Second),
+	}
+	for i := 0; i < 3; i++ {
+		if _, ok := rl.allow("AddPicComment", "user:1", limit); !ok {
+			t.Fatal("expected call", i, "to be allowed")
+		}
+	}
+	wait, ok := rl.allow("AddPicComment", "user:1", limit)
+	if ok {
+		t.Fatal("expected call to be limited")
+	}
+	if wait != 10*time.Second {
+		t.Error("have", wait, "want", 10*time.Second)
+	}
+
+	// Other clients and methods have their own limits.
+	if _, ok := rl.allow("AddPicComment", "user:2", limit); !ok {
+		t.Error("expected other user to be allowed")
+	}
+	if _, ok := rl.allow("UpsertPicVote", "user:1", limit); !ok {
+		t.Error("expected other method to be allowed")
+	}
+
+	now = now.Add(5 * time.Second)
+	if wait, ok := rl.allow("AddPicComment", "user:1", limit); ok || wait != 5*time.Second {
+		t.Error("expected to wait 5s", wait, ok)
+	}
+	now = now.Add(5 * time.Second)
+	if _, ok := rl.allow("AddPicComment", "user:1", limit); !ok {
+		t.Error("expected call to be allowed after waiting")
+	}
+	if _, ok := rl.allow("AddPicComment", "user:1", limit); ok {
+		t.Error("expected only one call to be earned back")
+	}
+}
+
+func TestRateLimiterIgnoresBadLimits(t *testing.T) {
+	rl := newRateLimiter(time.Now)
+	limits := []*schema.Configuration_RateLimit{
+		{Burst: 0, Interval: ptypes.DurationProto(time.Second)},
+		{Burst: 1},
+	}
+	for i, limit := range limits {
+		for k := 0; k < 5; k++ {
+			if _, ok := rl.allow("AddPicComment", "user:1", limit); !ok {
+				t.Error(i, "expected call to be allowed")
+			}
+		}
+	}
+}
+
+func TestRateLimiterForgetsIdleClients(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rl := newRateLimiter(func() time.Time { return now })
+	limit := &schema.Configuration_RateLimit{
+		Burst:    1,
+		Interval: ptypes.DurationProto(time.Second),
+	}
+	rl.allow("AddPicComment", "user:1", limit)
+	now = now.Add(rateLimitSweepInterval)
+	rl.allow("AddPicComment", "user:2", limit)
+	if _, present := rl.tat[rateLimitKey{method: "AddPicComment", client: "user:1"}]; present {
+		t.Error("expected idle client to be forgotten")
+	}
+	if len(rl.tat) != 1 {
+		t.Error("expected one client", rl.tat)
+	}
+}
+
+func TestRateLimitClient(t *testing.T) {
+	ctx := ctxFromClientAddr(context.Background(), clientAddr{host: "10.0.0.1"})
+	if have, want := rateLimitClient(ctx), "addr:10.0.0.1"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	// Api keys aren't validated yet, so each random key mustn't get its own limit.
+	ctx = tasks.CtxFromApiKey(ctx, "key")
+	if have, want := rateLimitClient(ctx), "addr:10.0.0.1"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	// The frontend logs in many users with the same key.
+	fctx := ctxFromClientAddr(ctx, clientAddr{host: "192.168.1.1", forwarded: true})
+	if have, want := rateLimitClient(fctx), "addr:192.168.1.1"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	ctx = tasks.CtxFromUserToken(ctx, 7, 1)
+	if have, want := rateLimitClient(ctx), "user:7"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindClientAddr(t *testing.T) {
+	_, trusted, _ := net.ParseCIDR("10.0.0.0/8")
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234},
+	})
+	if have, want := findClientAddr(ctx, nil), (clientAddr{host: "10.0.0.1"}); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(clientAddrHeaderKey, "192.168.1.1"))
+	if have, want := findClientAddr(ctx, nil), (clientAddr{host: "10.0.0.1"}); have != want {
+		t.Error("have", have, "want", want)
+	}
+	want := clientAddr{host: "192.168.1.1", forwarded: true}
+	if have := findClientAddr(ctx, []*net.IPNet{trusted}); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	// Forwarded addresses must be ips, even from trusted proxies.
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(clientAddrHeaderKey, "bogus"))
+	want = clientAddr{host: "10.0.0.1"}
+	if have := findClientAddr(ctx, []*net.IPNet{trusted}); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestServerInterceptorIgnoresSpoofedClientAddr(t *testing.T) {
+	conf := schema.GetDefaultConfiguration()
+	conf.RateLimit = &schema.Configuration_RateLimitSet{
+		RateLimit: map[string]*schema.Configuration_RateLimit{
+			"CreateUser": {
+				Burst:    1,
+				Interval: ptypes.DurationProto(time.Minute),
+			},
+		},
+	}
+	_, trusted, _ := net.ParseCIDR("10.0.0.0/8")
+	si := &serverInterceptor{
+		limiter:        newRateLimiter(time.Now),
+		trustedProxies: []*net.IPNet{trusted},
+	}
+	handler := grpc.UnaryHandler(func(ctx oldctx.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/pixur.api.PixurService/CreateUser",
+	}
+	call := func(peerIP net.IP, forwarded string) error {
+		ctx := tasks.CtxFromTestConfig(context.Background(), conf)
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: peerIP, Port: 1234}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(clientAddrHeaderKey, forwarded))
+		_, err := si.intercept(ctx, 1, info, handler)
+		return err
+	}
+
+	untrusted := net.IPv4(192, 168, 0, 1)
+	if err := call(untrusted, "172.16.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	// A different forwarded address from the same untrusted peer is the same client.
+	err := call(untrusted, "172.16.0.2")
+	if have, want := gstatus.Code(err), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want, err)
+	}
+
+	// Trusted proxies forward for many clients, each with their own limit.
+	proxy := net.IPv4(10, 0, 0, 1)
+	if err := call(proxy, "172.16.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := call(proxy, "172.16.0.2"); err != nil {
+		t.Error(err)
+	}
+	err = call(proxy, "172.16.0.2")
+	if have, want := gstatus.Code(err), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want, err)
+	}
+}
+
+func TestServerInterceptorTrustsLoopbackByDefault(t *testing.T) {
+	conf := schema.GetDefaultConfiguration()
+	conf.RateLimit = &schema.Configuration_RateLimitSet{
+		RateLimit: map[string]*schema.Configuration_RateLimit{
+			"GetRefreshToken": {
+				Burst:    1,
+				Interval: ptypes.DurationProto(time.Minute),
+			},
+		},
+	}
+	si := &serverInterceptor{
+		limiter:        newRateLimiter(time.Now),
+		trustedProxies: trustedProxiesOrDefault(nil),
+	}
+	handler := grpc.UnaryHandler(func(ctx oldctx.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/pixur.api.PixurService/GetRefreshToken",
+	}
+	call := func(peerIP net.IP, forwarded string) error {
+		ctx := tasks.CtxFromTestConfig(context.Background(), conf)
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: peerIP, Port: 1234}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(clientAddrHeaderKey, forwarded))
+		_, err := si.intercept(ctx, &api.GetRefreshTokenRequest{}, info, handler)
+		return err
+	}
+
+	// The frontend on the same host logs in many users, each with their own limit.
+	for _, fe := range []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback} {
+		if err := call(fe, "172.16.0.1"); err != nil {
+			t.Fatal(err)
+		}
+		if err := call(fe, "172.16.0.2"); err != nil {
+			t.Error(err)
+		}
+		err := call(fe, "172.16.0.2")
+		if have, want := gstatus.Code(err), codes.ResourceExhausted; have != want {
+			t.Error("have", have, "want", want, err)
+		}
+		si.limiter = newRateLimiter(time.Now)
+	}
+
+	// Other peers still aren't trusted.
+	untrusted := net.IPv4(192, 168, 0, 1)
+	if err := call(untrusted, "172.16.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	err := call(untrusted, "172.16.0.2")
+	if have, want := gstatus.Code(err), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want, err)
+	}
+}
+
+func TestServerInterceptorRateLimits(t *testing.T) {
+	conf := schema.GetDefaultConfiguration()
+	conf.RateLimit = &schema.Configuration_RateLimitSet{
+		RateLimit: map[string]*schema.Configuration_RateLimit{
+			"AddPicComment": {
+				Burst:    1,
+				Interval: ptypes.DurationProto(time.Minute),
+			},
+		},
+	}
+	ctx := tasks.CtxFromTestConfig(context.Background(), conf)
+	si := &serverInterceptor{
+		limiter: newRateLimiter(time.Now),
+	}
+	var calls int
+	handler := grpc.UnaryHandler(func(ctx oldctx.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, nil
+	})
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/pixur.api.PixurService/AddPicComment",
+	}
+	if _, err := si.intercept(ctx, 1, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	_, err := si.intercept(ctx, 1, info, handler)
+	if have, want := gstatus.Code(err), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want, err)
+	}
+	if calls != 1 {
+		t.Error("expected one call", calls)
+	}
+
+	// Unlimited methods are unaffected.
+	info.FullMethod = "/pixur.api.PixurService/FindIndexPics"
+	for i := 0; i < 3; i++ {
+		if _, err := si.intercept(ctx, 1, info, handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
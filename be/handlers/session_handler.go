@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleListSessions(ctx context.Context, req *api.ListSessionsRequest) (
+	*api.ListSessionsResponse, status.S) {
+	var task = &tasks.ListSessionsTask{
+		Beg: s.db,
+		Now: s.now,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.ListSessionsResponse{}
+	for _, ut := range task.Sessions {
+		resp.Session = append(resp.Session, apiSession(ut, task.CurrentTokenId))
+	}
+	return resp, nil
+}
+
+func (s *serv) handleRevokeSession(ctx context.Context, req *api.RevokeSessionRequest) (
+	*api.RevokeSessionResponse, status.S) {
+	var sessionId schema.Varint
+	if !req.All {
+		if err := sessionId.DecodeAll(req.SessionId); err != nil {
+			return nil, status.InvalidArgument(err, "bad session id")
+		}
+	}
+
+	var task = &tasks.RevokeSessionTask{
+		Beg:     s.db,
+		Now:     s.now,
+		TokenId: int64(sessionId),
+		All:     req.All,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.RevokeSessionResponse{}, nil
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestListSessions(t *testing.T) {
+	now := time.Now()
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap := task.(*tasks.ListSessionsTask)
+		taskCap.Sessions = []*schema.UserToken{{
+			TokenId:    2,
+			CreatedTs:  schema.ToTspb(now),
+			LastSeenTs: schema.ToTspb(now),
+			UserAgent:  "browser",
+			RemoteAddr: "192.0.2.1",
+		}, {
+			TokenId: 1,
+		}}
+		taskCap.CurrentTokenId = 2
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleListSessions(context.Background(), &api.ListSessionsRequest{})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+
+	want := &api.ListSessionsResponse{
+		Session: []*api.Session{{
+			SessionId:    schema.Varint(2).Encode(),
+			CreatedTime:  schema.ToTspb(now),
+			LastSeenTime: schema.ToTspb(now),
+			UserAgent:    "browser",
+			RemoteAddr:   "192.0.2.1",
+			Current:      true,
+		}, {
+			SessionId: schema.Varint(1).Encode(),
+		}},
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+}
+
+func TestRevokeSessionFailsOnBadId(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Error("task should not run")
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleRevokeSession(context.Background(), &api.RevokeSessionRequest{
+		SessionId: "x",
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad session id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRevokeSessionFailsOnMissingId(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Error("task should not run")
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleRevokeSession(context.Background(), &api.RevokeSessionRequest{})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	var taskCap *tasks.RevokeSessionTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RevokeSessionTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleRevokeSession(context.Background(), &api.RevokeSessionRequest{
+		All: true,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if !taskCap.All || taskCap.TokenId != 0 {
+		t.Error("bad task", taskCap)
+	}
+}
+
+func TestRevokeSessionById(t *testing.T) {
+	var taskCap *tasks.RevokeSessionTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RevokeSessionTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleRevokeSession(context.Background(), &api.RevokeSessionRequest{
+		SessionId: schema.Varint(2).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.All || taskCap.TokenId != 2 {
+		t.Error("bad task", taskCap)
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleSuspendUser(ctx context.Context, req *api.SuspendUserRequest) (
+	*api.SuspendUserResponse, status.S) {
+	var userId schema.Varint
+	if err := userId.DecodeAll(req.UserId); err != nil {
+		return nil, status.InvalidArgument(err, "bad user id")
+	}
+	var duration time.Duration
+	if req.Duration != nil {
+		var err error
+		if duration, err = ptypes.Duration(req.Duration); err != nil || duration <= 0 {
+			return nil, status.InvalidArgument(err, "bad duration")
+		}
+	}
+
+	var task = &tasks.SuspendUserTask{
+		Beg:          s.db,
+		Now:          s.now,
+		ObjectUserId: int64(userId),
+		Reason:       req.Reason,
+		Duration:     duration,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.SuspendUserResponse{
+		User: apiUser(task.User),
+	}, nil
+}
+
+func (s *serv) handleUnsuspendUser(ctx context.Context, req *api.UnsuspendUserRequest) (
+	*api.UnsuspendUserResponse, status.S) {
+	var userId schema.Varint
+	if err := userId.DecodeAll(req.UserId); err != nil {
+		return nil, status.InvalidArgument(err, "bad user id")
+	}
+
+	var task = &tasks.UnsuspendUserTask{
+		Beg:          s.db,
+		Now:          s.now,
+		ObjectUserId: int64(userId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.UnsuspendUserResponse{
+		User: apiUser(task.User),
+	}, nil
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestSuspendUserFailsOnBadDuration(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Error("task should not run")
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleSuspendUser(context.Background(), &api.SuspendUserRequest{
+		UserId:   schema.Varint(2).Encode(),
+		Reason:   "spam",
+		Duration: ptypes.DurationProto(-time.Second),
+	})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestSuspendUser(t *testing.T) {
+	var taskCap *tasks.SuspendUserTask
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			taskCap = task.(*tasks.SuspendUserTask)
+			taskCap.User = &schema.User{
+				UserId:     2,
+				ModifiedTs: schema.ToTspb(time.Now()),
+				Suspension: &schema.User_Suspension{
+					Reason:       taskCap.Reason,
+					IssuerUserId: 1,
+				},
+			}
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	resp, sts := s.handleSuspendUser(context.Background(), &api.SuspendUserRequest{
+		UserId:   schema.Varint(2).Encode(),
+		Reason:   "spam",
+		Duration: ptypes.DurationProto(time.Hour),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.ObjectUserId != 2 || taskCap.Reason != "spam" || taskCap.Duration != time.Hour {
+		t.Error("bad task", taskCap)
+	}
+	if have, want := resp.User.GetSuspension().GetIssuerUserId(), schema.Varint(1).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUnsuspendUser(t *testing.T) {
+	var taskCap *tasks.UnsuspendUserTask
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			taskCap = task.(*tasks.UnsuspendUserTask)
+			taskCap.User = &schema.User{UserId: 2, ModifiedTs: schema.ToTspb(time.Now())}
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	resp, sts := s.handleUnsuspendUser(context.Background(), &api.UnsuspendUserRequest{
+		UserId: schema.Varint(2).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.ObjectUserId != 2 {
+		t.Error("bad task", taskCap)
+	}
+	if resp.User.Suspension != nil {
+		t.Error("expected no suspension", resp.User)
+	}
+}
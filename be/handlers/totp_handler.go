@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+	"pixur.org/pixur/be/totp"
+)
+
+func (s *serv) handleStartTotpEnrollment(ctx context.Context, req *api.StartTotpEnrollmentRequest) (
+	*api.StartTotpEnrollmentResponse, status.S) {
+	var task = &tasks.StartTotpEnrollmentTask{
+		Beg:    s.db,
+		Now:    s.now,
+		Rand:   s.rand,
+		Secret: req.Secret,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.StartTotpEnrollmentResponse{
+		Secret: totp.EncodeSecret(task.TotpSecret),
+		KeyUri: task.KeyURI,
+	}, nil
+}
+
+func (s *serv) handleFinishTotpEnrollment(ctx context.Context, req *api.FinishTotpEnrollmentRequest) (
+	*api.FinishTotpEnrollmentResponse, status.S) {
+	var task = &tasks.FinishTotpEnrollmentTask{
+		Beg:  s.db,
+		Now:  s.now,
+		Rand: s.rand,
+		Code: req.Code,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.FinishTotpEnrollmentResponse{
+		RecoveryCode: task.RecoveryCode,
+	}, nil
+}
+
+func (s *serv) handleDisableTotp(ctx context.Context, req *api.DisableTotpRequest) (
+	*api.DisableTotpResponse, status.S) {
+	var task = &tasks.DisableTotpTask{
+		Beg:    s.db,
+		Now:    s.now,
+		Secret: req.Secret,
+		Code:   req.Code,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.DisableTotpResponse{}, nil
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestStartTotpEnrollmentFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Unauthenticated(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleStartTotpEnrollment(context.Background(), &api.StartTotpEnrollmentRequest{})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestStartTotpEnrollment(t *testing.T) {
+	var taskCap *tasks.StartTotpEnrollmentTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.StartTotpEnrollmentTask)
+		taskCap.TotpSecret = []byte("12345678901234567890")
+		taskCap.KeyURI = "otpauth://totp/uri"
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleStartTotpEnrollment(context.Background(), &api.StartTotpEnrollmentRequest{
+		Secret: "secret",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Secret != "secret" || taskCap.CompareHashAndPassword != nil {
+		t.Error("bad task", taskCap)
+	}
+	want := &api.StartTotpEnrollmentResponse{
+		Secret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+		KeyUri: "otpauth://totp/uri",
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+}
+
+func TestFinishTotpEnrollment(t *testing.T) {
+	var taskCap *tasks.FinishTotpEnrollmentTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FinishTotpEnrollmentTask)
+		taskCap.RecoveryCode = []string{"a", "b"}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleFinishTotpEnrollment(context.Background(), &api.FinishTotpEnrollmentRequest{
+		Code: "123456",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Code != "123456" {
+		t.Error("bad task", taskCap)
+	}
+	want := &api.FinishTotpEnrollmentResponse{
+		RecoveryCode: []string{"a", "b"},
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+}
+
+func TestDisableTotp(t *testing.T) {
+	var taskCap *tasks.DisableTotpTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.DisableTotpTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleDisableTotp(context.Background(), &api.DisableTotpRequest{
+		Secret: "secret",
+		Code:   "123456",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Secret != "secret" || taskCap.Code != "123456" || taskCap.CompareHashAndPassword != nil {
+		t.Error("bad task", taskCap)
+	}
+}
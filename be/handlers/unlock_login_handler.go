@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"net"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleUnlockLogin(ctx context.Context, req *api.UnlockLoginRequest) (
+	*api.UnlockLoginResponse, status.S) {
+	var userId schema.Varint
+	if req.UserId != "" {
+		if err := userId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+
+	// Addresses are locked out in the same form that clientAddr finds them.
+	var remoteAddr string
+	if req.RemoteAddr != "" {
+		ip := net.ParseIP(req.RemoteAddr)
+		if ip == nil {
+			return nil, status.InvalidArgument(nil, "bad remote addr")
+		}
+		remoteAddr = ip.String()
+	}
+
+	var task = &tasks.UnlockLoginTask{
+		Beg:        s.db,
+		Now:        s.now,
+		UserId:     int64(userId),
+		RemoteAddr: remoteAddr,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.UnlockLoginResponse{}, nil
+}
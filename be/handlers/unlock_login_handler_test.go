@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestUnlockLoginFailsOnBadUserId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleUnlockLogin(context.Background(), &api.UnlockLoginRequest{
+		UserId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad user id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUnlockLoginFailsOnBadRemoteAddr(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleUnlockLogin(context.Background(), &api.UnlockLoginRequest{
+		RemoteAddr: "10.0.0.1:1234",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad remote addr"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUnlockLogin(t *testing.T) {
+	var taskCap *tasks.UnlockLoginTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.UnlockLoginTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+	}
+	_, sts := s.handleUnlockLogin(context.Background(), &api.UnlockLoginRequest{
+		UserId:     "2",
+		RemoteAddr: "2001:DB8::0:1",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := taskCap.UserId, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+	// Addresses are unlocked in the same form they are locked out in.
+	if have, want := taskCap.RemoteAddr, "2001:db8::1"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -62,6 +62,24 @@ func (s *serv) handleUpdateUser(ctx context.Context, req *api.UpdateUserRequest)
 		Version:         req.Version,
 		SetCapability:   newcaps,
 		ClearCapability: oldcaps,
+		Reason:          req.Reason,
+	}
+	if req.Role != nil {
+		task.SetRole = req.Role.SetRole
+		task.ClearRole = req.Role.ClearRole
+	}
+	if req.Profile != nil {
+		var avatarPicId schema.Varint
+		if id := req.Profile.Profile.GetAvatarPicId(); id != "" {
+			if err := avatarPicId.DecodeAll(id); err != nil {
+				return nil, status.InvalidArgument(err, "bad avatar pic id")
+			}
+		}
+		task.Profile = &schema.User_Profile{
+			DisplayName: req.Profile.Profile.GetDisplayName(),
+			Bio:         req.Profile.Profile.GetBio(),
+			AvatarPicId: int64(avatarPicId),
+		}
 	}
 
 	if sts := s.runner.Run(ctx, task); sts != nil {
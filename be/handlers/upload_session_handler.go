@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"context"
+	"crypto/md5"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+// uploadSessionSweepInterval is how often expired upload sessions are removed.
+const uploadSessionSweepInterval = time.Hour
+
+// SweepUploadSessions removes expired upload sessions, and their files, every
+// uploadSessionSweepInterval until ctx is done.
+func SweepUploadSessions(ctx context.Context, beg db.DB, pixPath string) {
+	s := &serv{
+		db:      beg,
+		pixpath: pixPath,
+		now:     time.Now,
+	}
+	ticker := time.NewTicker(uploadSessionSweepInterval)
+	defer ticker.Stop()
+	for {
+		s.expireUploadSessions(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// expireUploadSessions removes expired upload sessions until there are none left.  The task
+// removes a limited number at once, so it is run repeatedly.
+func (s *serv) expireUploadSessions(ctx context.Context) {
+	for {
+		task := &tasks.ExpireUploadSessionsTask{
+			PixPath: s.pixpath,
+			Beg:     s.db,
+			Now:     s.now,
+			Remove:  os.Remove,
+		}
+		if sts := s.runner.Run(ctx, task); sts != nil {
+			glog.Warning("can't expire upload sessions: ", sts)
+			return
+		}
+		if len(task.ExpiredUploadSessions) == 0 {
+			return
+		}
+	}
+}
+
+func decodeUploadSessionId(rawId string) (int64, status.S) {
+	var uploadSessionId schema.Varint
+	if err := uploadSessionId.DecodeAll(rawId); err != nil {
+		return 0, status.InvalidArgument(err, "bad upload session id")
+	}
+	return int64(uploadSessionId), nil
+}
+
+func (s *serv) handleStartUploadSession(ctx context.Context, req *api.StartUploadSessionRequest) (
+	*api.StartUploadSessionResponse, status.S) {
+	if len(req.Md5Hash) != md5.Size {
+		return nil, status.InvalidArgument(nil, "bad md5 hash")
+	}
+
+	var task = &tasks.StartUploadSessionTask{
+		PixPath:  s.pixpath,
+		Beg:      s.db,
+		Now:      s.now,
+		MkdirAll: os.MkdirAll,
+		OpenFile: os.OpenFile,
+		Remove:   os.Remove,
+
+		FileURL:         req.Url,
+		FileURLReferrer: req.Referrer,
+		FileName:        req.Name,
+		Md5Hash:         req.Md5Hash,
+		Ext:             req.Ext,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+	conf, sts := tasks.GetConfiguration(ctx)
+	if sts != nil {
+		return nil, sts
+	}
+
+	return &api.StartUploadSessionResponse{
+		UploadSession: apiUploadSession(task.CreatedUploadSession, 0, conf),
+	}, nil
+}
+
+func (s *serv) handleAppendUploadSession(ctx context.Context, req *api.AppendUploadSessionRequest) (
+	*api.AppendUploadSessionResponse, status.S) {
+	uploadSessionId, sts := decodeUploadSessionId(req.UploadSessionId)
+	if sts != nil {
+		return nil, sts
+	}
+	if req.Offset < 0 {
+		return nil, status.InvalidArgument(nil, "bad offset")
+	}
+
+	var task = &tasks.AppendUploadSessionTask{
+		PixPath:  s.pixpath,
+		Beg:      s.db,
+		Now:      s.now,
+		OpenFile: os.OpenFile,
+
+		UploadSessionId: uploadSessionId,
+		Offset:          req.Offset,
+		Data:            req.Data,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+	conf, sts := tasks.GetConfiguration(ctx)
+	if sts != nil {
+		return nil, sts
+	}
+
+	return &api.AppendUploadSessionResponse{
+		UploadSession: apiUploadSession(task.UploadSession, task.NextOffset, conf),
+	}, nil
+}
+
+func (s *serv) handleLookupUploadSession(ctx context.Context, req *api.LookupUploadSessionRequest) (
+	*api.LookupUploadSessionResponse, status.S) {
+	uploadSessionId, sts := decodeUploadSessionId(req.UploadSessionId)
+	if sts != nil {
+		return nil, sts
+	}
+
+	var task = &tasks.LookupUploadSessionTask{
+		PixPath: s.pixpath,
+		Beg:     s.db,
+		Now:     s.now,
+		Stat:    os.Stat,
+
+		UploadSessionId: uploadSessionId,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+	conf, sts := tasks.GetConfiguration(ctx)
+	if sts != nil {
+		return nil, sts
+	}
+
+	return &api.LookupUploadSessionResponse{
+		UploadSession: apiUploadSession(task.UploadSession, task.Offset, conf),
+	}, nil
+}
+
+func (s *serv) handleFinishUploadSession(ctx context.Context, req *api.FinishUploadSessionRequest) (
+	_ *api.FinishUploadSessionResponse, stscap status.S) {
+	uploadSessionId, sts := decodeUploadSessionId(req.UploadSessionId)
+	if sts != nil {
+		return nil, sts
+	}
+
+	var lookupTask = &tasks.LookupUploadSessionTask{
+		PixPath: s.pixpath,
+		Beg:     s.db,
+		Now:     s.now,
+		Stat:    os.Stat,
+
+		UploadSessionId: uploadSessionId,
+	}
+	if sts := s.runner.Run(ctx, lookupTask); sts != nil {
+		return nil, sts
+	}
+	us := lookupTask.UploadSession
+
+	path := schema.UploadSessionPath(s.pixpath, us.UploadSessionId)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, status.Internal(err, "can't open upload session file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't close", path))
+		}
+	}()
+
+	var upsertTask = &tasks.UpsertPicTask{
+		PixPath:    s.pixpath,
+		Beg:        s.db,
+		HTTPClient: remoteFetchClient,
+		TempFile:   ioutil.TempFile,
+		Rename:     os.Rename,
+		MkdirAll:   os.MkdirAll,
+		Now:        s.now,
+		Remove:     os.Remove,
+
+		FileURL:         us.Url,
+		FileURLReferrer: us.Referrer,
+		File:            f,
+		Md5Hash:         us.Md5Hash,
+		FileName:        us.Name,
+		Ext:             us.Ext,
+	}
+	if sts := s.runner.Run(ctx, upsertTask); sts != nil {
+		return nil, sts
+	}
+
+	var deleteTask = &tasks.DeleteUploadSessionTask{
+		PixPath: s.pixpath,
+		Beg:     s.db,
+		Now:     s.now,
+		Remove:  os.Remove,
+
+		UploadSessionId: us.UploadSessionId,
+	}
+	if sts := s.runner.Run(ctx, deleteTask); sts != nil {
+		// The pic was created, so don't fail the request.  The session will expire eventually.
+		glog.Warning("can't delete upload session: ", sts)
+	}
+
+	return &api.FinishUploadSessionResponse{
+		Pic: apiPic(upsertTask.CreatedPic),
+	}, nil
+}
@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestStartUploadSessionFailsOnBadMd5(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleStartUploadSession(context.Background(), &api.StartUploadSessionRequest{
+		Md5Hash: []byte("x"),
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad md5 hash"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestExpireUploadSessionsRunsUntilNoneLeft(t *testing.T) {
+	var runs int
+	runner := func(ctx context.Context, task tasks.Task) status.S {
+		runs++
+		if runs < 3 {
+			task.(*tasks.ExpireUploadSessionsTask).ExpiredUploadSessions = []*schema.UploadSession{{
+				UploadSessionId: int64(runs),
+			}}
+		}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(runner),
+		now:    time.Now,
+	}
+	s.expireUploadSessions(context.Background())
+	if have, want := runs, 3; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestExpireUploadSessionsStopsOnError(t *testing.T) {
+	var runs int
+	runner := func(ctx context.Context, task tasks.Task) status.S {
+		runs++
+		task.(*tasks.ExpireUploadSessionsTask).ExpiredUploadSessions = []*schema.UploadSession{{
+			UploadSessionId: 1,
+		}}
+		return status.Internal(nil, "badness")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(runner),
+		now:    time.Now,
+	}
+	s.expireUploadSessions(context.Background())
+	if have, want := runs, 1; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestStartUploadSession(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.StartUploadSessionTask
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		switch task := task.(type) {
+		case *tasks.StartUploadSessionTask:
+			taskCap = task
+			task.CreatedUploadSession = &schema.UploadSession{
+				UploadSessionId: 2,
+			}
+			task.CreatedUploadSession.SetCreatedTime(now)
+			task.CreatedUploadSession.SetModifiedTime(now)
+		default:
+			t.Fatalf("unexpected task %T", task)
+		}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	ctx := tasks.CtxFromTestConfig(context.Background(), schema.GetDefaultConfiguration())
+	res, sts := s.handleStartUploadSession(ctx, &api.StartUploadSessionRequest{
+		Url:     "http://foo/",
+		Name:    "bar",
+		Md5Hash: []byte("0123456789abcdef"),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.FileURL, "http://foo/"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.FileName, "bar"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if taskCap.MkdirAll == nil || taskCap.OpenFile == nil || taskCap.Remove == nil {
+		t.Error("deps are nil", taskCap)
+	}
+	if have, want := res.UploadSession.UploadSessionId, schema.Varint(2).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if res.UploadSession.ExpireTime == nil {
+		t.Error("missing expire time", res.UploadSession)
+	}
+}
+
+func TestAppendUploadSessionFailsOnBadId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleAppendUploadSession(context.Background(), &api.AppendUploadSessionRequest{
+		UploadSessionId: "%",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad upload session id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAppendUploadSessionFailsOnBadOffset(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleAppendUploadSession(context.Background(), &api.AppendUploadSessionRequest{
+		UploadSessionId: schema.Varint(2).Encode(),
+		Offset:          -1,
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad offset"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAppendUploadSession(t *testing.T) {
+	var taskCap *tasks.AppendUploadSessionTask
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.AppendUploadSessionTask)
+		taskCap.UploadSession = &schema.UploadSession{
+			UploadSessionId: taskCap.UploadSessionId,
+		}
+		taskCap.UploadSession.SetCreatedTime(time.Now())
+		taskCap.UploadSession.SetModifiedTime(time.Now())
+		taskCap.NextOffset = taskCap.Offset + int64(len(taskCap.Data))
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	ctx := tasks.CtxFromTestConfig(context.Background(), schema.GetDefaultConfiguration())
+	res, sts := s.handleAppendUploadSession(ctx, &api.AppendUploadSessionRequest{
+		UploadSessionId: schema.Varint(2).Encode(),
+		Offset:          3,
+		Data:            []byte("data"),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := taskCap.UploadSessionId, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if taskCap.OpenFile == nil {
+		t.Error("deps are nil", taskCap)
+	}
+	if have, want := res.UploadSession.Offset, int64(7); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLookupUploadSession(t *testing.T) {
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap := task.(*tasks.LookupUploadSessionTask)
+		taskCap.UploadSession = &schema.UploadSession{
+			UploadSessionId: taskCap.UploadSessionId,
+		}
+		taskCap.UploadSession.SetCreatedTime(time.Now())
+		taskCap.UploadSession.SetModifiedTime(time.Now())
+		taskCap.Offset = 5
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	ctx := tasks.CtxFromTestConfig(context.Background(), schema.GetDefaultConfiguration())
+	res, sts := s.handleLookupUploadSession(ctx, &api.LookupUploadSessionRequest{
+		UploadSessionId: schema.Varint(2).Encode(),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := res.UploadSession.UploadSessionId, schema.Varint(2).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := res.UploadSession.Offset, int64(5); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFinishUploadSessionFailsOnLookup(t *testing.T) {
+	runner := func(ctx context.Context, task tasks.Task) status.S {
+		if _, ok := task.(*tasks.LookupUploadSessionTask); !ok {
+			t.Fatalf("unexpected task %T", task)
+		}
+		return status.NotFound(nil, "can't find upload session")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(runner),
+		now:    time.Now,
+	}
+	_, sts := s.handleFinishUploadSession(context.Background(), &api.FinishUploadSessionRequest{
+		UploadSessionId: schema.Varint(2).Encode(),
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -6,14 +6,17 @@ import (
 	"crypto/md5"
 	"io/ioutil"
 	"mime/multipart"
-	"net/http"
 	"os"
 
 	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/fetch"
 	"pixur.org/pixur/be/status"
 	"pixur.org/pixur/be/tasks"
 )
 
+// remoteFetchClient downloads pics by URL.  It refuses to connect to non-public addresses.
+var remoteFetchClient = fetch.NewClient(new(fetch.Dialer))
+
 var _ multipart.File = memFile{}
 
 type memFile struct {
@@ -42,7 +45,7 @@ func (s *serv) handleUpsertPic(ctx context.Context, req *api.UpsertPicRequest) (
 	var task = &tasks.UpsertPicTask{
 		PixPath:    s.pixpath,
 		Beg:        s.db,
-		HTTPClient: http.DefaultClient,
+		HTTPClient: remoteFetchClient,
 		TempFile:   ioutil.TempFile,
 		Rename:     os.Rename,
 		MkdirAll:   os.MkdirAll,
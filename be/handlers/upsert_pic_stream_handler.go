@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"crypto/md5"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+// upsertPicStreamReader adapts the chunks of an upsert stream into an io.Reader.
+type upsertPicStreamReader struct {
+	ups api.PixurService_UpsertPicStreamServer
+	buf []byte
+}
+
+func (r *upsertPicStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.ups.Recv()
+		if err != nil {
+			return 0, err
+		}
+		chunk, ok := req.Part.(*api.UpsertPicStreamRequest_Chunk)
+		if !ok {
+			return 0, status.InvalidArgument(nil, "expected chunk")
+		}
+		r.buf = chunk.Chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// handleUpsertPicStream passes the chunks to the task, which checks the capability of the user
+// before reading them.
+func (s *serv) handleUpsertPicStream(ups api.PixurService_UpsertPicStreamServer) (stscap status.S) {
+	req, err := ups.Recv()
+	if err == io.EOF {
+		return status.InvalidArgument(nil, "missing metadata")
+	} else if err != nil {
+		return status.Internal(err, "can't recv")
+	}
+	md := req.GetMetadata()
+	if md == nil {
+		return status.InvalidArgument(nil, "first message must be metadata")
+	}
+	if len(md.Md5Hash) != md5.Size {
+		return status.InvalidArgument(nil, "bad md5 hash")
+	}
+
+	var task = &tasks.UpsertPicTask{
+		PixPath:    s.pixpath,
+		Beg:        s.db,
+		HTTPClient: remoteFetchClient,
+		TempFile:   ioutil.TempFile,
+		Rename:     os.Rename,
+		MkdirAll:   os.MkdirAll,
+		Now:        s.now,
+		Remove:     os.Remove,
+
+		FileURL:         md.Url,
+		FileURLReferrer: md.Referrer,
+		FileStream:      &upsertPicStreamReader{ups: ups},
+		Md5Hash:         md.Md5Hash,
+		FileName:        md.Name,
+		Ext:             md.Ext,
+	}
+
+	defer func() {
+		if sts := task.CleanUp(); sts != nil {
+			status.ReplaceOrSuppress(&stscap, sts)
+		}
+	}()
+	if sts := s.runner.Run(ups.Context(), task); sts != nil {
+		return sts
+	}
+
+	if err := ups.SendAndClose(&api.UpsertPicStreamResponse{
+		Pic: apiPic(task.CreatedPic),
+	}); err != nil {
+		return status.Internal(err, "can't send")
+	}
+	return nil
+}
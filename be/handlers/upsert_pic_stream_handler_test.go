@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+type testUpsertPicStreamServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*api.UpsertPicStreamRequest
+	resp *api.UpsertPicStreamResponse
+}
+
+func (s *testUpsertPicStreamServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testUpsertPicStreamServer) Recv() (*api.UpsertPicStreamRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *testUpsertPicStreamServer) SendAndClose(resp *api.UpsertPicStreamResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestUpsertPicStreamFailsOnMissingMetadata(t *testing.T) {
+	s := &serv{}
+	sts := s.handleUpsertPicStream(&testUpsertPicStreamServer{
+		ctx: context.Background(),
+		reqs: []*api.UpsertPicStreamRequest{{
+			Part: &api.UpsertPicStreamRequest_Chunk{Chunk: []byte("a")},
+		}},
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "first message must be metadata"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertPicStreamFailsOnBadMd5(t *testing.T) {
+	s := &serv{}
+	sts := s.handleUpsertPicStream(&testUpsertPicStreamServer{
+		ctx: context.Background(),
+		reqs: []*api.UpsertPicStreamRequest{{
+			Part: &api.UpsertPicStreamRequest_Metadata_{
+				Metadata: &api.UpsertPicStreamRequest_Metadata{
+					Md5Hash: []byte("x"),
+				},
+			},
+		}},
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad md5 hash"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertPicStream(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.UpsertPicTask
+	var dataCap []byte
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.UpsertPicTask)
+		data, err := ioutil.ReadAll(taskCap.FileStream)
+		if err != nil {
+			return status.From(err)
+		}
+		dataCap = data
+		taskCap.CreatedPic = new(schema.Pic)
+		taskCap.CreatedPic.SetCreatedTime(now)
+		taskCap.CreatedPic.SetModifiedTime(now)
+
+		taskCap.CreatedPic.File = &schema.Pic_File{
+			Mime: schema.Pic_File_JPEG,
+		}
+		taskCap.CreatedPic.Thumbnail = []*schema.Pic_File{{
+			Mime: schema.Pic_File_JPEG,
+		}}
+
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	ups := &testUpsertPicStreamServer{
+		ctx: context.Background(),
+		reqs: []*api.UpsertPicStreamRequest{{
+			Part: &api.UpsertPicStreamRequest_Metadata_{
+				Metadata: &api.UpsertPicStreamRequest_Metadata{
+					Url:      "http://foo/",
+					Referrer: "http://bar/",
+					Name:     "bar",
+					Md5Hash:  []byte("0123456789abcdef"),
+				},
+			},
+		}, {
+			Part: &api.UpsertPicStreamRequest_Chunk{Chunk: []byte("ab")},
+		}, {
+			Part: &api.UpsertPicStreamRequest_Chunk{Chunk: []byte("")},
+		}, {
+			Part: &api.UpsertPicStreamRequest_Chunk{Chunk: []byte("cd")},
+		}},
+	}
+	if sts := s.handleUpsertPicStream(ups); sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.FileURL, "http://foo/"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.FileURLReferrer, "http://bar/"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.FileName, "bar"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if taskCap.File != nil {
+		t.Error("file is not nil")
+	}
+	if have, want := string(dataCap), "abcd"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if taskCap.HTTPClient == nil || taskCap.TempFile == nil || taskCap.Rename == nil ||
+		taskCap.MkdirAll == nil || taskCap.Now == nil {
+		t.Error("deps are nil", taskCap)
+	}
+	if len(taskCap.Md5Hash) != 16 {
+		t.Error("bad md5 hash", taskCap.Md5Hash)
+	}
+	if ups.resp == nil || ups.resp.Pic == nil {
+		t.Error("bad response", ups.resp)
+	}
+}
+
+func TestUpsertPicStreamFailsOnExtraMetadata(t *testing.T) {
+	md := &api.UpsertPicStreamRequest_Metadata_{
+		Metadata: &api.UpsertPicStreamRequest_Metadata{
+			Md5Hash: []byte("0123456789abcdef"),
+		},
+	}
+	runner := func(ctx context.Context, task tasks.Task) status.S {
+		_, err := ioutil.ReadAll(task.(*tasks.UpsertPicTask).FileStream)
+		return status.From(err)
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(runner),
+		now:    time.Now,
+	}
+	sts := s.handleUpsertPicStream(&testUpsertPicStreamServer{
+		ctx:  context.Background(),
+		reqs: []*api.UpsertPicStreamRequest{{Part: md}, {Part: md}},
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "expected chunk"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertPicStreamDoesntReadOnTaskFailure(t *testing.T) {
+	runner := func(ctx context.Context, task tasks.Task) status.S {
+		return status.PermissionDenied(nil, "missing cap PIC_CREATE")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(runner),
+		now:    time.Now,
+	}
+	ups := &testUpsertPicStreamServer{
+		ctx: context.Background(),
+		reqs: []*api.UpsertPicStreamRequest{{
+			Part: &api.UpsertPicStreamRequest_Metadata_{
+				Metadata: &api.UpsertPicStreamRequest_Metadata{
+					Md5Hash: []byte("0123456789abcdef"),
+				},
+			},
+		}, {
+			Part: &api.UpsertPicStreamRequest_Chunk{Chunk: []byte("ab")},
+		}},
+	}
+	sts := s.handleUpsertPicStream(ups)
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(ups.reqs) != 1 {
+		t.Error("chunks were read", ups.reqs)
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleUpdateUserSecret(ctx context.Context, req *api.UpdateUserSecretRequest) (
+	*api.UpdateUserSecretResponse, status.S) {
+	var task = &tasks.UpdateUserSecretTask{
+		Beg:       s.db,
+		Now:       s.now,
+		Secret:    req.Secret,
+		NewSecret: req.NewSecret,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.UpdateUserSecretResponse{}, nil
+}
+
+func (s *serv) handleStartUserSecretReset(ctx context.Context, req *api.StartUserSecretResetRequest) (
+	*api.StartUserSecretResetResponse, status.S) {
+	var task = &tasks.StartUserSecretResetTask{
+		Beg:      s.db,
+		Now:      s.now,
+		Notifier: s.notifier,
+		Rand:     s.rand,
+		Ident:    req.Ident,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.StartUserSecretResetResponse{}, nil
+}
+
+func (s *serv) handleFinishUserSecretReset(ctx context.Context, req *api.FinishUserSecretResetRequest) (
+	*api.FinishUserSecretResetResponse, status.S) {
+	var task = &tasks.FinishUserSecretResetTask{
+		Beg:        s.db,
+		Now:        s.now,
+		Ident:      req.Ident,
+		ResetToken: req.ResetToken,
+		NewSecret:  req.NewSecret,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.FinishUserSecretResetResponse{}, nil
+}
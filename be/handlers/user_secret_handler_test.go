@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/notify"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+type nopNotifier struct{}
+
+func (nopNotifier) Notify(context.Context, *notify.Notification) error {
+	return nil
+}
+
+func TestUpdateUserSecretFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Unauthenticated(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleUpdateUserSecret(context.Background(), &api.UpdateUserSecretRequest{})
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateUserSecret(t *testing.T) {
+	var taskCap *tasks.UpdateUserSecretTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.UpdateUserSecretTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleUpdateUserSecret(context.Background(), &api.UpdateUserSecretRequest{
+		Secret:    "old",
+		NewSecret: "new",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Secret != "old" || taskCap.NewSecret != "new" {
+		t.Error("bad task", taskCap)
+	}
+	if taskCap.HashPassword != nil || taskCap.CompareHashAndPassword != nil {
+		t.Error("expected configured hash policy to be used")
+	}
+}
+
+func TestStartUserSecretReset(t *testing.T) {
+	var taskCap *tasks.StartUserSecretResetTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.StartUserSecretResetTask)
+		return nil
+	}
+	s := &serv{
+		runner:   tasks.TestTaskRunner(successRunner),
+		now:      time.Now,
+		notifier: nopNotifier{},
+	}
+
+	_, sts := s.handleStartUserSecretReset(context.Background(), &api.StartUserSecretResetRequest{
+		Ident: "foo@bar.com",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Ident != "foo@bar.com" || taskCap.Notifier != s.notifier {
+		t.Error("bad task", taskCap)
+	}
+}
+
+func TestFinishUserSecretReset(t *testing.T) {
+	var taskCap *tasks.FinishUserSecretResetTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FinishUserSecretResetTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleFinishUserSecretReset(context.Background(), &api.FinishUserSecretResetRequest{
+		Ident:      "foo@bar.com",
+		ResetToken: "token",
+		NewSecret:  "new",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Ident != "foo@bar.com" || taskCap.ResetToken != "token" || taskCap.NewSecret != "new" {
+		t.Error("bad task", taskCap)
+	}
+	if taskCap.HashPassword != nil {
+		t.Error("expected configured hash policy to be used")
+	}
+}
@@ -92,6 +92,14 @@ func (im *ffmpegImage) PerceptualHash0() ([]byte, []float32, status.S) {
 	return im2.PerceptualHash0()
 }
 
+func (im *ffmpegImage) Placeholder() (*Placeholder, status.S) {
+	im2, sts := im.videoFrameImage()
+	if sts != nil {
+		return nil, sts
+	}
+	return im2.Placeholder()
+}
+
 func (im *ffmpegImage) Thumbnail() (PixurImage, status.S) {
 	im2, sts := im.videoFrameImage()
 	if sts != nil {
@@ -75,6 +75,39 @@ func (pi *imagickImage) PerceptualHash0() ([]byte, []float32, status.S) {
 	return hashBytes, outputs, nil
 }
 
+func (pi *imagickImage) Placeholder() (*Placeholder, status.S) {
+	newmw := pi.mw.Clone()
+	defer newmw.Destroy()
+	newmw.ResetIterator()
+
+	newmw.TransformImageColorspace(imagick.COLORSPACE_SRGB)
+	if err := newmw.ResizeImage(
+		placeholderSize, placeholderSize, imagick.FILTER_LANCZOS2_SHARP, 1); err != nil {
+		return nil, status.Internal(err, "can't resize")
+	}
+
+	it := newmw.NewPixelIterator()
+	defer it.Destroy()
+	p := &rgbPixels{
+		width:  int(newmw.GetImageWidth()),
+		height: int(newmw.GetImageHeight()),
+	}
+	for y := 0; y < p.height; y++ {
+		row := it.GetNextIteratorRow()
+		if len(row) != p.width {
+			panic(len(row))
+		}
+		for _, pix := range row {
+			p.pix = append(p.pix, [3]uint8{
+				uint8(math.Round(255 * pix.GetRed())),
+				uint8(math.Round(255 * pix.GetGreen())),
+				uint8(math.Round(255 * pix.GetBlue())),
+			})
+		}
+	}
+	return p.placeholder(), nil
+}
+
 func (pi *imagickImage) Write(w io.Writer) status.S {
 	defer pi.mw.ResetIterator()
 	// TODO: maybe make this work with GIF?  I don't think there is a case that Pixur wants to write
@@ -72,6 +72,9 @@ type PixurImage interface {
 
 	PerceptualHash0() ([]byte, []float32, status.S)
 
+	// Placeholder returns a compact stand-in for the image.
+	Placeholder() (*Placeholder, status.S)
+
 	Write(io.Writer) status.S
 
 	Close()
@@ -0,0 +1,174 @@
+package imaging
+
+import (
+	"image"
+	"math"
+	"strings"
+)
+
+const (
+	// placeholderSize is the size images are shrunk to before computing placeholders.
+	placeholderSize = 32
+	// The number of BlurHash components in each direction.
+	blurHashXComponents = 4
+	blurHashYComponents = 3
+)
+
+// Placeholder is a compact stand-in for an image, shown while the real image loads.
+type Placeholder struct {
+	// BlurHash is the BlurHash encoding of the image.  See https://blurha.sh/
+	BlurHash string
+	// DominantColor is the most common color of the image, as 0xRRGGBB.
+	DominantColor uint32
+}
+
+// rgbPixels is a row major list of sRGB pixels.
+type rgbPixels struct {
+	width, height int
+	pix           [][3]uint8
+}
+
+func (p *rgbPixels) at(x, y int) [3]uint8 {
+	return p.pix[y*p.width+x]
+}
+
+// ImagePlaceholder computes the placeholder for an image.
+func ImagePlaceholder(im image.Image) *Placeholder {
+	b := im.Bounds()
+	p := &rgbPixels{
+		width:  b.Dx(),
+		height: b.Dy(),
+	}
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, g, b, _ := im.At(x, y).RGBA()
+			p.pix = append(p.pix, [3]uint8{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8)})
+		}
+	}
+	return p.placeholder()
+}
+
+func (p *rgbPixels) placeholder() *Placeholder {
+	return &Placeholder{
+		BlurHash:      p.blurHash(blurHashXComponents, blurHashYComponents),
+		DominantColor: p.dominantColor(),
+	}
+}
+
+// dominantColor buckets each pixel into a 4 bit per channel color, and returns the average color
+// of the most popular bucket.
+func (p *rgbPixels) dominantColor() uint32 {
+	if len(p.pix) == 0 {
+		return 0
+	}
+	var counts [1 << 12]int
+	var sums [1 << 12][3]int
+	for _, px := range p.pix {
+		bucket := int(px[0]>>4)<<8 | int(px[1]>>4)<<4 | int(px[2]>>4)
+		counts[bucket]++
+		for i, c := range px {
+			sums[bucket][i] += int(c)
+		}
+	}
+	best := 0
+	for bucket, count := range counts {
+		if count > counts[best] {
+			best = bucket
+		}
+	}
+	var color uint32
+	for _, sum := range sums[best] {
+		color = color<<8 | uint32((sum+counts[best]/2)/counts[best])
+	}
+	return color
+}
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+func encodeBase83(b *strings.Builder, val, length int) {
+	for i := 1; i <= length; i++ {
+		digit := (val / int(math.Pow(83, float64(length-i)))) % 83
+		b.WriteByte(base83Chars[digit])
+	}
+}
+
+func srgbToLinear(v uint8) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+func linearToSrgb(v float64) int {
+	f := math.Max(0, math.Min(1, v))
+	if f <= 0.0031308 {
+		return int(f*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(f, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
+
+// blurHash encodes the pixels using the BlurHash algorithm.  This follows the reference
+// implementation at https://github.com/woltapp/blurhash
+func (p *rgbPixels) blurHash(xComponents, yComponents int) string {
+	factors := make([][3]float64, 0, xComponents*yComponents)
+	for j := 0; j < yComponents; j++ {
+		for i := 0; i < xComponents; i++ {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1
+			}
+			var factor [3]float64
+			for y := 0; y < p.height; y++ {
+				for x := 0; x < p.width; x++ {
+					basis := normalisation *
+						math.Cos(math.Pi*float64(i*x)/float64(p.width)) *
+						math.Cos(math.Pi*float64(j*y)/float64(p.height))
+					px := p.at(x, y)
+					for c := range factor {
+						factor[c] += basis * srgbToLinear(px[c])
+					}
+				}
+			}
+			scale := 1 / float64(p.width*p.height)
+			for c := range factor {
+				factor[c] *= scale
+			}
+			factors = append(factors, factor)
+		}
+	}
+
+	var b strings.Builder
+	encodeBase83(&b, (xComponents-1)+(yComponents-1)*9, 1)
+
+	dc, ac := factors[0], factors[1:]
+	maximumValue := 1.0
+	if len(ac) > 0 {
+		var actualMax float64
+		for _, f := range ac {
+			for _, c := range f {
+				actualMax = math.Max(actualMax, math.Abs(c))
+			}
+		}
+		quantisedMax := int(math.Max(0, math.Min(82, math.Floor(actualMax*166-0.5))))
+		maximumValue = float64(quantisedMax+1) / 166
+		encodeBase83(&b, quantisedMax, 1)
+	} else {
+		encodeBase83(&b, 0, 1)
+	}
+
+	encodeBase83(&b, linearToSrgb(dc[0])<<16|linearToSrgb(dc[1])<<8|linearToSrgb(dc[2]), 4)
+	for _, f := range ac {
+		var val int
+		for _, c := range f {
+			quant := int(math.Max(0, math.Min(18, math.Floor(signPow(c/maximumValue, 0.5)*9+9.5))))
+			val = val*19 + quant
+		}
+		encodeBase83(&b, val, 2)
+	}
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestImagePlaceholder_solidColor(t *testing.T) {
+	im := image.NewRGBA(image.Rect(0, 0, 8, 6))
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 8; x++ {
+			im.Set(x, y, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff})
+		}
+	}
+
+	p := ImagePlaceholder(im)
+	// A 4x3 size flag, followed by the AC max, and then a white DC.
+	if have, want := p.BlurHash[:1], "L"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := p.BlurHash[2:6], "TSUA"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := len(p.BlurHash), 1+1+4+2*11; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := p.DominantColor, uint32(0xffffff); have != want {
+		t.Errorf("have %06x want %06x", have, want)
+	}
+}
+
+func TestImagePlaceholder_dominantColor(t *testing.T) {
+	im := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			im.Set(x, y, color.RGBA{R: 0x20, G: 0x80, B: 0xc0, A: 0xff})
+		}
+	}
+	im.Set(0, 0, color.RGBA{R: 0xff, A: 0xff})
+	im.Set(1, 0, color.RGBA{R: 0x22, G: 0x80, B: 0xc0, A: 0xff})
+
+	p := ImagePlaceholder(im)
+	if have, want := p.DominantColor, uint32(0x2080c0); have != want {
+		t.Errorf("have %06x want %06x", have, want)
+	}
+	if have, want := len(p.BlurHash), 1+1+4+2*11; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
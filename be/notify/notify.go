@@ -0,0 +1,90 @@
+// Package notify delivers messages, such as secret reset codes, to users.  Pixur does not depend
+// on any particular delivery mechanism; instead it accepts a Notifier.  Two simple implementations
+// are provided: one that writes messages to local files, and one that sends mail over SMTP.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+)
+
+// Notification is a message to be delivered to a user.
+type Notification struct {
+	// To is the recipient, usually the user's ident.
+	To      string
+	Subject string
+	Body    string
+}
+
+// Notifier delivers notifications.  Implementations must be safe for concurrent use.
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// FileNotifier writes each notification to a new file in Dir.  It is meant for local development
+// and for servers without outgoing mail.
+type FileNotifier struct {
+	Dir string
+	// Now is used to name the files.  If nil, time.Now is used.
+	Now func() time.Time
+}
+
+func (fn *FileNotifier) Notify(ctx context.Context, n *Notification) error {
+	now := time.Now
+	if fn.Now != nil {
+		now = fn.Now
+	}
+	if err := os.MkdirAll(fn.Dir, 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(fn.Dir, fmt.Sprintf("%d-", now().UnixNano()))
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(formatMessage("", n)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// SMTPNotifier sends each notification as an email.
+type SMTPNotifier struct {
+	// Addr is the host:port of the mail server.
+	Addr string
+	// From is the sender address.
+	From string
+	// Auth is optional.
+	Auth smtp.Auth
+	// SendMail is used to send the message.  If nil, smtp.SendMail is used.
+	SendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func (sn *SMTPNotifier) Notify(ctx context.Context, n *Notification) error {
+	sendMail := smtp.SendMail
+	if sn.SendMail != nil {
+		sendMail = sn.SendMail
+	}
+	if strings.ContainsAny(n.To, "\r\n") {
+		return fmt.Errorf("bad recipient %q", n.To)
+	}
+	return sendMail(sn.Addr, sn.Auth, sn.From, []string{n.To}, formatMessage(sn.From, n))
+}
+
+func formatMessage(from string, n *Notification) []byte {
+	var buf bytes.Buffer
+	if from != "" {
+		fmt.Fprintf(&buf, "From: %s\r\n", from)
+	}
+	fmt.Fprintf(&buf, "To: %s\r\n", n.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", strings.NewReplacer("\r", "", "\n", " ").Replace(n.Subject))
+	buf.WriteString("\r\n")
+	buf.WriteString(n.Body)
+	return buf.Bytes()
+}
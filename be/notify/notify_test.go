@@ -0,0 +1,98 @@
+package notify
+
+import (
+	"context"
+	"io/ioutil"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileNotifier(t *testing.T) {
+	dir, err := ioutil.TempDir("", "notifytest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fn := &FileNotifier{
+		Dir: filepath.Join(dir, "out"),
+		Now: func() time.Time { return time.Unix(100, 0) },
+	}
+	n := &Notification{
+		To:      "a@example.com",
+		Subject: "Hi",
+		Body:    "body",
+	}
+	if err := fn.Notify(context.Background(), n); err != nil {
+		t.Fatal(err)
+	}
+
+	infos, err := ioutil.ReadDir(fn.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 1 {
+		t.Fatal("expected one file", infos)
+	}
+	if !strings.HasPrefix(infos[0].Name(), "100000000000-") {
+		t.Error("bad name", infos[0].Name())
+	}
+	data, err := ioutil.ReadFile(filepath.Join(fn.Dir, infos[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(data), "To: a@example.com\r\nSubject: Hi\r\n\r\nbody"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	var haveAddr, haveFrom string
+	var haveTo []string
+	var haveMsg []byte
+	sn := &SMTPNotifier{
+		Addr: "mail.example:25",
+		From: "pixur@example.com",
+		SendMail: func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+			haveAddr, haveFrom, haveTo, haveMsg = addr, from, to, msg
+			return nil
+		},
+	}
+	n := &Notification{
+		To:      "a@example.com",
+		Subject: "Hi\r\nBcc: b@example.com",
+		Body:    "body",
+	}
+	if err := sn.Notify(context.Background(), n); err != nil {
+		t.Fatal(err)
+	}
+	if haveAddr != "mail.example:25" || haveFrom != "pixur@example.com" {
+		t.Error("bad addr or from", haveAddr, haveFrom)
+	}
+	if len(haveTo) != 1 || haveTo[0] != "a@example.com" {
+		t.Error("bad to", haveTo)
+	}
+	want := "From: pixur@example.com\r\nTo: a@example.com\r\nSubject: Hi Bcc: b@example.com\r\n\r\nbody"
+	if have := string(haveMsg); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestSMTPNotifierBadRecipient(t *testing.T) {
+	sn := &SMTPNotifier{
+		SendMail: func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+			t.Error("should not send")
+			return nil
+		},
+	}
+	n := &Notification{
+		To: "a@example.com\r\nBcc: b@example.com",
+	}
+	if err := sn.Notify(context.Background(), n); err == nil {
+		t.Error("expected error")
+	}
+}
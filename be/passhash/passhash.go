@@ -0,0 +1,181 @@
+// Package passhash hashes and verifies user secrets.  Hashes are self describing, so that hashes
+// made under an older policy can still be verified, and upgraded when the user next logs in.
+//
+// Bcrypt hashes use the usual "$2a$<cost>$..." format.  Argon2id hashes use the PHC string format:
+// "$argon2id$v=19$m=<memory KiB>,t=<time>,p=<threads>$<salt>$<key>", with unpadded base64.
+package passhash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Algorithm is a way of hashing secrets.
+type Algorithm int
+
+const (
+	Bcrypt Algorithm = iota + 1
+	Argon2id
+)
+
+const (
+	argon2idPrefix = "$argon2id$"
+	argon2SaltLen  = 16
+	argon2KeyLen   = 32
+)
+
+var (
+	// ErrMismatch is returned when a password doesn't match its hash.
+	ErrMismatch = errors.New("passhash: password doesn't match hash")
+	// ErrUnknownFormat is returned when a hash isn't in a supported format.
+	ErrUnknownFormat = errors.New("passhash: unknown hash format")
+)
+
+// Policy describes how new hashes are made.
+type Policy struct {
+	Algorithm Algorithm
+
+	// BcryptCost is used by Bcrypt.
+	BcryptCost int
+
+	// Argon2Time, Argon2Memory (in KiB), and Argon2Threads are used by Argon2id.
+	Argon2Time    uint32
+	Argon2Memory  uint32
+	Argon2Threads uint8
+
+	// Rand is the source of salts.  If nil, crypto/rand is used.
+	Rand io.Reader
+}
+
+// DefaultPolicy is used when no other policy is configured.
+var DefaultPolicy = &Policy{
+	Algorithm:     Bcrypt,
+	BcryptCost:    bcrypt.DefaultCost,
+	Argon2Time:    3,
+	Argon2Memory:  64 * 1024,
+	Argon2Threads: 2,
+}
+
+// Hash hashes password according to the policy.
+func (p *Policy) Hash(password []byte) ([]byte, error) {
+	switch p.Algorithm {
+	case Bcrypt:
+		return bcrypt.GenerateFromPassword(password, p.BcryptCost)
+	case Argon2id:
+		if p.Argon2Time == 0 || p.Argon2Memory == 0 || p.Argon2Threads == 0 {
+			return nil, fmt.Errorf("passhash: bad argon2id params t=%d m=%d p=%d",
+				p.Argon2Time, p.Argon2Memory, p.Argon2Threads)
+		}
+		r := p.Rand
+		if r == nil {
+			r = rand.Reader
+		}
+		salt := make([]byte, argon2SaltLen)
+		if _, err := io.ReadFull(r, salt); err != nil {
+			return nil, err
+		}
+		key := argon2.IDKey(password, salt, p.Argon2Time, p.Argon2Memory, p.Argon2Threads, argon2KeyLen)
+		params := argon2idParams{
+			time:    p.Argon2Time,
+			memory:  p.Argon2Memory,
+			threads: p.Argon2Threads,
+		}
+		return []byte(params.format(salt, key)), nil
+	default:
+		return nil, fmt.Errorf("passhash: unknown algorithm %d", p.Algorithm)
+	}
+}
+
+// NeedsRehash returns true if hashed was made by a different algorithm, or is weaker than the
+// policy.  Hashes in an unknown format always need rehashing.
+func (p *Policy) NeedsRehash(hashed []byte) bool {
+	if params, _, _, err := parseArgon2id(hashed); err == nil {
+		return p.Algorithm != Argon2id ||
+			params.time < p.Argon2Time ||
+			params.memory < p.Argon2Memory ||
+			params.threads < p.Argon2Threads
+	}
+	if cost, err := bcrypt.Cost(hashed); err == nil {
+		return p.Algorithm != Bcrypt || cost < p.BcryptCost
+	}
+	return true
+}
+
+// Compare checks that password matches hashed, which may be in any supported format.  It returns
+// nil on success.
+func Compare(hashed, password []byte) error {
+	if strings.HasPrefix(string(hashed), argon2idPrefix) {
+		params, salt, key, err := parseArgon2id(hashed)
+		if err != nil {
+			return err
+		}
+		other := argon2.IDKey(password, salt, params.time, params.memory, params.threads,
+			uint32(len(key)))
+		if subtle.ConstantTimeCompare(key, other) != 1 {
+			return ErrMismatch
+		}
+		return nil
+	}
+	if _, err := bcrypt.Cost(hashed); err != nil {
+		return ErrUnknownFormat
+	}
+	if err := bcrypt.CompareHashAndPassword(hashed, password); err != nil {
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return ErrMismatch
+		}
+		return err
+	}
+	return nil
+}
+
+type argon2idParams struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+func (p argon2idParams) format(salt, key []byte) string {
+	enc := base64.RawStdEncoding
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version,
+		p.memory, p.time, p.threads, enc.EncodeToString(salt), enc.EncodeToString(key))
+}
+
+func parseArgon2id(hashed []byte) (params argon2idParams, salt, key []byte, _ error) {
+	s := string(hashed)
+	if !strings.HasPrefix(s, argon2idPrefix) {
+		return params, nil, nil, ErrUnknownFormat
+	}
+	parts := strings.Split(s[len(argon2idPrefix):], "$")
+	if len(parts) != 4 {
+		return params, nil, nil, ErrUnknownFormat
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[0], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrUnknownFormat
+	}
+	if _, err := fmt.Sscanf(parts[1], "m=%d,t=%d,p=%d", &params.memory, &params.time,
+		&params.threads); err != nil {
+		return params, nil, nil, ErrUnknownFormat
+	}
+	if params.time == 0 || params.memory == 0 || params.threads == 0 {
+		return params, nil, nil, ErrUnknownFormat
+	}
+	enc := base64.RawStdEncoding
+	salt, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return params, nil, nil, ErrUnknownFormat
+	}
+	key, err = enc.DecodeString(parts[3])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrUnknownFormat
+	}
+	return params, salt, key, nil
+}
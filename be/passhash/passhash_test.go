@@ -0,0 +1,111 @@
+package passhash
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testArgon2Policy = &Policy{
+	Algorithm:     Argon2id,
+	Argon2Time:    1,
+	Argon2Memory:  1024,
+	Argon2Threads: 1,
+}
+
+var testBcryptPolicy = &Policy{
+	Algorithm:  Bcrypt,
+	BcryptCost: bcrypt.MinCost,
+}
+
+func TestHashAndCompare(t *testing.T) {
+	for _, p := range []*Policy{testArgon2Policy, testBcryptPolicy} {
+		hashed, err := p.Hash([]byte("secret"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := Compare(hashed, []byte("secret")); err != nil {
+			t.Error(p.Algorithm, err)
+		}
+		if err := Compare(hashed, []byte("bogus")); err != ErrMismatch {
+			t.Error(p.Algorithm, "have", err, "want", ErrMismatch)
+		}
+		if p.NeedsRehash(hashed) {
+			t.Error(p.Algorithm, "expected hash to meet its own policy")
+		}
+	}
+}
+
+func TestArgon2idFormat(t *testing.T) {
+	hashed, err := testArgon2Policy.Hash([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(hashed), "$argon2id$v=19$m=1024,t=1,p=1$"; !strings.HasPrefix(have, want) {
+		t.Error("have", have, "want prefix", want)
+	}
+	other, err := testArgon2Policy.Hash([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(hashed) == string(other) {
+		t.Error("expected different salts")
+	}
+}
+
+func TestCompareRejectsBadHashes(t *testing.T) {
+	hashes := []string{
+		"",
+		"secret",
+		"$argon2id$",
+		"$argon2id$v=18$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=0,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$!!",
+	}
+	for _, h := range hashes {
+		if err := Compare([]byte(h), []byte("secret")); err == nil {
+			t.Error("expected error for", h)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	bcryptHash, err := testBcryptPolicy.Hash([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	argonHash, err := testArgon2Policy.Hash([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	strongerBcrypt := &Policy{
+		Algorithm:  Bcrypt,
+		BcryptCost: bcrypt.MinCost + 1,
+	}
+	strongerArgon2 := &Policy{
+		Algorithm:     Argon2id,
+		Argon2Time:    1,
+		Argon2Memory:  2048,
+		Argon2Threads: 1,
+	}
+	cases := []struct {
+		policy *Policy
+		hashed []byte
+		want   bool
+	}{
+		{policy: strongerBcrypt, hashed: bcryptHash, want: true},
+		{policy: testArgon2Policy, hashed: bcryptHash, want: true},
+		{policy: strongerArgon2, hashed: argonHash, want: true},
+		{policy: testBcryptPolicy, hashed: argonHash, want: true},
+		{policy: testBcryptPolicy, hashed: []byte("bogus"), want: true},
+		{policy: &Policy{Algorithm: Bcrypt, BcryptCost: bcrypt.MinCost}, hashed: bcryptHash},
+	}
+	for i, c := range cases {
+		if have := c.policy.NeedsRehash(c.hashed); have != c.want {
+			t.Error(i, "have", have, "want", c.want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+)
+
+func (ak *ApiKey) IdCol() int64 {
+	return ak.ApiKeyId
+}
+
+func (ak *ApiKey) KeyHashCol() []byte {
+	return ak.KeyHash
+}
+
+func (ak *ApiKey) UserIdCol() int64 {
+	return ak.UserId
+}
+
+func (ak *ApiKey) SetCreatedTime(now time.Time) {
+	ak.CreatedTs = ToTspb(now)
+}
+
+func (ak *ApiKey) SetModifiedTime(now time.Time) {
+	ak.ModifiedTs = ToTspb(now)
+}
+
+// Expired returns true if the key can no longer be used at the given time.
+func (ak *ApiKey) Expired(now time.Time) bool {
+	return ak.ExpireTs != nil && !now.Before(ToTime(ak.ExpireTs))
+}
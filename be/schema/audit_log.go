@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"pixur.org/pixur/be/status"
+)
+
+func (al *AuditLog) IdCol() int64 {
+	return al.AuditLogId
+}
+
+func (al *AuditLog) ActionCol() AuditLog_Action {
+	return al.Action
+}
+
+func (al *AuditLog) ActorUserIdCol() int64 {
+	return al.ActorUserId
+}
+
+func (al *AuditLog) TargetUserIdCol() int64 {
+	return al.TargetUserId
+}
+
+func (al *AuditLog) TargetPicIdCol() int64 {
+	return al.TargetPicId
+}
+
+func (al *AuditLog) SetCreatedTime(now time.Time) {
+	al.CreatedTs = ToTspb(now)
+}
+
+func (al *AuditLog) SetModifiedTime(now time.Time) {
+	al.ModifiedTs = ToTspb(now)
+}
+
+// AuditChanges finds the top level fields that differ between before and after.  Either may be
+// nil, such as when the target is created or removed, but if both are present they must be the
+// same type.
+func AuditChanges(before, after proto.Message) ([]*AuditLog_Change, status.S) {
+	var typ reflect.Type
+	if before != nil {
+		typ = reflect.TypeOf(before).Elem()
+	} else if after != nil {
+		typ = reflect.TypeOf(after).Elem()
+	} else {
+		return nil, nil
+	}
+	bv, av := reflect.New(typ).Elem(), reflect.New(typ).Elem()
+	if before != nil {
+		bv = reflect.ValueOf(before).Elem()
+	}
+	if after != nil {
+		if reflect.TypeOf(after).Elem() != typ {
+			return nil, status.Internal(nil, "mismatched audit types", typ, reflect.TypeOf(after).Elem())
+		}
+		av = reflect.ValueOf(after).Elem()
+	}
+
+	props := proto.GetProperties(typ)
+	var changes []*AuditLog_Change
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if strings.HasPrefix(sf.Name, "XXX_") {
+			continue
+		}
+		bt, at := auditFieldText(bv, i), auditFieldText(av, i)
+		if bt == at {
+			continue
+		}
+		name := props.Prop[i].OrigName
+		if name == "" {
+			// oneof fields don't have a single name.
+			name = sf.Name
+		}
+		changes = append(changes, &AuditLog_Change{
+			Field:  name,
+			Before: bt,
+			After:  at,
+		})
+	}
+	return changes, nil
+}
+
+// auditFieldText formats a single field of a message by copying it into an otherwise empty one.
+func auditFieldText(msg reflect.Value, i int) string {
+	only := reflect.New(msg.Type())
+	only.Elem().Field(i).Set(msg.Field(i))
+	return strings.TrimSpace(proto.CompactTextString(only.Interface().(proto.Message)))
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestAuditChanges(t *testing.T) {
+	before := &User{
+		Capability: []User_Capability{User_PIC_READ},
+		Ident:      "a",
+	}
+	after := &User{
+		Capability: []User_Capability{User_PIC_READ, User_PIC_CREATE},
+		Ident:      "a",
+	}
+
+	changes, sts := AuditChanges(before, after)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if len(changes) != 1 {
+		t.Fatal("expected one change", changes)
+	}
+	if c := changes[0]; c.Field != "capability" || c.Before != "capability:PIC_READ" ||
+		c.After != "capability:PIC_READ capability:PIC_CREATE" {
+		t.Error("wrong change", c)
+	}
+}
+
+func TestAuditChanges_Nil(t *testing.T) {
+	if changes, sts := AuditChanges(nil, nil); sts != nil || changes != nil {
+		t.Error("expected no changes", changes, sts)
+	}
+	changes, sts := AuditChanges(&User{UserId: 5}, nil)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if len(changes) != 1 || changes[0].Field != "user_id" || changes[0].Before != "user_id:5" ||
+		changes[0].After != "" {
+		t.Error("wrong changes", changes)
+	}
+}
+
+func TestAuditChanges_Same(t *testing.T) {
+	u := &User{UserId: 5, Capability: []User_Capability{User_PIC_READ}}
+	if changes, sts := AuditChanges(u, u); sts != nil || changes != nil {
+		t.Error("expected no changes", changes, sts)
+	}
+}
+
+func TestAuditChanges_MismatchedTypes(t *testing.T) {
+	changes, sts := AuditChanges(&User{UserId: 5}, &Pic{PicId: 5})
+	if sts == nil {
+		t.Fatal("expected error", changes)
+	}
+	if have, want := sts.Code(), codes.Internal; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
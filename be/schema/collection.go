@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+)
+
+func (c *Collection) IdCol() int64 {
+	return c.CollectionId
+}
+
+func (c *Collection) UserIdCol() int64 {
+	return c.UserId
+}
+
+func (c *Collection) SetCreatedTime(now time.Time) {
+	c.CreatedTs = ToTspb(now)
+}
+
+func (c *Collection) SetModifiedTime(now time.Time) {
+	c.ModifiedTs = ToTspb(now)
+}
+
+func (c *Collection) Version() int64 {
+	return ToTime(c.ModifiedTs).UnixNano()
+}
+
+func (cp *CollectionPic) CollectionIdCol() int64 {
+	return cp.CollectionId
+}
+
+func (cp *CollectionPic) PicIdCol() int64 {
+	return cp.PicId
+}
+
+func (cp *CollectionPic) PositionCol() int64 {
+	return cp.Position
+}
+
+func (cp *CollectionPic) SetCreatedTime(now time.Time) {
+	cp.CreatedTs = ToTspb(now)
+}
+
+func (cp *CollectionPic) SetModifiedTime(now time.Time) {
+	cp.ModifiedTs = ToTspb(now)
+}
@@ -91,11 +91,17 @@ var defaultConfiguration = &Configuration{
 			User_PIC_UPDATE_VIEW_COUNTER,
 			User_PIC_TAG_CREATE,
 			User_PIC_COMMENT_CREATE,
+			User_PIC_COMMENT_UPDATE,
+			User_PIC_COMMENT_DELETE,
 			User_PIC_VOTE_CREATE,
 			User_USER_READ_SELF,
 			User_USER_READ_PUBLIC,
 			User_USER_READ_PICS,
 			User_USER_READ_PIC_COMMENT,
+			User_USER_UPDATE_PROFILE,
+			User_PIC_FAVORITE_CREATE,
+			User_COLLECTION_CREATE,
+			User_FOLLOW_CREATE,
 		},
 	},
 	DefaultFindIndexPics: &wpb.Int64Value{
@@ -118,4 +124,148 @@ var defaultConfiguration = &Configuration{
 	MaxFindUserEvents: &wpb.Int64Value{
 		Value: 100,
 	},
+	UploadSessionExpiry: ptypes.DurationProto(24 * time.Hour),
+	MaxRemoteFetchRedirects: &wpb.Int64Value{
+		Value: 5,
+	},
+	MaxRemoteFetchSize: &wpb.Int64Value{
+		Value: 512 * 1024 * 1024,
+	},
+	RemoteFetchTimeout: ptypes.DurationProto(1 * time.Minute),
+	RemoteFetchContentType: &Configuration_StringSet{
+		Value: []string{
+			"image/gif",
+			"image/jpeg",
+			"image/png",
+			"image/webp",
+			"video/mp4",
+			"video/webm",
+		},
+	},
+	PasswordResetExpiry: ptypes.DurationProto(1 * time.Hour),
+	TotpRequiredCapability: &Configuration_CapabilitySet{
+		Capability: []User_Capability{
+			User_PIC_PURGE,
+		},
+	},
+	Role: &Configuration_RoleSet{
+		Role: map[string]*Configuration_CapabilitySet{
+			"uploader": {
+				Capability: []User_Capability{
+					User_PIC_CREATE,
+					User_PIC_TAG_CREATE,
+					User_PIC_POOL_EDIT,
+				},
+			},
+			"moderator": {
+				Capability: []User_Capability{
+					User_PIC_SOFT_DELETE,
+					User_PIC_COMMENT_MODERATE,
+					User_USER_READ_ALL,
+					User_USER_READ_PICS,
+					User_USER_READ_PIC_TAG,
+					User_USER_READ_PIC_COMMENT,
+					User_USER_READ_PIC_VOTE,
+				},
+			},
+			"admin": {
+				Capability: []User_Capability{
+					User_PIC_CREATE,
+					User_PIC_SOFT_DELETE,
+					User_PIC_HARD_DELETE,
+					User_PIC_PURGE,
+					User_PIC_TAG_CREATE,
+					User_PIC_POOL_EDIT,
+					User_PIC_COMMENT_MODERATE,
+					User_USER_CREATE,
+					User_USER_UPDATE_CAPABILITY,
+					User_USER_READ_ALL,
+					User_USER_READ_PICS,
+					User_USER_READ_PIC_TAG,
+					User_USER_READ_PIC_COMMENT,
+					User_USER_READ_PIC_VOTE,
+					User_USER_INVITE_CREATE,
+					User_USER_SUSPEND,
+					User_AUDIT_LOG_READ,
+				},
+			},
+		},
+	},
+	RateLimit: &Configuration_RateLimitSet{
+		RateLimit: map[string]*Configuration_RateLimit{
+			"AddPicComment": {
+				Burst:    10,
+				Interval: ptypes.DurationProto(30 * time.Second),
+			},
+			"EditPicComment": {
+				Burst:    10,
+				Interval: ptypes.DurationProto(30 * time.Second),
+			},
+			"UpsertPicVote": {
+				Burst:    60,
+				Interval: ptypes.DurationProto(2 * time.Second),
+			},
+			"UpsertPicCommentVote": {
+				Burst:    60,
+				Interval: ptypes.DurationProto(2 * time.Second),
+			},
+			"CreateUser": {
+				Burst:    5,
+				Interval: ptypes.DurationProto(10 * time.Minute),
+			},
+			"GetRefreshToken": {
+				Burst:    20,
+				Interval: ptypes.DurationProto(10 * time.Second),
+			},
+			"StartUserSecretReset": {
+				Burst:    5,
+				Interval: ptypes.DurationProto(10 * time.Minute),
+			},
+			"UpdateUserSecret": {
+				Burst:    5,
+				Interval: ptypes.DurationProto(10 * time.Minute),
+			},
+		},
+	},
+	PasswordHashPolicy: &Configuration_PasswordHashPolicy{
+		Algorithm:     Configuration_PasswordHashPolicy_BCRYPT,
+		BcryptCost:    10,
+		Argon2Time:    3,
+		Argon2Memory:  64 * 1024,
+		Argon2Threads: 2,
+	},
+	DefaultFindAuditLogs: &wpb.Int64Value{
+		Value: 50,
+	},
+	MaxFindAuditLogs: &wpb.Int64Value{
+		Value: 500,
+	},
+	PicCommentEditWindow: ptypes.DurationProto(1 * time.Hour),
+	MaxCollections: &wpb.Int64Value{
+		Value: 100,
+	},
+	MaxCollectionPics: &wpb.Int64Value{
+		Value: 1000,
+	},
+	MaxCollectionNameLength: &wpb.Int64Value{
+		Value: 64,
+	},
+	MaxPoolPics: &wpb.Int64Value{
+		Value: 1000,
+	},
+	MaxPoolTitleLength: &wpb.Int64Value{
+		Value: 128,
+	},
+	MaxPoolDescriptionLength: &wpb.Int64Value{
+		Value: 4096,
+	},
+	MaxFollows: &wpb.Int64Value{
+		Value: 1000,
+	},
+	MaxUploadStreamSize: &wpb.Int64Value{
+		Value: 512 * 1024 * 1024,
+	},
+	MaxUploadSessions: &wpb.Int64Value{
+		Value: 10,
+	},
 }
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+)
+
+func (uf *UserFollow) UserIdCol() int64 {
+	return uf.UserId
+}
+
+func (uf *UserFollow) FolloweeUserIdCol() int64 {
+	return uf.FolloweeUserId
+}
+
+func (uf *UserFollow) SetCreatedTime(now time.Time) {
+	uf.CreatedTs = ToTspb(now)
+}
+
+func (uf *UserFollow) SetModifiedTime(now time.Time) {
+	uf.ModifiedTs = ToTspb(now)
+}
+
+func (tf *TagFollow) UserIdCol() int64 {
+	return tf.UserId
+}
+
+func (tf *TagFollow) TagNameCol() string {
+	return TagUniqueName(tf.TagName)
+}
+
+func (tf *TagFollow) SetCreatedTime(now time.Time) {
+	tf.CreatedTs = ToTspb(now)
+}
+
+func (tf *TagFollow) SetModifiedTime(now time.Time) {
+	tf.ModifiedTs = ToTspb(now)
+}
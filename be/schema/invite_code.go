@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"time"
+)
+
+func (ic *InviteCode) IdCol() int64 {
+	return ic.InviteCodeId
+}
+
+func (ic *InviteCode) CodeHashCol() []byte {
+	return ic.CodeHash
+}
+
+func (ic *InviteCode) SetCreatedTime(now time.Time) {
+	ic.CreatedTs = ToTspb(now)
+}
+
+func (ic *InviteCode) SetModifiedTime(now time.Time) {
+	ic.ModifiedTs = ToTspb(now)
+}
+
+// Expired returns true if the code can no longer be used at the given time.
+func (ic *InviteCode) Expired(now time.Time) bool {
+	return ic.ExpireTs != nil && !now.Before(ToTime(ic.ExpireTs))
+}
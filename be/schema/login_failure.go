@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"time"
+)
+
+func (lf *LoginFailure) IdCol() int64 {
+	return lf.LoginFailureId
+}
+
+func (lf *LoginFailure) SubjectCol() string {
+	return lf.Subject
+}
+
+func (lf *LoginFailure) SetCreatedTime(now time.Time) {
+	lf.CreatedTs = ToTspb(now)
+}
+
+func (lf *LoginFailure) SetModifiedTime(now time.Time) {
+	lf.ModifiedTs = ToTspb(now)
+}
+
+// Locked returns true if logins for the subject are refused at the given time.  It is safe to call
+// on nil.
+func (lf *LoginFailure) Locked(now time.Time) bool {
+	return lf.GetLockedUntilTs() != nil && now.Before(ToTime(lf.GetLockedUntilTs()))
+}
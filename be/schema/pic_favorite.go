@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"time"
+)
+
+func (pf *PicFavorite) IdCol() int64 {
+	return pf.PicFavoriteId
+}
+
+func (pf *PicFavorite) PicIdCol() int64 {
+	return pf.PicId
+}
+
+func (pf *PicFavorite) UserIdCol() int64 {
+	return pf.UserId
+}
+
+func (pf *PicFavorite) SetCreatedTime(now time.Time) {
+	pf.CreatedTs = ToTspb(now)
+}
+
+func (pf *PicFavorite) SetModifiedTime(now time.Time) {
+	pf.ModifiedTs = ToTspb(now)
+}
@@ -33,3 +33,21 @@ func (pc *PicComment) GetModifiedTime() time.Time {
 func (pc *PicComment) Version() int64 {
 	return ToTime(pc.ModifiedTs).UnixNano()
 }
+
+// Deleted returns true if the comment was deleted.
+func (pc *PicComment) Deleted() bool {
+	return pc.DeletedTs != nil
+}
+
+// GetEditedTime returns when the text was last edited, and false if it never was.  Deleting a
+// comment doesn't count as an edit.
+func (pc *PicComment) GetEditedTime() (time.Time, bool) {
+	revs := pc.Revision
+	if pc.Deleted() && len(revs) > 0 {
+		revs = revs[:len(revs)-1]
+	}
+	if len(revs) == 0 {
+		return time.Time{}, false
+	}
+	return ToTime(revs[len(revs)-1].ReplacedTs), true
+}
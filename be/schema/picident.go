@@ -11,3 +11,8 @@ func (pi *PicIdent) TypeCol() PicIdent_Type {
 func (pi *PicIdent) ValueCol() []byte {
 	return pi.Value
 }
+
+// ColorIdentValue converts a 0xRRGGBB color to the value of a DOMINANT_COLOR PicIdent.
+func ColorIdentValue(color uint32) []byte {
+	return []byte{byte(color >> 16), byte(color >> 8), byte(color)}
+}
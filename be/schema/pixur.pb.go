@@ -100,6 +100,8 @@ const (
 	PicIdent_MD5        PicIdent_Type = 3
 	PicIdent_DCT_0      PicIdent_Type = 4
 	PicIdent_SHA512_256 PicIdent_Type = 5
+	// The dominant color of the pic, as 3 bytes of red, green, and blue.
+	PicIdent_DOMINANT_COLOR PicIdent_Type = 6
 )
 
 var PicIdent_Type_name = map[int32]string{
@@ -108,14 +110,16 @@ var PicIdent_Type_name = map[int32]string{
 	3: "MD5",
 	4: "DCT_0",
 	5: "SHA512_256",
+	6: "DOMINANT_COLOR",
 }
 
 var PicIdent_Type_value = map[string]int32{
-	"UNKNOWN":    0,
-	"SHA1":       2,
-	"MD5":        3,
-	"DCT_0":      4,
-	"SHA512_256": 5,
+	"UNKNOWN":        0,
+	"SHA1":           2,
+	"MD5":            3,
+	"DCT_0":          4,
+	"SHA512_256":     5,
+	"DOMINANT_COLOR": 6,
 }
 
 func (x PicIdent_Type) String() string {
@@ -188,6 +192,36 @@ func (PicCommentVote_Vote) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_962aa63430fd1f4b, []int{7, 0}
 }
 
+type Collection_Visibility int32
+
+const (
+	Collection_UNKNOWN Collection_Visibility = 0
+	// Only the owner can see the collection.
+	Collection_PRIVATE Collection_Visibility = 1
+	// Anyone who can read pics can see the collection.
+	Collection_PUBLIC Collection_Visibility = 2
+)
+
+var Collection_Visibility_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PRIVATE",
+	2: "PUBLIC",
+}
+
+var Collection_Visibility_value = map[string]int32{
+	"UNKNOWN": 0,
+	"PRIVATE": 1,
+	"PUBLIC":  2,
+}
+
+func (x Collection_Visibility) String() string {
+	return proto.EnumName(Collection_Visibility_name, int32(x))
+}
+
+func (Collection_Visibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{9, 0}
+}
+
 type User_Capability int32
 
 const (
@@ -260,6 +294,32 @@ const (
 	User_PIC_COMMENT_VOTE_CREATE User_Capability = 28
 	// Can this user create arbitrary extension data on a comment vote?
 	User_PIC_COMMENT_VOTE_EXTENSION_CREATE User_Capability = 29
+	// Can this user create invite codes?
+	User_USER_INVITE_CREATE User_Capability = 30
+	// Can this user suspend and unsuspend other users?
+	User_USER_SUSPEND User_Capability = 31
+	// Can this user edit their own profile?
+	User_USER_UPDATE_PROFILE User_Capability = 32
+	// Can this user log in as other users by an external identity linked to them?  Only trusted
+	// frontends should have this.
+	User_USER_AUTH_EXTERNAL User_Capability = 33
+	// Can this user read the audit log of privileged actions?
+	User_AUDIT_LOG_READ User_Capability = 34
+	// Can this user edit their own comments, within the configured edit window?
+	User_PIC_COMMENT_UPDATE User_Capability = 35
+	// Can this user delete their own comments?
+	User_PIC_COMMENT_DELETE User_Capability = 36
+	// Can this user edit or delete any comment at any time, and see the prior revisions of
+	// comments?
+	User_PIC_COMMENT_MODERATE User_Capability = 37
+	// Can this user favorite pics?
+	User_PIC_FAVORITE_CREATE User_Capability = 38
+	// Can this user create collections, and change the collections they own?
+	User_COLLECTION_CREATE User_Capability = 39
+	// Can this user create pools, and add, move, or remove the pics in them?
+	User_PIC_POOL_EDIT User_Capability = 40
+	// Can this user follow users and tags, and read their feed?
+	User_FOLLOW_CREATE User_Capability = 41
 )
 
 var User_Capability_name = map[int32]string{
@@ -293,6 +353,18 @@ var User_Capability_name = map[int32]string{
 	27: "USER_READ_PIC_VOTE",
 	28: "PIC_COMMENT_VOTE_CREATE",
 	29: "PIC_COMMENT_VOTE_EXTENSION_CREATE",
+	30: "USER_INVITE_CREATE",
+	31: "USER_SUSPEND",
+	32: "USER_UPDATE_PROFILE",
+	33: "USER_AUTH_EXTERNAL",
+	34: "AUDIT_LOG_READ",
+	35: "PIC_COMMENT_UPDATE",
+	36: "PIC_COMMENT_DELETE",
+	37: "PIC_COMMENT_MODERATE",
+	38: "PIC_FAVORITE_CREATE",
+	39: "COLLECTION_CREATE",
+	40: "PIC_POOL_EDIT",
+	41: "FOLLOW_CREATE",
 }
 
 var User_Capability_value = map[string]int32{
@@ -326,6 +398,18 @@ var User_Capability_value = map[string]int32{
 	"USER_READ_PIC_VOTE":                27,
 	"PIC_COMMENT_VOTE_CREATE":           28,
 	"PIC_COMMENT_VOTE_EXTENSION_CREATE": 29,
+	"USER_INVITE_CREATE":                30,
+	"USER_SUSPEND":                      31,
+	"USER_UPDATE_PROFILE":               32,
+	"USER_AUTH_EXTERNAL":                33,
+	"AUDIT_LOG_READ":                    34,
+	"PIC_COMMENT_UPDATE":                35,
+	"PIC_COMMENT_DELETE":                36,
+	"PIC_COMMENT_MODERATE":              37,
+	"PIC_FAVORITE_CREATE":               38,
+	"COLLECTION_CREATE":                 39,
+	"PIC_POOL_EDIT":                     40,
+	"FOLLOW_CREATE":                     41,
 }
 
 func (x User_Capability) String() string {
@@ -333,7 +417,93 @@ func (x User_Capability) String() string {
 }
 
 func (User_Capability) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{9, 0}
+	return fileDescriptor_962aa63430fd1f4b, []int{16, 0}
+}
+
+type AuditLog_Action int32
+
+const (
+	AuditLog_UNKNOWN                AuditLog_Action = 0
+	AuditLog_UPDATE_USER_CAPABILITY AuditLog_Action = 1
+	AuditLog_SOFT_DELETE_PIC        AuditLog_Action = 2
+	AuditLog_HARD_DELETE_PIC        AuditLog_Action = 3
+	AuditLog_PURGE_PIC              AuditLog_Action = 4
+	AuditLog_UPDATE_CONFIGURATION   AuditLog_Action = 5
+	AuditLog_SUSPEND_USER           AuditLog_Action = 6
+	AuditLog_UNSUSPEND_USER         AuditLog_Action = 7
+	// A moderator edited or deleted a comment of another user.
+	AuditLog_UPDATE_PIC_COMMENT AuditLog_Action = 8
+	AuditLog_DELETE_PIC_COMMENT AuditLog_Action = 9
+	// An admin ended the lockout of a user or address.
+	AuditLog_UNLOCK_LOGIN AuditLog_Action = 10
+	// The target is the invite code, which may grant capabilities to new users.
+	AuditLog_CREATE_INVITE_CODE AuditLog_Action = 11
+)
+
+var AuditLog_Action_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "UPDATE_USER_CAPABILITY",
+	2:  "SOFT_DELETE_PIC",
+	3:  "HARD_DELETE_PIC",
+	4:  "PURGE_PIC",
+	5:  "UPDATE_CONFIGURATION",
+	6:  "SUSPEND_USER",
+	7:  "UNSUSPEND_USER",
+	8:  "UPDATE_PIC_COMMENT",
+	9:  "DELETE_PIC_COMMENT",
+	10: "UNLOCK_LOGIN",
+	11: "CREATE_INVITE_CODE",
+}
+
+var AuditLog_Action_value = map[string]int32{
+	"UNKNOWN":                0,
+	"UPDATE_USER_CAPABILITY": 1,
+	"SOFT_DELETE_PIC":        2,
+	"HARD_DELETE_PIC":        3,
+	"PURGE_PIC":              4,
+	"UPDATE_CONFIGURATION":   5,
+	"SUSPEND_USER":           6,
+	"UNSUSPEND_USER":         7,
+	"UPDATE_PIC_COMMENT":     8,
+	"DELETE_PIC_COMMENT":     9,
+	"UNLOCK_LOGIN":           10,
+	"CREATE_INVITE_CODE":     11,
+}
+
+func (x AuditLog_Action) String() string {
+	return proto.EnumName(AuditLog_Action_name, int32(x))
+}
+
+func (AuditLog_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{20, 0}
+}
+
+type Configuration_PasswordHashPolicy_Algorithm int32
+
+const (
+	Configuration_PasswordHashPolicy_UNKNOWN  Configuration_PasswordHashPolicy_Algorithm = 0
+	Configuration_PasswordHashPolicy_BCRYPT   Configuration_PasswordHashPolicy_Algorithm = 1
+	Configuration_PasswordHashPolicy_ARGON2ID Configuration_PasswordHashPolicy_Algorithm = 2
+)
+
+var Configuration_PasswordHashPolicy_Algorithm_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "BCRYPT",
+	2: "ARGON2ID",
+}
+
+var Configuration_PasswordHashPolicy_Algorithm_value = map[string]int32{
+	"UNKNOWN":  0,
+	"BCRYPT":   1,
+	"ARGON2ID": 2,
+}
+
+func (x Configuration_PasswordHashPolicy_Algorithm) String() string {
+	return proto.EnumName(Configuration_PasswordHashPolicy_Algorithm_name, int32(x))
+}
+
+func (Configuration_PasswordHashPolicy_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{23, 5, 0}
 }
 
 type Pic struct {
@@ -353,13 +523,18 @@ type Pic struct {
 	VoteDown int64 `protobuf:"varint,18,opt,name=vote_down,json=voteDown,proto3" json:"vote_down,omitempty"`
 	// Extra information that may not fit into the schema
 	Ext map[string]*any.Any `protobuf:"bytes,19,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The denormalized count of users who favorited this pic
+	FavoriteCount int64 `protobuf:"varint,25,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
 	// represents thumbnails for this pic
 	Thumbnail []*Pic_File `protobuf:"bytes,21,rep,name=thumbnail,proto3" json:"thumbnail,omitempty"`
 	// alternate but equivalent forms of this file.
-	Derived              []*Pic_File `protobuf:"bytes,23,rep,name=derived,proto3" json:"derived,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Derived []*Pic_File `protobuf:"bytes,23,rep,name=derived,proto3" json:"derived,omitempty"`
+	// A compact stand-in for the pic, computed at upload.  May be absent for
+	// older pics.
+	Placeholder          *Pic_Placeholder `protobuf:"bytes,24,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Pic) Reset()         { *m = Pic{} }
@@ -457,6 +632,13 @@ func (m *Pic) GetExt() map[string]*any.Any {
 	return nil
 }
 
+func (m *Pic) GetFavoriteCount() int64 {
+	if m != nil {
+		return m.FavoriteCount
+	}
+	return 0
+}
+
 func (m *Pic) GetThumbnail() []*Pic_File {
 	if m != nil {
 		return m.Thumbnail
@@ -471,6 +653,13 @@ func (m *Pic) GetDerived() []*Pic_File {
 	return nil
 }
 
+func (m *Pic) GetPlaceholder() *Pic_Placeholder {
+	if m != nil {
+		return m.Placeholder
+	}
+	return nil
+}
+
 type Pic_DeletionStatus struct {
 	// Represents when this Pic was marked for deletion
 	MarkedDeletedTs *timestamp.Timestamp `protobuf:"bytes,1,opt,name=marked_deleted_ts,json=markedDeletedTs,proto3" json:"marked_deleted_ts,omitempty"`
@@ -733,6 +922,55 @@ func (m *Pic_File) GetAnimationInfo() *AnimationInfo {
 	return nil
 }
 
+type Pic_Placeholder struct {
+	// The BlurHash encoding of the pic.
+	BlurHash string `protobuf:"bytes,1,opt,name=blur_hash,json=blurHash,proto3" json:"blur_hash,omitempty"`
+	// The most common color in the pic, as 0xRRGGBB.
+	DominantColor        uint32   `protobuf:"varint,2,opt,name=dominant_color,json=dominantColor,proto3" json:"dominant_color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Pic_Placeholder) Reset()         { *m = Pic_Placeholder{} }
+func (m *Pic_Placeholder) String() string { return proto.CompactTextString(m) }
+func (*Pic_Placeholder) ProtoMessage()    {}
+func (*Pic_Placeholder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{0, 4}
+}
+
+func (m *Pic_Placeholder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Pic_Placeholder.Unmarshal(m, b)
+}
+func (m *Pic_Placeholder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Pic_Placeholder.Marshal(b, m, deterministic)
+}
+func (m *Pic_Placeholder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pic_Placeholder.Merge(m, src)
+}
+func (m *Pic_Placeholder) XXX_Size() int {
+	return xxx_messageInfo_Pic_Placeholder.Size(m)
+}
+func (m *Pic_Placeholder) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pic_Placeholder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pic_Placeholder proto.InternalMessageInfo
+
+func (m *Pic_Placeholder) GetBlurHash() string {
+	if m != nil {
+		return m.BlurHash
+	}
+	return ""
+}
+
+func (m *Pic_Placeholder) GetDominantColor() uint32 {
+	if m != nil {
+		return m.DominantColor
+	}
+	return 0
+}
+
 // A picture identifier
 type PicIdent struct {
 	PicId int64         `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
@@ -1032,10 +1270,16 @@ type PicComment struct {
 	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
 	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,6,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
 	// Extra information that may not fit into the schema
-	Ext                  map[string]*any.Any `protobuf:"bytes,8,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Ext map[string]*any.Any `protobuf:"bytes,8,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The prior texts of this comment, oldest first.  A revision is added each time the text is
+	// edited or deleted.
+	Revision []*PicComment_Revision `protobuf:"bytes,11,rep,name=revision,proto3" json:"revision,omitempty"`
+	// When this comment was deleted, if it was.  The comment is kept so that replies to it still
+	// have a parent, but its text is replaced with a placeholder.
+	DeletedTs            *timestamp.Timestamp `protobuf:"bytes,12,opt,name=deleted_ts,json=deletedTs,proto3" json:"deleted_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *PicComment) Reset()         { *m = PicComment{} }
@@ -1133,6 +1377,78 @@ func (m *PicComment) GetExt() map[string]*any.Any {
 	return nil
 }
 
+func (m *PicComment) GetRevision() []*PicComment_Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+func (m *PicComment) GetDeletedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeletedTs
+	}
+	return nil
+}
+
+type PicComment_Revision struct {
+	// The text before it was replaced.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// When the text was replaced.
+	ReplacedTs *timestamp.Timestamp `protobuf:"bytes,2,opt,name=replaced_ts,json=replacedTs,proto3" json:"replaced_ts,omitempty"`
+	// The user who replaced the text.  This is the author, unless a moderator edited it.
+	UserId               int64    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PicComment_Revision) Reset()         { *m = PicComment_Revision{} }
+func (m *PicComment_Revision) String() string { return proto.CompactTextString(m) }
+func (*PicComment_Revision) ProtoMessage()    {}
+func (*PicComment_Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{5, 1}
+}
+
+func (m *PicComment_Revision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicComment_Revision.Unmarshal(m, b)
+}
+func (m *PicComment_Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicComment_Revision.Marshal(b, m, deterministic)
+}
+func (m *PicComment_Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicComment_Revision.Merge(m, src)
+}
+func (m *PicComment_Revision) XXX_Size() int {
+	return xxx_messageInfo_PicComment_Revision.Size(m)
+}
+func (m *PicComment_Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicComment_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PicComment_Revision proto.InternalMessageInfo
+
+func (m *PicComment_Revision) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *PicComment_Revision) GetReplacedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReplacedTs
+	}
+	return nil
+}
+
+func (m *PicComment_Revision) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
 type PicVote struct {
 	PicId  int64 `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
 	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1321,506 +1637,2187 @@ func (m *PicCommentVote) GetExt() map[string]*any.Any {
 	return nil
 }
 
-type UserEvent struct {
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// If multiple events are being created for a single pic, this acts as a tie breaker.
-	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	// Indexed and can't change
-	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,4,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
-	// Types that are valid to be assigned to Evt:
-	//	*UserEvent_OutgoingUpsertPicVote_
-	//	*UserEvent_IncomingUpsertPicVote_
-	//	*UserEvent_OutgoingPicComment_
-	//	*UserEvent_IncomingPicComment_
-	//	*UserEvent_UpsertPic_
-	Evt                  isUserEvent_Evt `protobuf_oneof:"evt"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// PicFavorite is a pic saved by a user.  Unlike votes, favorites are only visible to the user.
+type PicFavorite struct {
+	PicFavoriteId        int64                `protobuf:"varint,1,opt,name=pic_favorite_id,json=picFavoriteId,proto3" json:"pic_favorite_id,omitempty"`
+	PicId                int64                `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	UserId               int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *UserEvent) Reset()         { *m = UserEvent{} }
-func (m *UserEvent) String() string { return proto.CompactTextString(m) }
-func (*UserEvent) ProtoMessage()    {}
-func (*UserEvent) Descriptor() ([]byte, []int) {
+func (m *PicFavorite) Reset()         { *m = PicFavorite{} }
+func (m *PicFavorite) String() string { return proto.CompactTextString(m) }
+func (*PicFavorite) ProtoMessage()    {}
+func (*PicFavorite) Descriptor() ([]byte, []int) {
 	return fileDescriptor_962aa63430fd1f4b, []int{8}
 }
 
-func (m *UserEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserEvent.Unmarshal(m, b)
+func (m *PicFavorite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicFavorite.Unmarshal(m, b)
 }
-func (m *UserEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserEvent.Marshal(b, m, deterministic)
+func (m *PicFavorite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicFavorite.Marshal(b, m, deterministic)
 }
-func (m *UserEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserEvent.Merge(m, src)
+func (m *PicFavorite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicFavorite.Merge(m, src)
 }
-func (m *UserEvent) XXX_Size() int {
-	return xxx_messageInfo_UserEvent.Size(m)
+func (m *PicFavorite) XXX_Size() int {
+	return xxx_messageInfo_PicFavorite.Size(m)
 }
-func (m *UserEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserEvent.DiscardUnknown(m)
+func (m *PicFavorite) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicFavorite.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserEvent proto.InternalMessageInfo
+var xxx_messageInfo_PicFavorite proto.InternalMessageInfo
 
-func (m *UserEvent) GetUserId() int64 {
+func (m *PicFavorite) GetPicFavoriteId() int64 {
 	if m != nil {
-		return m.UserId
+		return m.PicFavoriteId
 	}
 	return 0
 }
 
-func (m *UserEvent) GetIndex() int64 {
+func (m *PicFavorite) GetPicId() int64 {
 	if m != nil {
-		return m.Index
+		return m.PicId
 	}
 	return 0
 }
 
-func (m *UserEvent) GetCreatedTs() *timestamp.Timestamp {
+func (m *PicFavorite) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *PicFavorite) GetCreatedTs() *timestamp.Timestamp {
 	if m != nil {
 		return m.CreatedTs
 	}
 	return nil
 }
 
-func (m *UserEvent) GetModifiedTs() *timestamp.Timestamp {
+func (m *PicFavorite) GetModifiedTs() *timestamp.Timestamp {
 	if m != nil {
 		return m.ModifiedTs
 	}
 	return nil
 }
 
-type isUserEvent_Evt interface {
-	isUserEvent_Evt()
+// Collection is a named, ordered set of pics made by a user.
+type Collection struct {
+	CollectionId int64 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// The owner of the collection.
+	UserId               int64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                 string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Visibility           Collection_Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=pixur.be.schema.Collection_Visibility" json:"visibility,omitempty"`
+	CreatedTs            *timestamp.Timestamp  `protobuf:"bytes,5,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp  `protobuf:"bytes,6,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Collection) Reset()         { *m = Collection{} }
+func (m *Collection) String() string { return proto.CompactTextString(m) }
+func (*Collection) ProtoMessage()    {}
+func (*Collection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{9}
 }
 
-type UserEvent_OutgoingUpsertPicVote_ struct {
-	OutgoingUpsertPicVote *UserEvent_OutgoingUpsertPicVote `protobuf:"bytes,5,opt,name=outgoing_upsert_pic_vote,json=outgoingUpsertPicVote,proto3,oneof"`
+func (m *Collection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Collection.Unmarshal(m, b)
 }
-
-type UserEvent_IncomingUpsertPicVote_ struct {
-	IncomingUpsertPicVote *UserEvent_IncomingUpsertPicVote `protobuf:"bytes,6,opt,name=incoming_upsert_pic_vote,json=incomingUpsertPicVote,proto3,oneof"`
+func (m *Collection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Collection.Marshal(b, m, deterministic)
 }
-
-type UserEvent_OutgoingPicComment_ struct {
-	OutgoingPicComment *UserEvent_OutgoingPicComment `protobuf:"bytes,7,opt,name=outgoing_pic_comment,json=outgoingPicComment,proto3,oneof"`
+func (m *Collection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Collection.Merge(m, src)
 }
-
-type UserEvent_IncomingPicComment_ struct {
-	IncomingPicComment *UserEvent_IncomingPicComment `protobuf:"bytes,8,opt,name=incoming_pic_comment,json=incomingPicComment,proto3,oneof"`
+func (m *Collection) XXX_Size() int {
+	return xxx_messageInfo_Collection.Size(m)
 }
-
-type UserEvent_UpsertPic_ struct {
-	UpsertPic *UserEvent_UpsertPic `protobuf:"bytes,9,opt,name=upsert_pic,json=upsertPic,proto3,oneof"`
+func (m *Collection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Collection.DiscardUnknown(m)
 }
 
-func (*UserEvent_OutgoingUpsertPicVote_) isUserEvent_Evt() {}
-
-func (*UserEvent_IncomingUpsertPicVote_) isUserEvent_Evt() {}
-
-func (*UserEvent_OutgoingPicComment_) isUserEvent_Evt() {}
-
-func (*UserEvent_IncomingPicComment_) isUserEvent_Evt() {}
-
-func (*UserEvent_UpsertPic_) isUserEvent_Evt() {}
+var xxx_messageInfo_Collection proto.InternalMessageInfo
 
-func (m *UserEvent) GetEvt() isUserEvent_Evt {
+func (m *Collection) GetCollectionId() int64 {
 	if m != nil {
-		return m.Evt
+		return m.CollectionId
 	}
-	return nil
+	return 0
 }
 
-func (m *UserEvent) GetOutgoingUpsertPicVote() *UserEvent_OutgoingUpsertPicVote {
-	if x, ok := m.GetEvt().(*UserEvent_OutgoingUpsertPicVote_); ok {
-		return x.OutgoingUpsertPicVote
+func (m *Collection) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
 	}
-	return nil
+	return 0
 }
 
-func (m *UserEvent) GetIncomingUpsertPicVote() *UserEvent_IncomingUpsertPicVote {
-	if x, ok := m.GetEvt().(*UserEvent_IncomingUpsertPicVote_); ok {
-		return x.IncomingUpsertPicVote
+func (m *Collection) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *UserEvent) GetOutgoingPicComment() *UserEvent_OutgoingPicComment {
-	if x, ok := m.GetEvt().(*UserEvent_OutgoingPicComment_); ok {
-		return x.OutgoingPicComment
+func (m *Collection) GetVisibility() Collection_Visibility {
+	if m != nil {
+		return m.Visibility
 	}
-	return nil
+	return Collection_UNKNOWN
 }
 
-func (m *UserEvent) GetIncomingPicComment() *UserEvent_IncomingPicComment {
-	if x, ok := m.GetEvt().(*UserEvent_IncomingPicComment_); ok {
-		return x.IncomingPicComment
+func (m *Collection) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
 	}
 	return nil
 }
 
-func (m *UserEvent) GetUpsertPic() *UserEvent_UpsertPic {
-	if x, ok := m.GetEvt().(*UserEvent_UpsertPic_); ok {
-		return x.UpsertPic
+func (m *Collection) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*UserEvent) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*UserEvent_OutgoingUpsertPicVote_)(nil),
-		(*UserEvent_IncomingUpsertPicVote_)(nil),
-		(*UserEvent_OutgoingPicComment_)(nil),
-		(*UserEvent_IncomingPicComment_)(nil),
-		(*UserEvent_UpsertPic_)(nil),
-	}
+// CollectionPic is a pic in a collection.
+type CollectionPic struct {
+	CollectionId int64 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PicId        int64 `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// Pics are ordered by position, smallest first.  Positions are unique within a collection, but
+	// need not be contiguous.
+	Position             int64                `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-// IncomingUpsertPicVote represents sending a vote on a pic another user made.  If a user
-// self-votes, OutgoingUpsertPicVote will be created instead of IncomingUpsertPicVote.
-type UserEvent_OutgoingUpsertPicVote struct {
-	PicId                int64    `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CollectionPic) Reset()         { *m = CollectionPic{} }
+func (m *CollectionPic) String() string { return proto.CompactTextString(m) }
+func (*CollectionPic) ProtoMessage()    {}
+func (*CollectionPic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{10}
 }
 
-func (m *UserEvent_OutgoingUpsertPicVote) Reset()         { *m = UserEvent_OutgoingUpsertPicVote{} }
-func (m *UserEvent_OutgoingUpsertPicVote) String() string { return proto.CompactTextString(m) }
-func (*UserEvent_OutgoingUpsertPicVote) ProtoMessage()    {}
-func (*UserEvent_OutgoingUpsertPicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 0}
+func (m *CollectionPic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionPic.Unmarshal(m, b)
+}
+func (m *CollectionPic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionPic.Marshal(b, m, deterministic)
+}
+func (m *CollectionPic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionPic.Merge(m, src)
+}
+func (m *CollectionPic) XXX_Size() int {
+	return xxx_messageInfo_CollectionPic.Size(m)
+}
+func (m *CollectionPic) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionPic.DiscardUnknown(m)
 }
 
-func (m *UserEvent_OutgoingUpsertPicVote) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.Unmarshal(m, b)
+var xxx_messageInfo_CollectionPic proto.InternalMessageInfo
+
+func (m *CollectionPic) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
 }
-func (m *UserEvent_OutgoingUpsertPicVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.Marshal(b, m, deterministic)
+
+func (m *CollectionPic) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
 }
-func (m *UserEvent_OutgoingUpsertPicVote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.Merge(m, src)
+
+func (m *CollectionPic) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
 }
-func (m *UserEvent_OutgoingUpsertPicVote) XXX_Size() int {
-	return xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.Size(m)
+
+func (m *CollectionPic) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
 }
-func (m *UserEvent_OutgoingUpsertPicVote) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.DiscardUnknown(m)
+
+func (m *CollectionPic) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
 }
 
-var xxx_messageInfo_UserEvent_OutgoingUpsertPicVote proto.InternalMessageInfo
+// Pool is an ordered sequence of pics, such as the pages of a comic.  Unlike collections, pools
+// are shared, and can be changed by anyone with the PIC_POOL_EDIT capability.
+type Pool struct {
+	PoolId               int64                `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Title                string               `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
 
-func (m *UserEvent_OutgoingUpsertPicVote) GetPicId() int64 {
+func (m *Pool) Reset()         { *m = Pool{} }
+func (m *Pool) String() string { return proto.CompactTextString(m) }
+func (*Pool) ProtoMessage()    {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{11}
+}
+
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Pool.Unmarshal(m, b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return xxx_messageInfo_Pool.Size(m)
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func (m *Pool) GetPoolId() int64 {
 	if m != nil {
-		return m.PicId
+		return m.PoolId
 	}
 	return 0
 }
 
-// IncomingUpsertPicVote represents receiving a vote on a pic they made.  If a user
-// self-votes, OutgoingUpsertPicVote will be created instead of IncomingUpsertPicVote.
-type UserEvent_IncomingUpsertPicVote struct {
-	// The user who made the vote
-	SubjectUserId        int64    `protobuf:"varint,1,opt,name=subject_user_id,json=subjectUserId,proto3" json:"subject_user_id,omitempty"`
-	PicId                int64    `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Pool) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *UserEvent_IncomingUpsertPicVote) Reset()         { *m = UserEvent_IncomingUpsertPicVote{} }
-func (m *UserEvent_IncomingUpsertPicVote) String() string { return proto.CompactTextString(m) }
-func (*UserEvent_IncomingUpsertPicVote) ProtoMessage()    {}
-func (*UserEvent_IncomingUpsertPicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 1}
+func (m *Pool) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *UserEvent_IncomingUpsertPicVote) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserEvent_IncomingUpsertPicVote.Unmarshal(m, b)
+func (m *Pool) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
 }
-func (m *UserEvent_IncomingUpsertPicVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserEvent_IncomingUpsertPicVote.Marshal(b, m, deterministic)
+
+func (m *Pool) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
 }
-func (m *UserEvent_IncomingUpsertPicVote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserEvent_IncomingUpsertPicVote.Merge(m, src)
+
+// PoolPic is a pic in a pool.
+type PoolPic struct {
+	PoolId int64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PicId  int64 `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// Pics are ordered by position, smallest first.  Positions are unique within a pool, but need
+	// not be contiguous.
+	Position             int64                `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *UserEvent_IncomingUpsertPicVote) XXX_Size() int {
-	return xxx_messageInfo_UserEvent_IncomingUpsertPicVote.Size(m)
+
+func (m *PoolPic) Reset()         { *m = PoolPic{} }
+func (m *PoolPic) String() string { return proto.CompactTextString(m) }
+func (*PoolPic) ProtoMessage()    {}
+func (*PoolPic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{12}
 }
-func (m *UserEvent_IncomingUpsertPicVote) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserEvent_IncomingUpsertPicVote.DiscardUnknown(m)
+
+func (m *PoolPic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolPic.Unmarshal(m, b)
+}
+func (m *PoolPic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolPic.Marshal(b, m, deterministic)
+}
+func (m *PoolPic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPic.Merge(m, src)
+}
+func (m *PoolPic) XXX_Size() int {
+	return xxx_messageInfo_PoolPic.Size(m)
+}
+func (m *PoolPic) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPic.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserEvent_IncomingUpsertPicVote proto.InternalMessageInfo
+var xxx_messageInfo_PoolPic proto.InternalMessageInfo
 
-func (m *UserEvent_IncomingUpsertPicVote) GetSubjectUserId() int64 {
+func (m *PoolPic) GetPoolId() int64 {
 	if m != nil {
-		return m.SubjectUserId
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *UserEvent_IncomingUpsertPicVote) GetPicId() int64 {
+func (m *PoolPic) GetPicId() int64 {
 	if m != nil {
 		return m.PicId
 	}
 	return 0
 }
 
-// OutgoingPicComment represents commenting on someone else's pic.  If a user comments on
-// their own pic, OutgoingPicComment will be used instead of IncomingPicComment.
-type UserEvent_OutgoingPicComment struct {
-	// comment_id is the comment that this user created.
-	CommentId            int64    `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	PicId                int64    `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PoolPic) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
 }
 
-func (m *UserEvent_OutgoingPicComment) Reset()         { *m = UserEvent_OutgoingPicComment{} }
-func (m *UserEvent_OutgoingPicComment) String() string { return proto.CompactTextString(m) }
-func (*UserEvent_OutgoingPicComment) ProtoMessage()    {}
-func (*UserEvent_OutgoingPicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 2}
+func (m *PoolPic) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
 }
 
-func (m *UserEvent_OutgoingPicComment) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserEvent_OutgoingPicComment.Unmarshal(m, b)
+func (m *PoolPic) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
 }
-func (m *UserEvent_OutgoingPicComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserEvent_OutgoingPicComment.Marshal(b, m, deterministic)
+
+// UserFollow is a user following another user.  Pics uploaded by the followee appear in the
+// follower's feed.
+type UserFollow struct {
+	// The follower.
+	UserId               int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FolloweeUserId       int64                `protobuf:"varint,2,opt,name=followee_user_id,json=followeeUserId,proto3" json:"followee_user_id,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *UserEvent_OutgoingPicComment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserEvent_OutgoingPicComment.Merge(m, src)
+
+func (m *UserFollow) Reset()         { *m = UserFollow{} }
+func (m *UserFollow) String() string { return proto.CompactTextString(m) }
+func (*UserFollow) ProtoMessage()    {}
+func (*UserFollow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{13}
 }
-func (m *UserEvent_OutgoingPicComment) XXX_Size() int {
-	return xxx_messageInfo_UserEvent_OutgoingPicComment.Size(m)
+
+func (m *UserFollow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserFollow.Unmarshal(m, b)
 }
-func (m *UserEvent_OutgoingPicComment) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserEvent_OutgoingPicComment.DiscardUnknown(m)
+func (m *UserFollow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserFollow.Marshal(b, m, deterministic)
+}
+func (m *UserFollow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserFollow.Merge(m, src)
+}
+func (m *UserFollow) XXX_Size() int {
+	return xxx_messageInfo_UserFollow.Size(m)
+}
+func (m *UserFollow) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserFollow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserEvent_OutgoingPicComment proto.InternalMessageInfo
+var xxx_messageInfo_UserFollow proto.InternalMessageInfo
 
-func (m *UserEvent_OutgoingPicComment) GetCommentId() int64 {
+func (m *UserFollow) GetUserId() int64 {
 	if m != nil {
-		return m.CommentId
+		return m.UserId
 	}
 	return 0
 }
 
-func (m *UserEvent_OutgoingPicComment) GetPicId() int64 {
+func (m *UserFollow) GetFolloweeUserId() int64 {
 	if m != nil {
-		return m.PicId
+		return m.FolloweeUserId
 	}
 	return 0
 }
 
-// IncomingPicComment represents commenting on someone else's pic.  If a user comments on
-// their own pic, OutgoingPicComment will be used instead of IncomingPicComment.
-type UserEvent_IncomingPicComment struct {
-	// comment_id is the newly created child comment, **not** the one owned by user_id.
-	CommentId            int64    `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	PicId                int64    `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UserFollow) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
 }
 
-func (m *UserEvent_IncomingPicComment) Reset()         { *m = UserEvent_IncomingPicComment{} }
-func (m *UserEvent_IncomingPicComment) String() string { return proto.CompactTextString(m) }
-func (*UserEvent_IncomingPicComment) ProtoMessage()    {}
-func (*UserEvent_IncomingPicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 3}
+func (m *UserFollow) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
 }
 
-func (m *UserEvent_IncomingPicComment) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserEvent_IncomingPicComment.Unmarshal(m, b)
+// TagFollow is a user following a tag.  Pics with the tag appear in the follower's feed.  The
+// tag is followed by name rather than by id, since tags are removed when no pic uses them.
+type TagFollow struct {
+	// The follower.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The tag name, as provided by the follower.  The tag need not exist.
+	TagName              string               `protobuf:"bytes,2,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *UserEvent_IncomingPicComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserEvent_IncomingPicComment.Marshal(b, m, deterministic)
+
+func (m *TagFollow) Reset()         { *m = TagFollow{} }
+func (m *TagFollow) String() string { return proto.CompactTextString(m) }
+func (*TagFollow) ProtoMessage()    {}
+func (*TagFollow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{14}
 }
-func (m *UserEvent_IncomingPicComment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserEvent_IncomingPicComment.Merge(m, src)
+
+func (m *TagFollow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagFollow.Unmarshal(m, b)
 }
-func (m *UserEvent_IncomingPicComment) XXX_Size() int {
-	return xxx_messageInfo_UserEvent_IncomingPicComment.Size(m)
+func (m *TagFollow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagFollow.Marshal(b, m, deterministic)
 }
-func (m *UserEvent_IncomingPicComment) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserEvent_IncomingPicComment.DiscardUnknown(m)
+func (m *TagFollow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagFollow.Merge(m, src)
+}
+func (m *TagFollow) XXX_Size() int {
+	return xxx_messageInfo_TagFollow.Size(m)
+}
+func (m *TagFollow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagFollow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserEvent_IncomingPicComment proto.InternalMessageInfo
+var xxx_messageInfo_TagFollow proto.InternalMessageInfo
 
-func (m *UserEvent_IncomingPicComment) GetCommentId() int64 {
+func (m *TagFollow) GetUserId() int64 {
 	if m != nil {
-		return m.CommentId
+		return m.UserId
 	}
 	return 0
 }
 
-func (m *UserEvent_IncomingPicComment) GetPicId() int64 {
+func (m *TagFollow) GetTagName() string {
 	if m != nil {
-		return m.PicId
+		return m.TagName
 	}
-	return 0
+	return ""
 }
 
-type UserEvent_UpsertPic struct {
-	PicId                int64    `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TagFollow) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
 }
 
-func (m *UserEvent_UpsertPic) Reset()         { *m = UserEvent_UpsertPic{} }
-func (m *UserEvent_UpsertPic) String() string { return proto.CompactTextString(m) }
-func (*UserEvent_UpsertPic) ProtoMessage()    {}
-func (*UserEvent_UpsertPic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 4}
+func (m *TagFollow) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
 }
 
-func (m *UserEvent_UpsertPic) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserEvent_UpsertPic.Unmarshal(m, b)
+type UserEvent struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// If multiple events are being created for a single pic, this acts as a tie breaker.
+	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Indexed and can't change
+	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,4,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	// Types that are valid to be assigned to Evt:
+	//	*UserEvent_OutgoingUpsertPicVote_
+	//	*UserEvent_IncomingUpsertPicVote_
+	//	*UserEvent_OutgoingPicComment_
+	//	*UserEvent_IncomingPicComment_
+	//	*UserEvent_UpsertPic_
+	//	*UserEvent_LoginLockout_
+	//	*UserEvent_Suspend_
+	//	*UserEvent_Unsuspend_
+	//	*UserEvent_IncomingFollow_
+	Evt                  isUserEvent_Evt `protobuf_oneof:"evt"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *UserEvent_UpsertPic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserEvent_UpsertPic.Marshal(b, m, deterministic)
+
+func (m *UserEvent) Reset()         { *m = UserEvent{} }
+func (m *UserEvent) String() string { return proto.CompactTextString(m) }
+func (*UserEvent) ProtoMessage()    {}
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15}
 }
-func (m *UserEvent_UpsertPic) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserEvent_UpsertPic.Merge(m, src)
+
+func (m *UserEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent.Unmarshal(m, b)
 }
-func (m *UserEvent_UpsertPic) XXX_Size() int {
-	return xxx_messageInfo_UserEvent_UpsertPic.Size(m)
+func (m *UserEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent.Marshal(b, m, deterministic)
+}
+func (m *UserEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent.Merge(m, src)
+}
+func (m *UserEvent) XXX_Size() int {
+	return xxx_messageInfo_UserEvent.Size(m)
+}
+func (m *UserEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent proto.InternalMessageInfo
+
+func (m *UserEvent) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *UserEvent) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *UserEvent) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *UserEvent) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+type isUserEvent_Evt interface {
+	isUserEvent_Evt()
+}
+
+type UserEvent_OutgoingUpsertPicVote_ struct {
+	OutgoingUpsertPicVote *UserEvent_OutgoingUpsertPicVote `protobuf:"bytes,5,opt,name=outgoing_upsert_pic_vote,json=outgoingUpsertPicVote,proto3,oneof"`
+}
+
+type UserEvent_IncomingUpsertPicVote_ struct {
+	IncomingUpsertPicVote *UserEvent_IncomingUpsertPicVote `protobuf:"bytes,6,opt,name=incoming_upsert_pic_vote,json=incomingUpsertPicVote,proto3,oneof"`
+}
+
+type UserEvent_OutgoingPicComment_ struct {
+	OutgoingPicComment *UserEvent_OutgoingPicComment `protobuf:"bytes,7,opt,name=outgoing_pic_comment,json=outgoingPicComment,proto3,oneof"`
+}
+
+type UserEvent_IncomingPicComment_ struct {
+	IncomingPicComment *UserEvent_IncomingPicComment `protobuf:"bytes,8,opt,name=incoming_pic_comment,json=incomingPicComment,proto3,oneof"`
+}
+
+type UserEvent_UpsertPic_ struct {
+	UpsertPic *UserEvent_UpsertPic `protobuf:"bytes,9,opt,name=upsert_pic,json=upsertPic,proto3,oneof"`
+}
+
+type UserEvent_LoginLockout_ struct {
+	LoginLockout *UserEvent_LoginLockout `protobuf:"bytes,10,opt,name=login_lockout,json=loginLockout,proto3,oneof"`
+}
+
+type UserEvent_Suspend_ struct {
+	Suspend *UserEvent_Suspend `protobuf:"bytes,11,opt,name=suspend,proto3,oneof"`
+}
+
+type UserEvent_Unsuspend_ struct {
+	Unsuspend *UserEvent_Unsuspend `protobuf:"bytes,12,opt,name=unsuspend,proto3,oneof"`
+}
+
+type UserEvent_IncomingFollow_ struct {
+	IncomingFollow *UserEvent_IncomingFollow `protobuf:"bytes,13,opt,name=incoming_follow,json=incomingFollow,proto3,oneof"`
+}
+
+func (*UserEvent_OutgoingUpsertPicVote_) isUserEvent_Evt() {}
+
+func (*UserEvent_IncomingUpsertPicVote_) isUserEvent_Evt() {}
+
+func (*UserEvent_OutgoingPicComment_) isUserEvent_Evt() {}
+
+func (*UserEvent_IncomingPicComment_) isUserEvent_Evt() {}
+
+func (*UserEvent_UpsertPic_) isUserEvent_Evt() {}
+
+func (*UserEvent_LoginLockout_) isUserEvent_Evt() {}
+
+func (*UserEvent_Suspend_) isUserEvent_Evt() {}
+
+func (*UserEvent_Unsuspend_) isUserEvent_Evt() {}
+
+func (*UserEvent_IncomingFollow_) isUserEvent_Evt() {}
+
+func (m *UserEvent) GetEvt() isUserEvent_Evt {
+	if m != nil {
+		return m.Evt
+	}
+	return nil
+}
+
+func (m *UserEvent) GetOutgoingUpsertPicVote() *UserEvent_OutgoingUpsertPicVote {
+	if x, ok := m.GetEvt().(*UserEvent_OutgoingUpsertPicVote_); ok {
+		return x.OutgoingUpsertPicVote
+	}
+	return nil
+}
+
+func (m *UserEvent) GetIncomingUpsertPicVote() *UserEvent_IncomingUpsertPicVote {
+	if x, ok := m.GetEvt().(*UserEvent_IncomingUpsertPicVote_); ok {
+		return x.IncomingUpsertPicVote
+	}
+	return nil
+}
+
+func (m *UserEvent) GetOutgoingPicComment() *UserEvent_OutgoingPicComment {
+	if x, ok := m.GetEvt().(*UserEvent_OutgoingPicComment_); ok {
+		return x.OutgoingPicComment
+	}
+	return nil
+}
+
+func (m *UserEvent) GetIncomingPicComment() *UserEvent_IncomingPicComment {
+	if x, ok := m.GetEvt().(*UserEvent_IncomingPicComment_); ok {
+		return x.IncomingPicComment
+	}
+	return nil
+}
+
+func (m *UserEvent) GetUpsertPic() *UserEvent_UpsertPic {
+	if x, ok := m.GetEvt().(*UserEvent_UpsertPic_); ok {
+		return x.UpsertPic
+	}
+	return nil
+}
+
+func (m *UserEvent) GetLoginLockout() *UserEvent_LoginLockout {
+	if x, ok := m.GetEvt().(*UserEvent_LoginLockout_); ok {
+		return x.LoginLockout
+	}
+	return nil
+}
+
+func (m *UserEvent) GetSuspend() *UserEvent_Suspend {
+	if x, ok := m.GetEvt().(*UserEvent_Suspend_); ok {
+		return x.Suspend
+	}
+	return nil
+}
+
+func (m *UserEvent) GetUnsuspend() *UserEvent_Unsuspend {
+	if x, ok := m.GetEvt().(*UserEvent_Unsuspend_); ok {
+		return x.Unsuspend
+	}
+	return nil
+}
+
+func (m *UserEvent) GetIncomingFollow() *UserEvent_IncomingFollow {
+	if x, ok := m.GetEvt().(*UserEvent_IncomingFollow_); ok {
+		return x.IncomingFollow
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*UserEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*UserEvent_OutgoingUpsertPicVote_)(nil),
+		(*UserEvent_IncomingUpsertPicVote_)(nil),
+		(*UserEvent_OutgoingPicComment_)(nil),
+		(*UserEvent_IncomingPicComment_)(nil),
+		(*UserEvent_UpsertPic_)(nil),
+		(*UserEvent_LoginLockout_)(nil),
+		(*UserEvent_Suspend_)(nil),
+		(*UserEvent_Unsuspend_)(nil),
+		(*UserEvent_IncomingFollow_)(nil),
+	}
+}
+
+// IncomingUpsertPicVote represents sending a vote on a pic another user made.  If a user
+// self-votes, OutgoingUpsertPicVote will be created instead of IncomingUpsertPicVote.
+type UserEvent_OutgoingUpsertPicVote struct {
+	PicId                int64    `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_OutgoingUpsertPicVote) Reset()         { *m = UserEvent_OutgoingUpsertPicVote{} }
+func (m *UserEvent_OutgoingUpsertPicVote) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_OutgoingUpsertPicVote) ProtoMessage()    {}
+func (*UserEvent_OutgoingUpsertPicVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15, 0}
+}
+
+func (m *UserEvent_OutgoingUpsertPicVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.Unmarshal(m, b)
+}
+func (m *UserEvent_OutgoingUpsertPicVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_OutgoingUpsertPicVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.Merge(m, src)
+}
+func (m *UserEvent_OutgoingUpsertPicVote) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.Size(m)
+}
+func (m *UserEvent_OutgoingUpsertPicVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_OutgoingUpsertPicVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_OutgoingUpsertPicVote proto.InternalMessageInfo
+
+func (m *UserEvent_OutgoingUpsertPicVote) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+// IncomingUpsertPicVote represents receiving a vote on a pic they made.  If a user
+// self-votes, OutgoingUpsertPicVote will be created instead of IncomingUpsertPicVote.
+type UserEvent_IncomingUpsertPicVote struct {
+	// The user who made the vote
+	SubjectUserId        int64    `protobuf:"varint,1,opt,name=subject_user_id,json=subjectUserId,proto3" json:"subject_user_id,omitempty"`
+	PicId                int64    `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_IncomingUpsertPicVote) Reset()         { *m = UserEvent_IncomingUpsertPicVote{} }
+func (m *UserEvent_IncomingUpsertPicVote) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_IncomingUpsertPicVote) ProtoMessage()    {}
+func (*UserEvent_IncomingUpsertPicVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15, 1}
+}
+
+func (m *UserEvent_IncomingUpsertPicVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_IncomingUpsertPicVote.Unmarshal(m, b)
+}
+func (m *UserEvent_IncomingUpsertPicVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_IncomingUpsertPicVote.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_IncomingUpsertPicVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_IncomingUpsertPicVote.Merge(m, src)
+}
+func (m *UserEvent_IncomingUpsertPicVote) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_IncomingUpsertPicVote.Size(m)
+}
+func (m *UserEvent_IncomingUpsertPicVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_IncomingUpsertPicVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_IncomingUpsertPicVote proto.InternalMessageInfo
+
+func (m *UserEvent_IncomingUpsertPicVote) GetSubjectUserId() int64 {
+	if m != nil {
+		return m.SubjectUserId
+	}
+	return 0
+}
+
+func (m *UserEvent_IncomingUpsertPicVote) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+// OutgoingPicComment represents commenting on someone else's pic.  If a user comments on
+// their own pic, OutgoingPicComment will be used instead of IncomingPicComment.
+type UserEvent_OutgoingPicComment struct {
+	// comment_id is the comment that this user created.
+	CommentId            int64    `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PicId                int64    `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_OutgoingPicComment) Reset()         { *m = UserEvent_OutgoingPicComment{} }
+func (m *UserEvent_OutgoingPicComment) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_OutgoingPicComment) ProtoMessage()    {}
+func (*UserEvent_OutgoingPicComment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15, 2}
+}
+
+func (m *UserEvent_OutgoingPicComment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_OutgoingPicComment.Unmarshal(m, b)
+}
+func (m *UserEvent_OutgoingPicComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_OutgoingPicComment.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_OutgoingPicComment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_OutgoingPicComment.Merge(m, src)
+}
+func (m *UserEvent_OutgoingPicComment) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_OutgoingPicComment.Size(m)
+}
+func (m *UserEvent_OutgoingPicComment) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_OutgoingPicComment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_OutgoingPicComment proto.InternalMessageInfo
+
+func (m *UserEvent_OutgoingPicComment) GetCommentId() int64 {
+	if m != nil {
+		return m.CommentId
+	}
+	return 0
+}
+
+func (m *UserEvent_OutgoingPicComment) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+// IncomingPicComment represents commenting on someone else's pic.  If a user comments on
+// their own pic, OutgoingPicComment will be used instead of IncomingPicComment.
+type UserEvent_IncomingPicComment struct {
+	// comment_id is the newly created child comment, **not** the one owned by user_id.
+	CommentId            int64    `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PicId                int64    `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_IncomingPicComment) Reset()         { *m = UserEvent_IncomingPicComment{} }
+func (m *UserEvent_IncomingPicComment) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_IncomingPicComment) ProtoMessage()    {}
+func (*UserEvent_IncomingPicComment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15, 3}
+}
+
+func (m *UserEvent_IncomingPicComment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_IncomingPicComment.Unmarshal(m, b)
+}
+func (m *UserEvent_IncomingPicComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_IncomingPicComment.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_IncomingPicComment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_IncomingPicComment.Merge(m, src)
+}
+func (m *UserEvent_IncomingPicComment) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_IncomingPicComment.Size(m)
+}
+func (m *UserEvent_IncomingPicComment) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_IncomingPicComment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_IncomingPicComment proto.InternalMessageInfo
+
+func (m *UserEvent_IncomingPicComment) GetCommentId() int64 {
+	if m != nil {
+		return m.CommentId
+	}
+	return 0
+}
+
+func (m *UserEvent_IncomingPicComment) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+type UserEvent_UpsertPic struct {
+	PicId                int64    `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_UpsertPic) Reset()         { *m = UserEvent_UpsertPic{} }
+func (m *UserEvent_UpsertPic) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_UpsertPic) ProtoMessage()    {}
+func (*UserEvent_UpsertPic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15, 4}
+}
+
+func (m *UserEvent_UpsertPic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_UpsertPic.Unmarshal(m, b)
+}
+func (m *UserEvent_UpsertPic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_UpsertPic.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_UpsertPic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_UpsertPic.Merge(m, src)
+}
+func (m *UserEvent_UpsertPic) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_UpsertPic.Size(m)
+}
+func (m *UserEvent_UpsertPic) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_UpsertPic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_UpsertPic proto.InternalMessageInfo
+
+func (m *UserEvent_UpsertPic) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+// LoginLockout represents logins to this user being locked after too many failed attempts.
+type UserEvent_LoginLockout struct {
+	// The address of the last failed attempt, if known.
+	RemoteAddr           string               `protobuf:"bytes,1,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	LockedUntilTs        *timestamp.Timestamp `protobuf:"bytes,2,opt,name=locked_until_ts,json=lockedUntilTs,proto3" json:"locked_until_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserEvent_LoginLockout) Reset()         { *m = UserEvent_LoginLockout{} }
+func (m *UserEvent_LoginLockout) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_LoginLockout) ProtoMessage()    {}
+func (*UserEvent_LoginLockout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15, 5}
+}
+
+func (m *UserEvent_LoginLockout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_LoginLockout.Unmarshal(m, b)
+}
+func (m *UserEvent_LoginLockout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_LoginLockout.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_LoginLockout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_LoginLockout.Merge(m, src)
+}
+func (m *UserEvent_LoginLockout) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_LoginLockout.Size(m)
+}
+func (m *UserEvent_LoginLockout) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_LoginLockout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_LoginLockout proto.InternalMessageInfo
+
+func (m *UserEvent_LoginLockout) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
+}
+
+func (m *UserEvent_LoginLockout) GetLockedUntilTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.LockedUntilTs
+	}
+	return nil
+}
+
+// Suspend represents this user being suspended.
+type UserEvent_Suspend struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// When the suspension ends, if it isn't indefinite.
+	EndTs                *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserEvent_Suspend) Reset()         { *m = UserEvent_Suspend{} }
+func (m *UserEvent_Suspend) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_Suspend) ProtoMessage()    {}
+func (*UserEvent_Suspend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15, 6}
+}
+
+func (m *UserEvent_Suspend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_Suspend.Unmarshal(m, b)
+}
+func (m *UserEvent_Suspend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_Suspend.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_Suspend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_Suspend.Merge(m, src)
+}
+func (m *UserEvent_Suspend) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_Suspend.Size(m)
+}
+func (m *UserEvent_Suspend) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_Suspend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_Suspend proto.InternalMessageInfo
+
+func (m *UserEvent_Suspend) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *UserEvent_Suspend) GetEndTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTs
+	}
+	return nil
+}
+
+// Unsuspend represents this user's suspension being lifted early.
+type UserEvent_Unsuspend struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_Unsuspend) Reset()         { *m = UserEvent_Unsuspend{} }
+func (m *UserEvent_Unsuspend) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_Unsuspend) ProtoMessage()    {}
+func (*UserEvent_Unsuspend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15, 7}
+}
+
+func (m *UserEvent_Unsuspend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_Unsuspend.Unmarshal(m, b)
+}
+func (m *UserEvent_Unsuspend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_Unsuspend.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_Unsuspend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_Unsuspend.Merge(m, src)
+}
+func (m *UserEvent_Unsuspend) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_Unsuspend.Size(m)
+}
+func (m *UserEvent_Unsuspend) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_Unsuspend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_Unsuspend proto.InternalMessageInfo
+
+// IncomingFollow represents another user starting to follow this user.
+type UserEvent_IncomingFollow struct {
+	// The user who followed this user.
+	SubjectUserId        int64    `protobuf:"varint,1,opt,name=subject_user_id,json=subjectUserId,proto3" json:"subject_user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_IncomingFollow) Reset()         { *m = UserEvent_IncomingFollow{} }
+func (m *UserEvent_IncomingFollow) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_IncomingFollow) ProtoMessage()    {}
+func (*UserEvent_IncomingFollow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15, 8}
+}
+
+func (m *UserEvent_IncomingFollow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_IncomingFollow.Unmarshal(m, b)
+}
+func (m *UserEvent_IncomingFollow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_IncomingFollow.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_IncomingFollow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_IncomingFollow.Merge(m, src)
+}
+func (m *UserEvent_IncomingFollow) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_IncomingFollow.Size(m)
+}
+func (m *UserEvent_IncomingFollow) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_IncomingFollow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_IncomingFollow proto.InternalMessageInfo
+
+func (m *UserEvent_IncomingFollow) GetSubjectUserId() int64 {
+	if m != nil {
+		return m.SubjectUserId
+	}
+	return 0
+}
+
+type User struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Hashed secret token
+	Secret []byte `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Identity of the user, usually an email.
+	Ident      string               `protobuf:"bytes,3,opt,name=ident,proto3" json:"ident,omitempty"`
+	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	LastSeenTs *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_seen_ts,json=lastSeenTs,proto3" json:"last_seen_ts,omitempty"`
+	Capability []User_Capability    `protobuf:"varint,7,rep,packed,name=capability,proto3,enum=pixur.be.schema.User_Capability" json:"capability,omitempty"`
+	// Always increment-then-get
+	NextTokenId int64        `protobuf:"varint,8,opt,name=next_token_id,json=nextTokenId,proto3" json:"next_token_id,omitempty"`
+	UserToken   []*UserToken `protobuf:"bytes,9,rep,name=user_token,json=userToken,proto3" json:"user_token,omitempty"`
+	// Extra information that may not fit into the schema
+	Ext map[string]*any.Any `protobuf:"bytes,10,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The pending secret reset, if any.  Cleared once used.
+	PasswordReset *User_PasswordReset `protobuf:"bytes,11,opt,name=password_reset,json=passwordReset,proto3" json:"password_reset,omitempty"`
+	// How this user was invited, if they were created with an invite code.
+	Invitation *User_Invitation `protobuf:"bytes,12,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	// The two-factor authentication enrollment, if any.
+	Totp *User_Totp `protobuf:"bytes,13,opt,name=totp,proto3" json:"totp,omitempty"`
+	// The names of the roles the user holds.  The user has the capabilities of each role in the
+	// configuration, in addition to those in capability.  Unknown roles are ignored.
+	Role []string `protobuf:"bytes,14,rep,name=role,proto3" json:"role,omitempty"`
+	// The suspension, if any.  While active, the user can only read.
+	Suspension *User_Suspension `protobuf:"bytes,15,opt,name=suspension,proto3" json:"suspension,omitempty"`
+	// The public profile of the user, if any.
+	Profile              *User_Profile `protobuf:"bytes,16,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{16}
+}
+
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User.Unmarshal(m, b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+}
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
+}
+func (m *User) XXX_Size() int {
+	return xxx_messageInfo_User.Size(m)
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *User) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *User) GetIdent() string {
+	if m != nil {
+		return m.Ident
+	}
+	return ""
+}
+
+func (m *User) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *User) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+func (m *User) GetLastSeenTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSeenTs
+	}
+	return nil
+}
+
+func (m *User) GetCapability() []User_Capability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+func (m *User) GetNextTokenId() int64 {
+	if m != nil {
+		return m.NextTokenId
+	}
+	return 0
+}
+
+func (m *User) GetUserToken() []*UserToken {
+	if m != nil {
+		return m.UserToken
+	}
+	return nil
+}
+
+func (m *User) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+func (m *User) GetPasswordReset() *User_PasswordReset {
+	if m != nil {
+		return m.PasswordReset
+	}
+	return nil
+}
+
+func (m *User) GetInvitation() *User_Invitation {
+	if m != nil {
+		return m.Invitation
+	}
+	return nil
+}
+
+func (m *User) GetTotp() *User_Totp {
+	if m != nil {
+		return m.Totp
+	}
+	return nil
+}
+
+func (m *User) GetRole() []string {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *User) GetSuspension() *User_Suspension {
+	if m != nil {
+		return m.Suspension
+	}
+	return nil
+}
+
+func (m *User) GetProfile() *User_Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type User_PasswordReset struct {
+	// Hash of the reset token sent to the user.
+	TokenHash            []byte               `protobuf:"bytes,1,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ExpireTs             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_ts,json=expireTs,proto3" json:"expire_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *User_PasswordReset) Reset()         { *m = User_PasswordReset{} }
+func (m *User_PasswordReset) String() string { return proto.CompactTextString(m) }
+func (*User_PasswordReset) ProtoMessage()    {}
+func (*User_PasswordReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{16, 1}
+}
+
+func (m *User_PasswordReset) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User_PasswordReset.Unmarshal(m, b)
+}
+func (m *User_PasswordReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User_PasswordReset.Marshal(b, m, deterministic)
+}
+func (m *User_PasswordReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User_PasswordReset.Merge(m, src)
+}
+func (m *User_PasswordReset) XXX_Size() int {
+	return xxx_messageInfo_User_PasswordReset.Size(m)
+}
+func (m *User_PasswordReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_User_PasswordReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User_PasswordReset proto.InternalMessageInfo
+
+func (m *User_PasswordReset) GetTokenHash() []byte {
+	if m != nil {
+		return m.TokenHash
+	}
+	return nil
+}
+
+func (m *User_PasswordReset) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *User_PasswordReset) GetExpireTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTs
+	}
+	return nil
+}
+
+type User_Invitation struct {
+	// The user who created the invite code.
+	InviterUserId        int64    `protobuf:"varint,1,opt,name=inviter_user_id,json=inviterUserId,proto3" json:"inviter_user_id,omitempty"`
+	InviteCodeId         int64    `protobuf:"varint,2,opt,name=invite_code_id,json=inviteCodeId,proto3" json:"invite_code_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *User_Invitation) Reset()         { *m = User_Invitation{} }
+func (m *User_Invitation) String() string { return proto.CompactTextString(m) }
+func (*User_Invitation) ProtoMessage()    {}
+func (*User_Invitation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{16, 2}
+}
+
+func (m *User_Invitation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User_Invitation.Unmarshal(m, b)
+}
+func (m *User_Invitation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User_Invitation.Marshal(b, m, deterministic)
+}
+func (m *User_Invitation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User_Invitation.Merge(m, src)
+}
+func (m *User_Invitation) XXX_Size() int {
+	return xxx_messageInfo_User_Invitation.Size(m)
+}
+func (m *User_Invitation) XXX_DiscardUnknown() {
+	xxx_messageInfo_User_Invitation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User_Invitation proto.InternalMessageInfo
+
+func (m *User_Invitation) GetInviterUserId() int64 {
+	if m != nil {
+		return m.InviterUserId
+	}
+	return 0
+}
+
+func (m *User_Invitation) GetInviteCodeId() int64 {
+	if m != nil {
+		return m.InviteCodeId
+	}
+	return 0
+}
+
+type User_Totp struct {
+	// The shared secret used to generate codes.
+	Secret    []byte               `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedTs *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// When the user proved they could generate codes.  Until then, the secret is pending and
+	// not required at login.
+	ConfirmedTs *timestamp.Timestamp `protobuf:"bytes,3,opt,name=confirmed_ts,json=confirmedTs,proto3" json:"confirmed_ts,omitempty"`
+	// The last time step a code was accepted for.  Codes at or before this step are rejected
+	// to prevent replay.
+	LastStep int64 `protobuf:"varint,4,opt,name=last_step,json=lastStep,proto3" json:"last_step,omitempty"`
+	// Hashes of unused recovery codes.  Each may be used once instead of a code.
+	RecoveryCodeHash     [][]byte `protobuf:"bytes,5,rep,name=recovery_code_hash,json=recoveryCodeHash,proto3" json:"recovery_code_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *User_Totp) Reset()         { *m = User_Totp{} }
+func (m *User_Totp) String() string { return proto.CompactTextString(m) }
+func (*User_Totp) ProtoMessage()    {}
+func (*User_Totp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{16, 3}
+}
+
+func (m *User_Totp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User_Totp.Unmarshal(m, b)
+}
+func (m *User_Totp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User_Totp.Marshal(b, m, deterministic)
+}
+func (m *User_Totp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User_Totp.Merge(m, src)
+}
+func (m *User_Totp) XXX_Size() int {
+	return xxx_messageInfo_User_Totp.Size(m)
+}
+func (m *User_Totp) XXX_DiscardUnknown() {
+	xxx_messageInfo_User_Totp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User_Totp proto.InternalMessageInfo
+
+func (m *User_Totp) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *User_Totp) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *User_Totp) GetConfirmedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ConfirmedTs
+	}
+	return nil
+}
+
+func (m *User_Totp) GetLastStep() int64 {
+	if m != nil {
+		return m.LastStep
+	}
+	return 0
+}
+
+func (m *User_Totp) GetRecoveryCodeHash() [][]byte {
+	if m != nil {
+		return m.RecoveryCodeHash
+	}
+	return nil
+}
+
+type User_Suspension struct {
+	// Why the user was suspended.  Shown to the user.
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The user who suspended this user.
+	IssuerUserId int64                `protobuf:"varint,2,opt,name=issuer_user_id,json=issuerUserId,proto3" json:"issuer_user_id,omitempty"`
+	StartTs      *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	// When the suspension ends.  If absent, it lasts until the user is unsuspended.
+	EndTs                *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *User_Suspension) Reset()         { *m = User_Suspension{} }
+func (m *User_Suspension) String() string { return proto.CompactTextString(m) }
+func (*User_Suspension) ProtoMessage()    {}
+func (*User_Suspension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{16, 4}
+}
+
+func (m *User_Suspension) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User_Suspension.Unmarshal(m, b)
+}
+func (m *User_Suspension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User_Suspension.Marshal(b, m, deterministic)
+}
+func (m *User_Suspension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User_Suspension.Merge(m, src)
+}
+func (m *User_Suspension) XXX_Size() int {
+	return xxx_messageInfo_User_Suspension.Size(m)
+}
+func (m *User_Suspension) XXX_DiscardUnknown() {
+	xxx_messageInfo_User_Suspension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User_Suspension proto.InternalMessageInfo
+
+func (m *User_Suspension) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *User_Suspension) GetIssuerUserId() int64 {
+	if m != nil {
+		return m.IssuerUserId
+	}
+	return 0
+}
+
+func (m *User_Suspension) GetStartTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTs
+	}
+	return nil
+}
+
+func (m *User_Suspension) GetEndTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTs
+	}
+	return nil
+}
+
+type User_Profile struct {
+	// The name shown to other users instead of the ident.
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio         string `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	// The pic shown next to the user's name.  If 0, there is none.
+	AvatarPicId          int64    `protobuf:"varint,3,opt,name=avatar_pic_id,json=avatarPicId,proto3" json:"avatar_pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *User_Profile) Reset()         { *m = User_Profile{} }
+func (m *User_Profile) String() string { return proto.CompactTextString(m) }
+func (*User_Profile) ProtoMessage()    {}
+func (*User_Profile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{16, 5}
+}
+
+func (m *User_Profile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User_Profile.Unmarshal(m, b)
+}
+func (m *User_Profile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User_Profile.Marshal(b, m, deterministic)
+}
+func (m *User_Profile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User_Profile.Merge(m, src)
+}
+func (m *User_Profile) XXX_Size() int {
+	return xxx_messageInfo_User_Profile.Size(m)
+}
+func (m *User_Profile) XXX_DiscardUnknown() {
+	xxx_messageInfo_User_Profile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User_Profile proto.InternalMessageInfo
+
+func (m *User_Profile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *User_Profile) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *User_Profile) GetAvatarPicId() int64 {
+	if m != nil {
+		return m.AvatarPicId
+	}
+	return 0
+}
+
+// InviteCode allows a new user to be created, even if the anonymous user can't create users.
+type InviteCode struct {
+	InviteCodeId int64 `protobuf:"varint,1,opt,name=invite_code_id,json=inviteCodeId,proto3" json:"invite_code_id,omitempty"`
+	// Hash of the code given to the new user.
+	CodeHash []byte `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// The user who created the code.
+	CreatorUserId int64                `protobuf:"varint,3,opt,name=creator_user_id,json=creatorUserId,proto3" json:"creator_user_id,omitempty"`
+	CreatedTs     *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	// After this time, the code can't be used.  If absent, the code doesn't expire.
+	ExpireTs *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expire_ts,json=expireTs,proto3" json:"expire_ts,omitempty"`
+	// How many users may be created with this code.
+	MaxUses int64 `protobuf:"varint,7,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// How many users have been created with this code.
+	Uses int64 `protobuf:"varint,8,opt,name=uses,proto3" json:"uses,omitempty"`
+	// The capabilities granted to users created with this code.  If empty, the new user capabilities
+	// from the configuration are used.
+	Capability           []User_Capability `protobuf:"varint,9,rep,packed,name=capability,proto3,enum=pixur.be.schema.User_Capability" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InviteCode) Reset()         { *m = InviteCode{} }
+func (m *InviteCode) String() string { return proto.CompactTextString(m) }
+func (*InviteCode) ProtoMessage()    {}
+func (*InviteCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{17}
+}
+
+func (m *InviteCode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteCode.Unmarshal(m, b)
+}
+func (m *InviteCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteCode.Marshal(b, m, deterministic)
+}
+func (m *InviteCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteCode.Merge(m, src)
+}
+func (m *InviteCode) XXX_Size() int {
+	return xxx_messageInfo_InviteCode.Size(m)
+}
+func (m *InviteCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteCode proto.InternalMessageInfo
+
+func (m *InviteCode) GetInviteCodeId() int64 {
+	if m != nil {
+		return m.InviteCodeId
+	}
+	return 0
+}
+
+func (m *InviteCode) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *InviteCode) GetCreatorUserId() int64 {
+	if m != nil {
+		return m.CreatorUserId
+	}
+	return 0
+}
+
+func (m *InviteCode) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *InviteCode) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+func (m *InviteCode) GetExpireTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTs
+	}
+	return nil
+}
+
+func (m *InviteCode) GetMaxUses() int64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *InviteCode) GetUses() int64 {
+	if m != nil {
+		return m.Uses
+	}
+	return 0
+}
+
+func (m *InviteCode) GetCapability() []User_Capability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+// ApiKey is a long lived credential for a user, usually held by a bot or script.  It acts as the
+// user, but only with the capabilities listed on the key.
+type ApiKey struct {
+	ApiKeyId int64 `protobuf:"varint,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	// Hash of the key given to the user.
+	KeyHash []byte `protobuf:"bytes,2,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	// The user the key acts as.
+	UserId int64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// A description of what the key is for, chosen by the user.
+	Name       string               `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,6,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	// After this time, the key can't be used.  If absent, the key doesn't expire.
+	ExpireTs *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expire_ts,json=expireTs,proto3" json:"expire_ts,omitempty"`
+	// When the key was last used.  This is updated lazily.
+	LastUsedTs *timestamp.Timestamp `protobuf:"bytes,8,opt,name=last_used_ts,json=lastUsedTs,proto3" json:"last_used_ts,omitempty"`
+	// The capabilities the key may use.  The user must also have each one for it to be usable.
+	Capability           []User_Capability `protobuf:"varint,9,rep,packed,name=capability,proto3,enum=pixur.be.schema.User_Capability" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ApiKey) Reset()         { *m = ApiKey{} }
+func (m *ApiKey) String() string { return proto.CompactTextString(m) }
+func (*ApiKey) ProtoMessage()    {}
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{18}
+}
+
+func (m *ApiKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKey.Unmarshal(m, b)
+}
+func (m *ApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKey.Marshal(b, m, deterministic)
+}
+func (m *ApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKey.Merge(m, src)
+}
+func (m *ApiKey) XXX_Size() int {
+	return xxx_messageInfo_ApiKey.Size(m)
+}
+func (m *ApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKey proto.InternalMessageInfo
+
+func (m *ApiKey) GetApiKeyId() int64 {
+	if m != nil {
+		return m.ApiKeyId
+	}
+	return 0
+}
+
+func (m *ApiKey) GetKeyHash() []byte {
+	if m != nil {
+		return m.KeyHash
+	}
+	return nil
+}
+
+func (m *ApiKey) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *ApiKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiKey) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *ApiKey) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+func (m *ApiKey) GetExpireTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTs
+	}
+	return nil
+}
+
+func (m *ApiKey) GetLastUsedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastUsedTs
+	}
+	return nil
+}
+
+func (m *ApiKey) GetCapability() []User_Capability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+// LoginFailure counts recent failed logins for a user or an address, so that guessing secrets can
+// be locked out.
+type LoginFailure struct {
+	LoginFailureId int64 `protobuf:"varint,1,opt,name=login_failure_id,json=loginFailureId,proto3" json:"login_failure_id,omitempty"`
+	// What the failures are for, such as "user:<user id>" or "addr:<remote address>".
+	Subject   string               `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	CreatedTs *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// Updated on every failure.  Failures are forgotten some time after this.
+	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,4,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	// Failures since the last lockout.
+	Failures int64 `protobuf:"varint,5,opt,name=failures,proto3" json:"failures,omitempty"`
+	// How many times the subject has been locked out.  Each lockout is longer than the last.
+	Lockouts int64 `protobuf:"varint,6,opt,name=lockouts,proto3" json:"lockouts,omitempty"`
+	// Until this time, logins for the subject are refused.  If absent, logins are allowed.
+	LockedUntilTs        *timestamp.Timestamp `protobuf:"bytes,7,opt,name=locked_until_ts,json=lockedUntilTs,proto3" json:"locked_until_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *LoginFailure) Reset()         { *m = LoginFailure{} }
+func (m *LoginFailure) String() string { return proto.CompactTextString(m) }
+func (*LoginFailure) ProtoMessage()    {}
+func (*LoginFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{19}
+}
+
+func (m *LoginFailure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginFailure.Unmarshal(m, b)
+}
+func (m *LoginFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginFailure.Marshal(b, m, deterministic)
+}
+func (m *LoginFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginFailure.Merge(m, src)
+}
+func (m *LoginFailure) XXX_Size() int {
+	return xxx_messageInfo_LoginFailure.Size(m)
+}
+func (m *LoginFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginFailure proto.InternalMessageInfo
+
+func (m *LoginFailure) GetLoginFailureId() int64 {
+	if m != nil {
+		return m.LoginFailureId
+	}
+	return 0
+}
+
+func (m *LoginFailure) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *LoginFailure) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *LoginFailure) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+func (m *LoginFailure) GetFailures() int64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *LoginFailure) GetLockouts() int64 {
+	if m != nil {
+		return m.Lockouts
+	}
+	return 0
+}
+
+func (m *LoginFailure) GetLockedUntilTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.LockedUntilTs
+	}
+	return nil
+}
+
+// AuditLog records a privileged action, such as changing capabilities or deleting a pic.  It is
+// written in the same job as the action, and is never changed afterwards.
+type AuditLog struct {
+	AuditLogId int64           `protobuf:"varint,1,opt,name=audit_log_id,json=auditLogId,proto3" json:"audit_log_id,omitempty"`
+	Action     AuditLog_Action `protobuf:"varint,2,opt,name=action,proto3,enum=pixur.be.schema.AuditLog_Action" json:"action,omitempty"`
+	// The user who took the action.  If 0, the action was taken by the server itself, such as when
+	// loading the configuration.
+	ActorUserId int64 `protobuf:"varint,3,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	// What the action was taken on.  At most one of target_user_id and target_pic_id is set.
+	TargetUserId int64 `protobuf:"varint,4,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	TargetPicId  int64 `protobuf:"varint,5,opt,name=target_pic_id,json=targetPicId,proto3" json:"target_pic_id,omitempty"`
+	// For comment actions, the comment on target_pic_id.
+	TargetCommentId int64                `protobuf:"varint,11,opt,name=target_comment_id,json=targetCommentId,proto3" json:"target_comment_id,omitempty"`
+	CreatedTs       *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs      *timestamp.Timestamp `protobuf:"bytes,7,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	// Why the action was taken, as given by the actor.
+	Reason string             `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Change []*AuditLog_Change `protobuf:"bytes,9,rep,name=change,proto3" json:"change,omitempty"`
+	// For UPDATE_CONFIGURATION, the whole configuration after the change, so the next change can be
+	// compared to it.
+	Configuration        *Configuration `protobuf:"bytes,10,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AuditLog) Reset()         { *m = AuditLog{} }
+func (m *AuditLog) String() string { return proto.CompactTextString(m) }
+func (*AuditLog) ProtoMessage()    {}
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{20}
+}
+
+func (m *AuditLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLog.Unmarshal(m, b)
+}
+func (m *AuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLog.Marshal(b, m, deterministic)
+}
+func (m *AuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLog.Merge(m, src)
+}
+func (m *AuditLog) XXX_Size() int {
+	return xxx_messageInfo_AuditLog.Size(m)
+}
+func (m *AuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLog proto.InternalMessageInfo
+
+func (m *AuditLog) GetAuditLogId() int64 {
+	if m != nil {
+		return m.AuditLogId
+	}
+	return 0
+}
+
+func (m *AuditLog) GetAction() AuditLog_Action {
+	if m != nil {
+		return m.Action
+	}
+	return AuditLog_UNKNOWN
+}
+
+func (m *AuditLog) GetActorUserId() int64 {
+	if m != nil {
+		return m.ActorUserId
+	}
+	return 0
+}
+
+func (m *AuditLog) GetTargetUserId() int64 {
+	if m != nil {
+		return m.TargetUserId
+	}
+	return 0
+}
+
+func (m *AuditLog) GetTargetPicId() int64 {
+	if m != nil {
+		return m.TargetPicId
+	}
+	return 0
+}
+
+func (m *AuditLog) GetTargetCommentId() int64 {
+	if m != nil {
+		return m.TargetCommentId
+	}
+	return 0
+}
+
+func (m *AuditLog) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *AuditLog) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
 }
-func (m *UserEvent_UpsertPic) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserEvent_UpsertPic.DiscardUnknown(m)
+
+func (m *AuditLog) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-var xxx_messageInfo_UserEvent_UpsertPic proto.InternalMessageInfo
+func (m *AuditLog) GetChange() []*AuditLog_Change {
+	if m != nil {
+		return m.Change
+	}
+	return nil
+}
 
-func (m *UserEvent_UpsertPic) GetPicId() int64 {
+func (m *AuditLog) GetConfiguration() *Configuration {
 	if m != nil {
-		return m.PicId
+		return m.Configuration
 	}
-	return 0
+	return nil
 }
 
-type User struct {
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Hashed secret token
-	Secret []byte `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
-	// Identity of the user, usually an email.
-	Ident      string               `protobuf:"bytes,3,opt,name=ident,proto3" json:"ident,omitempty"`
-	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
-	LastSeenTs *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_seen_ts,json=lastSeenTs,proto3" json:"last_seen_ts,omitempty"`
-	Capability []User_Capability    `protobuf:"varint,7,rep,packed,name=capability,proto3,enum=pixur.be.schema.User_Capability" json:"capability,omitempty"`
-	// Always increment-then-get
-	NextTokenId int64        `protobuf:"varint,8,opt,name=next_token_id,json=nextTokenId,proto3" json:"next_token_id,omitempty"`
-	UserToken   []*UserToken `protobuf:"bytes,9,rep,name=user_token,json=userToken,proto3" json:"user_token,omitempty"`
-	// Extra information that may not fit into the schema
-	Ext                  map[string]*any.Any `protobuf:"bytes,10,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+// Change is a top level field of the target that the action changed.  The values are in the
+// compact text format, and are empty if the field was unset.
+type AuditLog_Change struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before               string   `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After                string   `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *User) Reset()         { *m = User{} }
-func (m *User) String() string { return proto.CompactTextString(m) }
-func (*User) ProtoMessage()    {}
-func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{9}
+func (m *AuditLog_Change) Reset()         { *m = AuditLog_Change{} }
+func (m *AuditLog_Change) String() string { return proto.CompactTextString(m) }
+func (*AuditLog_Change) ProtoMessage()    {}
+func (*AuditLog_Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{20, 0}
 }
 
-func (m *User) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_User.Unmarshal(m, b)
+func (m *AuditLog_Change) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLog_Change.Unmarshal(m, b)
 }
-func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+func (m *AuditLog_Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLog_Change.Marshal(b, m, deterministic)
 }
-func (m *User) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_User.Merge(m, src)
+func (m *AuditLog_Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLog_Change.Merge(m, src)
 }
-func (m *User) XXX_Size() int {
-	return xxx_messageInfo_User.Size(m)
+func (m *AuditLog_Change) XXX_Size() int {
+	return xxx_messageInfo_AuditLog_Change.Size(m)
 }
-func (m *User) XXX_DiscardUnknown() {
-	xxx_messageInfo_User.DiscardUnknown(m)
+func (m *AuditLog_Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLog_Change.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_User proto.InternalMessageInfo
+var xxx_messageInfo_AuditLog_Change proto.InternalMessageInfo
 
-func (m *User) GetUserId() int64 {
+func (m *AuditLog_Change) GetField() string {
 	if m != nil {
-		return m.UserId
+		return m.Field
 	}
-	return 0
+	return ""
 }
 
-func (m *User) GetSecret() []byte {
+func (m *AuditLog_Change) GetBefore() string {
 	if m != nil {
-		return m.Secret
+		return m.Before
 	}
-	return nil
+	return ""
 }
 
-func (m *User) GetIdent() string {
+func (m *AuditLog_Change) GetAfter() string {
 	if m != nil {
-		return m.Ident
+		return m.After
 	}
 	return ""
 }
 
-func (m *User) GetCreatedTs() *timestamp.Timestamp {
-	if m != nil {
-		return m.CreatedTs
-	}
-	return nil
+// UserLink ties an identity from an external provider, such as an OpenID Connect issuer, to a
+// user.  The user may then log in with the provider instead of their secret.
+type UserLink struct {
+	UserLinkId int64 `protobuf:"varint,1,opt,name=user_link_id,json=userLinkId,proto3" json:"user_link_id,omitempty"`
+	UserId     int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Who vouches for the identity, such as the issuer url.
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// The identity, as named by the provider.  It is unique within the provider.
+	Subject              string               `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *User) GetModifiedTs() *timestamp.Timestamp {
+func (m *UserLink) Reset()         { *m = UserLink{} }
+func (m *UserLink) String() string { return proto.CompactTextString(m) }
+func (*UserLink) ProtoMessage()    {}
+func (*UserLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{21}
+}
+
+func (m *UserLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserLink.Unmarshal(m, b)
+}
+func (m *UserLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserLink.Marshal(b, m, deterministic)
+}
+func (m *UserLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLink.Merge(m, src)
+}
+func (m *UserLink) XXX_Size() int {
+	return xxx_messageInfo_UserLink.Size(m)
+}
+func (m *UserLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserLink proto.InternalMessageInfo
+
+func (m *UserLink) GetUserLinkId() int64 {
 	if m != nil {
-		return m.ModifiedTs
+		return m.UserLinkId
 	}
-	return nil
+	return 0
 }
 
-func (m *User) GetLastSeenTs() *timestamp.Timestamp {
+func (m *UserLink) GetUserId() int64 {
 	if m != nil {
-		return m.LastSeenTs
+		return m.UserId
 	}
-	return nil
+	return 0
 }
 
-func (m *User) GetCapability() []User_Capability {
+func (m *UserLink) GetProvider() string {
 	if m != nil {
-		return m.Capability
+		return m.Provider
 	}
-	return nil
+	return ""
 }
 
-func (m *User) GetNextTokenId() int64 {
+func (m *UserLink) GetSubject() string {
 	if m != nil {
-		return m.NextTokenId
+		return m.Subject
 	}
-	return 0
+	return ""
 }
 
-func (m *User) GetUserToken() []*UserToken {
+func (m *UserLink) GetCreatedTs() *timestamp.Timestamp {
 	if m != nil {
-		return m.UserToken
+		return m.CreatedTs
 	}
 	return nil
 }
 
-func (m *User) GetExt() map[string]*any.Any {
+func (m *UserLink) GetModifiedTs() *timestamp.Timestamp {
 	if m != nil {
-		return m.Ext
+		return m.ModifiedTs
 	}
 	return nil
 }
@@ -1828,19 +3825,23 @@ func (m *User) GetExt() map[string]*any.Any {
 // Represent the valid auth tokens.  When a user logs out, these will be
 // deleted.
 type UserToken struct {
-	TokenId              int64                `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	LastSeenTs           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_seen_ts,json=lastSeenTs,proto3" json:"last_seen_ts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	TokenId    int64                `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	LastSeenTs *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_seen_ts,json=lastSeenTs,proto3" json:"last_seen_ts,omitempty"`
+	// The client info of the most recent use of the token, as seen by the caller of the backend
+	// (usually the frontend).  Used to tell sessions apart.
+	UserAgent            string   `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RemoteAddr           string   `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UserToken) Reset()         { *m = UserToken{} }
 func (m *UserToken) String() string { return proto.CompactTextString(m) }
 func (*UserToken) ProtoMessage()    {}
 func (*UserToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{10}
+	return fileDescriptor_962aa63430fd1f4b, []int{22}
 }
 
 func (m *UserToken) XXX_Unmarshal(b []byte) error {
@@ -1882,6 +3883,20 @@ func (m *UserToken) GetLastSeenTs() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *UserToken) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *UserToken) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
+}
+
 type Configuration struct {
 	// the minimum comment length in bytes.
 	MinCommentLength *wrappers.Int64Value `protobuf:"bytes,1,opt,name=min_comment_length,json=minCommentLength,proto3" json:"min_comment_length,omitempty"`
@@ -1920,7 +3935,54 @@ type Configuration struct {
 	// the default number of user events to return
 	DefaultFindUserEvents *wrappers.Int64Value `protobuf:"bytes,18,opt,name=default_find_user_events,json=defaultFindUserEvents,proto3" json:"default_find_user_events,omitempty"`
 	// the max number of user events to return
-	MaxFindUserEvents    *wrappers.Int64Value `protobuf:"bytes,19,opt,name=max_find_user_events,json=maxFindUserEvents,proto3" json:"max_find_user_events,omitempty"`
+	MaxFindUserEvents *wrappers.Int64Value `protobuf:"bytes,19,opt,name=max_find_user_events,json=maxFindUserEvents,proto3" json:"max_find_user_events,omitempty"`
+	// how long an upload session may go unmodified before it expires
+	UploadSessionExpiry *duration.Duration `protobuf:"bytes,20,opt,name=upload_session_expiry,json=uploadSessionExpiry,proto3" json:"upload_session_expiry,omitempty"`
+	// the max number of redirects to follow when fetching a remote pic
+	MaxRemoteFetchRedirects *wrappers.Int64Value `protobuf:"bytes,21,opt,name=max_remote_fetch_redirects,json=maxRemoteFetchRedirects,proto3" json:"max_remote_fetch_redirects,omitempty"`
+	// the max size in bytes of a remote pic
+	MaxRemoteFetchSize *wrappers.Int64Value `protobuf:"bytes,22,opt,name=max_remote_fetch_size,json=maxRemoteFetchSize,proto3" json:"max_remote_fetch_size,omitempty"`
+	// the max time to spend fetching a remote pic
+	RemoteFetchTimeout *duration.Duration `protobuf:"bytes,23,opt,name=remote_fetch_timeout,json=remoteFetchTimeout,proto3" json:"remote_fetch_timeout,omitempty"`
+	// the allowed media types of a remote pic.  If absent, any type is allowed.
+	RemoteFetchContentType *Configuration_StringSet `protobuf:"bytes,24,opt,name=remote_fetch_content_type,json=remoteFetchContentType,proto3" json:"remote_fetch_content_type,omitempty"`
+	// how long a secret reset token is valid for
+	PasswordResetExpiry *duration.Duration `protobuf:"bytes,25,opt,name=password_reset_expiry,json=passwordResetExpiry,proto3" json:"password_reset_expiry,omitempty"`
+	// users with any of these capabilities must enroll in two-factor authentication to use them
+	TotpRequiredCapability *Configuration_CapabilitySet `protobuf:"bytes,26,opt,name=totp_required_capability,json=totpRequiredCapability,proto3" json:"totp_required_capability,omitempty"`
+	// named sets of capabilities that users may hold.  Changing a role changes the capabilities of
+	// every user holding it.
+	Role *Configuration_RoleSet `protobuf:"bytes,27,opt,name=role,proto3" json:"role,omitempty"`
+	// limits on how often each rpc may be called by a single user, or by a single address for
+	// anonymous users.  Rpcs without a limit are unlimited.
+	RateLimit *Configuration_RateLimitSet `protobuf:"bytes,28,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// how new user secrets are hashed.  Existing secrets hashed some other way, or more weakly, are
+	// rehashed when the user next logs in.
+	PasswordHashPolicy *Configuration_PasswordHashPolicy `protobuf:"bytes,29,opt,name=password_hash_policy,json=passwordHashPolicy,proto3" json:"password_hash_policy,omitempty"`
+	// the default number of audit log entries to return
+	DefaultFindAuditLogs *wrappers.Int64Value `protobuf:"bytes,30,opt,name=default_find_audit_logs,json=defaultFindAuditLogs,proto3" json:"default_find_audit_logs,omitempty"`
+	// the max number of audit log entries to return
+	MaxFindAuditLogs *wrappers.Int64Value `protobuf:"bytes,31,opt,name=max_find_audit_logs,json=maxFindAuditLogs,proto3" json:"max_find_audit_logs,omitempty"`
+	// how long after creation the author may edit a comment.  If absent, there is no limit.
+	PicCommentEditWindow *duration.Duration `protobuf:"bytes,32,opt,name=pic_comment_edit_window,json=picCommentEditWindow,proto3" json:"pic_comment_edit_window,omitempty"`
+	// the max number of collections a user may own
+	MaxCollections *wrappers.Int64Value `protobuf:"bytes,33,opt,name=max_collections,json=maxCollections,proto3" json:"max_collections,omitempty"`
+	// the max number of pics in a single collection
+	MaxCollectionPics *wrappers.Int64Value `protobuf:"bytes,34,opt,name=max_collection_pics,json=maxCollectionPics,proto3" json:"max_collection_pics,omitempty"`
+	// the max collection name length in bytes.
+	MaxCollectionNameLength *wrappers.Int64Value `protobuf:"bytes,35,opt,name=max_collection_name_length,json=maxCollectionNameLength,proto3" json:"max_collection_name_length,omitempty"`
+	// the max number of pics in a single pool
+	MaxPoolPics *wrappers.Int64Value `protobuf:"bytes,36,opt,name=max_pool_pics,json=maxPoolPics,proto3" json:"max_pool_pics,omitempty"`
+	// the max pool title length in bytes.
+	MaxPoolTitleLength *wrappers.Int64Value `protobuf:"bytes,37,opt,name=max_pool_title_length,json=maxPoolTitleLength,proto3" json:"max_pool_title_length,omitempty"`
+	// the max pool description length in bytes.
+	MaxPoolDescriptionLength *wrappers.Int64Value `protobuf:"bytes,38,opt,name=max_pool_description_length,json=maxPoolDescriptionLength,proto3" json:"max_pool_description_length,omitempty"`
+	// the max number of users and tags, combined, that a user may follow
+	MaxFollows *wrappers.Int64Value `protobuf:"bytes,39,opt,name=max_follows,json=maxFollows,proto3" json:"max_follows,omitempty"`
+	// the max size in bytes of a pic streamed by UpsertPicStream, or sent in an upload session
+	MaxUploadStreamSize *wrappers.Int64Value `protobuf:"bytes,40,opt,name=max_upload_stream_size,json=maxUploadStreamSize,proto3" json:"max_upload_stream_size,omitempty"`
+	// the max number of unfinished upload sessions a user may have
+	MaxUploadSessions    *wrappers.Int64Value `protobuf:"bytes,41,opt,name=max_upload_sessions,json=maxUploadSessions,proto3" json:"max_upload_sessions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1930,156 +3992,310 @@ func (m *Configuration) Reset()         { *m = Configuration{} }
 func (m *Configuration) String() string { return proto.CompactTextString(m) }
 func (*Configuration) ProtoMessage()    {}
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{11}
+	return fileDescriptor_962aa63430fd1f4b, []int{23}
 }
 
 func (m *Configuration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Configuration.Unmarshal(m, b)
 }
-func (m *Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Configuration.Marshal(b, m, deterministic)
+func (m *Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configuration.Marshal(b, m, deterministic)
+}
+func (m *Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration.Merge(m, src)
+}
+func (m *Configuration) XXX_Size() int {
+	return xxx_messageInfo_Configuration.Size(m)
+}
+func (m *Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration proto.InternalMessageInfo
+
+func (m *Configuration) GetMinCommentLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MinCommentLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxCommentLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxCommentLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetMinIdentLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MinIdentLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxIdentLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxIdentLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetMinFileNameLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MinFileNameLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxFileNameLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFileNameLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetMinUrlLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MinUrlLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxUrlLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxUrlLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetMinTagLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MinTagLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxTagLength() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxTagLength
+	}
+	return nil
+}
+
+func (m *Configuration) GetAnonymousCapability() *Configuration_CapabilitySet {
+	if m != nil {
+		return m.AnonymousCapability
+	}
+	return nil
+}
+
+func (m *Configuration) GetNewUserCapability() *Configuration_CapabilitySet {
+	if m != nil {
+		return m.NewUserCapability
+	}
+	return nil
+}
+
+func (m *Configuration) GetDefaultFindIndexPics() *wrappers.Int64Value {
+	if m != nil {
+		return m.DefaultFindIndexPics
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxFindIndexPics() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFindIndexPics
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxVideoDuration() *duration.Duration {
+	if m != nil {
+		return m.MaxVideoDuration
+	}
+	return nil
+}
+
+func (m *Configuration) GetEnablePicCommentSelfReply() *wrappers.BoolValue {
+	if m != nil {
+		return m.EnablePicCommentSelfReply
+	}
+	return nil
+}
+
+func (m *Configuration) GetEnablePicCommentSiblingReply() *wrappers.BoolValue {
+	if m != nil {
+		return m.EnablePicCommentSiblingReply
+	}
+	return nil
+}
+
+func (m *Configuration) GetDefaultFindUserEvents() *wrappers.Int64Value {
+	if m != nil {
+		return m.DefaultFindUserEvents
+	}
+	return nil
 }
-func (m *Configuration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Configuration.Merge(m, src)
+
+func (m *Configuration) GetMaxFindUserEvents() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFindUserEvents
+	}
+	return nil
 }
-func (m *Configuration) XXX_Size() int {
-	return xxx_messageInfo_Configuration.Size(m)
+
+func (m *Configuration) GetUploadSessionExpiry() *duration.Duration {
+	if m != nil {
+		return m.UploadSessionExpiry
+	}
+	return nil
 }
-func (m *Configuration) XXX_DiscardUnknown() {
-	xxx_messageInfo_Configuration.DiscardUnknown(m)
+
+func (m *Configuration) GetMaxRemoteFetchRedirects() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxRemoteFetchRedirects
+	}
+	return nil
 }
 
-var xxx_messageInfo_Configuration proto.InternalMessageInfo
+func (m *Configuration) GetMaxRemoteFetchSize() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxRemoteFetchSize
+	}
+	return nil
+}
 
-func (m *Configuration) GetMinCommentLength() *wrappers.Int64Value {
+func (m *Configuration) GetRemoteFetchTimeout() *duration.Duration {
 	if m != nil {
-		return m.MinCommentLength
+		return m.RemoteFetchTimeout
 	}
 	return nil
 }
 
-func (m *Configuration) GetMaxCommentLength() *wrappers.Int64Value {
+func (m *Configuration) GetRemoteFetchContentType() *Configuration_StringSet {
 	if m != nil {
-		return m.MaxCommentLength
+		return m.RemoteFetchContentType
 	}
 	return nil
 }
 
-func (m *Configuration) GetMinIdentLength() *wrappers.Int64Value {
+func (m *Configuration) GetPasswordResetExpiry() *duration.Duration {
 	if m != nil {
-		return m.MinIdentLength
+		return m.PasswordResetExpiry
 	}
 	return nil
 }
 
-func (m *Configuration) GetMaxIdentLength() *wrappers.Int64Value {
+func (m *Configuration) GetTotpRequiredCapability() *Configuration_CapabilitySet {
 	if m != nil {
-		return m.MaxIdentLength
+		return m.TotpRequiredCapability
 	}
 	return nil
 }
 
-func (m *Configuration) GetMinFileNameLength() *wrappers.Int64Value {
+func (m *Configuration) GetRole() *Configuration_RoleSet {
 	if m != nil {
-		return m.MinFileNameLength
+		return m.Role
 	}
 	return nil
 }
 
-func (m *Configuration) GetMaxFileNameLength() *wrappers.Int64Value {
+func (m *Configuration) GetRateLimit() *Configuration_RateLimitSet {
 	if m != nil {
-		return m.MaxFileNameLength
+		return m.RateLimit
 	}
 	return nil
 }
 
-func (m *Configuration) GetMinUrlLength() *wrappers.Int64Value {
+func (m *Configuration) GetPasswordHashPolicy() *Configuration_PasswordHashPolicy {
 	if m != nil {
-		return m.MinUrlLength
+		return m.PasswordHashPolicy
 	}
 	return nil
 }
 
-func (m *Configuration) GetMaxUrlLength() *wrappers.Int64Value {
+func (m *Configuration) GetDefaultFindAuditLogs() *wrappers.Int64Value {
 	if m != nil {
-		return m.MaxUrlLength
+		return m.DefaultFindAuditLogs
 	}
 	return nil
 }
 
-func (m *Configuration) GetMinTagLength() *wrappers.Int64Value {
+func (m *Configuration) GetMaxFindAuditLogs() *wrappers.Int64Value {
 	if m != nil {
-		return m.MinTagLength
+		return m.MaxFindAuditLogs
 	}
 	return nil
 }
 
-func (m *Configuration) GetMaxTagLength() *wrappers.Int64Value {
+func (m *Configuration) GetPicCommentEditWindow() *duration.Duration {
 	if m != nil {
-		return m.MaxTagLength
+		return m.PicCommentEditWindow
 	}
 	return nil
 }
 
-func (m *Configuration) GetAnonymousCapability() *Configuration_CapabilitySet {
+func (m *Configuration) GetMaxCollections() *wrappers.Int64Value {
 	if m != nil {
-		return m.AnonymousCapability
+		return m.MaxCollections
 	}
 	return nil
 }
 
-func (m *Configuration) GetNewUserCapability() *Configuration_CapabilitySet {
+func (m *Configuration) GetMaxCollectionPics() *wrappers.Int64Value {
 	if m != nil {
-		return m.NewUserCapability
+		return m.MaxCollectionPics
 	}
 	return nil
 }
 
-func (m *Configuration) GetDefaultFindIndexPics() *wrappers.Int64Value {
+func (m *Configuration) GetMaxCollectionNameLength() *wrappers.Int64Value {
 	if m != nil {
-		return m.DefaultFindIndexPics
+		return m.MaxCollectionNameLength
 	}
 	return nil
 }
 
-func (m *Configuration) GetMaxFindIndexPics() *wrappers.Int64Value {
+func (m *Configuration) GetMaxPoolPics() *wrappers.Int64Value {
 	if m != nil {
-		return m.MaxFindIndexPics
+		return m.MaxPoolPics
 	}
 	return nil
 }
 
-func (m *Configuration) GetMaxVideoDuration() *duration.Duration {
+func (m *Configuration) GetMaxPoolTitleLength() *wrappers.Int64Value {
 	if m != nil {
-		return m.MaxVideoDuration
+		return m.MaxPoolTitleLength
 	}
 	return nil
 }
 
-func (m *Configuration) GetEnablePicCommentSelfReply() *wrappers.BoolValue {
+func (m *Configuration) GetMaxPoolDescriptionLength() *wrappers.Int64Value {
 	if m != nil {
-		return m.EnablePicCommentSelfReply
+		return m.MaxPoolDescriptionLength
 	}
 	return nil
 }
 
-func (m *Configuration) GetEnablePicCommentSiblingReply() *wrappers.BoolValue {
+func (m *Configuration) GetMaxFollows() *wrappers.Int64Value {
 	if m != nil {
-		return m.EnablePicCommentSiblingReply
+		return m.MaxFollows
 	}
 	return nil
 }
 
-func (m *Configuration) GetDefaultFindUserEvents() *wrappers.Int64Value {
+func (m *Configuration) GetMaxUploadStreamSize() *wrappers.Int64Value {
 	if m != nil {
-		return m.DefaultFindUserEvents
+		return m.MaxUploadStreamSize
 	}
 	return nil
 }
 
-func (m *Configuration) GetMaxFindUserEvents() *wrappers.Int64Value {
+func (m *Configuration) GetMaxUploadSessions() *wrappers.Int64Value {
 	if m != nil {
-		return m.MaxFindUserEvents
+		return m.MaxUploadSessions
 	}
 	return nil
 }
@@ -2095,7 +4311,7 @@ func (m *Configuration_CapabilitySet) Reset()         { *m = Configuration_Capab
 func (m *Configuration_CapabilitySet) String() string { return proto.CompactTextString(m) }
 func (*Configuration_CapabilitySet) ProtoMessage()    {}
 func (*Configuration_CapabilitySet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{11, 0}
+	return fileDescriptor_962aa63430fd1f4b, []int{23, 0}
 }
 
 func (m *Configuration_CapabilitySet) XXX_Unmarshal(b []byte) error {
@@ -2123,6 +4339,249 @@ func (m *Configuration_CapabilitySet) GetCapability() []User_Capability {
 	return nil
 }
 
+type Configuration_RoleSet struct {
+	// the capabilities of each role, by name.
+	Role                 map[string]*Configuration_CapabilitySet `protobuf:"bytes,1,rep,name=role,proto3" json:"role,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *Configuration_RoleSet) Reset()         { *m = Configuration_RoleSet{} }
+func (m *Configuration_RoleSet) String() string { return proto.CompactTextString(m) }
+func (*Configuration_RoleSet) ProtoMessage()    {}
+func (*Configuration_RoleSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{23, 1}
+}
+
+func (m *Configuration_RoleSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Configuration_RoleSet.Unmarshal(m, b)
+}
+func (m *Configuration_RoleSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configuration_RoleSet.Marshal(b, m, deterministic)
+}
+func (m *Configuration_RoleSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration_RoleSet.Merge(m, src)
+}
+func (m *Configuration_RoleSet) XXX_Size() int {
+	return xxx_messageInfo_Configuration_RoleSet.Size(m)
+}
+func (m *Configuration_RoleSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration_RoleSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration_RoleSet proto.InternalMessageInfo
+
+func (m *Configuration_RoleSet) GetRole() map[string]*Configuration_CapabilitySet {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type Configuration_StringSet struct {
+	Value                []string `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Configuration_StringSet) Reset()         { *m = Configuration_StringSet{} }
+func (m *Configuration_StringSet) String() string { return proto.CompactTextString(m) }
+func (*Configuration_StringSet) ProtoMessage()    {}
+func (*Configuration_StringSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{23, 2}
+}
+
+func (m *Configuration_StringSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Configuration_StringSet.Unmarshal(m, b)
+}
+func (m *Configuration_StringSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configuration_StringSet.Marshal(b, m, deterministic)
+}
+func (m *Configuration_StringSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration_StringSet.Merge(m, src)
+}
+func (m *Configuration_StringSet) XXX_Size() int {
+	return xxx_messageInfo_Configuration_StringSet.Size(m)
+}
+func (m *Configuration_StringSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration_StringSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration_StringSet proto.InternalMessageInfo
+
+func (m *Configuration_StringSet) GetValue() []string {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type Configuration_RateLimit struct {
+	// how many calls may be made at once, before being limited.
+	Burst int64 `protobuf:"varint,1,opt,name=burst,proto3" json:"burst,omitempty"`
+	// how long it takes to earn back one call.
+	Interval             *duration.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Configuration_RateLimit) Reset()         { *m = Configuration_RateLimit{} }
+func (m *Configuration_RateLimit) String() string { return proto.CompactTextString(m) }
+func (*Configuration_RateLimit) ProtoMessage()    {}
+func (*Configuration_RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{23, 3}
+}
+
+func (m *Configuration_RateLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Configuration_RateLimit.Unmarshal(m, b)
+}
+func (m *Configuration_RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configuration_RateLimit.Marshal(b, m, deterministic)
+}
+func (m *Configuration_RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration_RateLimit.Merge(m, src)
+}
+func (m *Configuration_RateLimit) XXX_Size() int {
+	return xxx_messageInfo_Configuration_RateLimit.Size(m)
+}
+func (m *Configuration_RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration_RateLimit proto.InternalMessageInfo
+
+func (m *Configuration_RateLimit) GetBurst() int64 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+func (m *Configuration_RateLimit) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+type Configuration_RateLimitSet struct {
+	// the limit of each rpc, by method name (e.g. "AddPicComment").
+	RateLimit            map[string]*Configuration_RateLimit `protobuf:"bytes,1,rep,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *Configuration_RateLimitSet) Reset()         { *m = Configuration_RateLimitSet{} }
+func (m *Configuration_RateLimitSet) String() string { return proto.CompactTextString(m) }
+func (*Configuration_RateLimitSet) ProtoMessage()    {}
+func (*Configuration_RateLimitSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{23, 4}
+}
+
+func (m *Configuration_RateLimitSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Configuration_RateLimitSet.Unmarshal(m, b)
+}
+func (m *Configuration_RateLimitSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configuration_RateLimitSet.Marshal(b, m, deterministic)
+}
+func (m *Configuration_RateLimitSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration_RateLimitSet.Merge(m, src)
+}
+func (m *Configuration_RateLimitSet) XXX_Size() int {
+	return xxx_messageInfo_Configuration_RateLimitSet.Size(m)
+}
+func (m *Configuration_RateLimitSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration_RateLimitSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration_RateLimitSet proto.InternalMessageInfo
+
+func (m *Configuration_RateLimitSet) GetRateLimit() map[string]*Configuration_RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+type Configuration_PasswordHashPolicy struct {
+	Algorithm Configuration_PasswordHashPolicy_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=pixur.be.schema.Configuration_PasswordHashPolicy_Algorithm" json:"algorithm,omitempty"`
+	// the cost of bcrypt hashes.
+	BcryptCost int64 `protobuf:"varint,2,opt,name=bcrypt_cost,json=bcryptCost,proto3" json:"bcrypt_cost,omitempty"`
+	// the number of passes of argon2id hashes.
+	Argon2Time int64 `protobuf:"varint,3,opt,name=argon2_time,json=argon2Time,proto3" json:"argon2_time,omitempty"`
+	// the memory in KiB of argon2id hashes.
+	Argon2Memory int64 `protobuf:"varint,4,opt,name=argon2_memory,json=argon2Memory,proto3" json:"argon2_memory,omitempty"`
+	// the parallelism of argon2id hashes.
+	Argon2Threads        int64    `protobuf:"varint,5,opt,name=argon2_threads,json=argon2Threads,proto3" json:"argon2_threads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Configuration_PasswordHashPolicy) Reset()         { *m = Configuration_PasswordHashPolicy{} }
+func (m *Configuration_PasswordHashPolicy) String() string { return proto.CompactTextString(m) }
+func (*Configuration_PasswordHashPolicy) ProtoMessage()    {}
+func (*Configuration_PasswordHashPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{23, 5}
+}
+
+func (m *Configuration_PasswordHashPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Configuration_PasswordHashPolicy.Unmarshal(m, b)
+}
+func (m *Configuration_PasswordHashPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configuration_PasswordHashPolicy.Marshal(b, m, deterministic)
+}
+func (m *Configuration_PasswordHashPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration_PasswordHashPolicy.Merge(m, src)
+}
+func (m *Configuration_PasswordHashPolicy) XXX_Size() int {
+	return xxx_messageInfo_Configuration_PasswordHashPolicy.Size(m)
+}
+func (m *Configuration_PasswordHashPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration_PasswordHashPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration_PasswordHashPolicy proto.InternalMessageInfo
+
+func (m *Configuration_PasswordHashPolicy) GetAlgorithm() Configuration_PasswordHashPolicy_Algorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return Configuration_PasswordHashPolicy_UNKNOWN
+}
+
+func (m *Configuration_PasswordHashPolicy) GetBcryptCost() int64 {
+	if m != nil {
+		return m.BcryptCost
+	}
+	return 0
+}
+
+func (m *Configuration_PasswordHashPolicy) GetArgon2Time() int64 {
+	if m != nil {
+		return m.Argon2Time
+	}
+	return 0
+}
+
+func (m *Configuration_PasswordHashPolicy) GetArgon2Memory() int64 {
+	if m != nil {
+		return m.Argon2Memory
+	}
+	return 0
+}
+
+func (m *Configuration_PasswordHashPolicy) GetArgon2Threads() int64 {
+	if m != nil {
+		return m.Argon2Threads
+	}
+	return 0
+}
+
 // CustomData is a free form message that can be used for experimental data.  It contains a 5-tuple
 // of long keys which are indexed as a prefix.  The keys must be unique.
 type CustomData struct {
@@ -2145,7 +4604,7 @@ func (m *CustomData) Reset()         { *m = CustomData{} }
 func (m *CustomData) String() string { return proto.CompactTextString(m) }
 func (*CustomData) ProtoMessage()    {}
 func (*CustomData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{12}
+	return fileDescriptor_962aa63430fd1f4b, []int{24}
 }
 
 func (m *CustomData) XXX_Unmarshal(b []byte) error {
@@ -2229,18 +4688,130 @@ func (m *CustomData) GetData() *any.Any {
 	return nil
 }
 
+// UploadSession is a pic being uploaded in multiple parts.  The data is
+// staged in a file under the pix path until the session is finished.
+type UploadSession struct {
+	UploadSessionId int64 `protobuf:"varint,1,opt,name=upload_session_id,json=uploadSessionId,proto3" json:"upload_session_id,omitempty"`
+	// The user who started the session.  Only they may modify it.
+	UserId    int64                `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedTs *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// Updated on every append.  Sessions expire relative to this time.
+	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,4,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	// The upsert parameters, used when the session is finished.
+	Url                  string              `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	Referrer             string              `protobuf:"bytes,6,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Name                 string              `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Md5Hash              []byte              `protobuf:"bytes,8,opt,name=md5_hash,json=md5Hash,proto3" json:"md5_hash,omitempty"`
+	Ext                  map[string]*any.Any `protobuf:"bytes,9,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UploadSession) Reset()         { *m = UploadSession{} }
+func (m *UploadSession) String() string { return proto.CompactTextString(m) }
+func (*UploadSession) ProtoMessage()    {}
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{25}
+}
+
+func (m *UploadSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadSession.Unmarshal(m, b)
+}
+func (m *UploadSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadSession.Marshal(b, m, deterministic)
+}
+func (m *UploadSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadSession.Merge(m, src)
+}
+func (m *UploadSession) XXX_Size() int {
+	return xxx_messageInfo_UploadSession.Size(m)
+}
+func (m *UploadSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadSession proto.InternalMessageInfo
+
+func (m *UploadSession) GetUploadSessionId() int64 {
+	if m != nil {
+		return m.UploadSessionId
+	}
+	return 0
+}
+
+func (m *UploadSession) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *UploadSession) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *UploadSession) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+func (m *UploadSession) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *UploadSession) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *UploadSession) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UploadSession) GetMd5Hash() []byte {
+	if m != nil {
+		return m.Md5Hash
+	}
+	return nil
+}
+
+func (m *UploadSession) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pixur.be.schema.Pic_DeletionStatus_Reason", Pic_DeletionStatus_Reason_name, Pic_DeletionStatus_Reason_value)
 	proto.RegisterEnum("pixur.be.schema.Pic_File_Mime", Pic_File_Mime_name, Pic_File_Mime_value)
 	proto.RegisterEnum("pixur.be.schema.PicIdent_Type", PicIdent_Type_name, PicIdent_Type_value)
 	proto.RegisterEnum("pixur.be.schema.PicVote_Vote", PicVote_Vote_name, PicVote_Vote_value)
 	proto.RegisterEnum("pixur.be.schema.PicCommentVote_Vote", PicCommentVote_Vote_name, PicCommentVote_Vote_value)
+	proto.RegisterEnum("pixur.be.schema.Collection_Visibility", Collection_Visibility_name, Collection_Visibility_value)
 	proto.RegisterEnum("pixur.be.schema.User_Capability", User_Capability_name, User_Capability_value)
+	proto.RegisterEnum("pixur.be.schema.AuditLog_Action", AuditLog_Action_name, AuditLog_Action_value)
+	proto.RegisterEnum("pixur.be.schema.Configuration_PasswordHashPolicy_Algorithm", Configuration_PasswordHashPolicy_Algorithm_name, Configuration_PasswordHashPolicy_Algorithm_value)
 	proto.RegisterType((*Pic)(nil), "pixur.be.schema.Pic")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.Pic.ExtEntry")
 	proto.RegisterType((*Pic_DeletionStatus)(nil), "pixur.be.schema.Pic.DeletionStatus")
 	proto.RegisterType((*Pic_FileSource)(nil), "pixur.be.schema.Pic.FileSource")
 	proto.RegisterType((*Pic_File)(nil), "pixur.be.schema.Pic.File")
+	proto.RegisterType((*Pic_Placeholder)(nil), "pixur.be.schema.Pic.Placeholder")
 	proto.RegisterType((*PicIdent)(nil), "pixur.be.schema.PicIdent")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.PicIdent.ExtEntry")
 	proto.RegisterType((*AnimationInfo)(nil), "pixur.be.schema.AnimationInfo")
@@ -2250,185 +4821,372 @@ func init() {
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.PicTag.ExtEntry")
 	proto.RegisterType((*PicComment)(nil), "pixur.be.schema.PicComment")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.PicComment.ExtEntry")
+	proto.RegisterType((*PicComment_Revision)(nil), "pixur.be.schema.PicComment.Revision")
 	proto.RegisterType((*PicVote)(nil), "pixur.be.schema.PicVote")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.PicVote.ExtEntry")
 	proto.RegisterType((*PicCommentVote)(nil), "pixur.be.schema.PicCommentVote")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.PicCommentVote.ExtEntry")
+	proto.RegisterType((*PicFavorite)(nil), "pixur.be.schema.PicFavorite")
+	proto.RegisterType((*Collection)(nil), "pixur.be.schema.Collection")
+	proto.RegisterType((*CollectionPic)(nil), "pixur.be.schema.CollectionPic")
+	proto.RegisterType((*Pool)(nil), "pixur.be.schema.Pool")
+	proto.RegisterType((*PoolPic)(nil), "pixur.be.schema.PoolPic")
+	proto.RegisterType((*UserFollow)(nil), "pixur.be.schema.UserFollow")
+	proto.RegisterType((*TagFollow)(nil), "pixur.be.schema.TagFollow")
 	proto.RegisterType((*UserEvent)(nil), "pixur.be.schema.UserEvent")
 	proto.RegisterType((*UserEvent_OutgoingUpsertPicVote)(nil), "pixur.be.schema.UserEvent.OutgoingUpsertPicVote")
 	proto.RegisterType((*UserEvent_IncomingUpsertPicVote)(nil), "pixur.be.schema.UserEvent.IncomingUpsertPicVote")
 	proto.RegisterType((*UserEvent_OutgoingPicComment)(nil), "pixur.be.schema.UserEvent.OutgoingPicComment")
 	proto.RegisterType((*UserEvent_IncomingPicComment)(nil), "pixur.be.schema.UserEvent.IncomingPicComment")
 	proto.RegisterType((*UserEvent_UpsertPic)(nil), "pixur.be.schema.UserEvent.UpsertPic")
+	proto.RegisterType((*UserEvent_LoginLockout)(nil), "pixur.be.schema.UserEvent.LoginLockout")
+	proto.RegisterType((*UserEvent_Suspend)(nil), "pixur.be.schema.UserEvent.Suspend")
+	proto.RegisterType((*UserEvent_Unsuspend)(nil), "pixur.be.schema.UserEvent.Unsuspend")
+	proto.RegisterType((*UserEvent_IncomingFollow)(nil), "pixur.be.schema.UserEvent.IncomingFollow")
 	proto.RegisterType((*User)(nil), "pixur.be.schema.User")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.User.ExtEntry")
+	proto.RegisterType((*User_PasswordReset)(nil), "pixur.be.schema.User.PasswordReset")
+	proto.RegisterType((*User_Invitation)(nil), "pixur.be.schema.User.Invitation")
+	proto.RegisterType((*User_Totp)(nil), "pixur.be.schema.User.Totp")
+	proto.RegisterType((*User_Suspension)(nil), "pixur.be.schema.User.Suspension")
+	proto.RegisterType((*User_Profile)(nil), "pixur.be.schema.User.Profile")
+	proto.RegisterType((*InviteCode)(nil), "pixur.be.schema.InviteCode")
+	proto.RegisterType((*ApiKey)(nil), "pixur.be.schema.ApiKey")
+	proto.RegisterType((*LoginFailure)(nil), "pixur.be.schema.LoginFailure")
+	proto.RegisterType((*AuditLog)(nil), "pixur.be.schema.AuditLog")
+	proto.RegisterType((*AuditLog_Change)(nil), "pixur.be.schema.AuditLog.Change")
+	proto.RegisterType((*UserLink)(nil), "pixur.be.schema.UserLink")
 	proto.RegisterType((*UserToken)(nil), "pixur.be.schema.UserToken")
 	proto.RegisterType((*Configuration)(nil), "pixur.be.schema.Configuration")
 	proto.RegisterType((*Configuration_CapabilitySet)(nil), "pixur.be.schema.Configuration.CapabilitySet")
+	proto.RegisterType((*Configuration_RoleSet)(nil), "pixur.be.schema.Configuration.RoleSet")
+	proto.RegisterMapType((map[string]*Configuration_CapabilitySet)(nil), "pixur.be.schema.Configuration.RoleSet.RoleEntry")
+	proto.RegisterType((*Configuration_StringSet)(nil), "pixur.be.schema.Configuration.StringSet")
+	proto.RegisterType((*Configuration_RateLimit)(nil), "pixur.be.schema.Configuration.RateLimit")
+	proto.RegisterType((*Configuration_RateLimitSet)(nil), "pixur.be.schema.Configuration.RateLimitSet")
+	proto.RegisterMapType((map[string]*Configuration_RateLimit)(nil), "pixur.be.schema.Configuration.RateLimitSet.RateLimitEntry")
+	proto.RegisterType((*Configuration_PasswordHashPolicy)(nil), "pixur.be.schema.Configuration.PasswordHashPolicy")
 	proto.RegisterType((*CustomData)(nil), "pixur.be.schema.CustomData")
+	proto.RegisterType((*UploadSession)(nil), "pixur.be.schema.UploadSession")
+	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.UploadSession.ExtEntry")
 }
 
 func init() { proto.RegisterFile("pixur.proto", fileDescriptor_962aa63430fd1f4b) }
 
 var fileDescriptor_962aa63430fd1f4b = []byte{
-	// 2541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x73, 0xe2, 0xc8,
-	0x15, 0x1f, 0x90, 0x00, 0xf1, 0x30, 0x58, 0x6e, 0xff, 0x93, 0x99, 0xf1, 0x8c, 0x97, 0x4d, 0x52,
-	0xae, 0xa9, 0x2c, 0x9e, 0x61, 0xc6, 0xb3, 0x9b, 0x4d, 0x0e, 0xc1, 0x20, 0xdb, 0x38, 0x18, 0x13,
-	0x21, 0x3c, 0x9b, 0xd4, 0x56, 0xa9, 0x64, 0x68, 0xb0, 0x62, 0x24, 0x51, 0x92, 0xb0, 0x21, 0xdf,
-	0x23, 0x95, 0x43, 0x0e, 0xa9, 0xca, 0x3d, 0x87, 0xdc, 0xf3, 0x01, 0x72, 0xcb, 0x21, 0xe7, 0xdc,
-	0xb2, 0x5f, 0x22, 0xa7, 0x54, 0xb7, 0x24, 0x90, 0xf8, 0x63, 0xec, 0x9d, 0x4c, 0x26, 0x17, 0x4a,
-	0xfd, 0xfa, 0xbd, 0xdf, 0x7b, 0xfd, 0x7e, 0xdd, 0xef, 0xb5, 0x04, 0xa4, 0xfa, 0xda, 0x70, 0x60,
-	0xe5, 0xfb, 0x96, 0xe9, 0x98, 0x68, 0xd5, 0x1d, 0x5c, 0xe1, 0xbc, 0xdd, 0xba, 0xc6, 0xba, 0x9a,
-	0xdd, 0xe9, 0x9a, 0x66, 0xb7, 0x87, 0x0f, 0xe8, 0xf4, 0xd5, 0xa0, 0x73, 0xa0, 0x1a, 0x23, 0x57,
-	0x37, 0xfb, 0x7c, 0x7a, 0xaa, 0x3d, 0xb0, 0x54, 0x47, 0x33, 0x0d, 0x6f, 0xfe, 0xc5, 0xf4, 0xbc,
-	0xa3, 0xe9, 0xd8, 0x76, 0x54, 0xbd, 0xbf, 0x08, 0xe0, 0xce, 0x52, 0xfb, 0x7d, 0x6c, 0xd9, 0xee,
-	0x7c, 0xee, 0xf7, 0x69, 0x60, 0xea, 0x5a, 0x0b, 0x6d, 0x42, 0xbc, 0xaf, 0xb5, 0x14, 0xad, 0x2d,
-	0x44, 0xf6, 0x22, 0xfb, 0x8c, 0x14, 0xeb, 0x6b, 0xad, 0x4a, 0x1b, 0x7d, 0x01, 0x6c, 0x47, 0xeb,
-	0x61, 0x61, 0x6b, 0x2f, 0xb2, 0x9f, 0x2a, 0xec, 0xe4, 0xa7, 0x42, 0xcf, 0xd7, 0xb5, 0x56, 0xfe,
-	0x58, 0xeb, 0x61, 0x89, 0xaa, 0xa1, 0x9f, 0x00, 0xb4, 0x2c, 0xac, 0x3a, 0xb8, 0xad, 0x38, 0xb6,
-	0x00, 0xd4, 0x28, 0x9b, 0x77, 0x43, 0xc8, 0xfb, 0x21, 0xe4, 0x65, 0x3f, 0x46, 0x29, 0xe9, 0x69,
-	0xcb, 0x36, 0xfa, 0x29, 0xa4, 0x74, 0xb3, 0xad, 0x75, 0x34, 0xd7, 0x36, 0xb5, 0xd4, 0x16, 0x7c,
-	0x75, 0xd9, 0x46, 0x55, 0x58, 0x6d, 0xe3, 0x1e, 0x26, 0x89, 0x51, 0x6c, 0x47, 0x75, 0x06, 0xb6,
-	0xb0, 0x42, 0x01, 0x3e, 0x9f, 0x1b, 0x71, 0xd9, 0xd3, 0x6d, 0x50, 0x55, 0x29, 0xd3, 0x0e, 0x8d,
-	0xd1, 0x2e, 0xc0, 0xad, 0x86, 0xef, 0x94, 0x96, 0x39, 0x30, 0x1c, 0x21, 0x43, 0xf3, 0x91, 0x24,
-	0x92, 0x12, 0x11, 0xa0, 0x2f, 0x21, 0x6e, 0x9b, 0x03, 0xab, 0x85, 0x85, 0xd5, 0x3d, 0x66, 0x3f,
-	0x55, 0x78, 0xb1, 0x30, 0x2b, 0x0d, 0xaa, 0x26, 0x79, 0xea, 0x68, 0x1b, 0x12, 0xb7, 0xa6, 0x83,
-	0x95, 0x41, 0x5f, 0x58, 0xa3, 0xa0, 0x71, 0x32, 0x6c, 0xf6, 0xd1, 0x53, 0x48, 0xd2, 0x89, 0xb6,
-	0x79, 0x67, 0x08, 0x88, 0x4e, 0x71, 0x44, 0x50, 0x36, 0xef, 0x0c, 0x74, 0x00, 0x0c, 0x1e, 0x3a,
-	0xc2, 0x3a, 0xf5, 0xb5, 0x3b, 0xd7, 0x97, 0x38, 0x74, 0x44, 0xc3, 0xb1, 0x46, 0x12, 0xd1, 0x44,
-	0x5f, 0x42, 0xd2, 0xb9, 0x1e, 0xe8, 0x57, 0x86, 0xaa, 0xf5, 0x84, 0x4d, 0x6a, 0x76, 0x0f, 0x71,
-	0x13, 0x5d, 0xf4, 0x06, 0x12, 0x6d, 0x6c, 0x69, 0xb7, 0xb8, 0x2d, 0x6c, 0x2f, 0x33, 0xf3, 0x35,
-	0xb3, 0x7f, 0x60, 0x20, 0x13, 0xce, 0x27, 0x3a, 0x86, 0x35, 0x5d, 0xb5, 0x6e, 0x70, 0x5b, 0xa1,
-	0x89, 0x75, 0x09, 0x8d, 0x2c, 0x25, 0x74, 0xd5, 0x35, 0x2a, 0xbb, 0x36, 0xb2, 0x8d, 0x4e, 0x01,
-	0xf5, 0xb1, 0xd1, 0xd6, 0x8c, 0x6e, 0x10, 0x28, 0xba, 0x14, 0x88, 0xf7, 0xac, 0x26, 0x48, 0xc7,
-	0xb0, 0xa6, 0xb6, 0x9c, 0x81, 0xda, 0x0b, 0x02, 0x31, 0xcb, 0x23, 0x72, 0x8d, 0x26, 0x38, 0x02,
-	0xc9, 0x90, 0xa3, 0x6a, 0x3d, 0x5b, 0x60, 0xf7, 0x22, 0xfb, 0x49, 0xc9, 0x1f, 0xa2, 0x23, 0x88,
-	0x5b, 0x58, 0xb5, 0x4d, 0x43, 0x88, 0xed, 0x45, 0xf6, 0x33, 0x85, 0x97, 0x0f, 0xd8, 0x78, 0x79,
-	0x89, 0x5a, 0x48, 0x9e, 0x25, 0x7a, 0x06, 0x49, 0x07, 0xeb, 0x7d, 0xd3, 0x52, 0xad, 0x91, 0x10,
-	0xdf, 0x8b, 0xec, 0x73, 0xd2, 0x44, 0x90, 0x7b, 0x03, 0x71, 0x57, 0x1f, 0xa5, 0x20, 0xd1, 0xac,
-	0xfd, 0xa2, 0x76, 0xf1, 0xbe, 0xc6, 0x3f, 0x41, 0x1c, 0xb0, 0xb5, 0x8b, 0x9a, 0xc8, 0x47, 0x10,
-	0x82, 0x8c, 0xd4, 0xac, 0x8a, 0xca, 0x65, 0xe5, 0xa2, 0x5a, 0x94, 0x2b, 0x17, 0x35, 0x3e, 0x9a,
-	0xfd, 0x53, 0x04, 0x60, 0xb2, 0x13, 0x11, 0x0f, 0xcc, 0xc0, 0xea, 0x51, 0x2e, 0x92, 0x12, 0x79,
-	0x44, 0x59, 0xe0, 0x2c, 0xdc, 0xc1, 0x96, 0x85, 0x2d, 0x9a, 0xd9, 0xa4, 0x34, 0x1e, 0x4f, 0x9d,
-	0x66, 0xe6, 0x31, 0xa7, 0x79, 0x1b, 0x12, 0x03, 0x1b, 0x5b, 0xa4, 0x9e, 0xb0, 0xee, 0x56, 0x27,
-	0xc3, 0x4a, 0x1b, 0x21, 0x60, 0x0d, 0x55, 0xc7, 0x34, 0x4b, 0x49, 0x89, 0x3e, 0x67, 0xab, 0xc0,
-	0xf9, 0x3b, 0x98, 0x44, 0x78, 0x83, 0x47, 0x7e, 0x84, 0x37, 0x78, 0x84, 0x5e, 0x42, 0xec, 0x56,
-	0xed, 0x0d, 0xb0, 0x47, 0xfc, 0xc6, 0x4c, 0x00, 0x45, 0x63, 0x24, 0xb9, 0x2a, 0x5f, 0x47, 0xbf,
-	0x8a, 0x64, 0x7f, 0xc7, 0x00, 0x4b, 0x96, 0x8c, 0x36, 0x20, 0xa6, 0x19, 0x6d, 0x3c, 0xf4, 0x2b,
-	0x1a, 0x1d, 0x90, 0x00, 0x6c, 0xed, 0xb7, 0x2e, 0x1a, 0x23, 0xd1, 0x67, 0x54, 0x00, 0x56, 0xd7,
-	0x74, 0x4c, 0x97, 0x98, 0x29, 0x3c, 0x5f, 0xb8, 0xeb, 0xf3, 0xe7, 0x9a, 0x8e, 0x25, 0xaa, 0x4b,
-	0xd0, 0xef, 0xb4, 0xb6, 0x73, 0xed, 0xad, 0xcf, 0x1d, 0xa0, 0x2d, 0x88, 0x5f, 0x63, 0xad, 0x7b,
-	0xed, 0xd0, 0x05, 0x32, 0x92, 0x37, 0x9a, 0x4a, 0x65, 0xfc, 0x03, 0x0a, 0x63, 0xe2, 0x51, 0x85,
-	0x51, 0x84, 0x8c, 0x6a, 0x68, 0x3a, 0x6d, 0x19, 0x8a, 0x66, 0x74, 0x4c, 0x81, 0xa3, 0xf6, 0xb3,
-	0x6b, 0x2c, 0xfa, 0x6a, 0x15, 0xa3, 0x63, 0x4a, 0x69, 0x35, 0x38, 0xcc, 0x1d, 0x01, 0x4b, 0x96,
-	0x3e, 0xb3, 0xf3, 0xce, 0xea, 0xe2, 0x09, 0x1f, 0x41, 0x09, 0x60, 0x4e, 0x2a, 0xc7, 0x7c, 0x94,
-	0x3c, 0xd4, 0x6b, 0x27, 0x3c, 0x43, 0xe6, 0xde, 0x8b, 0x47, 0xe7, 0x3c, 0x4b, 0x44, 0xe7, 0xf5,
-	0xb7, 0x7c, 0xec, 0x8c, 0xe5, 0xa2, 0x3c, 0x73, 0xc6, 0x72, 0x0c, 0xcf, 0x9e, 0xb1, 0x1c, 0x4b,
-	0x25, 0x31, 0x3e, 0x7e, 0xc6, 0x72, 0x49, 0x1e, 0xce, 0x58, 0x2e, 0xcd, 0x67, 0xce, 0x58, 0x8e,
-	0xe7, 0xd7, 0xce, 0x58, 0x6e, 0x83, 0xdf, 0xcc, 0x7d, 0x17, 0x05, 0xae, 0x4e, 0x9a, 0x10, 0x36,
-	0x9c, 0x45, 0xed, 0xa9, 0x00, 0xac, 0x33, 0xea, 0xbb, 0x64, 0x2e, 0x20, 0x8e, 0xda, 0xe7, 0xe5,
-	0x51, 0x1f, 0x4b, 0x54, 0x97, 0x10, 0xe7, 0xee, 0x27, 0xc2, 0xf6, 0x8a, 0xb7, 0x73, 0xd0, 0xe7,
-	0x90, 0x6a, 0xb7, 0x9c, 0x57, 0x0a, 0x1d, 0x91, 0xd3, 0xcd, 0xec, 0x47, 0x8f, 0xa2, 0x7c, 0x44,
-	0x02, 0x22, 0xbe, 0xa4, 0x52, 0xf4, 0xd6, 0x2d, 0xc5, 0x31, 0x5a, 0x1c, 0x73, 0x8b, 0xbd, 0x85,
-	0xea, 0xf1, 0x7f, 0x77, 0x7b, 0xe7, 0x2e, 0x80, 0x25, 0x8b, 0x99, 0xa1, 0xa2, 0x71, 0x5a, 0x7c,
-	0xed, 0x32, 0x70, 0x5e, 0x3e, 0xe4, 0x19, 0x94, 0x84, 0x58, 0xb9, 0x24, 0x2b, 0xaf, 0x78, 0x16,
-	0x65, 0x00, 0x1a, 0xa7, 0xc5, 0xc3, 0xd7, 0x05, 0xa5, 0x70, 0xf8, 0x8e, 0x8f, 0xe5, 0x58, 0x2e,
-	0xc2, 0x47, 0x5e, 0xc6, 0x1b, 0xa7, 0xc5, 0xc2, 0xe1, 0xbb, 0xdc, 0x31, 0xa4, 0x43, 0xdc, 0xa3,
-	0x43, 0xe0, 0xfc, 0x5b, 0x86, 0x57, 0xb5, 0x77, 0x66, 0x82, 0x2a, 0x7b, 0x0a, 0xd2, 0x58, 0x35,
-	0xf7, 0xb7, 0x28, 0x30, 0xb2, 0xda, 0x25, 0x54, 0x39, 0x6a, 0x37, 0x40, 0x95, 0xa3, 0x76, 0x03,
-	0x07, 0x3f, 0x3a, 0x39, 0xf8, 0xe8, 0x05, 0xa4, 0x06, 0xb6, 0xda, 0xc5, 0x5e, 0xa7, 0x65, 0xa8,
-	0x3e, 0x50, 0x91, 0xdb, 0x6a, 0x3f, 0xd5, 0xb1, 0xf1, 0x7a, 0x2e, 0xb7, 0xa0, 0xe7, 0xca, 0x6a,
-	0xf7, 0xa3, 0x72, 0xfc, 0xcf, 0x28, 0xc4, 0xeb, 0x5a, 0xcb, 0xcb, 0xe6, 0xbc, 0x8d, 0x3f, 0x49,
-	0x72, 0x74, 0x5e, 0x92, 0x99, 0x40, 0x92, 0x03, 0xa5, 0x98, 0x0b, 0x95, 0xe2, 0x4f, 0x95, 0xdc,
-	0x82, 0x9b, 0xdc, 0x24, 0x4d, 0xee, 0xde, 0xbc, 0x53, 0xf4, 0xb1, 0xf3, 0xfb, 0x77, 0x06, 0xa0,
-	0xae, 0xb5, 0x4a, 0xa6, 0xae, 0xdf, 0x53, 0x5c, 0x76, 0x01, 0x5a, 0xae, 0xc6, 0x24, 0xcf, 0x49,
-	0x4f, 0x52, 0x69, 0xa3, 0x97, 0xb0, 0xe6, 0x4f, 0xf7, 0x55, 0xcb, 0xd3, 0x72, 0xb7, 0xf0, 0xaa,
-	0x37, 0x51, 0xa7, 0xf2, 0x4a, 0xfb, 0xde, 0x76, 0xe8, 0x90, 0x64, 0x24, 0x5c, 0xc2, 0xc8, 0x73,
-	0xf0, 0x9a, 0x98, 0x5c, 0x7c, 0x4d, 0x84, 0xa9, 0x6b, 0x62, 0x98, 0xcd, 0xd8, 0x07, 0xb0, 0x19,
-	0x7f, 0x14, 0x9b, 0xef, 0x82, 0x47, 0xe5, 0x07, 0xf3, 0xd8, 0xf4, 0xd2, 0xfc, 0x51, 0x19, 0xfd,
-	0x0b, 0x03, 0x89, 0xba, 0xd6, 0xba, 0x34, 0x1d, 0xbc, 0x88, 0xce, 0x00, 0x07, 0xd1, 0x10, 0x07,
-	0xe3, 0x7b, 0x42, 0x22, 0x78, 0x4f, 0x78, 0x0d, 0x2c, 0xc9, 0xad, 0x77, 0x27, 0x98, 0x7b, 0xef,
-	0x26, 0xde, 0xf2, 0xe4, 0x47, 0xa2, 0xaa, 0x53, 0x14, 0xb0, 0x1f, 0x40, 0x41, 0xec, 0x51, 0x14,
-	0xbc, 0x71, 0x29, 0x88, 0x53, 0x0a, 0x3e, 0x5b, 0x18, 0xe9, 0xc7, 0xcc, 0x7f, 0x01, 0x58, 0x9a,
-	0xfb, 0x50, 0x57, 0x8a, 0x43, 0xb4, 0x59, 0xe7, 0x23, 0xa4, 0x3b, 0x95, 0x89, 0x24, 0x4a, 0xa6,
-	0x6b, 0x62, 0x53, 0x96, 0x8a, 0x55, 0x9e, 0xc9, 0x7d, 0xc7, 0x40, 0x66, 0xb2, 0x3d, 0xee, 0xa3,
-	0x6e, 0xc9, 0x49, 0x0c, 0x30, 0xcb, 0xcc, 0x67, 0x96, 0x0d, 0x32, 0xfb, 0x95, 0xc7, 0xac, 0x7b,
-	0x51, 0xbf, 0x6f, 0xcb, 0xde, 0x4f, 0xf0, 0xff, 0xae, 0x62, 0x7e, 0x1d, 0x3c, 0x63, 0xfb, 0xcb,
-	0x02, 0xfe, 0x7f, 0xe3, 0xf9, 0x5f, 0x09, 0x48, 0x36, 0x6d, 0x6c, 0x89, 0xb7, 0xa4, 0xd8, 0x06,
-	0xc8, 0x8a, 0xcc, 0x27, 0x2b, 0x1a, 0x24, 0xeb, 0x03, 0xde, 0x41, 0xa6, 0x52, 0xce, 0x3e, 0x2a,
-	0xe5, 0x37, 0x20, 0x98, 0x03, 0xa7, 0x6b, 0x92, 0x97, 0xcf, 0x41, 0xdf, 0xc6, 0x96, 0xa3, 0x90,
-	0x9d, 0x39, 0xde, 0x38, 0xa9, 0xc2, 0xab, 0x19, 0x1e, 0xc6, 0x8b, 0xcc, 0x5f, 0x78, 0xa6, 0x4d,
-	0x6a, 0xe9, 0x1d, 0xc0, 0xd3, 0x27, 0xd2, 0xa6, 0x39, 0x6f, 0x82, 0x38, 0xd3, 0x8c, 0x96, 0xa9,
-	0xcf, 0x73, 0x16, 0x5f, 0xea, 0xac, 0xe2, 0x99, 0xce, 0x38, 0xd3, 0xe6, 0x4d, 0x20, 0x15, 0x36,
-	0xc6, 0x2b, 0x23, 0x5e, 0xbc, 0x73, 0xe4, 0x6d, 0xc9, 0x2f, 0x1e, 0xb0, 0xaa, 0xc9, 0x7e, 0x3b,
-	0x7d, 0x22, 0x21, 0x73, 0x46, 0x4a, 0x5c, 0x8c, 0xd7, 0x13, 0x74, 0xc1, 0x2d, 0x75, 0xe1, 0xaf,
-	0x25, 0xec, 0x42, 0x9b, 0x91, 0x22, 0x11, 0x60, 0x92, 0x29, 0xda, 0x27, 0xe7, 0x75, 0x9f, 0x09,
-	0xf0, 0x38, 0x07, 0xa7, 0x4f, 0xa4, 0xe4, 0xc0, 0x1f, 0x64, 0xf3, 0xb0, 0x39, 0x97, 0xab, 0x05,
-	0x95, 0x28, 0x7b, 0x09, 0x9b, 0x73, 0xd3, 0x8d, 0x7e, 0x04, 0xab, 0xf6, 0xe0, 0xea, 0x37, 0xb8,
-	0xe5, 0x28, 0xe1, 0xed, 0x9d, 0xf6, 0xc4, 0x4d, 0x77, 0x97, 0x4f, 0x70, 0xa3, 0x41, 0xdc, 0x33,
-	0x40, 0xb3, 0xd9, 0x9d, 0xaa, 0x7b, 0x91, 0xe9, 0xba, 0xb7, 0x18, 0x6b, 0x36, 0x8d, 0xdf, 0x13,
-	0x2b, 0x07, 0xc9, 0xf1, 0x3a, 0x17, 0xe4, 0xe4, 0x28, 0x06, 0x0c, 0xbe, 0x75, 0x72, 0xff, 0x4e,
-	0x02, 0x4b, 0x16, 0xb9, 0xf8, 0x84, 0x6f, 0x41, 0xdc, 0xc6, 0x2d, 0x0b, 0x3b, 0xd4, 0xc7, 0x8a,
-	0xe4, 0x8d, 0xe8, 0xc9, 0x27, 0xef, 0x4d, 0xde, 0xb5, 0xd5, 0x1d, 0x7c, 0xb2, 0x6e, 0xfa, 0x33,
-	0x58, 0xe9, 0xa9, 0xb6, 0xa3, 0xd8, 0x18, 0x1b, 0x0f, 0xbc, 0x0e, 0x11, 0xfd, 0x06, 0xc6, 0x86,
-	0x6c, 0xa3, 0x9f, 0x03, 0xb4, 0xd4, 0xbe, 0x7a, 0xa5, 0xf5, 0x34, 0x67, 0x24, 0x24, 0xf6, 0x98,
-	0xfd, 0xcc, 0x9c, 0x3b, 0x2e, 0xc9, 0x53, 0xbe, 0x34, 0xd6, 0x93, 0x02, 0x36, 0x28, 0x07, 0x69,
-	0x03, 0x0f, 0x1d, 0xc5, 0x31, 0x6f, 0xb0, 0x31, 0xb9, 0xb5, 0xa7, 0x88, 0x50, 0x26, 0x32, 0xf7,
-	0xea, 0x4e, 0x53, 0x4c, 0x75, 0xbc, 0x9b, 0x74, 0x76, 0xae, 0x17, 0x6a, 0x21, 0x25, 0x07, 0xfe,
-	0x23, 0x7a, 0xe5, 0xf6, 0x12, 0xa0, 0x36, 0xcf, 0xe7, 0x47, 0xf6, 0x31, 0x3b, 0xc8, 0x3f, 0x62,
-	0x00, 0x93, 0x95, 0x87, 0x1b, 0x49, 0x06, 0xa0, 0x5e, 0x29, 0x29, 0x25, 0x49, 0x2c, 0xca, 0x22,
-	0x1f, 0x41, 0x2b, 0xc0, 0x91, 0xb1, 0x24, 0x16, 0xcb, 0x7c, 0x14, 0xa5, 0x21, 0x49, 0x46, 0x95,
-	0x5a, 0x59, 0xfc, 0x86, 0x67, 0xd0, 0x3a, 0xac, 0x92, 0x61, 0xe3, 0xe2, 0x58, 0x56, 0xca, 0x62,
-	0x55, 0x94, 0x45, 0x3e, 0xe6, 0x0b, 0x4f, 0x8b, 0x52, 0xd9, 0x17, 0xc6, 0x7d, 0xc3, 0x7a, 0x53,
-	0x3a, 0x11, 0xf9, 0x04, 0x7a, 0x0a, 0xdb, 0x64, 0xd8, 0xac, 0x97, 0x8b, 0xb2, 0xa8, 0x5c, 0x56,
-	0xc4, 0xf7, 0x4a, 0xe9, 0xa2, 0x59, 0x93, 0x45, 0x89, 0xe7, 0x10, 0x82, 0x0c, 0x99, 0x94, 0x8b,
-	0x27, 0x7e, 0x18, 0x49, 0xb4, 0x05, 0x88, 0x86, 0x75, 0x71, 0x7e, 0x2e, 0xd6, 0x64, 0x5f, 0x0e,
-	0xbe, 0xb3, 0xcb, 0x0b, 0x59, 0xf4, 0x85, 0x29, 0xb4, 0x0a, 0xa9, 0x66, 0x43, 0x94, 0x7c, 0x01,
-	0x8b, 0xb2, 0xb0, 0x45, 0x05, 0x9e, 0xbf, 0x52, 0xb1, 0x5e, 0x3c, 0xaa, 0x54, 0x2b, 0xf2, 0xaf,
-	0xf8, 0x15, 0xe2, 0x8d, 0xce, 0x91, 0x15, 0x2a, 0x0d, 0xb1, 0x7a, 0xcc, 0xa7, 0xd1, 0x1a, 0xa4,
-	0x27, 0xb2, 0x62, 0xb5, 0xca, 0x67, 0x90, 0x00, 0x1b, 0xc4, 0x91, 0xf8, 0x8d, 0x2c, 0xd6, 0x1a,
-	0x95, 0x8b, 0x9a, 0x0f, 0xbe, 0xea, 0x87, 0x36, 0x99, 0xa1, 0xb9, 0xe2, 0xd1, 0x1e, 0x3c, 0x0b,
-	0x86, 0x3c, 0x63, 0xb9, 0x86, 0x9e, 0x43, 0x76, 0xbe, 0x06, 0x45, 0x40, 0xe8, 0x19, 0x08, 0x7e,
-	0x22, 0x66, 0xac, 0xd7, 0xc9, 0xa2, 0x66, 0x67, 0xa9, 0xe5, 0x06, 0xda, 0x85, 0x9d, 0x71, 0x5a,
-	0x66, 0x4c, 0x37, 0xfd, 0xf4, 0x4f, 0x4d, 0x53, 0xdb, 0x2d, 0xb4, 0x01, 0xfc, 0x64, 0xf1, 0xf5,
-	0xe6, 0x51, 0xb5, 0x52, 0xe2, 0xb7, 0xc3, 0x69, 0xaa, 0x57, 0x4a, 0x0d, 0x5e, 0x40, 0x9b, 0xb0,
-	0x16, 0x92, 0x91, 0x58, 0xf8, 0x1d, 0xb4, 0x03, 0x9b, 0x61, 0xb1, 0xb7, 0x40, 0x3e, 0x4b, 0x72,
-	0x15, 0x9e, 0x22, 0x21, 0xf0, 0x4f, 0xfd, 0x80, 0xfc, 0x4c, 0x04, 0xe9, 0x7c, 0x86, 0x7e, 0x08,
-	0x9f, 0xcd, 0x4c, 0xce, 0x2c, 0x6a, 0x37, 0xf7, 0xc7, 0x88, 0x7b, 0xc7, 0x71, 0xcf, 0xd8, 0x0e,
-	0x70, 0xe3, 0xd3, 0xeb, 0x96, 0xc0, 0x84, 0x33, 0x39, 0xb9, 0x81, 0xaa, 0x16, 0x7d, 0x4c, 0x55,
-	0x9b, 0x2e, 0x4c, 0xcc, 0x63, 0x0a, 0x53, 0xee, 0xaf, 0x2b, 0x90, 0x2e, 0x99, 0x46, 0x47, 0xeb,
-	0x7a, 0x1f, 0x6c, 0x50, 0x05, 0x90, 0xae, 0x19, 0x7e, 0x73, 0x56, 0x7a, 0xd8, 0xe8, 0x3a, 0xd7,
-	0xde, 0x17, 0x9f, 0xa7, 0x33, 0xa8, 0x15, 0xc3, 0x79, 0xf7, 0x96, 0x7e, 0x07, 0x93, 0x78, 0x5d,
-	0x33, 0xbc, 0xb6, 0x52, 0xa5, 0x46, 0x14, 0x4a, 0x1d, 0x4e, 0x43, 0x45, 0x1f, 0x02, 0xa5, 0x0e,
-	0xc3, 0x50, 0x22, 0x10, 0x78, 0x85, 0xf6, 0x00, 0x1f, 0x88, 0x59, 0x0e, 0x94, 0xd1, 0x35, 0x83,
-	0x7e, 0x7c, 0x0b, 0xc0, 0xa8, 0xc3, 0x30, 0x0c, 0xfb, 0x10, 0x18, 0x75, 0x18, 0x84, 0xa9, 0xc2,
-	0x06, 0x89, 0xa6, 0xa3, 0xf5, 0xb0, 0x62, 0xa8, 0x3a, 0xf6, 0xa1, 0x62, 0xcb, 0xa1, 0xd6, 0x74,
-	0xcd, 0x38, 0xd6, 0x7a, 0xb8, 0xa6, 0xea, 0x38, 0x80, 0xa6, 0x0e, 0x67, 0xd1, 0xe2, 0x0f, 0x41,
-	0x53, 0x87, 0x53, 0x68, 0x45, 0x20, 0x8b, 0x56, 0x06, 0x56, 0xcf, 0xc7, 0x49, 0x2c, 0xc7, 0x59,
-	0xd1, 0x35, 0xa3, 0x69, 0xf5, 0x02, 0x10, 0xea, 0x30, 0x08, 0xc1, 0x3d, 0x04, 0x42, 0x1d, 0x86,
-	0x21, 0x34, 0x43, 0x71, 0xd4, 0xae, 0x0f, 0x91, 0x7c, 0x58, 0x14, 0xb2, 0xda, 0x0d, 0x47, 0x11,
-	0x80, 0x80, 0x87, 0x45, 0x31, 0x81, 0x50, 0x60, 0x43, 0x35, 0x4c, 0x63, 0xa4, 0x9b, 0x03, 0x5b,
-	0x09, 0x34, 0x60, 0xf7, 0x6f, 0xc4, 0x1f, 0xcf, 0xb4, 0xb9, 0xd0, 0x49, 0x08, 0x74, 0xe2, 0x06,
-	0x76, 0xa4, 0xf5, 0x31, 0x52, 0xa0, 0x4f, 0x7d, 0x0b, 0xeb, 0x06, 0xbe, 0x73, 0xef, 0x76, 0x01,
-	0xfc, 0x95, 0xef, 0x81, 0xbf, 0x66, 0xe0, 0x3b, 0x52, 0x2b, 0x02, 0xe8, 0x12, 0x6c, 0xb7, 0x71,
-	0x47, 0x1d, 0xf4, 0x1c, 0xa5, 0xa3, 0x19, 0x6d, 0x85, 0xbe, 0xfb, 0x90, 0x9b, 0xad, 0x2d, 0xa4,
-	0x97, 0xa7, 0x62, 0xc3, 0xb3, 0x3d, 0xd6, 0x8c, 0x76, 0x85, 0x58, 0xd6, 0xb5, 0x96, 0x8d, 0xce,
-	0x60, 0xdd, 0xdd, 0x6c, 0x61, 0xbc, 0xcc, 0xc3, 0x0e, 0x65, 0x18, 0xeb, 0xc4, 0x3d, 0xdf, 0xb7,
-	0x5a, 0x1b, 0x9b, 0xca, 0xf8, 0xe3, 0xf0, 0xea, 0xb2, 0x8f, 0xc3, 0x04, 0xe8, 0x92, 0xd8, 0xf8,
-	0x12, 0xf4, 0x2d, 0xec, 0x62, 0x43, 0xbd, 0xea, 0xe1, 0xe0, 0x7b, 0x81, 0x62, 0xe3, 0x5e, 0x47,
-	0xb1, 0x70, 0xbf, 0x37, 0x12, 0xf8, 0x05, 0x45, 0xed, 0xc8, 0x34, 0x7b, 0x6e, 0x74, 0x3b, 0x2e,
-	0xc0, 0xe4, 0x6a, 0xdb, 0xc0, 0xbd, 0x8e, 0x44, 0x8c, 0xd1, 0x15, 0xec, 0xcd, 0x43, 0xd7, 0xae,
-	0x7a, 0xe4, 0x4d, 0xc4, 0x75, 0xb0, 0xb6, 0xd4, 0xc1, 0xb3, 0x19, 0x07, 0x2e, 0x80, 0xeb, 0x43,
-	0x06, 0x21, 0x44, 0x15, 0xdd, 0x11, 0x98, 0xbc, 0x63, 0xd8, 0xf4, 0xaf, 0xdb, 0x25, 0xb9, 0xdd,
-	0x0c, 0x70, 0x35, 0x7e, 0x3b, 0xb1, 0x27, 0x95, 0x61, 0x0a, 0x71, 0xfd, 0xa1, 0x95, 0x21, 0x88,
-	0x96, 0xfd, 0x25, 0xa4, 0x43, 0x5b, 0x6e, 0xea, 0x56, 0x1a, 0x79, 0xfc, 0xad, 0x34, 0xf7, 0xe7,
-	0x28, 0x40, 0x69, 0x60, 0x3b, 0xa6, 0x5e, 0x56, 0x1d, 0x95, 0x74, 0xb8, 0x1b, 0x3c, 0x52, 0xe8,
-	0x9f, 0x2f, 0x5e, 0x87, 0xbb, 0xc1, 0x23, 0xfa, 0xc7, 0x04, 0x02, 0xf6, 0x06, 0x8f, 0x5e, 0xfb,
-	0x7f, 0xb0, 0x91, 0x67, 0x4f, 0x56, 0xf0, 0x3e, 0xcf, 0xd0, 0x67, 0x4f, 0xf6, 0xc6, 0xfb, 0x36,
-	0x43, 0x9f, 0x3d, 0xd9, 0x5b, 0xef, 0xcf, 0x33, 0xfa, 0xec, 0xc9, 0x0e, 0x69, 0x91, 0x74, 0x65,
-	0x87, 0x53, 0x5d, 0x34, 0xf1, 0x01, 0xef, 0x06, 0xdc, 0xa3, 0xde, 0x0d, 0xf6, 0x81, 0x6d, 0xab,
-	0x8e, 0xea, 0x95, 0xb8, 0xf9, 0x77, 0x5d, 0xaa, 0x71, 0xf4, 0xf4, 0xd7, 0x3b, 0x6e, 0x7a, 0x4d,
-	0xab, 0x7b, 0x40, 0x9f, 0x0e, 0xae, 0xf0, 0x81, 0x9b, 0xe8, 0xab, 0x38, 0x35, 0x78, 0xf3, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0xa8, 0x34, 0xe6, 0x17, 0x22, 0x00, 0x00,
+	// 5014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0x4b, 0x6f, 0x23, 0x49,
+	0x72, 0x6e, 0xbe, 0x8b, 0xc1, 0x87, 0x4a, 0xd9, 0x92, 0x9a, 0x62, 0xbf, 0xd4, 0xec, 0x99, 0x59,
+	0x6d, 0x7b, 0x57, 0x3d, 0xad, 0x99, 0x9e, 0x1d, 0xcf, 0xac, 0xd7, 0x4b, 0x91, 0x54, 0x8b, 0x1a,
+	0x8a, 0xe4, 0x96, 0x48, 0xcd, 0x03, 0x0b, 0x14, 0x4a, 0xac, 0x14, 0x55, 0x56, 0xb1, 0x8a, 0xae,
+	0x2a, 0x4a, 0xe2, 0x9e, 0xfd, 0x17, 0x7c, 0x30, 0x7c, 0x30, 0x30, 0x77, 0x1f, 0xd6, 0x30, 0xec,
+	0xbb, 0x0f, 0xb6, 0x2f, 0x86, 0x61, 0x03, 0x06, 0x7c, 0x59, 0x2c, 0x60, 0x63, 0xff, 0x80, 0x7d,
+	0xf2, 0xcd, 0xc8, 0x47, 0xbd, 0xf8, 0x10, 0xa9, 0xe9, 0x99, 0x91, 0x7d, 0xe9, 0xae, 0x8c, 0x8c,
+	0xf8, 0x32, 0x33, 0x22, 0x2a, 0x32, 0x32, 0xb2, 0x28, 0xc8, 0x0c, 0xb5, 0xeb, 0x91, 0xb5, 0x33,
+	0xb4, 0x4c, 0xc7, 0x44, 0x2b, 0xac, 0x71, 0x8a, 0x77, 0xec, 0xde, 0x39, 0x1e, 0x28, 0xc5, 0xcd,
+	0xbe, 0x69, 0xf6, 0x75, 0xfc, 0x92, 0x76, 0x9f, 0x8e, 0xce, 0x5e, 0x2a, 0xc6, 0x98, 0xf1, 0x16,
+	0x9f, 0x4c, 0x76, 0xa9, 0x23, 0x4b, 0x71, 0x34, 0xd3, 0xe0, 0xfd, 0x4f, 0x27, 0xfb, 0x1d, 0x6d,
+	0x80, 0x6d, 0x47, 0x19, 0x0c, 0xe7, 0x01, 0x5c, 0x59, 0xca, 0x70, 0x88, 0x2d, 0x9b, 0xf5, 0x97,
+	0xfe, 0x2d, 0x0f, 0xb1, 0xb6, 0xd6, 0x43, 0xeb, 0x90, 0x1c, 0x6a, 0x3d, 0x59, 0x53, 0x0b, 0x91,
+	0xad, 0xc8, 0x76, 0x4c, 0x4a, 0x0c, 0xb5, 0x5e, 0x5d, 0x45, 0x3f, 0x86, 0xf8, 0x99, 0xa6, 0xe3,
+	0xc2, 0xc6, 0x56, 0x64, 0x3b, 0xb3, 0xbb, 0xb9, 0x33, 0x31, 0xf5, 0x9d, 0xb6, 0xd6, 0xdb, 0xd9,
+	0xd7, 0x74, 0x2c, 0x51, 0x36, 0xf4, 0xfb, 0x00, 0x3d, 0x0b, 0x2b, 0x0e, 0x56, 0x65, 0xc7, 0x2e,
+	0x00, 0x15, 0x2a, 0xee, 0xb0, 0x29, 0xec, 0xb8, 0x53, 0xd8, 0xe9, 0xb8, 0x73, 0x94, 0xd2, 0x9c,
+	0xbb, 0x63, 0xa3, 0x4f, 0x21, 0x33, 0x30, 0x55, 0xed, 0x4c, 0x63, 0xb2, 0x99, 0x85, 0xb2, 0xe0,
+	0xb2, 0x77, 0x6c, 0xd4, 0x80, 0x15, 0x15, 0xeb, 0x98, 0x28, 0x46, 0xb6, 0x1d, 0xc5, 0x19, 0xd9,
+	0x85, 0x2c, 0x05, 0x78, 0x3e, 0x73, 0xc6, 0x55, 0xce, 0x7b, 0x4c, 0x59, 0xa5, 0xbc, 0x1a, 0x6a,
+	0xa3, 0xc7, 0x00, 0x97, 0x1a, 0xbe, 0x92, 0x7b, 0xe6, 0xc8, 0x70, 0x0a, 0x79, 0xaa, 0x8f, 0x34,
+	0xa1, 0x54, 0x08, 0x01, 0xfd, 0x04, 0x92, 0xb6, 0x39, 0xb2, 0x7a, 0xb8, 0xb0, 0xb2, 0x15, 0xdb,
+	0xce, 0xec, 0x3e, 0x9d, 0xab, 0x95, 0x63, 0xca, 0x26, 0x71, 0x76, 0xf4, 0x00, 0x52, 0x97, 0xa6,
+	0x83, 0xe5, 0xd1, 0xb0, 0xb0, 0x4a, 0x41, 0x93, 0xa4, 0xd9, 0x1d, 0xa2, 0x87, 0x90, 0xa6, 0x1d,
+	0xaa, 0x79, 0x65, 0x14, 0x10, 0xed, 0x12, 0x08, 0xa1, 0x6a, 0x5e, 0x19, 0xe8, 0x25, 0xc4, 0xf0,
+	0xb5, 0x53, 0xb8, 0x4f, 0xc7, 0x7a, 0x3c, 0x73, 0xac, 0xda, 0xb5, 0x53, 0x33, 0x1c, 0x6b, 0x2c,
+	0x11, 0x4e, 0xf4, 0x2e, 0xe4, 0xcf, 0x94, 0x4b, 0xd3, 0xd2, 0x1c, 0xcc, 0x97, 0xb0, 0x49, 0x21,
+	0x73, 0x2e, 0xd5, 0x5d, 0x46, 0xda, 0x39, 0x1f, 0x0d, 0x4e, 0x0d, 0x45, 0xd3, 0x0b, 0xeb, 0x14,
+	0xfd, 0x06, 0xfb, 0xfa, 0xbc, 0xe8, 0x03, 0x48, 0xa9, 0xd8, 0xd2, 0x2e, 0xb1, 0x5a, 0x78, 0xb0,
+	0x48, 0xcc, 0xe5, 0x44, 0x7b, 0x90, 0x19, 0xea, 0x4a, 0x0f, 0x9f, 0x9b, 0xba, 0x8a, 0xad, 0x42,
+	0x81, 0x5a, 0x67, 0x6b, 0xa6, 0x60, 0xdb, 0xe7, 0x93, 0x82, 0x42, 0xc5, 0x3f, 0x8f, 0x41, 0x3e,
+	0x6c, 0x3a, 0xb4, 0x0f, 0xab, 0x03, 0xc5, 0xba, 0xc0, 0xaa, 0x4c, 0x6d, 0xc8, 0x7c, 0x27, 0xb2,
+	0xd0, 0x77, 0x56, 0x98, 0x50, 0x95, 0xc9, 0x74, 0x6c, 0x74, 0x00, 0x68, 0x88, 0x0d, 0x55, 0x33,
+	0xfa, 0x41, 0xa0, 0xe8, 0x42, 0x20, 0x91, 0x4b, 0xf9, 0x48, 0xfb, 0xb0, 0xaa, 0xf4, 0x9c, 0x91,
+	0xa2, 0x07, 0x81, 0x62, 0x8b, 0x67, 0xc4, 0x84, 0x7c, 0x9c, 0x02, 0xd1, 0xb2, 0xa3, 0x68, 0xba,
+	0x5d, 0x88, 0x6f, 0x45, 0xb6, 0xd3, 0x92, 0xdb, 0x44, 0x7b, 0x90, 0xb4, 0xb0, 0x62, 0x9b, 0x46,
+	0x21, 0xb1, 0x15, 0xd9, 0xce, 0xef, 0xbe, 0x58, 0xc2, 0xc7, 0x77, 0x24, 0x2a, 0x21, 0x71, 0x49,
+	0xf4, 0x08, 0xd2, 0x0e, 0x1e, 0x0c, 0x4d, 0x4b, 0xb1, 0xc6, 0x85, 0xe4, 0x56, 0x64, 0x5b, 0x90,
+	0x7c, 0x42, 0xe9, 0x03, 0x48, 0x32, 0x7e, 0x94, 0x81, 0x54, 0xb7, 0xf9, 0x59, 0xb3, 0xf5, 0x79,
+	0x53, 0xbc, 0x87, 0x04, 0x88, 0x37, 0x5b, 0xcd, 0x9a, 0x18, 0x41, 0x08, 0xf2, 0x52, 0xb7, 0x51,
+	0x93, 0x4f, 0xea, 0xad, 0x46, 0xb9, 0x53, 0x6f, 0x35, 0xc5, 0x68, 0xf1, 0xeb, 0x08, 0x80, 0xef,
+	0xf4, 0x48, 0x84, 0xd8, 0xc8, 0xd2, 0xa9, 0x2d, 0xd2, 0x12, 0x79, 0x44, 0x45, 0x10, 0x2c, 0x7c,
+	0x86, 0x2d, 0x0b, 0x5b, 0x54, 0xb3, 0x69, 0xc9, 0x6b, 0x4f, 0x04, 0x8e, 0xd8, 0x6d, 0x02, 0xc7,
+	0x03, 0x48, 0x8d, 0x6c, 0x6c, 0x91, 0xd0, 0x15, 0x67, 0x6f, 0x15, 0x69, 0xd6, 0x55, 0x84, 0x20,
+	0x6e, 0x28, 0x03, 0x4c, 0xb5, 0x94, 0x96, 0xe8, 0x73, 0xb1, 0x01, 0x82, 0xfb, 0xb2, 0x90, 0x19,
+	0x5e, 0xe0, 0xb1, 0x3b, 0xc3, 0x0b, 0x3c, 0x46, 0x2f, 0x20, 0x71, 0xa9, 0xe8, 0x23, 0xcc, 0x0d,
+	0xbf, 0x36, 0x35, 0x81, 0xb2, 0x31, 0x96, 0x18, 0xcb, 0x27, 0xd1, 0x8f, 0x23, 0xc5, 0x3f, 0x8d,
+	0x41, 0x9c, 0x2c, 0x19, 0xad, 0x41, 0x42, 0x33, 0x54, 0x7c, 0xed, 0x06, 0x4f, 0xda, 0x20, 0x13,
+	0xb0, 0xb5, 0x5f, 0x31, 0xb4, 0x98, 0x44, 0x9f, 0xd1, 0x2e, 0xc4, 0x07, 0xda, 0x00, 0xd3, 0x25,
+	0xe6, 0x77, 0x9f, 0xcc, 0x7d, 0x73, 0x76, 0x8e, 0xb4, 0x01, 0x96, 0x28, 0x2f, 0x41, 0xbf, 0xd2,
+	0x54, 0xe7, 0x9c, 0xaf, 0x8f, 0x35, 0xd0, 0x06, 0x24, 0xcf, 0xb1, 0xd6, 0x3f, 0x77, 0xe8, 0x02,
+	0x63, 0x12, 0x6f, 0x4d, 0xa8, 0x32, 0xf9, 0x16, 0x31, 0x38, 0x75, 0xab, 0x18, 0x5c, 0x83, 0xbc,
+	0x62, 0x68, 0x03, 0xba, 0x3b, 0xc9, 0x9a, 0x71, 0x66, 0x16, 0x04, 0x2a, 0x3f, 0xbd, 0xc6, 0xb2,
+	0xcb, 0x56, 0x37, 0xce, 0x4c, 0x29, 0xa7, 0x04, 0x9b, 0xa5, 0x3d, 0x88, 0x93, 0xa5, 0x4f, 0x79,
+	0xde, 0x61, 0xbb, 0xf6, 0x46, 0x8c, 0xa0, 0x14, 0xc4, 0xde, 0xd4, 0xf7, 0xc5, 0x28, 0x79, 0x68,
+	0x37, 0xdf, 0x88, 0x31, 0xd2, 0xf7, 0x79, 0x6d, 0xef, 0x48, 0x8c, 0x13, 0xd2, 0x51, 0xfb, 0x43,
+	0x31, 0x51, 0xfc, 0x05, 0x64, 0x02, 0x41, 0x84, 0x84, 0xd7, 0x53, 0x7d, 0x64, 0xc9, 0xe7, 0x8a,
+	0x7d, 0xce, 0xcd, 0x2d, 0x10, 0xc2, 0x81, 0x62, 0x9f, 0x93, 0x68, 0xa9, 0x9a, 0x03, 0xcd, 0x50,
+	0x0c, 0x47, 0xee, 0x99, 0xba, 0xc9, 0x7c, 0x33, 0x27, 0xe5, 0x5c, 0x6a, 0x85, 0x10, 0x0f, 0xe3,
+	0x42, 0x54, 0x8c, 0x1d, 0xc6, 0x85, 0x98, 0x18, 0x3f, 0x8c, 0x0b, 0x71, 0x31, 0x71, 0x18, 0x17,
+	0x12, 0x62, 0xf2, 0x30, 0x2e, 0xa4, 0x45, 0x38, 0x8c, 0x0b, 0x39, 0x31, 0x7f, 0x18, 0x17, 0x44,
+	0x71, 0xf5, 0x30, 0x2e, 0xac, 0x89, 0xeb, 0xa5, 0xff, 0x89, 0x82, 0xd0, 0x26, 0x5b, 0x28, 0x36,
+	0x9c, 0x79, 0x9b, 0xeb, 0x2e, 0xc4, 0x9d, 0xf1, 0x90, 0xf9, 0xc7, 0x1c, 0x5f, 0xa0, 0xf2, 0x3b,
+	0x9d, 0xf1, 0x10, 0x4b, 0x94, 0x97, 0xf8, 0x02, 0x73, 0x51, 0xe2, 0x40, 0x59, 0xee, 0x8c, 0xe8,
+	0x39, 0x64, 0xd4, 0x9e, 0xf3, 0xbe, 0x4c, 0x5b, 0x24, 0x60, 0xc4, 0xb6, 0xa3, 0x7b, 0x51, 0x31,
+	0x22, 0x01, 0x21, 0x9f, 0x50, 0x2a, 0xfa, 0x90, 0x6d, 0x24, 0x09, 0x1a, 0xb3, 0x4b, 0xf3, 0x47,
+	0x0b, 0xed, 0x26, 0xdf, 0xee, 0x1b, 0x53, 0xea, 0x41, 0x9c, 0x2c, 0x66, 0xca, 0xba, 0xc7, 0x07,
+	0xe5, 0x57, 0xcc, 0xa8, 0x47, 0xd5, 0xd7, 0x62, 0x0c, 0xa5, 0x21, 0x51, 0xad, 0x74, 0xe4, 0xf7,
+	0xc5, 0x38, 0xca, 0x03, 0x1c, 0x1f, 0x94, 0x5f, 0xbf, 0xda, 0x95, 0x77, 0x5f, 0x7f, 0x24, 0x26,
+	0x48, 0xec, 0xa9, 0xb6, 0x8e, 0xea, 0xcd, 0x72, 0xb3, 0x23, 0x57, 0x5a, 0x8d, 0x96, 0x24, 0x26,
+	0x4b, 0x71, 0x21, 0x22, 0x46, 0x5e, 0x24, 0x8f, 0x0f, 0xca, 0xbb, 0xaf, 0x3f, 0x2a, 0xed, 0x43,
+	0x2e, 0xe4, 0x62, 0xe8, 0x35, 0x08, 0x6e, 0xde, 0xc4, 0x37, 0x87, 0xcd, 0xa9, 0x89, 0x56, 0x39,
+	0x83, 0xe4, 0xb1, 0x96, 0xfe, 0x31, 0x0a, 0xb1, 0x8e, 0xd2, 0x27, 0xe6, 0x73, 0x94, 0x7e, 0xc0,
+	0x7c, 0x8e, 0xd2, 0x0f, 0xc4, 0x97, 0xa8, 0x1f, 0x5f, 0xd0, 0x53, 0xc8, 0x8c, 0x6c, 0xa5, 0xef,
+	0x6e, 0xbc, 0x31, 0xca, 0x0f, 0x94, 0xc4, 0x76, 0xdd, 0xbb, 0x7a, 0x3b, 0x79, 0x16, 0x21, 0xcc,
+	0xc9, 0x22, 0x3a, 0x4a, 0xff, 0x3b, 0xb5, 0xfb, 0x6f, 0xa2, 0x90, 0x6c, 0x6b, 0x3d, 0xae, 0xcd,
+	0x59, 0x2f, 0x83, 0xaf, 0xe4, 0xe8, 0x2c, 0x25, 0xc7, 0x02, 0x4a, 0x0e, 0x44, 0x7c, 0x21, 0x14,
+	0xf1, 0xef, 0x4a, 0xb9, 0xbb, 0x4c, 0xb9, 0x69, 0xaa, 0xdc, 0x99, 0x49, 0xcd, 0x77, 0xad, 0xdf,
+	0xbf, 0x4b, 0x00, 0xb4, 0xb5, 0x5e, 0xc5, 0x1c, 0x0c, 0x6e, 0x08, 0x38, 0x8f, 0x01, 0x7a, 0x8c,
+	0xc3, 0xd7, 0x73, 0x9a, 0x53, 0xea, 0x2a, 0x7a, 0x01, 0xab, 0x6e, 0xf7, 0x50, 0xb1, 0x38, 0x17,
+	0x73, 0xe1, 0x15, 0xde, 0xd1, 0xa6, 0xf4, 0xba, 0x7a, 0xe3, 0xae, 0xeb, 0x10, 0x65, 0xa4, 0x98,
+	0xc1, 0xc8, 0x73, 0x30, 0xf1, 0x4d, 0xcf, 0x4f, 0x7c, 0x61, 0x22, 0xf1, 0x0d, 0x5b, 0x33, 0xf1,
+	0x16, 0xd6, 0x4c, 0xde, 0xca, 0x9a, 0x1f, 0x05, 0x5f, 0x95, 0x77, 0x66, 0x59, 0x93, 0xab, 0x79,
+	0x22, 0xef, 0xfe, 0x39, 0xc9, 0x6f, 0x2e, 0x35, 0x9b, 0x44, 0x99, 0xcc, 0x62, 0x61, 0x89, 0xf3,
+	0x4a, 0x9e, 0x14, 0x59, 0x71, 0x20, 0x69, 0xcc, 0x2e, 0x5e, 0xb1, 0xea, 0xa6, 0x8b, 0xdf, 0x72,
+	0x62, 0xe3, 0x80, 0xe0, 0x4e, 0xcf, 0x33, 0x68, 0x24, 0x60, 0xd0, 0x4f, 0x21, 0x63, 0x61, 0x9a,
+	0x9a, 0x2f, 0x99, 0x27, 0x83, 0xcb, 0x1e, 0x4e, 0xd8, 0x62, 0x41, 0xd7, 0x29, 0xfd, 0x3a, 0x06,
+	0xa9, 0xb6, 0xd6, 0x3b, 0x31, 0x1d, 0x3c, 0xcf, 0x83, 0x03, 0xb2, 0xd1, 0x90, 0xdb, 0x79, 0x19,
+	0x58, 0x2a, 0x98, 0x81, 0xbd, 0x82, 0x38, 0x71, 0x27, 0x9e, 0x6d, 0xcd, 0x3c, 0x3c, 0x91, 0xd1,
+	0x76, 0xc8, 0x3f, 0x12, 0x65, 0x9d, 0xf0, 0xba, 0xf8, 0x5b, 0x78, 0x5d, 0xe2, 0x56, 0x5e, 0xf7,
+	0x01, 0xf3, 0xba, 0x24, 0x75, 0x9c, 0x67, 0x73, 0x67, 0xfa, 0x5d, 0x06, 0x91, 0x5d, 0x88, 0x53,
+	0xdd, 0x87, 0x36, 0xe7, 0x24, 0x44, 0xbb, 0x6d, 0x31, 0x42, 0x36, 0xe9, 0x2a, 0xa1, 0x44, 0x49,
+	0x77, 0xb3, 0xd6, 0xed, 0x48, 0xe5, 0x86, 0x18, 0x2b, 0xfd, 0x2e, 0x06, 0x79, 0xdf, 0xa9, 0x6f,
+	0x32, 0xdd, 0x82, 0xe0, 0x33, 0xcf, 0x2b, 0x7c, 0xcb, 0xc6, 0x83, 0x96, 0xfd, 0x98, 0x5b, 0x96,
+	0x1d, 0x81, 0x6e, 0x7a, 0xd1, 0x6e, 0x36, 0xf0, 0xf7, 0xb7, 0x49, 0x7c, 0x12, 0x0c, 0x2b, 0xdb,
+	0x8b, 0x26, 0xfc, 0x7f, 0xcd, 0xce, 0xbf, 0x89, 0x40, 0xa6, 0xad, 0xf5, 0xf6, 0x79, 0x09, 0x01,
+	0xbd, 0x07, 0x2b, 0xc4, 0xc8, 0x5e, 0xa1, 0xc1, 0xb3, 0x76, 0x6e, 0xe8, 0x73, 0xb1, 0x6d, 0x9d,
+	0x3b, 0x43, 0x74, 0xce, 0x7b, 0x1c, 0xbb, 0x61, 0x0b, 0xff, 0xde, 0x5e, 0xbf, 0xd2, 0xbf, 0x46,
+	0x01, 0x2a, 0xa6, 0xae, 0xe3, 0x1e, 0x49, 0xfd, 0xd0, 0x73, 0xc8, 0xf5, 0xbc, 0x96, 0xbf, 0xb8,
+	0xac, 0x4f, 0xbc, 0x29, 0x18, 0xcd, 0x4a, 0x5a, 0xf6, 0x01, 0x48, 0x40, 0x3d, 0xd5, 0x74, 0xcd,
+	0x19, 0xd3, 0x85, 0xe5, 0x77, 0xdf, 0x9b, 0xf2, 0x02, 0x7f, 0x0a, 0x3b, 0x27, 0x1e, 0xb7, 0x14,
+	0x90, 0xbc, 0xab, 0x5d, 0xb1, 0xb4, 0x0b, 0xe0, 0xcf, 0x28, 0xec, 0x3a, 0x19, 0x48, 0xb5, 0xa5,
+	0xfa, 0x49, 0xb9, 0x53, 0x13, 0x23, 0x08, 0x20, 0xd9, 0xee, 0xee, 0x35, 0xea, 0x15, 0x31, 0x5a,
+	0xfa, 0x6d, 0x04, 0x72, 0xfe, 0x8a, 0xda, 0x5a, 0x6f, 0x39, 0xbd, 0xce, 0xf1, 0x99, 0x22, 0x08,
+	0x43, 0xd3, 0xd6, 0x68, 0x16, 0xcf, 0x9c, 0xc6, 0x6b, 0xdf, 0x99, 0xdb, 0xfc, 0x73, 0x04, 0xe2,
+	0x6d, 0xd3, 0xd4, 0x89, 0x2f, 0x0c, 0x4d, 0x53, 0xf7, 0x97, 0x94, 0x24, 0x4d, 0x16, 0xbe, 0x1c,
+	0xcd, 0xd1, 0xdd, 0x63, 0x02, 0x6b, 0xa0, 0x2d, 0xc8, 0xa8, 0xd8, 0xee, 0x59, 0xda, 0xd0, 0x5b,
+	0x4e, 0x5a, 0x0a, 0x92, 0xee, 0x6c, 0x45, 0xff, 0x14, 0x81, 0x14, 0x59, 0x11, 0xb1, 0xd6, 0xdc,
+	0x45, 0xfd, 0x3f, 0xb2, 0xd0, 0x3f, 0x44, 0x00, 0xba, 0x36, 0xb6, 0xf6, 0x4d, 0x5d, 0x37, 0xaf,
+	0x82, 0xef, 0x6c, 0x24, 0xf4, 0xce, 0x6e, 0x83, 0x78, 0x46, 0x59, 0x30, 0x96, 0xc3, 0x6f, 0x75,
+	0xde, 0xa5, 0x77, 0x67, 0x85, 0xa8, 0xd8, 0x5b, 0xac, 0x24, 0x7e, 0xab, 0x95, 0xfc, 0x6d, 0x04,
+	0xd2, 0x1d, 0xa5, 0xbf, 0x68, 0x21, 0x9b, 0x20, 0x90, 0x83, 0x54, 0xe0, 0x68, 0x9a, 0x72, 0x94,
+	0x7e, 0x93, 0xc4, 0xa0, 0xbb, 0x9a, 0xf9, 0xd7, 0x59, 0x48, 0x13, 0xe5, 0xd5, 0x2e, 0xc9, 0xe1,
+	0x64, 0xee, 0xcc, 0xbd, 0x9d, 0x3e, 0x1a, 0xdc, 0xe9, 0xef, 0x68, 0xd2, 0xe8, 0x02, 0x0a, 0xe6,
+	0xc8, 0xe9, 0x9b, 0x9a, 0xd1, 0x97, 0x47, 0x43, 0x1b, 0x5b, 0x8e, 0x4c, 0x7c, 0xde, 0xcb, 0x3a,
+	0x32, 0xbb, 0xef, 0x4f, 0x85, 0x6f, 0x6f, 0x91, 0x3b, 0x2d, 0x2e, 0xda, 0xa5, 0x92, 0x3c, 0x7b,
+	0x3b, 0xb8, 0x27, 0xad, 0x9b, 0xb3, 0x3a, 0xc8, 0x60, 0x9a, 0xd1, 0x33, 0x07, 0xb3, 0x06, 0x4b,
+	0x2e, 0x1c, 0xac, 0xce, 0x45, 0xa7, 0x06, 0xd3, 0x66, 0x75, 0x20, 0x05, 0xd6, 0xbc, 0x95, 0x91,
+	0x51, 0x78, 0x12, 0xc6, 0xf3, 0x99, 0x1f, 0x2f, 0xb1, 0x2a, 0x3f, 0x59, 0x39, 0xb8, 0x27, 0x21,
+	0x73, 0x8a, 0x4a, 0x86, 0xf0, 0xd6, 0x13, 0x1c, 0x42, 0x58, 0x38, 0x84, 0xbb, 0x96, 0xf0, 0x10,
+	0xda, 0x14, 0x15, 0xd5, 0x00, 0x7c, 0x4d, 0xd1, 0x73, 0xe5, 0xac, 0x03, 0x97, 0x0f, 0xec, 0xe9,
+	0xe0, 0xe0, 0x9e, 0x94, 0x1e, 0xb9, 0x0d, 0xd4, 0x84, 0x9c, 0x6e, 0xf6, 0x35, 0x43, 0xd6, 0xcd,
+	0xde, 0x85, 0x39, 0x72, 0xf8, 0xad, 0xd5, 0x0f, 0x6e, 0x40, 0x6a, 0x10, 0xfe, 0x06, 0x63, 0x3f,
+	0xb8, 0x27, 0x65, 0xf5, 0x40, 0x1b, 0xfd, 0x0c, 0x52, 0xf6, 0xc8, 0x1e, 0x62, 0x43, 0xe5, 0x77,
+	0x58, 0xa5, 0x1b, 0x90, 0x8e, 0x19, 0xe7, 0xc1, 0x3d, 0xc9, 0x15, 0x42, 0x55, 0x48, 0x8f, 0x0c,
+	0x17, 0x21, 0xbb, 0x78, 0x55, 0x2e, 0x2f, 0x5d, 0x95, 0xdb, 0x40, 0x1d, 0x58, 0xf1, 0xf4, 0xcf,
+	0xc2, 0x57, 0x21, 0x47, 0xb1, 0x7e, 0xb8, 0x84, 0xea, 0x59, 0x84, 0x39, 0xb8, 0x27, 0xe5, 0xb5,
+	0x10, 0xa5, 0xb8, 0x03, 0xeb, 0x33, 0xfd, 0x7a, 0x4e, 0xca, 0x5f, 0x3c, 0x81, 0xf5, 0x99, 0xae,
+	0x49, 0xb2, 0x47, 0x7b, 0x74, 0xfa, 0x47, 0xb8, 0xe7, 0xc8, 0xe1, 0x50, 0x90, 0xe3, 0x64, 0x1e,
+	0x6a, 0x67, 0xef, 0x33, 0xc5, 0x43, 0x40, 0xd3, 0x9e, 0x38, 0x71, 0xc0, 0x88, 0x4c, 0x1e, 0x30,
+	0xe6, 0x63, 0x4d, 0xbb, 0xdc, 0x37, 0xc4, 0x2a, 0x41, 0xda, 0x5b, 0xe7, 0x3c, 0x9d, 0xd8, 0x90,
+	0x0d, 0xfa, 0x0f, 0x7a, 0x4a, 0x8e, 0xd2, 0x03, 0xd3, 0xc1, 0xb2, 0xa2, 0xaa, 0x16, 0x4f, 0xeb,
+	0x81, 0x91, 0xca, 0xaa, 0x6a, 0xa1, 0x3d, 0x58, 0x21, 0xae, 0x89, 0x55, 0x79, 0x64, 0x38, 0x9a,
+	0xbe, 0xdc, 0x79, 0x3b, 0xc7, 0x44, 0xba, 0x44, 0xa2, 0x63, 0x17, 0x3b, 0x90, 0xe2, 0xae, 0x86,
+	0x36, 0xbc, 0xdb, 0x23, 0x36, 0x94, 0x7b, 0x23, 0xf4, 0x0a, 0x92, 0xd8, 0x58, 0xf2, 0x34, 0x9f,
+	0xc0, 0x86, 0xda, 0xb1, 0x8b, 0x19, 0x48, 0x7b, 0xee, 0x57, 0xfc, 0x18, 0xf2, 0x61, 0xff, 0x59,
+	0xd6, 0xc8, 0x7b, 0x09, 0x88, 0xe1, 0x4b, 0xa7, 0xf4, 0xef, 0x6b, 0x10, 0x27, 0x94, 0xf9, 0xfb,
+	0xc3, 0x06, 0x24, 0x6d, 0xdc, 0xb3, 0xb0, 0x43, 0xa7, 0x98, 0x95, 0x78, 0x8b, 0xee, 0x1b, 0x2a,
+	0xe6, 0xe5, 0xd6, 0xb4, 0xc4, 0x1a, 0x77, 0x76, 0x90, 0xff, 0x29, 0x64, 0x75, 0xc5, 0x76, 0x64,
+	0x1b, 0x63, 0x63, 0xc9, 0x34, 0x9b, 0xf0, 0x1f, 0x63, 0x6c, 0x74, 0x6c, 0xf4, 0x73, 0x80, 0x9e,
+	0x32, 0x54, 0xf8, 0x31, 0x21, 0xb5, 0x15, 0xdb, 0xce, 0xcf, 0xa8, 0x28, 0x12, 0x3d, 0xed, 0x54,
+	0x3c, 0x3e, 0x29, 0x20, 0x83, 0x4a, 0x90, 0x33, 0xf0, 0xb5, 0x23, 0x3b, 0xe6, 0x05, 0x36, 0xfc,
+	0x1a, 0x69, 0x86, 0x10, 0x3b, 0x84, 0xc6, 0x52, 0x18, 0xaa, 0x62, 0xca, 0xc3, 0xeb, 0x96, 0xc5,
+	0x99, 0xa3, 0x50, 0x09, 0x29, 0x3d, 0x72, 0x1f, 0xd1, 0xfb, 0xec, 0x18, 0x0b, 0x54, 0xe6, 0xc9,
+	0xec, 0x99, 0x85, 0xeb, 0x62, 0x87, 0x90, 0x1f, 0x2a, 0xb6, 0x7d, 0x65, 0x5a, 0xaa, 0x6c, 0x61,
+	0x1b, 0x3b, 0x3c, 0x30, 0x3e, 0x9f, 0x2d, 0xdc, 0xe6, 0xbc, 0x12, 0x61, 0x95, 0x72, 0xc3, 0x60,
+	0x93, 0xa8, 0x47, 0x33, 0x2e, 0x35, 0x87, 0xd5, 0xf2, 0xb3, 0x73, 0x6e, 0x91, 0x29, 0x4e, 0xdd,
+	0xe3, 0x93, 0x02, 0x32, 0x68, 0x07, 0xe2, 0x8e, 0xe9, 0x0c, 0x79, 0x38, 0x9c, 0xbd, 0xe8, 0x9d,
+	0x8e, 0xe9, 0x0c, 0x25, 0xca, 0x47, 0xce, 0x72, 0x96, 0xa9, 0xe3, 0x42, 0x7e, 0x2b, 0x46, 0xce,
+	0x72, 0xe4, 0x99, 0xcc, 0x82, 0xb9, 0x3d, 0xad, 0xf5, 0xad, 0xdc, 0x34, 0x8b, 0x63, 0x8f, 0x4f,
+	0x0a, 0xc8, 0xa0, 0x9f, 0x40, 0x6a, 0x68, 0x99, 0xf4, 0xd3, 0x0a, 0x91, 0x8a, 0x3f, 0x9e, 0xa3,
+	0x0c, 0xc6, 0x24, 0xb9, 0xdc, 0xdf, 0x72, 0x9d, 0xef, 0xeb, 0x08, 0xe4, 0x42, 0xfa, 0x26, 0x81,
+	0x8f, 0x39, 0x8e, 0x77, 0x59, 0x96, 0x95, 0xd2, 0x94, 0x42, 0x6f, 0xcb, 0xc2, 0x2f, 0x55, 0xf4,
+	0x36, 0x2f, 0xd5, 0x4f, 0x20, 0x8d, 0xaf, 0x87, 0x9a, 0x85, 0x97, 0x4b, 0xe3, 0x04, 0xc6, 0xdc,
+	0xb1, 0x8b, 0x5f, 0x01, 0xf8, 0xb6, 0x24, 0x51, 0x85, 0x5a, 0x13, 0x5b, 0x93, 0x51, 0x85, 0x93,
+	0xf9, 0xd6, 0xf1, 0x0e, 0xe4, 0x19, 0x41, 0xee, 0x99, 0x2a, 0xf6, 0x43, 0x75, 0x96, 0x51, 0x2b,
+	0xa6, 0x8a, 0xeb, 0x6a, 0xf1, 0x3f, 0x23, 0x10, 0x27, 0xc6, 0x0e, 0xc4, 0x96, 0x48, 0x28, 0xb6,
+	0xbc, 0xc5, 0x82, 0xff, 0x00, 0xb2, 0x3d, 0xd3, 0x38, 0xd3, 0xac, 0xc1, 0xb2, 0xa9, 0x6b, 0xc6,
+	0xe3, 0xef, 0xd8, 0xe8, 0x21, 0xa4, 0x59, 0x1c, 0x71, 0xf0, 0x90, 0xd7, 0xbe, 0x04, 0x1a, 0x28,
+	0x1c, 0x3c, 0x44, 0x3f, 0x02, 0x64, 0xe1, 0x9e, 0x79, 0x89, 0xad, 0x31, 0x5b, 0x1f, 0x35, 0x57,
+	0x62, 0x2b, 0xb6, 0x9d, 0x95, 0x44, 0xb7, 0x87, 0xac, 0x91, 0x58, 0xad, 0xf8, 0xd7, 0x11, 0x00,
+	0xdf, 0x11, 0xe7, 0x6e, 0x01, 0x44, 0x65, 0xb6, 0x3d, 0x0a, 0x68, 0xd6, 0x55, 0x19, 0xa5, 0x72,
+	0xc5, 0xbe, 0x06, 0xc1, 0x76, 0x14, 0xcb, 0x59, 0x6e, 0x49, 0x29, 0xca, 0xdb, 0xb1, 0x03, 0xfb,
+	0x4b, 0x7c, 0xd9, 0xfd, 0xe5, 0x14, 0x52, 0xdc, 0xff, 0xd1, 0x33, 0xc8, 0xaa, 0x9a, 0x3d, 0xd4,
+	0x95, 0x31, 0x3b, 0xd8, 0x44, 0xf8, 0x81, 0x99, 0xd1, 0xe8, 0xe1, 0x46, 0x84, 0xd8, 0xa9, 0x66,
+	0xf2, 0x23, 0x0f, 0x79, 0x24, 0x91, 0x50, 0xb9, 0x54, 0x1c, 0xc5, 0x92, 0xf9, 0x46, 0xcc, 0xce,
+	0xa4, 0x19, 0x46, 0xa4, 0xf7, 0x9d, 0xa5, 0xff, 0x48, 0x01, 0xf8, 0x81, 0x34, 0x5c, 0xd7, 0xc8,
+	0x03, 0xb4, 0xeb, 0x15, 0xb9, 0x22, 0xd5, 0x58, 0x69, 0x23, 0x0b, 0x02, 0x69, 0x4b, 0xb5, 0x72,
+	0x55, 0x8c, 0xa2, 0x1c, 0xa4, 0x49, 0xab, 0xde, 0xac, 0xd6, 0xbe, 0x10, 0x63, 0xe8, 0x3e, 0xac,
+	0x90, 0xe6, 0x71, 0x6b, 0xbf, 0x23, 0x57, 0x6b, 0x8d, 0x5a, 0xa7, 0x26, 0x26, 0x5c, 0xe2, 0x41,
+	0x59, 0xaa, 0xba, 0xc4, 0xa4, 0x2b, 0xd8, 0xee, 0x4a, 0x6f, 0x6a, 0x62, 0x0a, 0x3d, 0x84, 0x07,
+	0xa4, 0xd9, 0x6d, 0x57, 0xcb, 0x9d, 0x9a, 0x7c, 0x52, 0xaf, 0x7d, 0x2e, 0x57, 0x5a, 0xdd, 0x66,
+	0xa7, 0x26, 0x89, 0x02, 0x42, 0x90, 0x27, 0x9d, 0x9d, 0xf2, 0x1b, 0x77, 0x1a, 0x69, 0xb4, 0x01,
+	0x88, 0x4e, 0xab, 0x75, 0x74, 0x54, 0x6b, 0x76, 0x5c, 0x3a, 0xb8, 0x83, 0x9d, 0xb4, 0x3a, 0x35,
+	0x97, 0x98, 0x41, 0x2b, 0x90, 0xe9, 0x1e, 0xd7, 0x24, 0x97, 0x10, 0x47, 0x45, 0xd8, 0xa0, 0x04,
+	0x3e, 0x5e, 0xa5, 0xdc, 0x2e, 0xef, 0xd5, 0x1b, 0xf5, 0xce, 0x97, 0x62, 0x96, 0x8c, 0x46, 0xfb,
+	0xc8, 0x0a, 0xe5, 0xe3, 0x5a, 0x63, 0x5f, 0xcc, 0xa1, 0x55, 0xc8, 0xf9, 0xb4, 0x72, 0xa3, 0x21,
+	0xe6, 0x51, 0x01, 0xd6, 0xc8, 0x40, 0xb5, 0x2f, 0x3a, 0xb5, 0xe6, 0x71, 0xbd, 0xd5, 0x74, 0xc1,
+	0x57, 0xdc, 0xa9, 0xf9, 0x3d, 0x54, 0x57, 0x22, 0xda, 0x82, 0x47, 0xc1, 0x29, 0x4f, 0x49, 0xae,
+	0xa2, 0x27, 0x50, 0x9c, 0xcd, 0x41, 0x11, 0x10, 0x7a, 0x04, 0x05, 0x57, 0x11, 0x53, 0xd2, 0xf7,
+	0xc9, 0xa2, 0xa6, 0x7b, 0xa9, 0xe4, 0x1a, 0x7a, 0x0c, 0x9b, 0x9e, 0x5a, 0xa6, 0x44, 0xd7, 0x5d,
+	0xf5, 0x4f, 0x74, 0x53, 0xd9, 0x0d, 0xb4, 0x06, 0xa2, 0xbf, 0x78, 0x5e, 0xd6, 0x7a, 0x10, 0x56,
+	0x53, 0xbb, 0x5e, 0x39, 0x16, 0x0b, 0x68, 0x1d, 0x56, 0x43, 0x34, 0x32, 0x17, 0x71, 0x13, 0x6d,
+	0xc2, 0x7a, 0x98, 0xcc, 0x17, 0x28, 0x16, 0x89, 0xae, 0xc2, 0x5d, 0x64, 0x0a, 0xe2, 0x43, 0x77,
+	0x42, 0xae, 0x26, 0x82, 0xe6, 0x7c, 0x84, 0xde, 0x85, 0x67, 0x53, 0x9d, 0x53, 0x8b, 0x7a, 0xec,
+	0x61, 0xd7, 0x9b, 0x27, 0x75, 0x5f, 0xfc, 0x09, 0x12, 0x21, 0x4b, 0xe9, 0xc7, 0xdd, 0xe3, 0x76,
+	0xad, 0x59, 0x15, 0x9f, 0xa2, 0x07, 0x70, 0x3f, 0xe8, 0x0e, 0x6d, 0xa9, 0xb5, 0x5f, 0x6f, 0xd4,
+	0xc4, 0x2d, 0x0f, 0xa2, 0xdc, 0xed, 0x1c, 0xd0, 0x21, 0xa4, 0x66, 0xb9, 0x21, 0x3e, 0x23, 0x8b,
+	0x2f, 0x77, 0xab, 0xf5, 0x8e, 0xdc, 0x68, 0xbd, 0x61, 0x6a, 0x2a, 0x4d, 0x7a, 0x24, 0xc3, 0x12,
+	0x9f, 0x4f, 0xd2, 0xf9, 0x1b, 0xf0, 0x8e, 0xeb, 0x40, 0x2e, 0xfd, 0xa8, 0x55, 0xad, 0x49, 0x44,
+	0xe2, 0x5d, 0x32, 0x1d, 0xd2, 0xb3, 0x5f, 0x3e, 0x69, 0x49, 0x81, 0x99, 0xbf, 0x47, 0xf4, 0x5b,
+	0x69, 0x35, 0x1a, 0xb5, 0x4a, 0x27, 0xb0, 0xd0, 0x1f, 0x10, 0xef, 0xa4, 0xef, 0x52, 0xab, 0xd5,
+	0x90, 0x6b, 0xd5, 0x7a, 0x47, 0xdc, 0x26, 0xa4, 0xfd, 0x56, 0xa3, 0xd1, 0xfa, 0xdc, 0xe5, 0xfa,
+	0x61, 0xe9, 0xcf, 0x62, 0x7c, 0x0b, 0xa1, 0x61, 0x7f, 0xc6, 0xd6, 0x10, 0x99, 0xde, 0x1a, 0x48,
+	0xfc, 0xf5, 0x23, 0x2b, 0x4b, 0x38, 0x85, 0x1e, 0x8f, 0xa8, 0x64, 0x17, 0xa2, 0x81, 0xde, 0xf4,
+	0x63, 0x25, 0x0b, 0x2e, 0x39, 0x4e, 0xee, 0xde, 0x69, 0x39, 0x3b, 0xbc, 0xd9, 0x26, 0x97, 0xdf,
+	0x6c, 0xd1, 0x26, 0x08, 0x03, 0xe5, 0x9a, 0x2c, 0xca, 0xe6, 0x57, 0x69, 0xa9, 0x81, 0x72, 0xdd,
+	0xb5, 0xb1, 0x4d, 0x32, 0x21, 0x4a, 0x66, 0xf9, 0x24, 0x7d, 0x9e, 0x48, 0x57, 0xd3, 0xb7, 0x4f,
+	0x57, 0x4b, 0x7f, 0x11, 0x83, 0x64, 0x79, 0xa8, 0x7d, 0x86, 0xc7, 0xe8, 0x11, 0x80, 0x32, 0xd4,
+	0xe4, 0x0b, 0x3c, 0xf6, 0x6d, 0x22, 0x28, 0xb4, 0x8f, 0xd5, 0xb5, 0x48, 0x4f, 0xc0, 0x1c, 0xa9,
+	0x0b, 0x3c, 0xa6, 0xd6, 0x98, 0x7b, 0x9b, 0xe0, 0x16, 0xe2, 0xe3, 0x81, 0x42, 0xfc, 0x5d, 0x5d,
+	0x2b, 0x87, 0x4c, 0x92, 0xba, 0x85, 0x49, 0xdc, 0x03, 0xc5, 0xc8, 0x66, 0xc3, 0x0a, 0xcb, 0x1d,
+	0x28, 0xba, 0x36, 0x1d, 0xf6, 0xed, 0x2d, 0xf4, 0xf7, 0x51, 0x7e, 0x64, 0xdd, 0x57, 0x34, 0x7d,
+	0x64, 0x61, 0xb4, 0x0d, 0x22, 0x2b, 0x99, 0x9c, 0x31, 0x82, 0x6f, 0xad, 0xbc, 0x1e, 0xe0, 0xab,
+	0xab, 0xa8, 0x00, 0x29, 0x7e, 0xd6, 0x73, 0x4b, 0x91, 0xbc, 0x79, 0x67, 0x55, 0xbd, 0x22, 0x08,
+	0x7c, 0xd6, 0x36, 0xff, 0x6e, 0xce, 0x6b, 0x93, 0x3e, 0x5e, 0x04, 0x62, 0xb6, 0x25, 0x09, 0x17,
+	0x6f, 0xcf, 0x3a, 0x85, 0xa7, 0x6e, 0x79, 0x0a, 0x2f, 0xfd, 0x2e, 0x09, 0x42, 0x79, 0xa4, 0x6a,
+	0x4e, 0xc3, 0xec, 0xa3, 0x2d, 0xc8, 0x2a, 0xe4, 0x59, 0xd6, 0xcd, 0xc0, 0xa7, 0x45, 0xa0, 0xf0,
+	0xfe, 0xba, 0x8a, 0x3e, 0x86, 0xa4, 0x42, 0xaf, 0x44, 0xf8, 0x07, 0x62, 0xd3, 0x56, 0x73, 0xc1,
+	0x76, 0xca, 0x94, 0x4f, 0xe2, 0xfc, 0x34, 0xf1, 0xe9, 0x4d, 0xc7, 0xa6, 0x0c, 0x25, 0xfa, 0xf9,
+	0xb1, 0xa3, 0x58, 0x7d, 0xec, 0x1f, 0xce, 0x59, 0x8e, 0x99, 0x65, 0x54, 0xce, 0x55, 0x82, 0x1c,
+	0xe7, 0xe2, 0x29, 0x14, 0xd3, 0x59, 0x86, 0x11, 0x69, 0x0a, 0x85, 0x5e, 0xc0, 0x2a, 0xe7, 0x09,
+	0x94, 0x4c, 0x32, 0xec, 0xb3, 0x11, 0xd6, 0x51, 0xf1, 0x0a, 0x27, 0x77, 0x75, 0xf9, 0xea, 0xa7,
+	0xbc, 0x42, 0x28, 0xe5, 0xfd, 0x18, 0x92, 0xbd, 0x73, 0xc5, 0xe8, 0xe3, 0xb9, 0x1f, 0xef, 0x78,
+	0x3a, 0xae, 0x50, 0x3e, 0x89, 0xf3, 0xa3, 0x2a, 0xe4, 0x68, 0xb6, 0xde, 0x77, 0x3f, 0x2c, 0x83,
+	0x39, 0x5f, 0x3b, 0x56, 0x82, 0x5c, 0x52, 0x58, 0xa8, 0xd8, 0x80, 0x24, 0xc3, 0x45, 0x6b, 0x90,
+	0x38, 0xd3, 0xb0, 0xae, 0xf2, 0xd4, 0x96, 0x35, 0xc8, 0xbc, 0x4f, 0xf1, 0x99, 0x69, 0xb9, 0xa5,
+	0x7c, 0xde, 0x22, 0xdc, 0xca, 0x99, 0x83, 0x2d, 0xb7, 0xe4, 0x41, 0x1b, 0xa5, 0x3f, 0x89, 0x42,
+	0x92, 0xb9, 0x42, 0x38, 0x91, 0x25, 0x39, 0x1f, 0xdb, 0xdf, 0x59, 0x2e, 0xe8, 0xe7, 0x7c, 0x11,
+	0x92, 0x35, 0x06, 0x72, 0x56, 0x92, 0x88, 0x88, 0x51, 0x42, 0x0c, 0xe4, 0xac, 0x94, 0x18, 0xa3,
+	0x79, 0x2b, 0xc9, 0x59, 0x69, 0x33, 0x4e, 0x36, 0x71, 0x37, 0x87, 0x6c, 0x35, 0xf7, 0xeb, 0x6f,
+	0xba, 0x12, 0xfb, 0x12, 0x38, 0x41, 0xb2, 0x0c, 0x9e, 0x60, 0xd0, 0xf1, 0xc4, 0x24, 0xcd, 0x98,
+	0x9a, 0x21, 0x5a, 0x8a, 0x26, 0x18, 0x3c, 0xe9, 0x08, 0xe4, 0x45, 0x02, 0xa1, 0xfb, 0xc3, 0x7a,
+	0xf4, 0x34, 0xcd, 0x5d, 0x9a, 0x8d, 0x56, 0xe5, 0x33, 0x92, 0x79, 0xd4, 0x9b, 0x22, 0x10, 0x4e,
+	0xb6, 0xc5, 0x7b, 0x79, 0x4e, 0xab, 0x5a, 0x13, 0x33, 0xa5, 0xff, 0x8e, 0x80, 0x40, 0xfc, 0xb7,
+	0xa1, 0x19, 0x17, 0xe4, 0x3d, 0xa3, 0x0e, 0xae, 0x6b, 0xc6, 0x45, 0xe0, 0x3d, 0x1b, 0xf1, 0xfe,
+	0x9b, 0xae, 0x71, 0x8b, 0x20, 0x0c, 0x2d, 0xf3, 0x52, 0x53, 0x3d, 0x3d, 0x7b, 0xed, 0x60, 0x64,
+	0x8b, 0xdf, 0x14, 0xd9, 0xbe, 0xbf, 0x0b, 0xda, 0xdf, 0x46, 0xd8, 0x25, 0x0b, 0x2b, 0xd3, 0x6c,
+	0x82, 0xe0, 0x15, 0x80, 0xd8, 0x92, 0x53, 0x8e, 0x5f, 0xfc, 0xf9, 0xa6, 0x47, 0xda, 0xc9, 0xda,
+	0x56, 0xec, 0x56, 0xb5, 0xad, 0xc7, 0xbc, 0xea, 0xa4, 0xf4, 0xb1, 0xe1, 0xaa, 0x8d, 0x56, 0x96,
+	0xca, 0x84, 0x30, 0x59, 0x09, 0x4d, 0x4c, 0x56, 0x42, 0x4b, 0xff, 0xb5, 0x05, 0xb9, 0xd0, 0xdb,
+	0x84, 0xea, 0x80, 0x06, 0x9a, 0xe1, 0xc5, 0x1d, 0x1d, 0x1b, 0x7d, 0xe7, 0x9c, 0x7f, 0xe2, 0xf9,
+	0x70, 0x6a, 0x56, 0x75, 0xc3, 0xf9, 0xe8, 0x43, 0xfa, 0x31, 0xac, 0x24, 0x0e, 0x34, 0x83, 0x47,
+	0xa5, 0x06, 0x15, 0xa2, 0x50, 0xca, 0xf5, 0x24, 0x54, 0x74, 0x19, 0x28, 0xe5, 0x3a, 0x0c, 0x55,
+	0x03, 0x02, 0x2f, 0xd3, 0x32, 0xa4, 0x0b, 0x14, 0x5b, 0x0c, 0x94, 0x1f, 0x68, 0x06, 0xfd, 0x02,
+	0x37, 0x00, 0xa3, 0x5c, 0x87, 0x61, 0xe2, 0xcb, 0xc0, 0x28, 0xd7, 0x41, 0x98, 0x06, 0xac, 0x91,
+	0xd9, 0x90, 0x63, 0x34, 0x3d, 0x3b, 0xbb, 0x50, 0x89, 0xc5, 0x50, 0xab, 0x03, 0xcd, 0xd8, 0xd7,
+	0x74, 0x4c, 0xce, 0xd7, 0x01, 0x34, 0xe5, 0x7a, 0x1a, 0x2d, 0xb9, 0x0c, 0x9a, 0x72, 0x3d, 0x81,
+	0x56, 0x06, 0xb2, 0x68, 0x79, 0x64, 0xe9, 0x2e, 0x4e, 0x6a, 0x31, 0x4e, 0x76, 0xa0, 0x19, 0x5d,
+	0x4b, 0x0f, 0x40, 0x90, 0x84, 0xd5, 0x87, 0x10, 0x96, 0x81, 0x50, 0xae, 0xc3, 0x10, 0x9a, 0x21,
+	0x3b, 0x4a, 0xdf, 0x85, 0x48, 0x2f, 0x37, 0x8b, 0x8e, 0xd2, 0x0f, 0xcf, 0x22, 0x00, 0x01, 0xcb,
+	0xcd, 0xc2, 0x87, 0x90, 0x61, 0x4d, 0x31, 0x4c, 0x63, 0x3c, 0x30, 0x47, 0xb6, 0x1c, 0x48, 0xd9,
+	0x58, 0xb1, 0xf4, 0x47, 0x37, 0xef, 0x2b, 0x81, 0xdc, 0xed, 0x18, 0x3b, 0xd2, 0x7d, 0x0f, 0x29,
+	0x50, 0xdb, 0xf8, 0x25, 0xdc, 0x37, 0xf0, 0x15, 0xdb, 0xee, 0x03, 0xf8, 0xd9, 0x6f, 0x80, 0xbf,
+	0x6a, 0xe0, 0x2b, 0x12, 0x6b, 0x02, 0xe8, 0x12, 0x3c, 0x50, 0xf1, 0x99, 0x32, 0xd2, 0x1d, 0xf9,
+	0x4c, 0x33, 0x54, 0x99, 0x5e, 0xde, 0x92, 0xac, 0xc1, 0xe6, 0xa5, 0xd6, 0x1b, 0x55, 0xb1, 0xc6,
+	0x65, 0xf7, 0x35, 0x43, 0xad, 0x13, 0xc9, 0xb6, 0xd6, 0xb3, 0xd1, 0x21, 0xdc, 0x67, 0xce, 0x16,
+	0xc6, 0xcb, 0x2f, 0xf7, 0x52, 0x86, 0xb1, 0xde, 0xb0, 0xf7, 0x9b, 0x44, 0x6f, 0x53, 0xf6, 0xbe,
+	0x06, 0x5f, 0x59, 0xf4, 0x35, 0x38, 0x01, 0x3a, 0x21, 0x32, 0x2e, 0x05, 0xfd, 0x12, 0x1e, 0x63,
+	0x43, 0x39, 0xd5, 0x71, 0xf0, 0x62, 0x53, 0xb6, 0xb1, 0x7e, 0x26, 0x5b, 0x78, 0xa8, 0x8f, 0x79,
+	0x41, 0x77, 0x3a, 0x28, 0xee, 0x99, 0xa6, 0xce, 0x66, 0xb7, 0xc9, 0x00, 0xfc, 0xfb, 0xa6, 0x63,
+	0xac, 0x9f, 0x49, 0x44, 0x18, 0x9d, 0xc2, 0xd6, 0x2c, 0x74, 0xed, 0x54, 0xd7, 0x8c, 0x3e, 0x1f,
+	0x60, 0x75, 0xe1, 0x00, 0x8f, 0xa6, 0x06, 0x60, 0x00, 0x6c, 0x8c, 0x0e, 0x14, 0x42, 0xa6, 0xa2,
+	0x1e, 0x81, 0x2f, 0xb1, 0xe1, 0xd8, 0xf4, 0xd7, 0x67, 0x0b, 0x74, 0xbb, 0x1e, 0xb0, 0x95, 0x77,
+	0x79, 0x68, 0xfb, 0x91, 0x61, 0x02, 0xf1, 0xfe, 0xb2, 0x91, 0x21, 0x84, 0x76, 0x04, 0xeb, 0xa3,
+	0xa1, 0x6e, 0x2a, 0xaa, 0x6c, 0x63, 0xdb, 0xd6, 0x4c, 0x43, 0xa6, 0xe7, 0xa1, 0x71, 0x61, 0x6d,
+	0x91, 0xc5, 0xee, 0x33, 0xb9, 0x63, 0x26, 0x56, 0xa3, 0x52, 0xe8, 0x0b, 0x28, 0x92, 0xc9, 0xf1,
+	0xfd, 0xe5, 0x0c, 0x3b, 0xbd, 0x73, 0xd9, 0xc2, 0xaa, 0x66, 0xe1, 0x9e, 0x63, 0x17, 0xd6, 0x17,
+	0x4f, 0xf1, 0xc1, 0x40, 0xb9, 0x96, 0xa8, 0xf4, 0x3e, 0x11, 0x96, 0x5c, 0x59, 0xd4, 0x84, 0xf5,
+	0x29, 0x64, 0xfa, 0xab, 0x9f, 0x8d, 0xc5, 0xa0, 0x28, 0x0c, 0x7a, 0xac, 0xfd, 0x0a, 0xa3, 0xcf,
+	0x60, 0x2d, 0x84, 0xe5, 0x68, 0x03, 0x6c, 0x8e, 0x9c, 0xc2, 0x83, 0x45, 0xeb, 0x46, 0x96, 0x8f,
+	0xd4, 0x61, 0x42, 0xa8, 0x07, 0x9b, 0x21, 0xb0, 0x9e, 0x69, 0x38, 0xc4, 0x9f, 0xe8, 0xcf, 0x4e,
+	0xd8, 0x6f, 0xf0, 0xb6, 0x17, 0xbc, 0xf8, 0xc7, 0x8e, 0xa5, 0x19, 0x7d, 0xf2, 0xd2, 0x6f, 0x04,
+	0x06, 0xa8, 0x30, 0x20, 0xfa, 0x5b, 0x8e, 0x23, 0x58, 0x0f, 0xdf, 0xef, 0xb8, 0xa6, 0xda, 0x5c,
+	0x68, 0xaa, 0xd0, 0xe5, 0x0e, 0x37, 0xd5, 0x19, 0x14, 0x1c, 0xd3, 0x19, 0xca, 0x16, 0xfe, 0xe3,
+	0x91, 0x66, 0x61, 0x35, 0x18, 0xab, 0x8a, 0xdf, 0x20, 0x56, 0x6d, 0x10, 0x34, 0x89, 0x83, 0x05,
+	0x02, 0xd6, 0x27, 0xfc, 0x62, 0xe7, 0x21, 0xc5, 0x7c, 0x6f, 0x01, 0xa6, 0x64, 0xea, 0x98, 0xa0,
+	0xb1, 0x0b, 0xa0, 0x43, 0x00, 0x4b, 0x71, 0xb0, 0xac, 0x6b, 0x03, 0xcd, 0x29, 0x3c, 0xa2, 0x08,
+	0xbf, 0xb7, 0x08, 0x41, 0x71, 0x70, 0x83, 0xf0, 0x13, 0x98, 0xb4, 0xe5, 0xb6, 0x50, 0x0f, 0xd6,
+	0x3c, 0xf5, 0x9d, 0x2b, 0xf6, 0xb9, 0x3c, 0x34, 0x75, 0xad, 0x37, 0x2e, 0x3c, 0xa6, 0xa8, 0xaf,
+	0x16, 0xa0, 0xba, 0xb7, 0x37, 0x07, 0x8a, 0x7d, 0xde, 0xa6, 0x82, 0x12, 0x1a, 0x4e, 0xd1, 0xa6,
+	0xa2, 0xb3, 0x77, 0xf4, 0xb4, 0x0b, 0x4f, 0x6e, 0x17, 0x9d, 0xdd, 0xf3, 0x50, 0x38, 0x3a, 0x07,
+	0xf0, 0x9e, 0x2e, 0x1f, 0x9d, 0x7d, 0xac, 0x36, 0x3c, 0x08, 0xc6, 0x3b, 0x4c, 0xd0, 0xae, 0x34,
+	0x43, 0x35, 0xaf, 0x0a, 0x5b, 0x8b, 0xbc, 0x68, 0x6d, 0xe8, 0x85, 0xb9, 0x9a, 0xaa, 0x39, 0x9f,
+	0x53, 0x31, 0x54, 0x85, 0x15, 0x96, 0xcf, 0xb9, 0x1f, 0x16, 0xda, 0x85, 0x67, 0xcb, 0x25, 0x4f,
+	0xfe, 0x17, 0x8b, 0x36, 0xfa, 0x8c, 0xad, 0x31, 0xf0, 0xcd, 0x22, 0xdd, 0x81, 0x4a, 0xcb, 0xc5,
+	0xb4, 0xd0, 0xb7, 0x8f, 0xb6, 0x1b, 0x84, 0x02, 0x60, 0xc1, 0x0c, 0xea, 0xf9, 0x72, 0x41, 0xc8,
+	0xc7, 0x0c, 0xe4, 0x51, 0x7f, 0x08, 0x39, 0x82, 0x4c, 0x3f, 0xd6, 0xa3, 0x13, 0x7c, 0x67, 0x31,
+	0x58, 0x66, 0xa0, 0x5c, 0xf3, 0x0f, 0xfd, 0xbc, 0x28, 0x46, 0x01, 0xe8, 0x17, 0x8a, 0xee, 0xac,
+	0xde, 0x5d, 0x2e, 0x8a, 0x11, 0xa0, 0x0e, 0x91, 0xe3, 0x13, 0xfa, 0x0a, 0x1e, 0x7a, 0x78, 0x81,
+	0xaf, 0x1a, 0x5d, 0xd4, 0xf7, 0x16, 0xa3, 0x16, 0x38, 0x6a, 0xd5, 0x97, 0xe6, 0xd8, 0x3f, 0x85,
+	0x0c, 0xf5, 0x3b, 0xfa, 0x95, 0x81, 0x5d, 0xf8, 0xc1, 0x62, 0x2c, 0x20, 0xfe, 0xc6, 0xd8, 0x51,
+	0x1b, 0x36, 0x68, 0xbe, 0xc8, 0x37, 0x17, 0xc7, 0xc2, 0xca, 0x80, 0x05, 0xec, 0xed, 0xc5, 0x40,
+	0xc4, 0x19, 0xba, 0x6c, 0x7b, 0xa1, 0x82, 0x3c, 0x62, 0xdf, 0x0f, 0x22, 0xb2, 0x7d, 0xc7, 0x2e,
+	0xfc, 0x70, 0x39, 0x1f, 0xe9, 0x06, 0x77, 0x2b, 0xbb, 0xf8, 0x0b, 0xc8, 0x85, 0xc2, 0xd7, 0x44,
+	0xfd, 0x2e, 0x72, 0xfb, 0xfa, 0x5d, 0xf1, 0x6f, 0x22, 0x90, 0xe2, 0xe1, 0x0b, 0x55, 0x79, 0xd0,
+	0x8b, 0xd0, 0x6a, 0xc7, 0xfb, 0xcb, 0x05, 0x3d, 0xfa, 0x3f, 0xbb, 0xd0, 0xa7, 0xd2, 0x45, 0x0c,
+	0x69, 0x8f, 0x34, 0xe3, 0x16, 0x7a, 0x2f, 0x7c, 0x0b, 0x7d, 0xbb, 0x70, 0x1d, 0xb8, 0x9d, 0x7e,
+	0x06, 0x69, 0x6f, 0xf7, 0xf1, 0x7f, 0xf8, 0x18, 0xa1, 0x17, 0xf1, 0xac, 0x51, 0xfc, 0x02, 0xd2,
+	0x5e, 0x5c, 0x25, 0x2c, 0xa7, 0x23, 0xcb, 0x76, 0xdc, 0x0f, 0x6e, 0x68, 0x03, 0xbd, 0x06, 0x41,
+	0x33, 0x1c, 0x6c, 0x5d, 0x2a, 0x3a, 0x9f, 0xd0, 0x4d, 0x3f, 0xfe, 0x73, 0x59, 0x8b, 0xff, 0x12,
+	0x81, 0x6c, 0x30, 0x64, 0xa3, 0x2f, 0x43, 0x31, 0x9f, 0x29, 0xf0, 0x93, 0x5b, 0xc4, 0x7c, 0xbf,
+	0xc1, 0x54, 0xe9, 0x6f, 0x01, 0xc5, 0x33, 0xc8, 0x87, 0x3b, 0x67, 0x28, 0xf5, 0x67, 0x61, 0xa5,
+	0x6e, 0x2f, 0x3b, 0x72, 0x50, 0xa1, 0xbf, 0x8e, 0x02, 0x9a, 0xde, 0x30, 0xd0, 0x97, 0x90, 0x56,
+	0xf4, 0xbe, 0x69, 0x69, 0xce, 0xf9, 0x80, 0x0e, 0x99, 0xdf, 0xfd, 0xf4, 0xd6, 0xdb, 0xce, 0x4e,
+	0xd9, 0x85, 0x90, 0x7c, 0x34, 0x72, 0xa6, 0x3f, 0xed, 0x59, 0xe3, 0xa1, 0x23, 0xf7, 0x4c, 0xdb,
+	0xe1, 0xf5, 0x15, 0x60, 0xa4, 0x8a, 0x69, 0xd3, 0x43, 0xbf, 0x62, 0xf5, 0x4d, 0x63, 0x97, 0x26,
+	0x3a, 0xee, 0x0f, 0x26, 0x19, 0x89, 0x64, 0x31, 0xe8, 0x39, 0xe4, 0x38, 0xc3, 0x00, 0x0f, 0x4c,
+	0x6b, 0xec, 0x96, 0x29, 0x19, 0xf1, 0x88, 0xd2, 0xd0, 0xbb, 0x90, 0x77, 0x51, 0xce, 0x2d, 0xac,
+	0xa8, 0x6e, 0x6d, 0x97, 0x8b, 0x76, 0x18, 0xb1, 0xb4, 0x0b, 0x69, 0x6f, 0x96, 0xe1, 0x0a, 0x19,
+	0x40, 0x72, 0xaf, 0x22, 0x7d, 0xd9, 0xee, 0xb0, 0x6b, 0xde, 0xb2, 0xf4, 0xa6, 0xd5, 0xdc, 0xad,
+	0x57, 0xc5, 0x68, 0xe9, 0x2f, 0xa3, 0x00, 0x95, 0x91, 0xed, 0x98, 0x83, 0xaa, 0xe2, 0x28, 0xee,
+	0x2d, 0x04, 0x4d, 0xa0, 0x78, 0x5d, 0xe5, 0x02, 0x8f, 0x69, 0x1e, 0x84, 0x20, 0x7e, 0x81, 0xc7,
+	0xaf, 0xdc, 0x9f, 0x7b, 0x93, 0x67, 0x4e, 0xdb, 0xe5, 0xeb, 0xa2, 0xcf, 0x9c, 0xf6, 0x01, 0x5f,
+	0x08, 0x7d, 0xe6, 0xb4, 0x0f, 0xf9, 0xb4, 0xe9, 0x33, 0xa7, 0xbd, 0xe6, 0xa5, 0x68, 0xfa, 0x3c,
+	0x51, 0xbb, 0x49, 0xbd, 0x45, 0x71, 0x49, 0xb8, 0x55, 0xfd, 0x74, 0x1b, 0xe2, 0xaa, 0xe2, 0x28,
+	0xfc, 0x60, 0x3c, 0xfb, 0xbb, 0x12, 0xca, 0x51, 0xfa, 0xab, 0x18, 0xe4, 0x42, 0x31, 0x0d, 0xbd,
+	0x80, 0xd5, 0x89, 0x54, 0xde, 0xab, 0x49, 0xad, 0x84, 0x72, 0xf5, 0x9b, 0x6a, 0x71, 0x77, 0x75,
+	0x5f, 0xc0, 0xff, 0x8c, 0x41, 0x62, 0xf6, 0x9f, 0x31, 0x48, 0x4e, 0xfc, 0x19, 0x03, 0xf7, 0xbe,
+	0x29, 0x15, 0xb8, 0x6f, 0xda, 0x04, 0x61, 0xa0, 0xbe, 0x66, 0xf7, 0x56, 0x02, 0xbb, 0xb7, 0x1a,
+	0xa8, 0xaf, 0xf9, 0xd7, 0x34, 0x81, 0xdf, 0x8d, 0xce, 0xf8, 0xe2, 0x34, 0xa8, 0x9c, 0xef, 0xf2,
+	0x17, 0x41, 0x7b, 0x0f, 0xbf, 0xda, 0x64, 0x83, 0x9b, 0x56, 0xff, 0x25, 0x7d, 0x7a, 0x79, 0x8a,
+	0x5f, 0xb2, 0x69, 0x9c, 0x26, 0xa9, 0xd4, 0x07, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x50, 0xaf,
+	0xe1, 0x54, 0xc5, 0x46, 0x00, 0x00,
 }
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+)
+
+func (p *Pool) IdCol() int64 {
+	return p.PoolId
+}
+
+func (p *Pool) SetCreatedTime(now time.Time) {
+	p.CreatedTs = ToTspb(now)
+}
+
+func (p *Pool) SetModifiedTime(now time.Time) {
+	p.ModifiedTs = ToTspb(now)
+}
+
+func (pp *PoolPic) PoolIdCol() int64 {
+	return pp.PoolId
+}
+
+func (pp *PoolPic) PicIdCol() int64 {
+	return pp.PicId
+}
+
+func (pp *PoolPic) PositionCol() int64 {
+	return pp.Position
+}
+
+func (pp *PoolPic) SetCreatedTime(now time.Time) {
+	pp.CreatedTs = ToTspb(now)
+}
+
+func (pp *PoolPic) SetModifiedTime(now time.Time) {
+	pp.ModifiedTs = ToTspb(now)
+}
@@ -660,6 +660,793 @@ func (m *CustomDataRow) GetData() *schema.CustomData {
 	return nil
 }
 
+type UploadSessionRow struct {
+	Id                   int64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModifiedTs           int64                 `protobuf:"varint,2,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	UserId               int64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data                 *schema.UploadSession `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UploadSessionRow) Reset()         { *m = UploadSessionRow{} }
+func (m *UploadSessionRow) String() string { return proto.CompactTextString(m) }
+func (*UploadSessionRow) ProtoMessage()    {}
+func (*UploadSessionRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{10}
+}
+
+func (m *UploadSessionRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadSessionRow.Unmarshal(m, b)
+}
+func (m *UploadSessionRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadSessionRow.Marshal(b, m, deterministic)
+}
+func (m *UploadSessionRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadSessionRow.Merge(m, src)
+}
+func (m *UploadSessionRow) XXX_Size() int {
+	return xxx_messageInfo_UploadSessionRow.Size(m)
+}
+func (m *UploadSessionRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadSessionRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadSessionRow proto.InternalMessageInfo
+
+func (m *UploadSessionRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UploadSessionRow) GetModifiedTs() int64 {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return 0
+}
+
+func (m *UploadSessionRow) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *UploadSessionRow) GetData() *schema.UploadSession {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type InviteCodeRow struct {
+	Id                   int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CodeHash             []byte             `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	Data                 *schema.InviteCode `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *InviteCodeRow) Reset()         { *m = InviteCodeRow{} }
+func (m *InviteCodeRow) String() string { return proto.CompactTextString(m) }
+func (*InviteCodeRow) ProtoMessage()    {}
+func (*InviteCodeRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{11}
+}
+
+func (m *InviteCodeRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteCodeRow.Unmarshal(m, b)
+}
+func (m *InviteCodeRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteCodeRow.Marshal(b, m, deterministic)
+}
+func (m *InviteCodeRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteCodeRow.Merge(m, src)
+}
+func (m *InviteCodeRow) XXX_Size() int {
+	return xxx_messageInfo_InviteCodeRow.Size(m)
+}
+func (m *InviteCodeRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteCodeRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteCodeRow proto.InternalMessageInfo
+
+func (m *InviteCodeRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *InviteCodeRow) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *InviteCodeRow) GetData() *schema.InviteCode {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ApiKeyRow struct {
+	Id                   int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyHash              []byte         `protobuf:"bytes,2,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	UserId               int64          `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data                 *schema.ApiKey `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ApiKeyRow) Reset()         { *m = ApiKeyRow{} }
+func (m *ApiKeyRow) String() string { return proto.CompactTextString(m) }
+func (*ApiKeyRow) ProtoMessage()    {}
+func (*ApiKeyRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{12}
+}
+
+func (m *ApiKeyRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKeyRow.Unmarshal(m, b)
+}
+func (m *ApiKeyRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKeyRow.Marshal(b, m, deterministic)
+}
+func (m *ApiKeyRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKeyRow.Merge(m, src)
+}
+func (m *ApiKeyRow) XXX_Size() int {
+	return xxx_messageInfo_ApiKeyRow.Size(m)
+}
+func (m *ApiKeyRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKeyRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKeyRow proto.InternalMessageInfo
+
+func (m *ApiKeyRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ApiKeyRow) GetKeyHash() []byte {
+	if m != nil {
+		return m.KeyHash
+	}
+	return nil
+}
+
+func (m *ApiKeyRow) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *ApiKeyRow) GetData() *schema.ApiKey {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type AuditLogRow struct {
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action               schema.AuditLog_Action `protobuf:"varint,2,opt,name=action,proto3,enum=pixur.be.schema.AuditLog_Action" json:"action,omitempty"`
+	ActorUserId          int64                  `protobuf:"varint,3,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	TargetUserId         int64                  `protobuf:"varint,4,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	TargetPicId          int64                  `protobuf:"varint,5,opt,name=target_pic_id,json=targetPicId,proto3" json:"target_pic_id,omitempty"`
+	Data                 *schema.AuditLog       `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *AuditLogRow) Reset()         { *m = AuditLogRow{} }
+func (m *AuditLogRow) String() string { return proto.CompactTextString(m) }
+func (*AuditLogRow) ProtoMessage()    {}
+func (*AuditLogRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{13}
+}
+
+func (m *AuditLogRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogRow.Unmarshal(m, b)
+}
+func (m *AuditLogRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogRow.Marshal(b, m, deterministic)
+}
+func (m *AuditLogRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogRow.Merge(m, src)
+}
+func (m *AuditLogRow) XXX_Size() int {
+	return xxx_messageInfo_AuditLogRow.Size(m)
+}
+func (m *AuditLogRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogRow proto.InternalMessageInfo
+
+func (m *AuditLogRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditLogRow) GetAction() schema.AuditLog_Action {
+	if m != nil {
+		return m.Action
+	}
+	return schema.AuditLog_UNKNOWN
+}
+
+func (m *AuditLogRow) GetActorUserId() int64 {
+	if m != nil {
+		return m.ActorUserId
+	}
+	return 0
+}
+
+func (m *AuditLogRow) GetTargetUserId() int64 {
+	if m != nil {
+		return m.TargetUserId
+	}
+	return 0
+}
+
+func (m *AuditLogRow) GetTargetPicId() int64 {
+	if m != nil {
+		return m.TargetPicId
+	}
+	return 0
+}
+
+func (m *AuditLogRow) GetData() *schema.AuditLog {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type UserLinkRow struct {
+	Id                   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider             string           `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Subject              string           `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	UserId               int64            `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data                 *schema.UserLink `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UserLinkRow) Reset()         { *m = UserLinkRow{} }
+func (m *UserLinkRow) String() string { return proto.CompactTextString(m) }
+func (*UserLinkRow) ProtoMessage()    {}
+func (*UserLinkRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{14}
+}
+
+func (m *UserLinkRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserLinkRow.Unmarshal(m, b)
+}
+func (m *UserLinkRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserLinkRow.Marshal(b, m, deterministic)
+}
+func (m *UserLinkRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLinkRow.Merge(m, src)
+}
+func (m *UserLinkRow) XXX_Size() int {
+	return xxx_messageInfo_UserLinkRow.Size(m)
+}
+func (m *UserLinkRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLinkRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserLinkRow proto.InternalMessageInfo
+
+func (m *UserLinkRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UserLinkRow) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *UserLinkRow) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *UserLinkRow) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *UserLinkRow) GetData() *schema.UserLink {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type LoginFailureRow struct {
+	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject              string               `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Data                 *schema.LoginFailure `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *LoginFailureRow) Reset()         { *m = LoginFailureRow{} }
+func (m *LoginFailureRow) String() string { return proto.CompactTextString(m) }
+func (*LoginFailureRow) ProtoMessage()    {}
+func (*LoginFailureRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{15}
+}
+
+func (m *LoginFailureRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginFailureRow.Unmarshal(m, b)
+}
+func (m *LoginFailureRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginFailureRow.Marshal(b, m, deterministic)
+}
+func (m *LoginFailureRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginFailureRow.Merge(m, src)
+}
+func (m *LoginFailureRow) XXX_Size() int {
+	return xxx_messageInfo_LoginFailureRow.Size(m)
+}
+func (m *LoginFailureRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginFailureRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginFailureRow proto.InternalMessageInfo
+
+func (m *LoginFailureRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LoginFailureRow) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *LoginFailureRow) GetData() *schema.LoginFailure {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type PicFavoriteRow struct {
+	Id                   int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PicId                int64               `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	UserId               int64               `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data                 *schema.PicFavorite `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PicFavoriteRow) Reset()         { *m = PicFavoriteRow{} }
+func (m *PicFavoriteRow) String() string { return proto.CompactTextString(m) }
+func (*PicFavoriteRow) ProtoMessage()    {}
+func (*PicFavoriteRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{16}
+}
+
+func (m *PicFavoriteRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicFavoriteRow.Unmarshal(m, b)
+}
+func (m *PicFavoriteRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicFavoriteRow.Marshal(b, m, deterministic)
+}
+func (m *PicFavoriteRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicFavoriteRow.Merge(m, src)
+}
+func (m *PicFavoriteRow) XXX_Size() int {
+	return xxx_messageInfo_PicFavoriteRow.Size(m)
+}
+func (m *PicFavoriteRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicFavoriteRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PicFavoriteRow proto.InternalMessageInfo
+
+func (m *PicFavoriteRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PicFavoriteRow) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+func (m *PicFavoriteRow) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *PicFavoriteRow) GetData() *schema.PicFavorite {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type CollectionRow struct {
+	Id                   int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               int64              `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data                 *schema.Collection `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CollectionRow) Reset()         { *m = CollectionRow{} }
+func (m *CollectionRow) String() string { return proto.CompactTextString(m) }
+func (*CollectionRow) ProtoMessage()    {}
+func (*CollectionRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{17}
+}
+
+func (m *CollectionRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionRow.Unmarshal(m, b)
+}
+func (m *CollectionRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionRow.Marshal(b, m, deterministic)
+}
+func (m *CollectionRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionRow.Merge(m, src)
+}
+func (m *CollectionRow) XXX_Size() int {
+	return xxx_messageInfo_CollectionRow.Size(m)
+}
+func (m *CollectionRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionRow proto.InternalMessageInfo
+
+func (m *CollectionRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CollectionRow) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *CollectionRow) GetData() *schema.Collection {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type CollectionPicRow struct {
+	CollectionId         int64                 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PicId                int64                 `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	Position             int64                 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Data                 *schema.CollectionPic `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CollectionPicRow) Reset()         { *m = CollectionPicRow{} }
+func (m *CollectionPicRow) String() string { return proto.CompactTextString(m) }
+func (*CollectionPicRow) ProtoMessage()    {}
+func (*CollectionPicRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{18}
+}
+
+func (m *CollectionPicRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionPicRow.Unmarshal(m, b)
+}
+func (m *CollectionPicRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionPicRow.Marshal(b, m, deterministic)
+}
+func (m *CollectionPicRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionPicRow.Merge(m, src)
+}
+func (m *CollectionPicRow) XXX_Size() int {
+	return xxx_messageInfo_CollectionPicRow.Size(m)
+}
+func (m *CollectionPicRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionPicRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionPicRow proto.InternalMessageInfo
+
+func (m *CollectionPicRow) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *CollectionPicRow) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+func (m *CollectionPicRow) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *CollectionPicRow) GetData() *schema.CollectionPic {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type PoolRow struct {
+	Id                   int64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data                 *schema.Pool `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PoolRow) Reset()         { *m = PoolRow{} }
+func (m *PoolRow) String() string { return proto.CompactTextString(m) }
+func (*PoolRow) ProtoMessage()    {}
+func (*PoolRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{19}
+}
+
+func (m *PoolRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolRow.Unmarshal(m, b)
+}
+func (m *PoolRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolRow.Marshal(b, m, deterministic)
+}
+func (m *PoolRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRow.Merge(m, src)
+}
+func (m *PoolRow) XXX_Size() int {
+	return xxx_messageInfo_PoolRow.Size(m)
+}
+func (m *PoolRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRow proto.InternalMessageInfo
+
+func (m *PoolRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PoolRow) GetData() *schema.Pool {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type PoolPicRow struct {
+	PoolId               int64           `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PicId                int64           `protobuf:"varint,2,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	Position             int64           `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Data                 *schema.PoolPic `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PoolPicRow) Reset()         { *m = PoolPicRow{} }
+func (m *PoolPicRow) String() string { return proto.CompactTextString(m) }
+func (*PoolPicRow) ProtoMessage()    {}
+func (*PoolPicRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{20}
+}
+
+func (m *PoolPicRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolPicRow.Unmarshal(m, b)
+}
+func (m *PoolPicRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolPicRow.Marshal(b, m, deterministic)
+}
+func (m *PoolPicRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPicRow.Merge(m, src)
+}
+func (m *PoolPicRow) XXX_Size() int {
+	return xxx_messageInfo_PoolPicRow.Size(m)
+}
+func (m *PoolPicRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPicRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPicRow proto.InternalMessageInfo
+
+func (m *PoolPicRow) GetPoolId() int64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolPicRow) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+func (m *PoolPicRow) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *PoolPicRow) GetData() *schema.PoolPic {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type UserFollowRow struct {
+	UserId               int64              `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FolloweeUserId       int64              `protobuf:"varint,2,opt,name=followee_user_id,json=followeeUserId,proto3" json:"followee_user_id,omitempty"`
+	Data                 *schema.UserFollow `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *UserFollowRow) Reset()         { *m = UserFollowRow{} }
+func (m *UserFollowRow) String() string { return proto.CompactTextString(m) }
+func (*UserFollowRow) ProtoMessage()    {}
+func (*UserFollowRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{21}
+}
+
+func (m *UserFollowRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserFollowRow.Unmarshal(m, b)
+}
+func (m *UserFollowRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserFollowRow.Marshal(b, m, deterministic)
+}
+func (m *UserFollowRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserFollowRow.Merge(m, src)
+}
+func (m *UserFollowRow) XXX_Size() int {
+	return xxx_messageInfo_UserFollowRow.Size(m)
+}
+func (m *UserFollowRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserFollowRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserFollowRow proto.InternalMessageInfo
+
+func (m *UserFollowRow) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *UserFollowRow) GetFolloweeUserId() int64 {
+	if m != nil {
+		return m.FolloweeUserId
+	}
+	return 0
+}
+
+func (m *UserFollowRow) GetData() *schema.UserFollow {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type TagFollowRow struct {
+	UserId               int64             `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TagName              string            `protobuf:"bytes,2,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	Data                 *schema.TagFollow `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TagFollowRow) Reset()         { *m = TagFollowRow{} }
+func (m *TagFollowRow) String() string { return proto.CompactTextString(m) }
+func (*TagFollowRow) ProtoMessage()    {}
+func (*TagFollowRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{22}
+}
+
+func (m *TagFollowRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagFollowRow.Unmarshal(m, b)
+}
+func (m *TagFollowRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagFollowRow.Marshal(b, m, deterministic)
+}
+func (m *TagFollowRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagFollowRow.Merge(m, src)
+}
+func (m *TagFollowRow) XXX_Size() int {
+	return xxx_messageInfo_TagFollowRow.Size(m)
+}
+func (m *TagFollowRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagFollowRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagFollowRow proto.InternalMessageInfo
+
+func (m *TagFollowRow) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TagFollowRow) GetTagName() string {
+	if m != nil {
+		return m.TagName
+	}
+	return ""
+}
+
+func (m *TagFollowRow) GetData() *schema.TagFollow {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PicRow)(nil), "pixur.be.schema.tables.PicRow")
 	proto.RegisterType((*TagRow)(nil), "pixur.be.schema.tables.TagRow")
@@ -671,73 +1458,142 @@ func init() {
 	proto.RegisterType((*UserRow)(nil), "pixur.be.schema.tables.UserRow")
 	proto.RegisterType((*UserEventRow)(nil), "pixur.be.schema.tables.UserEventRow")
 	proto.RegisterType((*CustomDataRow)(nil), "pixur.be.schema.tables.CustomDataRow")
+	proto.RegisterType((*UploadSessionRow)(nil), "pixur.be.schema.tables.UploadSessionRow")
+	proto.RegisterType((*InviteCodeRow)(nil), "pixur.be.schema.tables.InviteCodeRow")
+	proto.RegisterType((*ApiKeyRow)(nil), "pixur.be.schema.tables.ApiKeyRow")
+	proto.RegisterType((*AuditLogRow)(nil), "pixur.be.schema.tables.AuditLogRow")
+	proto.RegisterType((*UserLinkRow)(nil), "pixur.be.schema.tables.UserLinkRow")
+	proto.RegisterType((*LoginFailureRow)(nil), "pixur.be.schema.tables.LoginFailureRow")
+	proto.RegisterType((*PicFavoriteRow)(nil), "pixur.be.schema.tables.PicFavoriteRow")
+	proto.RegisterType((*CollectionRow)(nil), "pixur.be.schema.tables.CollectionRow")
+	proto.RegisterType((*CollectionPicRow)(nil), "pixur.be.schema.tables.CollectionPicRow")
+	proto.RegisterType((*PoolRow)(nil), "pixur.be.schema.tables.PoolRow")
+	proto.RegisterType((*PoolPicRow)(nil), "pixur.be.schema.tables.PoolPicRow")
+	proto.RegisterType((*UserFollowRow)(nil), "pixur.be.schema.tables.UserFollowRow")
+	proto.RegisterType((*TagFollowRow)(nil), "pixur.be.schema.tables.TagFollowRow")
 }
 
 func init() { proto.RegisterFile("tables.proto", fileDescriptor_9e429e24f449e1ed) }
 
 var fileDescriptor_9e429e24f449e1ed = []byte{
-	// 1004 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
-	0x17, 0x05, 0xa9, 0x97, 0x75, 0x25, 0xdb, 0xcc, 0x7c, 0x5f, 0x1c, 0x87, 0x41, 0xed, 0x31, 0x51,
-	0x17, 0x6a, 0x93, 0x4a, 0xb5, 0x64, 0x17, 0x6d, 0x91, 0x02, 0xa9, 0x9c, 0x2e, 0x92, 0x14, 0xa9,
-	0xe0, 0x28, 0x59, 0x74, 0x23, 0x50, 0xe4, 0x40, 0x26, 0x6c, 0x89, 0x2a, 0x49, 0x25, 0xd1, 0x6e,
-	0xba, 0x9d, 0x45, 0x97, 0x5d, 0x76, 0xdf, 0x65, 0xff, 0x4e, 0x57, 0xfd, 0x0f, 0x5d, 0x17, 0x28,
-	0xe6, 0xc5, 0x87, 0x23, 0x39, 0x30, 0x50, 0x74, 0x63, 0xcc, 0xdc, 0x39, 0x77, 0xee, 0x39, 0xe7,
-	0x8e, 0xae, 0x09, 0xcd, 0xc4, 0x1d, 0x5f, 0x92, 0xb8, 0x3d, 0x8f, 0xc2, 0x24, 0x44, 0x3b, 0xf3,
-	0xe0, 0xed, 0x22, 0x6a, 0x8f, 0x49, 0x3b, 0xf6, 0xce, 0xc9, 0xd4, 0x6d, 0xcb, 0x53, 0xfb, 0x50,
-	0xc6, 0xc3, 0x68, 0xd2, 0x11, 0xab, 0xce, 0x98, 0x74, 0x24, 0x42, 0xee, 0x65, 0xba, 0xdd, 0x5e,
-	0x0f, 0xf3, 0xc7, 0x9d, 0x69, 0xe8, 0x93, 0x4b, 0xf9, 0x57, 0xe2, 0x9d, 0xbf, 0x4d, 0xa8, 0x0e,
-	0x02, 0xef, 0x2c, 0x7c, 0x83, 0xee, 0x81, 0x19, 0xf8, 0xbb, 0x06, 0x36, 0x5a, 0xa5, 0x7e, 0x83,
-	0x51, 0x5c, 0x83, 0xca, 0x13, 0xff, 0x34, 0xbc, 0x3c, 0x33, 0x03, 0x1f, 0x1d, 0x43, 0x23, 0x98,
-	0xf9, 0xe4, 0xed, 0x28, 0x8c, 0x7c, 0x12, 0xed, 0x9a, 0x02, 0xf5, 0x3f, 0x46, 0xf1, 0x36, 0x6c,
-	0x3e, 0xe1, 0x07, 0xdf, 0xf3, 0x38, 0x47, 0x43, 0x90, 0x6e, 0xd1, 0xe7, 0xd0, 0x88, 0xbd, 0x30,
-	0x22, 0x2a, 0xab, 0x82, 0x8d, 0x56, 0xa5, 0x7f, 0x9b, 0x51, 0x7c, 0x0b, 0xb6, 0xbf, 0x0b, 0xdf,
-	0x90, 0xe8, 0x05, 0x3f, 0xed, 0x87, 0x8b, 0x99, 0x7f, 0x06, 0x02, 0x99, 0xcb, 0x3b, 0x27, 0xbe,
-	0xca, 0xab, 0xe6, 0xf3, 0x5e, 0xce, 0xe7, 0x57, 0xf3, 0xce, 0x89, 0x2f, 0xf3, 0x5a, 0x50, 0xf6,
-	0xdd, 0xc4, 0xdd, 0x2d, 0x63, 0xa3, 0xd5, 0xe8, 0xfe, 0xbf, 0x7d, 0xd5, 0x4b, 0xae, 0x54, 0x20,
-	0xbe, 0x5a, 0x30, 0x8a, 0x7f, 0x84, 0xf2, 0x20, 0xf0, 0x62, 0x54, 0xe5, 0xc2, 0x2d, 0x03, 0xed,
-	0x17, 0x34, 0x8a, 0xa0, 0x69, 0x43, 0xa6, 0x8e, 0x03, 0x72, 0x72, 0x34, 0xe0, 0x45, 0xc6, 0x7b,
-	0xbf, 0xc0, 0x3b, 0x03, 0x68, 0x82, 0x4f, 0xcb, 0x1b, 0x25, 0xab, 0x7c, 0x56, 0x0f, 0xe2, 0xd1,
-	0x79, 0xe0, 0xfb, 0x64, 0xe6, 0xfc, 0x62, 0x40, 0x75, 0xe8, 0x4e, 0xde, 0xeb, 0xff, 0x01, 0x94,
-	0x67, 0xee, 0x94, 0x08, 0xe3, 0xeb, 0xfd, 0x4d, 0x46, 0x71, 0x1d, 0x6a, 0xcf, 0xdd, 0x29, 0xe1,
-	0x00, 0x71, 0x94, 0x8a, 0x2f, 0xad, 0x11, 0xcf, 0xcb, 0x48, 0xf1, 0x0e, 0xa3, 0x78, 0x0f, 0xca,
-	0x43, 0x77, 0x92, 0x89, 0xdf, 0x92, 0x05, 0xac, 0x92, 0x5d, 0xe6, 0xd7, 0x3a, 0xbf, 0x19, 0x50,
-	0x1f, 0x04, 0x9e, 0xe2, 0x76, 0x08, 0xd5, 0x79, 0xe0, 0x8d, 0x52, 0x7e, 0x5b, 0x8c, 0x62, 0x80,
-	0x8d, 0x41, 0xe0, 0x49, 0x8a, 0x95, 0x39, 0x5f, 0x71, 0x58, 0xe2, 0x4e, 0x38, 0xcc, 0xcc, 0xc3,
-	0x86, 0xee, 0x44, 0xc1, 0x12, 0xbe, 0x42, 0xf7, 0x0b, 0x4c, 0xef, 0xac, 0x6a, 0x53, 0x46, 0xf6,
-	0x80, 0x51, 0xfc, 0x01, 0xd4, 0x64, 0x2c, 0x46, 0x48, 0x33, 0xd1, 0xa5, 0x2c, 0xc3, 0xf9, 0xd9,
-	0x84, 0x86, 0xa0, 0x42, 0x66, 0xc9, 0x0d, 0xd8, 0x7e, 0x03, 0xe5, 0x64, 0x39, 0x97, 0x9e, 0x6e,
-	0x75, 0xf7, 0x56, 0xd1, 0x10, 0x57, 0xb6, 0x87, 0xcb, 0x39, 0xd1, 0x9e, 0xf3, 0xb5, 0xf0, 0x9c,
-	0xa7, 0xa2, 0x0f, 0xa1, 0xf2, 0xda, 0xbd, 0x5c, 0x10, 0x21, 0xa5, 0xa9, 0x0b, 0xbd, 0xe2, 0x21,
-	0x51, 0x48, 0x1c, 0xa2, 0x4f, 0x0b, 0xcf, 0xf2, 0xee, 0xda, 0x42, 0x4a, 0xf1, 0x23, 0x46, 0xf1,
-	0x43, 0xe1, 0xbe, 0x88, 0xc6, 0xe8, 0x4e, 0xaa, 0x59, 0x54, 0x55, 0x35, 0x2d, 0x03, 0xed, 0x14,
-	0x03, 0xa6, 0x5d, 0x11, 0x19, 0xce, 0x9f, 0x06, 0x6c, 0x0e, 0x02, 0xef, 0x34, 0x9c, 0x4e, 0x6f,
-	0x66, 0xc9, 0x11, 0x80, 0x27, 0x93, 0xb2, 0x26, 0x22, 0x46, 0xf1, 0x16, 0x34, 0xd5, 0x65, 0x12,
-	0x5e, 0xf7, 0xf4, 0x0e, 0x75, 0x0a, 0xcd, 0xbc, 0xb7, 0x4a, 0x9c, 0xe6, 0x21, 0xe5, 0x3d, 0x66,
-	0x14, 0x3f, 0x12, 0x0d, 0x53, 0xf1, 0x18, 0xed, 0xa4, 0x02, 0x73, 0xe5, 0x2d, 0x03, 0xdd, 0x2d,
-	0xec, 0x4b, 0x76, 0x3d, 0x25, 0xe1, 0xfc, 0x64, 0x02, 0x0c, 0x02, 0xef, 0x55, 0x98, 0x90, 0x1b,
-	0xe8, 0x6b, 0x41, 0x6d, 0x11, 0x93, 0x28, 0x13, 0xb7, 0xcd, 0x28, 0x6e, 0x40, 0xfd, 0x65, 0x4c,
-	0x22, 0x09, 0xac, 0x2e, 0xc4, 0x92, 0x77, 0x56, 0x0c, 0x03, 0xd1, 0xb4, 0xf4, 0x3e, 0x31, 0x0c,
-	0xc4, 0x7d, 0xe2, 0x10, 0x3d, 0x28, 0x88, 0xdf, 0x5d, 0x25, 0x5e, 0x30, 0x94, 0xca, 0x9f, 0x33,
-	0x8a, 0x9f, 0x0a, 0x52, 0x3c, 0x18, 0x23, 0x3b, 0x95, 0xad, 0x59, 0xa9, 0xa2, 0x96, 0x81, 0x9c,
-	0x2c, 0xa6, 0x41, 0xea, 0xac, 0x64, 0x57, 0x25, 0x5d, 0xe7, 0x77, 0x13, 0x6e, 0xa9, 0xcb, 0xfe,
-	0x93, 0x56, 0xe7, 0xdc, 0x2b, 0xfd, 0x1b, 0xee, 0xf5, 0x94, 0x7b, 0x15, 0xe1, 0xde, 0xfe, 0x35,
-	0x4f, 0x27, 0x67, 0xe2, 0xd7, 0x8c, 0xe2, 0x2f, 0x61, 0xbb, 0x78, 0x16, 0xa3, 0x8f, 0x56, 0x3d,
-	0xa1, 0x77, 0x7d, 0x75, 0x7e, 0x35, 0xa0, 0xc6, 0xf9, 0xbe, 0x77, 0xe2, 0x72, 0x09, 0xfc, 0xc7,
-	0xa4, 0x46, 0xae, 0x96, 0xc0, 0x43, 0x52, 0x02, 0x5f, 0xa1, 0x8f, 0x0b, 0x0f, 0xe0, 0xf6, 0x3b,
-	0x12, 0x44, 0x29, 0x49, 0xfc, 0x90, 0x51, 0x7c, 0x00, 0x15, 0x1e, 0xc9, 0xc6, 0xae, 0xa5, 0xaa,
-	0x58, 0x25, 0xfd, 0xdb, 0xfd, 0xcb, 0x80, 0x26, 0xc7, 0x7c, 0xfb, 0x5a, 0xf5, 0x33, 0xe7, 0xba,
-	0x71, 0xbd, 0xeb, 0xbc, 0xa5, 0x11, 0x71, 0x13, 0xe2, 0x8f, 0x92, 0xf8, 0x4a, 0x4b, 0x65, 0x7c,
-	0x18, 0xcb, 0x96, 0xea, 0x5d, 0xd6, 0xa8, 0xd2, 0x75, 0x8d, 0x6a, 0x17, 0x1a, 0x65, 0xaf, 0x54,
-	0x29, 0xf9, 0x4a, 0xa9, 0x9f, 0x31, 0x8a, 0x1f, 0x00, 0xa4, 0xe1, 0x18, 0xed, 0x65, 0xad, 0xc8,
-	0x71, 0xcc, 0xda, 0xf2, 0x87, 0x09, 0x9b, 0xa7, 0x8b, 0x38, 0x09, 0xa7, 0x8f, 0xdd, 0xc4, 0xe5,
-	0xb2, 0xef, 0xc3, 0xc6, 0x05, 0x59, 0x8e, 0xc4, 0x84, 0x96, 0xba, 0x2d, 0x46, 0x71, 0x13, 0xe0,
-	0x19, 0x59, 0xea, 0x21, 0x5c, 0xbb, 0x90, 0x6b, 0xfe, 0xef, 0xf1, 0x82, 0x2c, 0x8f, 0x94, 0x66,
-	0x35, 0xaa, 0x9f, 0x91, 0xe5, 0x91, 0x18, 0xd5, 0xfc, 0x48, 0x41, 0xba, 0x4a, 0x68, 0x06, 0xe9,
-	0x6a, 0x48, 0x57, 0x41, 0x7a, 0xea, 0xd1, 0x66, 0x90, 0x9e, 0x86, 0xf4, 0x14, 0xe4, 0x58, 0x38,
-	0x91, 0x87, 0x1c, 0x6b, 0xc8, 0xb1, 0x82, 0x9c, 0x88, 0xaf, 0x96, 0x3c, 0xe4, 0x44, 0x43, 0x4e,
-	0xd2, 0x99, 0x59, 0x5b, 0x33, 0x33, 0x73, 0x4e, 0x48, 0x43, 0x1f, 0x32, 0x8a, 0xbf, 0x00, 0xc8,
-	0xe2, 0xe8, 0x93, 0xcc, 0x1e, 0xa9, 0x5d, 0xca, 0x93, 0x0a, 0x24, 0x49, 0xc9, 0xc3, 0x32, 0xfa,
-	0xce, 0x0f, 0x78, 0xfd, 0x67, 0xa1, 0xfc, 0xbe, 0x1c, 0x57, 0xc5, 0xf7, 0x60, 0xef, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x2e, 0x70, 0xea, 0x01, 0x8e, 0x0a, 0x00, 0x00,
+	// 1898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x57, 0xf7, 0x7c, 0xbf, 0xf9, 0x70, 0xa7, 0xf2, 0x61, 0x67, 0x96, 0x24, 0x95, 0x66, 0xb3,
+	0x98, 0x4d, 0x3c, 0x5e, 0xdb, 0x09, 0x5a, 0x96, 0x45, 0x5a, 0x3b, 0xbb, 0x11, 0xd9, 0x84, 0x65,
+	0x70, 0x26, 0x11, 0x02, 0xa4, 0xa1, 0xdd, 0x5d, 0x1e, 0x37, 0x9e, 0x99, 0x1e, 0xa6, 0x7b, 0x9c,
+	0xf8, 0xd6, 0x9c, 0x90, 0xfa, 0xb0, 0x47, 0x24, 0x04, 0xca, 0x9d, 0x23, 0x37, 0x24, 0x24, 0x04,
+	0xff, 0x02, 0x47, 0x4e, 0xfc, 0x0f, 0x48, 0xdc, 0x90, 0x50, 0x7d, 0x75, 0x55, 0x7b, 0x66, 0xdc,
+	0x36, 0x82, 0xbd, 0x8c, 0xba, 0x5e, 0xfd, 0xaa, 0xde, 0xef, 0xf7, 0x5e, 0xd5, 0xab, 0xaa, 0x81,
+	0x46, 0xe4, 0x1c, 0x0c, 0x49, 0xd8, 0x99, 0x4c, 0x83, 0x28, 0x40, 0x37, 0x26, 0xfe, 0x9b, 0xd9,
+	0xb4, 0x73, 0x40, 0x3a, 0xa1, 0x7b, 0x44, 0x46, 0x4e, 0x87, 0xf7, 0xb6, 0xef, 0x71, 0x7b, 0x30,
+	0x1d, 0x6c, 0xb2, 0xaf, 0xcd, 0x03, 0xb2, 0xc9, 0x11, 0xbc, 0xcd, 0x87, 0xb7, 0x3b, 0xcb, 0x61,
+	0xde, 0xc1, 0xe6, 0x28, 0xf0, 0xc8, 0x90, 0xff, 0x72, 0xbc, 0xfd, 0x6f, 0x13, 0xca, 0x5d, 0xdf,
+	0xdd, 0x0f, 0x5e, 0xa3, 0x77, 0xc0, 0xf4, 0xbd, 0x35, 0x03, 0x1b, 0xeb, 0x85, 0xbd, 0x7a, 0x12,
+	0xe3, 0x0a, 0x94, 0x9e, 0x7a, 0x8f, 0x83, 0xe1, 0xbe, 0xe9, 0x7b, 0xe8, 0x21, 0xd4, 0xfd, 0xb1,
+	0x47, 0xde, 0xf4, 0x83, 0xa9, 0x47, 0xa6, 0x6b, 0x26, 0x43, 0x5d, 0x4d, 0x62, 0xbc, 0x02, 0xcd,
+	0xa7, 0xb4, 0xe3, 0x07, 0xd4, 0x4e, 0xd1, 0xe0, 0xa7, 0x4d, 0xf4, 0x2d, 0xa8, 0x87, 0x6e, 0x30,
+	0x25, 0x62, 0x54, 0x09, 0x1b, 0xeb, 0xa5, 0xbd, 0xeb, 0x49, 0x8c, 0xaf, 0xc0, 0xca, 0xf3, 0xe0,
+	0x35, 0x99, 0xbe, 0xa0, 0xbd, 0x7b, 0xc1, 0x6c, 0xec, 0xed, 0x03, 0x43, 0x6a, 0xe3, 0x8e, 0x88,
+	0x27, 0xc6, 0x95, 0xf5, 0x71, 0x2f, 0x27, 0x93, 0xb3, 0xe3, 0x8e, 0x88, 0xc7, 0xc7, 0xad, 0x43,
+	0xd1, 0x73, 0x22, 0x67, 0xad, 0x88, 0x8d, 0xf5, 0xfa, 0xf6, 0xb5, 0xce, 0xd9, 0x58, 0x52, 0xa5,
+	0x0c, 0xf1, 0xd1, 0x2c, 0x89, 0xf1, 0x2f, 0xa0, 0xd8, 0xf5, 0xdd, 0x10, 0x95, 0xa9, 0x70, 0xcb,
+	0x40, 0x77, 0x32, 0x1a, 0x99, 0xd1, 0x6c, 0x83, 0x52, 0x47, 0x01, 0x9a, 0x1c, 0x09, 0x78, 0xa1,
+	0x78, 0xdf, 0xc9, 0xf0, 0x56, 0x00, 0x49, 0xf0, 0xf3, 0x62, 0xb5, 0x60, 0x15, 0xf7, 0x6b, 0x7e,
+	0xd8, 0x3f, 0xf2, 0x3d, 0x8f, 0x8c, 0xed, 0x5f, 0x1b, 0x50, 0xee, 0x39, 0x83, 0xdc, 0xf8, 0xdf,
+	0x85, 0xe2, 0xd8, 0x19, 0x11, 0x16, 0xf8, 0xda, 0x5e, 0x33, 0x89, 0x71, 0x0d, 0x2a, 0x5f, 0x38,
+	0x23, 0x42, 0x01, 0xac, 0x2b, 0x15, 0x5f, 0x58, 0x22, 0x9e, 0xba, 0xe1, 0xe2, 0xed, 0x24, 0xc6,
+	0xb7, 0xa1, 0xd8, 0x73, 0x06, 0x4a, 0x7c, 0x8b, 0x3b, 0xb0, 0x0a, 0xed, 0x22, 0x9d, 0xd6, 0xfe,
+	0xbd, 0x01, 0xb5, 0xae, 0xef, 0x0a, 0x6e, 0xf7, 0xa0, 0x3c, 0xf1, 0xdd, 0x7e, 0xca, 0xaf, 0x95,
+	0xc4, 0x18, 0xa0, 0xda, 0xf5, 0x5d, 0x4e, 0xb1, 0x34, 0xa1, 0x5f, 0x14, 0x16, 0x39, 0x03, 0x0a,
+	0x33, 0x75, 0x58, 0xcf, 0x19, 0x08, 0x58, 0x44, 0xbf, 0xd0, 0xfd, 0x0c, 0xd3, 0xd5, 0x45, 0x69,
+	0x52, 0x64, 0xef, 0x26, 0x31, 0xbe, 0x05, 0x15, 0x6e, 0x0b, 0x11, 0x92, 0x4c, 0xa4, 0x2b, 0xcb,
+	0xb0, 0xbf, 0x34, 0xa1, 0xce, 0xa8, 0x90, 0x71, 0x74, 0x09, 0xb6, 0xbb, 0x50, 0x8c, 0x4e, 0x27,
+	0x3c, 0xa6, 0xad, 0xed, 0xdb, 0x8b, 0x68, 0xb0, 0x29, 0x3b, 0xbd, 0xd3, 0x09, 0x91, 0x31, 0xa7,
+	0xdf, 0x2c, 0xe6, 0x74, 0x28, 0x7a, 0x17, 0x4a, 0x27, 0xce, 0x70, 0x46, 0x98, 0x94, 0x86, 0x74,
+	0xf4, 0x8a, 0x9a, 0x98, 0x23, 0xd6, 0x89, 0x36, 0x32, 0xcb, 0xf2, 0xe6, 0x52, 0x47, 0x42, 0xf1,
+	0x27, 0x49, 0x8c, 0x3f, 0x66, 0xd1, 0x67, 0xd6, 0x10, 0xad, 0xa6, 0x9a, 0x99, 0x57, 0xe1, 0xd3,
+	0x32, 0xd0, 0x8d, 0xac, 0xc1, 0x6c, 0x97, 0xd8, 0x08, 0xfb, 0x1f, 0x06, 0x34, 0xbb, 0xbe, 0xfb,
+	0x38, 0x18, 0x8d, 0x2e, 0x17, 0x92, 0x2d, 0x00, 0x97, 0x0f, 0x52, 0x49, 0x44, 0x49, 0x8c, 0x5b,
+	0xd0, 0x10, 0x93, 0x71, 0x78, 0xcd, 0x95, 0x2d, 0xb4, 0x99, 0x49, 0xe6, 0x3b, 0x8b, 0xc4, 0x49,
+	0x1e, 0x5c, 0xde, 0xa7, 0x49, 0x8c, 0x3f, 0x61, 0x09, 0x13, 0xf6, 0x10, 0xdd, 0x48, 0x05, 0x6a,
+	0xee, 0x2d, 0x03, 0xdd, 0xcc, 0xb4, 0x0b, 0xed, 0x5a, 0x4a, 0xc2, 0xfe, 0xa5, 0x09, 0xd0, 0xf5,
+	0xdd, 0x57, 0x41, 0x44, 0x2e, 0xa1, 0x6f, 0x1d, 0x2a, 0xb3, 0x90, 0x4c, 0x95, 0xb8, 0x95, 0x24,
+	0xc6, 0x75, 0xa8, 0xbd, 0x0c, 0xc9, 0x94, 0x03, 0xcb, 0x33, 0xf6, 0x49, 0x33, 0xcb, 0x8a, 0x01,
+	0x4b, 0x5a, 0x3a, 0x1f, 0x2b, 0x06, 0x6c, 0x3e, 0xd6, 0x89, 0x1e, 0x64, 0xc4, 0xaf, 0x2d, 0x12,
+	0xcf, 0x18, 0x72, 0xe5, 0x5f, 0x24, 0x31, 0xfe, 0x9c, 0x91, 0xa2, 0xc6, 0x10, 0xb5, 0x53, 0xd9,
+	0x92, 0x95, 0x70, 0x6a, 0x19, 0xc8, 0x56, 0x36, 0x09, 0x12, 0x7d, 0x85, 0x76, 0x99, 0xd3, 0xb5,
+	0xff, 0x60, 0xc2, 0x15, 0x31, 0xd9, 0x57, 0x92, 0x6a, 0x2d, 0x7a, 0x85, 0xff, 0x45, 0xf4, 0x76,
+	0x44, 0xf4, 0x4a, 0x2c, 0x7a, 0x77, 0xce, 0x59, 0x3a, 0x5a, 0x10, 0xbf, 0x9b, 0xc4, 0xf8, 0xdb,
+	0xb0, 0x92, 0xed, 0x0b, 0xd1, 0x7b, 0x8b, 0x96, 0xd0, 0x7c, 0x5c, 0xed, 0xb7, 0x06, 0x54, 0x28,
+	0xdf, 0xdc, 0x8a, 0x4b, 0x25, 0xd0, 0xcd, 0x24, 0x4a, 0xae, 0x94, 0x40, 0x4d, 0x5c, 0x02, 0xfd,
+	0x42, 0xdf, 0xcc, 0x2c, 0x80, 0xeb, 0x73, 0x12, 0x98, 0x2b, 0x4e, 0xfc, 0x5e, 0x12, 0xe3, 0xbb,
+	0x50, 0xa2, 0x16, 0x55, 0x76, 0x2d, 0xe1, 0xc5, 0x2a, 0xc8, 0xbd, 0xfb, 0x4f, 0x03, 0x1a, 0x14,
+	0xf3, 0xd9, 0x89, 0xc8, 0xa7, 0x16, 0x75, 0xe3, 0xfc, 0xa8, 0xd3, 0x94, 0x4e, 0x89, 0x13, 0x11,
+	0xaf, 0x1f, 0x85, 0x67, 0x52, 0xca, 0xed, 0xbd, 0x90, 0xa7, 0x54, 0xb6, 0x54, 0xa2, 0x0a, 0xe7,
+	0x25, 0xaa, 0x93, 0x49, 0x54, 0x7b, 0xa1, 0x4a, 0xce, 0x97, 0x4b, 0xfd, 0x20, 0x89, 0xf1, 0x03,
+	0x80, 0xd4, 0x1c, 0xa2, 0xdb, 0x2a, 0x15, 0x1a, 0x47, 0x95, 0x96, 0xbf, 0x9b, 0xd0, 0x7c, 0x3c,
+	0x0b, 0xa3, 0x60, 0xf4, 0xa9, 0x13, 0x39, 0x54, 0xf6, 0x7d, 0xa8, 0x1e, 0x93, 0xd3, 0x3e, 0xab,
+	0xd0, 0x5c, 0xb7, 0x95, 0xc4, 0xb8, 0x01, 0xf0, 0x8c, 0x9c, 0xca, 0x22, 0x5c, 0x39, 0xe6, 0xdf,
+	0xf4, 0x78, 0x3c, 0x26, 0xa7, 0x5b, 0x42, 0xb3, 0x28, 0xd5, 0xcf, 0xc8, 0xe9, 0x16, 0x2b, 0xd5,
+	0xb4, 0x4b, 0x40, 0xb6, 0x85, 0x50, 0x05, 0xd9, 0x96, 0x90, 0x6d, 0x01, 0xd9, 0x11, 0x8b, 0x56,
+	0x41, 0x76, 0x24, 0x64, 0x47, 0x40, 0x1e, 0xb2, 0x48, 0xe8, 0x90, 0x87, 0x12, 0xf2, 0x50, 0x40,
+	0x1e, 0xb1, 0x5b, 0x8b, 0x0e, 0x79, 0x24, 0x21, 0x8f, 0xd2, 0x9a, 0x59, 0x59, 0x52, 0x33, 0xb5,
+	0x48, 0xf0, 0x80, 0x7e, 0x9c, 0xc4, 0xf8, 0x43, 0x00, 0x65, 0x47, 0xef, 0xab, 0xf0, 0x70, 0xed,
+	0x5c, 0x1e, 0x57, 0xc0, 0x49, 0x72, 0x1e, 0x96, 0x61, 0xff, 0xd6, 0x04, 0xeb, 0xe5, 0x64, 0x18,
+	0x38, 0xde, 0x0b, 0x12, 0x86, 0x7e, 0x30, 0xbe, 0xc8, 0x75, 0x6f, 0x14, 0x78, 0xfe, 0xa1, 0xaf,
+	0x2f, 0x25, 0x71, 0xdd, 0xfb, 0xbe, 0xe8, 0xe0, 0x6b, 0x09, 0x46, 0x69, 0xf3, 0x12, 0xf5, 0x61,
+	0x3b, 0x73, 0x22, 0xce, 0x1f, 0xbd, 0x59, 0xb6, 0x3c, 0x06, 0xaf, 0x92, 0x18, 0xef, 0x43, 0x2b,
+	0xd3, 0x95, 0xb9, 0xbc, 0x69, 0x8c, 0xe5, 0xd5, 0x4b, 0x71, 0x45, 0xab, 0x6a, 0x05, 0xf2, 0x4e,
+	0x59, 0x45, 0xff, 0x6a, 0xd0, 0x2b, 0xec, 0x89, 0x4f, 0x8b, 0xa8, 0x47, 0x72, 0x43, 0xd3, 0x81,
+	0x9a, 0x1b, 0x78, 0xa4, 0x7f, 0xe4, 0x84, 0x47, 0x2c, 0x30, 0x8d, 0xbd, 0x2b, 0x49, 0x8c, 0x9b,
+	0x50, 0xa7, 0xc3, 0xbf, 0xe7, 0x84, 0x47, 0x14, 0x59, 0x75, 0x45, 0x23, 0xf7, 0x7c, 0xd4, 0x5c,
+	0x73, 0x9d, 0xdb, 0x49, 0x8c, 0x3b, 0x50, 0x57, 0x76, 0x25, 0x72, 0x55, 0xf3, 0x6d, 0x15, 0xda,
+	0x55, 0xe9, 0x91, 0x16, 0x8d, 0xda, 0xee, 0xc4, 0x7f, 0x46, 0x4e, 0x73, 0xf9, 0x8b, 0x7d, 0xa5,
+	0xd1, 0x57, 0xfb, 0x4a, 0xb2, 0xa7, 0xfb, 0x8a, 0x91, 0xbf, 0x78, 0x46, 0xef, 0x67, 0x32, 0x3a,
+	0x7f, 0xa7, 0x13, 0xec, 0xb8, 0xc4, 0xcf, 0x92, 0x18, 0xef, 0x42, 0x85, 0xdb, 0x94, 0xbc, 0xeb,
+	0x8a, 0x9a, 0x55, 0x68, 0x57, 0x04, 0xa1, 0xe5, 0x99, 0xfb, 0x57, 0x01, 0xea, 0xbb, 0x33, 0xcf,
+	0x8f, 0x9e, 0x07, 0xf9, 0x37, 0xe8, 0xa7, 0x50, 0x76, 0xdc, 0xc8, 0x0f, 0xc6, 0xe2, 0xbe, 0x87,
+	0xe7, 0x29, 0x8a, 0xa9, 0x3a, 0xbb, 0x0c, 0x27, 0xb5, 0xf2, 0x16, 0xd3, 0xca, 0x27, 0x40, 0x1f,
+	0x42, 0xd3, 0x71, 0xa3, 0x60, 0xda, 0xcf, 0xc6, 0xe6, 0x5a, 0x12, 0x63, 0x0b, 0x5a, 0xbb, 0xb4,
+	0x4b, 0x05, 0xa8, 0xee, 0xa8, 0x36, 0xfa, 0x0e, 0xb4, 0x22, 0x67, 0x3a, 0x20, 0x51, 0x3a, 0x94,
+	0xd7, 0x1a, 0xf1, 0xb6, 0xe9, 0xb1, 0x3e, 0x35, 0xb6, 0x11, 0x69, 0x06, 0xea, 0x56, 0x0c, 0x16,
+	0xe7, 0x7b, 0x49, 0x77, 0xcb, 0xc7, 0xa6, 0xa7, 0x7c, 0x3d, 0x52, 0xed, 0xf4, 0x02, 0x5a, 0x5e,
+	0x72, 0x01, 0x4d, 0x83, 0xc8, 0xd3, 0xf3, 0xd6, 0x48, 0x62, 0xfc, 0x1b, 0xba, 0xa4, 0x84, 0x5d,
+	0xa5, 0xe8, 0x86, 0x8c, 0xa2, 0x4c, 0x05, 0x8f, 0x0e, 0xb2, 0xcf, 0x84, 0x44, 0x74, 0xd7, 0xb5,
+	0x60, 0xa0, 0x77, 0xcf, 0x8a, 0x17, 0xa0, 0x86, 0x2e, 0x9b, 0xce, 0x94, 0x51, 0x29, 0x67, 0xd2,
+	0xf4, 0xd9, 0x7f, 0x34, 0xa1, 0x4e, 0xe1, 0xcf, 0xfd, 0xf1, 0x71, 0x6e, 0xe2, 0x37, 0xa0, 0x3a,
+	0x99, 0x06, 0x27, 0xbe, 0x7c, 0xb7, 0xd6, 0xe4, 0x7e, 0xed, 0x0a, 0x2b, 0xdb, 0xaf, 0x12, 0x82,
+	0xde, 0x87, 0x4a, 0x38, 0x3b, 0xf8, 0x39, 0x71, 0x23, 0x96, 0xd6, 0x9a, 0xdc, 0x1e, 0x2f, 0xb8,
+	0x91, 0x6d, 0x0f, 0x01, 0xd0, 0xb7, 0x47, 0xf1, 0xfc, 0xed, 0xb1, 0x91, 0x39, 0x41, 0x6f, 0x2e,
+	0x3c, 0x41, 0x99, 0x1a, 0x9e, 0x81, 0x1f, 0x25, 0x31, 0xee, 0xf1, 0x79, 0xa8, 0x55, 0xc5, 0xff,
+	0x3d, 0x25, 0x26, 0xe5, 0x69, 0x15, 0xda, 0x2b, 0x52, 0x8b, 0x60, 0xb9, 0x7c, 0xcf, 0xfc, 0xc5,
+	0xa0, 0x4f, 0xef, 0x81, 0x3f, 0x7e, 0xe2, 0xf8, 0xc3, 0xd9, 0x34, 0xbf, 0xde, 0x69, 0xf1, 0x30,
+	0xf3, 0xe2, 0xb1, 0x95, 0xa9, 0x75, 0xb7, 0xe6, 0x54, 0x66, 0x1c, 0x73, 0xa5, 0x5b, 0x49, 0x8c,
+	0x37, 0xa0, 0xa9, 0xf7, 0x28, 0xb5, 0xd7, 0x74, 0x8d, 0x15, 0xe1, 0xd1, 0xfe, 0x95, 0x09, 0xad,
+	0xae, 0xef, 0x3e, 0x71, 0x4e, 0x82, 0xa9, 0x1f, 0xe5, 0x2b, 0x50, 0x17, 0x62, 0xf3, 0x82, 0x6f,
+	0x83, 0x9c, 0x5a, 0xf7, 0x41, 0xa6, 0xd6, 0x7d, 0x6d, 0xd1, 0xbd, 0x35, 0x25, 0xc7, 0x55, 0xbe,
+	0x4c, 0x62, 0xfc, 0x43, 0x68, 0x68, 0x1d, 0x4a, 0x24, 0x9e, 0x7b, 0x05, 0x58, 0x85, 0x36, 0x7f,
+	0xcf, 0x8a, 0x2d, 0xb1, 0x34, 0x99, 0x7f, 0x32, 0xa0, 0xf9, 0x38, 0x18, 0x0e, 0x09, 0xdb, 0x84,
+	0xb9, 0x81, 0xb8, 0xf8, 0xeb, 0x27, 0xef, 0xd0, 0xd2, 0x9c, 0x66, 0x0e, 0x2d, 0x65, 0xd7, 0x0f,
+	0xad, 0x25, 0xec, 0xff, 0x66, 0x82, 0xa5, 0x06, 0x88, 0x7f, 0xa1, 0x3e, 0x82, 0xa6, 0x9b, 0xda,
+	0xd4, 0x9d, 0x57, 0x14, 0x48, 0x05, 0x17, 0x05, 0xd2, 0xd5, 0x0c, 0x17, 0x4d, 0x34, 0x2d, 0x08,
+	0x41, 0xe8, 0xb3, 0xb3, 0x80, 0x67, 0x5a, 0x16, 0x04, 0x61, 0xe5, 0x05, 0x41, 0x34, 0x72, 0xef,
+	0x2a, 0x59, 0x09, 0x3c, 0x1c, 0x6f, 0x92, 0x18, 0x47, 0xd0, 0xca, 0x74, 0x85, 0xe8, 0xe6, 0x19,
+	0x6d, 0x92, 0xae, 0x65, 0xa0, 0x7b, 0x67, 0xbb, 0x52, 0xff, 0x96, 0xd9, 0xae, 0x4a, 0x62, 0xda,
+	0x5a, 0xc9, 0xc2, 0xe9, 0xd3, 0x9f, 0x97, 0xc6, 0x43, 0xa8, 0x74, 0x83, 0x60, 0x98, 0xbb, 0x16,
+	0xe4, 0xc3, 0xc5, 0x5c, 0xf2, 0x70, 0x61, 0x93, 0x70, 0x31, 0xfc, 0xde, 0x57, 0xa2, 0x96, 0x34,
+	0xab, 0xf6, 0xef, 0xe8, 0xfb, 0x3b, 0x08, 0x86, 0x22, 0x6d, 0xeb, 0x50, 0x99, 0x04, 0xc1, 0x70,
+	0xee, 0x91, 0x42, 0x21, 0x62, 0x69, 0x4d, 0xd8, 0xe7, 0xff, 0x29, 0x49, 0x0f, 0x32, 0x49, 0x5a,
+	0x5b, 0x28, 0x47, 0xa5, 0xe7, 0x67, 0x49, 0x8c, 0x7f, 0x0a, 0x55, 0x61, 0x0c, 0xd1, 0xd5, 0x94,
+	0xbd, 0x96, 0x92, 0x3b, 0xca, 0xb8, 0x38, 0x19, 0xda, 0xf3, 0x5d, 0x00, 0x55, 0x1a, 0xbe, 0x34,
+	0xa1, 0x49, 0x97, 0xf9, 0x93, 0x60, 0x38, 0x0c, 0x5e, 0x5f, 0xee, 0x19, 0xb7, 0x0b, 0xd6, 0x21,
+	0x1b, 0x46, 0x48, 0x3f, 0xbb, 0x5f, 0x57, 0x93, 0x18, 0x5f, 0x85, 0x2b, 0x4f, 0x44, 0xaf, 0x1a,
+	0xda, 0x3a, 0xcc, 0x98, 0x72, 0xf7, 0xaf, 0x46, 0x8d, 0x47, 0xa4, 0x9f, 0xc4, 0xf8, 0x27, 0xfc,
+	0x50, 0xe5, 0xf6, 0x10, 0xdd, 0x52, 0xfb, 0x76, 0x8e, 0x8f, 0x65, 0xa0, 0x8d, 0x79, 0xab, 0x2a,
+	0x60, 0x66, 0xbb, 0x95, 0x25, 0x69, 0xff, 0xd9, 0x80, 0x46, 0xcf, 0x19, 0xfc, 0x37, 0xf1, 0xb8,
+	0x0f, 0xd5, 0xc8, 0x19, 0xf4, 0xb5, 0xff, 0x3f, 0xc5, 0x11, 0xd4, 0x73, 0x06, 0xf2, 0x2f, 0xd0,
+	0x4a, 0xc4, 0xbf, 0xd3, 0xa7, 0x6a, 0x61, 0xc9, 0x53, 0x55, 0x71, 0xe0, 0xc2, 0xbf, 0x91, 0xc4,
+	0xf8, 0xeb, 0x6c, 0x2a, 0xa9, 0xfb, 0xba, 0xd2, 0x9d, 0xfa, 0xb5, 0x8c, 0x3d, 0xfb, 0xc7, 0x78,
+	0xf9, 0x7f, 0xeb, 0xfc, 0x4f, 0xfa, 0x83, 0x32, 0xfb, 0x53, 0x7d, 0xe7, 0x3f, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0x31, 0x78, 0xe5, 0xd0, 0xd3, 0x17, 0x00, 0x00,
 }
@@ -184,6 +184,220 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE TABLE \"UploadSessions\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"modified_ts\" bigint NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"UploadSessionsModifiedTs\" ON \"UploadSessions\" (\"modified_ts\",\"id\");",
+
+		"CREATE INDEX \"UploadSessionsUserId\" ON \"UploadSessions\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"InviteCodes\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"code_hash\" bytea NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"code_hash\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"ApiKeys\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"key_hash\" bytea NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"key_hash\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"ApiKeysUserId\" ON \"ApiKeys\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"AuditLogs\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"action\" integer NOT NULL, " +
+
+			"\"actor_user_id\" bigint NOT NULL, " +
+
+			"\"target_user_id\" bigint NOT NULL, " +
+
+			"\"target_pic_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"AuditLogsAction\" ON \"AuditLogs\" (\"action\",\"id\");",
+
+		"CREATE INDEX \"AuditLogsActorUserId\" ON \"AuditLogs\" (\"actor_user_id\",\"id\");",
+
+		"CREATE INDEX \"AuditLogsTargetUserId\" ON \"AuditLogs\" (\"target_user_id\",\"id\");",
+
+		"CREATE INDEX \"AuditLogsTargetPicId\" ON \"AuditLogs\" (\"target_pic_id\",\"id\");",
+
+		"CREATE TABLE \"UserLinks\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"provider\" bytea NOT NULL, " +
+
+			"\"subject\" bytea NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"provider\",\"subject\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"UserLinksUserId\" ON \"UserLinks\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"LoginFailures\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"subject\" bytea NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"subject\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"PicFavorites\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"pic_id\" bigint NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"pic_id\",\"user_id\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"PicFavoritesUserId\" ON \"PicFavorites\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"Collections\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"CollectionsUserId\" ON \"Collections\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"CollectionPics\" (" +
+
+			"\"collection_id\" bigint NOT NULL, " +
+
+			"\"pic_id\" bigint NOT NULL, " +
+
+			"\"position\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"collection_id\",\"pic_id\")" +
+
+			");",
+
+		"CREATE INDEX \"CollectionPicsPosition\" ON \"CollectionPics\" (\"collection_id\",\"position\");",
+
+		"CREATE INDEX \"CollectionPicsPicId\" ON \"CollectionPics\" (\"pic_id\",\"collection_id\");",
+
+		"CREATE TABLE \"Pools\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"PoolPics\" (" +
+
+			"\"pool_id\" bigint NOT NULL, " +
+
+			"\"pic_id\" bigint NOT NULL, " +
+
+			"\"position\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"pool_id\",\"pic_id\")" +
+
+			");",
+
+		"CREATE INDEX \"PoolPicsPosition\" ON \"PoolPics\" (\"pool_id\",\"position\");",
+
+		"CREATE INDEX \"PoolPicsPicId\" ON \"PoolPics\" (\"pic_id\",\"pool_id\");",
+
+		"CREATE TABLE \"UserFollows\" (" +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"followee_user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"user_id\",\"followee_user_id\")" +
+
+			");",
+
+		"CREATE INDEX \"UserFollowsFolloweeUserId\" ON \"UserFollows\" (\"followee_user_id\",\"user_id\");",
+
+		"CREATE TABLE \"TagFollows\" (" +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"tag_name\" bytea NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"user_id\",\"tag_name\")" +
+
+			");",
+
 		"CREATE TABLE \"_SequenceTable\" (\"the_sequence\" bigint NOT NULL);",
 	},
 
@@ -347,185 +561,236 @@ var SqlTables = map[string][]string{
 
 			");",
 
-		"CREATE TABLE `_SequenceTable` (`the_sequence` bigint(20) NOT NULL);",
-	},
-
-	"postgres": {
-
-		"CREATE TABLE \"Pics\" (" +
-
-			"\"id\" bigint NOT NULL, " +
+		"CREATE TABLE `UploadSessions` (" +
 
-			"\"index_order\" bigint NOT NULL, " +
+			"`id` bigint(20) NOT NULL, " +
 
-			"\"score_order\" integer NOT NULL, " +
+			"`modified_ts` bigint(20) NOT NULL, " +
 
-			"\"sched_order\" integer NOT NULL, " +
+			"`user_id` bigint(20) NOT NULL, " +
 
-			"\"data\" bytea NOT NULL, " +
+			"`data` blob NOT NULL, " +
 
-			"PRIMARY KEY(\"id\")" +
+			"PRIMARY KEY(`id`)" +
 
 			");",
 
-		"CREATE INDEX \"PicsIndexOrder\" ON \"Pics\" (\"index_order\",\"id\");",
-
-		"CREATE INDEX \"PicsScoreOrder\" ON \"Pics\" (\"score_order\",\"id\");",
+		"CREATE INDEX `UploadSessionsModifiedTs` ON `UploadSessions` (`modified_ts`,`id`);",
 
-		"CREATE INDEX \"PicsSchedOrder\" ON \"Pics\" (\"sched_order\",\"id\");",
+		"CREATE INDEX `UploadSessionsUserId` ON `UploadSessions` (`user_id`,`id`);",
 
-		"CREATE TABLE \"Tags\" (" +
+		"CREATE TABLE `InviteCodes` (" +
 
-			"\"id\" bigint NOT NULL, " +
+			"`id` bigint(20) NOT NULL, " +
 
-			"\"name\" bytea NOT NULL, " +
+			"`code_hash` blob NOT NULL, " +
 
-			"\"data\" bytea NOT NULL, " +
+			"`data` blob NOT NULL, " +
 
-			"UNIQUE(\"name\"), " +
+			"UNIQUE(`code_hash`(255)), " +
 
-			"PRIMARY KEY(\"id\")" +
+			"PRIMARY KEY(`id`)" +
 
 			");",
 
-		"CREATE TABLE \"PicTags\" (" +
+		"CREATE TABLE `ApiKeys` (" +
 
-			"\"pic_id\" bigint NOT NULL, " +
+			"`id` bigint(20) NOT NULL, " +
 
-			"\"tag_id\" bigint NOT NULL, " +
+			"`key_hash` blob NOT NULL, " +
 
-			"\"data\" bytea NOT NULL, " +
+			"`user_id` bigint(20) NOT NULL, " +
 
-			"PRIMARY KEY(\"pic_id\",\"tag_id\")" +
+			"`data` blob NOT NULL, " +
+
+			"UNIQUE(`key_hash`(255)), " +
+
+			"PRIMARY KEY(`id`)" +
 
 			");",
 
-		"CREATE TABLE \"PicIdents\" (" +
+		"CREATE INDEX `ApiKeysUserId` ON `ApiKeys` (`user_id`,`id`);",
 
-			"\"pic_id\" bigint NOT NULL, " +
+		"CREATE TABLE `AuditLogs` (" +
 
-			"\"type\" integer NOT NULL, " +
+			"`id` bigint(20) NOT NULL, " +
 
-			"\"value\" bytea NOT NULL, " +
+			"`action` int NOT NULL, " +
 
-			"\"data\" bytea NOT NULL, " +
+			"`actor_user_id` bigint(20) NOT NULL, " +
 
-			"PRIMARY KEY(\"pic_id\",\"type\",\"value\")" +
+			"`target_user_id` bigint(20) NOT NULL, " +
 
-			");",
+			"`target_pic_id` bigint(20) NOT NULL, " +
 
-		"CREATE INDEX \"PicIdentsIdent\" ON \"PicIdents\" (\"type\",\"value\");",
+			"`data` blob NOT NULL, " +
 
-		"CREATE TABLE \"PicComments\" (" +
+			"PRIMARY KEY(`id`)" +
 
-			"\"pic_id\" bigint NOT NULL, " +
+			");",
 
-			"\"comment_id\" bigint NOT NULL, " +
+		"CREATE INDEX `AuditLogsAction` ON `AuditLogs` (`action`,`id`);",
 
-			"\"data\" bytea NOT NULL, " +
+		"CREATE INDEX `AuditLogsActorUserId` ON `AuditLogs` (`actor_user_id`,`id`);",
 
-			"UNIQUE(\"comment_id\"), " +
+		"CREATE INDEX `AuditLogsTargetUserId` ON `AuditLogs` (`target_user_id`,`id`);",
 
-			"PRIMARY KEY(\"pic_id\",\"comment_id\")" +
+		"CREATE INDEX `AuditLogsTargetPicId` ON `AuditLogs` (`target_pic_id`,`id`);",
 
-			");",
+		"CREATE TABLE `UserLinks` (" +
 
-		"CREATE TABLE \"PicVotes\" (" +
+			"`id` bigint(20) NOT NULL, " +
 
-			"\"pic_id\" bigint NOT NULL, " +
+			"`provider` blob NOT NULL, " +
 
-			"\"user_id\" bigint NOT NULL, " +
+			"`subject` blob NOT NULL, " +
 
-			"\"index\" bigint NOT NULL, " +
+			"`user_id` bigint(20) NOT NULL, " +
 
-			"\"data\" bytea NOT NULL, " +
+			"`data` blob NOT NULL, " +
 
-			"UNIQUE(\"user_id\",\"pic_id\",\"index\"), " +
+			"UNIQUE(`provider`(255),`subject`(255)), " +
 
-			"PRIMARY KEY(\"pic_id\",\"user_id\",\"index\")" +
+			"PRIMARY KEY(`id`)" +
 
 			");",
 
-		"CREATE TABLE \"PicCommentVotes\" (" +
+		"CREATE INDEX `UserLinksUserId` ON `UserLinks` (`user_id`,`id`);",
 
-			"\"pic_id\" bigint NOT NULL, " +
+		"CREATE TABLE `LoginFailures` (" +
 
-			"\"comment_id\" bigint NOT NULL, " +
+			"`id` bigint(20) NOT NULL, " +
 
-			"\"user_id\" bigint NOT NULL, " +
+			"`subject` blob NOT NULL, " +
 
-			"\"index\" bigint NOT NULL, " +
+			"`data` blob NOT NULL, " +
 
-			"\"data\" bytea NOT NULL, " +
+			"UNIQUE(`subject`(255)), " +
 
-			"PRIMARY KEY(\"pic_id\",\"comment_id\",\"user_id\",\"index\")" +
+			"PRIMARY KEY(`id`)" +
 
 			");",
 
-		"CREATE TABLE \"Users\" (" +
+		"CREATE TABLE `PicFavorites` (" +
 
-			"\"id\" bigint NOT NULL, " +
+			"`id` bigint(20) NOT NULL, " +
 
-			"\"ident\" bytea NOT NULL, " +
+			"`pic_id` bigint(20) NOT NULL, " +
 
-			"\"data\" bytea NOT NULL, " +
+			"`user_id` bigint(20) NOT NULL, " +
 
-			"UNIQUE(\"ident\"), " +
+			"`data` blob NOT NULL, " +
 
-			"PRIMARY KEY(\"id\")" +
+			"UNIQUE(`pic_id`,`user_id`), " +
+
+			"PRIMARY KEY(`id`)" +
 
 			");",
 
-		"CREATE TABLE \"UserEvents\" (" +
+		"CREATE INDEX `PicFavoritesUserId` ON `PicFavorites` (`user_id`,`id`);",
 
-			"\"user_id\" bigint NOT NULL, " +
+		"CREATE TABLE `Collections` (" +
 
-			"\"created_ts\" bigint NOT NULL, " +
+			"`id` bigint(20) NOT NULL, " +
 
-			"\"index\" bigint NOT NULL, " +
+			"`user_id` bigint(20) NOT NULL, " +
 
-			"\"data\" bytea NOT NULL, " +
+			"`data` blob NOT NULL, " +
 
-			"PRIMARY KEY(\"user_id\",\"created_ts\",\"index\")" +
+			"PRIMARY KEY(`id`)" +
 
 			");",
 
-		"CREATE TABLE \"CustomData\" (" +
+		"CREATE INDEX `CollectionsUserId` ON `Collections` (`user_id`,`id`);",
 
-			"\"key_type\" bigint NOT NULL, " +
+		"CREATE TABLE `CollectionPics` (" +
 
-			"\"key1\" bigint NOT NULL, " +
+			"`collection_id` bigint(20) NOT NULL, " +
 
-			"\"key2\" bigint NOT NULL, " +
+			"`pic_id` bigint(20) NOT NULL, " +
 
-			"\"key3\" bigint NOT NULL, " +
+			"`position` bigint(20) NOT NULL, " +
 
-			"\"key4\" bigint NOT NULL, " +
+			"`data` blob NOT NULL, " +
 
-			"\"key5\" bigint NOT NULL, " +
+			"PRIMARY KEY(`collection_id`,`pic_id`)" +
 
-			"\"data\" bytea NOT NULL, " +
+			");",
 
-			"PRIMARY KEY(\"key_type\",\"key1\",\"key2\",\"key3\",\"key4\",\"key5\")" +
+		"CREATE INDEX `CollectionPicsPosition` ON `CollectionPics` (`collection_id`,`position`);",
+
+		"CREATE INDEX `CollectionPicsPicId` ON `CollectionPics` (`pic_id`,`collection_id`);",
+
+		"CREATE TABLE `Pools` (" +
+
+			"`id` bigint(20) NOT NULL, " +
+
+			"`data` blob NOT NULL, " +
+
+			"PRIMARY KEY(`id`)" +
 
 			");",
 
-		"CREATE TABLE \"_SequenceTable\" (\"the_sequence\" bigint NOT NULL);",
+		"CREATE TABLE `PoolPics` (" +
+
+			"`pool_id` bigint(20) NOT NULL, " +
+
+			"`pic_id` bigint(20) NOT NULL, " +
+
+			"`position` bigint(20) NOT NULL, " +
+
+			"`data` blob NOT NULL, " +
+
+			"PRIMARY KEY(`pool_id`,`pic_id`)" +
+
+			");",
+
+		"CREATE INDEX `PoolPicsPosition` ON `PoolPics` (`pool_id`,`position`);",
+
+		"CREATE INDEX `PoolPicsPicId` ON `PoolPics` (`pic_id`,`pool_id`);",
+
+		"CREATE TABLE `UserFollows` (" +
+
+			"`user_id` bigint(20) NOT NULL, " +
+
+			"`followee_user_id` bigint(20) NOT NULL, " +
+
+			"`data` blob NOT NULL, " +
+
+			"PRIMARY KEY(`user_id`,`followee_user_id`)" +
+
+			");",
+
+		"CREATE INDEX `UserFollowsFolloweeUserId` ON `UserFollows` (`followee_user_id`,`user_id`);",
+
+		"CREATE TABLE `TagFollows` (" +
+
+			"`user_id` bigint(20) NOT NULL, " +
+
+			"`tag_name` blob NOT NULL, " +
+
+			"`data` blob NOT NULL, " +
+
+			"PRIMARY KEY(`user_id`,`tag_name`(255))" +
+
+			");",
+
+		"CREATE TABLE `_SequenceTable` (`the_sequence` bigint(20) NOT NULL);",
 	},
 
-	"sqlite3": {
+	"postgres": {
 
 		"CREATE TABLE \"Pics\" (" +
 
-			"\"id\" integer NOT NULL, " +
+			"\"id\" bigint NOT NULL, " +
 
-			"\"index_order\" integer NOT NULL, " +
+			"\"index_order\" bigint NOT NULL, " +
 
 			"\"score_order\" integer NOT NULL, " +
 
 			"\"sched_order\" integer NOT NULL, " +
 
-			"\"data\" blob NOT NULL, " +
+			"\"data\" bytea NOT NULL, " +
 
 			"PRIMARY KEY(\"id\")" +
 
@@ -539,11 +804,11 @@ var SqlTables = map[string][]string{
 
 		"CREATE TABLE \"Tags\" (" +
 
-			"\"id\" integer NOT NULL, " +
+			"\"id\" bigint NOT NULL, " +
 
-			"\"name\" blob NOT NULL, " +
+			"\"name\" bytea NOT NULL, " +
 
-			"\"data\" blob NOT NULL, " +
+			"\"data\" bytea NOT NULL, " +
 
 			"UNIQUE(\"name\"), " +
 
@@ -553,11 +818,11 @@ var SqlTables = map[string][]string{
 
 		"CREATE TABLE \"PicTags\" (" +
 
-			"\"pic_id\" integer NOT NULL, " +
+			"\"pic_id\" bigint NOT NULL, " +
 
-			"\"tag_id\" integer NOT NULL, " +
+			"\"tag_id\" bigint NOT NULL, " +
 
-			"\"data\" blob NOT NULL, " +
+			"\"data\" bytea NOT NULL, " +
 
 			"PRIMARY KEY(\"pic_id\",\"tag_id\")" +
 
@@ -565,13 +830,13 @@ var SqlTables = map[string][]string{
 
 		"CREATE TABLE \"PicIdents\" (" +
 
-			"\"pic_id\" integer NOT NULL, " +
+			"\"pic_id\" bigint NOT NULL, " +
 
 			"\"type\" integer NOT NULL, " +
 
-			"\"value\" blob NOT NULL, " +
+			"\"value\" bytea NOT NULL, " +
 
-			"\"data\" blob NOT NULL, " +
+			"\"data\" bytea NOT NULL, " +
 
 			"PRIMARY KEY(\"pic_id\",\"type\",\"value\")" +
 
@@ -581,11 +846,11 @@ var SqlTables = map[string][]string{
 
 		"CREATE TABLE \"PicComments\" (" +
 
-			"\"pic_id\" integer NOT NULL, " +
+			"\"pic_id\" bigint NOT NULL, " +
 
-			"\"comment_id\" integer NOT NULL, " +
+			"\"comment_id\" bigint NOT NULL, " +
 
-			"\"data\" blob NOT NULL, " +
+			"\"data\" bytea NOT NULL, " +
 
 			"UNIQUE(\"comment_id\"), " +
 
@@ -595,199 +860,3311 @@ var SqlTables = map[string][]string{
 
 		"CREATE TABLE \"PicVotes\" (" +
 
-			"\"pic_id\" integer NOT NULL, " +
+			"\"pic_id\" bigint NOT NULL, " +
 
-			"\"user_id\" integer NOT NULL, " +
+			"\"user_id\" bigint NOT NULL, " +
 
-			"\"index\" integer NOT NULL, " +
+			"\"index\" bigint NOT NULL, " +
 
-			"\"data\" blob NOT NULL, " +
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"user_id\",\"pic_id\",\"index\"), " +
+
+			"PRIMARY KEY(\"pic_id\",\"user_id\",\"index\")" +
+
+			");",
+
+		"CREATE TABLE \"PicCommentVotes\" (" +
+
+			"\"pic_id\" bigint NOT NULL, " +
+
+			"\"comment_id\" bigint NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"index\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"pic_id\",\"comment_id\",\"user_id\",\"index\")" +
+
+			");",
+
+		"CREATE TABLE \"Users\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"ident\" bytea NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"ident\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"UserEvents\" (" +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"created_ts\" bigint NOT NULL, " +
+
+			"\"index\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"user_id\",\"created_ts\",\"index\")" +
+
+			");",
+
+		"CREATE TABLE \"CustomData\" (" +
+
+			"\"key_type\" bigint NOT NULL, " +
+
+			"\"key1\" bigint NOT NULL, " +
+
+			"\"key2\" bigint NOT NULL, " +
+
+			"\"key3\" bigint NOT NULL, " +
+
+			"\"key4\" bigint NOT NULL, " +
+
+			"\"key5\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"key_type\",\"key1\",\"key2\",\"key3\",\"key4\",\"key5\")" +
+
+			");",
+
+		"CREATE TABLE \"UploadSessions\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"modified_ts\" bigint NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"UploadSessionsModifiedTs\" ON \"UploadSessions\" (\"modified_ts\",\"id\");",
+
+		"CREATE INDEX \"UploadSessionsUserId\" ON \"UploadSessions\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"InviteCodes\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"code_hash\" bytea NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"code_hash\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"ApiKeys\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"key_hash\" bytea NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"key_hash\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"ApiKeysUserId\" ON \"ApiKeys\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"AuditLogs\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"action\" integer NOT NULL, " +
+
+			"\"actor_user_id\" bigint NOT NULL, " +
+
+			"\"target_user_id\" bigint NOT NULL, " +
+
+			"\"target_pic_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"AuditLogsAction\" ON \"AuditLogs\" (\"action\",\"id\");",
+
+		"CREATE INDEX \"AuditLogsActorUserId\" ON \"AuditLogs\" (\"actor_user_id\",\"id\");",
+
+		"CREATE INDEX \"AuditLogsTargetUserId\" ON \"AuditLogs\" (\"target_user_id\",\"id\");",
+
+		"CREATE INDEX \"AuditLogsTargetPicId\" ON \"AuditLogs\" (\"target_pic_id\",\"id\");",
+
+		"CREATE TABLE \"UserLinks\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"provider\" bytea NOT NULL, " +
+
+			"\"subject\" bytea NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"provider\",\"subject\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"UserLinksUserId\" ON \"UserLinks\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"LoginFailures\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"subject\" bytea NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"subject\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"PicFavorites\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"pic_id\" bigint NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"pic_id\",\"user_id\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"PicFavoritesUserId\" ON \"PicFavorites\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"Collections\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"CollectionsUserId\" ON \"Collections\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"CollectionPics\" (" +
+
+			"\"collection_id\" bigint NOT NULL, " +
+
+			"\"pic_id\" bigint NOT NULL, " +
+
+			"\"position\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"collection_id\",\"pic_id\")" +
+
+			");",
+
+		"CREATE INDEX \"CollectionPicsPosition\" ON \"CollectionPics\" (\"collection_id\",\"position\");",
+
+		"CREATE INDEX \"CollectionPicsPicId\" ON \"CollectionPics\" (\"pic_id\",\"collection_id\");",
+
+		"CREATE TABLE \"Pools\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"PoolPics\" (" +
+
+			"\"pool_id\" bigint NOT NULL, " +
+
+			"\"pic_id\" bigint NOT NULL, " +
+
+			"\"position\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"pool_id\",\"pic_id\")" +
+
+			");",
+
+		"CREATE INDEX \"PoolPicsPosition\" ON \"PoolPics\" (\"pool_id\",\"position\");",
+
+		"CREATE INDEX \"PoolPicsPicId\" ON \"PoolPics\" (\"pic_id\",\"pool_id\");",
+
+		"CREATE TABLE \"UserFollows\" (" +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"followee_user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"user_id\",\"followee_user_id\")" +
+
+			");",
+
+		"CREATE INDEX \"UserFollowsFolloweeUserId\" ON \"UserFollows\" (\"followee_user_id\",\"user_id\");",
+
+		"CREATE TABLE \"TagFollows\" (" +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"tag_name\" bytea NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"user_id\",\"tag_name\")" +
+
+			");",
+
+		"CREATE TABLE \"_SequenceTable\" (\"the_sequence\" bigint NOT NULL);",
+	},
+
+	"sqlite3": {
+
+		"CREATE TABLE \"Pics\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"index_order\" integer NOT NULL, " +
+
+			"\"score_order\" integer NOT NULL, " +
+
+			"\"sched_order\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"PicsIndexOrder\" ON \"Pics\" (\"index_order\",\"id\");",
+
+		"CREATE INDEX \"PicsScoreOrder\" ON \"Pics\" (\"score_order\",\"id\");",
+
+		"CREATE INDEX \"PicsSchedOrder\" ON \"Pics\" (\"sched_order\",\"id\");",
+
+		"CREATE TABLE \"Tags\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"name\" blob NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"UNIQUE(\"name\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"PicTags\" (" +
+
+			"\"pic_id\" integer NOT NULL, " +
+
+			"\"tag_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"pic_id\",\"tag_id\")" +
+
+			");",
+
+		"CREATE TABLE \"PicIdents\" (" +
+
+			"\"pic_id\" integer NOT NULL, " +
+
+			"\"type\" integer NOT NULL, " +
+
+			"\"value\" blob NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"pic_id\",\"type\",\"value\")" +
+
+			");",
+
+		"CREATE INDEX \"PicIdentsIdent\" ON \"PicIdents\" (\"type\",\"value\");",
+
+		"CREATE TABLE \"PicComments\" (" +
+
+			"\"pic_id\" integer NOT NULL, " +
+
+			"\"comment_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"UNIQUE(\"comment_id\"), " +
+
+			"PRIMARY KEY(\"pic_id\",\"comment_id\")" +
+
+			");",
+
+		"CREATE TABLE \"PicVotes\" (" +
+
+			"\"pic_id\" integer NOT NULL, " +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"index\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"UNIQUE(\"user_id\",\"pic_id\",\"index\"), " +
+
+			"PRIMARY KEY(\"pic_id\",\"user_id\",\"index\")" +
+
+			");",
+
+		"CREATE TABLE \"PicCommentVotes\" (" +
+
+			"\"pic_id\" integer NOT NULL, " +
+
+			"\"comment_id\" integer NOT NULL, " +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"index\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"pic_id\",\"comment_id\",\"user_id\",\"index\")" +
+
+			");",
+
+		"CREATE TABLE \"Users\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"ident\" blob NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"UNIQUE(\"ident\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"UserEvents\" (" +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"created_ts\" integer NOT NULL, " +
+
+			"\"index\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"user_id\",\"created_ts\",\"index\")" +
+
+			");",
+
+		"CREATE TABLE \"CustomData\" (" +
+
+			"\"key_type\" integer NOT NULL, " +
+
+			"\"key1\" integer NOT NULL, " +
+
+			"\"key2\" integer NOT NULL, " +
+
+			"\"key3\" integer NOT NULL, " +
+
+			"\"key4\" integer NOT NULL, " +
+
+			"\"key5\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"key_type\",\"key1\",\"key2\",\"key3\",\"key4\",\"key5\")" +
+
+			");",
+
+		"CREATE TABLE \"UploadSessions\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"modified_ts\" integer NOT NULL, " +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"UploadSessionsModifiedTs\" ON \"UploadSessions\" (\"modified_ts\",\"id\");",
+
+		"CREATE INDEX \"UploadSessionsUserId\" ON \"UploadSessions\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"InviteCodes\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"code_hash\" blob NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"UNIQUE(\"code_hash\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"ApiKeys\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"key_hash\" blob NOT NULL, " +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"UNIQUE(\"key_hash\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"ApiKeysUserId\" ON \"ApiKeys\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"AuditLogs\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"action\" integer NOT NULL, " +
+
+			"\"actor_user_id\" integer NOT NULL, " +
+
+			"\"target_user_id\" integer NOT NULL, " +
+
+			"\"target_pic_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"AuditLogsAction\" ON \"AuditLogs\" (\"action\",\"id\");",
+
+		"CREATE INDEX \"AuditLogsActorUserId\" ON \"AuditLogs\" (\"actor_user_id\",\"id\");",
+
+		"CREATE INDEX \"AuditLogsTargetUserId\" ON \"AuditLogs\" (\"target_user_id\",\"id\");",
+
+		"CREATE INDEX \"AuditLogsTargetPicId\" ON \"AuditLogs\" (\"target_pic_id\",\"id\");",
+
+		"CREATE TABLE \"UserLinks\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"provider\" blob NOT NULL, " +
+
+			"\"subject\" blob NOT NULL, " +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"UNIQUE(\"provider\",\"subject\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"UserLinksUserId\" ON \"UserLinks\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"LoginFailures\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"subject\" blob NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"UNIQUE(\"subject\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"PicFavorites\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"pic_id\" integer NOT NULL, " +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"UNIQUE(\"pic_id\",\"user_id\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"PicFavoritesUserId\" ON \"PicFavorites\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"Collections\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"CollectionsUserId\" ON \"Collections\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"CollectionPics\" (" +
+
+			"\"collection_id\" integer NOT NULL, " +
+
+			"\"pic_id\" integer NOT NULL, " +
+
+			"\"position\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"collection_id\",\"pic_id\")" +
+
+			");",
+
+		"CREATE INDEX \"CollectionPicsPosition\" ON \"CollectionPics\" (\"collection_id\",\"position\");",
+
+		"CREATE INDEX \"CollectionPicsPicId\" ON \"CollectionPics\" (\"pic_id\",\"collection_id\");",
+
+		"CREATE TABLE \"Pools\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"PoolPics\" (" +
+
+			"\"pool_id\" integer NOT NULL, " +
+
+			"\"pic_id\" integer NOT NULL, " +
+
+			"\"position\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"pool_id\",\"pic_id\")" +
+
+			");",
+
+		"CREATE INDEX \"PoolPicsPosition\" ON \"PoolPics\" (\"pool_id\",\"position\");",
+
+		"CREATE INDEX \"PoolPicsPicId\" ON \"PoolPics\" (\"pic_id\",\"pool_id\");",
+
+		"CREATE TABLE \"UserFollows\" (" +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"followee_user_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"user_id\",\"followee_user_id\")" +
+
+			");",
+
+		"CREATE INDEX \"UserFollowsFolloweeUserId\" ON \"UserFollows\" (\"followee_user_id\",\"user_id\");",
+
+		"CREATE TABLE \"TagFollows\" (" +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"tag_name\" blob NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"user_id\",\"tag_name\")" +
+
+			");",
+
+		"CREATE TABLE \"_SequenceTable\" (\"the_sequence\" integer NOT NULL);",
+	},
+}
+
+var SqlInitTables = map[string][]string{
+
+	"cockroach": {
+		"INSERT INTO \"_SequenceTable\" (\"the_sequence\") VALUES (1);",
+	},
+
+	"mysql": {
+		"INSERT INTO `_SequenceTable` (`the_sequence`) VALUES (1);",
+	},
+
+	"postgres": {
+		"INSERT INTO \"_SequenceTable\" (\"the_sequence\") VALUES (1);",
+	},
+
+	"sqlite3": {
+		"INSERT INTO \"_SequenceTable\" (\"the_sequence\") VALUES (1);",
+	},
+}
+
+type JobBeginner interface {
+	db.Beginner
+	db.DBAdaptable
+}
+
+func NewJob(ctx context.Context, beg JobBeginner) (*Job, error) {
+	return newJob(ctx, beg, false)
+}
+
+func NewReadonlyJob(ctx context.Context, beg JobBeginner) (*Job, error) {
+	return newJob(ctx, beg, true)
+}
+
+func newJob(ctx context.Context, beg JobBeginner, readonly bool) (*Job, error) {
+	adap := beg.Adapter()
+	var alloc *db.IdAlloc
+	if all, ok := beg.(db.IdAllocatable); ok {
+		alloc = all.IdAllocator()
+		if err := db.PreallocateIds(ctx, beg, alloc, adap); err != nil {
+			return nil, err
+		}
+	}
+	tx, err := beg.Begin(ctx, readonly)
+	if err != nil {
+		return nil, err
+	}
+	j := &Job{
+		ctx:      ctx,
+		tx:       tx,
+		alloc:    alloc,
+		adap:     adap,
+		readonly: readonly,
+	}
+	runtime.SetFinalizer(j, jobCloser)
+	return j, nil
+}
+
+type Job struct {
+	ctx      context.Context
+	tx       db.QuerierExecutorCommitter
+	adap     db.DBAdapter
+	alloc    *db.IdAlloc
+	done     bool
+	readonly bool
+}
+
+func (j *Job) Commit() error {
+	defer runtime.SetFinalizer(j, nil)
+	err := j.tx.Commit()
+	if err == nil {
+		j.done = true
+	}
+	return err
+}
+
+func (j *Job) Rollback() error {
+	if j.done {
+		return nil
+	}
+	j.done = true
+	defer runtime.SetFinalizer(j, nil)
+	return j.tx.Rollback()
+}
+
+var jobCloser = func(j *Job) {
+	log.Println("warning: found orphaned job")
+	if err := j.Rollback(); err != nil {
+		log.Println("error rolling back orphaned job", err)
+	}
+}
+
+func (j *Job) AllocId() (int64, error) {
+	return db.AllocIdJob(j.ctx, j.tx, j.alloc, j.adap)
+}
+
+type PicsPrimary struct {
+	Id *int64
+}
+
+func (_ PicsPrimary) Unique() {}
+
+var _ db.UniqueIdx = PicsPrimary{}
+
+var colsPicsPrimary = []string{"id"}
+
+func (idx PicsPrimary) Cols() []string {
+	return colsPicsPrimary
+}
+
+func (idx PicsPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type PicsIndexOrder struct {
+	IndexOrder *int64
+
+	Id *int64
+}
+
+var _ db.Idx = PicsIndexOrder{}
+
+var colsPicsIndexOrder = []string{"index_order", "id"}
+
+func (idx PicsIndexOrder) Cols() []string {
+	return colsPicsIndexOrder
+}
+
+func (idx PicsIndexOrder) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.IndexOrder != nil {
+		if done {
+			panic("Extra value IndexOrder")
+		}
+		vals = append(vals, *idx.IndexOrder)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type PicsScoreOrder struct {
+	ScoreOrder *int32
+
+	Id *int64
+}
+
+var _ db.Idx = PicsScoreOrder{}
+
+var colsPicsScoreOrder = []string{"score_order", "id"}
+
+func (idx PicsScoreOrder) Cols() []string {
+	return colsPicsScoreOrder
+}
+
+func (idx PicsScoreOrder) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.ScoreOrder != nil {
+		if done {
+			panic("Extra value ScoreOrder")
+		}
+		vals = append(vals, *idx.ScoreOrder)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type PicsSchedOrder struct {
+	SchedOrder *int32
+
+	Id *int64
+}
+
+var _ db.Idx = PicsSchedOrder{}
+
+var colsPicsSchedOrder = []string{"sched_order", "id"}
+
+func (idx PicsSchedOrder) Cols() []string {
+	return colsPicsSchedOrder
+}
+
+func (idx PicsSchedOrder) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.SchedOrder != nil {
+		if done {
+			panic("Extra value SchedOrder")
+		}
+		vals = append(vals, *idx.SchedOrder)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForPic(pb *schema.Pic) PicsPrimary {
+
+	Id := pb.IdCol()
+
+	return PicsPrimary{
+
+		Id: &Id,
+	}
+}
+
+var colsPics = []string{"id", "index_order", "score_order", "sched_order", "data"}
+
+func (j *Job) ScanPics(opts db.Opts, cb func(*schema.Pic) error) error {
+	return db.Scan(j.tx, "Pics", opts, func(data []byte) error {
+		var pb schema.Pic
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindPics(opts db.Opts) (rows []*schema.Pic, err error) {
+	err = j.ScanPics(opts, func(data *schema.Pic) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ IdCol() int64 } = (*schema.Pic)(nil)
+
+var _ interface{ IndexOrderCol() int64 } = (*schema.Pic)(nil)
+
+var _ interface{ LowerScoreBound() int32 } = (*schema.Pic)(nil)
+
+var _ interface{ UpperScoreBound() int32 } = (*schema.Pic)(nil)
+
+func (j *Job) InsertPic(pb *schema.Pic) error {
+	return j.InsertPicRow(&PicRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		IndexOrder: pb.IndexOrderCol(),
+
+		ScoreOrder: pb.LowerScoreBound(),
+
+		SchedOrder: pb.UpperScoreBound(),
+	})
+}
+
+func (j *Job) InsertPicRow(row *PicRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.IndexOrder)
+
+	vals = append(vals, row.ScoreOrder)
+
+	vals = append(vals, row.SchedOrder)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "Pics", colsPics, vals, j.adap)
+}
+
+var _ interface{ IdCol() int64 } = (*schema.Pic)(nil)
+
+var _ interface{ IndexOrderCol() int64 } = (*schema.Pic)(nil)
+
+var _ interface{ LowerScoreBound() int32 } = (*schema.Pic)(nil)
+
+var _ interface{ UpperScoreBound() int32 } = (*schema.Pic)(nil)
+
+func (j *Job) UpdatePic(pb *schema.Pic) error {
+	return j.UpdatePicRow(&PicRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		IndexOrder: pb.IndexOrderCol(),
+
+		ScoreOrder: pb.LowerScoreBound(),
+
+		SchedOrder: pb.UpperScoreBound(),
+	})
+}
+
+func (j *Job) UpdatePicRow(row *PicRow) error {
+	key := KeyForPic(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.IndexOrder)
+
+	vals = append(vals, row.ScoreOrder)
+
+	vals = append(vals, row.SchedOrder)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "Pics", colsPics, vals, key, j.adap)
+}
+
+func (j *Job) DeletePic(key PicsPrimary) error {
+	return db.Delete(j.tx, "Pics", key, j.adap)
+}
+
+type TagsPrimary struct {
+	Id *int64
+}
+
+func (_ TagsPrimary) Unique() {}
+
+var _ db.UniqueIdx = TagsPrimary{}
+
+var colsTagsPrimary = []string{"id"}
+
+func (idx TagsPrimary) Cols() []string {
+	return colsTagsPrimary
+}
+
+func (idx TagsPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type TagsName struct {
+	Name *string
+}
+
+func (_ TagsName) Unique() {}
+
+var _ db.UniqueIdx = TagsName{}
+
+var colsTagsName = []string{"name"}
+
+func (idx TagsName) Cols() []string {
+	return colsTagsName
+}
+
+func (idx TagsName) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Name != nil {
+		if done {
+			panic("Extra value Name")
+		}
+		vals = append(vals, *idx.Name)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForTag(pb *schema.Tag) TagsPrimary {
+
+	Id := pb.IdCol()
+
+	return TagsPrimary{
+
+		Id: &Id,
+	}
+}
+
+var colsTags = []string{"id", "name", "data"}
+
+func (j *Job) ScanTags(opts db.Opts, cb func(*schema.Tag) error) error {
+	return db.Scan(j.tx, "Tags", opts, func(data []byte) error {
+		var pb schema.Tag
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindTags(opts db.Opts) (rows []*schema.Tag, err error) {
+	err = j.ScanTags(opts, func(data *schema.Tag) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ IdCol() int64 } = (*schema.Tag)(nil)
+
+var _ interface{ NameCol() string } = (*schema.Tag)(nil)
+
+func (j *Job) InsertTag(pb *schema.Tag) error {
+	return j.InsertTagRow(&TagRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		Name: pb.NameCol(),
+	})
+}
+
+func (j *Job) InsertTagRow(row *TagRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.Name)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "Tags", colsTags, vals, j.adap)
+}
+
+var _ interface{ IdCol() int64 } = (*schema.Tag)(nil)
+
+var _ interface{ NameCol() string } = (*schema.Tag)(nil)
+
+func (j *Job) UpdateTag(pb *schema.Tag) error {
+	return j.UpdateTagRow(&TagRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		Name: pb.NameCol(),
+	})
+}
+
+func (j *Job) UpdateTagRow(row *TagRow) error {
+	key := KeyForTag(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.Name)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "Tags", colsTags, vals, key, j.adap)
+}
+
+func (j *Job) DeleteTag(key TagsPrimary) error {
+	return db.Delete(j.tx, "Tags", key, j.adap)
+}
+
+type PicTagsPrimary struct {
+	PicId *int64
+
+	TagId *int64
+}
+
+func (_ PicTagsPrimary) Unique() {}
+
+var _ db.UniqueIdx = PicTagsPrimary{}
+
+var colsPicTagsPrimary = []string{"pic_id", "tag_id"}
+
+func (idx PicTagsPrimary) Cols() []string {
+	return colsPicTagsPrimary
+}
+
+func (idx PicTagsPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.TagId != nil {
+		if done {
+			panic("Extra value TagId")
+		}
+		vals = append(vals, *idx.TagId)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForPicTag(pb *schema.PicTag) PicTagsPrimary {
+
+	PicId := pb.PicIdCol()
+
+	TagId := pb.TagIdCol()
+
+	return PicTagsPrimary{
+
+		PicId: &PicId,
+
+		TagId: &TagId,
+	}
+}
+
+var colsPicTags = []string{"pic_id", "tag_id", "data"}
+
+func (j *Job) ScanPicTags(opts db.Opts, cb func(*schema.PicTag) error) error {
+	return db.Scan(j.tx, "PicTags", opts, func(data []byte) error {
+		var pb schema.PicTag
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindPicTags(opts db.Opts) (rows []*schema.PicTag, err error) {
+	err = j.ScanPicTags(opts, func(data *schema.PicTag) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicTag)(nil)
+
+var _ interface{ TagIdCol() int64 } = (*schema.PicTag)(nil)
+
+func (j *Job) InsertPicTag(pb *schema.PicTag) error {
+	return j.InsertPicTagRow(&PicTagRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		TagId: pb.TagIdCol(),
+	})
+}
+
+func (j *Job) InsertPicTagRow(row *PicTagRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.TagId)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "PicTags", colsPicTags, vals, j.adap)
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicTag)(nil)
+
+var _ interface{ TagIdCol() int64 } = (*schema.PicTag)(nil)
+
+func (j *Job) UpdatePicTag(pb *schema.PicTag) error {
+	return j.UpdatePicTagRow(&PicTagRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		TagId: pb.TagIdCol(),
+	})
+}
+
+func (j *Job) UpdatePicTagRow(row *PicTagRow) error {
+	key := KeyForPicTag(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.TagId)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "PicTags", colsPicTags, vals, key, j.adap)
+}
+
+func (j *Job) DeletePicTag(key PicTagsPrimary) error {
+	return db.Delete(j.tx, "PicTags", key, j.adap)
+}
+
+type PicIdentsPrimary struct {
+	PicId *int64
+
+	Type *schema.PicIdent_Type
+
+	Value *[]byte
+}
+
+func (_ PicIdentsPrimary) Unique() {}
+
+var _ db.UniqueIdx = PicIdentsPrimary{}
+
+var colsPicIdentsPrimary = []string{"pic_id", "type", "value"}
+
+func (idx PicIdentsPrimary) Cols() []string {
+	return colsPicIdentsPrimary
+}
+
+func (idx PicIdentsPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.Type != nil {
+		if done {
+			panic("Extra value Type")
+		}
+		vals = append(vals, *idx.Type)
+	} else {
+		done = true
+	}
+
+	if idx.Value != nil {
+		if done {
+			panic("Extra value Value")
+		}
+		vals = append(vals, *idx.Value)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type PicIdentsIdent struct {
+	Type *schema.PicIdent_Type
+
+	Value *[]byte
+}
+
+var _ db.Idx = PicIdentsIdent{}
+
+var colsPicIdentsIdent = []string{"type", "value"}
+
+func (idx PicIdentsIdent) Cols() []string {
+	return colsPicIdentsIdent
+}
+
+func (idx PicIdentsIdent) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Type != nil {
+		if done {
+			panic("Extra value Type")
+		}
+		vals = append(vals, *idx.Type)
+	} else {
+		done = true
+	}
+
+	if idx.Value != nil {
+		if done {
+			panic("Extra value Value")
+		}
+		vals = append(vals, *idx.Value)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForPicIdent(pb *schema.PicIdent) PicIdentsPrimary {
+
+	PicId := pb.PicIdCol()
+
+	Type := pb.TypeCol()
+
+	Value := pb.ValueCol()
+
+	return PicIdentsPrimary{
+
+		PicId: &PicId,
+
+		Type: &Type,
+
+		Value: &Value,
+	}
+}
+
+var colsPicIdents = []string{"pic_id", "type", "value", "data"}
+
+func (j *Job) ScanPicIdents(opts db.Opts, cb func(*schema.PicIdent) error) error {
+	return db.Scan(j.tx, "PicIdents", opts, func(data []byte) error {
+		var pb schema.PicIdent
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindPicIdents(opts db.Opts) (rows []*schema.PicIdent, err error) {
+	err = j.ScanPicIdents(opts, func(data *schema.PicIdent) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicIdent)(nil)
+
+var _ interface{ TypeCol() schema.PicIdent_Type } = (*schema.PicIdent)(nil)
+
+var _ interface{ ValueCol() []byte } = (*schema.PicIdent)(nil)
+
+func (j *Job) InsertPicIdent(pb *schema.PicIdent) error {
+	return j.InsertPicIdentRow(&PicIdentRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		Type: pb.TypeCol(),
+
+		Value: pb.ValueCol(),
+	})
+}
+
+func (j *Job) InsertPicIdentRow(row *PicIdentRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.Type)
+
+	vals = append(vals, row.Value)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "PicIdents", colsPicIdents, vals, j.adap)
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicIdent)(nil)
+
+var _ interface{ TypeCol() schema.PicIdent_Type } = (*schema.PicIdent)(nil)
+
+var _ interface{ ValueCol() []byte } = (*schema.PicIdent)(nil)
+
+func (j *Job) UpdatePicIdent(pb *schema.PicIdent) error {
+	return j.UpdatePicIdentRow(&PicIdentRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		Type: pb.TypeCol(),
+
+		Value: pb.ValueCol(),
+	})
+}
+
+func (j *Job) UpdatePicIdentRow(row *PicIdentRow) error {
+	key := KeyForPicIdent(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.Type)
+
+	vals = append(vals, row.Value)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "PicIdents", colsPicIdents, vals, key, j.adap)
+}
+
+func (j *Job) DeletePicIdent(key PicIdentsPrimary) error {
+	return db.Delete(j.tx, "PicIdents", key, j.adap)
+}
+
+type PicCommentsPrimary struct {
+	PicId *int64
+
+	CommentId *int64
+}
+
+func (_ PicCommentsPrimary) Unique() {}
+
+var _ db.UniqueIdx = PicCommentsPrimary{}
+
+var colsPicCommentsPrimary = []string{"pic_id", "comment_id"}
+
+func (idx PicCommentsPrimary) Cols() []string {
+	return colsPicCommentsPrimary
+}
+
+func (idx PicCommentsPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.CommentId != nil {
+		if done {
+			panic("Extra value CommentId")
+		}
+		vals = append(vals, *idx.CommentId)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type PicCommentsCommentId struct {
+	CommentId *int64
+}
+
+func (_ PicCommentsCommentId) Unique() {}
+
+var _ db.UniqueIdx = PicCommentsCommentId{}
+
+var colsPicCommentsCommentId = []string{"comment_id"}
+
+func (idx PicCommentsCommentId) Cols() []string {
+	return colsPicCommentsCommentId
+}
+
+func (idx PicCommentsCommentId) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.CommentId != nil {
+		if done {
+			panic("Extra value CommentId")
+		}
+		vals = append(vals, *idx.CommentId)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForPicComment(pb *schema.PicComment) PicCommentsPrimary {
+
+	PicId := pb.PicIdCol()
+
+	CommentId := pb.CommentIdCol()
+
+	return PicCommentsPrimary{
+
+		PicId: &PicId,
+
+		CommentId: &CommentId,
+	}
+}
+
+var colsPicComments = []string{"pic_id", "comment_id", "data"}
+
+func (j *Job) ScanPicComments(opts db.Opts, cb func(*schema.PicComment) error) error {
+	return db.Scan(j.tx, "PicComments", opts, func(data []byte) error {
+		var pb schema.PicComment
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindPicComments(opts db.Opts) (rows []*schema.PicComment, err error) {
+	err = j.ScanPicComments(opts, func(data *schema.PicComment) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicComment)(nil)
+
+var _ interface{ CommentIdCol() int64 } = (*schema.PicComment)(nil)
+
+func (j *Job) InsertPicComment(pb *schema.PicComment) error {
+	return j.InsertPicCommentRow(&PicCommentRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		CommentId: pb.CommentIdCol(),
+	})
+}
+
+func (j *Job) InsertPicCommentRow(row *PicCommentRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.CommentId)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "PicComments", colsPicComments, vals, j.adap)
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicComment)(nil)
+
+var _ interface{ CommentIdCol() int64 } = (*schema.PicComment)(nil)
+
+func (j *Job) UpdatePicComment(pb *schema.PicComment) error {
+	return j.UpdatePicCommentRow(&PicCommentRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		CommentId: pb.CommentIdCol(),
+	})
+}
+
+func (j *Job) UpdatePicCommentRow(row *PicCommentRow) error {
+	key := KeyForPicComment(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.CommentId)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "PicComments", colsPicComments, vals, key, j.adap)
+}
+
+func (j *Job) DeletePicComment(key PicCommentsPrimary) error {
+	return db.Delete(j.tx, "PicComments", key, j.adap)
+}
+
+type PicVotesPrimary struct {
+	PicId *int64
+
+	UserId *int64
+
+	Index *int64
+}
+
+func (_ PicVotesPrimary) Unique() {}
+
+var _ db.UniqueIdx = PicVotesPrimary{}
+
+var colsPicVotesPrimary = []string{"pic_id", "user_id", "index"}
+
+func (idx PicVotesPrimary) Cols() []string {
+	return colsPicVotesPrimary
+}
+
+func (idx PicVotesPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.Index != nil {
+		if done {
+			panic("Extra value Index")
+		}
+		vals = append(vals, *idx.Index)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type PicVotesUserId struct {
+	UserId *int64
+
+	PicId *int64
+
+	Index *int64
+}
+
+func (_ PicVotesUserId) Unique() {}
+
+var _ db.UniqueIdx = PicVotesUserId{}
+
+var colsPicVotesUserId = []string{"user_id", "pic_id", "index"}
+
+func (idx PicVotesUserId) Cols() []string {
+	return colsPicVotesUserId
+}
+
+func (idx PicVotesUserId) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.Index != nil {
+		if done {
+			panic("Extra value Index")
+		}
+		vals = append(vals, *idx.Index)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForPicVote(pb *schema.PicVote) PicVotesPrimary {
+
+	PicId := pb.PicIdCol()
+
+	UserId := pb.UserIdCol()
+
+	Index := pb.IndexCol()
+
+	return PicVotesPrimary{
+
+		PicId: &PicId,
+
+		UserId: &UserId,
+
+		Index: &Index,
+	}
+}
+
+var colsPicVotes = []string{"pic_id", "user_id", "index", "data"}
+
+func (j *Job) ScanPicVotes(opts db.Opts, cb func(*schema.PicVote) error) error {
+	return db.Scan(j.tx, "PicVotes", opts, func(data []byte) error {
+		var pb schema.PicVote
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindPicVotes(opts db.Opts) (rows []*schema.PicVote, err error) {
+	err = j.ScanPicVotes(opts, func(data *schema.PicVote) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicVote)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.PicVote)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.PicVote)(nil)
+
+func (j *Job) InsertPicVote(pb *schema.PicVote) error {
+	return j.InsertPicVoteRow(&PicVoteRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		UserId: pb.UserIdCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) InsertPicVoteRow(row *PicVoteRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "PicVotes", colsPicVotes, vals, j.adap)
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicVote)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.PicVote)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.PicVote)(nil)
+
+func (j *Job) UpdatePicVote(pb *schema.PicVote) error {
+	return j.UpdatePicVoteRow(&PicVoteRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		UserId: pb.UserIdCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) UpdatePicVoteRow(row *PicVoteRow) error {
+	key := KeyForPicVote(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "PicVotes", colsPicVotes, vals, key, j.adap)
+}
+
+func (j *Job) DeletePicVote(key PicVotesPrimary) error {
+	return db.Delete(j.tx, "PicVotes", key, j.adap)
+}
+
+type PicCommentVotesPrimary struct {
+	PicId *int64
+
+	CommentId *int64
+
+	UserId *int64
+
+	Index *int64
+}
+
+func (_ PicCommentVotesPrimary) Unique() {}
+
+var _ db.UniqueIdx = PicCommentVotesPrimary{}
+
+var colsPicCommentVotesPrimary = []string{"pic_id", "comment_id", "user_id", "index"}
+
+func (idx PicCommentVotesPrimary) Cols() []string {
+	return colsPicCommentVotesPrimary
+}
+
+func (idx PicCommentVotesPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.CommentId != nil {
+		if done {
+			panic("Extra value CommentId")
+		}
+		vals = append(vals, *idx.CommentId)
+	} else {
+		done = true
+	}
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.Index != nil {
+		if done {
+			panic("Extra value Index")
+		}
+		vals = append(vals, *idx.Index)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForPicCommentVote(pb *schema.PicCommentVote) PicCommentVotesPrimary {
+
+	PicId := pb.PicIdCol()
+
+	CommentId := pb.CommentIdCol()
+
+	UserId := pb.UserIdCol()
+
+	Index := pb.IndexCol()
+
+	return PicCommentVotesPrimary{
+
+		PicId: &PicId,
+
+		CommentId: &CommentId,
+
+		UserId: &UserId,
+
+		Index: &Index,
+	}
+}
+
+var colsPicCommentVotes = []string{"pic_id", "comment_id", "user_id", "index", "data"}
+
+func (j *Job) ScanPicCommentVotes(opts db.Opts, cb func(*schema.PicCommentVote) error) error {
+	return db.Scan(j.tx, "PicCommentVotes", opts, func(data []byte) error {
+		var pb schema.PicCommentVote
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindPicCommentVotes(opts db.Opts) (rows []*schema.PicCommentVote, err error) {
+	err = j.ScanPicCommentVotes(opts, func(data *schema.PicCommentVote) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ CommentIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.PicCommentVote)(nil)
+
+func (j *Job) InsertPicCommentVote(pb *schema.PicCommentVote) error {
+	return j.InsertPicVoteCommentRow(&PicVoteCommentRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		CommentId: pb.CommentIdCol(),
+
+		UserId: pb.UserIdCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) InsertPicVoteCommentRow(row *PicVoteCommentRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.CommentId)
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "PicCommentVotes", colsPicCommentVotes, vals, j.adap)
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ CommentIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.PicCommentVote)(nil)
+
+func (j *Job) UpdatePicCommentVote(pb *schema.PicCommentVote) error {
+	return j.UpdatePicVoteCommentRow(&PicVoteCommentRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		CommentId: pb.CommentIdCol(),
+
+		UserId: pb.UserIdCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) UpdatePicVoteCommentRow(row *PicVoteCommentRow) error {
+	key := KeyForPicCommentVote(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.CommentId)
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "PicCommentVotes", colsPicCommentVotes, vals, key, j.adap)
+}
+
+func (j *Job) DeletePicCommentVote(key PicCommentVotesPrimary) error {
+	return db.Delete(j.tx, "PicCommentVotes", key, j.adap)
+}
+
+type UsersPrimary struct {
+	Id *int64
+}
+
+func (_ UsersPrimary) Unique() {}
+
+var _ db.UniqueIdx = UsersPrimary{}
+
+var colsUsersPrimary = []string{"id"}
+
+func (idx UsersPrimary) Cols() []string {
+	return colsUsersPrimary
+}
+
+func (idx UsersPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type UsersIdent struct {
+	Ident *string
+}
+
+func (_ UsersIdent) Unique() {}
+
+var _ db.UniqueIdx = UsersIdent{}
+
+var colsUsersIdent = []string{"ident"}
+
+func (idx UsersIdent) Cols() []string {
+	return colsUsersIdent
+}
+
+func (idx UsersIdent) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Ident != nil {
+		if done {
+			panic("Extra value Ident")
+		}
+		vals = append(vals, *idx.Ident)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForUser(pb *schema.User) UsersPrimary {
+
+	Id := pb.IdCol()
+
+	return UsersPrimary{
+
+		Id: &Id,
+	}
+}
+
+var colsUsers = []string{"id", "ident", "data"}
+
+func (j *Job) ScanUsers(opts db.Opts, cb func(*schema.User) error) error {
+	return db.Scan(j.tx, "Users", opts, func(data []byte) error {
+		var pb schema.User
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindUsers(opts db.Opts) (rows []*schema.User, err error) {
+	err = j.ScanUsers(opts, func(data *schema.User) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ IdCol() int64 } = (*schema.User)(nil)
+
+var _ interface{ IdentCol() string } = (*schema.User)(nil)
+
+func (j *Job) InsertUser(pb *schema.User) error {
+	return j.InsertUserRow(&UserRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		Ident: pb.IdentCol(),
+	})
+}
+
+func (j *Job) InsertUserRow(row *UserRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.Ident)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "Users", colsUsers, vals, j.adap)
+}
+
+var _ interface{ IdCol() int64 } = (*schema.User)(nil)
+
+var _ interface{ IdentCol() string } = (*schema.User)(nil)
+
+func (j *Job) UpdateUser(pb *schema.User) error {
+	return j.UpdateUserRow(&UserRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		Ident: pb.IdentCol(),
+	})
+}
+
+func (j *Job) UpdateUserRow(row *UserRow) error {
+	key := KeyForUser(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.Ident)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "Users", colsUsers, vals, key, j.adap)
+}
+
+func (j *Job) DeleteUser(key UsersPrimary) error {
+	return db.Delete(j.tx, "Users", key, j.adap)
+}
+
+type UserEventsPrimary struct {
+	UserId *int64
+
+	CreatedTs *int64
+
+	Index *int64
+}
+
+func (_ UserEventsPrimary) Unique() {}
+
+var _ db.UniqueIdx = UserEventsPrimary{}
+
+var colsUserEventsPrimary = []string{"user_id", "created_ts", "index"}
+
+func (idx UserEventsPrimary) Cols() []string {
+	return colsUserEventsPrimary
+}
+
+func (idx UserEventsPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.CreatedTs != nil {
+		if done {
+			panic("Extra value CreatedTs")
+		}
+		vals = append(vals, *idx.CreatedTs)
+	} else {
+		done = true
+	}
+
+	if idx.Index != nil {
+		if done {
+			panic("Extra value Index")
+		}
+		vals = append(vals, *idx.Index)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForUserEvent(pb *schema.UserEvent) UserEventsPrimary {
+
+	UserId := pb.UserIdCol()
+
+	CreatedTs := pb.CreatedTsCol()
+
+	Index := pb.IndexCol()
+
+	return UserEventsPrimary{
+
+		UserId: &UserId,
+
+		CreatedTs: &CreatedTs,
+
+		Index: &Index,
+	}
+}
+
+var colsUserEvents = []string{"user_id", "created_ts", "index", "data"}
+
+func (j *Job) ScanUserEvents(opts db.Opts, cb func(*schema.UserEvent) error) error {
+	return db.Scan(j.tx, "UserEvents", opts, func(data []byte) error {
+		var pb schema.UserEvent
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindUserEvents(opts db.Opts) (rows []*schema.UserEvent, err error) {
+	err = j.ScanUserEvents(opts, func(data *schema.UserEvent) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ UserIdCol() int64 } = (*schema.UserEvent)(nil)
+
+var _ interface{ CreatedTsCol() int64 } = (*schema.UserEvent)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.UserEvent)(nil)
+
+func (j *Job) InsertUserEvent(pb *schema.UserEvent) error {
+	return j.InsertUserEventRow(&UserEventRow{
+		Data: pb,
+
+		UserId: pb.UserIdCol(),
+
+		CreatedTs: pb.CreatedTsCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) InsertUserEventRow(row *UserEventRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.CreatedTs)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "UserEvents", colsUserEvents, vals, j.adap)
+}
+
+var _ interface{ UserIdCol() int64 } = (*schema.UserEvent)(nil)
+
+var _ interface{ CreatedTsCol() int64 } = (*schema.UserEvent)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.UserEvent)(nil)
+
+func (j *Job) UpdateUserEvent(pb *schema.UserEvent) error {
+	return j.UpdateUserEventRow(&UserEventRow{
+		Data: pb,
+
+		UserId: pb.UserIdCol(),
+
+		CreatedTs: pb.CreatedTsCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) UpdateUserEventRow(row *UserEventRow) error {
+	key := KeyForUserEvent(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.CreatedTs)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "UserEvents", colsUserEvents, vals, key, j.adap)
+}
+
+func (j *Job) DeleteUserEvent(key UserEventsPrimary) error {
+	return db.Delete(j.tx, "UserEvents", key, j.adap)
+}
+
+type CustomDataPrimary struct {
+	KeyType *int64
+
+	Key1 *int64
+
+	Key2 *int64
+
+	Key3 *int64
+
+	Key4 *int64
+
+	Key5 *int64
+}
+
+func (_ CustomDataPrimary) Unique() {}
+
+var _ db.UniqueIdx = CustomDataPrimary{}
+
+var colsCustomDataPrimary = []string{"key_type", "key1", "key2", "key3", "key4", "key5"}
+
+func (idx CustomDataPrimary) Cols() []string {
+	return colsCustomDataPrimary
+}
+
+func (idx CustomDataPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.KeyType != nil {
+		if done {
+			panic("Extra value KeyType")
+		}
+		vals = append(vals, *idx.KeyType)
+	} else {
+		done = true
+	}
+
+	if idx.Key1 != nil {
+		if done {
+			panic("Extra value Key1")
+		}
+		vals = append(vals, *idx.Key1)
+	} else {
+		done = true
+	}
+
+	if idx.Key2 != nil {
+		if done {
+			panic("Extra value Key2")
+		}
+		vals = append(vals, *idx.Key2)
+	} else {
+		done = true
+	}
+
+	if idx.Key3 != nil {
+		if done {
+			panic("Extra value Key3")
+		}
+		vals = append(vals, *idx.Key3)
+	} else {
+		done = true
+	}
+
+	if idx.Key4 != nil {
+		if done {
+			panic("Extra value Key4")
+		}
+		vals = append(vals, *idx.Key4)
+	} else {
+		done = true
+	}
+
+	if idx.Key5 != nil {
+		if done {
+			panic("Extra value Key5")
+		}
+		vals = append(vals, *idx.Key5)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForCustomData(pb *schema.CustomData) CustomDataPrimary {
+
+	KeyType := pb.KeyTypeCol()
+
+	Key1 := pb.Key1Col()
+
+	Key2 := pb.Key2Col()
+
+	Key3 := pb.Key3Col()
+
+	Key4 := pb.Key4Col()
+
+	Key5 := pb.Key5Col()
+
+	return CustomDataPrimary{
+
+		KeyType: &KeyType,
+
+		Key1: &Key1,
+
+		Key2: &Key2,
+
+		Key3: &Key3,
+
+		Key4: &Key4,
+
+		Key5: &Key5,
+	}
+}
+
+var colsCustomData = []string{"key_type", "key1", "key2", "key3", "key4", "key5", "data"}
+
+func (j *Job) ScanCustomData(opts db.Opts, cb func(*schema.CustomData) error) error {
+	return db.Scan(j.tx, "CustomData", opts, func(data []byte) error {
+		var pb schema.CustomData
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindCustomData(opts db.Opts) (rows []*schema.CustomData, err error) {
+	err = j.ScanCustomData(opts, func(data *schema.CustomData) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ KeyTypeCol() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key1Col() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key2Col() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key3Col() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key4Col() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key5Col() int64 } = (*schema.CustomData)(nil)
+
+func (j *Job) InsertCustomData(pb *schema.CustomData) error {
+	return j.InsertCustomDataRow(&CustomDataRow{
+		Data: pb,
+
+		KeyType: pb.KeyTypeCol(),
+
+		Key1: pb.Key1Col(),
+
+		Key2: pb.Key2Col(),
+
+		Key3: pb.Key3Col(),
+
+		Key4: pb.Key4Col(),
+
+		Key5: pb.Key5Col(),
+	})
+}
+
+func (j *Job) InsertCustomDataRow(row *CustomDataRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.KeyType)
+
+	vals = append(vals, row.Key1)
+
+	vals = append(vals, row.Key2)
+
+	vals = append(vals, row.Key3)
+
+	vals = append(vals, row.Key4)
+
+	vals = append(vals, row.Key5)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "CustomData", colsCustomData, vals, j.adap)
+}
+
+var _ interface{ KeyTypeCol() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key1Col() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key2Col() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key3Col() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key4Col() int64 } = (*schema.CustomData)(nil)
+
+var _ interface{ Key5Col() int64 } = (*schema.CustomData)(nil)
+
+func (j *Job) UpdateCustomData(pb *schema.CustomData) error {
+	return j.UpdateCustomDataRow(&CustomDataRow{
+		Data: pb,
+
+		KeyType: pb.KeyTypeCol(),
+
+		Key1: pb.Key1Col(),
+
+		Key2: pb.Key2Col(),
+
+		Key3: pb.Key3Col(),
+
+		Key4: pb.Key4Col(),
+
+		Key5: pb.Key5Col(),
+	})
+}
+
+func (j *Job) UpdateCustomDataRow(row *CustomDataRow) error {
+	key := KeyForCustomData(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.KeyType)
+
+	vals = append(vals, row.Key1)
+
+	vals = append(vals, row.Key2)
+
+	vals = append(vals, row.Key3)
+
+	vals = append(vals, row.Key4)
+
+	vals = append(vals, row.Key5)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "CustomData", colsCustomData, vals, key, j.adap)
+}
+
+func (j *Job) DeleteCustomData(key CustomDataPrimary) error {
+	return db.Delete(j.tx, "CustomData", key, j.adap)
+}
+
+type UploadSessionsPrimary struct {
+	Id *int64
+}
+
+func (_ UploadSessionsPrimary) Unique() {}
+
+var _ db.UniqueIdx = UploadSessionsPrimary{}
+
+var colsUploadSessionsPrimary = []string{"id"}
+
+func (idx UploadSessionsPrimary) Cols() []string {
+	return colsUploadSessionsPrimary
+}
+
+func (idx UploadSessionsPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type UploadSessionsModifiedTs struct {
+	ModifiedTs *int64
+
+	Id *int64
+}
+
+var _ db.Idx = UploadSessionsModifiedTs{}
+
+var colsUploadSessionsModifiedTs = []string{"modified_ts", "id"}
+
+func (idx UploadSessionsModifiedTs) Cols() []string {
+	return colsUploadSessionsModifiedTs
+}
+
+func (idx UploadSessionsModifiedTs) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.ModifiedTs != nil {
+		if done {
+			panic("Extra value ModifiedTs")
+		}
+		vals = append(vals, *idx.ModifiedTs)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type UploadSessionsUserId struct {
+	UserId *int64
+
+	Id *int64
+}
+
+var _ db.Idx = UploadSessionsUserId{}
+
+var colsUploadSessionsUserId = []string{"user_id", "id"}
+
+func (idx UploadSessionsUserId) Cols() []string {
+	return colsUploadSessionsUserId
+}
+
+func (idx UploadSessionsUserId) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForUploadSession(pb *schema.UploadSession) UploadSessionsPrimary {
+
+	Id := pb.IdCol()
+
+	return UploadSessionsPrimary{
+
+		Id: &Id,
+	}
+}
+
+var colsUploadSessions = []string{"id", "modified_ts", "user_id", "data"}
+
+func (j *Job) ScanUploadSessions(opts db.Opts, cb func(*schema.UploadSession) error) error {
+	return db.Scan(j.tx, "UploadSessions", opts, func(data []byte) error {
+		var pb schema.UploadSession
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindUploadSessions(opts db.Opts) (rows []*schema.UploadSession, err error) {
+	err = j.ScanUploadSessions(opts, func(data *schema.UploadSession) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ IdCol() int64 } = (*schema.UploadSession)(nil)
+
+var _ interface{ ModifiedTsCol() int64 } = (*schema.UploadSession)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.UploadSession)(nil)
+
+func (j *Job) InsertUploadSession(pb *schema.UploadSession) error {
+	return j.InsertUploadSessionRow(&UploadSessionRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		ModifiedTs: pb.ModifiedTsCol(),
+
+		UserId: pb.UserIdCol(),
+	})
+}
+
+func (j *Job) InsertUploadSessionRow(row *UploadSessionRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.ModifiedTs)
+
+	vals = append(vals, row.UserId)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "UploadSessions", colsUploadSessions, vals, j.adap)
+}
+
+var _ interface{ IdCol() int64 } = (*schema.UploadSession)(nil)
+
+var _ interface{ ModifiedTsCol() int64 } = (*schema.UploadSession)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.UploadSession)(nil)
+
+func (j *Job) UpdateUploadSession(pb *schema.UploadSession) error {
+	return j.UpdateUploadSessionRow(&UploadSessionRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		ModifiedTs: pb.ModifiedTsCol(),
+
+		UserId: pb.UserIdCol(),
+	})
+}
+
+func (j *Job) UpdateUploadSessionRow(row *UploadSessionRow) error {
+	key := KeyForUploadSession(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.ModifiedTs)
+
+	vals = append(vals, row.UserId)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "UploadSessions", colsUploadSessions, vals, key, j.adap)
+}
+
+func (j *Job) DeleteUploadSession(key UploadSessionsPrimary) error {
+	return db.Delete(j.tx, "UploadSessions", key, j.adap)
+}
+
+type InviteCodesPrimary struct {
+	Id *int64
+}
+
+func (_ InviteCodesPrimary) Unique() {}
+
+var _ db.UniqueIdx = InviteCodesPrimary{}
+
+var colsInviteCodesPrimary = []string{"id"}
+
+func (idx InviteCodesPrimary) Cols() []string {
+	return colsInviteCodesPrimary
+}
+
+func (idx InviteCodesPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type InviteCodesCodeHash struct {
+	CodeHash *[]byte
+}
+
+func (_ InviteCodesCodeHash) Unique() {}
+
+var _ db.UniqueIdx = InviteCodesCodeHash{}
+
+var colsInviteCodesCodeHash = []string{"code_hash"}
+
+func (idx InviteCodesCodeHash) Cols() []string {
+	return colsInviteCodesCodeHash
+}
+
+func (idx InviteCodesCodeHash) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.CodeHash != nil {
+		if done {
+			panic("Extra value CodeHash")
+		}
+		vals = append(vals, *idx.CodeHash)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForInviteCode(pb *schema.InviteCode) InviteCodesPrimary {
+
+	Id := pb.IdCol()
+
+	return InviteCodesPrimary{
+
+		Id: &Id,
+	}
+}
+
+var colsInviteCodes = []string{"id", "code_hash", "data"}
+
+func (j *Job) ScanInviteCodes(opts db.Opts, cb func(*schema.InviteCode) error) error {
+	return db.Scan(j.tx, "InviteCodes", opts, func(data []byte) error {
+		var pb schema.InviteCode
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindInviteCodes(opts db.Opts) (rows []*schema.InviteCode, err error) {
+	err = j.ScanInviteCodes(opts, func(data *schema.InviteCode) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ IdCol() int64 } = (*schema.InviteCode)(nil)
+
+var _ interface{ CodeHashCol() []byte } = (*schema.InviteCode)(nil)
+
+func (j *Job) InsertInviteCode(pb *schema.InviteCode) error {
+	return j.InsertInviteCodeRow(&InviteCodeRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		CodeHash: pb.CodeHashCol(),
+	})
+}
+
+func (j *Job) InsertInviteCodeRow(row *InviteCodeRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.CodeHash)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "InviteCodes", colsInviteCodes, vals, j.adap)
+}
+
+var _ interface{ IdCol() int64 } = (*schema.InviteCode)(nil)
+
+var _ interface{ CodeHashCol() []byte } = (*schema.InviteCode)(nil)
+
+func (j *Job) UpdateInviteCode(pb *schema.InviteCode) error {
+	return j.UpdateInviteCodeRow(&InviteCodeRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		CodeHash: pb.CodeHashCol(),
+	})
+}
+
+func (j *Job) UpdateInviteCodeRow(row *InviteCodeRow) error {
+	key := KeyForInviteCode(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.CodeHash)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "InviteCodes", colsInviteCodes, vals, key, j.adap)
+}
+
+func (j *Job) DeleteInviteCode(key InviteCodesPrimary) error {
+	return db.Delete(j.tx, "InviteCodes", key, j.adap)
+}
+
+type ApiKeysPrimary struct {
+	Id *int64
+}
+
+func (_ ApiKeysPrimary) Unique() {}
+
+var _ db.UniqueIdx = ApiKeysPrimary{}
+
+var colsApiKeysPrimary = []string{"id"}
+
+func (idx ApiKeysPrimary) Cols() []string {
+	return colsApiKeysPrimary
+}
+
+func (idx ApiKeysPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type ApiKeysKeyHash struct {
+	KeyHash *[]byte
+}
+
+func (_ ApiKeysKeyHash) Unique() {}
+
+var _ db.UniqueIdx = ApiKeysKeyHash{}
+
+var colsApiKeysKeyHash = []string{"key_hash"}
+
+func (idx ApiKeysKeyHash) Cols() []string {
+	return colsApiKeysKeyHash
+}
+
+func (idx ApiKeysKeyHash) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.KeyHash != nil {
+		if done {
+			panic("Extra value KeyHash")
+		}
+		vals = append(vals, *idx.KeyHash)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type ApiKeysUserId struct {
+	UserId *int64
+
+	Id *int64
+}
 
-			"UNIQUE(\"user_id\",\"pic_id\",\"index\"), " +
+var _ db.Idx = ApiKeysUserId{}
 
-			"PRIMARY KEY(\"pic_id\",\"user_id\",\"index\")" +
+var colsApiKeysUserId = []string{"user_id", "id"}
 
-			");",
+func (idx ApiKeysUserId) Cols() []string {
+	return colsApiKeysUserId
+}
 
-		"CREATE TABLE \"PicCommentVotes\" (" +
+func (idx ApiKeysUserId) Vals() (vals []interface{}) {
+	var done bool
 
-			"\"pic_id\" integer NOT NULL, " +
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
 
-			"\"comment_id\" integer NOT NULL, " +
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
 
-			"\"user_id\" integer NOT NULL, " +
+	return
+}
 
-			"\"index\" integer NOT NULL, " +
+func KeyForApiKey(pb *schema.ApiKey) ApiKeysPrimary {
 
-			"\"data\" blob NOT NULL, " +
+	Id := pb.IdCol()
 
-			"PRIMARY KEY(\"pic_id\",\"comment_id\",\"user_id\",\"index\")" +
+	return ApiKeysPrimary{
 
-			");",
+		Id: &Id,
+	}
+}
 
-		"CREATE TABLE \"Users\" (" +
+var colsApiKeys = []string{"id", "key_hash", "user_id", "data"}
 
-			"\"id\" integer NOT NULL, " +
+func (j *Job) ScanApiKeys(opts db.Opts, cb func(*schema.ApiKey) error) error {
+	return db.Scan(j.tx, "ApiKeys", opts, func(data []byte) error {
+		var pb schema.ApiKey
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
 
-			"\"ident\" blob NOT NULL, " +
+func (j *Job) FindApiKeys(opts db.Opts) (rows []*schema.ApiKey, err error) {
+	err = j.ScanApiKeys(opts, func(data *schema.ApiKey) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
 
-			"\"data\" blob NOT NULL, " +
+var _ interface{ IdCol() int64 } = (*schema.ApiKey)(nil)
 
-			"UNIQUE(\"ident\"), " +
+var _ interface{ KeyHashCol() []byte } = (*schema.ApiKey)(nil)
 
-			"PRIMARY KEY(\"id\")" +
+var _ interface{ UserIdCol() int64 } = (*schema.ApiKey)(nil)
 
-			");",
+func (j *Job) InsertApiKey(pb *schema.ApiKey) error {
+	return j.InsertApiKeyRow(&ApiKeyRow{
+		Data: pb,
 
-		"CREATE TABLE \"UserEvents\" (" +
+		Id: pb.IdCol(),
 
-			"\"user_id\" integer NOT NULL, " +
+		KeyHash: pb.KeyHashCol(),
 
-			"\"created_ts\" integer NOT NULL, " +
+		UserId: pb.UserIdCol(),
+	})
+}
 
-			"\"index\" integer NOT NULL, " +
+func (j *Job) InsertApiKeyRow(row *ApiKeyRow) error {
+	var vals []interface{}
 
-			"\"data\" blob NOT NULL, " +
+	vals = append(vals, row.Id)
 
-			"PRIMARY KEY(\"user_id\",\"created_ts\",\"index\")" +
+	vals = append(vals, row.KeyHash)
 
-			");",
+	vals = append(vals, row.UserId)
 
-		"CREATE TABLE \"CustomData\" (" +
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
 
-			"\"key_type\" integer NOT NULL, " +
+	return db.Insert(j.tx, "ApiKeys", colsApiKeys, vals, j.adap)
+}
 
-			"\"key1\" integer NOT NULL, " +
+var _ interface{ IdCol() int64 } = (*schema.ApiKey)(nil)
 
-			"\"key2\" integer NOT NULL, " +
+var _ interface{ KeyHashCol() []byte } = (*schema.ApiKey)(nil)
 
-			"\"key3\" integer NOT NULL, " +
+var _ interface{ UserIdCol() int64 } = (*schema.ApiKey)(nil)
 
-			"\"key4\" integer NOT NULL, " +
+func (j *Job) UpdateApiKey(pb *schema.ApiKey) error {
+	return j.UpdateApiKeyRow(&ApiKeyRow{
+		Data: pb,
 
-			"\"key5\" integer NOT NULL, " +
+		Id: pb.IdCol(),
 
-			"\"data\" blob NOT NULL, " +
+		KeyHash: pb.KeyHashCol(),
 
-			"PRIMARY KEY(\"key_type\",\"key1\",\"key2\",\"key3\",\"key4\",\"key5\")" +
+		UserId: pb.UserIdCol(),
+	})
+}
 
-			");",
+func (j *Job) UpdateApiKeyRow(row *ApiKeyRow) error {
+	key := KeyForApiKey(row.Data)
 
-		"CREATE TABLE \"_SequenceTable\" (\"the_sequence\" integer NOT NULL);",
-	},
-}
+	var vals []interface{}
 
-var SqlInitTables = map[string][]string{
+	vals = append(vals, row.Id)
 
-	"cockroach": {
-		"INSERT INTO \"_SequenceTable\" (\"the_sequence\") VALUES (1);",
-	},
+	vals = append(vals, row.KeyHash)
 
-	"mysql": {
-		"INSERT INTO `_SequenceTable` (`the_sequence`) VALUES (1);",
-	},
+	vals = append(vals, row.UserId)
 
-	"postgres": {
-		"INSERT INTO \"_SequenceTable\" (\"the_sequence\") VALUES (1);",
-	},
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
 
-	"sqlite3": {
-		"INSERT INTO \"_SequenceTable\" (\"the_sequence\") VALUES (1);",
-	},
+	return db.Update(j.tx, "ApiKeys", colsApiKeys, vals, key, j.adap)
 }
 
-type JobBeginner interface {
-	db.Beginner
-	db.DBAdaptable
+func (j *Job) DeleteApiKey(key ApiKeysPrimary) error {
+	return db.Delete(j.tx, "ApiKeys", key, j.adap)
 }
 
-func NewJob(ctx context.Context, beg JobBeginner) (*Job, error) {
-	return newJob(ctx, beg, false)
+type AuditLogsPrimary struct {
+	Id *int64
 }
 
-func NewReadonlyJob(ctx context.Context, beg JobBeginner) (*Job, error) {
-	return newJob(ctx, beg, true)
-}
+func (_ AuditLogsPrimary) Unique() {}
 
-func newJob(ctx context.Context, beg JobBeginner, readonly bool) (*Job, error) {
-	adap := beg.Adapter()
-	var alloc *db.IdAlloc
-	if all, ok := beg.(db.IdAllocatable); ok {
-		alloc = all.IdAllocator()
-		if err := db.PreallocateIds(ctx, beg, alloc, adap); err != nil {
-			return nil, err
-		}
-	}
-	tx, err := beg.Begin(ctx, readonly)
-	if err != nil {
-		return nil, err
-	}
-	j := &Job{
-		ctx:      ctx,
-		tx:       tx,
-		alloc:    alloc,
-		adap:     adap,
-		readonly: readonly,
-	}
-	runtime.SetFinalizer(j, jobCloser)
-	return j, nil
-}
+var _ db.UniqueIdx = AuditLogsPrimary{}
 
-type Job struct {
-	ctx      context.Context
-	tx       db.QuerierExecutorCommitter
-	adap     db.DBAdapter
-	alloc    *db.IdAlloc
-	done     bool
-	readonly bool
-}
+var colsAuditLogsPrimary = []string{"id"}
 
-func (j *Job) Commit() error {
-	defer runtime.SetFinalizer(j, nil)
-	err := j.tx.Commit()
-	if err == nil {
-		j.done = true
-	}
-	return err
+func (idx AuditLogsPrimary) Cols() []string {
+	return colsAuditLogsPrimary
 }
 
-func (j *Job) Rollback() error {
-	if j.done {
-		return nil
-	}
-	j.done = true
-	defer runtime.SetFinalizer(j, nil)
-	return j.tx.Rollback()
-}
+func (idx AuditLogsPrimary) Vals() (vals []interface{}) {
+	var done bool
 
-var jobCloser = func(j *Job) {
-	log.Println("warning: found orphaned job")
-	if err := j.Rollback(); err != nil {
-		log.Println("error rolling back orphaned job", err)
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
 	}
-}
 
-func (j *Job) AllocId() (int64, error) {
-	return db.AllocIdJob(j.ctx, j.tx, j.alloc, j.adap)
+	return
 }
 
-type PicsPrimary struct {
+type AuditLogsAction struct {
+	Action *schema.AuditLog_Action
+
 	Id *int64
 }
 
-func (_ PicsPrimary) Unique() {}
-
-var _ db.UniqueIdx = PicsPrimary{}
+var _ db.Idx = AuditLogsAction{}
 
-var colsPicsPrimary = []string{"id"}
+var colsAuditLogsAction = []string{"action", "id"}
 
-func (idx PicsPrimary) Cols() []string {
-	return colsPicsPrimary
+func (idx AuditLogsAction) Cols() []string {
+	return colsAuditLogsAction
 }
 
-func (idx PicsPrimary) Vals() (vals []interface{}) {
+func (idx AuditLogsAction) Vals() (vals []interface{}) {
 	var done bool
 
+	if idx.Action != nil {
+		if done {
+			panic("Extra value Action")
+		}
+		vals = append(vals, *idx.Action)
+	} else {
+		done = true
+	}
+
 	if idx.Id != nil {
 		if done {
 			panic("Extra value Id")
@@ -800,28 +4177,28 @@ func (idx PicsPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type PicsIndexOrder struct {
-	IndexOrder *int64
+type AuditLogsActorUserId struct {
+	ActorUserId *int64
 
 	Id *int64
 }
 
-var _ db.Idx = PicsIndexOrder{}
+var _ db.Idx = AuditLogsActorUserId{}
 
-var colsPicsIndexOrder = []string{"index_order", "id"}
+var colsAuditLogsActorUserId = []string{"actor_user_id", "id"}
 
-func (idx PicsIndexOrder) Cols() []string {
-	return colsPicsIndexOrder
+func (idx AuditLogsActorUserId) Cols() []string {
+	return colsAuditLogsActorUserId
 }
 
-func (idx PicsIndexOrder) Vals() (vals []interface{}) {
+func (idx AuditLogsActorUserId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.IndexOrder != nil {
+	if idx.ActorUserId != nil {
 		if done {
-			panic("Extra value IndexOrder")
+			panic("Extra value ActorUserId")
 		}
-		vals = append(vals, *idx.IndexOrder)
+		vals = append(vals, *idx.ActorUserId)
 	} else {
 		done = true
 	}
@@ -838,28 +4215,28 @@ func (idx PicsIndexOrder) Vals() (vals []interface{}) {
 	return
 }
 
-type PicsScoreOrder struct {
-	ScoreOrder *int32
+type AuditLogsTargetUserId struct {
+	TargetUserId *int64
 
 	Id *int64
 }
 
-var _ db.Idx = PicsScoreOrder{}
+var _ db.Idx = AuditLogsTargetUserId{}
 
-var colsPicsScoreOrder = []string{"score_order", "id"}
+var colsAuditLogsTargetUserId = []string{"target_user_id", "id"}
 
-func (idx PicsScoreOrder) Cols() []string {
-	return colsPicsScoreOrder
+func (idx AuditLogsTargetUserId) Cols() []string {
+	return colsAuditLogsTargetUserId
 }
 
-func (idx PicsScoreOrder) Vals() (vals []interface{}) {
+func (idx AuditLogsTargetUserId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.ScoreOrder != nil {
+	if idx.TargetUserId != nil {
 		if done {
-			panic("Extra value ScoreOrder")
+			panic("Extra value TargetUserId")
 		}
-		vals = append(vals, *idx.ScoreOrder)
+		vals = append(vals, *idx.TargetUserId)
 	} else {
 		done = true
 	}
@@ -876,28 +4253,28 @@ func (idx PicsScoreOrder) Vals() (vals []interface{}) {
 	return
 }
 
-type PicsSchedOrder struct {
-	SchedOrder *int32
+type AuditLogsTargetPicId struct {
+	TargetPicId *int64
 
 	Id *int64
 }
 
-var _ db.Idx = PicsSchedOrder{}
+var _ db.Idx = AuditLogsTargetPicId{}
 
-var colsPicsSchedOrder = []string{"sched_order", "id"}
+var colsAuditLogsTargetPicId = []string{"target_pic_id", "id"}
 
-func (idx PicsSchedOrder) Cols() []string {
-	return colsPicsSchedOrder
+func (idx AuditLogsTargetPicId) Cols() []string {
+	return colsAuditLogsTargetPicId
 }
 
-func (idx PicsSchedOrder) Vals() (vals []interface{}) {
+func (idx AuditLogsTargetPicId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.SchedOrder != nil {
+	if idx.TargetPicId != nil {
 		if done {
-			panic("Extra value SchedOrder")
+			panic("Extra value TargetPicId")
 		}
-		vals = append(vals, *idx.SchedOrder)
+		vals = append(vals, *idx.TargetPicId)
 	} else {
 		done = true
 	}
@@ -914,21 +4291,21 @@ func (idx PicsSchedOrder) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPic(pb *schema.Pic) PicsPrimary {
+func KeyForAuditLog(pb *schema.AuditLog) AuditLogsPrimary {
 
 	Id := pb.IdCol()
 
-	return PicsPrimary{
+	return AuditLogsPrimary{
 
 		Id: &Id,
 	}
 }
 
-var colsPics = []string{"id", "index_order", "score_order", "sched_order", "data"}
+var colsAuditLogs = []string{"id", "action", "actor_user_id", "target_user_id", "target_pic_id", "data"}
 
-func (j *Job) ScanPics(opts db.Opts, cb func(*schema.Pic) error) error {
-	return db.Scan(j.tx, "Pics", opts, func(data []byte) error {
-		var pb schema.Pic
+func (j *Job) ScanAuditLogs(opts db.Opts, cb func(*schema.AuditLog) error) error {
+	return db.Scan(j.tx, "AuditLogs", opts, func(data []byte) error {
+		var pb schema.AuditLog
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -936,46 +4313,52 @@ func (j *Job) ScanPics(opts db.Opts, cb func(*schema.Pic) error) error {
 	}, j.adap)
 }
 
-func (j *Job) FindPics(opts db.Opts) (rows []*schema.Pic, err error) {
-	err = j.ScanPics(opts, func(data *schema.Pic) error {
+func (j *Job) FindAuditLogs(opts db.Opts) (rows []*schema.AuditLog, err error) {
+	err = j.ScanAuditLogs(opts, func(data *schema.AuditLog) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ IdCol() int64 } = (*schema.Pic)(nil)
+var _ interface{ IdCol() int64 } = (*schema.AuditLog)(nil)
 
-var _ interface{ IndexOrderCol() int64 } = (*schema.Pic)(nil)
+var _ interface{ ActionCol() schema.AuditLog_Action } = (*schema.AuditLog)(nil)
 
-var _ interface{ LowerScoreBound() int32 } = (*schema.Pic)(nil)
+var _ interface{ ActorUserIdCol() int64 } = (*schema.AuditLog)(nil)
 
-var _ interface{ UpperScoreBound() int32 } = (*schema.Pic)(nil)
+var _ interface{ TargetUserIdCol() int64 } = (*schema.AuditLog)(nil)
 
-func (j *Job) InsertPic(pb *schema.Pic) error {
-	return j.InsertPicRow(&PicRow{
+var _ interface{ TargetPicIdCol() int64 } = (*schema.AuditLog)(nil)
+
+func (j *Job) InsertAuditLog(pb *schema.AuditLog) error {
+	return j.InsertAuditLogRow(&AuditLogRow{
 		Data: pb,
 
 		Id: pb.IdCol(),
 
-		IndexOrder: pb.IndexOrderCol(),
+		Action: pb.ActionCol(),
 
-		ScoreOrder: pb.LowerScoreBound(),
+		ActorUserId: pb.ActorUserIdCol(),
 
-		SchedOrder: pb.UpperScoreBound(),
+		TargetUserId: pb.TargetUserIdCol(),
+
+		TargetPicId: pb.TargetPicIdCol(),
 	})
 }
 
-func (j *Job) InsertPicRow(row *PicRow) error {
+func (j *Job) InsertAuditLogRow(row *AuditLogRow) error {
 	var vals []interface{}
 
 	vals = append(vals, row.Id)
 
-	vals = append(vals, row.IndexOrder)
+	vals = append(vals, row.Action)
 
-	vals = append(vals, row.ScoreOrder)
+	vals = append(vals, row.ActorUserId)
 
-	vals = append(vals, row.SchedOrder)
+	vals = append(vals, row.TargetUserId)
+
+	vals = append(vals, row.TargetPicId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -983,43 +4366,49 @@ func (j *Job) InsertPicRow(row *PicRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "Pics", colsPics, vals, j.adap)
+	return db.Insert(j.tx, "AuditLogs", colsAuditLogs, vals, j.adap)
 }
 
-var _ interface{ IdCol() int64 } = (*schema.Pic)(nil)
+var _ interface{ IdCol() int64 } = (*schema.AuditLog)(nil)
+
+var _ interface{ ActionCol() schema.AuditLog_Action } = (*schema.AuditLog)(nil)
 
-var _ interface{ IndexOrderCol() int64 } = (*schema.Pic)(nil)
+var _ interface{ ActorUserIdCol() int64 } = (*schema.AuditLog)(nil)
 
-var _ interface{ LowerScoreBound() int32 } = (*schema.Pic)(nil)
+var _ interface{ TargetUserIdCol() int64 } = (*schema.AuditLog)(nil)
 
-var _ interface{ UpperScoreBound() int32 } = (*schema.Pic)(nil)
+var _ interface{ TargetPicIdCol() int64 } = (*schema.AuditLog)(nil)
 
-func (j *Job) UpdatePic(pb *schema.Pic) error {
-	return j.UpdatePicRow(&PicRow{
+func (j *Job) UpdateAuditLog(pb *schema.AuditLog) error {
+	return j.UpdateAuditLogRow(&AuditLogRow{
 		Data: pb,
 
 		Id: pb.IdCol(),
 
-		IndexOrder: pb.IndexOrderCol(),
+		Action: pb.ActionCol(),
 
-		ScoreOrder: pb.LowerScoreBound(),
+		ActorUserId: pb.ActorUserIdCol(),
 
-		SchedOrder: pb.UpperScoreBound(),
+		TargetUserId: pb.TargetUserIdCol(),
+
+		TargetPicId: pb.TargetPicIdCol(),
 	})
 }
 
-func (j *Job) UpdatePicRow(row *PicRow) error {
-	key := KeyForPic(row.Data)
+func (j *Job) UpdateAuditLogRow(row *AuditLogRow) error {
+	key := KeyForAuditLog(row.Data)
 
 	var vals []interface{}
 
 	vals = append(vals, row.Id)
 
-	vals = append(vals, row.IndexOrder)
+	vals = append(vals, row.Action)
 
-	vals = append(vals, row.ScoreOrder)
+	vals = append(vals, row.ActorUserId)
 
-	vals = append(vals, row.SchedOrder)
+	vals = append(vals, row.TargetUserId)
+
+	vals = append(vals, row.TargetPicId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1027,28 +4416,28 @@ func (j *Job) UpdatePicRow(row *PicRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "Pics", colsPics, vals, key, j.adap)
+	return db.Update(j.tx, "AuditLogs", colsAuditLogs, vals, key, j.adap)
 }
 
-func (j *Job) DeletePic(key PicsPrimary) error {
-	return db.Delete(j.tx, "Pics", key, j.adap)
+func (j *Job) DeleteAuditLog(key AuditLogsPrimary) error {
+	return db.Delete(j.tx, "AuditLogs", key, j.adap)
 }
 
-type TagsPrimary struct {
+type UserLinksPrimary struct {
 	Id *int64
 }
 
-func (_ TagsPrimary) Unique() {}
+func (_ UserLinksPrimary) Unique() {}
 
-var _ db.UniqueIdx = TagsPrimary{}
+var _ db.UniqueIdx = UserLinksPrimary{}
 
-var colsTagsPrimary = []string{"id"}
+var colsUserLinksPrimary = []string{"id"}
 
-func (idx TagsPrimary) Cols() []string {
-	return colsTagsPrimary
+func (idx UserLinksPrimary) Cols() []string {
+	return colsUserLinksPrimary
 }
 
-func (idx TagsPrimary) Vals() (vals []interface{}) {
+func (idx UserLinksPrimary) Vals() (vals []interface{}) {
 	var done bool
 
 	if idx.Id != nil {
@@ -1063,28 +4452,39 @@ func (idx TagsPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type TagsName struct {
-	Name *string
+type UserLinksProviderSubject struct {
+	Provider *string
+
+	Subject *string
 }
 
-func (_ TagsName) Unique() {}
+func (_ UserLinksProviderSubject) Unique() {}
 
-var _ db.UniqueIdx = TagsName{}
+var _ db.UniqueIdx = UserLinksProviderSubject{}
 
-var colsTagsName = []string{"name"}
+var colsUserLinksProviderSubject = []string{"provider", "subject"}
 
-func (idx TagsName) Cols() []string {
-	return colsTagsName
+func (idx UserLinksProviderSubject) Cols() []string {
+	return colsUserLinksProviderSubject
 }
 
-func (idx TagsName) Vals() (vals []interface{}) {
+func (idx UserLinksProviderSubject) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.Name != nil {
+	if idx.Provider != nil {
 		if done {
-			panic("Extra value Name")
+			panic("Extra value Provider")
 		}
-		vals = append(vals, *idx.Name)
+		vals = append(vals, *idx.Provider)
+	} else {
+		done = true
+	}
+
+	if idx.Subject != nil {
+		if done {
+			panic("Extra value Subject")
+		}
+		vals = append(vals, *idx.Subject)
 	} else {
 		done = true
 	}
@@ -1092,21 +4492,59 @@ func (idx TagsName) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForTag(pb *schema.Tag) TagsPrimary {
+type UserLinksUserId struct {
+	UserId *int64
+
+	Id *int64
+}
+
+var _ db.Idx = UserLinksUserId{}
+
+var colsUserLinksUserId = []string{"user_id", "id"}
+
+func (idx UserLinksUserId) Cols() []string {
+	return colsUserLinksUserId
+}
+
+func (idx UserLinksUserId) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForUserLink(pb *schema.UserLink) UserLinksPrimary {
 
 	Id := pb.IdCol()
 
-	return TagsPrimary{
+	return UserLinksPrimary{
 
 		Id: &Id,
 	}
 }
 
-var colsTags = []string{"id", "name", "data"}
+var colsUserLinks = []string{"id", "provider", "subject", "user_id", "data"}
 
-func (j *Job) ScanTags(opts db.Opts, cb func(*schema.Tag) error) error {
-	return db.Scan(j.tx, "Tags", opts, func(data []byte) error {
-		var pb schema.Tag
+func (j *Job) ScanUserLinks(opts db.Opts, cb func(*schema.UserLink) error) error {
+	return db.Scan(j.tx, "UserLinks", opts, func(data []byte) error {
+		var pb schema.UserLink
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -1114,34 +4552,46 @@ func (j *Job) ScanTags(opts db.Opts, cb func(*schema.Tag) error) error {
 	}, j.adap)
 }
 
-func (j *Job) FindTags(opts db.Opts) (rows []*schema.Tag, err error) {
-	err = j.ScanTags(opts, func(data *schema.Tag) error {
+func (j *Job) FindUserLinks(opts db.Opts) (rows []*schema.UserLink, err error) {
+	err = j.ScanUserLinks(opts, func(data *schema.UserLink) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ IdCol() int64 } = (*schema.Tag)(nil)
+var _ interface{ IdCol() int64 } = (*schema.UserLink)(nil)
 
-var _ interface{ NameCol() string } = (*schema.Tag)(nil)
+var _ interface{ ProviderCol() string } = (*schema.UserLink)(nil)
 
-func (j *Job) InsertTag(pb *schema.Tag) error {
-	return j.InsertTagRow(&TagRow{
+var _ interface{ SubjectCol() string } = (*schema.UserLink)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.UserLink)(nil)
+
+func (j *Job) InsertUserLink(pb *schema.UserLink) error {
+	return j.InsertUserLinkRow(&UserLinkRow{
 		Data: pb,
 
 		Id: pb.IdCol(),
 
-		Name: pb.NameCol(),
+		Provider: pb.ProviderCol(),
+
+		Subject: pb.SubjectCol(),
+
+		UserId: pb.UserIdCol(),
 	})
 }
 
-func (j *Job) InsertTagRow(row *TagRow) error {
+func (j *Job) InsertUserLinkRow(row *UserLinkRow) error {
 	var vals []interface{}
 
 	vals = append(vals, row.Id)
 
-	vals = append(vals, row.Name)
+	vals = append(vals, row.Provider)
+
+	vals = append(vals, row.Subject)
+
+	vals = append(vals, row.UserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1149,31 +4599,43 @@ func (j *Job) InsertTagRow(row *TagRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "Tags", colsTags, vals, j.adap)
+	return db.Insert(j.tx, "UserLinks", colsUserLinks, vals, j.adap)
 }
 
-var _ interface{ IdCol() int64 } = (*schema.Tag)(nil)
+var _ interface{ IdCol() int64 } = (*schema.UserLink)(nil)
 
-var _ interface{ NameCol() string } = (*schema.Tag)(nil)
+var _ interface{ ProviderCol() string } = (*schema.UserLink)(nil)
 
-func (j *Job) UpdateTag(pb *schema.Tag) error {
-	return j.UpdateTagRow(&TagRow{
+var _ interface{ SubjectCol() string } = (*schema.UserLink)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.UserLink)(nil)
+
+func (j *Job) UpdateUserLink(pb *schema.UserLink) error {
+	return j.UpdateUserLinkRow(&UserLinkRow{
 		Data: pb,
 
 		Id: pb.IdCol(),
 
-		Name: pb.NameCol(),
+		Provider: pb.ProviderCol(),
+
+		Subject: pb.SubjectCol(),
+
+		UserId: pb.UserIdCol(),
 	})
 }
 
-func (j *Job) UpdateTagRow(row *TagRow) error {
-	key := KeyForTag(row.Data)
+func (j *Job) UpdateUserLinkRow(row *UserLinkRow) error {
+	key := KeyForUserLink(row.Data)
 
 	var vals []interface{}
 
 	vals = append(vals, row.Id)
 
-	vals = append(vals, row.Name)
+	vals = append(vals, row.Provider)
+
+	vals = append(vals, row.Subject)
+
+	vals = append(vals, row.UserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1181,46 +4643,64 @@ func (j *Job) UpdateTagRow(row *TagRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "Tags", colsTags, vals, key, j.adap)
+	return db.Update(j.tx, "UserLinks", colsUserLinks, vals, key, j.adap)
 }
 
-func (j *Job) DeleteTag(key TagsPrimary) error {
-	return db.Delete(j.tx, "Tags", key, j.adap)
+func (j *Job) DeleteUserLink(key UserLinksPrimary) error {
+	return db.Delete(j.tx, "UserLinks", key, j.adap)
 }
 
-type PicTagsPrimary struct {
-	PicId *int64
-
-	TagId *int64
+type LoginFailuresPrimary struct {
+	Id *int64
 }
 
-func (_ PicTagsPrimary) Unique() {}
+func (_ LoginFailuresPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicTagsPrimary{}
+var _ db.UniqueIdx = LoginFailuresPrimary{}
 
-var colsPicTagsPrimary = []string{"pic_id", "tag_id"}
+var colsLoginFailuresPrimary = []string{"id"}
 
-func (idx PicTagsPrimary) Cols() []string {
-	return colsPicTagsPrimary
+func (idx LoginFailuresPrimary) Cols() []string {
+	return colsLoginFailuresPrimary
 }
 
-func (idx PicTagsPrimary) Vals() (vals []interface{}) {
+func (idx LoginFailuresPrimary) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.PicId != nil {
+	if idx.Id != nil {
 		if done {
-			panic("Extra value PicId")
+			panic("Extra value Id")
 		}
-		vals = append(vals, *idx.PicId)
+		vals = append(vals, *idx.Id)
 	} else {
 		done = true
 	}
 
-	if idx.TagId != nil {
+	return
+}
+
+type LoginFailuresSubject struct {
+	Subject *string
+}
+
+func (_ LoginFailuresSubject) Unique() {}
+
+var _ db.UniqueIdx = LoginFailuresSubject{}
+
+var colsLoginFailuresSubject = []string{"subject"}
+
+func (idx LoginFailuresSubject) Cols() []string {
+	return colsLoginFailuresSubject
+}
+
+func (idx LoginFailuresSubject) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Subject != nil {
 		if done {
-			panic("Extra value TagId")
+			panic("Extra value Subject")
 		}
-		vals = append(vals, *idx.TagId)
+		vals = append(vals, *idx.Subject)
 	} else {
 		done = true
 	}
@@ -1228,25 +4708,21 @@ func (idx PicTagsPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPicTag(pb *schema.PicTag) PicTagsPrimary {
-
-	PicId := pb.PicIdCol()
-
-	TagId := pb.TagIdCol()
+func KeyForLoginFailure(pb *schema.LoginFailure) LoginFailuresPrimary {
 
-	return PicTagsPrimary{
+	Id := pb.IdCol()
 
-		PicId: &PicId,
+	return LoginFailuresPrimary{
 
-		TagId: &TagId,
+		Id: &Id,
 	}
 }
 
-var colsPicTags = []string{"pic_id", "tag_id", "data"}
+var colsLoginFailures = []string{"id", "subject", "data"}
 
-func (j *Job) ScanPicTags(opts db.Opts, cb func(*schema.PicTag) error) error {
-	return db.Scan(j.tx, "PicTags", opts, func(data []byte) error {
-		var pb schema.PicTag
+func (j *Job) ScanLoginFailures(opts db.Opts, cb func(*schema.LoginFailure) error) error {
+	return db.Scan(j.tx, "LoginFailures", opts, func(data []byte) error {
+		var pb schema.LoginFailure
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -1254,34 +4730,34 @@ func (j *Job) ScanPicTags(opts db.Opts, cb func(*schema.PicTag) error) error {
 	}, j.adap)
 }
 
-func (j *Job) FindPicTags(opts db.Opts) (rows []*schema.PicTag, err error) {
-	err = j.ScanPicTags(opts, func(data *schema.PicTag) error {
+func (j *Job) FindLoginFailures(opts db.Opts) (rows []*schema.LoginFailure, err error) {
+	err = j.ScanLoginFailures(opts, func(data *schema.LoginFailure) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicTag)(nil)
+var _ interface{ IdCol() int64 } = (*schema.LoginFailure)(nil)
 
-var _ interface{ TagIdCol() int64 } = (*schema.PicTag)(nil)
+var _ interface{ SubjectCol() string } = (*schema.LoginFailure)(nil)
 
-func (j *Job) InsertPicTag(pb *schema.PicTag) error {
-	return j.InsertPicTagRow(&PicTagRow{
+func (j *Job) InsertLoginFailure(pb *schema.LoginFailure) error {
+	return j.InsertLoginFailureRow(&LoginFailureRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		Id: pb.IdCol(),
 
-		TagId: pb.TagIdCol(),
+		Subject: pb.SubjectCol(),
 	})
 }
 
-func (j *Job) InsertPicTagRow(row *PicTagRow) error {
+func (j *Job) InsertLoginFailureRow(row *LoginFailureRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.Id)
 
-	vals = append(vals, row.TagId)
+	vals = append(vals, row.Subject)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1289,31 +4765,31 @@ func (j *Job) InsertPicTagRow(row *PicTagRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicTags", colsPicTags, vals, j.adap)
+	return db.Insert(j.tx, "LoginFailures", colsLoginFailures, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicTag)(nil)
+var _ interface{ IdCol() int64 } = (*schema.LoginFailure)(nil)
 
-var _ interface{ TagIdCol() int64 } = (*schema.PicTag)(nil)
+var _ interface{ SubjectCol() string } = (*schema.LoginFailure)(nil)
 
-func (j *Job) UpdatePicTag(pb *schema.PicTag) error {
-	return j.UpdatePicTagRow(&PicTagRow{
+func (j *Job) UpdateLoginFailure(pb *schema.LoginFailure) error {
+	return j.UpdateLoginFailureRow(&LoginFailureRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		Id: pb.IdCol(),
 
-		TagId: pb.TagIdCol(),
+		Subject: pb.SubjectCol(),
 	})
 }
 
-func (j *Job) UpdatePicTagRow(row *PicTagRow) error {
-	key := KeyForPicTag(row.Data)
+func (j *Job) UpdateLoginFailureRow(row *LoginFailureRow) error {
+	key := KeyForLoginFailure(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.Id)
 
-	vals = append(vals, row.TagId)
+	vals = append(vals, row.Subject)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1321,57 +4797,75 @@ func (j *Job) UpdatePicTagRow(row *PicTagRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicTags", colsPicTags, vals, key, j.adap)
+	return db.Update(j.tx, "LoginFailures", colsLoginFailures, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicTag(key PicTagsPrimary) error {
-	return db.Delete(j.tx, "PicTags", key, j.adap)
+func (j *Job) DeleteLoginFailure(key LoginFailuresPrimary) error {
+	return db.Delete(j.tx, "LoginFailures", key, j.adap)
 }
 
-type PicIdentsPrimary struct {
-	PicId *int64
-
-	Type *schema.PicIdent_Type
-
-	Value *[]byte
+type PicFavoritesPrimary struct {
+	Id *int64
 }
 
-func (_ PicIdentsPrimary) Unique() {}
+func (_ PicFavoritesPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicIdentsPrimary{}
+var _ db.UniqueIdx = PicFavoritesPrimary{}
 
-var colsPicIdentsPrimary = []string{"pic_id", "type", "value"}
+var colsPicFavoritesPrimary = []string{"id"}
 
-func (idx PicIdentsPrimary) Cols() []string {
-	return colsPicIdentsPrimary
+func (idx PicFavoritesPrimary) Cols() []string {
+	return colsPicFavoritesPrimary
 }
 
-func (idx PicIdentsPrimary) Vals() (vals []interface{}) {
+func (idx PicFavoritesPrimary) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.PicId != nil {
+	if idx.Id != nil {
 		if done {
-			panic("Extra value PicId")
+			panic("Extra value Id")
 		}
-		vals = append(vals, *idx.PicId)
+		vals = append(vals, *idx.Id)
 	} else {
 		done = true
 	}
 
-	if idx.Type != nil {
+	return
+}
+
+type PicFavoritesPicIdUserId struct {
+	PicId *int64
+
+	UserId *int64
+}
+
+func (_ PicFavoritesPicIdUserId) Unique() {}
+
+var _ db.UniqueIdx = PicFavoritesPicIdUserId{}
+
+var colsPicFavoritesPicIdUserId = []string{"pic_id", "user_id"}
+
+func (idx PicFavoritesPicIdUserId) Cols() []string {
+	return colsPicFavoritesPicIdUserId
+}
+
+func (idx PicFavoritesPicIdUserId) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PicId != nil {
 		if done {
-			panic("Extra value Type")
+			panic("Extra value PicId")
 		}
-		vals = append(vals, *idx.Type)
+		vals = append(vals, *idx.PicId)
 	} else {
 		done = true
 	}
 
-	if idx.Value != nil {
+	if idx.UserId != nil {
 		if done {
-			panic("Extra value Value")
+			panic("Extra value UserId")
 		}
-		vals = append(vals, *idx.Value)
+		vals = append(vals, *idx.UserId)
 	} else {
 		done = true
 	}
@@ -1379,37 +4873,37 @@ func (idx PicIdentsPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type PicIdentsIdent struct {
-	Type *schema.PicIdent_Type
+type PicFavoritesUserId struct {
+	UserId *int64
 
-	Value *[]byte
+	Id *int64
 }
 
-var _ db.Idx = PicIdentsIdent{}
+var _ db.Idx = PicFavoritesUserId{}
 
-var colsPicIdentsIdent = []string{"type", "value"}
+var colsPicFavoritesUserId = []string{"user_id", "id"}
 
-func (idx PicIdentsIdent) Cols() []string {
-	return colsPicIdentsIdent
+func (idx PicFavoritesUserId) Cols() []string {
+	return colsPicFavoritesUserId
 }
 
-func (idx PicIdentsIdent) Vals() (vals []interface{}) {
+func (idx PicFavoritesUserId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.Type != nil {
+	if idx.UserId != nil {
 		if done {
-			panic("Extra value Type")
+			panic("Extra value UserId")
 		}
-		vals = append(vals, *idx.Type)
+		vals = append(vals, *idx.UserId)
 	} else {
 		done = true
 	}
 
-	if idx.Value != nil {
+	if idx.Id != nil {
 		if done {
-			panic("Extra value Value")
+			panic("Extra value Id")
 		}
-		vals = append(vals, *idx.Value)
+		vals = append(vals, *idx.Id)
 	} else {
 		done = true
 	}
@@ -1417,29 +4911,21 @@ func (idx PicIdentsIdent) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPicIdent(pb *schema.PicIdent) PicIdentsPrimary {
-
-	PicId := pb.PicIdCol()
-
-	Type := pb.TypeCol()
-
-	Value := pb.ValueCol()
-
-	return PicIdentsPrimary{
+func KeyForPicFavorite(pb *schema.PicFavorite) PicFavoritesPrimary {
 
-		PicId: &PicId,
+	Id := pb.IdCol()
 
-		Type: &Type,
+	return PicFavoritesPrimary{
 
-		Value: &Value,
+		Id: &Id,
 	}
 }
 
-var colsPicIdents = []string{"pic_id", "type", "value", "data"}
+var colsPicFavorites = []string{"id", "pic_id", "user_id", "data"}
 
-func (j *Job) ScanPicIdents(opts db.Opts, cb func(*schema.PicIdent) error) error {
-	return db.Scan(j.tx, "PicIdents", opts, func(data []byte) error {
-		var pb schema.PicIdent
+func (j *Job) ScanPicFavorites(opts db.Opts, cb func(*schema.PicFavorite) error) error {
+	return db.Scan(j.tx, "PicFavorites", opts, func(data []byte) error {
+		var pb schema.PicFavorite
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -1447,40 +4933,40 @@ func (j *Job) ScanPicIdents(opts db.Opts, cb func(*schema.PicIdent) error) error
 	}, j.adap)
 }
 
-func (j *Job) FindPicIdents(opts db.Opts) (rows []*schema.PicIdent, err error) {
-	err = j.ScanPicIdents(opts, func(data *schema.PicIdent) error {
+func (j *Job) FindPicFavorites(opts db.Opts) (rows []*schema.PicFavorite, err error) {
+	err = j.ScanPicFavorites(opts, func(data *schema.PicFavorite) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicIdent)(nil)
+var _ interface{ IdCol() int64 } = (*schema.PicFavorite)(nil)
 
-var _ interface{ TypeCol() schema.PicIdent_Type } = (*schema.PicIdent)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PicFavorite)(nil)
 
-var _ interface{ ValueCol() []byte } = (*schema.PicIdent)(nil)
+var _ interface{ UserIdCol() int64 } = (*schema.PicFavorite)(nil)
 
-func (j *Job) InsertPicIdent(pb *schema.PicIdent) error {
-	return j.InsertPicIdentRow(&PicIdentRow{
+func (j *Job) InsertPicFavorite(pb *schema.PicFavorite) error {
+	return j.InsertPicFavoriteRow(&PicFavoriteRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		Id: pb.IdCol(),
 
-		Type: pb.TypeCol(),
+		PicId: pb.PicIdCol(),
 
-		Value: pb.ValueCol(),
+		UserId: pb.UserIdCol(),
 	})
 }
 
-func (j *Job) InsertPicIdentRow(row *PicIdentRow) error {
+func (j *Job) InsertPicFavoriteRow(row *PicFavoriteRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.Id)
 
-	vals = append(vals, row.Type)
+	vals = append(vals, row.PicId)
 
-	vals = append(vals, row.Value)
+	vals = append(vals, row.UserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1488,37 +4974,37 @@ func (j *Job) InsertPicIdentRow(row *PicIdentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicIdents", colsPicIdents, vals, j.adap)
+	return db.Insert(j.tx, "PicFavorites", colsPicFavorites, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicIdent)(nil)
+var _ interface{ IdCol() int64 } = (*schema.PicFavorite)(nil)
 
-var _ interface{ TypeCol() schema.PicIdent_Type } = (*schema.PicIdent)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PicFavorite)(nil)
 
-var _ interface{ ValueCol() []byte } = (*schema.PicIdent)(nil)
+var _ interface{ UserIdCol() int64 } = (*schema.PicFavorite)(nil)
 
-func (j *Job) UpdatePicIdent(pb *schema.PicIdent) error {
-	return j.UpdatePicIdentRow(&PicIdentRow{
+func (j *Job) UpdatePicFavorite(pb *schema.PicFavorite) error {
+	return j.UpdatePicFavoriteRow(&PicFavoriteRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		Id: pb.IdCol(),
 
-		Type: pb.TypeCol(),
+		PicId: pb.PicIdCol(),
 
-		Value: pb.ValueCol(),
+		UserId: pb.UserIdCol(),
 	})
 }
 
-func (j *Job) UpdatePicIdentRow(row *PicIdentRow) error {
-	key := KeyForPicIdent(row.Data)
+func (j *Job) UpdatePicFavoriteRow(row *PicFavoriteRow) error {
+	key := KeyForPicFavorite(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.Id)
 
-	vals = append(vals, row.Type)
+	vals = append(vals, row.PicId)
 
-	vals = append(vals, row.Value)
+	vals = append(vals, row.UserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1526,46 +5012,35 @@ func (j *Job) UpdatePicIdentRow(row *PicIdentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicIdents", colsPicIdents, vals, key, j.adap)
+	return db.Update(j.tx, "PicFavorites", colsPicFavorites, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicIdent(key PicIdentsPrimary) error {
-	return db.Delete(j.tx, "PicIdents", key, j.adap)
+func (j *Job) DeletePicFavorite(key PicFavoritesPrimary) error {
+	return db.Delete(j.tx, "PicFavorites", key, j.adap)
 }
 
-type PicCommentsPrimary struct {
-	PicId *int64
-
-	CommentId *int64
+type CollectionsPrimary struct {
+	Id *int64
 }
 
-func (_ PicCommentsPrimary) Unique() {}
+func (_ CollectionsPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicCommentsPrimary{}
+var _ db.UniqueIdx = CollectionsPrimary{}
 
-var colsPicCommentsPrimary = []string{"pic_id", "comment_id"}
+var colsCollectionsPrimary = []string{"id"}
 
-func (idx PicCommentsPrimary) Cols() []string {
-	return colsPicCommentsPrimary
+func (idx CollectionsPrimary) Cols() []string {
+	return colsCollectionsPrimary
 }
 
-func (idx PicCommentsPrimary) Vals() (vals []interface{}) {
+func (idx CollectionsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.PicId != nil {
-		if done {
-			panic("Extra value PicId")
-		}
-		vals = append(vals, *idx.PicId)
-	} else {
-		done = true
-	}
-
-	if idx.CommentId != nil {
+	if idx.Id != nil {
 		if done {
-			panic("Extra value CommentId")
+			panic("Extra value Id")
 		}
-		vals = append(vals, *idx.CommentId)
+		vals = append(vals, *idx.Id)
 	} else {
 		done = true
 	}
@@ -1573,28 +5048,37 @@ func (idx PicCommentsPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type PicCommentsCommentId struct {
-	CommentId *int64
-}
+type CollectionsUserId struct {
+	UserId *int64
 
-func (_ PicCommentsCommentId) Unique() {}
+	Id *int64
+}
 
-var _ db.UniqueIdx = PicCommentsCommentId{}
+var _ db.Idx = CollectionsUserId{}
 
-var colsPicCommentsCommentId = []string{"comment_id"}
+var colsCollectionsUserId = []string{"user_id", "id"}
 
-func (idx PicCommentsCommentId) Cols() []string {
-	return colsPicCommentsCommentId
+func (idx CollectionsUserId) Cols() []string {
+	return colsCollectionsUserId
 }
 
-func (idx PicCommentsCommentId) Vals() (vals []interface{}) {
+func (idx CollectionsUserId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.CommentId != nil {
+	if idx.UserId != nil {
 		if done {
-			panic("Extra value CommentId")
+			panic("Extra value UserId")
 		}
-		vals = append(vals, *idx.CommentId)
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
 	} else {
 		done = true
 	}
@@ -1602,25 +5086,21 @@ func (idx PicCommentsCommentId) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPicComment(pb *schema.PicComment) PicCommentsPrimary {
-
-	PicId := pb.PicIdCol()
-
-	CommentId := pb.CommentIdCol()
+func KeyForCollection(pb *schema.Collection) CollectionsPrimary {
 
-	return PicCommentsPrimary{
+	Id := pb.IdCol()
 
-		PicId: &PicId,
+	return CollectionsPrimary{
 
-		CommentId: &CommentId,
+		Id: &Id,
 	}
 }
 
-var colsPicComments = []string{"pic_id", "comment_id", "data"}
+var colsCollections = []string{"id", "user_id", "data"}
 
-func (j *Job) ScanPicComments(opts db.Opts, cb func(*schema.PicComment) error) error {
-	return db.Scan(j.tx, "PicComments", opts, func(data []byte) error {
-		var pb schema.PicComment
+func (j *Job) ScanCollections(opts db.Opts, cb func(*schema.Collection) error) error {
+	return db.Scan(j.tx, "Collections", opts, func(data []byte) error {
+		var pb schema.Collection
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -1628,34 +5108,34 @@ func (j *Job) ScanPicComments(opts db.Opts, cb func(*schema.PicComment) error) e
 	}, j.adap)
 }
 
-func (j *Job) FindPicComments(opts db.Opts) (rows []*schema.PicComment, err error) {
-	err = j.ScanPicComments(opts, func(data *schema.PicComment) error {
+func (j *Job) FindCollections(opts db.Opts) (rows []*schema.Collection, err error) {
+	err = j.ScanCollections(opts, func(data *schema.Collection) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicComment)(nil)
+var _ interface{ IdCol() int64 } = (*schema.Collection)(nil)
 
-var _ interface{ CommentIdCol() int64 } = (*schema.PicComment)(nil)
+var _ interface{ UserIdCol() int64 } = (*schema.Collection)(nil)
 
-func (j *Job) InsertPicComment(pb *schema.PicComment) error {
-	return j.InsertPicCommentRow(&PicCommentRow{
+func (j *Job) InsertCollection(pb *schema.Collection) error {
+	return j.InsertCollectionRow(&CollectionRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		Id: pb.IdCol(),
 
-		CommentId: pb.CommentIdCol(),
+		UserId: pb.UserIdCol(),
 	})
 }
 
-func (j *Job) InsertPicCommentRow(row *PicCommentRow) error {
+func (j *Job) InsertCollectionRow(row *CollectionRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.Id)
 
-	vals = append(vals, row.CommentId)
+	vals = append(vals, row.UserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1663,31 +5143,31 @@ func (j *Job) InsertPicCommentRow(row *PicCommentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicComments", colsPicComments, vals, j.adap)
+	return db.Insert(j.tx, "Collections", colsCollections, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicComment)(nil)
+var _ interface{ IdCol() int64 } = (*schema.Collection)(nil)
 
-var _ interface{ CommentIdCol() int64 } = (*schema.PicComment)(nil)
+var _ interface{ UserIdCol() int64 } = (*schema.Collection)(nil)
 
-func (j *Job) UpdatePicComment(pb *schema.PicComment) error {
-	return j.UpdatePicCommentRow(&PicCommentRow{
+func (j *Job) UpdateCollection(pb *schema.Collection) error {
+	return j.UpdateCollectionRow(&CollectionRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		Id: pb.IdCol(),
 
-		CommentId: pb.CommentIdCol(),
+		UserId: pb.UserIdCol(),
 	})
 }
 
-func (j *Job) UpdatePicCommentRow(row *PicCommentRow) error {
-	key := KeyForPicComment(row.Data)
+func (j *Job) UpdateCollectionRow(row *CollectionRow) error {
+	key := KeyForCollection(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.Id)
 
-	vals = append(vals, row.CommentId)
+	vals = append(vals, row.UserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1695,34 +5175,41 @@ func (j *Job) UpdatePicCommentRow(row *PicCommentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicComments", colsPicComments, vals, key, j.adap)
+	return db.Update(j.tx, "Collections", colsCollections, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicComment(key PicCommentsPrimary) error {
-	return db.Delete(j.tx, "PicComments", key, j.adap)
+func (j *Job) DeleteCollection(key CollectionsPrimary) error {
+	return db.Delete(j.tx, "Collections", key, j.adap)
 }
 
-type PicVotesPrimary struct {
-	PicId *int64
-
-	UserId *int64
+type CollectionPicsPrimary struct {
+	CollectionId *int64
 
-	Index *int64
+	PicId *int64
 }
 
-func (_ PicVotesPrimary) Unique() {}
+func (_ CollectionPicsPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicVotesPrimary{}
+var _ db.UniqueIdx = CollectionPicsPrimary{}
 
-var colsPicVotesPrimary = []string{"pic_id", "user_id", "index"}
+var colsCollectionPicsPrimary = []string{"collection_id", "pic_id"}
 
-func (idx PicVotesPrimary) Cols() []string {
-	return colsPicVotesPrimary
+func (idx CollectionPicsPrimary) Cols() []string {
+	return colsCollectionPicsPrimary
 }
 
-func (idx PicVotesPrimary) Vals() (vals []interface{}) {
+func (idx CollectionPicsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
+	if idx.CollectionId != nil {
+		if done {
+			panic("Extra value CollectionId")
+		}
+		vals = append(vals, *idx.CollectionId)
+	} else {
+		done = true
+	}
+
 	if idx.PicId != nil {
 		if done {
 			panic("Extra value PicId")
@@ -1732,20 +5219,40 @@ func (idx PicVotesPrimary) Vals() (vals []interface{}) {
 		done = true
 	}
 
-	if idx.UserId != nil {
+	return
+}
+
+type CollectionPicsPosition struct {
+	CollectionId *int64
+
+	Position *int64
+}
+
+var _ db.Idx = CollectionPicsPosition{}
+
+var colsCollectionPicsPosition = []string{"collection_id", "position"}
+
+func (idx CollectionPicsPosition) Cols() []string {
+	return colsCollectionPicsPosition
+}
+
+func (idx CollectionPicsPosition) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.CollectionId != nil {
 		if done {
-			panic("Extra value UserId")
+			panic("Extra value CollectionId")
 		}
-		vals = append(vals, *idx.UserId)
+		vals = append(vals, *idx.CollectionId)
 	} else {
 		done = true
 	}
 
-	if idx.Index != nil {
+	if idx.Position != nil {
 		if done {
-			panic("Extra value Index")
+			panic("Extra value Position")
 		}
-		vals = append(vals, *idx.Index)
+		vals = append(vals, *idx.Position)
 	} else {
 		done = true
 	}
@@ -1753,36 +5260,23 @@ func (idx PicVotesPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type PicVotesUserId struct {
-	UserId *int64
-
+type CollectionPicsPicId struct {
 	PicId *int64
 
-	Index *int64
+	CollectionId *int64
 }
 
-func (_ PicVotesUserId) Unique() {}
-
-var _ db.UniqueIdx = PicVotesUserId{}
+var _ db.Idx = CollectionPicsPicId{}
 
-var colsPicVotesUserId = []string{"user_id", "pic_id", "index"}
+var colsCollectionPicsPicId = []string{"pic_id", "collection_id"}
 
-func (idx PicVotesUserId) Cols() []string {
-	return colsPicVotesUserId
+func (idx CollectionPicsPicId) Cols() []string {
+	return colsCollectionPicsPicId
 }
 
-func (idx PicVotesUserId) Vals() (vals []interface{}) {
+func (idx CollectionPicsPicId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.UserId != nil {
-		if done {
-			panic("Extra value UserId")
-		}
-		vals = append(vals, *idx.UserId)
-	} else {
-		done = true
-	}
-
 	if idx.PicId != nil {
 		if done {
 			panic("Extra value PicId")
@@ -1792,11 +5286,11 @@ func (idx PicVotesUserId) Vals() (vals []interface{}) {
 		done = true
 	}
 
-	if idx.Index != nil {
+	if idx.CollectionId != nil {
 		if done {
-			panic("Extra value Index")
+			panic("Extra value CollectionId")
 		}
-		vals = append(vals, *idx.Index)
+		vals = append(vals, *idx.CollectionId)
 	} else {
 		done = true
 	}
@@ -1804,29 +5298,25 @@ func (idx PicVotesUserId) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPicVote(pb *schema.PicVote) PicVotesPrimary {
+func KeyForCollectionPic(pb *schema.CollectionPic) CollectionPicsPrimary {
 
-	PicId := pb.PicIdCol()
+	CollectionId := pb.CollectionIdCol()
 
-	UserId := pb.UserIdCol()
+	PicId := pb.PicIdCol()
 
-	Index := pb.IndexCol()
+	return CollectionPicsPrimary{
 
-	return PicVotesPrimary{
+		CollectionId: &CollectionId,
 
 		PicId: &PicId,
-
-		UserId: &UserId,
-
-		Index: &Index,
 	}
 }
 
-var colsPicVotes = []string{"pic_id", "user_id", "index", "data"}
+var colsCollectionPics = []string{"collection_id", "pic_id", "position", "data"}
 
-func (j *Job) ScanPicVotes(opts db.Opts, cb func(*schema.PicVote) error) error {
-	return db.Scan(j.tx, "PicVotes", opts, func(data []byte) error {
-		var pb schema.PicVote
+func (j *Job) ScanCollectionPics(opts db.Opts, cb func(*schema.CollectionPic) error) error {
+	return db.Scan(j.tx, "CollectionPics", opts, func(data []byte) error {
+		var pb schema.CollectionPic
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -1834,40 +5324,40 @@ func (j *Job) ScanPicVotes(opts db.Opts, cb func(*schema.PicVote) error) error {
 	}, j.adap)
 }
 
-func (j *Job) FindPicVotes(opts db.Opts) (rows []*schema.PicVote, err error) {
-	err = j.ScanPicVotes(opts, func(data *schema.PicVote) error {
+func (j *Job) FindCollectionPics(opts db.Opts) (rows []*schema.CollectionPic, err error) {
+	err = j.ScanCollectionPics(opts, func(data *schema.CollectionPic) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ CollectionIdCol() int64 } = (*schema.CollectionPic)(nil)
 
-var _ interface{ UserIdCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.CollectionPic)(nil)
 
-var _ interface{ IndexCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ PositionCol() int64 } = (*schema.CollectionPic)(nil)
 
-func (j *Job) InsertPicVote(pb *schema.PicVote) error {
-	return j.InsertPicVoteRow(&PicVoteRow{
+func (j *Job) InsertCollectionPic(pb *schema.CollectionPic) error {
+	return j.InsertCollectionPicRow(&CollectionPicRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		CollectionId: pb.CollectionIdCol(),
 
-		UserId: pb.UserIdCol(),
+		PicId: pb.PicIdCol(),
 
-		Index: pb.IndexCol(),
+		Position: pb.PositionCol(),
 	})
 }
 
-func (j *Job) InsertPicVoteRow(row *PicVoteRow) error {
+func (j *Job) InsertCollectionPicRow(row *CollectionPicRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.CollectionId)
 
-	vals = append(vals, row.UserId)
+	vals = append(vals, row.PicId)
 
-	vals = append(vals, row.Index)
+	vals = append(vals, row.Position)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1875,37 +5365,37 @@ func (j *Job) InsertPicVoteRow(row *PicVoteRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicVotes", colsPicVotes, vals, j.adap)
+	return db.Insert(j.tx, "CollectionPics", colsCollectionPics, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ CollectionIdCol() int64 } = (*schema.CollectionPic)(nil)
 
-var _ interface{ UserIdCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.CollectionPic)(nil)
 
-var _ interface{ IndexCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ PositionCol() int64 } = (*schema.CollectionPic)(nil)
 
-func (j *Job) UpdatePicVote(pb *schema.PicVote) error {
-	return j.UpdatePicVoteRow(&PicVoteRow{
+func (j *Job) UpdateCollectionPic(pb *schema.CollectionPic) error {
+	return j.UpdateCollectionPicRow(&CollectionPicRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		CollectionId: pb.CollectionIdCol(),
 
-		UserId: pb.UserIdCol(),
+		PicId: pb.PicIdCol(),
 
-		Index: pb.IndexCol(),
+		Position: pb.PositionCol(),
 	})
 }
 
-func (j *Job) UpdatePicVoteRow(row *PicVoteRow) error {
-	key := KeyForPicVote(row.Data)
+func (j *Job) UpdateCollectionPicRow(row *CollectionPicRow) error {
+	key := KeyForCollectionPic(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.CollectionId)
 
-	vals = append(vals, row.UserId)
+	vals = append(vals, row.PicId)
 
-	vals = append(vals, row.Index)
+	vals = append(vals, row.Position)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1913,68 +5403,35 @@ func (j *Job) UpdatePicVoteRow(row *PicVoteRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicVotes", colsPicVotes, vals, key, j.adap)
+	return db.Update(j.tx, "CollectionPics", colsCollectionPics, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicVote(key PicVotesPrimary) error {
-	return db.Delete(j.tx, "PicVotes", key, j.adap)
+func (j *Job) DeleteCollectionPic(key CollectionPicsPrimary) error {
+	return db.Delete(j.tx, "CollectionPics", key, j.adap)
 }
 
-type PicCommentVotesPrimary struct {
-	PicId *int64
-
-	CommentId *int64
-
-	UserId *int64
-
-	Index *int64
+type PoolsPrimary struct {
+	Id *int64
 }
 
-func (_ PicCommentVotesPrimary) Unique() {}
+func (_ PoolsPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicCommentVotesPrimary{}
+var _ db.UniqueIdx = PoolsPrimary{}
 
-var colsPicCommentVotesPrimary = []string{"pic_id", "comment_id", "user_id", "index"}
+var colsPoolsPrimary = []string{"id"}
 
-func (idx PicCommentVotesPrimary) Cols() []string {
-	return colsPicCommentVotesPrimary
+func (idx PoolsPrimary) Cols() []string {
+	return colsPoolsPrimary
 }
 
-func (idx PicCommentVotesPrimary) Vals() (vals []interface{}) {
+func (idx PoolsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.PicId != nil {
-		if done {
-			panic("Extra value PicId")
-		}
-		vals = append(vals, *idx.PicId)
-	} else {
-		done = true
-	}
-
-	if idx.CommentId != nil {
-		if done {
-			panic("Extra value CommentId")
-		}
-		vals = append(vals, *idx.CommentId)
-	} else {
-		done = true
-	}
-
-	if idx.UserId != nil {
-		if done {
-			panic("Extra value UserId")
-		}
-		vals = append(vals, *idx.UserId)
-	} else {
-		done = true
-	}
-
-	if idx.Index != nil {
+	if idx.Id != nil {
 		if done {
-			panic("Extra value Index")
+			panic("Extra value Id")
 		}
-		vals = append(vals, *idx.Index)
+		vals = append(vals, *idx.Id)
 	} else {
 		done = true
 	}
@@ -1982,33 +5439,21 @@ func (idx PicCommentVotesPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPicCommentVote(pb *schema.PicCommentVote) PicCommentVotesPrimary {
-
-	PicId := pb.PicIdCol()
-
-	CommentId := pb.CommentIdCol()
-
-	UserId := pb.UserIdCol()
-
-	Index := pb.IndexCol()
-
-	return PicCommentVotesPrimary{
-
-		PicId: &PicId,
+func KeyForPool(pb *schema.Pool) PoolsPrimary {
 
-		CommentId: &CommentId,
+	Id := pb.IdCol()
 
-		UserId: &UserId,
+	return PoolsPrimary{
 
-		Index: &Index,
+		Id: &Id,
 	}
 }
 
-var colsPicCommentVotes = []string{"pic_id", "comment_id", "user_id", "index", "data"}
+var colsPools = []string{"id", "data"}
 
-func (j *Job) ScanPicCommentVotes(opts db.Opts, cb func(*schema.PicCommentVote) error) error {
-	return db.Scan(j.tx, "PicCommentVotes", opts, func(data []byte) error {
-		var pb schema.PicCommentVote
+func (j *Job) ScanPools(opts db.Opts, cb func(*schema.Pool) error) error {
+	return db.Scan(j.tx, "Pools", opts, func(data []byte) error {
+		var pb schema.Pool
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -2016,46 +5461,28 @@ func (j *Job) ScanPicCommentVotes(opts db.Opts, cb func(*schema.PicCommentVote)
 	}, j.adap)
 }
 
-func (j *Job) FindPicCommentVotes(opts db.Opts) (rows []*schema.PicCommentVote, err error) {
-	err = j.ScanPicCommentVotes(opts, func(data *schema.PicCommentVote) error {
+func (j *Job) FindPools(opts db.Opts) (rows []*schema.Pool, err error) {
+	err = j.ScanPools(opts, func(data *schema.Pool) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ CommentIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ UserIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ IndexCol() int64 } = (*schema.PicCommentVote)(nil)
+var _ interface{ IdCol() int64 } = (*schema.Pool)(nil)
 
-func (j *Job) InsertPicCommentVote(pb *schema.PicCommentVote) error {
-	return j.InsertPicVoteCommentRow(&PicVoteCommentRow{
+func (j *Job) InsertPool(pb *schema.Pool) error {
+	return j.InsertPoolRow(&PoolRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
-
-		CommentId: pb.CommentIdCol(),
-
-		UserId: pb.UserIdCol(),
-
-		Index: pb.IndexCol(),
+		Id: pb.IdCol(),
 	})
 }
 
-func (j *Job) InsertPicVoteCommentRow(row *PicVoteCommentRow) error {
+func (j *Job) InsertPoolRow(row *PoolRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
-
-	vals = append(vals, row.CommentId)
-
-	vals = append(vals, row.UserId)
-
-	vals = append(vals, row.Index)
+	vals = append(vals, row.Id)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2063,43 +5490,25 @@ func (j *Job) InsertPicVoteCommentRow(row *PicVoteCommentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicCommentVotes", colsPicCommentVotes, vals, j.adap)
+	return db.Insert(j.tx, "Pools", colsPools, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ CommentIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ UserIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ IndexCol() int64 } = (*schema.PicCommentVote)(nil)
+var _ interface{ IdCol() int64 } = (*schema.Pool)(nil)
 
-func (j *Job) UpdatePicCommentVote(pb *schema.PicCommentVote) error {
-	return j.UpdatePicVoteCommentRow(&PicVoteCommentRow{
+func (j *Job) UpdatePool(pb *schema.Pool) error {
+	return j.UpdatePoolRow(&PoolRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
-
-		CommentId: pb.CommentIdCol(),
-
-		UserId: pb.UserIdCol(),
-
-		Index: pb.IndexCol(),
+		Id: pb.IdCol(),
 	})
 }
 
-func (j *Job) UpdatePicVoteCommentRow(row *PicVoteCommentRow) error {
-	key := KeyForPicCommentVote(row.Data)
+func (j *Job) UpdatePoolRow(row *PoolRow) error {
+	key := KeyForPool(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
-
-	vals = append(vals, row.CommentId)
-
-	vals = append(vals, row.UserId)
-
-	vals = append(vals, row.Index)
+	vals = append(vals, row.Id)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2107,35 +5516,46 @@ func (j *Job) UpdatePicVoteCommentRow(row *PicVoteCommentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicCommentVotes", colsPicCommentVotes, vals, key, j.adap)
+	return db.Update(j.tx, "Pools", colsPools, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicCommentVote(key PicCommentVotesPrimary) error {
-	return db.Delete(j.tx, "PicCommentVotes", key, j.adap)
+func (j *Job) DeletePool(key PoolsPrimary) error {
+	return db.Delete(j.tx, "Pools", key, j.adap)
 }
 
-type UsersPrimary struct {
-	Id *int64
+type PoolPicsPrimary struct {
+	PoolId *int64
+
+	PicId *int64
 }
 
-func (_ UsersPrimary) Unique() {}
+func (_ PoolPicsPrimary) Unique() {}
 
-var _ db.UniqueIdx = UsersPrimary{}
+var _ db.UniqueIdx = PoolPicsPrimary{}
 
-var colsUsersPrimary = []string{"id"}
+var colsPoolPicsPrimary = []string{"pool_id", "pic_id"}
 
-func (idx UsersPrimary) Cols() []string {
-	return colsUsersPrimary
+func (idx PoolPicsPrimary) Cols() []string {
+	return colsPoolPicsPrimary
 }
 
-func (idx UsersPrimary) Vals() (vals []interface{}) {
+func (idx PoolPicsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.Id != nil {
+	if idx.PoolId != nil {
 		if done {
-			panic("Extra value Id")
+			panic("Extra value PoolId")
 		}
-		vals = append(vals, *idx.Id)
+		vals = append(vals, *idx.PoolId)
+	} else {
+		done = true
+	}
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
 	} else {
 		done = true
 	}
@@ -2143,28 +5563,75 @@ func (idx UsersPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type UsersIdent struct {
-	Ident *string
+type PoolPicsPosition struct {
+	PoolId *int64
+
+	Position *int64
 }
 
-func (_ UsersIdent) Unique() {}
+var _ db.Idx = PoolPicsPosition{}
 
-var _ db.UniqueIdx = UsersIdent{}
+var colsPoolPicsPosition = []string{"pool_id", "position"}
 
-var colsUsersIdent = []string{"ident"}
+func (idx PoolPicsPosition) Cols() []string {
+	return colsPoolPicsPosition
+}
 
-func (idx UsersIdent) Cols() []string {
-	return colsUsersIdent
+func (idx PoolPicsPosition) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PoolId != nil {
+		if done {
+			panic("Extra value PoolId")
+		}
+		vals = append(vals, *idx.PoolId)
+	} else {
+		done = true
+	}
+
+	if idx.Position != nil {
+		if done {
+			panic("Extra value Position")
+		}
+		vals = append(vals, *idx.Position)
+	} else {
+		done = true
+	}
+
+	return
 }
 
-func (idx UsersIdent) Vals() (vals []interface{}) {
+type PoolPicsPicId struct {
+	PicId *int64
+
+	PoolId *int64
+}
+
+var _ db.Idx = PoolPicsPicId{}
+
+var colsPoolPicsPicId = []string{"pic_id", "pool_id"}
+
+func (idx PoolPicsPicId) Cols() []string {
+	return colsPoolPicsPicId
+}
+
+func (idx PoolPicsPicId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.Ident != nil {
+	if idx.PicId != nil {
 		if done {
-			panic("Extra value Ident")
+			panic("Extra value PicId")
 		}
-		vals = append(vals, *idx.Ident)
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.PoolId != nil {
+		if done {
+			panic("Extra value PoolId")
+		}
+		vals = append(vals, *idx.PoolId)
 	} else {
 		done = true
 	}
@@ -2172,21 +5639,25 @@ func (idx UsersIdent) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForUser(pb *schema.User) UsersPrimary {
+func KeyForPoolPic(pb *schema.PoolPic) PoolPicsPrimary {
 
-	Id := pb.IdCol()
+	PoolId := pb.PoolIdCol()
 
-	return UsersPrimary{
+	PicId := pb.PicIdCol()
 
-		Id: &Id,
+	return PoolPicsPrimary{
+
+		PoolId: &PoolId,
+
+		PicId: &PicId,
 	}
 }
 
-var colsUsers = []string{"id", "ident", "data"}
+var colsPoolPics = []string{"pool_id", "pic_id", "position", "data"}
 
-func (j *Job) ScanUsers(opts db.Opts, cb func(*schema.User) error) error {
-	return db.Scan(j.tx, "Users", opts, func(data []byte) error {
-		var pb schema.User
+func (j *Job) ScanPoolPics(opts db.Opts, cb func(*schema.PoolPic) error) error {
+	return db.Scan(j.tx, "PoolPics", opts, func(data []byte) error {
+		var pb schema.PoolPic
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -2194,34 +5665,40 @@ func (j *Job) ScanUsers(opts db.Opts, cb func(*schema.User) error) error {
 	}, j.adap)
 }
 
-func (j *Job) FindUsers(opts db.Opts) (rows []*schema.User, err error) {
-	err = j.ScanUsers(opts, func(data *schema.User) error {
+func (j *Job) FindPoolPics(opts db.Opts) (rows []*schema.PoolPic, err error) {
+	err = j.ScanPoolPics(opts, func(data *schema.PoolPic) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ IdCol() int64 } = (*schema.User)(nil)
+var _ interface{ PoolIdCol() int64 } = (*schema.PoolPic)(nil)
 
-var _ interface{ IdentCol() string } = (*schema.User)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PoolPic)(nil)
 
-func (j *Job) InsertUser(pb *schema.User) error {
-	return j.InsertUserRow(&UserRow{
+var _ interface{ PositionCol() int64 } = (*schema.PoolPic)(nil)
+
+func (j *Job) InsertPoolPic(pb *schema.PoolPic) error {
+	return j.InsertPoolPicRow(&PoolPicRow{
 		Data: pb,
 
-		Id: pb.IdCol(),
+		PoolId: pb.PoolIdCol(),
 
-		Ident: pb.IdentCol(),
+		PicId: pb.PicIdCol(),
+
+		Position: pb.PositionCol(),
 	})
 }
 
-func (j *Job) InsertUserRow(row *UserRow) error {
+func (j *Job) InsertPoolPicRow(row *PoolPicRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.Id)
+	vals = append(vals, row.PoolId)
 
-	vals = append(vals, row.Ident)
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.Position)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2229,31 +5706,37 @@ func (j *Job) InsertUserRow(row *UserRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "Users", colsUsers, vals, j.adap)
+	return db.Insert(j.tx, "PoolPics", colsPoolPics, vals, j.adap)
 }
 
-var _ interface{ IdCol() int64 } = (*schema.User)(nil)
+var _ interface{ PoolIdCol() int64 } = (*schema.PoolPic)(nil)
 
-var _ interface{ IdentCol() string } = (*schema.User)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PoolPic)(nil)
 
-func (j *Job) UpdateUser(pb *schema.User) error {
-	return j.UpdateUserRow(&UserRow{
+var _ interface{ PositionCol() int64 } = (*schema.PoolPic)(nil)
+
+func (j *Job) UpdatePoolPic(pb *schema.PoolPic) error {
+	return j.UpdatePoolPicRow(&PoolPicRow{
 		Data: pb,
 
-		Id: pb.IdCol(),
+		PoolId: pb.PoolIdCol(),
 
-		Ident: pb.IdentCol(),
+		PicId: pb.PicIdCol(),
+
+		Position: pb.PositionCol(),
 	})
 }
 
-func (j *Job) UpdateUserRow(row *UserRow) error {
-	key := KeyForUser(row.Data)
+func (j *Job) UpdatePoolPicRow(row *PoolPicRow) error {
+	key := KeyForPoolPic(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.Id)
+	vals = append(vals, row.PoolId)
+
+	vals = append(vals, row.PicId)
 
-	vals = append(vals, row.Ident)
+	vals = append(vals, row.Position)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2261,32 +5744,30 @@ func (j *Job) UpdateUserRow(row *UserRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "Users", colsUsers, vals, key, j.adap)
+	return db.Update(j.tx, "PoolPics", colsPoolPics, vals, key, j.adap)
 }
 
-func (j *Job) DeleteUser(key UsersPrimary) error {
-	return db.Delete(j.tx, "Users", key, j.adap)
+func (j *Job) DeletePoolPic(key PoolPicsPrimary) error {
+	return db.Delete(j.tx, "PoolPics", key, j.adap)
 }
 
-type UserEventsPrimary struct {
+type UserFollowsPrimary struct {
 	UserId *int64
 
-	CreatedTs *int64
-
-	Index *int64
+	FolloweeUserId *int64
 }
 
-func (_ UserEventsPrimary) Unique() {}
+func (_ UserFollowsPrimary) Unique() {}
 
-var _ db.UniqueIdx = UserEventsPrimary{}
+var _ db.UniqueIdx = UserFollowsPrimary{}
 
-var colsUserEventsPrimary = []string{"user_id", "created_ts", "index"}
+var colsUserFollowsPrimary = []string{"user_id", "followee_user_id"}
 
-func (idx UserEventsPrimary) Cols() []string {
-	return colsUserEventsPrimary
+func (idx UserFollowsPrimary) Cols() []string {
+	return colsUserFollowsPrimary
 }
 
-func (idx UserEventsPrimary) Vals() (vals []interface{}) {
+func (idx UserFollowsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
 	if idx.UserId != nil {
@@ -2298,20 +5779,49 @@ func (idx UserEventsPrimary) Vals() (vals []interface{}) {
 		done = true
 	}
 
-	if idx.CreatedTs != nil {
+	if idx.FolloweeUserId != nil {
 		if done {
-			panic("Extra value CreatedTs")
+			panic("Extra value FolloweeUserId")
 		}
-		vals = append(vals, *idx.CreatedTs)
+		vals = append(vals, *idx.FolloweeUserId)
 	} else {
 		done = true
 	}
 
-	if idx.Index != nil {
+	return
+}
+
+type UserFollowsFolloweeUserId struct {
+	FolloweeUserId *int64
+
+	UserId *int64
+}
+
+var _ db.Idx = UserFollowsFolloweeUserId{}
+
+var colsUserFollowsFolloweeUserId = []string{"followee_user_id", "user_id"}
+
+func (idx UserFollowsFolloweeUserId) Cols() []string {
+	return colsUserFollowsFolloweeUserId
+}
+
+func (idx UserFollowsFolloweeUserId) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.FolloweeUserId != nil {
 		if done {
-			panic("Extra value Index")
+			panic("Extra value FolloweeUserId")
 		}
-		vals = append(vals, *idx.Index)
+		vals = append(vals, *idx.FolloweeUserId)
+	} else {
+		done = true
+	}
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
 	} else {
 		done = true
 	}
@@ -2319,29 +5829,25 @@ func (idx UserEventsPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForUserEvent(pb *schema.UserEvent) UserEventsPrimary {
+func KeyForUserFollow(pb *schema.UserFollow) UserFollowsPrimary {
 
 	UserId := pb.UserIdCol()
 
-	CreatedTs := pb.CreatedTsCol()
-
-	Index := pb.IndexCol()
+	FolloweeUserId := pb.FolloweeUserIdCol()
 
-	return UserEventsPrimary{
+	return UserFollowsPrimary{
 
 		UserId: &UserId,
 
-		CreatedTs: &CreatedTs,
-
-		Index: &Index,
+		FolloweeUserId: &FolloweeUserId,
 	}
 }
 
-var colsUserEvents = []string{"user_id", "created_ts", "index", "data"}
+var colsUserFollows = []string{"user_id", "followee_user_id", "data"}
 
-func (j *Job) ScanUserEvents(opts db.Opts, cb func(*schema.UserEvent) error) error {
-	return db.Scan(j.tx, "UserEvents", opts, func(data []byte) error {
-		var pb schema.UserEvent
+func (j *Job) ScanUserFollows(opts db.Opts, cb func(*schema.UserFollow) error) error {
+	return db.Scan(j.tx, "UserFollows", opts, func(data []byte) error {
+		var pb schema.UserFollow
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -2349,40 +5855,34 @@ func (j *Job) ScanUserEvents(opts db.Opts, cb func(*schema.UserEvent) error) err
 	}, j.adap)
 }
 
-func (j *Job) FindUserEvents(opts db.Opts) (rows []*schema.UserEvent, err error) {
-	err = j.ScanUserEvents(opts, func(data *schema.UserEvent) error {
+func (j *Job) FindUserFollows(opts db.Opts) (rows []*schema.UserFollow, err error) {
+	err = j.ScanUserFollows(opts, func(data *schema.UserFollow) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ UserIdCol() int64 } = (*schema.UserEvent)(nil)
-
-var _ interface{ CreatedTsCol() int64 } = (*schema.UserEvent)(nil)
+var _ interface{ UserIdCol() int64 } = (*schema.UserFollow)(nil)
 
-var _ interface{ IndexCol() int64 } = (*schema.UserEvent)(nil)
+var _ interface{ FolloweeUserIdCol() int64 } = (*schema.UserFollow)(nil)
 
-func (j *Job) InsertUserEvent(pb *schema.UserEvent) error {
-	return j.InsertUserEventRow(&UserEventRow{
+func (j *Job) InsertUserFollow(pb *schema.UserFollow) error {
+	return j.InsertUserFollowRow(&UserFollowRow{
 		Data: pb,
 
 		UserId: pb.UserIdCol(),
 
-		CreatedTs: pb.CreatedTsCol(),
-
-		Index: pb.IndexCol(),
+		FolloweeUserId: pb.FolloweeUserIdCol(),
 	})
 }
 
-func (j *Job) InsertUserEventRow(row *UserEventRow) error {
+func (j *Job) InsertUserFollowRow(row *UserFollowRow) error {
 	var vals []interface{}
 
 	vals = append(vals, row.UserId)
 
-	vals = append(vals, row.CreatedTs)
-
-	vals = append(vals, row.Index)
+	vals = append(vals, row.FolloweeUserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2390,37 +5890,31 @@ func (j *Job) InsertUserEventRow(row *UserEventRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "UserEvents", colsUserEvents, vals, j.adap)
+	return db.Insert(j.tx, "UserFollows", colsUserFollows, vals, j.adap)
 }
 
-var _ interface{ UserIdCol() int64 } = (*schema.UserEvent)(nil)
-
-var _ interface{ CreatedTsCol() int64 } = (*schema.UserEvent)(nil)
+var _ interface{ UserIdCol() int64 } = (*schema.UserFollow)(nil)
 
-var _ interface{ IndexCol() int64 } = (*schema.UserEvent)(nil)
+var _ interface{ FolloweeUserIdCol() int64 } = (*schema.UserFollow)(nil)
 
-func (j *Job) UpdateUserEvent(pb *schema.UserEvent) error {
-	return j.UpdateUserEventRow(&UserEventRow{
+func (j *Job) UpdateUserFollow(pb *schema.UserFollow) error {
+	return j.UpdateUserFollowRow(&UserFollowRow{
 		Data: pb,
 
 		UserId: pb.UserIdCol(),
 
-		CreatedTs: pb.CreatedTsCol(),
-
-		Index: pb.IndexCol(),
+		FolloweeUserId: pb.FolloweeUserIdCol(),
 	})
 }
 
-func (j *Job) UpdateUserEventRow(row *UserEventRow) error {
-	key := KeyForUserEvent(row.Data)
+func (j *Job) UpdateUserFollowRow(row *UserFollowRow) error {
+	key := KeyForUserFollow(row.Data)
 
 	var vals []interface{}
 
 	vals = append(vals, row.UserId)
 
-	vals = append(vals, row.CreatedTs)
-
-	vals = append(vals, row.Index)
+	vals = append(vals, row.FolloweeUserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2428,90 +5922,46 @@ func (j *Job) UpdateUserEventRow(row *UserEventRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "UserEvents", colsUserEvents, vals, key, j.adap)
+	return db.Update(j.tx, "UserFollows", colsUserFollows, vals, key, j.adap)
 }
 
-func (j *Job) DeleteUserEvent(key UserEventsPrimary) error {
-	return db.Delete(j.tx, "UserEvents", key, j.adap)
+func (j *Job) DeleteUserFollow(key UserFollowsPrimary) error {
+	return db.Delete(j.tx, "UserFollows", key, j.adap)
 }
 
-type CustomDataPrimary struct {
-	KeyType *int64
-
-	Key1 *int64
-
-	Key2 *int64
-
-	Key3 *int64
-
-	Key4 *int64
+type TagFollowsPrimary struct {
+	UserId *int64
 
-	Key5 *int64
+	TagName *string
 }
 
-func (_ CustomDataPrimary) Unique() {}
+func (_ TagFollowsPrimary) Unique() {}
 
-var _ db.UniqueIdx = CustomDataPrimary{}
+var _ db.UniqueIdx = TagFollowsPrimary{}
 
-var colsCustomDataPrimary = []string{"key_type", "key1", "key2", "key3", "key4", "key5"}
+var colsTagFollowsPrimary = []string{"user_id", "tag_name"}
 
-func (idx CustomDataPrimary) Cols() []string {
-	return colsCustomDataPrimary
+func (idx TagFollowsPrimary) Cols() []string {
+	return colsTagFollowsPrimary
 }
 
-func (idx CustomDataPrimary) Vals() (vals []interface{}) {
+func (idx TagFollowsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.KeyType != nil {
-		if done {
-			panic("Extra value KeyType")
-		}
-		vals = append(vals, *idx.KeyType)
-	} else {
-		done = true
-	}
-
-	if idx.Key1 != nil {
-		if done {
-			panic("Extra value Key1")
-		}
-		vals = append(vals, *idx.Key1)
-	} else {
-		done = true
-	}
-
-	if idx.Key2 != nil {
-		if done {
-			panic("Extra value Key2")
-		}
-		vals = append(vals, *idx.Key2)
-	} else {
-		done = true
-	}
-
-	if idx.Key3 != nil {
-		if done {
-			panic("Extra value Key3")
-		}
-		vals = append(vals, *idx.Key3)
-	} else {
-		done = true
-	}
-
-	if idx.Key4 != nil {
+	if idx.UserId != nil {
 		if done {
-			panic("Extra value Key4")
+			panic("Extra value UserId")
 		}
-		vals = append(vals, *idx.Key4)
+		vals = append(vals, *idx.UserId)
 	} else {
 		done = true
 	}
 
-	if idx.Key5 != nil {
+	if idx.TagName != nil {
 		if done {
-			panic("Extra value Key5")
+			panic("Extra value TagName")
 		}
-		vals = append(vals, *idx.Key5)
+		vals = append(vals, *idx.TagName)
 	} else {
 		done = true
 	}
@@ -2519,41 +5969,25 @@ func (idx CustomDataPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForCustomData(pb *schema.CustomData) CustomDataPrimary {
-
-	KeyType := pb.KeyTypeCol()
-
-	Key1 := pb.Key1Col()
-
-	Key2 := pb.Key2Col()
-
-	Key3 := pb.Key3Col()
-
-	Key4 := pb.Key4Col()
-
-	Key5 := pb.Key5Col()
-
-	return CustomDataPrimary{
-
-		KeyType: &KeyType,
+func KeyForTagFollow(pb *schema.TagFollow) TagFollowsPrimary {
 
-		Key1: &Key1,
+	UserId := pb.UserIdCol()
 
-		Key2: &Key2,
+	TagName := pb.TagNameCol()
 
-		Key3: &Key3,
+	return TagFollowsPrimary{
 
-		Key4: &Key4,
+		UserId: &UserId,
 
-		Key5: &Key5,
+		TagName: &TagName,
 	}
 }
 
-var colsCustomData = []string{"key_type", "key1", "key2", "key3", "key4", "key5", "data"}
+var colsTagFollows = []string{"user_id", "tag_name", "data"}
 
-func (j *Job) ScanCustomData(opts db.Opts, cb func(*schema.CustomData) error) error {
-	return db.Scan(j.tx, "CustomData", opts, func(data []byte) error {
-		var pb schema.CustomData
+func (j *Job) ScanTagFollows(opts db.Opts, cb func(*schema.TagFollow) error) error {
+	return db.Scan(j.tx, "TagFollows", opts, func(data []byte) error {
+		var pb schema.TagFollow
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -2561,58 +5995,34 @@ func (j *Job) ScanCustomData(opts db.Opts, cb func(*schema.CustomData) error) er
 	}, j.adap)
 }
 
-func (j *Job) FindCustomData(opts db.Opts) (rows []*schema.CustomData, err error) {
-	err = j.ScanCustomData(opts, func(data *schema.CustomData) error {
+func (j *Job) FindTagFollows(opts db.Opts) (rows []*schema.TagFollow, err error) {
+	err = j.ScanTagFollows(opts, func(data *schema.TagFollow) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ KeyTypeCol() int64 } = (*schema.CustomData)(nil)
-
-var _ interface{ Key1Col() int64 } = (*schema.CustomData)(nil)
-
-var _ interface{ Key2Col() int64 } = (*schema.CustomData)(nil)
-
-var _ interface{ Key3Col() int64 } = (*schema.CustomData)(nil)
-
-var _ interface{ Key4Col() int64 } = (*schema.CustomData)(nil)
+var _ interface{ UserIdCol() int64 } = (*schema.TagFollow)(nil)
 
-var _ interface{ Key5Col() int64 } = (*schema.CustomData)(nil)
+var _ interface{ TagNameCol() string } = (*schema.TagFollow)(nil)
 
-func (j *Job) InsertCustomData(pb *schema.CustomData) error {
-	return j.InsertCustomDataRow(&CustomDataRow{
+func (j *Job) InsertTagFollow(pb *schema.TagFollow) error {
+	return j.InsertTagFollowRow(&TagFollowRow{
 		Data: pb,
 
-		KeyType: pb.KeyTypeCol(),
-
-		Key1: pb.Key1Col(),
-
-		Key2: pb.Key2Col(),
-
-		Key3: pb.Key3Col(),
-
-		Key4: pb.Key4Col(),
+		UserId: pb.UserIdCol(),
 
-		Key5: pb.Key5Col(),
+		TagName: pb.TagNameCol(),
 	})
 }
 
-func (j *Job) InsertCustomDataRow(row *CustomDataRow) error {
+func (j *Job) InsertTagFollowRow(row *TagFollowRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.KeyType)
-
-	vals = append(vals, row.Key1)
-
-	vals = append(vals, row.Key2)
-
-	vals = append(vals, row.Key3)
-
-	vals = append(vals, row.Key4)
+	vals = append(vals, row.UserId)
 
-	vals = append(vals, row.Key5)
+	vals = append(vals, row.TagName)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2620,55 +6030,31 @@ func (j *Job) InsertCustomDataRow(row *CustomDataRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "CustomData", colsCustomData, vals, j.adap)
+	return db.Insert(j.tx, "TagFollows", colsTagFollows, vals, j.adap)
 }
 
-var _ interface{ KeyTypeCol() int64 } = (*schema.CustomData)(nil)
-
-var _ interface{ Key1Col() int64 } = (*schema.CustomData)(nil)
-
-var _ interface{ Key2Col() int64 } = (*schema.CustomData)(nil)
-
-var _ interface{ Key3Col() int64 } = (*schema.CustomData)(nil)
-
-var _ interface{ Key4Col() int64 } = (*schema.CustomData)(nil)
+var _ interface{ UserIdCol() int64 } = (*schema.TagFollow)(nil)
 
-var _ interface{ Key5Col() int64 } = (*schema.CustomData)(nil)
+var _ interface{ TagNameCol() string } = (*schema.TagFollow)(nil)
 
-func (j *Job) UpdateCustomData(pb *schema.CustomData) error {
-	return j.UpdateCustomDataRow(&CustomDataRow{
+func (j *Job) UpdateTagFollow(pb *schema.TagFollow) error {
+	return j.UpdateTagFollowRow(&TagFollowRow{
 		Data: pb,
 
-		KeyType: pb.KeyTypeCol(),
-
-		Key1: pb.Key1Col(),
-
-		Key2: pb.Key2Col(),
-
-		Key3: pb.Key3Col(),
-
-		Key4: pb.Key4Col(),
+		UserId: pb.UserIdCol(),
 
-		Key5: pb.Key5Col(),
+		TagName: pb.TagNameCol(),
 	})
 }
 
-func (j *Job) UpdateCustomDataRow(row *CustomDataRow) error {
-	key := KeyForCustomData(row.Data)
+func (j *Job) UpdateTagFollowRow(row *TagFollowRow) error {
+	key := KeyForTagFollow(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.KeyType)
-
-	vals = append(vals, row.Key1)
-
-	vals = append(vals, row.Key2)
-
-	vals = append(vals, row.Key3)
-
-	vals = append(vals, row.Key4)
+	vals = append(vals, row.UserId)
 
-	vals = append(vals, row.Key5)
+	vals = append(vals, row.TagName)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2676,9 +6062,9 @@ func (j *Job) UpdateCustomDataRow(row *CustomDataRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "CustomData", colsCustomData, vals, key, j.adap)
+	return db.Update(j.tx, "TagFollows", colsTagFollows, vals, key, j.adap)
 }
 
-func (j *Job) DeleteCustomData(key CustomDataPrimary) error {
-	return db.Delete(j.tx, "CustomData", key, j.adap)
+func (j *Job) DeleteTagFollow(key TagFollowsPrimary) error {
+	return db.Delete(j.tx, "TagFollows", key, j.adap)
 }
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"path/filepath"
+	"time"
+)
+
+func (us *UploadSession) IdCol() int64 {
+	return us.UploadSessionId
+}
+
+func (us *UploadSession) UserIdCol() int64 {
+	return us.UserId
+}
+
+func (us *UploadSession) ModifiedTsCol() int64 {
+	return ToTime(us.ModifiedTs).UnixNano()
+}
+
+func (us *UploadSession) SetCreatedTime(now time.Time) {
+	us.CreatedTs = ToTspb(now)
+}
+
+func (us *UploadSession) SetModifiedTime(now time.Time) {
+	us.ModifiedTs = ToTspb(now)
+}
+
+func (us *UploadSession) GetModifiedTime() time.Time {
+	return ToTime(us.ModifiedTs)
+}
+
+// UploadSessionDir is the staging directory for upload sessions.  The leading underscores keep it
+// from colliding with the pic directories.
+func UploadSessionDir(pixPath string) string {
+	return filepath.Join(pixPath, "__uploads")
+}
+
+// UploadSessionPath is the staging file for the data of an upload session.
+func UploadSessionPath(pixPath string, id int64) string {
+	return filepath.Join(UploadSessionDir(pixPath), Varint(id).Encode())
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"fmt"
+	"math"
 	"math/bits"
 	"sort"
 	"time"
@@ -63,6 +64,57 @@ func (u *User) IsAnon() bool {
 	return u == nil
 }
 
+// TotpEnrolled returns true if the user has confirmed two-factor authentication.  A pending
+// enrollment does not count.
+func (u *User) TotpEnrolled() bool {
+	return u != nil && u.Totp != nil && u.Totp.ConfirmedTs != nil
+}
+
+// Suspended returns true if the user has a suspension that is active at now.
+func (u *User) Suspended(now time.Time) bool {
+	if u == nil || u.Suspension == nil {
+		return false
+	}
+	if now.Before(ToTime(u.Suspension.StartTs)) {
+		return false
+	}
+	return u.Suspension.EndTs == nil || now.Before(ToTime(u.Suspension.EndTs))
+}
+
+// ReadCapabilities are the capabilities that only read, which suspended users keep.
+var ReadCapabilities = []User_Capability{
+	User_PIC_READ,
+	User_PIC_INDEX,
+	User_USER_READ_SELF,
+	User_USER_READ_ALL,
+	User_PIC_EXTENSION_READ,
+	User_PIC_COMMENT_EXTENSION_READ,
+	User_PIC_TAG_EXTENSION_READ,
+	User_PIC_VOTE_EXTENSION_READ,
+	User_USER_READ_PUBLIC,
+	User_USER_READ_PICS,
+	User_USER_READ_PIC_TAG,
+	User_USER_READ_PIC_COMMENT,
+	User_USER_READ_PIC_VOTE,
+}
+
+// CapSet returns the capabilities of the user, including those of the roles the user holds in
+// conf.  conf may be nil, in which case only the user's own capabilities are included.
+func (u *User) CapSet(conf *Configuration) *CapSet {
+	cs := CapSetOf(u.Capability...)
+	if conf == nil || conf.Role == nil {
+		return cs
+	}
+	for _, name := range u.Role {
+		if rcs, present := conf.Role.Role[name]; present && rcs != nil {
+			for _, c := range rcs.Capability {
+				cs.Add(c)
+			}
+		}
+	}
+	return cs
+}
+
 /**
  * The user id of the anonymous user.  Due to proto3, this is not distinguishable
  * from not being set, so bugs in the code will appear to set anonymous when they
@@ -74,6 +126,11 @@ func (u *User) IsAnon() bool {
  */
 var AnonymousUserId int64 = 0
 
+// DeletedUserId is the user id that the contributions of deleted users are reassigned to.  No user
+// has this id, since ids are allocated counting up from the bottom.  Unlike anonymous
+// contributions, deleted ones can be told apart later, such as to remove them too.
+var DeletedUserId int64 = math.MaxInt64
+
 // TODO: test
 func VerifyCapSubset(have, want *CapSet) status.S {
 	_, _, right := CapIntersect(have, want)
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"time"
+)
+
+func (ul *UserLink) IdCol() int64 {
+	return ul.UserLinkId
+}
+
+func (ul *UserLink) ProviderCol() string {
+	return ul.Provider
+}
+
+func (ul *UserLink) SubjectCol() string {
+	return ul.Subject
+}
+
+func (ul *UserLink) UserIdCol() int64 {
+	return ul.UserId
+}
+
+func (ul *UserLink) SetCreatedTime(now time.Time) {
+	ul.CreatedTs = ToTspb(now)
+}
+
+func (ul *UserLink) SetModifiedTime(now time.Time) {
+	ul.ModifiedTs = ToTspb(now)
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"testing"
+	"time"
 )
 
 func TestNamesMap(t *testing.T) {
@@ -117,3 +118,51 @@ func TestCapIntersect(t *testing.T) {
 		t.Error("wrong right", right)
 	}
 }
+
+func TestUserCapSet_IncludesRoles(t *testing.T) {
+	conf := &Configuration{
+		Role: &Configuration_RoleSet{
+			Role: map[string]*Configuration_CapabilitySet{
+				"uploader": {
+					Capability: []User_Capability{User_PIC_CREATE},
+				},
+			},
+		},
+	}
+	u := &User{
+		Capability: []User_Capability{User_PIC_READ},
+		Role:       []string{"uploader", "missing"},
+	}
+
+	cs := u.CapSet(conf)
+	if cs.Size() != 2 || !cs.Has(User_PIC_READ) || !cs.Has(User_PIC_CREATE) {
+		t.Error("wrong caps", cs)
+	}
+	if cs := u.CapSet(nil); cs.Size() != 1 || !cs.Has(User_PIC_READ) {
+		t.Error("wrong caps", cs)
+	}
+}
+
+func TestUserSuspended(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cases := []struct {
+		s    *User_Suspension
+		want bool
+	}{
+		{s: nil},
+		{s: &User_Suspension{StartTs: ToTspb(now)}, want: true},
+		{s: &User_Suspension{StartTs: ToTspb(now.Add(time.Second))}},
+		{s: &User_Suspension{StartTs: ToTspb(now), EndTs: ToTspb(now.Add(time.Second))}, want: true},
+		{s: &User_Suspension{StartTs: ToTspb(now.Add(-time.Second)), EndTs: ToTspb(now)}},
+	}
+	for i, c := range cases {
+		u := &User{Suspension: c.s}
+		if have := u.Suspended(now); have != c.want {
+			t.Error(i, "have", have, "want", c.want)
+		}
+	}
+	var u *User
+	if u.Suspended(now) {
+		t.Error("expected anonymous user to not be suspended")
+	}
+}
@@ -81,6 +81,10 @@ func parseConfigFlag() (*Config, error) {
 		dir := filepath.Dir(f.Name())
 		config.PixPath = filepath.Join(dir, config.PixPath)
 	}
+	if config.PwtKeySetPath != "" && !filepath.IsAbs(config.PwtKeySetPath) {
+		dir := filepath.Dir(f.Name())
+		config.PwtKeySetPath = filepath.Join(dir, config.PwtKeySetPath)
+	}
 
 	return config, nil
 }
@@ -33,13 +33,25 @@ type Config struct {
 	// Path to look for pictures.
 	PixPath string `protobuf:"bytes,4,opt,name=pix_path,json=pixPath,proto3" json:"pix_path,omitempty"`
 	// session stuff
-	TokenSecret           string                    `protobuf:"bytes,5,opt,name=token_secret,json=tokenSecret,proto3" json:"token_secret,omitempty"`
-	SessionPrivateKeyPath string                    `protobuf:"bytes,6,opt,name=session_private_key_path,json=sessionPrivateKeyPath,proto3" json:"session_private_key_path,omitempty"`
-	SessionPublicKeyPath  string                    `protobuf:"bytes,7,opt,name=session_public_key_path,json=sessionPublicKeyPath,proto3" json:"session_public_key_path,omitempty"`
-	BackendConfiguration  *api.BackendConfiguration `protobuf:"bytes,10,opt,name=backend_configuration,json=backendConfiguration,proto3" json:"backend_configuration,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
-	XXX_unrecognized      []byte                    `json:"-"`
-	XXX_sizecache         int32                     `json:"-"`
+	TokenSecret           string `protobuf:"bytes,5,opt,name=token_secret,json=tokenSecret,proto3" json:"token_secret,omitempty"`
+	SessionPrivateKeyPath string `protobuf:"bytes,6,opt,name=session_private_key_path,json=sessionPrivateKeyPath,proto3" json:"session_private_key_path,omitempty"`
+	SessionPublicKeyPath  string `protobuf:"bytes,7,opt,name=session_public_key_path,json=sessionPublicKeyPath,proto3" json:"session_public_key_path,omitempty"`
+	// Path to a PwtKeySet text proto of the keys used to sign and verify tokens.  The file is
+	// reloaded when it changes, so keys can be rotated without logging out users.  Tokens without a
+	// key id are verified with token_secret.
+	PwtKeySetPath        string                    `protobuf:"bytes,12,opt,name=pwt_key_set_path,json=pwtKeySetPath,proto3" json:"pwt_key_set_path,omitempty"`
+	BackendConfiguration *api.BackendConfiguration `protobuf:"bytes,10,opt,name=backend_configuration,json=backendConfiguration,proto3" json:"backend_configuration,omitempty"`
+	// How to deliver messages, such as secret reset codes, to users.  If absent, secret resets are
+	// disabled.
+	Notifier *Notifier `protobuf:"bytes,11,opt,name=notifier,proto3" json:"notifier,omitempty"`
+	// Peers allowed to forward the address of their clients, such as the frontend, as ips or cidr
+	// ranges (e.g. "10.0.0.0/8").  Client addresses are used to rate limit and lock out logins, so
+	// addresses forwarded by other peers are ignored.  If unset, loopback peers are trusted, since
+	// the frontend usually runs on the same host.
+	TrustedProxy         []string `protobuf:"bytes,13,rep,name=trusted_proxy,json=trustedProxy,proto3" json:"trusted_proxy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -123,6 +135,13 @@ func (m *Config) GetSessionPublicKeyPath() string {
 	return ""
 }
 
+func (m *Config) GetPwtKeySetPath() string {
+	if m != nil {
+		return m.PwtKeySetPath
+	}
+	return ""
+}
+
 func (m *Config) GetBackendConfiguration() *api.BackendConfiguration {
 	if m != nil {
 		return m.BackendConfiguration
@@ -130,33 +149,258 @@ func (m *Config) GetBackendConfiguration() *api.BackendConfiguration {
 	return nil
 }
 
+func (m *Config) GetNotifier() *Notifier {
+	if m != nil {
+		return m.Notifier
+	}
+	return nil
+}
+
+func (m *Config) GetTrustedProxy() []string {
+	if m != nil {
+		return m.TrustedProxy
+	}
+	return nil
+}
+
+type Notifier struct {
+	// Directory to write messages to, for servers without outgoing mail.
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// SMTP server to send messages through (e.g. "mail.example.com:587").  Takes precedence over
+	// dir.
+	SmtpAddress string `protobuf:"bytes,2,opt,name=smtp_address,json=smtpAddress,proto3" json:"smtp_address,omitempty"`
+	// The sender address of messages.
+	SmtpFrom string `protobuf:"bytes,3,opt,name=smtp_from,json=smtpFrom,proto3" json:"smtp_from,omitempty"`
+	// Credentials for PLAIN auth.  If absent, no auth is used.
+	SmtpUsername         string   `protobuf:"bytes,4,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	SmtpPassword         string   `protobuf:"bytes,5,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Notifier) Reset()         { *m = Notifier{} }
+func (m *Notifier) String() string { return proto.CompactTextString(m) }
+func (*Notifier) ProtoMessage()    {}
+func (*Notifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{1}
+}
+
+func (m *Notifier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notifier.Unmarshal(m, b)
+}
+func (m *Notifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notifier.Marshal(b, m, deterministic)
+}
+func (m *Notifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notifier.Merge(m, src)
+}
+func (m *Notifier) XXX_Size() int {
+	return xxx_messageInfo_Notifier.Size(m)
+}
+func (m *Notifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notifier proto.InternalMessageInfo
+
+func (m *Notifier) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *Notifier) GetSmtpAddress() string {
+	if m != nil {
+		return m.SmtpAddress
+	}
+	return ""
+}
+
+func (m *Notifier) GetSmtpFrom() string {
+	if m != nil {
+		return m.SmtpFrom
+	}
+	return ""
+}
+
+func (m *Notifier) GetSmtpUsername() string {
+	if m != nil {
+		return m.SmtpUsername
+	}
+	return ""
+}
+
+func (m *Notifier) GetSmtpPassword() string {
+	if m != nil {
+		return m.SmtpPassword
+	}
+	return ""
+}
+
+// PwtKeySet is the keys used to sign and verify tokens.  Tokens signed by any of the keys are
+// accepted.
+type PwtKeySet struct {
+	// The key used to sign new tokens.  It must be one of key.
+	SigningKeyId         string    `protobuf:"bytes,1,opt,name=signing_key_id,json=signingKeyId,proto3" json:"signing_key_id,omitempty"`
+	Key                  []*PwtKey `protobuf:"bytes,2,rep,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PwtKeySet) Reset()         { *m = PwtKeySet{} }
+func (m *PwtKeySet) String() string { return proto.CompactTextString(m) }
+func (*PwtKeySet) ProtoMessage()    {}
+func (*PwtKeySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{2}
+}
+
+func (m *PwtKeySet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PwtKeySet.Unmarshal(m, b)
+}
+func (m *PwtKeySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PwtKeySet.Marshal(b, m, deterministic)
+}
+func (m *PwtKeySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PwtKeySet.Merge(m, src)
+}
+func (m *PwtKeySet) XXX_Size() int {
+	return xxx_messageInfo_PwtKeySet.Size(m)
+}
+func (m *PwtKeySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_PwtKeySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PwtKeySet proto.InternalMessageInfo
+
+func (m *PwtKeySet) GetSigningKeyId() string {
+	if m != nil {
+		return m.SigningKeyId
+	}
+	return ""
+}
+
+func (m *PwtKeySet) GetKey() []*PwtKey {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+type PwtKey struct {
+	// Identifies the key in the header of tokens.
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// The HMAC-SHA512/256 secret.  Exactly one of secret or public_key must be set.
+	Secret []byte `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// A PEM encoded Ed25519 or ECDSA P-256 public key, in PKIX form.
+	PublicKey string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The PEM encoded private key of public_key, in PKCS8 form.  Only the signing key needs it.
+	PrivateKey           string   `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PwtKey) Reset()         { *m = PwtKey{} }
+func (m *PwtKey) String() string { return proto.CompactTextString(m) }
+func (*PwtKey) ProtoMessage()    {}
+func (*PwtKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{3}
+}
+
+func (m *PwtKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PwtKey.Unmarshal(m, b)
+}
+func (m *PwtKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PwtKey.Marshal(b, m, deterministic)
+}
+func (m *PwtKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PwtKey.Merge(m, src)
+}
+func (m *PwtKey) XXX_Size() int {
+	return xxx_messageInfo_PwtKey.Size(m)
+}
+func (m *PwtKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_PwtKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PwtKey proto.InternalMessageInfo
+
+func (m *PwtKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *PwtKey) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *PwtKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *PwtKey) GetPrivateKey() string {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "pixur.be.server.Config")
+	proto.RegisterType((*Notifier)(nil), "pixur.be.server.Notifier")
+	proto.RegisterType((*PwtKeySet)(nil), "pixur.be.server.PwtKeySet")
+	proto.RegisterType((*PwtKey)(nil), "pixur.be.server.PwtKey")
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4) }
 
 var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4e, 0x2a, 0x31,
-	0x14, 0x86, 0xc3, 0xe5, 0xde, 0x01, 0x0a, 0x5c, 0x93, 0x09, 0x84, 0xaa, 0x0b, 0xd1, 0xc4, 0x88,
-	0x9b, 0x99, 0x44, 0x63, 0x5c, 0xb8, 0x12, 0x96, 0x26, 0x84, 0xa0, 0x2b, 0x37, 0x4d, 0x3b, 0x3d,
-	0x42, 0x33, 0xd0, 0x36, 0x6d, 0x41, 0x78, 0x0b, 0x1f, 0xd9, 0xd8, 0x03, 0xca, 0xc2, 0xd5, 0x4c,
-	0xfe, 0xff, 0xfb, 0x4e, 0x93, 0x73, 0x48, 0xab, 0x30, 0xfa, 0x4d, 0xcd, 0x32, 0xeb, 0x4c, 0x30,
-	0xe9, 0x91, 0x55, 0x9b, 0x95, 0xcb, 0x04, 0x64, 0x1e, 0xdc, 0x1a, 0xdc, 0x49, 0x9b, 0x5b, 0x95,
-	0x73, 0xab, 0xb0, 0xbf, 0xf8, 0xa8, 0x92, 0x64, 0x14, 0x85, 0xb4, 0x47, 0x6a, 0x52, 0x30, 0xcd,
-	0x97, 0x40, 0x2b, 0xfd, 0xca, 0xa0, 0x31, 0x4d, 0xa4, 0x18, 0xf3, 0x25, 0xa4, 0xa7, 0xa4, 0x21,
-	0x05, 0xc3, 0xb1, 0xf4, 0x4f, 0xac, 0xea, 0x52, 0xec, 0xac, 0x4b, 0xf2, 0x7f, 0xa1, 0x7c, 0x00,
-	0xcd, 0x34, 0x84, 0x77, 0xe3, 0x4a, 0x5a, 0x8d, 0x44, 0x1b, 0xd3, 0x31, 0x86, 0x07, 0x18, 0x97,
-	0xd2, 0x81, 0xf7, 0xb4, 0x71, 0x88, 0x3d, 0x62, 0x98, 0x1e, 0x93, 0xba, 0x55, 0x1b, 0x66, 0x79,
-	0x98, 0xd3, 0xbf, 0x11, 0xa8, 0x59, 0xb5, 0x99, 0xf0, 0x30, 0x4f, 0xcf, 0x49, 0x2b, 0x98, 0x12,
-	0x34, 0xf3, 0x50, 0x38, 0x08, 0xf4, 0x5f, 0xac, 0x9b, 0x31, 0x7b, 0x8e, 0x51, 0x7a, 0x4f, 0xa8,
-	0x07, 0xef, 0x95, 0xd1, 0xcc, 0x3a, 0xb5, 0xe6, 0x01, 0x58, 0x09, 0x5b, 0x9c, 0x96, 0x44, 0xbc,
-	0xbb, 0xeb, 0x27, 0x58, 0x3f, 0xc1, 0x36, 0xce, 0xbe, 0x23, 0xbd, 0x6f, 0x71, 0x25, 0x16, 0xaa,
-	0xf8, 0xf1, 0x6a, 0xd1, 0xeb, 0xec, 0xbd, 0xd8, 0xee, 0xb5, 0x17, 0xd2, 0x15, 0xbc, 0x28, 0x41,
-	0xcb, 0xdd, 0x76, 0x56, 0x8e, 0x07, 0x65, 0x34, 0x25, 0xfd, 0xca, 0xa0, 0x79, 0x73, 0x96, 0xe1,
-	0xf2, 0xbf, 0xb6, 0x3d, 0x44, 0x6e, 0x74, 0x88, 0x4d, 0x3b, 0xe2, 0x97, 0x74, 0x78, 0xfd, 0x7a,
-	0x85, 0x9e, 0x71, 0xb3, 0x3c, 0xfe, 0xe5, 0x02, 0x72, 0x3c, 0x5f, 0x8e, 0xef, 0x3c, 0xe0, 0x47,
-	0x24, 0xf1, 0x88, 0xb7, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x6a, 0x80, 0x94, 0xf4, 0x01,
-	0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x5f, 0x6b, 0xdb, 0x3c,
+	0x14, 0xc6, 0x49, 0xd3, 0xba, 0xf1, 0xb1, 0xdb, 0xb7, 0x88, 0xf6, 0xad, 0xbb, 0x32, 0x9a, 0x65,
+	0x1b, 0x4d, 0x6f, 0x1c, 0xe8, 0x28, 0xbb, 0xd8, 0xd5, 0x5a, 0x18, 0x8c, 0x42, 0x09, 0xee, 0x76,
+	0x33, 0x06, 0x46, 0x8e, 0x4e, 0x52, 0xe1, 0xc6, 0x12, 0x92, 0xd2, 0xc4, 0x1f, 0x64, 0x5f, 0x61,
+	0x9f, 0x73, 0x58, 0x92, 0xd3, 0xec, 0xcf, 0x55, 0xac, 0xe7, 0xfc, 0xce, 0x41, 0x3a, 0xcf, 0x13,
+	0x88, 0x27, 0xa2, 0x9a, 0xf2, 0x59, 0x2a, 0x95, 0x30, 0x82, 0xfc, 0x27, 0xf9, 0x6a, 0xa1, 0xd2,
+	0x02, 0x53, 0x8d, 0xea, 0x09, 0xd5, 0x8b, 0x7d, 0x2a, 0xf9, 0x88, 0x51, 0x43, 0x1d, 0x30, 0xf8,
+	0xb1, 0x0d, 0xc1, 0x8d, 0xed, 0x20, 0xc7, 0xb0, 0xcb, 0x8a, 0xbc, 0xa2, 0x73, 0x4c, 0x3a, 0xfd,
+	0xce, 0x30, 0xcc, 0x02, 0x56, 0xdc, 0xd1, 0x39, 0x92, 0x53, 0x08, 0x59, 0x91, 0xbb, 0xb9, 0xc9,
+	0x96, 0x2d, 0xf5, 0x58, 0xe1, 0xbb, 0xde, 0xc2, 0xfe, 0x23, 0xd7, 0x06, 0xab, 0xbc, 0x42, 0xb3,
+	0x14, 0xaa, 0x4c, 0xba, 0x96, 0xd8, 0x73, 0xea, 0x9d, 0x13, 0x37, 0x30, 0xca, 0x98, 0x42, 0xad,
+	0x93, 0x70, 0x13, 0xfb, 0xe8, 0x44, 0x72, 0x02, 0x3d, 0xc9, 0x57, 0xb9, 0xa4, 0xe6, 0x21, 0xd9,
+	0xb6, 0xc0, 0xae, 0xe4, 0xab, 0x31, 0x35, 0x0f, 0xe4, 0x15, 0xc4, 0x46, 0x94, 0x58, 0xe5, 0x1a,
+	0x27, 0x0a, 0x4d, 0xb2, 0x63, 0xcb, 0x91, 0xd5, 0xee, 0xad, 0x44, 0xde, 0x43, 0xa2, 0x51, 0x6b,
+	0x2e, 0xaa, 0x5c, 0x2a, 0xfe, 0x44, 0x0d, 0xe6, 0x25, 0xd6, 0x6e, 0x5a, 0x60, 0xf1, 0x23, 0x5f,
+	0x1f, 0xbb, 0xf2, 0x2d, 0xd6, 0x76, 0xf6, 0x15, 0x1c, 0xaf, 0x1b, 0x17, 0xc5, 0x23, 0x9f, 0x3c,
+	0xf7, 0xed, 0xda, 0xbe, 0xc3, 0xb6, 0xcf, 0x56, 0xdb, 0xb6, 0x73, 0x38, 0x90, 0x4b, 0x63, 0x59,
+	0x8d, 0xc6, 0xf1, 0xb1, 0x7b, 0x96, 0x5c, 0x9a, 0x5b, 0xac, 0xef, 0xd1, 0x58, 0xf0, 0x0b, 0x1c,
+	0x15, 0x74, 0x52, 0x62, 0xc5, 0xfc, 0x1a, 0x17, 0x8a, 0x1a, 0x2e, 0xaa, 0x04, 0xfa, 0x9d, 0x61,
+	0x74, 0x79, 0x96, 0x3a, 0x9b, 0xa8, 0xe4, 0xe9, 0xb5, 0xe3, 0x6e, 0x36, 0xb1, 0xec, 0xb0, 0xf8,
+	0x87, 0x4a, 0xae, 0xa0, 0x57, 0x09, 0xc3, 0xa7, 0x1c, 0x55, 0x12, 0xd9, 0x41, 0x27, 0xe9, 0x1f,
+	0x7e, 0xa7, 0x77, 0x1e, 0xc8, 0xd6, 0x28, 0x79, 0x0d, 0x7b, 0x46, 0x2d, 0xb4, 0x41, 0x96, 0x4b,
+	0x25, 0x56, 0x75, 0xb2, 0xd7, 0xef, 0x0e, 0xc3, 0x2c, 0xf6, 0xe2, 0xb8, 0xd1, 0x06, 0x3f, 0x3b,
+	0xd0, 0x6b, 0x7b, 0xc9, 0x01, 0x74, 0x19, 0x57, 0x3e, 0x15, 0xcd, 0x67, 0x63, 0x86, 0x9e, 0x1b,
+	0xb9, 0x36, 0xd3, 0xa5, 0x22, 0x6a, 0xb4, 0xd6, 0xca, 0x53, 0x08, 0x2d, 0x32, 0x55, 0x62, 0xee,
+	0x33, 0xd1, 0x6b, 0x84, 0x4f, 0x4a, 0xcc, 0x9b, 0x3b, 0xd8, 0xe2, 0x42, 0xa3, 0xb2, 0x89, 0x73,
+	0x66, 0xdb, 0xa1, 0x5f, 0xbd, 0xb6, 0x86, 0x24, 0xd5, 0x7a, 0x29, 0x14, 0xf3, 0x96, 0x5b, 0x68,
+	0xec, 0xb5, 0xc1, 0x77, 0x08, 0xc7, 0xed, 0xae, 0xc9, 0x1b, 0xd8, 0xd7, 0x7c, 0x56, 0xf1, 0x6a,
+	0x66, 0x4d, 0xe1, 0xcc, 0xdf, 0x39, 0xf6, 0xea, 0x2d, 0xd6, 0x9f, 0x19, 0xb9, 0x80, 0x6e, 0x89,
+	0x75, 0xb2, 0xd5, 0xef, 0x0e, 0xa3, 0xcb, 0xe3, 0xbf, 0x56, 0xe6, 0xc6, 0x65, 0x0d, 0x33, 0x58,
+	0x42, 0xe0, 0x8e, 0xe4, 0x08, 0x82, 0xdf, 0x46, 0xee, 0x94, 0x76, 0xd6, 0xff, 0x10, 0xf8, 0x3c,
+	0x36, 0x2b, 0x88, 0x33, 0x7f, 0x22, 0x2f, 0x01, 0x9e, 0x93, 0xe4, 0x9f, 0x1f, 0xca, 0x36, 0x3d,
+	0xe4, 0x0c, 0xa2, 0x8d, 0x84, 0xfa, 0xd7, 0x83, 0x5c, 0xa7, 0xf2, 0xfa, 0xe2, 0xdb, 0xb9, 0xbb,
+	0x97, 0x50, 0xb3, 0x91, 0xfd, 0x1a, 0x15, 0x38, 0x72, 0x37, 0x1c, 0xb9, 0x0c, 0x7d, 0x70, 0x3f,
+	0x45, 0x60, 0xff, 0xc9, 0xef, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x6b, 0x9b, 0x1b, 0xfa,
+	0x03, 0x00, 0x00,
 }
@@ -3,16 +3,24 @@ package server // import "pixur.org/pixur/be/server"
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"io/ioutil"
 	"net"
+	"net/smtp"
 	"os"
+	"time"
 
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc"
 
 	"pixur.org/pixur/be/handlers"
+	"pixur.org/pixur/be/notify"
 	sdb "pixur.org/pixur/be/schema/db"
 	"pixur.org/pixur/be/server/config"
 	"pixur.org/pixur/be/status"
@@ -24,10 +32,16 @@ type Server struct {
 	lnnet, lnaddr string
 	pixPath       string
 	tokenSecret   []byte
-	publicKey     *rsa.PublicKey
-	privateKey    *rsa.PrivateKey
+	publicKey     crypto.PublicKey
+	privateKey    crypto.Signer
+
+	pwtKeySetPath    string
+	pwtKeySetModTime time.Time
 }
 
+// pwtKeySetReloadInterval is how often the pwt key set file is checked for changes.
+const pwtKeySetReloadInterval = time.Minute
+
 func (s *Server) setup(ctx context.Context, c *config.Config) (stscap status.S) {
 	db, err := sdb.Open(ctx, c.DbName, c.DbConfig)
 	if err != nil {
@@ -56,65 +70,74 @@ func (s *Server) setup(ctx context.Context, c *config.Config) (stscap status.S)
 		return status.InvalidArgument(nil, pixPath, "is not a directory")
 	}
 
-	var privKey *rsa.PrivateKey
+	var privKey crypto.Signer
 	if c.SessionPrivateKeyPath != "" {
-		f, err := os.Open(c.SessionPrivateKeyPath)
-		if err != nil {
-			return status.Internal(err, "can't open private key")
-		}
-		defer f.Close()
-		data, err := ioutil.ReadAll(f)
+		data, err := ioutil.ReadFile(c.SessionPrivateKeyPath)
 		if err != nil {
 			return status.Internal(err, "can't read private key")
 		}
-		block, _ := pem.Decode(data)
-		if block == nil {
-			return status.InvalidArgument(nil, "no key in", c.SessionPrivateKeyPath)
-		}
-		if block.Type != "RSA PRIVATE KEY" {
-			return status.InvalidArgument(nil, "wrong private key type", block.Type)
+		key, sts := parsePrivateKey(data)
+		if sts != nil {
+			return status.InvalidArgument(sts, "can't parse", c.SessionPrivateKeyPath)
 		}
-		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
-		if err != nil {
-			return status.Internal(err, "can't parse private key")
-		}
-		key.Precompute()
 		privKey = key
 	}
 
-	var pubKey *rsa.PublicKey
+	var pubKey crypto.PublicKey
 	if c.SessionPublicKeyPath != "" {
-		f, err := os.Open(c.SessionPublicKeyPath)
-		if err != nil {
-			return status.Internal(err, "can't open public key")
-		}
-		defer f.Close()
-		data, err := ioutil.ReadAll(f)
+		data, err := ioutil.ReadFile(c.SessionPublicKeyPath)
 		if err != nil {
 			return status.Internal(err, "can't read public key")
 		}
-		block, _ := pem.Decode(data)
-		if block == nil {
-			return status.InvalidArgument(nil, "no key in", c.SessionPublicKeyPath)
-		}
-		if block.Type != "PUBLIC KEY" {
-			return status.InvalidArgument(nil, "wrong public key type", block.Type)
-		}
-		key, err := x509.ParsePKIXPublicKey(block.Bytes)
-		if err != nil {
-			return status.Internal(err, "can't parse public key")
-		}
-		if rsaKey, ok := key.(*rsa.PublicKey); ok {
-			pubKey = rsaKey
-		} else {
-			return status.InvalidArgumentf(nil, "wrong public key type %T", key)
+		key, sts := parsePublicKey(data)
+		if sts != nil {
+			return status.InvalidArgument(sts, "can't parse", c.SessionPublicKeyPath)
 		}
+		pubKey = key
 	}
 	var tokenSecret []byte
 	if c.TokenSecret != "" {
 		tokenSecret = []byte(c.TokenSecret)
 	}
 
+	var pwtKeySet *handlers.PwtKeySet
+	var pwtKeySetModTime time.Time
+	if c.PwtKeySetPath != "" {
+		ks, mt, sts := loadPwtKeySet(c.PwtKeySetPath)
+		if sts != nil {
+			return sts
+		}
+		pwtKeySet, pwtKeySetModTime = ks, mt
+	}
+
+	var notifier notify.Notifier
+	if nc := c.Notifier; nc != nil {
+		if nc.SmtpAddress != "" {
+			host, _, err := net.SplitHostPort(nc.SmtpAddress)
+			if err != nil {
+				return status.InvalidArgument(err, "bad smtp address")
+			}
+			var auth smtp.Auth
+			if nc.SmtpUsername != "" {
+				auth = smtp.PlainAuth("", nc.SmtpUsername, nc.SmtpPassword, host)
+			}
+			notifier = &notify.SMTPNotifier{
+				Addr: nc.SmtpAddress,
+				From: nc.SmtpFrom,
+				Auth: auth,
+			}
+		} else if nc.Dir != "" {
+			notifier = &notify.FileNotifier{
+				Dir: nc.Dir,
+			}
+		}
+	}
+
+	trustedProxies, sts := parseTrustedProxies(c.TrustedProxy)
+	if sts != nil {
+		return sts
+	}
+
 	opts, cb := handlers.HandlersInit(ctx, &handlers.ServerConfig{
 		DB:                   db,
 		PixPath:              pixPath,
@@ -122,6 +145,9 @@ func (s *Server) setup(ctx context.Context, c *config.Config) (stscap status.S)
 		PrivateKey:           privKey,
 		PublicKey:            pubKey,
 		BackendConfiguration: c.BackendConfiguration,
+		Notifier:             notifier,
+		PwtKeySet:            pwtKeySet,
+		TrustedProxies:       trustedProxies,
 	})
 	grpcServer := grpc.NewServer(opts...)
 	cb(grpcServer)
@@ -132,6 +158,8 @@ func (s *Server) setup(ctx context.Context, c *config.Config) (stscap status.S)
 	s.privateKey = privKey
 	s.publicKey = pubKey
 	s.tokenSecret = tokenSecret
+	s.pwtKeySetPath = c.PwtKeySetPath
+	s.pwtKeySetModTime = pwtKeySetModTime
 	s.s = grpcServer
 	s.lnnet, s.lnaddr = c.ListenNetwork, c.ListenAddress
 
@@ -167,9 +195,169 @@ func (s *Server) listenAndServe(ctx context.Context, lnready chan<- struct{}) (s
 	if lnready != nil {
 		close(lnready)
 	}
+	if s.pwtKeySetPath != "" {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go s.watchPwtKeySet(ctx)
+	}
+	sweepCtx, cancelSweep := context.WithCancel(ctx)
+	defer cancelSweep()
+	go handlers.SweepUploadSessions(sweepCtx, s.db, s.pixPath)
 
 	if err := s.s.Serve(ln); err != nil {
 		return status.Internal(err, "failed to serve")
 	}
 	return nil
 }
+
+// parseTrustedProxies parses ips and cidr ranges.  A single ip matches only itself.
+func parseTrustedProxies(specs []string) ([]*net.IPNet, status.S) {
+	var nets []*net.IPNet
+	for _, spec := range specs {
+		if ip := net.ParseIP(spec); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(spec)
+		if err != nil {
+			return nil, status.InvalidArgument(err, "bad trusted proxy", spec)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+// loadPwtKeySet reads a text format PwtKeySet from path, along with the time it was last modified.
+func loadPwtKeySet(path string) (*handlers.PwtKeySet, time.Time, status.S) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, time.Time{}, status.Internal(err, "can't stat pwt key set")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, status.Internal(err, "can't read pwt key set")
+	}
+	var pks config.PwtKeySet
+	if err := proto.UnmarshalText(string(data), &pks); err != nil {
+		return nil, time.Time{}, status.InvalidArgument(err, "can't parse pwt key set")
+	}
+	ks := &handlers.PwtKeySet{
+		SigningKeyId: pks.SigningKeyId,
+	}
+	for _, k := range pks.Key {
+		key := handlers.PwtKey{
+			KeyId:  k.KeyId,
+			Secret: k.Secret,
+		}
+		if k.PublicKey != "" {
+			pub, sts := parsePublicKey([]byte(k.PublicKey))
+			if sts != nil {
+				return nil, time.Time{}, status.InvalidArgument(sts, "bad public key for", k.KeyId)
+			}
+			key.PublicKey = pub
+		}
+		if k.PrivateKey != "" {
+			priv, sts := parsePrivateKey([]byte(k.PrivateKey))
+			if sts != nil {
+				return nil, time.Time{}, status.InvalidArgument(sts, "bad private key for", k.KeyId)
+			}
+			key.PrivateKey = priv
+		}
+		ks.Keys = append(ks.Keys, key)
+	}
+	return ks, fi.ModTime(), nil
+}
+
+// parsePrivateKey decodes a PEM encoded private key.  RSA keys may be in PKCS1 form, ECDSA keys
+// may be in SEC1 form, and any of RSA, ECDSA, and Ed25519 keys may be in PKCS8 form.
+func parsePrivateKey(data []byte) (crypto.Signer, status.S) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, status.InvalidArgument(nil, "no private key")
+	}
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, status.InvalidArgument(nil, "wrong private key type", block.Type)
+	}
+	if err != nil {
+		return nil, status.InvalidArgument(err, "can't parse private key")
+	}
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		key.Precompute()
+		return key, nil
+	case *ecdsa.PrivateKey:
+		return key, nil
+	case ed25519.PrivateKey:
+		return key, nil
+	default:
+		return nil, status.InvalidArgumentf(nil, "wrong private key type %T", key)
+	}
+}
+
+// parsePublicKey decodes a PEM encoded RSA, ECDSA, or Ed25519 public key in PKIX form.
+func parsePublicKey(data []byte) (crypto.PublicKey, status.S) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, status.InvalidArgument(nil, "no public key")
+	}
+	if block.Type != "PUBLIC KEY" {
+		return nil, status.InvalidArgument(nil, "wrong public key type", block.Type)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, status.InvalidArgument(err, "can't parse public key")
+	}
+	switch key := key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, status.InvalidArgumentf(nil, "wrong public key type %T", key)
+	}
+}
+
+// watchPwtKeySet reloads the pwt key set whenever its file changes, until ctx is done.  Bad key
+// sets are logged and ignored, leaving the previous keys in use.
+func (s *Server) watchPwtKeySet(ctx context.Context) {
+	ticker := time.NewTicker(pwtKeySetReloadInterval)
+	defer ticker.Stop()
+	modTime := s.pwtKeySetModTime
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		fi, err := os.Stat(s.pwtKeySetPath)
+		if err != nil {
+			glog.Warning("can't stat pwt key set ", err)
+			continue
+		}
+		if fi.ModTime().Equal(modTime) {
+			continue
+		}
+		ks, mt, sts := loadPwtKeySet(s.pwtKeySetPath)
+		if sts != nil {
+			glog.Warning(sts.String())
+			continue
+		}
+		if err := handlers.SetPwtKeySet(ks); err != nil {
+			glog.Warning(err)
+			continue
+		}
+		modTime = mt
+		glog.Info("reloaded pwt key set ", s.pwtKeySetPath)
+	}
+}
@@ -0,0 +1,96 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &AddCollectionPicTask{}
+
+// AddCollectionPicTask adds a pic to the end of a collection.  Only the owner may add pics.
+type AddCollectionPicTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	CollectionId int64
+	PicId        int64
+
+	// Results
+	Collection *schema.Collection
+}
+
+func (t *AddCollectionPicTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	c, sts := lookupCollectionForUpdate(j, t.CollectionId, su, conf)
+	if sts != nil {
+		return sts
+	}
+
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&t.PicId},
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't lookup pic")
+	}
+	if len(pics) != 1 {
+		return status.NotFound(nil, "can't find pic")
+	}
+	if pics[0].HardDeleted() {
+		return status.InvalidArgument(nil, "can't add deleted pic")
+	}
+
+	cps, sts := findCollectionPics(j, c.CollectionId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	for _, cp := range cps {
+		if cp.PicId == t.PicId {
+			return status.AlreadyExists(nil, "pic already in collection")
+		}
+	}
+	if conf.MaxCollectionPics != nil && int64(len(cps)) >= conf.MaxCollectionPics.Value {
+		return status.ResourceExhausted(nil, "too many pics in collection")
+	}
+	var position int64
+	if len(cps) != 0 {
+		position = cps[len(cps)-1].Position + 1
+	}
+
+	cp := &schema.CollectionPic{
+		CollectionId: c.CollectionId,
+		PicId:        t.PicId,
+		Position:     position,
+	}
+	cp.SetCreatedTime(now)
+	cp.SetModifiedTime(now)
+	if err := j.InsertCollectionPic(cp); err != nil {
+		return status.Internal(err, "can't insert collection pic")
+	}
+	if sts := touchCollection(j, c, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Collection = c
+	return nil
+}
@@ -0,0 +1,74 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestAddCollectionPic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	col := u.CreateCollection("Cats", schema.Collection_PRIVATE)
+	p1, p2 := c.CreatePic(), c.CreatePic()
+	c.AddCollectionPic(col, p1, 5)
+
+	task := &AddCollectionPicTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		CollectionId: col.CollectionId,
+		PicId:        p2.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if ids := c.CollectionPicIds(col); len(ids) != 2 || ids[0] != p1.Pic.PicId || ids[1] != p2.Pic.PicId {
+		t.Error("wrong pics", ids)
+	}
+
+	task.Collection = nil
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.AlreadyExists; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddCollectionPic_TooMany(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	col := u.CreateCollection("Cats", schema.Collection_PRIVATE)
+	c.AddCollectionPic(col, c.CreatePic(), 0)
+
+	conf := schema.GetDefaultConfiguration()
+	conf.MaxCollectionPics = &wpb.Int64Value{Value: 1}
+	ctx := CtxFromTestConfig(u.AuthedCtx(c.Ctx), conf)
+
+	task := &AddCollectionPicTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		CollectionId: col.CollectionId,
+		PicId:        c.CreatePic().Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -44,20 +44,9 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	var minCommentLen, maxCommentLen int64
-	if conf.MinCommentLength != nil {
-		minCommentLen = conf.MinCommentLength.Value
-	} else {
-		minCommentLen = math.MinInt64
-	}
-	if conf.MaxCommentLength != nil {
-		maxCommentLen = conf.MaxCommentLength.Value
-	} else {
-		maxCommentLen = math.MaxInt64
-	}
-	txt, err := text.DefaultValidateAndNormalize(t.Text, "comment", minCommentLen, maxCommentLen)
-	if err != nil {
-		return status.From(err)
+	txt, sts := validatePicCommentText(t.Text, conf)
+	if sts != nil {
+		return sts
 	}
 
 	if sts := validateCapability(u, conf, schema.User_PIC_COMMENT_CREATE); sts != nil {
@@ -101,6 +90,9 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 			return status.NotFound(nil, "can't find comment")
 		}
 		commentParent = comments[0]
+		if commentParent.Deleted() {
+			return status.InvalidArgument(nil, "can't reply to deleted comment")
+		}
 
 		if conf.EnablePicCommentSelfReply != nil && !conf.EnablePicCommentSelfReply.Value {
 			if userId == commentParent.UserId && userId != schema.AnonymousUserId {
@@ -173,7 +165,7 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 		}
 	}
 	if commentParent != nil && commentParent.UserId != schema.AnonymousUserId &&
-		!notifications[commentParent.UserId] {
+		commentParent.UserId != schema.DeletedUserId && !notifications[commentParent.UserId] {
 		idx, sts := next(commentParent.UserId)
 		if sts != nil {
 			return sts
@@ -196,7 +188,8 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 	// for each of the "uploaders" of the pic.
 	if commentParent == nil {
 		for _, fs := range p.Source {
-			if fs.UserId != schema.AnonymousUserId && !notifications[fs.UserId] {
+			if fs.UserId != schema.AnonymousUserId && fs.UserId != schema.DeletedUserId &&
+				!notifications[fs.UserId] {
 				idx, sts := next(fs.UserId)
 				if sts != nil {
 					return sts
@@ -239,6 +232,27 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 	return nil
 }
 
+// validatePicCommentText normalizes the text of a comment, and checks it is within the configured
+// lengths.
+func validatePicCommentText(txt string, conf *schema.Configuration) (string, status.S) {
+	var minCommentLen, maxCommentLen int64
+	if conf.MinCommentLength != nil {
+		minCommentLen = conf.MinCommentLength.Value
+	} else {
+		minCommentLen = math.MinInt64
+	}
+	if conf.MaxCommentLength != nil {
+		maxCommentLen = conf.MaxCommentLength.Value
+	} else {
+		maxCommentLen = math.MaxInt64
+	}
+	normal, err := text.DefaultValidateAndNormalize(txt, "comment", minCommentLen, maxCommentLen)
+	if err != nil {
+		return "", status.From(err)
+	}
+	return normal, nil
+}
+
 func nextUserEventIndex(j *tab.Job, userId, createdTs int64) (int64, status.S) {
 	ues, err := j.FindUserEvents(db.Opts{
 		// We don't actually intend to write, but this prevents other transactions
@@ -279,7 +293,7 @@ func userCredOf(su *schema.User, conf *schema.Configuration) *userCred {
 	if su != nil {
 		return &userCred{
 			subjectUserId: su.UserId,
-			cs:            schema.CapSetOf(su.Capability...),
+			cs:            su.CapSet(conf),
 		}
 	} else {
 		return &userCred{
@@ -304,6 +318,13 @@ func filterPicCommentInternal(pc *schema.PicComment, uc *userCred) *schema.PicCo
 	if !uc.cs.Has(schema.User_PIC_COMMENT_EXTENSION_READ) {
 		dpc.Ext = nil
 	}
+	isAuthor := uc.subjectUserId != schema.AnonymousUserId && uc.subjectUserId == dpc.UserId
+	if !isAuthor && !uc.cs.Has(schema.User_PIC_COMMENT_MODERATE) {
+		dpc.Revision = nil
+		if dpc.Deleted() {
+			dpc.UserId = schema.AnonymousUserId
+		}
+	}
 	switch {
 	case uc.cs.Has(schema.User_USER_READ_ALL):
 	case uc.cs.Has(schema.User_USER_READ_PUBLIC) && uc.cs.Has(schema.User_USER_READ_PIC_COMMENT):
@@ -0,0 +1,97 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &AddPicFavoriteTask{}
+
+// AddPicFavoriteTask favorites a pic for the subject user.  Favoriting a pic that is already a
+// favorite does nothing.
+type AddPicFavoriteTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	PicId int64
+}
+
+func (t *AddPicFavoriteTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_FAVORITE_CREATE); sts != nil {
+		return sts
+	}
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&t.PicId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't lookup pic")
+	}
+	if len(pics) != 1 {
+		return status.NotFound(nil, "can't find pic")
+	}
+	p := pics[0]
+	if p.HardDeleted() {
+		return status.InvalidArgument(nil, "can't favorite deleted pic")
+	}
+
+	pf, sts := findPicFavorite(j, p.PicId, su.UserId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	if pf != nil {
+		if err := j.Rollback(); err != nil {
+			return status.Internal(err, "can't rollback job")
+		}
+		return nil
+	}
+
+	id, err := j.AllocId()
+	if err != nil {
+		return status.Internal(err, "can't allocate id")
+	}
+	pf = &schema.PicFavorite{
+		PicFavoriteId: id,
+		PicId:         p.PicId,
+		UserId:        su.UserId,
+	}
+	pf.SetCreatedTime(now)
+	pf.SetModifiedTime(now)
+	if err := j.InsertPicFavorite(pf); err != nil {
+		return status.Internal(err, "can't insert pic favorite")
+	}
+
+	p.FavoriteCount++
+	p.SetModifiedTime(now)
+	if err := j.UpdatePic(p); err != nil {
+		return status.Internal(err, "can't update pic")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+)
+
+func (u *TestUser) Favorite(p *TestPic) *schema.PicFavorite {
+	task := &AddPicFavoriteTask{
+		Beg:   u.c.DB(),
+		Now:   time.Now,
+		PicId: p.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(u.c.Ctx), task); sts != nil {
+		u.c.T.Fatal(sts)
+	}
+	j := u.c.Job()
+	defer j.Rollback()
+	pf, sts := findPicFavorite(j, p.Pic.PicId, u.User.UserId, db.LockNone)
+	if sts != nil {
+		u.c.T.Fatal(sts)
+	}
+	return pf
+}
+
+func TestAddPicFavorite(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_FAVORITE_CREATE)
+	u.Update()
+	p := c.CreatePic()
+
+	pf := u.Favorite(p)
+	if pf == nil || pf.UserId != u.User.UserId {
+		t.Fatal("expected favorite", pf)
+	}
+	p.Refresh()
+	if p.Pic.FavoriteCount != 1 {
+		t.Error("wrong favorite count", p.Pic.FavoriteCount)
+	}
+
+	// Favoriting again does nothing.
+	again := u.Favorite(p)
+	if again.PicFavoriteId != pf.PicFavoriteId {
+		t.Error("expected same favorite", again, pf)
+	}
+	p.Refresh()
+	if p.Pic.FavoriteCount != 1 {
+		t.Error("wrong favorite count", p.Pic.FavoriteCount)
+	}
+}
+
+func TestAddPicFavorite_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	p := c.CreatePic()
+
+	task := &AddPicFavoriteTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing cap"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddPicFavorite_DeletedPic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_FAVORITE_CREATE)
+	u.Update()
+	p := c.CreatePic()
+	nowts := schema.ToTspb(time.Now())
+	p.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: nowts,
+		ActualDeletedTs: nowts,
+	}
+	p.Update()
+
+	task := &AddPicFavoriteTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -160,7 +160,8 @@ func (t *AddPicVoteTask) Run(ctx context.Context) (stscap status.S) {
 			}
 		}
 		for _, fs := range p.Source {
-			if fs.UserId != schema.AnonymousUserId && !notifications[fs.UserId] {
+			if fs.UserId != schema.AnonymousUserId && fs.UserId != schema.DeletedUserId &&
+				!notifications[fs.UserId] {
 				idx, sts := next(fs.UserId)
 				if sts != nil {
 					return sts
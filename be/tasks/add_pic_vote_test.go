@@ -105,6 +105,45 @@ func TestAddPicVoteTaskWork_NoDoubleVoting(t *testing.T) {
 	}
 }
 
+func TestAddPicVoteTask_NoEventsForDeletedUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_VOTE_CREATE)
+	u.Update()
+
+	p := c.CreatePic()
+	p.Pic.Source = append(p.Pic.Source, &schema.Pic_FileSource{
+		UserId:    schema.DeletedUserId,
+		CreatedTs: p.Pic.CreatedTs,
+	})
+	p.Update()
+
+	task := &AddPicVoteTask{
+		Vote:  schema.PicVote_UP,
+		PicId: p.Pic.PicId,
+		Beg:   c.DB(),
+		Now:   time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	deletedUserId := schema.DeletedUserId
+	ues, err := j.FindUserEvents(db.Opts{
+		Prefix: tab.UserEventsPrimary{UserId: &deletedUserId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ues) != 0 {
+		t.Error("expected no events for the deleted user", ues)
+	}
+}
+
 func TestAddPicVoteTaskWork_MissingPic(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -0,0 +1,81 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &AddTagFollowTask{}
+
+// AddTagFollowTask follows a tag for the subject user.  The tag doesn't need to be on any pics
+// yet.  Following a tag that is already followed does nothing.
+type AddTagFollowTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	TagName string
+}
+
+func (t *AddTagFollowTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_FOLLOW_CREATE); sts != nil {
+		return sts
+	}
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	name, uniq, sts := cleanFollowTagName(t.TagName, conf)
+	if sts != nil {
+		return sts
+	}
+
+	tfs, err := j.FindTagFollows(db.Opts{
+		Prefix: tab.TagFollowsPrimary{UserId: &su.UserId, TagName: &uniq},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find tag follows")
+	}
+	if len(tfs) != 0 {
+		if err := j.Rollback(); err != nil {
+			return status.Internal(err, "can't rollback job")
+		}
+		return nil
+	}
+	if sts := validateCanFollowMore(j, su.UserId, conf); sts != nil {
+		return sts
+	}
+
+	tf := &schema.TagFollow{
+		UserId:  su.UserId,
+		TagName: name,
+	}
+	tf.SetCreatedTime(now)
+	tf.SetModifiedTime(now)
+	if err := j.InsertTagFollow(tf); err != nil {
+		return status.Internal(err, "can't insert tag follow")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
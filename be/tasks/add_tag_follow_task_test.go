@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestAddTagFollow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+
+	u.FollowTag(" Cats ")
+	_, tfs := u.Follows()
+	if len(tfs) != 1 {
+		t.Fatal("expected follow", tfs)
+	}
+	if have, want := tfs[0].TagName, "Cats"; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	// Following the same tag with a different case does nothing.
+	u.FollowTag("cats")
+	_, tfs = u.Follows()
+	if len(tfs) != 1 {
+		t.Fatal("expected one follow", tfs)
+	}
+	if have, want := tfs[0].TagName, "Cats"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddTagFollow_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &AddTagFollowTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		TagName: "cats",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing cap"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddTagFollow_BadName(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+
+	task := &AddTagFollowTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		TagName: "a\nb",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,114 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &AddUserFollowTask{}
+
+// AddUserFollowTask follows a user for the subject user, and lets the followee know with a user
+// event.  Following a user that is already followed does nothing.
+type AddUserFollowTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	FolloweeUserId int64
+}
+
+func (t *AddUserFollowTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_FOLLOW_CREATE); sts != nil {
+		return sts
+	}
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if t.FolloweeUserId == schema.AnonymousUserId {
+		return status.InvalidArgument(nil, "missing user id")
+	}
+	if t.FolloweeUserId == su.UserId {
+		return status.InvalidArgument(nil, "can't follow self")
+	}
+
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&t.FolloweeUserId},
+		Lock:   db.LockNone,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.NotFound(nil, "can't lookup user")
+	}
+
+	ufs, err := j.FindUserFollows(db.Opts{
+		Prefix: tab.UserFollowsPrimary{UserId: &su.UserId, FolloweeUserId: &t.FolloweeUserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find user follows")
+	}
+	if len(ufs) != 0 {
+		if err := j.Rollback(); err != nil {
+			return status.Internal(err, "can't rollback job")
+		}
+		return nil
+	}
+	if sts := validateCanFollowMore(j, su.UserId, conf); sts != nil {
+		return sts
+	}
+
+	uf := &schema.UserFollow{
+		UserId:         su.UserId,
+		FolloweeUserId: t.FolloweeUserId,
+	}
+	uf.SetCreatedTime(now)
+	uf.SetModifiedTime(now)
+	if err := j.InsertUserFollow(uf); err != nil {
+		return status.Internal(err, "can't insert user follow")
+	}
+
+	idx, sts := nextUserEventIndex(j, t.FolloweeUserId, schema.UserEventCreatedTsCol(uf.CreatedTs))
+	if sts != nil {
+		return sts
+	}
+	ue := &schema.UserEvent{
+		UserId:     t.FolloweeUserId,
+		Index:      idx,
+		CreatedTs:  uf.CreatedTs,
+		ModifiedTs: uf.ModifiedTs,
+		Evt: &schema.UserEvent_IncomingFollow_{
+			IncomingFollow: &schema.UserEvent_IncomingFollow{
+				SubjectUserId: su.UserId,
+			},
+		},
+	}
+	if err := j.InsertUserEvent(ue); err != nil {
+		return status.Internal(err, "can't create incoming user event")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+func (u *TestUser) FollowUser(followee *TestUser) {
+	task := &AddUserFollowTask{
+		Beg:            u.c.DB(),
+		Now:            time.Now,
+		FolloweeUserId: followee.User.UserId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(u.c.Ctx), task); sts != nil {
+		u.c.T.Fatal(sts)
+	}
+}
+
+func (u *TestUser) FollowTag(name string) {
+	task := &AddTagFollowTask{
+		Beg:     u.c.DB(),
+		Now:     time.Now,
+		TagName: name,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(u.c.Ctx), task); sts != nil {
+		u.c.T.Fatal(sts)
+	}
+}
+
+func (u *TestUser) Follows() ([]*schema.UserFollow, []*schema.TagFollow) {
+	j := u.c.Job()
+	defer j.Rollback()
+	ufs, sts := findUserFollows(j, u.User.UserId, db.LockNone)
+	if sts != nil {
+		u.c.T.Fatal(sts)
+	}
+	tfs, sts := findTagFollows(j, u.User.UserId, db.LockNone)
+	if sts != nil {
+		u.c.T.Fatal(sts)
+	}
+	return ufs, tfs
+}
+
+func (u *TestUser) Events() []*schema.UserEvent {
+	j := u.c.Job()
+	defer j.Rollback()
+	ues, err := j.FindUserEvents(db.Opts{
+		Prefix: tab.UserEventsPrimary{UserId: &u.User.UserId},
+	})
+	if err != nil {
+		u.c.T.Fatal(err)
+	}
+	return ues
+}
+
+func TestAddUserFollow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+	followee := c.CreateUser()
+
+	u.FollowUser(followee)
+	ufs, _ := u.Follows()
+	if len(ufs) != 1 || ufs[0].FolloweeUserId != followee.User.UserId {
+		t.Fatal("expected follow", ufs)
+	}
+	ues := followee.Events()
+	if len(ues) != 1 {
+		t.Fatal("expected one event", ues)
+	}
+	if have, want := ues[0].GetIncomingFollow().GetSubjectUserId(), u.User.UserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	// Following again does nothing.
+	u.FollowUser(followee)
+	if ufs, _ := u.Follows(); len(ufs) != 1 {
+		t.Error("expected one follow", ufs)
+	}
+	if ues := followee.Events(); len(ues) != 1 {
+		t.Error("expected one event", ues)
+	}
+}
+
+func TestAddUserFollow_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	followee := c.CreateUser()
+
+	task := &AddUserFollowTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		FolloweeUserId: followee.User.UserId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing cap"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddUserFollow_Self(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+
+	task := &AddUserFollowTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		FolloweeUserId: u.User.UserId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddUserFollow_MissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+
+	task := &AddUserFollowTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		FolloweeUserId: c.Id(),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddUserFollow_TooMany(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+	u.FollowTag("cats")
+	followee := c.CreateUser()
+
+	conf := schema.GetDefaultConfiguration()
+	conf.MaxFollows = &wpb.Int64Value{Value: 1}
+	ctx := CtxFromTestConfig(u.AuthedCtx(c.Ctx), conf)
+
+	task := &AddUserFollowTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		FolloweeUserId: followee.User.UserId,
+	}
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
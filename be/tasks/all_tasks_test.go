@@ -43,6 +43,7 @@ func Container(t testing.TB) *TestContainer {
 	}
 	task := &LoadConfigurationTask{
 		Beg: c.DB(),
+		Now: time.Now,
 	}
 	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
 		t.Fatal(sts)
@@ -0,0 +1,104 @@
+package tasks
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &AppendUploadSessionTask{}
+
+// AppendUploadSessionTask writes the next part of the pic data into an upload session.
+type AppendUploadSessionTask struct {
+	// Deps
+	PixPath string
+	Beg     tab.JobBeginner
+	Now     func() time.Time
+	// os functions
+	OpenFile func(name string, flag int, perm os.FileMode) (*os.File, error)
+
+	// Inputs
+	UploadSessionId int64
+	// Offset must match the number of bytes already received.  This prevents a retried append from
+	// writing the same data twice.
+	Offset int64
+	Data   []byte
+
+	// Results
+	UploadSession *schema.UploadSession
+	// NextOffset is the offset to use for the following append.
+	NextOffset int64
+}
+
+func (t *AppendUploadSessionTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.UploadSession, t.NextOffset = nil, 0
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if u == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(u, conf, schema.User_PIC_CREATE); sts != nil {
+		return sts
+	}
+
+	// Lock the session so concurrent appends are serialized.
+	us, sts := findUploadSession(j, t.UploadSessionId, u.UserId, db.LockWrite, conf, now)
+	if sts != nil {
+		return sts
+	}
+
+	path := schema.UploadSessionPath(t.PixPath, us.UploadSessionId)
+	f, err := t.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return status.Internal(err, "can't open upload session file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't close", path))
+		}
+	}()
+	fi, err := f.Stat()
+	if err != nil {
+		return status.Internal(err, "can't stat upload session file")
+	}
+	if fi.Size() != t.Offset {
+		return status.FailedPreconditionf(nil, "offset %d doesn't match upload session offset %d",
+			t.Offset, fi.Size())
+	}
+	if max := conf.MaxUploadStreamSize; max != nil && t.Offset+int64(len(t.Data)) > max.Value {
+		return status.InvalidArgumentf(nil, "file too large > %d", max.Value)
+	}
+	if _, err := f.WriteAt(t.Data, t.Offset); err != nil {
+		return status.Internal(err, "can't write upload session file")
+	}
+	if err := f.Sync(); err != nil {
+		return status.Internal(err, "can't sync upload session file")
+	}
+
+	us.SetModifiedTime(now)
+	if err := j.UpdateUploadSession(us); err != nil {
+		return status.Internal(err, "can't update upload session")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit")
+	}
+
+	t.UploadSession = us
+	t.NextOffset = t.Offset + int64(len(t.Data))
+	return nil
+}
@@ -0,0 +1,208 @@
+package tasks
+
+import (
+	"crypto/md5"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func TestAppendUploadSession(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	us := c.startUploadSession(u, md5Hash[:], time.Unix(100, 0))
+
+	for i, part := range []string{"da", "ta"} {
+		now := time.Unix(int64(200+i), 0)
+		task := &AppendUploadSessionTask{
+			PixPath:  c.TempDir(),
+			Beg:      c.DB(),
+			Now:      func() time.Time { return now },
+			OpenFile: os.OpenFile,
+
+			UploadSessionId: us.UploadSessionId,
+			Offset:          int64(2 * i),
+			Data:            []byte(part),
+		}
+		if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+			t.Fatal(sts)
+		}
+		if have, want := task.NextOffset, int64(2*i+2); have != want {
+			t.Error("have", have, "want", want)
+		}
+		if !task.UploadSession.GetModifiedTime().Equal(now) {
+			t.Error("modified time not updated", task.UploadSession)
+		}
+	}
+
+	data, err := ioutil.ReadFile(schema.UploadSessionPath(c.TempDir(), us.UploadSessionId))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(data), "data"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAppendUploadSession_WrongOffset(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	us := c.startUploadSession(u, md5Hash[:], time.Now())
+
+	task := &AppendUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      time.Now,
+		OpenFile: os.OpenFile,
+
+		UploadSessionId: us.UploadSessionId,
+		Offset:          2,
+		Data:            []byte("ta"),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.FailedPrecondition(nil, "offset 2 doesn't match upload session offset 0")
+	compareStatus(t, sts, expected)
+}
+
+func TestAppendUploadSession_OtherUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	u2 := c.CreateUser()
+	u2.User.Capability = append(u2.User.Capability, schema.User_PIC_CREATE)
+	u2.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	us := c.startUploadSession(u, md5Hash[:], time.Now())
+
+	task := &AppendUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      time.Now,
+		OpenFile: os.OpenFile,
+
+		UploadSessionId: us.UploadSessionId,
+		Data:            []byte("da"),
+	}
+	sts := new(TaskRunner).Run(u2.AuthedCtx(c.Ctx), task)
+	expected := status.NotFound(nil, "can't find upload session")
+	compareStatus(t, sts, expected)
+}
+
+func TestAppendUploadSession_MissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	us := c.startUploadSession(u, md5Hash[:], time.Now())
+
+	task := &AppendUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      time.Now,
+		OpenFile: os.OpenFile,
+
+		UploadSessionId: us.UploadSessionId,
+		Data:            []byte("da"),
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
+
+func TestAppendUploadSession_Expired(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	now := time.Now()
+	us := c.startUploadSession(u, md5Hash[:], now)
+
+	// The default expiry is a day.
+	task := &AppendUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      func() time.Time { return now.Add(24 * time.Hour) },
+		OpenFile: os.OpenFile,
+
+		UploadSessionId: us.UploadSessionId,
+		Data:            []byte("da"),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.NotFound(nil, "upload session expired")
+	compareStatus(t, sts, expected)
+}
+
+func TestAppendUploadSession_TooLarge(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	us := c.startUploadSession(u, md5Hash[:], time.Now())
+
+	conf := schema.GetDefaultConfiguration()
+	conf.MaxUploadStreamSize = &wpb.Int64Value{Value: 3}
+	ctx := CtxFromTestConfig(u.AuthedCtx(c.Ctx), conf)
+	for i, part := range []string{"da", "ta"} {
+		task := &AppendUploadSessionTask{
+			PixPath:  c.TempDir(),
+			Beg:      c.DB(),
+			Now:      time.Now,
+			OpenFile: os.OpenFile,
+
+			UploadSessionId: us.UploadSessionId,
+			Offset:          int64(2 * i),
+			Data:            []byte(part),
+		}
+		sts := new(TaskRunner).Run(ctx, task)
+		if i == 0 {
+			if sts != nil {
+				t.Fatal(sts)
+			}
+			continue
+		}
+		expected := status.InvalidArgument(nil, "file too large > 3")
+		compareStatus(t, sts, expected)
+	}
+
+	data, err := ioutil.ReadFile(schema.UploadSessionPath(c.TempDir(), us.UploadSessionId))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(data), "da"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
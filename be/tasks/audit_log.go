@@ -0,0 +1,54 @@
+package tasks
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+// maxAuditReasonLength is the maximum length in bytes of the reason given for a privileged action.
+const maxAuditReasonLength = 1024
+
+// validateAuditReason checks a reason given for a privileged action.  The reason is optional.
+func validateAuditReason(reason string) (string, status.S) {
+	if reason == "" {
+		return "", nil
+	}
+	normal, err := text.DefaultValidateAndNormalize(reason, "reason", 1, maxAuditReasonLength)
+	if err != nil {
+		return "", status.From(err)
+	}
+	return normal, nil
+}
+
+// insertAuditLog records a privileged action as part of j, so the entry is only kept if the action
+// is.  The caller fills in the action, target, and reason of al.  before and after are the target
+// before and after the action, and are compared to find the changes.  su is the actor, and may be
+// nil if the server took the action.
+func insertAuditLog(j *tab.Job, al *schema.AuditLog, su *schema.User,
+	before, after proto.Message, now time.Time) status.S {
+	id, err := j.AllocId()
+	if err != nil {
+		return status.Internal(err, "can't allocate id")
+	}
+	al.AuditLogId = id
+	if su != nil {
+		al.ActorUserId = su.UserId
+	}
+	changes, sts := schema.AuditChanges(before, after)
+	if sts != nil {
+		return sts
+	}
+	al.Change = changes
+	al.SetCreatedTime(now)
+	al.SetModifiedTime(now)
+	if err := j.InsertAuditLog(al); err != nil {
+		return status.Internal(err, "can't create audit log")
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 
 	"pixur.org/pixur/be/schema"
@@ -12,7 +13,12 @@ import (
 	"pixur.org/pixur/be/status"
 )
 
-const lastSeenUpdateThreshold = 24 * time.Hour
+const (
+	lastSeenUpdateThreshold = 24 * time.Hour
+	// apiKeyLastUsedUpdateThreshold is finer than for users, since keys are usually revoked based
+	// on when they were last used.
+	apiKeyLastUsedUpdateThreshold = time.Hour
+)
 
 func authedJob(ctx context.Context, beg tab.JobBeginner, now time.Time) (
 	_ *tab.Job, _ *schema.User, stscap status.S) {
@@ -28,6 +34,50 @@ func authedJobInternal(ctx context.Context, beg tab.JobBeginner, now time.Time,
 	_ *tab.Job, _ *schema.User, stscap status.S) {
 
 	tok, tokPresent := UserTokenFromCtx(ctx)
+	key, keyPresent := ApiKeyFromCtx(ctx)
+	if tokPresent && keyPresent {
+		return nil, nil, status.InvalidArgument(nil, "can't use both auth token and api key")
+	}
+
+	// validate finds the subject user.  If the user or key needs updating, save is non-nil, and
+	// must be called with a writable job.
+	var validate func(j *tab.Job, lk db.Lock) (_ *schema.User, save func() status.S, _ status.S)
+	var conf *schema.Configuration
+	if tokPresent || keyPresent {
+		// The configuration is needed to resolve the roles of the user.
+		var sts status.S
+		if conf, sts = GetConfiguration(ctx); sts != nil {
+			return nil, nil, sts
+		}
+	}
+	if tokPresent {
+		validate = func(j *tab.Job, lk db.Lock) (*schema.User, func() status.S, status.S) {
+			su, u, updated, sts :=
+				validateAndUpdateUserAndToken(j, tok.UserId, tok.TokenId, conf, lk, now)
+			if sts != nil || !updated {
+				return su, nil, sts
+			}
+			return su, func() status.S {
+				if err := j.UpdateUser(u); err != nil {
+					return status.Internal(err, "can't update user")
+				}
+				return nil
+			}, nil
+		}
+	} else if keyPresent {
+		validate = func(j *tab.Job, lk db.Lock) (*schema.User, func() status.S, status.S) {
+			u, ak, updated, sts := validateAndUpdateApiKey(j, key, conf, lk, now)
+			if sts != nil || !updated {
+				return u, nil, sts
+			}
+			return u, func() status.S {
+				if err := j.UpdateApiKey(ak); err != nil {
+					return status.Internal(err, "can't update api key")
+				}
+				return nil
+			}, nil
+		}
+	}
 
 	var j *tab.Job
 	var err error
@@ -52,16 +102,16 @@ func authedJobInternal(ctx context.Context, beg tab.JobBeginner, now time.Time,
 		}
 		rollback = true
 
-		if !tokPresent {
+		if validate == nil {
 			rollback = false
 			return j, nil, nil
 		}
 
-		u, updated, sts := validateAndUpdateUserAndToken(j, tok.UserId, tok.TokenId, db.LockNone, now)
+		u, save, sts := validate(j, db.LockNone)
 		if sts != nil {
 			return nil, nil, sts
 		}
-		if !updated {
+		if save == nil {
 			rollback = false
 			return j, u, nil
 		}
@@ -78,16 +128,16 @@ func authedJobInternal(ctx context.Context, beg tab.JobBeginner, now time.Time,
 			rollback = true
 		}
 
-		u, updated, sts = validateAndUpdateUserAndToken(j, tok.UserId, tok.TokenId, db.LockWrite, now)
+		_, save, sts = validate(j, db.LockWrite)
 		if sts != nil {
 			return nil, nil, sts
 		}
-		if !updated {
+		if save == nil {
 			// I don't think this is technically possible, but just in case.
 			return nil, nil, status.Internal(nil, "unexpected non snapshot read during update")
 		}
-		if err := j.UpdateUser(u); err != nil {
-			return nil, nil, status.Internal(err, "can't update user")
+		if sts := save(); sts != nil {
+			return nil, nil, sts
 		}
 		if err := j.Commit(); err != nil {
 			return nil, nil, status.Internal(err, "can't commit")
@@ -96,17 +146,20 @@ func authedJobInternal(ctx context.Context, beg tab.JobBeginner, now time.Time,
 	}
 }
 
-func validateAndUpdateUserAndToken(j *tab.Job, userId, tokenId int64, lk db.Lock, now time.Time) (
-	*schema.User, bool, status.S) {
+// validateAndUpdateUserAndToken finds the user of a token.  It returns the subject user, and the
+// stored user to save if updated.  If the user is suspended, the subject user is a copy with only
+// the read capabilities the user has, and no roles, and must not be saved.
+func validateAndUpdateUserAndToken(j *tab.Job, userId, tokenId int64, conf *schema.Configuration,
+	lk db.Lock, now time.Time) (_, _ *schema.User, _ bool, _ status.S) {
 	us, err := j.FindUsers(db.Opts{
 		Prefix: tab.UsersPrimary{&userId},
 		Lock:   lk,
 	})
 	if err != nil {
-		return nil, false, status.Internal(err, "can't find users")
+		return nil, nil, false, status.Internal(err, "can't find users")
 	}
 	if len(us) != 1 {
-		return nil, false, status.Unauthenticated(nil, "can't lookup user")
+		return nil, nil, false, status.Unauthenticated(nil, "can't lookup user")
 	}
 	u := us[0]
 	tokenIdx := -1
@@ -117,18 +170,18 @@ func validateAndUpdateUserAndToken(j *tab.Job, userId, tokenId int64, lk db.Lock
 		}
 	}
 	if tokenIdx == -1 {
-		return nil, false, status.Unauthenticated(nil, "token id has been deleted")
+		return nil, nil, false, status.Unauthenticated(nil, "token id has been deleted")
 	}
 	nowts, err := ptypes.TimestampProto(now)
 	if err != nil {
-		return nil, false, status.Internal(err, "can't get now ts")
+		return nil, nil, false, status.Internal(err, "can't get now ts")
 	}
 	ut := u.UserToken[tokenIdx]
 	var updated bool
 	if ut.LastSeenTs != nil {
 		utLastSeen, err := ptypes.Timestamp(ut.LastSeenTs)
 		if err != nil {
-			return nil, false, status.Internal(err, "can't get token ts")
+			return nil, nil, false, status.Internal(err, "can't get token ts")
 		}
 		if now.Add(-lastSeenUpdateThreshold).After(utLastSeen) {
 			ut.LastSeenTs = nowts
@@ -138,7 +191,7 @@ func validateAndUpdateUserAndToken(j *tab.Job, userId, tokenId int64, lk db.Lock
 	if u.LastSeenTs != nil {
 		uLastSeen, err := ptypes.Timestamp(u.LastSeenTs)
 		if err != nil {
-			return nil, false, status.Internal(err, "can't get user ts")
+			return nil, nil, false, status.Internal(err, "can't get user ts")
 		}
 		if now.Add(-lastSeenUpdateThreshold).After(uLastSeen) {
 			u.LastSeenTs = nowts
@@ -148,7 +201,60 @@ func validateAndUpdateUserAndToken(j *tab.Job, userId, tokenId int64, lk db.Lock
 	if updated {
 		u.ModifiedTs = nowts
 	}
-	return u, updated, nil
+	su := u
+	if u.Suspended(now) {
+		su = proto.Clone(u).(*schema.User)
+		readonly, _, _ := schema.CapIntersect(u.CapSet(conf), schema.CapSetOf(schema.ReadCapabilities...))
+		su.Capability = readonly.Slice()
+		su.Role = nil
+	}
+	return su, u, updated, nil
+}
+
+// validateAndUpdateApiKey finds the user of an api key.  The returned user is a copy with only the
+// capabilities allowed by the key, and no roles, and must not be saved.
+func validateAndUpdateApiKey(
+	j *tab.Job, key string, conf *schema.Configuration, lk db.Lock, now time.Time) (
+	*schema.User, *schema.ApiKey, bool, status.S) {
+	keyHash := secretTokenHash(key)
+	aks, err := j.FindApiKeys(db.Opts{
+		Prefix: tab.ApiKeysKeyHash{&keyHash},
+		Lock:   lk,
+	})
+	if err != nil {
+		return nil, nil, false, status.Internal(err, "can't find api keys")
+	}
+	if len(aks) != 1 {
+		return nil, nil, false, status.Unauthenticated(nil, "bad api key")
+	}
+	ak := aks[0]
+	if ak.Expired(now) {
+		return nil, nil, false, status.Unauthenticated(nil, "api key expired")
+	}
+	us, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&ak.UserId},
+	})
+	if err != nil {
+		return nil, nil, false, status.Internal(err, "can't find users")
+	}
+	if len(us) != 1 {
+		return nil, nil, false, status.Unauthenticated(nil, "can't lookup user")
+	}
+	u := us[0]
+	have := u.CapSet(conf)
+	if u.Suspended(now) {
+		have, _, _ = schema.CapIntersect(have, schema.CapSetOf(schema.ReadCapabilities...))
+	}
+	both, _, _ := schema.CapIntersect(have, schema.CapSetOf(ak.Capability...))
+	u.Capability = both.Slice()
+	u.Role = nil
+
+	var updated bool
+	if ak.LastUsedTs == nil || now.Add(-apiKeyLastUsedUpdateThreshold).After(schema.ToTime(ak.LastUsedTs)) {
+		ak.LastUsedTs = schema.ToTspb(now)
+		updated = true
+	}
+	return u, ak, updated, nil
 }
 
 // validateCapability ensures the given user has the requested permissions.  If the user is nil,
@@ -161,16 +267,28 @@ func validateCapability(
 
 // validateCapSet ensures the given user has the requested permissions.  If the user is nil,
 // the anonymous user is used from the given configuration.  At least one of `u` or `conf` must
-// not be nil.
+// not be nil.  The user has both their own capabilities and those of their roles.  If the
+// configuration requires two-factor authentication for any of the wanted capabilities, the user
+// must be enrolled.
 func validateCapSet(
 	u *schema.User, conf *schema.Configuration, want *schema.CapSet) status.S {
 	var have *schema.CapSet
 	if u != nil {
-		have = schema.CapSetOf(u.Capability...)
+		have = u.CapSet(conf)
 	} else {
 		have = schema.CapSetOf(conf.AnonymousCapability.Capability...)
 	}
-	return schema.VerifyCapSubset(have, want)
+	if sts := schema.VerifyCapSubset(have, want); sts != nil {
+		return sts
+	}
+	if u != nil && conf != nil && conf.TotpRequiredCapability != nil && !u.TotpEnrolled() {
+		for _, c := range conf.TotpRequiredCapability.Capability {
+			if want.Has(c) {
+				return status.PermissionDenied(nil, "cap", c, "requires two-factor authentication")
+			}
+		}
+	}
+	return nil
 }
 
 // deriveObjectUserId combines a requested object user id and a subject user.
@@ -190,7 +308,8 @@ func deriveObjectUserId(objectUserId int64, subjectUser *schema.User) (int64, st
 
 // lookupObjectUser returns the user for objectUserId, or subjectUser if it has the same UserId.
 // The subject user may be nil, but the returned object user will not be nil.  If the subject user
-// has the same user id as the object user, the returned user will be identical to subjectUser.
+// has the same user id as the object user, the returned user will be identical to subjectUser,
+// unless the subject user was authenticated with an api key.
 func lookupObjectUser(
 	ctx context.Context, j *tab.Job, lk db.Lock, objectUserId int64, subjectUser *schema.User) (
 	*schema.User, status.S) {
@@ -198,7 +317,9 @@ func lookupObjectUser(
 	if sts != nil {
 		return nil, sts
 	}
-	if subjectUser != nil && subjectUser.UserId == objectUserId {
+	// Users authenticated with an api key have their capabilities reduced, and can't be reused.
+	if _, keyPresent := ApiKeyFromCtx(ctx); !keyPresent &&
+		subjectUser != nil && subjectUser.UserId == objectUserId {
 		return subjectUser, nil
 	}
 	us, err := j.FindUsers(db.Opts{
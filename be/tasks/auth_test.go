@@ -11,6 +11,8 @@ import (
 
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
 )
 
 func TestAuthedJob_badBeg(t *testing.T) {
@@ -119,6 +121,71 @@ func TestAuthedJob_userUpdate(t *testing.T) {
 	}
 }
 
+func TestAuthedJob_suspendedUserCanOnlyRead(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	u.User.Capability = []schema.User_Capability{schema.User_PIC_READ, schema.User_PIC_CREATE}
+	u.User.Role = []string{"moderator"}
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId:    2,
+		CreatedTs:  schema.ToTspb(now),
+		LastSeenTs: schema.ToTspb(now.Add(-lastSeenUpdateThreshold - 1)),
+	})
+	u.User.Suspension = &schema.User_Suspension{
+		Reason:  "spam",
+		StartTs: schema.ToTspb(now.Add(-time.Hour)),
+		EndTs:   schema.ToTspb(now.Add(time.Hour)),
+	}
+	u.Update()
+
+	ctx := CtxFromUserToken(c.Ctx, u.User.UserId, 2)
+	j, su, sts := authedJob(ctx, c.DB(), now)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if err := j.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_READ, schema.User_USER_READ_ALL); sts != nil {
+		t.Error("expected read caps to be kept", sts)
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_CREATE); sts == nil {
+		t.Error("expected create cap to be stripped")
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_SOFT_DELETE); sts == nil {
+		t.Error("expected role cap to be stripped")
+	}
+
+	// The stored user keeps their capabilities, so they return when the suspension ends.
+	u.Refresh()
+	if len(u.User.Capability) != 2 || len(u.User.Role) != 1 {
+		t.Error("expected stored user to be unchanged", u.User)
+	}
+	ut := u.User.UserToken[len(u.User.UserToken)-1]
+	if !proto.Equal(ut.LastSeenTs, schema.ToTspb(now)) {
+		t.Error("expected token last seen to update", ut)
+	}
+
+	j, su, sts = authedReadonlyJob(ctx, c.DB(), now.Add(2*time.Hour))
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if err := j.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_CREATE); sts != nil {
+		t.Error("expected caps after suspension ends", sts)
+	}
+}
+
 func TestValidateAndUpdateUserAndToken_badJob(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -127,7 +194,7 @@ func TestValidateAndUpdateUserAndToken_badJob(t *testing.T) {
 	if err := j.Rollback(); err != nil {
 		t.Fatal(err)
 	}
-	_, _, sts := validateAndUpdateUserAndToken(j, 1, 2, db.LockNone, time.Now())
+	_, _, _, sts := validateAndUpdateUserAndToken(j, 1, 2, nil, db.LockNone, time.Now())
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -147,7 +214,7 @@ func TestValidateAndUpdateUserAndToken_noUser(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, 1, 2, db.LockNone, time.Now())
+	_, _, _, sts := validateAndUpdateUserAndToken(j, 1, 2, nil, db.LockNone, time.Now())
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -169,7 +236,7 @@ func TestValidateAndUpdateUserAndToken_noToken(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, -1, db.LockNone, time.Now())
+	_, _, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, -1, nil, db.LockNone, time.Now())
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -200,7 +267,7 @@ func TestValidateAndUpdateUserAndToken_badNow(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, time.Unix(1<<62, 0))
+	_, _, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, nil, db.LockNone, time.Unix(1<<62, 0))
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -231,7 +298,7 @@ func TestValidateAndUpdateUserAndToken_badUtLastSeen(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now)
+	_, _, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, nil, db.LockNone, now)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -262,7 +329,7 @@ func TestValidateAndUpdateUserAndToken_badULastSeen(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now)
+	_, _, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, nil, db.LockNone, now)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -293,7 +360,7 @@ func TestValidateAndUpdateUserAndToken_utNotUpdated(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	u2, updated, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now)
+	_, u2, updated, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, nil, db.LockNone, now)
 	if sts != nil {
 		t.Fatal(sts)
 	}
@@ -324,7 +391,7 @@ func TestValidateAndUpdateUserAndToken_updated(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	u2, updated, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now)
+	_, u2, updated, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, nil, db.LockNone, now)
 	if sts != nil {
 		t.Fatal(sts)
 	}
@@ -442,3 +509,203 @@ func TestValidateCapability_capabilitiesNotMerged(t *testing.T) {
 		t.Error("have", have, "want", want)
 	}
 }
+
+func TestValidateCapability_failsOnUnenrolledUserWithTotpRequiredCap(t *testing.T) {
+	u := &schema.User{
+		Capability: []schema.User_Capability{
+			schema.User_PIC_PURGE,
+		},
+	}
+	conf := &schema.Configuration{
+		TotpRequiredCapability: &schema.Configuration_CapabilitySet{
+			Capability: []schema.User_Capability{
+				schema.User_PIC_PURGE,
+			},
+		},
+	}
+	sts := validateCapability(u, conf, schema.User_PIC_PURGE)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "requires two-factor authentication"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestValidateCapability_worksOnEnrolledUserWithTotpRequiredCap(t *testing.T) {
+	u := &schema.User{
+		Capability: []schema.User_Capability{
+			schema.User_PIC_PURGE,
+		},
+		Totp: &schema.User_Totp{
+			ConfirmedTs: schema.ToTspb(time.Now()),
+		},
+	}
+	conf := &schema.Configuration{
+		TotpRequiredCapability: &schema.Configuration_CapabilitySet{
+			Capability: []schema.User_Capability{
+				schema.User_PIC_PURGE,
+			},
+		},
+	}
+	sts := validateCapability(u, conf, schema.User_PIC_PURGE)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+}
+
+func TestValidateCapability_worksOnUserWithRole(t *testing.T) {
+	u := &schema.User{
+		Role: []string{"uploader"},
+	}
+	conf := &schema.Configuration{
+		Role: &schema.Configuration_RoleSet{
+			Role: map[string]*schema.Configuration_CapabilitySet{
+				"uploader": {
+					Capability: []schema.User_Capability{
+						schema.User_PIC_CREATE,
+					},
+				},
+			},
+		},
+	}
+	if sts := validateCapability(u, conf, schema.User_PIC_CREATE); sts != nil {
+		t.Fatal(sts)
+	}
+	// Changing the role changes the user's capabilities.
+	conf.Role.Role["uploader"].Capability = nil
+	sts := validateCapability(u, conf, schema.User_PIC_CREATE)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAuthedJob_apiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE, schema.User_PIC_READ)
+	u.Update()
+	// USER_CREATE is on the key, but not the user, so it should not be granted.
+	u.CreateApiKey("key", schema.User_PIC_READ, schema.User_USER_CREATE)
+
+	j, u2, sts := authedJob(CtxFromApiKey(c.Ctx, "key"), c.DB(), now)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if err := j.Rollback(); err != nil {
+		t.Error("can't rollback", err)
+	}
+	if u2 == nil || u2.UserId != u.User.UserId {
+		t.Fatal("wrong user", u2)
+	}
+	if len(u2.Capability) != 1 || u2.Capability[0] != schema.User_PIC_READ {
+		t.Error("bad caps", u2.Capability)
+	}
+
+	j = c.Job()
+	defer j.Rollback()
+	aks, err := j.FindApiKeys(db.Opts{
+		Prefix: tab.ApiKeysUserId{UserId: &u.User.UserId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aks) != 1 || !schema.ToTime(aks[0].LastUsedTs).Equal(now) {
+		t.Error("expected last used to be updated", aks)
+	}
+}
+
+func TestAuthedJob_apiKeyExpired(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	ak := u.CreateApiKey("key")
+	ak.ExpireTs = schema.ToTspb(now)
+	c.AutoJob(func(j *tab.Job) error {
+		return j.UpdateApiKey(ak)
+	})
+
+	_, _, sts := authedJob(CtxFromApiKey(c.Ctx, "key"), c.DB(), now)
+	expected := status.Unauthenticated(nil, "api key expired")
+	compareStatus(t, sts, expected)
+}
+
+func TestAuthedJob_badApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	_, _, sts := authedJob(CtxFromApiKey(c.Ctx, "key"), c.DB(), time.Now())
+	expected := status.Unauthenticated(nil, "bad api key")
+	compareStatus(t, sts, expected)
+}
+
+func TestAuthedJob_tokenAndApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.CreateApiKey("key")
+
+	_, _, sts := authedJob(CtxFromApiKey(u.AuthedCtx(c.Ctx), "key"), c.DB(), time.Now())
+	expected := status.InvalidArgument(nil, "can't use both auth token and api key")
+	compareStatus(t, sts, expected)
+}
+
+func TestLookupObjectUser_apiKeyRefetchesSubject(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE, schema.User_PIC_READ)
+	u.Update()
+	u.CreateApiKey("key", schema.User_PIC_READ)
+
+	ctx := CtxFromApiKey(c.Ctx, "key")
+	j, su, sts := authedJob(ctx, c.DB(), time.Now())
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	defer j.Rollback()
+
+	ou, sts := lookupObjectUser(ctx, j, db.LockNone, 0, su)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if ou == su || len(ou.Capability) != 2 {
+		t.Error("expected stored user", ou)
+	}
+}
+
+func TestAuthedJob_apiKeyLimitsRoles(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Role = append(u.User.Role, "uploader")
+	u.Update()
+	u.CreateApiKey("key", schema.User_PIC_CREATE)
+
+	j, u2, sts := authedJob(CtxFromApiKey(c.Ctx, "key"), c.DB(), time.Now())
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if err := j.Rollback(); err != nil {
+		t.Error("can't rollback", err)
+	}
+	// The role cap is granted by the key, but the role itself can't be used.
+	if len(u2.Capability) != 1 || u2.Capability[0] != schema.User_PIC_CREATE || len(u2.Role) != 0 {
+		t.Error("bad caps", u2.Capability, u2.Role)
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"math"
 	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/golang/protobuf/ptypes"
-	"golang.org/x/crypto/bcrypt"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
 
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
@@ -21,15 +23,33 @@ type AuthUserTask struct {
 	Beg                    tab.JobBeginner
 	Now                    func() time.Time
 	CompareHashAndPassword func(hashed, password []byte) error
+	// HashPassword rehashes the secret if it doesn't meet the configured policy.  If absent, the
+	// policy is used.
+	HashPassword func([]byte) ([]byte, error)
 	// TODO: GC tokens after a handler provided timeout
 
 	// Inputs
 	Ident  string
 	Secret string
+	// TotpCode is required with Ident and Secret if the user is enrolled in two-factor
+	// authentication.  It may also be an unused recovery code.
+	TotpCode string
 
 	// Alt inputs
 	UserId  int64
 	TokenId int64
+	// ExternalProvider and ExternalSubject name an identity vouched for by the subject user, who
+	// must have the USER_AUTH_EXTERNAL capability.  The user linked to the identity is logged in.
+	// If the identity isn't linked, UserId and TokenId must be provided, and the identity is linked
+	// to that user.
+	ExternalProvider string
+	ExternalSubject  string
+
+	// Optional inputs
+	// UserAgent and RemoteAddr describe the client, and are recorded with the token.  Failed logins
+	// are also counted against RemoteAddr, so it must not be chosen by the client.
+	UserAgent  string
+	RemoteAddr string
 
 	// Results
 	User       *schema.User
@@ -38,16 +58,28 @@ type AuthUserTask struct {
 
 const (
 	maxUserTokens = 10
+	// maxClientInfoLength is the maximum length in bytes of the client info kept with a token.
+	maxClientInfoLength = 256
+	// maxExternalIdentityLength is the maximum length in bytes of an external provider or subject.
+	maxExternalIdentityLength = 255
 )
 
 func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 	now := t.Now()
-	j, _, sts := authedJob(ctx, t.Beg, now)
+	j, su, sts := authedJob(ctx, t.Beg, now)
 	if sts != nil {
 		return sts
 	}
 	defer revert(j, &stscap)
 
+	external := t.ExternalProvider != "" || t.ExternalSubject != ""
+	var link *schema.UserLink
+	if external {
+		if link, sts = t.findUserLink(ctx, j, su); sts != nil {
+			return sts
+		}
+	}
+
 	var user *schema.User
 	nowts, err := ptypes.TimestampProto(now)
 	if err != nil {
@@ -75,6 +107,15 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 		if err != nil {
 			return status.From(err)
 		}
+		var addrFailure *schema.LoginFailure
+		if t.RemoteAddr != "" {
+			if addrFailure, sts = findLoginFailure(j, addrLoginFailureSubject(t.RemoteAddr)); sts != nil {
+				return sts
+			}
+			if addrFailure.Locked(now) {
+				return status.ResourceExhausted(nil, errLoginLockedMsg)
+			}
+		}
 		keyident := schema.UserUniqueIdent(ident)
 		users, err := j.FindUsers(db.Opts{
 			Prefix: tab.UsersIdent{&keyident},
@@ -85,7 +126,8 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 			return status.Internal(err, "can't find users")
 		}
 		if len(users) != 1 {
-			return status.Unauthenticated(nil, "can't lookup user")
+			return t.failLogin(j, nil, nil, addrFailure, now,
+				status.Unauthenticated(nil, "can't lookup user"))
 		}
 		user = users[0]
 
@@ -95,17 +137,40 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 			return status.InvalidArgument(nil, "secret too long")
 		}
 
-		// TODO: rate limit this.
-		if err := t.compareHashAndPassword(user.Secret, []byte(t.Secret)); err != nil {
-			return status.Unauthenticated(err, "can't lookup user")
+		userFailure, sts := findLoginFailure(j, userLoginFailureSubject(user.UserId))
+		if sts != nil {
+			return sts
 		}
-		user.NextTokenId++
-		newTokenId = user.NextTokenId
-		user.UserToken = append(user.UserToken, &schema.UserToken{
-			TokenId:    user.NextTokenId,
-			CreatedTs:  nowts,
-			LastSeenTs: nowts,
-		})
+		if userFailure.Locked(now) {
+			return status.ResourceExhausted(nil, errLoginLockedMsg)
+		}
+
+		if err := compareHashAndPassword(t.CompareHashAndPassword, user.Secret, []byte(t.Secret)); err != nil {
+			return t.failLogin(j, user, userFailure, addrFailure, now,
+				status.Unauthenticated(err, "can't lookup user"))
+		}
+		if user.TotpEnrolled() {
+			if t.TotpCode == "" {
+				return status.Unauthenticated(nil, "missing totp code")
+			}
+			if !verifyTotpCode(user.Totp, t.TotpCode, now) {
+				return t.failLogin(j, user, userFailure, addrFailure, now,
+					status.Unauthenticated(nil, "bad totp code"))
+			}
+		}
+		if passwordHashPolicy(conf).NeedsRehash(user.Secret) {
+			hashed, err := hashPassword(t.HashPassword, conf, []byte(t.Secret))
+			if err != nil {
+				return status.Internal(err, "can't generate password")
+			}
+			user.Secret = hashed
+		}
+		// Only the user's failures are forgotten, so that an attacker with their own account can't
+		// reset the failures of their address.
+		if sts := deleteLoginFailure(j, userFailure); sts != nil {
+			return sts
+		}
+		newTokenId = t.addUserToken(user, nowts)
 
 	} else if t.UserId != 0 {
 		users, err := j.FindUsers(db.Opts{
@@ -123,6 +188,12 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 		for _, ut := range user.UserToken {
 			if ut.TokenId == t.TokenId {
 				ut.LastSeenTs = nowts
+				if t.UserAgent != "" {
+					ut.UserAgent = clientInfo(t.UserAgent)
+				}
+				if t.RemoteAddr != "" {
+					ut.RemoteAddr = clientInfo(t.RemoteAddr)
+				}
 				newTokenId = t.TokenId
 				break
 			}
@@ -130,6 +201,24 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 		if newTokenId == 0 {
 			return status.Unauthenticated(nil, "can't find token")
 		}
+		if link != nil && link.UserId != user.UserId {
+			return status.PermissionDenied(nil, "external identity linked to another user")
+		}
+
+	} else if link != nil {
+		users, err := j.FindUsers(db.Opts{
+			Prefix: tab.UsersPrimary{&link.UserId},
+			Lock:   db.LockWrite,
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find users")
+		}
+		if len(users) != 1 {
+			return status.Unauthenticated(nil, "can't lookup user")
+		}
+		user = users[0]
+		newTokenId = t.addUserToken(user, nowts)
 
 	} else {
 		return status.InvalidArgument(nil, "no user identifier provided")
@@ -155,6 +244,24 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 		return status.Internal(err, "can't update user")
 	}
 
+	if external && link == nil {
+		userLinkId, err := j.AllocId()
+		if err != nil {
+			return status.Internal(err, "can't allocate id")
+		}
+		link = &schema.UserLink{
+			UserLinkId: userLinkId,
+			UserId:     user.UserId,
+			Provider:   t.ExternalProvider,
+			Subject:    t.ExternalSubject,
+		}
+		link.SetCreatedTime(now)
+		link.SetModifiedTime(now)
+		if err := j.InsertUserLink(link); err != nil {
+			return status.Internal(err, "can't create user link")
+		}
+	}
+
 	if err := j.Commit(); err != nil {
 		return status.Internal(err, "can' commit job")
 	}
@@ -163,12 +270,117 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 	return nil
 }
 
-func (t *AuthUserTask) compareHashAndPassword(hashed, password []byte) error {
-	if t.CompareHashAndPassword != nil {
-		return t.CompareHashAndPassword(hashed, password)
-	} else {
-		return bcrypt.CompareHashAndPassword(hashed, password)
+// findUserLink checks that su may vouch for external identities, and finds the link for the
+// external identity.  If the identity isn't linked, nil is returned, but only if there is a user to
+// link it to.
+func (t *AuthUserTask) findUserLink(ctx context.Context, j *tab.Job, su *schema.User) (
+	*schema.UserLink, status.S) {
+	if t.Ident != "" {
+		return nil, status.InvalidArgument(nil, "can't use ident with external identity")
+	}
+	if t.ExternalProvider == "" {
+		return nil, status.InvalidArgument(nil, "missing external provider")
+	} else if len(t.ExternalProvider) > maxExternalIdentityLength {
+		return nil, status.InvalidArgument(nil, "external provider too long")
+	}
+	if t.ExternalSubject == "" {
+		return nil, status.InvalidArgument(nil, "missing external subject")
+	} else if len(t.ExternalSubject) > maxExternalIdentityLength {
+		return nil, status.InvalidArgument(nil, "external subject too long")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return nil, sts
+	}
+	if sts := validateCapability(su, conf, schema.User_USER_AUTH_EXTERNAL); sts != nil {
+		return nil, sts
+	}
+	links, err := j.FindUserLinks(db.Opts{
+		Prefix: tab.UserLinksProviderSubject{
+			Provider: &t.ExternalProvider,
+			Subject:  &t.ExternalSubject,
+		},
+		Lock:  db.LockWrite,
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find user links")
+	}
+	if len(links) == 1 {
+		return links[0], nil
+	}
+	if t.UserId == 0 {
+		return nil, status.Unauthenticated(nil, "external identity not linked to a user")
+	}
+	return nil, nil
+}
+
+// addUserToken adds a new token to the user for this client, and returns its id.
+func (t *AuthUserTask) addUserToken(user *schema.User, nowts *tspb.Timestamp) int64 {
+	user.NextTokenId++
+	user.UserToken = append(user.UserToken, &schema.UserToken{
+		TokenId:    user.NextTokenId,
+		CreatedTs:  nowts,
+		LastSeenTs: nowts,
+		UserAgent:  clientInfo(t.UserAgent),
+		RemoteAddr: clientInfo(t.RemoteAddr),
+	})
+	return user.NextTokenId
+}
+
+// failLogin records a failed login for the user and address, and returns sts.  Unlike other
+// failures, the job is committed so the failure is remembered.  If the user is locked out, they
+// are told with a user event.
+func (t *AuthUserTask) failLogin(j *tab.Job, user *schema.User,
+	userFailure, addrFailure *schema.LoginFailure, now time.Time, sts status.S) status.S {
+	if t.RemoteAddr != "" {
+		subject := addrLoginFailureSubject(t.RemoteAddr)
+		if _, _, sts := recordLoginFailure(j, addrFailure, subject, now); sts != nil {
+			return sts
+		}
+	}
+	if user != nil {
+		subject := userLoginFailureSubject(user.UserId)
+		lf, locked, sts := recordLoginFailure(j, userFailure, subject, now)
+		if sts != nil {
+			return sts
+		}
+		if locked {
+			nowts := schema.ToTspb(now)
+			ue := &schema.UserEvent{
+				UserId:     user.UserId,
+				Index:      0,
+				CreatedTs:  nowts,
+				ModifiedTs: nowts,
+				Evt: &schema.UserEvent_LoginLockout_{
+					LoginLockout: &schema.UserEvent_LoginLockout{
+						RemoteAddr:    clientInfo(t.RemoteAddr),
+						LockedUntilTs: lf.LockedUntilTs,
+					},
+				},
+			}
+			if err := j.InsertUserEvent(ue); err != nil {
+				return status.Internal(err, "can't insert user event")
+			}
+		}
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return sts
+}
+
+// clientInfo makes client provided info safe to store, since it isn't validated by the caller.
+func clientInfo(s string) string {
+	s = strings.ToValidUTF8(s, string(utf8.RuneError))
+	if len(s) <= maxClientInfoLength {
+		return s
+	}
+	i := maxClientInfoLength
+	for i > 0 && !utf8.RuneStart(s[i]) {
+		i--
 	}
+	return s[:i]
 }
 
 type userTokens []*schema.UserToken
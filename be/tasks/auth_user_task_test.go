@@ -1,6 +1,7 @@
 package tasks
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 	"time"
@@ -11,6 +12,10 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/totp"
 )
 
 func TestAuthUserTaskFailsOnNoJob(t *testing.T) {
@@ -261,6 +266,52 @@ func TestAuthUserTaskCreatesNewToken(t *testing.T) {
 	}
 }
 
+func TestAuthUserTaskRehashesSecret(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	conf := schema.GetDefaultConfiguration()
+	conf.PasswordHashPolicy = &schema.Configuration_PasswordHashPolicy{
+		Algorithm:     schema.Configuration_PasswordHashPolicy_ARGON2ID,
+		Argon2Time:    1,
+		Argon2Memory:  1024,
+		Argon2Threads: 1,
+	}
+	ctx := CtxFromTestConfig(c.Ctx, conf)
+
+	task := &AuthUserTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Ident:  u.User.Ident,
+		Secret: "secret",
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if have, want := string(u.User.Secret), "$argon2id$v=19$m=1024,t=1,p=1$"; !strings.HasPrefix(have, want) {
+		t.Error("have", have, "want prefix", want)
+	}
+	rehashed := u.User.Secret
+
+	// The new hash still works, and isn't rehashed again.
+	task = &AuthUserTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Ident:  u.User.Ident,
+		Secret: "secret",
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	u.Refresh()
+	if !bytes.Equal(u.User.Secret, rehashed) {
+		t.Error("expected secret to be unchanged", string(u.User.Secret))
+	}
+}
+
 func TestAuthUserTask_PreferIdent(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -355,3 +406,444 @@ func TestAuthUserTask_LowerIdentWorks(t *testing.T) {
 		t.Error("Wrong user preferred")
 	}
 }
+
+func TestAuthUserTaskFailsOnMissingTotpCode(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.enrollTotp()
+	u.Update()
+
+	task := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    time.Now,
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		Ident:                  u.User.Ident,
+		Secret:                 "secret",
+	}
+
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing totp code"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAuthUserTaskFailsOnBadTotpCode(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.enrollTotp()
+	u.Update()
+
+	task := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    time.Now,
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		Ident:                  u.User.Ident,
+		Secret:                 "secret",
+		TotpCode:               "bogus",
+	}
+
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad totp code"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAuthUserTaskWorksWithTotpCode(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.enrollTotp()
+	u.Update()
+
+	now := time.Now()
+	task := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    func() time.Time { return now },
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		Ident:                  u.User.Ident,
+		Secret:                 "secret",
+		TotpCode:               totp.Code(testTotpSecret, totp.Step(now)),
+	}
+
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.NewTokenId == 0 {
+		t.Error("expected new token")
+	}
+	u.Refresh()
+	if have, want := u.User.Totp.LastStep, totp.Step(now); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAuthUserTaskRecordsClientInfo(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.UserToken = nil
+	u.Update()
+
+	task := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    time.Now,
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		Ident:                  u.User.Ident,
+		Secret:                 "secret",
+		UserAgent:              "browser/1.0",
+		RemoteAddr:             "192.0.2.1",
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	token := u.User.UserToken[0]
+	if token.UserAgent != "browser/1.0" || token.RemoteAddr != "192.0.2.1" {
+		t.Error("expected client info", token)
+	}
+
+	// Refreshing from elsewhere updates the info.
+	task = &AuthUserTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		UserId:     u.User.UserId,
+		TokenId:    token.TokenId,
+		RemoteAddr: "192.0.2.2",
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	token = u.User.UserToken[0]
+	if token.UserAgent != "browser/1.0" || token.RemoteAddr != "192.0.2.2" {
+		t.Error("expected client info", token)
+	}
+}
+
+func TestClientInfo(t *testing.T) {
+	if have, want := clientInfo("a\xffb"), "a�b"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	long := strings.Repeat("a", maxClientInfoLength-1) + "é"
+	if have, want := clientInfo(long), strings.Repeat("a", maxClientInfoLength-1); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAuthUserTaskLocksOutUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	now := time.Now()
+	login := func(secret string) status.S {
+		task := &AuthUserTask{
+			Beg:                    c.DB(),
+			Now:                    func() time.Time { return now },
+			CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+			Ident:                  u.User.Ident,
+			Secret:                 secret,
+			RemoteAddr:             "10.0.0.1",
+		}
+		return new(TaskRunner).Run(c.Ctx, task)
+	}
+
+	for i := 0; i < maxLoginFailures; i++ {
+		if sts := login("bogus"); sts == nil || sts.Code() != codes.Unauthenticated {
+			t.Fatal("expected failure", i, sts)
+		}
+	}
+	// Even the right secret is refused while locked.
+	sts := login("secret")
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), errLoginLockedMsg; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+
+	j := c.Job()
+	ues, err := j.FindUserEvents(db.Opts{
+		Prefix: tab.UserEventsPrimary{UserId: &u.User.UserId},
+	})
+	j.Rollback()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ues) != 1 {
+		t.Fatal("wrong number of events", ues)
+	}
+	expect := &schema.UserEvent{
+		UserId:     u.User.UserId,
+		CreatedTs:  schema.ToTspb(now),
+		ModifiedTs: schema.ToTspb(now),
+		Evt: &schema.UserEvent_LoginLockout_{
+			LoginLockout: &schema.UserEvent_LoginLockout{
+				RemoteAddr:    "10.0.0.1",
+				LockedUntilTs: schema.ToTspb(now.Add(loginLockoutBase)),
+			},
+		},
+	}
+	if !proto.Equal(ues[0], expect) {
+		t.Error("have", ues[0], "want", expect)
+	}
+
+	now = now.Add(loginLockoutBase)
+	if sts := login("secret"); sts != nil {
+		t.Fatal(sts)
+	}
+	j = c.Job()
+	lf, sts := findLoginFailure(j, userLoginFailureSubject(u.User.UserId))
+	j.Rollback()
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if lf != nil {
+		t.Error("expected user failures to be forgotten", lf)
+	}
+}
+
+func TestAuthUserTaskLockoutsGrow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	fail := func() status.S {
+		task := &AuthUserTask{
+			Beg:                    c.DB(),
+			Now:                    func() time.Time { return now },
+			CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+			Ident:                  "nobody@example.com",
+			Secret:                 "bogus",
+			RemoteAddr:             "10.0.0.2",
+		}
+		return new(TaskRunner).Run(c.Ctx, task)
+	}
+
+	for lockout := 0; lockout < 3; lockout++ {
+		for i := 0; i < maxLoginFailures; i++ {
+			if sts := fail(); sts == nil || sts.Code() != codes.Unauthenticated {
+				t.Fatal("expected failure", lockout, i, sts)
+			}
+		}
+		if sts := fail(); sts == nil || sts.Code() != codes.ResourceExhausted {
+			t.Fatal("expected lockout", lockout, sts)
+		}
+		duration := loginLockoutBase << uint(lockout)
+		now = now.Add(duration - time.Second)
+		if sts := fail(); sts == nil || sts.Code() != codes.ResourceExhausted {
+			t.Fatal("expected lockout to last", duration, sts)
+		}
+		now = now.Add(time.Second)
+	}
+
+	// Failures are forgotten after a while.
+	now = now.Add(loginFailureExpiry)
+	if sts := fail(); sts == nil || sts.Code() != codes.Unauthenticated {
+		t.Fatal("expected failure", sts)
+	}
+	j := c.Job()
+	lf, sts := findLoginFailure(j, addrLoginFailureSubject("10.0.0.2"))
+	j.Rollback()
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if lf.Failures != 1 || lf.Lockouts != 0 {
+		t.Error("expected failures to be reset", lf)
+	}
+}
+
+func (u *TestUser) CreateUserLink(provider, subject string) *schema.UserLink {
+	now := time.Now()
+	ul := &schema.UserLink{
+		UserLinkId: u.c.Id(),
+		UserId:     u.User.UserId,
+		Provider:   provider,
+		Subject:    subject,
+	}
+	ul.SetCreatedTime(now)
+	ul.SetModifiedTime(now)
+	u.c.AutoJob(func(j *tab.Job) error {
+		return j.InsertUserLink(ul)
+	})
+	return ul
+}
+
+// createExternalAuther creates a user with an api key that may vouch for external identities.
+func createExternalAuther(c *TestContainer) *TestUser {
+	fe := c.CreateUser()
+	fe.User.Capability = append(fe.User.Capability, schema.User_USER_AUTH_EXTERNAL)
+	fe.Update()
+	fe.CreateApiKey("key", schema.User_USER_AUTH_EXTERNAL)
+	return fe
+}
+
+func TestAuthUserTaskExternalCreatesNewToken(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	createExternalAuther(c)
+	u := c.CreateUser()
+	u.CreateUserLink("https://idp", "alice")
+
+	task := &AuthUserTask{
+		Beg:              c.DB(),
+		Now:              time.Now,
+		ExternalProvider: "https://idp",
+		ExternalSubject:  "alice",
+	}
+
+	if sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task); sts != nil {
+		t.Fatal(sts)
+	}
+	u.Refresh()
+	if task.User.UserId != u.User.UserId || task.NewTokenId != u.User.NextTokenId {
+		t.Error("wrong task results", task.User.UserId, task.NewTokenId)
+	}
+	if len(u.User.UserToken) != 2 {
+		t.Error("expected new token", u.User.UserToken)
+	}
+}
+
+func TestAuthUserTaskExternalFailsOnMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	fe := c.CreateUser()
+	fe.CreateApiKey("key", schema.User_PIC_READ)
+	u := c.CreateUser()
+	u.CreateUserLink("https://idp", "alice")
+
+	task := &AuthUserTask{
+		Beg:              c.DB(),
+		Now:              time.Now,
+		ExternalProvider: "https://idp",
+		ExternalSubject:  "alice",
+	}
+
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.PermissionDenied(nil, "missing cap USER_AUTH_EXTERNAL")
+	compareStatus(t, sts, expected)
+}
+
+func TestAuthUserTaskExternalFailsOnUnlinked(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	createExternalAuther(c)
+
+	task := &AuthUserTask{
+		Beg:              c.DB(),
+		Now:              time.Now,
+		ExternalProvider: "https://idp",
+		ExternalSubject:  "alice",
+	}
+
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.Unauthenticated(nil, "external identity not linked")
+	compareStatus(t, sts, expected)
+}
+
+func TestAuthUserTaskExternalFailsOnIdent(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	createExternalAuther(c)
+	u := c.CreateUser()
+
+	task := &AuthUserTask{
+		Beg:              c.DB(),
+		Now:              time.Now,
+		Ident:            u.User.Ident,
+		Secret:           "secret",
+		ExternalProvider: "https://idp",
+		ExternalSubject:  "alice",
+	}
+
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.InvalidArgument(nil, "can't use ident with external identity")
+	compareStatus(t, sts, expected)
+}
+
+func TestAuthUserTaskExternalLinksUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	createExternalAuther(c)
+	u := c.CreateUser()
+
+	task := &AuthUserTask{
+		Beg:              c.DB(),
+		Now:              time.Now,
+		UserId:           u.User.UserId,
+		TokenId:          1,
+		ExternalProvider: "https://idp",
+		ExternalSubject:  "alice",
+	}
+
+	if sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.User.UserId != u.User.UserId || task.NewTokenId != 1 {
+		t.Error("wrong task results", task.User.UserId, task.NewTokenId)
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	provider, subject := "https://idp", "alice"
+	links, err := j.FindUserLinks(db.Opts{
+		Prefix: tab.UserLinksProviderSubject{Provider: &provider, Subject: &subject},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(links) != 1 || links[0].UserId != u.User.UserId {
+		t.Error("expected link", links)
+	}
+}
+
+func TestAuthUserTaskExternalFailsOnOtherUsersLink(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	createExternalAuther(c)
+	u := c.CreateUser()
+	u2 := c.CreateUser()
+	u2.CreateUserLink("https://idp", "alice")
+
+	task := &AuthUserTask{
+		Beg:              c.DB(),
+		Now:              time.Now,
+		UserId:           u.User.UserId,
+		TokenId:          1,
+		ExternalProvider: "https://idp",
+		ExternalSubject:  "alice",
+	}
+
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.PermissionDenied(nil, "external identity linked to another user")
+	compareStatus(t, sts, expected)
+}
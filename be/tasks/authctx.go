@@ -13,6 +13,8 @@ type UserToken struct {
 
 type authTokenKey struct{}
 
+type apiKeyKey struct{}
+
 func CtxFromUserToken(ctx context.Context, userId, tokenId int64) context.Context {
 	return context.WithValue(ctx, userTokenKey{}, &UserToken{
 		UserId:  userId,
@@ -33,3 +35,14 @@ func AuthTokenFromCtx(ctx context.Context) (token string, ok bool) {
 	token, ok = ctx.Value(authTokenKey{}).(string)
 	return
 }
+
+// CtxFromApiKey adds an api key to the context.  Tasks authenticate as the user of the key, limited
+// to the capabilities of the key.
+func CtxFromApiKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, apiKeyKey{}, key)
+}
+
+func ApiKeyFromCtx(ctx context.Context) (key string, ok bool) {
+	key, ok = ctx.Value(apiKeyKey{}).(string)
+	return
+}
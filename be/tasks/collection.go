@@ -0,0 +1,102 @@
+package tasks
+
+import (
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+func validateCollectionName(name string, conf *schema.Configuration) (string, status.S) {
+	maxNameLen := int64(math.MaxInt64)
+	if conf.MaxCollectionNameLength != nil {
+		maxNameLen = conf.MaxCollectionNameLength.Value
+	}
+	normal, err := text.DefaultValidateNoNewlineAndNormalize(name, "collection name", 1, maxNameLen)
+	if err != nil {
+		return "", status.From(err)
+	}
+	return normal, nil
+}
+
+// validateCollectionVisibility checks vis is known.  Unset visibility is treated as private.
+func validateCollectionVisibility(vis schema.Collection_Visibility) (
+	schema.Collection_Visibility, status.S) {
+	switch vis {
+	case schema.Collection_UNKNOWN:
+		return schema.Collection_PRIVATE, nil
+	case schema.Collection_PRIVATE, schema.Collection_PUBLIC:
+		return vis, nil
+	default:
+		return schema.Collection_UNKNOWN, status.InvalidArgument(nil, "bad visibility", vis)
+	}
+}
+
+// canReadCollection returns true if su may see c.  The caller must check if su can read pics.
+func canReadCollection(c *schema.Collection, su *schema.User) bool {
+	return c.Visibility == schema.Collection_PUBLIC || (su != nil && su.UserId == c.UserId)
+}
+
+// lookupCollection finds a collection that su can see.  Private collections of other users are
+// reported as missing.
+func lookupCollection(j *tab.Job, collectionId int64, su *schema.User, lock db.Lock) (
+	*schema.Collection, status.S) {
+	cs, err := j.FindCollections(db.Opts{
+		Prefix: tab.CollectionsPrimary{&collectionId},
+		Lock:   lock,
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find collections")
+	}
+	if len(cs) != 1 || !canReadCollection(cs[0], su) {
+		return nil, status.NotFound(nil, "can't find collection")
+	}
+	return cs[0], nil
+}
+
+// lookupCollectionForUpdate finds and locks a collection owned by su.
+func lookupCollectionForUpdate(
+	j *tab.Job, collectionId int64, su *schema.User, conf *schema.Configuration) (
+	*schema.Collection, status.S) {
+	if sts := validateCapability(su, conf, schema.User_COLLECTION_CREATE); sts != nil {
+		return nil, sts
+	}
+	if su == nil {
+		return nil, status.Unauthenticated(nil, "missing user")
+	}
+	c, sts := lookupCollection(j, collectionId, su, db.LockWrite)
+	if sts != nil {
+		return nil, sts
+	}
+	if c.UserId != su.UserId {
+		return nil, status.PermissionDenied(nil, "can't change collection of other user")
+	}
+	return c, nil
+}
+
+// findCollectionPics finds the pics in a collection, in order.
+func findCollectionPics(j *tab.Job, collectionId int64, lock db.Lock) (
+	[]*schema.CollectionPic, status.S) {
+	cps, err := j.FindCollectionPics(db.Opts{
+		Prefix: tab.CollectionPicsPosition{CollectionId: &collectionId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find collection pics")
+	}
+	return cps, nil
+}
+
+// touchCollection marks the collection as modified, such as when its pics change.
+func touchCollection(j *tab.Job, c *schema.Collection, now time.Time) status.S {
+	c.SetModifiedTime(now)
+	if err := j.UpdateCollection(c); err != nil {
+		return status.Internal(err, "can't update collection")
+	}
+	return nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
 	"pixur.org/pixur/be/status"
 )
 
@@ -29,8 +31,11 @@ var (
 	_configLoading     = make(chan struct{})
 )
 
+// LoadConfigurationTask sets the site configuration.  If it differs from the last loaded
+// configuration, the change is recorded in the audit log.
 type LoadConfigurationTask struct {
-	Beg db.Beginner
+	Beg tab.JobBeginner
+	Now func() time.Time
 
 	// If non-nil, this will override any other configuration that might be used.
 	Config *schema.Configuration
@@ -41,10 +46,14 @@ func (t *LoadConfigurationTask) Run(ctx context.Context) (stscap status.S) {
 		panic("nil Beginner")
 	}
 
-	_configLoadLock.Lock()
-	old := _siteConfiguration.Load()
 	newconf := schema.GetDefaultConfiguration()
 	schema.MergeConfiguration(newconf, t.Config)
+	if sts := auditConfiguration(ctx, t.Beg, newconf, t.Now()); sts != nil {
+		return sts
+	}
+
+	_configLoadLock.Lock()
+	old := _siteConfiguration.Load()
 	_siteConfiguration.Store(newconf)
 	_configLoadLock.Unlock()
 	if old == nil {
@@ -68,3 +77,48 @@ func GetConfiguration(ctx context.Context) (*schema.Configuration, status.S) {
 		}
 	}
 }
+
+// auditConfiguration records conf in the audit log, if it differs from the configuration of the
+// last UPDATE_CONFIGURATION entry.  If there is no entry, the default configuration is assumed.
+func auditConfiguration(
+	ctx context.Context, beg tab.JobBeginner, conf *schema.Configuration, now time.Time) (
+	stscap status.S) {
+	j, err := tab.NewJob(ctx, beg)
+	if err != nil {
+		return status.Internal(err, "can't create job")
+	}
+	defer revert(j, &stscap)
+
+	action := schema.AuditLog_UPDATE_CONFIGURATION
+	als, err := j.FindAuditLogs(db.Opts{
+		Prefix:  tab.AuditLogsAction{Action: &action},
+		Reverse: true,
+		Lock:    db.LockWrite,
+		Limit:   1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find audit logs")
+	}
+	prev := schema.GetDefaultConfiguration()
+	if len(als) == 1 && als[0].Configuration != nil {
+		prev = als[0].Configuration
+	}
+	if proto.Equal(prev, conf) {
+		if err := j.Rollback(); err != nil {
+			return status.Internal(err, "can't rollback job")
+		}
+		return nil
+	}
+	al := &schema.AuditLog{
+		Action:        action,
+		Reason:        "configuration loaded",
+		Configuration: conf,
+	}
+	if sts := insertAuditLog(j, al, nil, prev, conf, now); sts != nil {
+		return sts
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestAuditConfiguration(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	conf := schema.GetDefaultConfiguration()
+	if sts := auditConfiguration(c.Ctx, c.DB(), conf, time.Now()); sts != nil {
+		t.Fatal(sts)
+	}
+	if als := c.AuditLogs(); len(als) != 0 {
+		t.Fatal("expected no audit log for default configuration", als)
+	}
+
+	conf.MaxFindAuditLogs = &wpb.Int64Value{Value: 7}
+	if sts := auditConfiguration(c.Ctx, c.DB(), conf, time.Now()); sts != nil {
+		t.Fatal(sts)
+	}
+	als := c.AuditLogs()
+	if len(als) != 1 || als[0].Action != schema.AuditLog_UPDATE_CONFIGURATION ||
+		!proto.Equal(als[0].Configuration, conf) || len(als[0].Change) != 1 ||
+		als[0].Change[0].Field != "max_find_audit_logs" {
+		t.Fatal("expected audit log", als)
+	}
+
+	// Loading the same configuration again shouldn't add an entry.
+	if sts := auditConfiguration(c.Ctx, c.DB(), conf, time.Now()); sts != nil {
+		t.Fatal(sts)
+	}
+	if als := c.AuditLogs(); len(als) != 1 {
+		t.Error("expected one audit log", als)
+	}
+}
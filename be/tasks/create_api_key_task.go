@@ -0,0 +1,111 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+const (
+	maxApiKeyNameLength = 64
+)
+
+var _ Task = &CreateApiKeyTask{}
+
+// CreateApiKeyTask creates a long lived key for the subject user, which may be used in place of an
+// auth token.  The key only has the requested capabilities, which the user must also have.  Api keys
+// can't be used to create more api keys.
+type CreateApiKeyTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+	// Rand is the source of keys.  If nil, crypto/rand is used.
+	Rand io.Reader
+
+	// Inputs
+	Name string
+	// Expiry is how long the key may be used for.  If zero, the key doesn't expire.
+	Expiry     time.Duration
+	Capability []schema.User_Capability
+
+	// Results
+	CreatedApiKey *schema.ApiKey
+	// Key is the secret key to give to the bot or script.  Only its hash is stored.
+	Key string
+}
+
+func (t *CreateApiKeyTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if _, keyPresent := ApiKeyFromCtx(ctx); keyPresent {
+		return status.PermissionDenied(nil, "can't create api key with api key")
+	}
+	name, err := text.DefaultValidateNoNewlineAndNormalize(t.Name, "name", 1, maxApiKeyNameLength)
+	if err != nil {
+		return status.From(err)
+	}
+	if t.Expiry < 0 {
+		return status.InvalidArgument(nil, "bad expiry")
+	}
+	if len(t.Capability) == 0 {
+		return status.InvalidArgument(nil, "missing capability")
+	}
+	for _, c := range t.Capability {
+		if _, ok := schema.User_Capability_name[int32(c)]; !ok || c == schema.User_UNKNOWN {
+			return status.InvalidArgument(nil, "unknown cap", c)
+		}
+	}
+	// Keys can't do more than the user.
+	if sts := validateCapability(su, conf, t.Capability...); sts != nil {
+		return sts
+	}
+
+	key, sts := newSecretToken(t.Rand)
+	if sts != nil {
+		return sts
+	}
+	apiKeyId, err := j.AllocId()
+	if err != nil {
+		return status.Internal(err, "can't allocate id")
+	}
+	ak := &schema.ApiKey{
+		ApiKeyId:   apiKeyId,
+		KeyHash:    secretTokenHash(key),
+		UserId:     su.UserId,
+		Name:       name,
+		Capability: schema.CapSetOf(t.Capability...).Slice(),
+	}
+	ak.SetCreatedTime(now)
+	ak.SetModifiedTime(now)
+	if t.Expiry != 0 {
+		ak.ExpireTs = schema.ToTspb(now.Add(t.Expiry))
+	}
+
+	if err := j.InsertApiKey(ak); err != nil {
+		return status.Internal(err, "can't create api key")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	t.CreatedApiKey = ak
+	t.Key = key
+	return nil
+}
@@ -0,0 +1,133 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func (u *TestUser) CreateApiKey(key string, caps ...schema.User_Capability) *schema.ApiKey {
+	now := time.Now()
+	ak := &schema.ApiKey{
+		ApiKeyId:   u.c.Id(),
+		KeyHash:    secretTokenHash(key),
+		UserId:     u.User.UserId,
+		Name:       "bot",
+		Capability: caps,
+	}
+	ak.SetCreatedTime(now)
+	ak.SetModifiedTime(now)
+	u.c.AutoJob(func(j *tab.Job) error {
+		return j.InsertApiKey(ak)
+	})
+	return ak
+}
+
+func TestCreateApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE, schema.User_PIC_READ)
+	u.Update()
+
+	now := time.Now()
+	task := &CreateApiKeyTask{
+		Beg:        c.DB(),
+		Now:        func() time.Time { return now },
+		Rand:       bytes.NewReader(make([]byte, secretTokenSize)),
+		Name:       "upload bot",
+		Expiry:     time.Hour,
+		Capability: []schema.User_Capability{schema.User_PIC_CREATE},
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if task.Key == "" {
+		t.Error("missing key")
+	}
+	ak := task.CreatedApiKey
+	if !bytes.Equal(ak.KeyHash, secretTokenHash(task.Key)) {
+		t.Error("bad key hash", ak.KeyHash)
+	}
+	if ak.UserId != u.User.UserId || ak.Name != "upload bot" {
+		t.Error("bad api key", ak)
+	}
+	if len(ak.Capability) != 1 || ak.Capability[0] != schema.User_PIC_CREATE {
+		t.Error("bad caps", ak.Capability)
+	}
+	if have, want := schema.ToTime(ak.ExpireTs), now.Add(time.Hour); !have.Equal(want) {
+		t.Error("have", have, "want", want)
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	aks, err := j.FindApiKeys(db.Opts{
+		Prefix: tab.ApiKeysPrimary{&ak.ApiKeyId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aks) != 1 {
+		t.Error("expected stored api key", aks)
+	}
+}
+
+func TestCreateApiKey_CantGrantMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &CreateApiKeyTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Name:       "bot",
+		Capability: []schema.User_Capability{schema.User_PIC_CREATE},
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.PermissionDenied(nil, "missing cap PIC_CREATE")
+	compareStatus(t, sts, expected)
+}
+
+func TestCreateApiKey_MissingCapability(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &CreateApiKeyTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Name: "bot",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.InvalidArgument(nil, "missing capability")
+	compareStatus(t, sts, expected)
+}
+
+func TestCreateApiKey_CantUseApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	u.CreateApiKey("key", schema.User_PIC_CREATE)
+
+	task := &CreateApiKeyTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Name:       "bot",
+		Capability: []schema.User_Capability{schema.User_PIC_CREATE},
+	}
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.PermissionDenied(nil, "can't create api key with api key")
+	compareStatus(t, sts, expected)
+}
@@ -0,0 +1,103 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &CreateCollectionTask{}
+
+// CreateCollectionTask creates an empty collection owned by the subject user.
+type CreateCollectionTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	Name string
+	// Visibility is who can see the collection.  If unset, the collection is private.
+	Visibility schema.Collection_Visibility
+
+	// Results
+	Collection *schema.Collection
+}
+
+func (t *CreateCollectionTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_COLLECTION_CREATE); sts != nil {
+		return sts
+	}
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	name, sts := validateCollectionName(t.Name, conf)
+	if sts != nil {
+		return sts
+	}
+	vis, sts := validateCollectionVisibility(t.Visibility)
+	if sts != nil {
+		return sts
+	}
+
+	// Lock the user, so concurrent creates can't go over the max.
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&su.UserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "can't lookup user")
+	}
+	if conf.MaxCollections != nil {
+		cs, err := j.FindCollections(db.Opts{
+			Prefix: tab.CollectionsUserId{UserId: &su.UserId},
+			Lock:   db.LockNone,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find collections")
+		}
+		if int64(len(cs)) >= conf.MaxCollections.Value {
+			return status.ResourceExhausted(nil, "too many collections")
+		}
+	}
+
+	id, err := j.AllocId()
+	if err != nil {
+		return status.Internal(err, "can't allocate id")
+	}
+	c := &schema.Collection{
+		CollectionId: id,
+		UserId:       su.UserId,
+		Name:         name,
+		Visibility:   vis,
+	}
+	c.SetCreatedTime(now)
+	c.SetModifiedTime(now)
+	if err := j.InsertCollection(c); err != nil {
+		return status.Internal(err, "can't insert collection")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Collection = c
+	return nil
+}
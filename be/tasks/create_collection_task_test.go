@@ -0,0 +1,164 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+func (u *TestUser) CreateCollection(name string, vis schema.Collection_Visibility) *schema.Collection {
+	now := time.Now()
+	col := &schema.Collection{
+		CollectionId: u.c.Id(),
+		UserId:       u.User.UserId,
+		Name:         name,
+		Visibility:   vis,
+	}
+	col.SetCreatedTime(now)
+	col.SetModifiedTime(now)
+	u.c.AutoJob(func(j *tab.Job) error {
+		return j.InsertCollection(col)
+	})
+	return col
+}
+
+func (c *TestContainer) AddCollectionPic(col *schema.Collection, p *TestPic, position int64) {
+	now := time.Now()
+	cp := &schema.CollectionPic{
+		CollectionId: col.CollectionId,
+		PicId:        p.Pic.PicId,
+		Position:     position,
+	}
+	cp.SetCreatedTime(now)
+	cp.SetModifiedTime(now)
+	c.AutoJob(func(j *tab.Job) error {
+		return j.InsertCollectionPic(cp)
+	})
+}
+
+func (c *TestContainer) CollectionPicIds(col *schema.Collection) []int64 {
+	j := c.Job()
+	defer j.Rollback()
+	cps, sts := findCollectionPics(j, col.CollectionId, db.LockNone)
+	if sts != nil {
+		c.T.Fatal(sts)
+	}
+	var ids []int64
+	for _, cp := range cps {
+		ids = append(ids, cp.PicId)
+	}
+	return ids
+}
+
+func TestCreateCollection(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+
+	task := &CreateCollectionTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Name: "Cats",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	col := task.Collection
+	if col.Name != "Cats" || col.UserId != u.User.UserId || col.Visibility != schema.Collection_PRIVATE {
+		t.Error("wrong collection", col)
+	}
+	j := c.Job()
+	defer j.Rollback()
+	cols, err := j.FindCollections(db.Opts{
+		Prefix: tab.CollectionsPrimary{&col.CollectionId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 1 {
+		t.Error("expected collection", cols)
+	}
+}
+
+func TestCreateCollection_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &CreateCollectionTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Name: "Cats",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing cap"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateCollection_BadName(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+
+	task := &CreateCollectionTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Name: strings.Repeat("a", 65),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateCollection_TooMany(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	u.CreateCollection("first", schema.Collection_PRIVATE)
+
+	conf := schema.GetDefaultConfiguration()
+	conf.MaxCollections = &wpb.Int64Value{Value: 1}
+	ctx := CtxFromTestConfig(u.AuthedCtx(c.Ctx), conf)
+
+	task := &CreateCollectionTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Name: "second",
+	}
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
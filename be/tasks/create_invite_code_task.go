@@ -0,0 +1,116 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &CreateInviteCodeTask{}
+
+// CreateInviteCodeTask creates a code that lets new users be created, even if the anonymous user
+// doesn't have the USER_CREATE capability.  Users created with the code record who invited them.
+type CreateInviteCodeTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+	// Rand is the source of codes.  If nil, crypto/rand is used.
+	Rand io.Reader
+
+	// Inputs
+	// Expiry is how long the code may be used for.  If zero, the code doesn't expire.
+	Expiry time.Duration
+	// MaxUses is how many users may be created with the code.
+	MaxUses int64
+	// Capability is granted to users created with the code.  The subject user must have each one.
+	Capability []schema.User_Capability
+
+	// Results
+	CreatedInviteCode *schema.InviteCode
+	// Code is the secret code to give to the invited user.  Only its hash is stored.
+	Code string
+}
+
+func (t *CreateInviteCodeTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if sts := validateCapability(su, conf, schema.User_USER_INVITE_CREATE); sts != nil {
+		return sts
+	}
+	if t.MaxUses <= 0 {
+		return status.InvalidArgument(nil, "bad max uses")
+	}
+	if t.Expiry < 0 {
+		return status.InvalidArgument(nil, "bad expiry")
+	}
+	for _, c := range t.Capability {
+		if _, ok := schema.User_Capability_name[int32(c)]; !ok || c == schema.User_UNKNOWN {
+			return status.InvalidArgument(nil, "unknown cap", c)
+		}
+	}
+	// Users can't grant more than they have.
+	if sts := validateCapability(su, conf, t.Capability...); sts != nil {
+		return sts
+	}
+
+	code, sts := newSecretToken(t.Rand)
+	if sts != nil {
+		return sts
+	}
+	inviteCodeId, err := j.AllocId()
+	if err != nil {
+		return status.Internal(err, "can't allocate id")
+	}
+	ic := &schema.InviteCode{
+		InviteCodeId:  inviteCodeId,
+		CodeHash:      secretTokenHash(code),
+		CreatorUserId: su.UserId,
+		MaxUses:       t.MaxUses,
+		Capability:    t.Capability,
+	}
+	ic.SetCreatedTime(now)
+	ic.SetModifiedTime(now)
+	if t.Expiry != 0 {
+		ic.ExpireTs = schema.ToTspb(now.Add(t.Expiry))
+	}
+
+	if err := j.InsertInviteCode(ic); err != nil {
+		return status.Internal(err, "can't create invite code")
+	}
+	// The code hash is left out, since the log is readable by more users than the code is.
+	al := &schema.AuditLog{
+		Action: schema.AuditLog_CREATE_INVITE_CODE,
+	}
+	logged := &schema.InviteCode{
+		InviteCodeId: ic.InviteCodeId,
+		MaxUses:      ic.MaxUses,
+		Capability:   ic.Capability,
+		ExpireTs:     ic.ExpireTs,
+	}
+	if sts := insertAuditLog(j, al, su, nil, logged, now); sts != nil {
+		return sts
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	t.CreatedInviteCode = ic
+	t.Code = code
+	return nil
+}
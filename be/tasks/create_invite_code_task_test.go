@@ -0,0 +1,139 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func (c *TestContainer) CreateInviteCode(creator *TestUser, code string, maxUses int64) *schema.InviteCode {
+	now := time.Now()
+	ic := &schema.InviteCode{
+		InviteCodeId:  c.Id(),
+		CodeHash:      secretTokenHash(code),
+		CreatorUserId: creator.User.UserId,
+		MaxUses:       maxUses,
+	}
+	ic.SetCreatedTime(now)
+	ic.SetModifiedTime(now)
+	c.AutoJob(func(j *tab.Job) error {
+		return j.InsertInviteCode(ic)
+	})
+	return ic
+}
+
+func TestCreateInviteCode(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_INVITE_CREATE, schema.User_PIC_CREATE)
+	u.Update()
+
+	now := time.Now()
+	task := &CreateInviteCodeTask{
+		Beg:        c.DB(),
+		Now:        func() time.Time { return now },
+		Rand:       bytes.NewReader(make([]byte, secretTokenSize)),
+		Expiry:     time.Hour,
+		MaxUses:    2,
+		Capability: []schema.User_Capability{schema.User_PIC_CREATE},
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if task.Code == "" {
+		t.Error("missing code")
+	}
+	ic := task.CreatedInviteCode
+	if !bytes.Equal(ic.CodeHash, secretTokenHash(task.Code)) {
+		t.Error("bad code hash", ic.CodeHash)
+	}
+	if ic.CreatorUserId != u.User.UserId || ic.MaxUses != 2 || ic.Uses != 0 {
+		t.Error("bad invite code", ic)
+	}
+	if have, want := schema.ToTime(ic.ExpireTs), now.Add(time.Hour); !have.Equal(want) {
+		t.Error("have", have, "want", want)
+	}
+
+	als := c.AuditLogs()
+	if len(als) != 1 || als[0].Action != schema.AuditLog_CREATE_INVITE_CODE ||
+		als[0].ActorUserId != u.User.UserId {
+		t.Fatal("expected audit log", als)
+	}
+	for _, ch := range als[0].Change {
+		if ch.Field == "code_hash" {
+			t.Error("code hash should not be logged", ch)
+		}
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	ics, err := j.FindInviteCodes(db.Opts{
+		Prefix: tab.InviteCodesPrimary{&ic.InviteCodeId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ics) != 1 {
+		t.Error("expected stored invite code", ics)
+	}
+}
+
+func TestCreateInviteCode_CantGrantMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_INVITE_CREATE)
+	u.Update()
+
+	task := &CreateInviteCodeTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		MaxUses:    1,
+		Capability: []schema.User_Capability{schema.User_USER_UPDATE_CAPABILITY},
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.PermissionDenied(nil, "missing cap USER_UPDATE_CAPABILITY")
+	compareStatus(t, sts, expected)
+}
+
+func TestCreateInviteCode_BadMaxUses(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_INVITE_CREATE)
+	u.Update()
+
+	task := &CreateInviteCodeTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.InvalidArgument(nil, "bad max uses")
+	compareStatus(t, sts, expected)
+}
+
+func TestCreateInviteCode_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &CreateInviteCodeTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		MaxUses: 1,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.PermissionDenied(nil, "missing cap USER_INVITE_CREATE")
+	compareStatus(t, sts, expected)
+}
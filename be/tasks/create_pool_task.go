@@ -0,0 +1,72 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &CreatePoolTask{}
+
+// CreatePoolTask creates an empty pool.
+type CreatePoolTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	Title       string
+	Description string
+
+	// Results
+	Pool *schema.Pool
+}
+
+func (t *CreatePoolTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_POOL_EDIT); sts != nil {
+		return sts
+	}
+	title, sts := validatePoolTitle(t.Title, conf)
+	if sts != nil {
+		return sts
+	}
+	description, sts := validatePoolDescription(t.Description, conf)
+	if sts != nil {
+		return sts
+	}
+
+	id, err := j.AllocId()
+	if err != nil {
+		return status.Internal(err, "can't allocate id")
+	}
+	p := &schema.Pool{
+		PoolId:      id,
+		Title:       title,
+		Description: description,
+	}
+	p.SetCreatedTime(now)
+	p.SetModifiedTime(now)
+	if err := j.InsertPool(p); err != nil {
+		return status.Internal(err, "can't insert pool")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Pool = p
+	return nil
+}
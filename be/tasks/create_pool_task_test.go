@@ -0,0 +1,129 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+func (c *TestContainer) CreatePool(title string) *schema.Pool {
+	now := time.Now()
+	p := &schema.Pool{
+		PoolId: c.Id(),
+		Title:  title,
+	}
+	p.SetCreatedTime(now)
+	p.SetModifiedTime(now)
+	c.AutoJob(func(j *tab.Job) error {
+		return j.InsertPool(p)
+	})
+	return p
+}
+
+func (c *TestContainer) AddPoolPic(p *schema.Pool, tp *TestPic, position int64) {
+	now := time.Now()
+	pp := &schema.PoolPic{
+		PoolId:   p.PoolId,
+		PicId:    tp.Pic.PicId,
+		Position: position,
+	}
+	pp.SetCreatedTime(now)
+	pp.SetModifiedTime(now)
+	c.AutoJob(func(j *tab.Job) error {
+		return j.InsertPoolPic(pp)
+	})
+}
+
+func (c *TestContainer) PoolPicIds(p *schema.Pool) []int64 {
+	j := c.Job()
+	defer j.Rollback()
+	pps, sts := findPoolPics(j, p.PoolId, db.LockNone)
+	if sts != nil {
+		c.T.Fatal(sts)
+	}
+	var ids []int64
+	for _, pp := range pps {
+		ids = append(ids, pp.PicId)
+	}
+	return ids
+}
+
+func TestCreatePool(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_POOL_EDIT)
+	u.Update()
+
+	task := &CreatePoolTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		Title:       "Chapter 1",
+		Description: "The beginning.\nOf the story.",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	p := task.Pool
+	if p.Title != "Chapter 1" || p.Description != "The beginning.\nOf the story." {
+		t.Error("wrong pool", p)
+	}
+	j := c.Job()
+	defer j.Rollback()
+	if _, sts := lookupPool(j, p.PoolId, db.LockNone); sts != nil {
+		t.Error(sts)
+	}
+}
+
+func TestCreatePool_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &CreatePoolTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Title: "Chapter 1",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing cap"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreatePool_BadTitle(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_POOL_EDIT)
+	u.Update()
+
+	task := &CreatePoolTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Title: "Chapter\n1",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	any "github.com/golang/protobuf/ptypes/any"
-	"golang.org/x/crypto/bcrypt"
 
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
@@ -30,6 +29,8 @@ type CreateUserTask struct {
 	Secret string
 	// Special input that overrides the defaults.  Used for site bootstrapping.
 	Capability []schema.User_Capability
+	// InviteCode is optional.  If present, it is used instead of the USER_CREATE capability.
+	InviteCode string
 
 	// Ext is additional extra data associated with this user.
 	Ext map[string]*any.Any
@@ -51,7 +52,12 @@ func (t *CreateUserTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(su, conf, schema.User_USER_CREATE); sts != nil {
+	var ic *schema.InviteCode
+	if t.InviteCode != "" {
+		if ic, sts = lookupInviteCode(j, t.InviteCode, now); sts != nil {
+			return sts
+		}
+	} else if sts := validateCapability(su, conf, schema.User_USER_CREATE); sts != nil {
 		return sts
 	}
 
@@ -97,7 +103,7 @@ func (t *CreateUserTask) Run(ctx context.Context) (stscap status.S) {
 	}
 
 	// TODO: rate limit this.
-	hashed, err := t.hashPassword([]byte(t.Secret))
+	hashed, err := hashPassword(t.HashPassword, conf, []byte(t.Secret))
 	if err != nil {
 		return status.Internal(err, "can't generate password")
 	}
@@ -107,6 +113,8 @@ func (t *CreateUserTask) Run(ctx context.Context) (stscap status.S) {
 	if t.Capability != nil {
 		// TODO: check there are no UNKNOWN capabilities
 		newcap = t.Capability
+	} else if ic != nil && len(ic.Capability) != 0 {
+		newcap = ic.Capability
 	} else {
 		newcap = conf.NewUserCapability.Capability
 	}
@@ -121,10 +129,23 @@ func (t *CreateUserTask) Run(ctx context.Context) (stscap status.S) {
 	}
 	user.SetCreatedTime(now)
 	user.SetModifiedTime(now)
+	if ic != nil {
+		user.Invitation = &schema.User_Invitation{
+			InviterUserId: ic.CreatorUserId,
+			InviteCodeId:  ic.InviteCodeId,
+		}
+	}
 
 	if err := j.InsertUser(user); err != nil {
 		return status.Internal(err, "can't create user")
 	}
+	if ic != nil {
+		ic.Uses++
+		ic.SetModifiedTime(now)
+		if err := j.UpdateInviteCode(ic); err != nil {
+			return status.Internal(err, "can't update invite code")
+		}
+	}
 
 	if err := j.Commit(); err != nil {
 		return status.Internal(err, "can't commit job")
@@ -135,10 +156,26 @@ func (t *CreateUserTask) Run(ctx context.Context) (stscap status.S) {
 	return nil
 }
 
-func (t *CreateUserTask) hashPassword(password []byte) ([]byte, error) {
-	if t.HashPassword != nil {
-		return t.HashPassword(password)
-	} else {
-		return bcrypt.GenerateFromPassword(password, bcrypt.DefaultCost)
+// lookupInviteCode finds a usable invite code, locked for update.
+func lookupInviteCode(j *tab.Job, code string, now time.Time) (*schema.InviteCode, status.S) {
+	codeHash := secretTokenHash(code)
+	ics, err := j.FindInviteCodes(db.Opts{
+		Prefix: tab.InviteCodesCodeHash{&codeHash},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find invite codes")
+	}
+	if len(ics) != 1 {
+		return nil, status.PermissionDenied(nil, "bad invite code")
+	}
+	ic := ics[0]
+	if ic.Expired(now) {
+		return nil, status.PermissionDenied(nil, "invite code expired")
+	}
+	if ic.Uses >= ic.MaxUses {
+		return nil, status.PermissionDenied(nil, "invite code used up")
 	}
+	return ic, nil
 }
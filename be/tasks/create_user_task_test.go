@@ -12,6 +12,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
 	"pixur.org/pixur/be/status"
 )
 
@@ -314,3 +315,94 @@ func TestCreateUserCantBegin(t *testing.T) {
 	expected := status.Internal(nil, "can't create job")
 	compareStatus(t, sts, expected)
 }
+
+func TestCreateUserInviteCode(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	inviter := c.CreateUser()
+	ic := c.CreateInviteCode(inviter, "code", 1)
+	ic.Capability = []schema.User_Capability{schema.User_PIC_CREATE}
+	c.AutoJob(func(j *tab.Job) error {
+		return j.UpdateInviteCode(ic)
+	})
+
+	conf, sts := GetConfiguration(c.Ctx)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	// The anonymous user can't normally create users.
+	conf.AnonymousCapability.Capability = nil
+	ctx := CtxFromTestConfig(c.Ctx, conf)
+
+	hashPassword := func(pw []byte) ([]byte, error) {
+		return bcrypt.GenerateFromPassword(pw, bcrypt.MinCost)
+	}
+	task := &CreateUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		HashPassword: hashPassword,
+		Ident:        "email",
+		Secret:       "secret",
+		InviteCode:   "code",
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	expectedInvitation := &schema.User_Invitation{
+		InviterUserId: inviter.User.UserId,
+		InviteCodeId:  ic.InviteCodeId,
+	}
+	if !proto.Equal(task.CreatedUser.Invitation, expectedInvitation) {
+		t.Error("have", task.CreatedUser.Invitation, "want", expectedInvitation)
+	}
+	if len(task.CreatedUser.Capability) != 1 ||
+		task.CreatedUser.Capability[0] != schema.User_PIC_CREATE {
+		t.Error("bad caps", task.CreatedUser.Capability)
+	}
+
+	// The code has been used up.
+	task.Ident = "email2"
+	sts = new(TaskRunner).Run(ctx, task)
+	expected := status.PermissionDenied(nil, "invite code used up")
+	compareStatus(t, sts, expected)
+}
+
+func TestCreateUserInviteCodeExpired(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	ic := c.CreateInviteCode(c.CreateUser(), "code", 1)
+	ic.ExpireTs = schema.ToTspb(time.Now().Add(-time.Second))
+	c.AutoJob(func(j *tab.Job) error {
+		return j.UpdateInviteCode(ic)
+	})
+
+	task := &CreateUserTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Ident:      "email",
+		Secret:     "secret",
+		InviteCode: "code",
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.PermissionDenied(nil, "invite code expired")
+	compareStatus(t, sts, expected)
+}
+
+func TestCreateUserInviteCodeBad(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &CreateUserTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Ident:      "email",
+		Secret:     "secret",
+		InviteCode: "code",
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.PermissionDenied(nil, "bad invite code")
+	compareStatus(t, sts, expected)
+}
@@ -0,0 +1,367 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &DeleteAccountTask{}
+
+// DeleteAccountTask removes the subject user.  Their uploads, tags, comments, and votes are kept,
+// but are reassigned to the deleted user, schema.DeletedUserId.  Their events, api keys, linked
+// identities, favorites, collections, follows, followers, upload sessions, and tokens are removed.
+// The current secret, and a two-factor code if enrolled, must be provided.
+//
+// The deleted user has no user row, and may have many votes on the same pic, like the anonymous
+// user.  Moved votes are given the next unused index, so they never collide.
+type DeleteAccountTask struct {
+	// Deps
+	PixPath                string
+	Beg                    tab.JobBeginner
+	Now                    func() time.Time
+	CompareHashAndPassword func(hashed, password []byte) error
+	// os functions
+	Remove func(name string) error
+
+	// Inputs
+	Secret string
+	Code   string
+}
+
+func (t *DeleteAccountTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if _, keyPresent := ApiKeyFromCtx(ctx); keyPresent {
+		return status.PermissionDenied(nil, "can't delete account with api key")
+	}
+	if sts := validateSecret(t.Secret); sts != nil {
+		return sts
+	}
+
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&su.UserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "can't lookup user")
+	}
+	user := users[0]
+
+	if err := compareHashAndPassword(t.CompareHashAndPassword, user.Secret, []byte(t.Secret)); err != nil {
+		return status.Unauthenticated(err, "can't lookup user")
+	}
+	if user.TotpEnrolled() {
+		if t.Code == "" {
+			return status.InvalidArgument(nil, "missing totp code")
+		}
+		if !verifyTotpCode(user.Totp, t.Code, now) {
+			return status.Unauthenticated(nil, "bad totp code")
+		}
+	}
+
+	ud, sts := findUserData(j, user.UserId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	if sts := lockScannedUserData(j, ud, user.UserId); sts != nil {
+		return sts
+	}
+	nowts := schema.ToTspb(now)
+	for _, p := range ud.pics {
+		for _, s := range p.Source {
+			if s.UserId == user.UserId {
+				s.UserId = schema.DeletedUserId
+			}
+		}
+		p.ModifiedTs = nowts
+		if err := j.UpdatePic(p); err != nil {
+			return status.Internal(err, "can't update pic")
+		}
+	}
+	for _, pt := range ud.picTags {
+		pt.UserId = schema.DeletedUserId
+		pt.ModifiedTs = nowts
+		if err := j.UpdatePicTag(pt); err != nil {
+			return status.Internal(err, "can't update pic tag")
+		}
+	}
+	for _, pc := range ud.picComments {
+		pc.UserId = schema.DeletedUserId
+		for _, r := range pc.Revision {
+			if r.UserId == su.UserId {
+				r.UserId = schema.DeletedUserId
+			}
+		}
+		pc.ModifiedTs = nowts
+		if err := j.UpdatePicComment(pc); err != nil {
+			return status.Internal(err, "can't update pic comment")
+		}
+	}
+	// Votes are keyed by the user, so they are moved rather than updated.
+	for _, pv := range ud.picVotes {
+		if err := j.DeletePicVote(tab.PicVotesPrimary{
+			PicId:  &pv.PicId,
+			UserId: &pv.UserId,
+			Index:  &pv.Index,
+		}); err != nil {
+			return status.Internal(err, "can't delete pic vote")
+		}
+		index, sts := nextDeletedPicVoteIndex(j, pv.PicId)
+		if sts != nil {
+			return sts
+		}
+		pv.UserId = schema.DeletedUserId
+		pv.Index = index
+		pv.ModifiedTs = nowts
+		if err := j.InsertPicVote(pv); err != nil {
+			return status.Internal(err, "can't insert pic vote")
+		}
+	}
+	for _, pcv := range ud.picCommentVotes {
+		if err := j.DeletePicCommentVote(tab.PicCommentVotesPrimary{
+			PicId:     &pcv.PicId,
+			CommentId: &pcv.CommentId,
+			UserId:    &pcv.UserId,
+			Index:     &pcv.Index,
+		}); err != nil {
+			return status.Internal(err, "can't delete pic comment vote")
+		}
+		index, sts := nextDeletedPicCommentVoteIndex(j, pcv.PicId, pcv.CommentId)
+		if sts != nil {
+			return sts
+		}
+		pcv.UserId = schema.DeletedUserId
+		pcv.Index = index
+		pcv.ModifiedTs = nowts
+		if err := j.InsertPicCommentVote(pcv); err != nil {
+			return status.Internal(err, "can't insert pic comment vote")
+		}
+	}
+	for _, ue := range ud.userEvents {
+		createdTs := ue.CreatedTsCol()
+		if err := j.DeleteUserEvent(tab.UserEventsPrimary{
+			UserId:    &ue.UserId,
+			CreatedTs: &createdTs,
+			Index:     &ue.Index,
+		}); err != nil {
+			return status.Internal(err, "can't delete user event")
+		}
+	}
+	for _, ak := range ud.apiKeys {
+		if err := j.DeleteApiKey(tab.ApiKeysPrimary{&ak.ApiKeyId}); err != nil {
+			return status.Internal(err, "can't delete api key")
+		}
+	}
+	for _, ul := range ud.userLinks {
+		if err := j.DeleteUserLink(tab.UserLinksPrimary{&ul.UserLinkId}); err != nil {
+			return status.Internal(err, "can't delete user link")
+		}
+	}
+	for _, pf := range ud.picFavorites {
+		if sts := deletePicFavorite(j, pf, now); sts != nil {
+			return sts
+		}
+	}
+	for _, cp := range ud.collectionPics {
+		if err := j.DeleteCollectionPic(tab.KeyForCollectionPic(cp)); err != nil {
+			return status.Internal(err, "can't delete collection pic")
+		}
+	}
+	for _, c := range ud.collections {
+		if err := j.DeleteCollection(tab.KeyForCollection(c)); err != nil {
+			return status.Internal(err, "can't delete collection")
+		}
+	}
+	for _, uf := range ud.userFollows {
+		if err := j.DeleteUserFollow(tab.KeyForUserFollow(uf)); err != nil {
+			return status.Internal(err, "can't delete user follow")
+		}
+	}
+	for _, tf := range ud.tagFollows {
+		if err := j.DeleteTagFollow(tab.KeyForTagFollow(tf)); err != nil {
+			return status.Internal(err, "can't delete tag follow")
+		}
+	}
+	for _, us := range ud.uploadSessions {
+		if err := j.DeleteUploadSession(tab.KeyForUploadSession(us)); err != nil {
+			return status.Internal(err, "can't delete upload session")
+		}
+	}
+	followers, err := j.FindUserFollows(db.Opts{
+		Prefix: tab.UserFollowsFolloweeUserId{FolloweeUserId: &user.UserId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find followers")
+	}
+	for _, uf := range followers {
+		if err := j.DeleteUserFollow(tab.KeyForUserFollow(uf)); err != nil {
+			return status.Internal(err, "can't delete user follow")
+		}
+	}
+	lf, sts := findLoginFailure(j, userLoginFailureSubject(user.UserId))
+	if sts != nil {
+		return sts
+	}
+	if sts := deleteLoginFailure(j, lf); sts != nil {
+		return sts
+	}
+
+	if err := j.DeleteUser(tab.UsersPrimary{&user.UserId}); err != nil {
+		return status.Internal(err, "can't delete user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	for _, us := range ud.uploadSessions {
+		if sts := removeUploadSessionFile(t.PixPath, us.UploadSessionId, t.Remove); sts != nil {
+			status.ReplaceOrSuppress(&stscap, sts)
+		}
+	}
+	return stscap
+}
+
+// nextDeletedPicVoteIndex finds the next unused deleted user vote index for a pic.
+func nextDeletedPicVoteIndex(j *tab.Job, picId int64) (int64, status.S) {
+	deletedUserId := schema.DeletedUserId
+	pvs, err := j.FindPicVotes(db.Opts{
+		Prefix: tab.PicVotesPrimary{
+			PicId:  &picId,
+			UserId: &deletedUserId,
+		},
+		Lock: db.LockWrite,
+	})
+	if err != nil {
+		return 0, status.Internal(err, "can't find pic votes")
+	}
+	biggest := int64(-1)
+	for _, pv := range pvs {
+		if pv.Index > biggest {
+			biggest = pv.Index
+		}
+	}
+	if biggest == math.MaxInt64 {
+		return 0, status.Internal(nil, "overflow of pic vote index")
+	}
+	return biggest + 1, nil
+}
+
+// nextDeletedPicCommentVoteIndex finds the next unused deleted user vote index for a comment.
+func nextDeletedPicCommentVoteIndex(j *tab.Job, picId, commentId int64) (int64, status.S) {
+	deletedUserId := schema.DeletedUserId
+	pcvs, err := j.FindPicCommentVotes(db.Opts{
+		Prefix: tab.PicCommentVotesPrimary{
+			PicId:     &picId,
+			CommentId: &commentId,
+			UserId:    &deletedUserId,
+		},
+		Lock: db.LockWrite,
+	})
+	if err != nil {
+		return 0, status.Internal(err, "can't find pic comment votes")
+	}
+	biggest := int64(-1)
+	for _, pcv := range pcvs {
+		if pcv.Index > biggest {
+			biggest = pcv.Index
+		}
+	}
+	if biggest == math.MaxInt64 {
+		return 0, status.Internal(nil, "overflow of pic comment vote index")
+	}
+	return biggest + 1, nil
+}
+
+// lockScannedUserData locks the rows findUserData found by scanning, dropping any that no longer
+// refer to userId.  The locked rows replace the scanned ones, since they may have changed.
+func lockScannedUserData(j *tab.Job, ud *userData, userId int64) status.S {
+	var pics []*schema.Pic
+	for _, p := range ud.pics {
+		ps, err := j.FindPics(db.Opts{
+			Prefix: tab.KeyForPic(p),
+			Lock:   db.LockWrite,
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find pics")
+		}
+		if len(ps) != 1 {
+			continue
+		}
+		for _, s := range ps[0].Source {
+			if s.UserId == userId {
+				pics = append(pics, ps[0])
+				break
+			}
+		}
+	}
+	ud.pics = pics
+
+	var picTags []*schema.PicTag
+	for _, pt := range ud.picTags {
+		pts, err := j.FindPicTags(db.Opts{
+			Prefix: tab.KeyForPicTag(pt),
+			Lock:   db.LockWrite,
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find pic tags")
+		}
+		if len(pts) == 1 && pts[0].UserId == userId {
+			picTags = append(picTags, pts[0])
+		}
+	}
+	ud.picTags = picTags
+
+	var picComments []*schema.PicComment
+	for _, pc := range ud.picComments {
+		pcs, err := j.FindPicComments(db.Opts{
+			Prefix: tab.KeyForPicComment(pc),
+			Lock:   db.LockWrite,
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find pic comments")
+		}
+		if len(pcs) == 1 && pcs[0].UserId == userId {
+			picComments = append(picComments, pcs[0])
+		}
+	}
+	ud.picComments = picComments
+
+	var picCommentVotes []*schema.PicCommentVote
+	for _, pcv := range ud.picCommentVotes {
+		pcvs, err := j.FindPicCommentVotes(db.Opts{
+			Prefix: tab.KeyForPicCommentVote(pcv),
+			Lock:   db.LockWrite,
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find pic comment votes")
+		}
+		if len(pcvs) == 1 && pcvs[0].UserId == userId {
+			picCommentVotes = append(picCommentVotes, pcvs[0])
+		}
+	}
+	ud.picCommentVotes = picCommentVotes
+	return nil
+}
@@ -0,0 +1,322 @@
+package tasks
+
+import (
+	"crypto/md5"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func TestDeleteAccountFailsOnMissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &DeleteAccountTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
+
+func TestDeleteAccountFailsOnWrongSecret(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	task := &DeleteAccountTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "wrong",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if !u.Refresh() {
+		t.Error("expected user to still exist")
+	}
+}
+
+func TestDeleteAccountFailsOnApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	u.CreateApiKey("key", schema.User_PIC_INDEX)
+
+	task := &DeleteAccountTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+	}
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.PermissionDenied(nil, "can't delete account with api key")
+	compareStatus(t, sts, expected)
+	if !u.Refresh() {
+		t.Error("expected user to still exist")
+	}
+}
+
+func TestDeleteAccountFailsOnMissingTotpCode(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.enrollTotp()
+	u.Update()
+	task := &DeleteAccountTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.InvalidArgument(nil, "missing totp code")
+	compareStatus(t, sts, expected)
+}
+
+func TestDeleteAccountReassignsContributions(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	other := c.CreateUser()
+	u.CreateApiKey("key", schema.User_PIC_READ)
+	u.CreateEvent()
+
+	p := c.CreatePic()
+	p.Pic.Source = append(p.Pic.Source, &schema.Pic_FileSource{
+		UserId:    u.User.UserId,
+		CreatedTs: p.Pic.CreatedTs,
+	})
+	p.Update()
+
+	tag := c.CreateTag()
+	pt := c.CreatePicTag(p, tag)
+	pt.PicTag.UserId = u.User.UserId
+	c.AutoJob(func(j *tab.Job) error {
+		return j.UpdatePicTag(pt.PicTag)
+	})
+
+	pc := p.Comment()
+	pc.PicComment.UserId = u.User.UserId
+	pc.Update()
+
+	pv := c.CreatePicVote(p, u)
+	pv.PicVote.Vote = schema.PicVote_UP
+	pv.Update()
+	// An existing vote of an earlier deleted user on the same pic must not be overwritten.
+	deletedVote := c.CreatePicVote(p, other)
+	c.AutoJob(func(j *tab.Job) error {
+		if err := j.DeletePicVote(tab.PicVotesPrimary{
+			PicId:  &deletedVote.PicVote.PicId,
+			UserId: &deletedVote.PicVote.UserId,
+			Index:  &deletedVote.PicVote.Index,
+		}); err != nil {
+			return err
+		}
+		deletedVote.PicVote.UserId = schema.DeletedUserId
+		return j.InsertPicVote(deletedVote.PicVote)
+	})
+
+	task := &DeleteAccountTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	userId := u.User.UserId
+	if u.Refresh() {
+		t.Error("expected user to be deleted")
+	}
+	if !other.Refresh() {
+		t.Error("expected other user to be kept")
+	}
+
+	p.Refresh()
+	if have, want := p.Pic.Source[len(p.Pic.Source)-1].UserId, schema.DeletedUserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	pt.Refresh()
+	if pt.PicTag.UserId != schema.DeletedUserId {
+		t.Error("expected pic tag to be reassigned", pt.PicTag)
+	}
+	pc.Refresh()
+	if pc.PicComment.UserId != schema.DeletedUserId {
+		t.Error("expected pic comment to be reassigned", pc.PicComment)
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	deletedUserId := schema.DeletedUserId
+	pvs, err := j.FindPicVotes(db.Opts{
+		Prefix: tab.PicVotesPrimary{PicId: &p.Pic.PicId, UserId: &deletedUserId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pvs) != 2 || pvs[0].Index != 0 || pvs[1].Index != 1 || pvs[1].Vote != schema.PicVote_UP {
+		t.Error("expected vote to be reassigned", pvs)
+	}
+	anonymousUserId := schema.AnonymousUserId
+	anonPvs, err := j.FindPicVotes(db.Opts{
+		Prefix: tab.PicVotesPrimary{PicId: &p.Pic.PicId, UserId: &anonymousUserId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(anonPvs) != 0 {
+		t.Error("expected no anonymous votes", anonPvs)
+	}
+	ues, err := j.FindUserEvents(db.Opts{
+		Prefix: tab.UserEventsPrimary{UserId: &userId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ues) != 0 {
+		t.Error("expected user events to be deleted", ues)
+	}
+	aks, err := j.FindApiKeys(db.Opts{
+		Prefix: tab.ApiKeysUserId{UserId: &userId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aks) != 0 {
+		t.Error("expected api keys to be deleted", aks)
+	}
+}
+
+func TestDeleteAccountRemovesUploadSessions(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	other := c.CreateUser()
+	other.User.Capability = append(other.User.Capability, schema.User_PIC_CREATE)
+	other.Update()
+	md5Hash := md5.Sum([]byte("data"))
+	us := c.startUploadSession(u, md5Hash[:], time.Now())
+	otherUs := c.startUploadSession(other, md5Hash[:], time.Now())
+
+	task := &DeleteAccountTask{
+		PixPath: c.TempDir(),
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Remove:  os.Remove,
+		Secret:  "secret",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	path := schema.UploadSessionPath(c.TempDir(), us.UploadSessionId)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("expected file to be removed", err)
+	}
+	otherPath := schema.UploadSessionPath(c.TempDir(), otherUs.UploadSessionId)
+	if _, err := os.Stat(otherPath); err != nil {
+		t.Error("expected other file to be kept", err)
+	}
+	j := c.Job()
+	defer j.Rollback()
+	uss, err := j.FindUploadSessions(db.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uss) != 1 || uss[0].UploadSessionId != otherUs.UploadSessionId {
+		t.Error("expected only the other session to be kept", uss)
+	}
+}
+
+func TestDeleteAccountRemovesFavoritesAndCollections(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_FAVORITE_CREATE)
+	u.Update()
+	p := c.CreatePic()
+	u.Favorite(p)
+	col := u.CreateCollection("Cats", schema.Collection_PUBLIC)
+	c.AddCollectionPic(col, p, 0)
+
+	task := &DeleteAccountTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	p.Refresh()
+	if p.Pic.FavoriteCount != 0 {
+		t.Error("wrong favorite count", p.Pic.FavoriteCount)
+	}
+	if ids := c.CollectionPicIds(col); len(ids) != 0 {
+		t.Error("expected collection pics deleted", ids)
+	}
+	j := c.Job()
+	defer j.Rollback()
+	cols, err := j.FindCollections(db.Opts{
+		Prefix: tab.CollectionsUserId{UserId: &u.User.UserId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 0 {
+		t.Error("expected collections deleted", cols)
+	}
+}
+
+func TestDeleteAccountRemovesFollowsAndFollowers(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+	follower := c.CreateUser()
+	follower.User.Capability = append(follower.User.Capability, schema.User_FOLLOW_CREATE)
+	follower.Update()
+	u.FollowUser(c.CreateUser())
+	u.FollowTag("cats")
+	follower.FollowUser(u)
+
+	task := &DeleteAccountTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if ufs, tfs := u.Follows(); len(ufs) != 0 || len(tfs) != 0 {
+		t.Error("expected follows deleted", ufs, tfs)
+	}
+	if ufs, _ := follower.Follows(); len(ufs) != 0 {
+		t.Error("expected followers deleted", ufs)
+	}
+}
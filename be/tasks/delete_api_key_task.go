@@ -0,0 +1,58 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &DeleteApiKeyTask{}
+
+// DeleteApiKeyTask revokes one of the subject user's api keys.
+type DeleteApiKeyTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	ApiKeyId int64
+}
+
+func (t *DeleteApiKeyTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if _, keyPresent := ApiKeyFromCtx(ctx); keyPresent {
+		return status.PermissionDenied(nil, "can't delete api key with api key")
+	}
+
+	aks, err := j.FindApiKeys(db.Opts{
+		Prefix: tab.ApiKeysPrimary{&t.ApiKeyId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find api keys")
+	}
+	// Don't reveal that keys of other users exist.
+	if len(aks) != 1 || aks[0].UserId != su.UserId {
+		return status.NotFound(nil, "can't find api key")
+	}
+
+	if err := j.DeleteApiKey(tab.ApiKeysPrimary{&t.ApiKeyId}); err != nil {
+		return status.Internal(err, "can't delete api key")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func TestDeleteApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	ak := u.CreateApiKey("key")
+
+	task := &DeleteApiKeyTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		ApiKeyId: ak.ApiKeyId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	aks, err := j.FindApiKeys(db.Opts{
+		Prefix: tab.ApiKeysPrimary{&ak.ApiKeyId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aks) != 0 {
+		t.Error("expected api key deleted", aks)
+	}
+}
+
+func TestDeleteApiKey_OtherUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	other := c.CreateUser()
+	ak := other.CreateApiKey("key")
+
+	task := &DeleteApiKeyTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		ApiKeyId: ak.ApiKeyId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.NotFound(nil, "can't find api key")
+	compareStatus(t, sts, expected)
+}
+
+func TestDeleteApiKey_CantUseApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	ak := u.CreateApiKey("key", schema.User_PIC_INDEX)
+
+	task := &DeleteApiKeyTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		ApiKeyId: ak.ApiKeyId,
+	}
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.PermissionDenied(nil, "can't delete api key with api key")
+	compareStatus(t, sts, expected)
+
+	j := c.Job()
+	defer j.Rollback()
+	aks, err := j.FindApiKeys(db.Opts{
+		Prefix: tab.ApiKeysPrimary{&ak.ApiKeyId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aks) != 1 {
+		t.Error("expected api key not deleted", aks)
+	}
+}
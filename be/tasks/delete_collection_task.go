@@ -0,0 +1,63 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &DeleteCollectionTask{}
+
+// DeleteCollectionTask removes a collection.  The pics in it are unaffected.  Only the owner may
+// delete the collection.
+type DeleteCollectionTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	CollectionId int64
+	Version      int64
+}
+
+func (t *DeleteCollectionTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	c, sts := lookupCollectionForUpdate(j, t.CollectionId, su, conf)
+	if sts != nil {
+		return sts
+	}
+	if c.Version() != t.Version {
+		return status.Aborted(nil, "version mismatch")
+	}
+
+	cps, sts := findCollectionPics(j, c.CollectionId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	for _, cp := range cps {
+		if err := j.DeleteCollectionPic(tab.KeyForCollectionPic(cp)); err != nil {
+			return status.Internal(err, "can't delete collection pic")
+		}
+	}
+	if err := j.DeleteCollection(tab.KeyForCollection(c)); err != nil {
+		return status.Internal(err, "can't delete collection")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+func TestDeleteCollection(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	col := u.CreateCollection("Cats", schema.Collection_PRIVATE)
+	p := c.CreatePic()
+	c.AddCollectionPic(col, p, 0)
+
+	task := &DeleteCollectionTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		CollectionId: col.CollectionId,
+		Version:      col.Version(),
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if ids := c.CollectionPicIds(col); len(ids) != 0 {
+		t.Error("expected collection pics deleted", ids)
+	}
+	if !p.Refresh() {
+		t.Error("pic should be kept")
+	}
+	j := c.Job()
+	defer j.Rollback()
+	cols, err := j.FindCollections(db.Opts{
+		Prefix: tab.CollectionsPrimary{&col.CollectionId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 0 {
+		t.Error("expected collection deleted", cols)
+	}
+}
@@ -0,0 +1,96 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// deletedPicCommentText replaces the text of deleted comments.
+const deletedPicCommentText = "[deleted]"
+
+var _ Task = &DeletePicCommentTask{}
+
+// DeletePicCommentTask deletes a comment.  The comment is kept, with a placeholder text, so that
+// replies to it still have a parent.  Authors may delete their own comments, and moderators may
+// delete any comment.
+type DeletePicCommentTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	PicId     int64
+	CommentId int64
+	// Version is the version of the comment being deleted.
+	Version int64
+	// Reason is why the comment is being deleted.  It is only recorded when deleting the comment of
+	// another user.
+	Reason string
+
+	// Results
+	UnfilteredPicComment *schema.PicComment
+	PicComment           *schema.PicComment
+}
+
+func (t *DeletePicCommentTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	reason, sts := validateAuditReason(t.Reason)
+	if sts != nil {
+		return sts
+	}
+	pc, _, sts := lookupPicCommentForUpdate(
+		j, t.PicId, t.CommentId, t.Version, su, conf, schema.User_PIC_COMMENT_DELETE)
+	if sts != nil {
+		return sts
+	}
+	if pc.Deleted() {
+		return status.InvalidArgument(nil, "comment already deleted")
+	}
+
+	before := proto.Clone(pc).(*schema.PicComment)
+	pc.Revision = append(pc.Revision, &schema.PicComment_Revision{
+		Text:       pc.Text,
+		ReplacedTs: schema.ToTspb(now),
+		UserId:     su.UserId,
+	})
+	pc.Text = deletedPicCommentText
+	pc.DeletedTs = schema.ToTspb(now)
+	pc.SetModifiedTime(now)
+	if err := j.UpdatePicComment(pc); err != nil {
+		return status.Internal(err, "can't update comment")
+	}
+	if su.UserId != pc.UserId {
+		al := &schema.AuditLog{
+			Action:          schema.AuditLog_DELETE_PIC_COMMENT,
+			TargetPicId:     pc.PicId,
+			TargetCommentId: pc.CommentId,
+			Reason:          reason,
+		}
+		if sts := insertAuditLog(j, al, su, before, pc, now); sts != nil {
+			return sts
+		}
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.UnfilteredPicComment = pc
+	t.PicComment = filterPicComment(t.UnfilteredPicComment, su, conf)
+	return nil
+}
@@ -0,0 +1,158 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestDeletePicComment(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_COMMENT_DELETE)
+	u.Update()
+	pc := c.CreatePic().Comment()
+	pc.PicComment.UserId = u.User.UserId
+	pc.PicComment.Text = "oops"
+	pc.Update()
+	reply := pc.Comment()
+
+	task := &DeletePicCommentTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		PicId:     pc.PicComment.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Version:   pc.PicComment.Version(),
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !pc.Refresh() {
+		t.Fatal("expected comment to be kept")
+	}
+	if !pc.PicComment.Deleted() || pc.PicComment.Text != deletedPicCommentText {
+		t.Error("expected comment to be deleted", pc.PicComment)
+	}
+	if revs := pc.PicComment.Revision; len(revs) != 1 || revs[0].Text != "oops" {
+		t.Error("expected revision", revs)
+	}
+	if _, edited := pc.PicComment.GetEditedTime(); edited {
+		t.Error("deletion shouldn't count as an edit")
+	}
+	if !reply.Refresh() {
+		t.Error("expected reply to be kept")
+	}
+	if als := c.AuditLogs(); len(als) != 0 {
+		t.Error("expected no audit log for author delete", als)
+	}
+
+	// Others can no longer see who wrote it, or what it said.
+	conf, sts := GetConfiguration(c.Ctx)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	other := c.CreateUser()
+	other.User.Capability = append(other.User.Capability, schema.User_USER_READ_ALL)
+	fpc := filterPicComment(pc.PicComment, other.User, conf)
+	if fpc.UserId != schema.AnonymousUserId || len(fpc.Revision) != 0 {
+		t.Error("expected filtered comment", fpc)
+	}
+}
+
+func TestDeletePicComment_Moderator(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	mod := c.CreateUser()
+	mod.User.Capability = append(mod.User.Capability,
+		schema.User_PIC_COMMENT_MODERATE, schema.User_USER_READ_ALL)
+	mod.Update()
+	author := c.CreateUser()
+	pc := c.CreatePic().Comment()
+	pc.PicComment.UserId = author.User.UserId
+	pc.Update()
+
+	task := &DeletePicCommentTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		PicId:     pc.PicComment.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Version:   pc.PicComment.Version(),
+		Reason:    "spam",
+	}
+	if sts := new(TaskRunner).Run(mod.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	pc.Refresh()
+	if !pc.PicComment.Deleted() {
+		t.Error("expected comment to be deleted", pc.PicComment)
+	}
+	if task.PicComment.UserId != author.User.UserId || len(task.PicComment.Revision) != 1 {
+		t.Error("moderators should see the author and revisions", task.PicComment)
+	}
+	als := c.AuditLogs()
+	if len(als) != 1 || als[0].Action != schema.AuditLog_DELETE_PIC_COMMENT ||
+		als[0].TargetPicId != pc.PicComment.PicId || als[0].Reason != "spam" {
+		t.Error("expected audit log", als)
+	}
+}
+
+func TestDeletePicComment_AlreadyDeleted(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_COMMENT_DELETE)
+	u.Update()
+	pc := c.CreatePic().Comment()
+	pc.PicComment.UserId = u.User.UserId
+	pc.PicComment.DeletedTs = pc.PicComment.CreatedTs
+	pc.Update()
+
+	task := &DeletePicCommentTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		PicId:     pc.PicComment.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Version:   pc.PicComment.Version(),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeletePicComment_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	pc := c.CreatePic().Comment()
+	pc.PicComment.UserId = u.User.UserId
+	pc.Update()
+
+	task := &DeletePicCommentTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		PicId:     pc.PicComment.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Version:   pc.PicComment.Version(),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
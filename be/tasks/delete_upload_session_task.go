@@ -0,0 +1,70 @@
+package tasks
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &DeleteUploadSessionTask{}
+
+// DeleteUploadSessionTask removes an upload session of the current user, along with its data.
+type DeleteUploadSessionTask struct {
+	// Deps
+	PixPath string
+	Beg     tab.JobBeginner
+	Now     func() time.Time
+	// os functions
+	Remove func(name string) error
+
+	// Inputs
+	UploadSessionId int64
+}
+
+func (t *DeleteUploadSessionTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if u == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(u, conf, schema.User_PIC_CREATE); sts != nil {
+		return sts
+	}
+
+	us, sts := findUploadSession(j, t.UploadSessionId, u.UserId, db.LockWrite, conf, now)
+	if sts != nil {
+		return sts
+	}
+	if err := j.DeleteUploadSession(tab.KeyForUploadSession(us)); err != nil {
+		return status.Internal(err, "can't delete upload session")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit")
+	}
+
+	return removeUploadSessionFile(t.PixPath, us.UploadSessionId, t.Remove)
+}
+
+// removeUploadSessionFile removes the staging file of an upload session.  It is only called after
+// the session row is gone, so a missing file is not an error.
+func removeUploadSessionFile(pixPath string, uploadSessionId int64, remove func(string) error) status.S {
+	path := schema.UploadSessionPath(pixPath, uploadSessionId)
+	if err := remove(path); err != nil && !os.IsNotExist(err) {
+		return status.Internal(err, "can't remove", path)
+	}
+	return nil
+}
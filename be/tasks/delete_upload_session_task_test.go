@@ -0,0 +1,51 @@
+package tasks
+
+import (
+	"crypto/md5"
+	"os"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func TestDeleteUploadSession(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	us := c.startUploadSession(u, md5Hash[:], time.Now())
+
+	task := &DeleteUploadSessionTask{
+		PixPath: c.TempDir(),
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Remove:  os.Remove,
+
+		UploadSessionId: us.UploadSessionId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	path := schema.UploadSessionPath(c.TempDir(), us.UploadSessionId)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("expected file to be removed", err)
+	}
+	lookupTask := &LookupUploadSessionTask{
+		PixPath: c.TempDir(),
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Stat:    os.Stat,
+
+		UploadSessionId: us.UploadSessionId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), lookupTask)
+	expected := status.NotFound(nil, "can't find upload session")
+	compareStatus(t, sts, expected)
+}
@@ -0,0 +1,83 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &DisableTotpTask{}
+
+// DisableTotpTask removes two-factor authentication from the subject user.  Both the current
+// secret and a code (or recovery code) must be provided.
+type DisableTotpTask struct {
+	// Deps
+	Beg                    tab.JobBeginner
+	Now                    func() time.Time
+	CompareHashAndPassword func(hashed, password []byte) error
+
+	// Inputs
+	Secret string
+	Code   string
+
+	// Results
+	User *schema.User
+}
+
+func (t *DisableTotpTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if sts := validateSecret(t.Secret); sts != nil {
+		return sts
+	}
+	if t.Code == "" {
+		return status.InvalidArgument(nil, "missing totp code")
+	}
+
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&su.UserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "can't lookup user")
+	}
+	user := users[0]
+
+	// TODO: rate limit this.
+	if err := compareHashAndPassword(t.CompareHashAndPassword, user.Secret, []byte(t.Secret)); err != nil {
+		return status.Unauthenticated(err, "can't lookup user")
+	}
+	if !user.TotpEnrolled() {
+		return status.FailedPrecondition(nil, "not enrolled in two-factor authentication")
+	}
+	if !verifyTotpCode(user.Totp, t.Code, now) {
+		return status.Unauthenticated(nil, "bad totp code")
+	}
+	user.Totp = nil
+	user.SetModifiedTime(now)
+
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.User = user
+	return nil
+}
@@ -0,0 +1,73 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/status"
+)
+
+func TestDisableTotp(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.enrollTotp("recovery")
+	u.Update()
+
+	task := &DisableTotpTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+		Code:   "recovery",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if u.User.Totp != nil {
+		t.Error("expected enrollment removed", u.User.Totp)
+	}
+}
+
+func TestDisableTotp_BadCode(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.enrollTotp("recovery")
+	u.Update()
+
+	task := &DisableTotpTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+		Code:   "bogus",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.Unauthenticated(nil, "bad totp code")
+	compareStatus(t, sts, expected)
+
+	u.Refresh()
+	if !u.User.TotpEnrolled() {
+		t.Error("enrollment should remain")
+	}
+}
+
+func TestDisableTotp_NotEnrolled(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &DisableTotpTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+		Code:   "123456",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.FailedPrecondition(nil, "not enrolled in two-factor authentication")
+	compareStatus(t, sts, expected)
+}
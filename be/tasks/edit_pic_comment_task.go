@@ -0,0 +1,138 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &EditPicCommentTask{}
+
+// EditPicCommentTask replaces the text of a comment, keeping the prior text as a revision.  Authors
+// may edit their own comments within the configured edit window.  Moderators may edit any comment.
+type EditPicCommentTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	PicId     int64
+	CommentId int64
+	// Version is the version of the comment being edited.
+	Version int64
+	Text    string
+
+	// Results
+	UnfilteredPicComment *schema.PicComment
+	PicComment           *schema.PicComment
+}
+
+func (t *EditPicCommentTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	txt, sts := validatePicCommentText(t.Text, conf)
+	if sts != nil {
+		return sts
+	}
+	pc, moderator, sts := lookupPicCommentForUpdate(
+		j, t.PicId, t.CommentId, t.Version, su, conf, schema.User_PIC_COMMENT_UPDATE)
+	if sts != nil {
+		return sts
+	}
+	if pc.Deleted() {
+		return status.InvalidArgument(nil, "can't edit deleted comment")
+	}
+	if !moderator && conf.PicCommentEditWindow != nil {
+		window, err := ptypes.Duration(conf.PicCommentEditWindow)
+		if err != nil {
+			return status.Internal(err, "can't parse edit window")
+		}
+		if now.After(pc.GetCreatedTime().Add(window)) {
+			return status.PermissionDenied(nil, "comment can no longer be edited")
+		}
+	}
+
+	if txt != pc.Text {
+		before := proto.Clone(pc).(*schema.PicComment)
+		pc.Revision = append(pc.Revision, &schema.PicComment_Revision{
+			Text:       pc.Text,
+			ReplacedTs: schema.ToTspb(now),
+			UserId:     su.UserId,
+		})
+		pc.Text = txt
+		pc.SetModifiedTime(now)
+		if err := j.UpdatePicComment(pc); err != nil {
+			return status.Internal(err, "can't update comment")
+		}
+		if su.UserId != pc.UserId {
+			al := &schema.AuditLog{
+				Action:          schema.AuditLog_UPDATE_PIC_COMMENT,
+				TargetPicId:     pc.PicId,
+				TargetCommentId: pc.CommentId,
+			}
+			if sts := insertAuditLog(j, al, su, before, pc, now); sts != nil {
+				return sts
+			}
+		}
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.UnfilteredPicComment = pc
+	t.PicComment = filterPicComment(t.UnfilteredPicComment, su, conf)
+	return nil
+}
+
+// lookupPicCommentForUpdate finds a comment that su may change, locked for writing.  Authors need
+// authorCap to change their own comments, and anyone else needs PIC_COMMENT_MODERATE.  moderator
+// is true if su has PIC_COMMENT_MODERATE.
+func lookupPicCommentForUpdate(j *tab.Job, picId, commentId, version int64, su *schema.User,
+	conf *schema.Configuration, authorCap schema.User_Capability) (
+	_ *schema.PicComment, moderator bool, _ status.S) {
+	if su == nil {
+		// Anonymous users can't show that they wrote a comment.
+		return nil, false, status.Unauthenticated(nil, "must be logged in to change comments")
+	}
+	pcs, err := j.FindPicComments(db.Opts{
+		Prefix: tab.PicCommentsPrimary{PicId: &picId, CommentId: &commentId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return nil, false, status.Internal(err, "can't lookup comment")
+	}
+	if len(pcs) != 1 {
+		return nil, false, status.NotFound(nil, "can't find comment")
+	}
+	pc := pcs[0]
+
+	moderator = validateCapability(su, conf, schema.User_PIC_COMMENT_MODERATE) == nil
+	if !moderator {
+		if pc.UserId != su.UserId {
+			return nil, false, status.PermissionDenied(nil, "can't change comment of another user")
+		}
+		if sts := validateCapability(su, conf, authorCap); sts != nil {
+			return nil, false, sts
+		}
+	}
+	if pc.Version() != version {
+		return nil, false, status.Aborted(nil, "version mismatch")
+	}
+	return pc, moderator, nil
+}
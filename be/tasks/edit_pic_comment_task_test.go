@@ -0,0 +1,182 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestEditPicComment(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_COMMENT_UPDATE)
+	u.Update()
+	pc := c.CreatePic().Comment()
+	pc.PicComment.UserId = u.User.UserId
+	pc.PicComment.Text = "frist"
+	pc.Update()
+
+	now := time.Now()
+	task := &EditPicCommentTask{
+		Beg:       c.DB(),
+		Now:       func() time.Time { return now },
+		PicId:     pc.PicComment.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Version:   pc.PicComment.Version(),
+		Text:      "first",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	pc.Refresh()
+	if pc.PicComment.Text != "first" {
+		t.Error("text not updated", pc.PicComment)
+	}
+	if revs := pc.PicComment.Revision; len(revs) != 1 || revs[0].Text != "frist" ||
+		revs[0].UserId != u.User.UserId {
+		t.Error("expected revision", revs)
+	}
+	if edited, ok := pc.PicComment.GetEditedTime(); !ok || !edited.Equal(now) {
+		t.Error("wrong edited time", edited, ok)
+	}
+	if len(task.PicComment.Revision) != 1 {
+		t.Error("author should see revisions", task.PicComment)
+	}
+	if als := c.AuditLogs(); len(als) != 0 {
+		t.Error("expected no audit log for author edit", als)
+	}
+}
+
+func TestEditPicComment_EditWindowPassed(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_COMMENT_UPDATE)
+	u.Update()
+	pc := c.CreatePic().Comment()
+	pc.PicComment.UserId = u.User.UserId
+	pc.Update()
+
+	task := &EditPicCommentTask{
+		Beg:       c.DB(),
+		Now:       func() time.Time { return time.Now().Add(2 * time.Hour) },
+		PicId:     pc.PicComment.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Version:   pc.PicComment.Version(),
+		Text:      "too late",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "no longer be edited"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestEditPicComment_ModeratorEditsOtherUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	mod := c.CreateUser()
+	mod.User.Capability = append(mod.User.Capability, schema.User_PIC_COMMENT_MODERATE)
+	mod.Update()
+	author := c.CreateUser()
+	pc := c.CreatePic().Comment()
+	pc.PicComment.UserId = author.User.UserId
+	pc.PicComment.Text = "rude"
+	pc.Update()
+
+	task := &EditPicCommentTask{
+		Beg:       c.DB(),
+		Now:       func() time.Time { return time.Now().Add(2 * time.Hour) },
+		PicId:     pc.PicComment.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Version:   pc.PicComment.Version(),
+		Text:      "polite",
+	}
+	if sts := new(TaskRunner).Run(mod.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	pc.Refresh()
+	if pc.PicComment.Text != "polite" || pc.PicComment.UserId != author.User.UserId {
+		t.Error("wrong comment", pc.PicComment)
+	}
+	if revs := pc.PicComment.Revision; len(revs) != 1 || revs[0].UserId != mod.User.UserId {
+		t.Error("expected revision", revs)
+	}
+	als := c.AuditLogs()
+	if len(als) != 1 || als[0].Action != schema.AuditLog_UPDATE_PIC_COMMENT ||
+		als[0].ActorUserId != mod.User.UserId || als[0].TargetCommentId != pc.PicComment.CommentId {
+		t.Error("expected audit log", als)
+	}
+}
+
+func TestEditPicComment_OtherUserDenied(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_COMMENT_UPDATE)
+	u.Update()
+	author := c.CreateUser()
+	pc := c.CreatePic().Comment()
+	pc.PicComment.UserId = author.User.UserId
+	pc.Update()
+
+	task := &EditPicCommentTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		PicId:     pc.PicComment.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Version:   pc.PicComment.Version(),
+		Text:      "mine now",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestEditPicComment_VersionMismatch(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_COMMENT_UPDATE)
+	u.Update()
+	pc := c.CreatePic().Comment()
+	pc.PicComment.UserId = u.User.UserId
+	pc.Update()
+
+	task := &EditPicCommentTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		PicId:     pc.PicComment.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Version:   pc.PicComment.Version() - 1,
+		Text:      "stale",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.Aborted; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &ExpireUploadSessionsTask{}
+
+// maxExpireUploadSessions limits how many sessions are removed at once, to keep the job small.
+const maxExpireUploadSessions = 100
+
+// ExpireUploadSessionsTask removes upload sessions that haven't been modified recently.  It does
+// not act on behalf of a user.
+type ExpireUploadSessionsTask struct {
+	// Deps
+	PixPath string
+	Beg     tab.JobBeginner
+	Now     func() time.Time
+	// os functions
+	Remove func(name string) error
+
+	// Results
+	ExpiredUploadSessions []*schema.UploadSession
+}
+
+func (t *ExpireUploadSessionsTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.ExpiredUploadSessions = nil
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	expiry, sts := uploadSessionExpiry(conf)
+	if sts != nil {
+		return sts
+	}
+	if expiry == nil {
+		return nil
+	}
+	maxModifiedTs := t.Now().Add(-*expiry).UnixNano()
+
+	j, err := tab.NewJob(ctx, t.Beg)
+	if err != nil {
+		return status.Internal(err, "can't create job")
+	}
+	defer revert(j, &stscap)
+
+	uss, err := j.FindUploadSessions(db.Opts{
+		StopInc: tab.UploadSessionsModifiedTs{
+			ModifiedTs: &maxModifiedTs,
+		},
+		Lock:  db.LockWrite,
+		Limit: maxExpireUploadSessions,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find upload sessions")
+	}
+	for _, us := range uss {
+		if err := j.DeleteUploadSession(tab.KeyForUploadSession(us)); err != nil {
+			return status.Internal(err, "can't delete upload session")
+		}
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit")
+	}
+
+	for _, us := range uss {
+		if sts := removeUploadSessionFile(t.PixPath, us.UploadSessionId, t.Remove); sts != nil {
+			status.ReplaceOrSuppress(&stscap, sts)
+		}
+	}
+	t.ExpiredUploadSessions = uss
+	return stscap
+}
@@ -0,0 +1,45 @@
+package tasks
+
+import (
+	"crypto/md5"
+	"os"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestExpireUploadSessions(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	now := time.Now()
+	old := c.startUploadSession(u, md5Hash[:], now.Add(-48*time.Hour))
+	fresh := c.startUploadSession(u, md5Hash[:], now)
+
+	task := &ExpireUploadSessionsTask{
+		PixPath: c.TempDir(),
+		Beg:     c.DB(),
+		Now:     func() time.Time { return now },
+		Remove:  os.Remove,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.ExpiredUploadSessions) != 1 ||
+		task.ExpiredUploadSessions[0].UploadSessionId != old.UploadSessionId {
+		t.Error("bad expired sessions", task.ExpiredUploadSessions)
+	}
+
+	if _, err := os.Stat(schema.UploadSessionPath(c.TempDir(), old.UploadSessionId)); !os.IsNotExist(err) {
+		t.Error("expected file to be removed", err)
+	}
+	if _, err := os.Stat(schema.UploadSessionPath(c.TempDir(), fresh.UploadSessionId)); err != nil {
+		t.Error("expected file to exist", err)
+	}
+}
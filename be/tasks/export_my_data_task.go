@@ -0,0 +1,225 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &ExportMyDataTask{}
+
+// ExportMyDataTask collects everything stored about the subject user, and everything they have
+// contributed.
+type ExportMyDataTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Results
+	User *schema.User
+	// Uploads are the pics the user added a file source to.  Each pic only includes the sources
+	// added by the user.
+	Uploads         []*schema.Pic
+	PicTags         []*schema.PicTag
+	PicComments     []*schema.PicComment
+	PicVotes        []*schema.PicVote
+	PicCommentVotes []*schema.PicCommentVote
+	UserEvents      []*schema.UserEvent
+	// ApiKeys are the user's api keys, without their hashes.
+	ApiKeys        []*schema.ApiKey
+	PicFavorites   []*schema.PicFavorite
+	Collections    []*schema.Collection
+	CollectionPics []*schema.CollectionPic
+	UserFollows    []*schema.UserFollow
+	TagFollows     []*schema.TagFollow
+	UploadSessions []*schema.UploadSession
+}
+
+func (t *ExportMyDataTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if _, keyPresent := ApiKeyFromCtx(ctx); keyPresent {
+		return status.PermissionDenied(nil, "can't export data with api key")
+	}
+
+	ud, sts := findUserData(j, su.UserId, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.User = su
+	for _, p := range ud.pics {
+		var sources []*schema.Pic_FileSource
+		for _, s := range p.Source {
+			if s.UserId == su.UserId {
+				sources = append(sources, s)
+			}
+		}
+		p.Source = sources
+		t.Uploads = append(t.Uploads, p)
+	}
+	t.PicTags = ud.picTags
+	t.PicComments = ud.picComments
+	t.PicVotes = ud.picVotes
+	t.PicCommentVotes = ud.picCommentVotes
+	t.UserEvents = ud.userEvents
+	for _, ak := range ud.apiKeys {
+		ak.KeyHash = nil
+		t.ApiKeys = append(t.ApiKeys, ak)
+	}
+	t.PicFavorites = ud.picFavorites
+	t.Collections = ud.collections
+	t.CollectionPics = ud.collectionPics
+	t.UserFollows = ud.userFollows
+	t.TagFollows = ud.tagFollows
+	t.UploadSessions = ud.uploadSessions
+	return nil
+}
+
+// userData is the rows that belong to, or were contributed by, a single user.
+type userData struct {
+	pics            []*schema.Pic
+	picTags         []*schema.PicTag
+	picComments     []*schema.PicComment
+	picVotes        []*schema.PicVote
+	picCommentVotes []*schema.PicCommentVote
+	userEvents      []*schema.UserEvent
+	apiKeys         []*schema.ApiKey
+	userLinks       []*schema.UserLink
+	picFavorites    []*schema.PicFavorite
+	collections     []*schema.Collection
+	collectionPics  []*schema.CollectionPic
+	userFollows     []*schema.UserFollow
+	tagFollows      []*schema.TagFollow
+	uploadSessions  []*schema.UploadSession
+}
+
+// findUserData finds all rows that refer to userId.  Rows without an index on the user are found
+// by scanning the whole table, so this should only be used for rare, user initiated requests.  The
+// scans never lock, since locking whole tables would block every other writer.  Callers that
+// modify the scanned rows must lock them again with lockScannedUserData.
+func findUserData(j *tab.Job, userId int64, lock db.Lock) (*userData, status.S) {
+	ud := new(userData)
+	err := j.ScanPics(db.Opts{Lock: db.LockNone}, func(p *schema.Pic) error {
+		for _, s := range p.Source {
+			if s.UserId == userId {
+				ud.pics = append(ud.pics, p)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't scan pics")
+	}
+	err = j.ScanPicTags(db.Opts{Lock: db.LockNone}, func(pt *schema.PicTag) error {
+		if pt.UserId == userId {
+			ud.picTags = append(ud.picTags, pt)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't scan pic tags")
+	}
+	err = j.ScanPicComments(db.Opts{Lock: db.LockNone}, func(pc *schema.PicComment) error {
+		if pc.UserId == userId {
+			ud.picComments = append(ud.picComments, pc)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't scan pic comments")
+	}
+	err = j.ScanPicCommentVotes(db.Opts{Lock: db.LockNone}, func(pcv *schema.PicCommentVote) error {
+		if pcv.UserId == userId {
+			ud.picCommentVotes = append(ud.picCommentVotes, pcv)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't scan pic comment votes")
+	}
+
+	ud.picVotes, err = j.FindPicVotes(db.Opts{
+		Prefix: tab.PicVotesUserId{UserId: &userId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pic votes")
+	}
+	ud.userEvents, err = j.FindUserEvents(db.Opts{
+		Prefix: tab.UserEventsPrimary{UserId: &userId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find user events")
+	}
+	ud.apiKeys, err = j.FindApiKeys(db.Opts{
+		Prefix: tab.ApiKeysUserId{UserId: &userId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find api keys")
+	}
+	ud.userLinks, err = j.FindUserLinks(db.Opts{
+		Prefix: tab.UserLinksUserId{UserId: &userId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find user links")
+	}
+	ud.picFavorites, err = j.FindPicFavorites(db.Opts{
+		Prefix: tab.PicFavoritesUserId{UserId: &userId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pic favorites")
+	}
+	ud.collections, err = j.FindCollections(db.Opts{
+		Prefix: tab.CollectionsUserId{UserId: &userId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find collections")
+	}
+	for _, c := range ud.collections {
+		cps, sts := findCollectionPics(j, c.CollectionId, lock)
+		if sts != nil {
+			return nil, sts
+		}
+		ud.collectionPics = append(ud.collectionPics, cps...)
+	}
+	ufs, sts := findUserFollows(j, userId, lock)
+	if sts != nil {
+		return nil, sts
+	}
+	ud.userFollows = ufs
+	tfs, sts := findTagFollows(j, userId, lock)
+	if sts != nil {
+		return nil, sts
+	}
+	ud.tagFollows = tfs
+	ud.uploadSessions, err = j.FindUploadSessions(db.Opts{
+		Prefix: tab.UploadSessionsUserId{UserId: &userId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find upload sessions")
+	}
+	return ud, nil
+}
@@ -0,0 +1,122 @@
+package tasks
+
+import (
+	"crypto/md5"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func TestExportMyDataFailsOnMissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &ExportMyDataTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
+
+func TestExportMyDataFailsOnApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	u.CreateApiKey("key", schema.User_PIC_INDEX)
+
+	task := &ExportMyDataTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.PermissionDenied(nil, "can't export data with api key")
+	compareStatus(t, sts, expected)
+}
+
+func TestExportMyData(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	other := c.CreateUser()
+
+	p := c.CreatePic()
+	p.Pic.Source = append(p.Pic.Source, &schema.Pic_FileSource{
+		UserId:    u.User.UserId,
+		Url:       "http://example.com/cat.png",
+		CreatedTs: p.Pic.CreatedTs,
+	})
+	p.Update()
+	otherPic := c.CreatePic()
+
+	tag := c.CreateTag()
+	pt := c.CreatePicTag(p, tag)
+	pt.PicTag.UserId = u.User.UserId
+	c.AutoJob(func(j *tab.Job) error {
+		return j.UpdatePicTag(pt.PicTag)
+	})
+
+	pc := p.Comment()
+	pc.PicComment.UserId = u.User.UserId
+	pc.Update()
+	otherPc := otherPic.Comment()
+	otherPc.PicComment.UserId = other.User.UserId
+	otherPc.Update()
+
+	c.CreatePicVote(otherPic, u)
+	c.CreatePicVote(otherPic, other)
+	u.CreateEvent()
+	ak := u.CreateApiKey("key")
+	other.CreateApiKey("otherkey")
+	md5Hash := md5.Sum([]byte("data"))
+	us := c.startUploadSession(u, md5Hash[:], time.Now())
+
+	task := &ExportMyDataTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if task.User.UserId != u.User.UserId {
+		t.Error("wrong user", task.User)
+	}
+	if len(task.Uploads) != 1 || task.Uploads[0].PicId != p.Pic.PicId {
+		t.Fatal("wrong uploads", task.Uploads)
+	}
+	if len(task.Uploads[0].Source) != 1 || task.Uploads[0].Source[0].Url != "http://example.com/cat.png" {
+		t.Error("expected only the user's sources", task.Uploads[0].Source)
+	}
+	if len(task.PicTags) != 1 || task.PicTags[0].TagId != tag.Tag.TagId {
+		t.Error("wrong pic tags", task.PicTags)
+	}
+	if len(task.PicComments) != 1 || task.PicComments[0].CommentId != pc.PicComment.CommentId {
+		t.Error("wrong pic comments", task.PicComments)
+	}
+	if len(task.PicVotes) != 1 || task.PicVotes[0].PicId != otherPic.Pic.PicId {
+		t.Error("wrong pic votes", task.PicVotes)
+	}
+	if len(task.UserEvents) != 1 {
+		t.Error("wrong user events", task.UserEvents)
+	}
+	if len(task.ApiKeys) != 1 || task.ApiKeys[0].ApiKeyId != ak.ApiKeyId {
+		t.Fatal("wrong api keys", task.ApiKeys)
+	}
+	if task.ApiKeys[0].KeyHash != nil {
+		t.Error("expected no key hash", task.ApiKeys[0])
+	}
+	if len(task.UploadSessions) != 1 || task.UploadSessions[0].UploadSessionId != us.UploadSessionId {
+		t.Error("wrong upload sessions", task.UploadSessions)
+	}
+}
@@ -0,0 +1,52 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &FindApiKeysTask{}
+
+// FindApiKeysTask finds the api keys of the subject user.
+type FindApiKeysTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Results
+	ApiKeys []*schema.ApiKey
+}
+
+func (t *FindApiKeysTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if _, keyPresent := ApiKeyFromCtx(ctx); keyPresent {
+		return status.PermissionDenied(nil, "can't find api keys with api key")
+	}
+
+	aks, err := j.FindApiKeys(db.Opts{
+		Prefix: tab.ApiKeysUserId{UserId: &su.UserId},
+	})
+	if err != nil {
+		return status.Internal(err, "can't find api keys")
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.ApiKeys = aks
+	return nil
+}
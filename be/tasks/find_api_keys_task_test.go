@@ -0,0 +1,62 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func TestFindApiKeys(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	ak := u.CreateApiKey("key")
+	other := c.CreateUser()
+	other.CreateApiKey("otherkey")
+
+	task := &FindApiKeysTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if len(task.ApiKeys) != 1 || task.ApiKeys[0].ApiKeyId != ak.ApiKeyId {
+		t.Error("bad api keys", task.ApiKeys)
+	}
+}
+
+func TestFindApiKeys_MissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &FindApiKeysTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
+
+func TestFindApiKeys_CantUseApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	u.CreateApiKey("key", schema.User_PIC_INDEX)
+
+	task := &FindApiKeysTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.PermissionDenied(nil, "can't find api keys with api key")
+	compareStatus(t, sts, expected)
+}
@@ -0,0 +1,141 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// maxAuditLogScan is the most entries a single find will look at.  Filters that can't use an index
+// may return fewer entries than requested, along with where to continue from.
+const maxAuditLogScan = 10000
+
+var _ Task = &FindAuditLogTask{}
+
+// FindAuditLogTask finds audit log entries, newest first.  The filters are combined, and zero
+// valued filters are ignored.
+type FindAuditLogTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	ActorUserId  int64
+	TargetUserId int64
+	TargetPicId  int64
+	Action       schema.AuditLog_Action
+	// StartAuditLogId is the newest entry to return.  If 0, the newest entries are returned.
+	StartAuditLogId int64
+	// MaxAuditLogs is the max number of entries to return.  If 0, the configured default is used.
+	MaxAuditLogs int64
+
+	// Results
+	AuditLogs []*schema.AuditLog
+	// NextAuditLogId is where the next page starts.  It is 0 if there are no more entries.
+	NextAuditLogId int64
+}
+
+func (t *FindAuditLogTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_AUDIT_LOG_READ); sts != nil {
+		return sts
+	}
+	if t.MaxAuditLogs < 0 {
+		return status.InvalidArgument(nil, "negative max audit logs")
+	}
+	if t.StartAuditLogId < 0 {
+		return status.InvalidArgument(nil, "bad start audit log id")
+	}
+	_, overmax := getMaxConf(t.MaxAuditLogs, conf.DefaultFindAuditLogs, conf.MaxFindAuditLogs)
+
+	minId, maxId := int64(0), int64(math.MaxInt64)
+	if t.StartAuditLogId != 0 {
+		maxId = t.StartAuditLogId
+	}
+	key := t.auditLogKey
+	opts := db.Opts{
+		StartInc: key(&minId),
+		StopInc:  key(&maxId),
+		Reverse:  true,
+		Limit:    int(overmax),
+		Lock:     db.LockNone,
+	}
+	var found []*schema.AuditLog
+	var scanned int
+	var lastId int64
+	for {
+		als, err := j.FindAuditLogs(opts)
+		if err != nil {
+			return status.Internal(err, "can't find audit logs")
+		}
+		for _, al := range als {
+			if t.matches(al) {
+				found = append(found, al)
+			}
+		}
+		scanned += len(als)
+		if len(found) >= int(overmax) || len(als) != opts.Limit {
+			lastId = 0
+			break
+		}
+		lastId = als[len(als)-1].AuditLogId
+		if scanned >= maxAuditLogScan {
+			break
+		}
+		opts.StopInc = nil
+		opts.StopEx = key(&lastId)
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	if n := len(found); n > 0 && int64(n) >= overmax {
+		t.AuditLogs = found[:overmax-1]
+		t.NextAuditLogId = found[overmax-1].AuditLogId
+	} else {
+		t.AuditLogs = found
+		if lastId > 1 {
+			// The scan limit was reached before finding enough entries.
+			t.NextAuditLogId = lastId - 1
+		}
+	}
+	return nil
+}
+
+// auditLogKey builds a key on the most selective index for the filters.
+func (t *FindAuditLogTask) auditLogKey(id *int64) db.Idx {
+	switch {
+	case t.TargetPicId != 0:
+		return tab.AuditLogsTargetPicId{TargetPicId: &t.TargetPicId, Id: id}
+	case t.TargetUserId != 0:
+		return tab.AuditLogsTargetUserId{TargetUserId: &t.TargetUserId, Id: id}
+	case t.ActorUserId != 0:
+		return tab.AuditLogsActorUserId{ActorUserId: &t.ActorUserId, Id: id}
+	case t.Action != schema.AuditLog_UNKNOWN:
+		return tab.AuditLogsAction{Action: &t.Action, Id: id}
+	default:
+		return tab.AuditLogsPrimary{Id: id}
+	}
+}
+
+func (t *FindAuditLogTask) matches(al *schema.AuditLog) bool {
+	return (t.ActorUserId == 0 || al.ActorUserId == t.ActorUserId) &&
+		(t.TargetUserId == 0 || al.TargetUserId == t.TargetUserId) &&
+		(t.TargetPicId == 0 || al.TargetPicId == t.TargetPicId) &&
+		(t.Action == schema.AuditLog_UNKNOWN || al.Action == t.Action)
+}
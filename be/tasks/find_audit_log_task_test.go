@@ -0,0 +1,153 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+// AuditLogs returns all audit log entries, oldest first.
+func (c *TestContainer) AuditLogs() []*schema.AuditLog {
+	j := c.Job()
+	defer j.Rollback()
+	als, err := j.FindAuditLogs(db.Opts{
+		Prefix: tab.AuditLogsPrimary{},
+	})
+	if err != nil {
+		c.T.Fatal(err)
+	}
+	return als
+}
+
+func (c *TestContainer) CreateAuditLog(al *schema.AuditLog) *schema.AuditLog {
+	c.AutoJob(func(j *tab.Job) error {
+		return insertAuditLog(j, al, nil, nil, nil, time.Now())
+	})
+	return al
+}
+
+func TestFindAuditLog(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_AUDIT_LOG_READ)
+	u.Update()
+
+	al1 := c.CreateAuditLog(&schema.AuditLog{Action: schema.AuditLog_PURGE_PIC, TargetPicId: 5})
+	al2 := c.CreateAuditLog(&schema.AuditLog{Action: schema.AuditLog_SUSPEND_USER, TargetUserId: 6})
+	al3 := c.CreateAuditLog(&schema.AuditLog{Action: schema.AuditLog_PURGE_PIC, TargetPicId: 7})
+
+	task := &FindAuditLogTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.AuditLogs) != 3 || task.AuditLogs[0].AuditLogId != al3.AuditLogId ||
+		task.AuditLogs[2].AuditLogId != al1.AuditLogId {
+		t.Error("wrong audit logs", task.AuditLogs)
+	}
+	if task.NextAuditLogId != 0 {
+		t.Error("expected no next id", task.NextAuditLogId)
+	}
+
+	task = &FindAuditLogTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Action: schema.AuditLog_PURGE_PIC,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.AuditLogs) != 2 || task.AuditLogs[0].AuditLogId != al3.AuditLogId ||
+		task.AuditLogs[1].AuditLogId != al1.AuditLogId {
+		t.Error("wrong audit logs", task.AuditLogs)
+	}
+
+	task = &FindAuditLogTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		TargetUserId: 6,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.AuditLogs) != 1 || task.AuditLogs[0].AuditLogId != al2.AuditLogId {
+		t.Error("wrong audit logs", task.AuditLogs)
+	}
+}
+
+func TestFindAuditLog_Paging(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_AUDIT_LOG_READ)
+	u.Update()
+
+	al1 := c.CreateAuditLog(&schema.AuditLog{Action: schema.AuditLog_PURGE_PIC})
+	al2 := c.CreateAuditLog(&schema.AuditLog{Action: schema.AuditLog_PURGE_PIC})
+	al3 := c.CreateAuditLog(&schema.AuditLog{Action: schema.AuditLog_PURGE_PIC})
+
+	task := &FindAuditLogTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		MaxAuditLogs: 2,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.AuditLogs) != 2 || task.AuditLogs[0].AuditLogId != al3.AuditLogId ||
+		task.AuditLogs[1].AuditLogId != al2.AuditLogId {
+		t.Error("wrong audit logs", task.AuditLogs)
+	}
+	if task.NextAuditLogId != al1.AuditLogId {
+		t.Error("wrong next id", task.NextAuditLogId, al1.AuditLogId)
+	}
+
+	task = &FindAuditLogTask{
+		Beg:             c.DB(),
+		Now:             time.Now,
+		StartAuditLogId: task.NextAuditLogId,
+		MaxAuditLogs:    2,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.AuditLogs) != 1 || task.AuditLogs[0].AuditLogId != al1.AuditLogId {
+		t.Error("wrong audit logs", task.AuditLogs)
+	}
+	if task.NextAuditLogId != 0 {
+		t.Error("expected no next id", task.NextAuditLogId)
+	}
+}
+
+func TestFindAuditLog_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &FindAuditLogTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing cap"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
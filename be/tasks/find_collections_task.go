@@ -0,0 +1,69 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &FindCollectionsTask{}
+
+// FindCollectionsTask finds the collections of a user, oldest first.  Private collections are
+// only found for their owner.
+type FindCollectionsTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// UserId is the owner of the collections.  If 0, the subject user is used.
+	UserId int64
+
+	// Results
+	Collections []*schema.Collection
+}
+
+func (t *FindCollectionsTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_INDEX); sts != nil {
+		return sts
+	}
+	userId := t.UserId
+	if userId == 0 {
+		if su == nil {
+			return status.Unauthenticated(nil, "missing user")
+		}
+		userId = su.UserId
+	}
+
+	cs, err := j.FindCollections(db.Opts{
+		Prefix: tab.CollectionsUserId{UserId: &userId},
+		Lock:   db.LockNone,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find collections")
+	}
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	for _, c := range cs {
+		if canReadCollection(c, su) {
+			t.Collections = append(t.Collections, c)
+		}
+	}
+	return nil
+}
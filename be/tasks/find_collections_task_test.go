@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestFindCollections(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	owner := c.CreateUser()
+	owner.User.Capability = append(owner.User.Capability, schema.User_PIC_INDEX)
+	owner.Update()
+	viewer := c.CreateUser()
+	viewer.User.Capability = append(viewer.User.Capability, schema.User_PIC_INDEX)
+	viewer.Update()
+	private := owner.CreateCollection("Private", schema.Collection_PRIVATE)
+	public := owner.CreateCollection("Public", schema.Collection_PUBLIC)
+
+	task := &FindCollectionsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(owner.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if cols := task.Collections; len(cols) != 2 ||
+		cols[0].CollectionId != private.CollectionId || cols[1].CollectionId != public.CollectionId {
+		t.Error("wrong collections", cols)
+	}
+
+	task = &FindCollectionsTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		UserId: owner.User.UserId,
+	}
+	if sts := new(TaskRunner).Run(viewer.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if cols := task.Collections; len(cols) != 1 || cols[0].CollectionId != public.CollectionId {
+		t.Error("wrong collections", cols)
+	}
+}
@@ -0,0 +1,311 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+const (
+	// maxFeedPicScan is the most pics a single find will look at.  Pics aren't indexed by uploader or
+	// tag, so a find may return fewer pics than requested, along with where to continue from.
+	maxFeedPicScan = 1000
+	// feedPicScanBatch is how many pics are read from the index at a time.
+	feedPicScanBatch = 100
+	// maxFeedPicTagScan is the most pic tags read at once for a batch of pics.  Batches with ids too
+	// far apart fall back to finding the tags of each pic.
+	maxFeedPicTagScan = 10 * feedPicScanBatch
+)
+
+var _ Task = &FindFeedPicsTask{}
+
+// FindFeedPicsTask finds the pics uploaded by the users, or tagged with the tags, that the subject
+// user follows.  Pics are in the same order as ReadIndexPicsTask.
+type FindFeedPicsTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// Only get pics with Pic Id <= than this.  If unset, the latest pics will be returned.
+	StartId int64
+	// MaxPics is the maximum number of pics to return.  If unset, a default is used.
+	MaxPics int64
+	// Ascending determines the order of pics returned.
+	Ascending bool
+
+	// Results
+	UnfilteredPics []*schema.Pic
+	// Same as pics, but with User info removed based on capability
+	Pics []*schema.Pic
+
+	// NextId and PrevId are where the adjacent pages start.  If the scan limit is reached, they may
+	// be pics that aren't in the feed.
+	NextId, PrevId int64
+}
+
+func (t *FindFeedPicsTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_INDEX); sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_FOLLOW_CREATE); sts != nil {
+		return sts
+	}
+	_, overmax, sts := getAndValidateMaxPics(conf, t.MaxPics)
+	if sts != nil {
+		return sts
+	}
+
+	m, sts := newFeedMatcher(j, su.UserId)
+	if sts != nil {
+		return sts
+	}
+	if len(m.userIds) == 0 && len(m.tagIds) == 0 {
+		if err := j.Rollback(); err != nil {
+			return status.Internal(err, "can't rollback job")
+		}
+		return nil
+	}
+
+	var startPic *schema.Pic
+	if t.StartId != 0 {
+		if startPic, sts = lookupStartPic(j, t.StartId, t.Ascending); sts != nil {
+			return sts
+		}
+	}
+
+	minIndexOrder := int64(defaultAscIndexId)
+	maxIndexOrder := int64(defaultDescIndexId)
+	minIndexOrderPicId := int64(0)
+	maxIndexOrderPicId := int64(math.MaxInt64)
+	if startPic != nil {
+		if t.Ascending {
+			minIndexOrder = startPic.NonHiddenIndexOrder()
+			minIndexOrderPicId = startPic.PicId
+		} else {
+			maxIndexOrder = startPic.NonHiddenIndexOrder()
+			maxIndexOrderPicId = startPic.PicId + 1 // Stop is exclusive, we want inclusive
+		}
+	}
+
+	pics, contId, sts := m.scan(db.Opts{
+		StartInc: tab.PicsIndexOrder{
+			IndexOrder: &minIndexOrder,
+			Id:         &minIndexOrderPicId,
+		},
+		StopEx: tab.PicsIndexOrder{
+			IndexOrder: &maxIndexOrder,
+			Id:         &maxIndexOrderPicId,
+		},
+		Reverse: !t.Ascending,
+	}, int(overmax))
+	if sts != nil {
+		return sts
+	}
+
+	var prevPicId int64
+	if startPic != nil {
+		if t.Ascending {
+			maxIndexOrder, maxIndexOrderPicId = minIndexOrder, minIndexOrderPicId
+			minIndexOrder, minIndexOrderPicId = defaultAscIndexId, 0
+		} else {
+			minIndexOrder, minIndexOrderPicId = maxIndexOrder, maxIndexOrderPicId
+			maxIndexOrder, maxIndexOrderPicId = defaultDescIndexId, math.MaxInt64
+		}
+		prevPics, prevContId, sts := m.scan(db.Opts{
+			StartInc: tab.PicsIndexOrder{
+				IndexOrder: &minIndexOrder,
+				Id:         &minIndexOrderPicId,
+			},
+			StopEx: tab.PicsIndexOrder{
+				IndexOrder: &maxIndexOrder,
+				Id:         &maxIndexOrderPicId,
+			},
+			Reverse: t.Ascending,
+		}, 1)
+		if sts != nil {
+			return sts
+		}
+		if len(prevPics) > 0 {
+			prevPicId = prevPics[0].PicId
+		} else {
+			prevPicId = prevContId
+		}
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	if n := len(pics); n > 0 && int64(n) == overmax {
+		t.UnfilteredPics = pics[:n-1]
+		t.NextId = pics[n-1].PicId
+	} else {
+		t.UnfilteredPics = pics
+		t.NextId = contId
+	}
+	t.PrevId = prevPicId
+	t.Pics = filterPics(t.UnfilteredPics, su, conf)
+
+	return nil
+}
+
+// feedMatcher decides which pics are in a user's feed.
+type feedMatcher struct {
+	j       *tab.Job
+	userIds map[int64]bool
+	tagIds  map[int64]bool
+}
+
+func newFeedMatcher(j *tab.Job, userId int64) (*feedMatcher, status.S) {
+	ufs, sts := findUserFollows(j, userId, db.LockNone)
+	if sts != nil {
+		return nil, sts
+	}
+	tfs, sts := findTagFollows(j, userId, db.LockNone)
+	if sts != nil {
+		return nil, sts
+	}
+	m := &feedMatcher{
+		j:       j,
+		userIds: make(map[int64]bool, len(ufs)),
+		tagIds:  make(map[int64]bool, len(tfs)),
+	}
+	for _, uf := range ufs {
+		m.userIds[uf.FolloweeUserId] = true
+	}
+	for _, tf := range tfs {
+		uniq := tf.TagNameCol()
+		ts, err := j.FindTags(db.Opts{
+			Prefix: tab.TagsName{&uniq},
+			Limit:  1,
+			Lock:   db.LockNone,
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't find tags")
+		}
+		// Followed tags may not be on any pics.
+		if len(ts) == 1 {
+			m.tagIds[ts[0].TagId] = true
+		}
+	}
+	return m, nil
+}
+
+// matches finds which of pics are in the feed, returning their ids.  Pics in index order usually
+// have nearby ids, so the tags of all of them are found at once using the range of their ids.
+func (m *feedMatcher) matches(pics []*schema.Pic) (map[int64]bool, status.S) {
+	matched := make(map[int64]bool)
+	unmatched := make(map[int64]bool)
+	minPicId, maxPicId := int64(math.MaxInt64), int64(math.MinInt64)
+	for _, p := range pics {
+		for _, s := range p.Source {
+			if m.userIds[s.UserId] {
+				matched[p.PicId] = true
+				break
+			}
+		}
+		if !matched[p.PicId] {
+			unmatched[p.PicId] = true
+			if p.PicId < minPicId {
+				minPicId = p.PicId
+			}
+			if p.PicId > maxPicId {
+				maxPicId = p.PicId
+			}
+		}
+	}
+	if len(m.tagIds) == 0 || len(unmatched) == 0 {
+		return matched, nil
+	}
+
+	pts, err := m.j.FindPicTags(db.Opts{
+		StartInc: tab.PicTagsPrimary{PicId: &minPicId},
+		StopInc:  tab.PicTagsPrimary{PicId: &maxPicId},
+		Lock:     db.LockNone,
+		Limit:    maxFeedPicTagScan + 1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pic tags")
+	}
+	if len(pts) > maxFeedPicTagScan {
+		pts = nil
+		for picId := range unmatched {
+			picId := picId
+			ppts, err := m.j.FindPicTags(db.Opts{
+				Prefix: tab.PicTagsPrimary{PicId: &picId},
+				Lock:   db.LockNone,
+			})
+			if err != nil {
+				return nil, status.Internal(err, "can't find pic tags")
+			}
+			pts = append(pts, ppts...)
+		}
+	}
+	for _, pt := range pts {
+		if unmatched[pt.PicId] && m.tagIds[pt.TagId] {
+			matched[pt.PicId] = true
+		}
+	}
+	return matched, nil
+}
+
+// scan finds up to limit pics in the feed, using the range and direction from opts.  If the scan
+// limit is reached before finding enough pics, the id of the next unscanned pic is returned.
+func (m *feedMatcher) scan(opts db.Opts, limit int) (_ []*schema.Pic, contId int64, _ status.S) {
+	opts.Limit = feedPicScanBatch
+	opts.Lock = db.LockNone
+	var found []*schema.Pic
+	var scanned int
+	for {
+		pics, err := m.j.FindPics(opts)
+		if err != nil {
+			return nil, 0, status.Internal(err, "can't find pics")
+		}
+		matched, sts := m.matches(pics)
+		if sts != nil {
+			return nil, 0, sts
+		}
+		for _, p := range pics {
+			if scanned >= maxFeedPicScan {
+				return found, p.PicId, nil
+			}
+			scanned++
+			if matched[p.PicId] {
+				found = append(found, p)
+				if len(found) >= limit {
+					return found, 0, nil
+				}
+			}
+		}
+		if len(pics) != opts.Limit {
+			return found, 0, nil
+		}
+		last := pics[len(pics)-1]
+		lastIndexOrder, lastPicId := last.IndexOrder(), last.PicId
+		if opts.Reverse {
+			opts.StopEx = tab.PicsIndexOrder{IndexOrder: &lastIndexOrder, Id: &lastPicId}
+		} else {
+			lastPicId++
+			opts.StartInc = tab.PicsIndexOrder{IndexOrder: &lastIndexOrder, Id: &lastPicId}
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func TestFindFeedPics(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX, schema.User_FOLLOW_CREATE)
+	u.Update()
+	followee := c.CreateUser()
+	u.FollowUser(followee)
+	tag := c.CreateTag()
+	u.FollowTag(tag.Tag.Name)
+
+	uploaded := c.CreatePic()
+	uploaded.Pic.Source[0].UserId = followee.User.UserId
+	uploaded.Update()
+	c.CreatePic()
+	tagged := c.CreatePic()
+	c.CreatePicTag(tagged, tag)
+	c.CreatePic()
+
+	task := &FindFeedPicsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 2 ||
+		task.Pics[0].PicId != tagged.Pic.PicId || task.Pics[1].PicId != uploaded.Pic.PicId {
+		t.Error("wrong pics", task.Pics)
+	}
+	if task.NextId != 0 || task.PrevId != 0 {
+		t.Error("expected no more pages", task.NextId, task.PrevId)
+	}
+}
+
+func TestFindFeedPics_Pages(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX, schema.User_FOLLOW_CREATE)
+	u.Update()
+	tag := c.CreateTag()
+	u.FollowTag(tag.Tag.Name)
+
+	p1 := c.CreatePic()
+	c.CreatePicTag(p1, tag)
+	c.CreatePic()
+	p3 := c.CreatePic()
+	c.CreatePicTag(p3, tag)
+
+	task := &FindFeedPicsTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		MaxPics: 1,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 1 || task.Pics[0].PicId != p3.Pic.PicId {
+		t.Error("wrong pics", task.Pics)
+	}
+	if have, want := task.NextId, p1.Pic.PicId; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	task = &FindFeedPicsTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		MaxPics: 1,
+		StartId: p1.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 1 || task.Pics[0].PicId != p1.Pic.PicId {
+		t.Error("wrong pics", task.Pics)
+	}
+	if have, want := task.NextId, int64(0); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := task.PrevId, p3.Pic.PicId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindFeedPics_NoFollows(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX, schema.User_FOLLOW_CREATE)
+	u.Update()
+	c.CreatePic()
+
+	task := &FindFeedPicsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 0 {
+		t.Error("expected no pics", task.Pics)
+	}
+}
+
+func TestFindFeedPics_ManyPicTags(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX, schema.User_FOLLOW_CREATE)
+	u.Update()
+	tag := c.CreateTag()
+	u.FollowTag(tag.Tag.Name)
+
+	first := c.CreatePic()
+	c.CreatePicTag(first, tag)
+	// Too many tags to read at once, forcing the tags of each pic to be found separately.
+	crowded := c.CreatePic()
+	c.AutoJob(func(j *tab.Job) error {
+		for i := 0; i < maxFeedPicTagScan; i++ {
+			if err := j.InsertPicTag(&schema.PicTag{
+				PicId: crowded.Pic.PicId,
+				TagId: int64(1000000 + i),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	last := c.CreatePic()
+	c.CreatePicTag(last, tag)
+
+	task := &FindFeedPicsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 2 ||
+		task.Pics[0].PicId != last.Pic.PicId || task.Pics[1].PicId != first.Pic.PicId {
+		t.Error("wrong pics", task.Pics)
+	}
+}
+
+func TestFindFeedPics_MissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &FindFeedPicsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
+
+func TestFindFeedPics_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	task := &FindFeedPicsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing cap"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
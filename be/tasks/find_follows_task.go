@@ -0,0 +1,56 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &FindFollowsTask{}
+
+// FindFollowsTask finds the users and tags the subject user follows.
+type FindFollowsTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Results
+	// UserFollows are in followee user id order.
+	UserFollows []*schema.UserFollow
+	// TagFollows are in unique tag name order.
+	TagFollows []*schema.TagFollow
+}
+
+func (t *FindFollowsTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	// No capability is needed, so that users who can no longer follow can still unfollow.
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+
+	ufs, sts := findUserFollows(j, su.UserId, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+	tfs, sts := findTagFollows(j, su.UserId, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.UserFollows = ufs
+	t.TagFollows = tfs
+	return nil
+}
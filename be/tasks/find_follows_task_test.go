@@ -0,0 +1,55 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestFindFollows(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+	followee := c.CreateUser()
+	u.FollowUser(followee)
+	u.FollowTag("Dogs")
+	u.FollowTag("cats")
+
+	task := &FindFollowsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.UserFollows) != 1 || task.UserFollows[0].FolloweeUserId != followee.User.UserId {
+		t.Error("wrong user follows", task.UserFollows)
+	}
+	if len(task.TagFollows) != 2 ||
+		task.TagFollows[0].TagName != "cats" || task.TagFollows[1].TagName != "Dogs" {
+		t.Error("wrong tag follows", task.TagFollows)
+	}
+}
+
+func TestFindFollows_Anonymous(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &FindFollowsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,99 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &FindPicFavoritesTask{}
+
+// FindPicFavoritesTask finds the pics the subject user has favorited, most recently favorited
+// first.  Favorites of deleted pics are skipped.
+type FindPicFavoritesTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// StartPicFavoriteId is the newest favorite to return.  If 0, the newest favorites are returned.
+	StartPicFavoriteId int64
+	// MaxPics is the max number of pics to return.  If 0, the configured default is used.
+	MaxPics int64
+
+	// Results
+	UnfilteredPics []*schema.Pic
+	Pics           []*schema.Pic
+	// NextPicFavoriteId is where the next page starts.  It is 0 if there are no more favorites.
+	NextPicFavoriteId int64
+}
+
+func (t *FindPicFavoritesTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_INDEX); sts != nil {
+		return sts
+	}
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if t.StartPicFavoriteId < 0 {
+		return status.InvalidArgument(nil, "bad start pic favorite id")
+	}
+	_, overmax, sts := getAndValidateMaxPics(conf, t.MaxPics)
+	if sts != nil {
+		return sts
+	}
+
+	minId, maxId := int64(0), int64(math.MaxInt64)
+	if t.StartPicFavoriteId != 0 {
+		maxId = t.StartPicFavoriteId
+	}
+	pfs, err := j.FindPicFavorites(db.Opts{
+		StartInc: tab.PicFavoritesUserId{UserId: &su.UserId, Id: &minId},
+		StopInc:  tab.PicFavoritesUserId{UserId: &su.UserId, Id: &maxId},
+		Reverse:  true,
+		Limit:    int(overmax),
+		Lock:     db.LockNone,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic favorites")
+	}
+	if n := len(pfs); n > 0 && int64(n) == overmax {
+		t.NextPicFavoriteId = pfs[n-1].PicFavoriteId
+		pfs = pfs[:n-1]
+	}
+
+	for _, pf := range pfs {
+		pics, err := j.FindPics(db.Opts{
+			Prefix: tab.PicsPrimary{&pf.PicId},
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't lookup pic")
+		}
+		if len(pics) != 1 || pics[0].HardDeleted() {
+			continue
+		}
+		t.UnfilteredPics = append(t.UnfilteredPics, pics[0])
+	}
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.Pics = filterPics(t.UnfilteredPics, su, conf)
+	return nil
+}
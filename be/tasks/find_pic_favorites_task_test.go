@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestFindPicFavorites(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_FAVORITE_CREATE, schema.User_PIC_INDEX)
+	u.Update()
+	other := c.CreateUser()
+	other.User.Capability = append(other.User.Capability, schema.User_PIC_FAVORITE_CREATE)
+	other.Update()
+	p1, p2, p3 := c.CreatePic(), c.CreatePic(), c.CreatePic()
+	u.Favorite(p1)
+	u.Favorite(p3)
+	pf2 := u.Favorite(p2)
+	other.Favorite(p1)
+
+	task := &FindPicFavoritesTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		MaxPics: 2,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 2 || task.Pics[0].PicId != p2.Pic.PicId || task.Pics[1].PicId != p3.Pic.PicId {
+		t.Fatal("wrong pics", task.Pics)
+	}
+	if task.NextPicFavoriteId == 0 || task.NextPicFavoriteId >= pf2.PicFavoriteId {
+		t.Fatal("wrong next id", task.NextPicFavoriteId)
+	}
+
+	task = &FindPicFavoritesTask{
+		Beg:                c.DB(),
+		Now:                time.Now,
+		MaxPics:            2,
+		StartPicFavoriteId: task.NextPicFavoriteId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 1 || task.Pics[0].PicId != p1.Pic.PicId {
+		t.Error("wrong pics", task.Pics)
+	}
+	if task.NextPicFavoriteId != 0 {
+		t.Error("expected no next id", task.NextPicFavoriteId)
+	}
+}
+
+func TestFindPicFavorites_SkipsDeletedPics(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_FAVORITE_CREATE, schema.User_PIC_INDEX)
+	u.Update()
+	p := c.CreatePic()
+	u.Favorite(p)
+	nowts := schema.ToTspb(time.Now())
+	p.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: nowts,
+		ActualDeletedTs: nowts,
+	}
+	p.Update()
+
+	task := &FindPicFavoritesTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 0 {
+		t.Error("expected no pics", task.Pics)
+	}
+}
@@ -0,0 +1,190 @@
+package tasks
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// FindPicsByColorTask finds pics whose dominant color is close to a target color.
+type FindPicsByColorTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// Color is the target color, as 0xRRGGBB.
+	Color uint32
+	// Tolerance is how far each of the red, green, and blue values may be from the target color.
+	Tolerance int64
+
+	// Results
+	UnfilteredPics []*schema.Pic
+	// Same as pics, but with User info removed based on capability
+	Pics []*schema.Pic
+}
+
+const (
+	// maxColorIdentScan is the most colors a single find will look at.  Common colors with a wide
+	// tolerance could otherwise scan most pics.
+	maxColorIdentScan = 10000
+	// maxColorMatches is the most matches kept while scanning.  Only the closest are kept.
+	maxColorMatches = 1000
+)
+
+type colorMatch struct {
+	picId    int64
+	distance int64
+}
+
+func colorChannels(color uint32) [3]int64 {
+	return [3]int64{int64(color>>16) & 0xff, int64(color>>8) & 0xff, int64(color) & 0xff}
+}
+
+// colorChannelRange is the range of channel values within tolerance of target.
+func colorChannelRange(target, tolerance int64) (int64, int64) {
+	lo, hi := target-tolerance, target+tolerance
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > 0xff {
+		hi = 0xff
+	}
+	return lo, hi
+}
+
+// colorChannelScanOrder is the channel values within tolerance of target, closest first, so that
+// reaching the scan limit drops the farthest colors.
+func colorChannelScanOrder(target, tolerance int64) []int64 {
+	lo, hi := colorChannelRange(target, tolerance)
+	vals := []int64{target}
+	for d := int64(1); target-d >= lo || target+d <= hi; d++ {
+		if target-d >= lo {
+			vals = append(vals, target-d)
+		}
+		if target+d <= hi {
+			vals = append(vals, target+d)
+		}
+	}
+	return vals
+}
+
+// closestColorMatches sorts matches by distance, and keeps at most maxColorMatches of them.
+func closestColorMatches(matches []colorMatch) []colorMatch {
+	sort.SliceStable(matches, func(i, k int) bool {
+		if matches[i].distance != matches[k].distance {
+			return matches[i].distance < matches[k].distance
+		}
+		// Prefer newer pics
+		return matches[i].picId > matches[k].picId
+	})
+	if len(matches) > maxColorMatches {
+		matches = matches[:maxColorMatches]
+	}
+	return matches
+}
+
+func (t *FindPicsByColorTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, u, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(u, conf, schema.User_PIC_INDEX); sts != nil {
+		return sts
+	}
+	if t.Color > 0xffffff {
+		return status.InvalidArgument(nil, "bad color")
+	}
+	if t.Tolerance < 0 || t.Tolerance > 0xff {
+		return status.InvalidArgument(nil, "bad tolerance")
+	}
+	maxPics, _ := getMaxPics(0, conf)
+
+	target := colorChannels(t.Color)
+	greenLo, greenHi := colorChannelRange(target[1], t.Tolerance)
+
+	// Colors are indexed red, then green, then blue, so each red value in range is scanned for the
+	// green range.  Blue is checked while scanning.  Red values closest to the target are scanned
+	// first.
+	colorIdentType := schema.PicIdent_DOMINANT_COLOR
+	var matches []colorMatch
+	var scanned int
+	for _, red := range colorChannelScanOrder(target[0], t.Tolerance) {
+		if scanned >= maxColorIdentScan {
+			break
+		}
+		startValue := schema.ColorIdentValue(uint32(red)<<16 | uint32(greenLo)<<8)
+		stopValue := schema.ColorIdentValue(uint32(red)<<16 | uint32(greenHi)<<8 | 0xff)
+		err := j.ScanPicIdents(db.Opts{
+			StartInc: tab.PicIdentsIdent{Type: &colorIdentType, Value: &startValue},
+			StopInc:  tab.PicIdentsIdent{Type: &colorIdentType, Value: &stopValue},
+			Lock:     db.LockNone,
+			Limit:    maxColorIdentScan - scanned,
+		}, func(pi *schema.PicIdent) error {
+			scanned++
+			if len(pi.Value) != 3 {
+				return nil
+			}
+			var distance int64
+			for i, c := range pi.Value {
+				d := int64(c) - target[i]
+				if d < 0 {
+					d = -d
+				}
+				if d > t.Tolerance {
+					return nil
+				}
+				if d > distance {
+					distance = d
+				}
+			}
+			matches = append(matches, colorMatch{picId: pi.PicId, distance: distance})
+			// Only the closest matches are needed, so drop the rest once there are too many.
+			if len(matches) >= 2*maxColorMatches {
+				matches = closestColorMatches(matches)
+			}
+			return nil
+		})
+		if err != nil {
+			return status.Internal(err, "can't scan pic idents")
+		}
+	}
+	matches = closestColorMatches(matches)
+
+	var pics []*schema.Pic
+	for _, m := range matches {
+		if int64(len(pics)) >= maxPics {
+			break
+		}
+		ps, err := j.FindPics(db.Opts{
+			Prefix: tab.PicsPrimary{&m.picId},
+			Lock:   db.LockNone,
+		})
+		if err != nil {
+			return status.Internal(err, "can't lookup pic")
+		}
+		if len(ps) != 1 || ps[0].HardDeleted() {
+			continue
+		}
+		pics = append(pics, ps[0])
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.UnfilteredPics = pics
+	t.Pics = filterPics(pics, u, conf)
+	return nil
+}
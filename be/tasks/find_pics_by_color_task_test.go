@@ -0,0 +1,206 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func (p *TestPic) setColor(color uint32) {
+	p.Pic.Placeholder = &schema.Pic_Placeholder{
+		DominantColor: color,
+	}
+	p.Update()
+	p.c.AutoJob(func(j *tab.Job) error {
+		return j.InsertPicIdent(&schema.PicIdent{
+			PicId: p.Pic.PicId,
+			Type:  schema.PicIdent_DOMINANT_COLOR,
+			Value: schema.ColorIdentValue(color),
+		})
+	})
+}
+
+func TestFindPicsByColor(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	far := c.CreatePic()
+	far.setColor(0x108080)
+	close := c.CreatePic()
+	close.setColor(0x818283)
+	exact := c.CreatePic()
+	exact.setColor(0x808080)
+	wrongGreen := c.CreatePic()
+	wrongGreen.setColor(0x80ff80)
+	deleted := c.CreatePic()
+	deleted.setColor(0x808080)
+	deleted.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: deleted.Pic.CreatedTs,
+		ActualDeletedTs: deleted.Pic.CreatedTs,
+	}
+	deleted.Update()
+	c.CreatePic()
+
+	task := &FindPicsByColorTask{
+		Beg: c.DB(),
+		Now: time.Now,
+
+		Color:     0x808080,
+		Tolerance: 10,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	var have []int64
+	for _, p := range task.Pics {
+		have = append(have, p.PicId)
+	}
+	want := []int64{exact.Pic.PicId, close.Pic.PicId}
+	if len(have) != len(want) || have[0] != want[0] || have[1] != want[1] {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPicsByColor_ManyRedOnlyMatches(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	exact := c.CreatePic()
+	exact.setColor(0x808080)
+	// Enough colors with only a matching red value to use up the scan, and enough close colors to
+	// be dropped.  They don't need pics, since they shouldn't be looked up.
+	c.AutoJob(func(j *tab.Job) error {
+		for i := 0; i < maxColorIdentScan; i++ {
+			if err := j.InsertPicIdent(&schema.PicIdent{
+				PicId: int64(1000000 + i),
+				Type:  schema.PicIdent_DOMINANT_COLOR,
+				Value: schema.ColorIdentValue(0x800000 | uint32(i%0x40)),
+			}); err != nil {
+				return err
+			}
+		}
+		for i := 0; i < 2*maxColorMatches+1; i++ {
+			if err := j.InsertPicIdent(&schema.PicIdent{
+				PicId: int64(2000000 + i),
+				Type:  schema.PicIdent_DOMINANT_COLOR,
+				Value: schema.ColorIdentValue(0x818181),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	task := &FindPicsByColorTask{
+		Beg: c.DB(),
+		Now: time.Now,
+
+		Color:     0x808080,
+		Tolerance: 10,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 1 || task.Pics[0].PicId != exact.Pic.PicId {
+		t.Error("expected only the exact pic", task.Pics)
+	}
+}
+
+func TestFindPicsByColor_ScansClosestRedFirst(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	exact := c.CreatePic()
+	exact.setColor(0x808080)
+	// Enough matching colors at the edge of the tolerance to use up the scan.  They sort before the
+	// exact color in the index.
+	c.AutoJob(func(j *tab.Job) error {
+		for i := 0; i < maxColorIdentScan; i++ {
+			if err := j.InsertPicIdent(&schema.PicIdent{
+				PicId: int64(1000000 + i),
+				Type:  schema.PicIdent_DOMINANT_COLOR,
+				Value: schema.ColorIdentValue(0x768080),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	task := &FindPicsByColorTask{
+		Beg: c.DB(),
+		Now: time.Now,
+
+		Color:     0x808080,
+		Tolerance: 10,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pics) != 1 || task.Pics[0].PicId != exact.Pic.PicId {
+		t.Error("expected the exact pic", task.Pics)
+	}
+}
+
+func TestColorChannelScanOrder(t *testing.T) {
+	have := colorChannelScanOrder(1, 2)
+	want := []int64{1, 0, 2, 3}
+	if len(have) != len(want) {
+		t.Fatal("have", have, "want", want)
+	}
+	for i := range want {
+		if have[i] != want[i] {
+			t.Error("have", have, "want", want)
+		}
+	}
+}
+
+func TestFindPicsByColor_BadTolerance(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	task := &FindPicsByColorTask{
+		Beg: c.DB(),
+		Now: time.Now,
+
+		Tolerance: 256,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.InvalidArgument(nil, "bad tolerance")
+	compareStatus(t, sts, expected)
+}
+
+func TestFindPicsByColor_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &FindPicsByColorTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.PermissionDenied(nil, "missing cap PIC_INDEX")
+	compareStatus(t, sts, expected)
+}
@@ -0,0 +1,76 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+const maxFindPools = 50
+
+var _ Task = &FindPoolsTask{}
+
+// FindPoolsTask finds pools, most recently created first.
+type FindPoolsTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// StartPoolId is the newest pool to return.  If 0, the newest pools are returned.
+	StartPoolId int64
+
+	// Results
+	Pools []*schema.Pool
+	// NextPoolId is where the next page starts.  It is 0 if there are no more pools.
+	NextPoolId int64
+}
+
+func (t *FindPoolsTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_INDEX); sts != nil {
+		return sts
+	}
+	if t.StartPoolId < 0 {
+		return status.InvalidArgument(nil, "bad start pool id")
+	}
+
+	minId, maxId := int64(0), int64(math.MaxInt64)
+	if t.StartPoolId != 0 {
+		maxId = t.StartPoolId
+	}
+	ps, err := j.FindPools(db.Opts{
+		StartInc: tab.PoolsPrimary{&minId},
+		StopInc:  tab.PoolsPrimary{&maxId},
+		Reverse:  true,
+		Limit:    maxFindPools + 1,
+		Lock:     db.LockNone,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pools")
+	}
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	if len(ps) > maxFindPools {
+		t.NextPoolId = ps[maxFindPools].PoolId
+		ps = ps[:maxFindPools]
+	}
+	t.Pools = ps
+	return nil
+}
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestFindPools(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	pool1 := c.CreatePool("Chapter 1")
+	pool2 := c.CreatePool("Chapter 2")
+
+	task := &FindPoolsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pools) != 2 || task.Pools[0].PoolId != pool2.PoolId ||
+		task.Pools[1].PoolId != pool1.PoolId {
+		t.Error("wrong pools", task.Pools)
+	}
+	if task.NextPoolId != 0 {
+		t.Error("unexpected next pool", task.NextPoolId)
+	}
+
+	task = &FindPoolsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		StartPoolId: pool1.PoolId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.Pools) != 1 || task.Pools[0].PoolId != pool1.PoolId {
+		t.Error("wrong pools", task.Pools)
+	}
+}
@@ -0,0 +1,99 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/totp"
+)
+
+var _ Task = &FinishTotpEnrollmentTask{}
+
+// FinishTotpEnrollmentTask confirms a pending two-factor enrollment of the subject user, using a
+// code generated from the pending secret.  Once finished, codes are required at login, and
+// recovery codes are returned which may be used once each in place of a code.
+type FinishTotpEnrollmentTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+	// Rand is the source of recovery codes.  If nil, crypto/rand is used.
+	Rand io.Reader
+
+	// Inputs
+	Code string
+
+	// Results
+	User *schema.User
+	// RecoveryCode is shown to the user once.  Only the hashes are stored.
+	RecoveryCode []string
+}
+
+func (t *FinishTotpEnrollmentTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if t.Code == "" {
+		return status.InvalidArgument(nil, "missing totp code")
+	}
+
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&su.UserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "can't lookup user")
+	}
+	user := users[0]
+
+	if user.TotpEnrolled() {
+		return status.AlreadyExists(nil, "already enrolled in two-factor authentication")
+	}
+	if user.Totp == nil {
+		return status.FailedPrecondition(nil, "no pending two-factor enrollment")
+	}
+	step, ok := totp.Validate(user.Totp.Secret, normalizeTotpCode(t.Code), now, totpSkew)
+	if !ok {
+		return status.InvalidArgument(nil, "bad totp code")
+	}
+
+	var codes []string
+	var hashes [][]byte
+	for i := 0; i < totpRecoveryCodes; i++ {
+		code, sts := newSecretToken(t.Rand)
+		if sts != nil {
+			return sts
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, secretTokenHash(code))
+	}
+	user.Totp.ConfirmedTs = schema.ToTspb(now)
+	user.Totp.LastStep = step
+	user.Totp.RecoveryCodeHash = hashes
+	user.SetModifiedTime(now)
+
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.User = user
+	t.RecoveryCode = codes
+	return nil
+}
@@ -0,0 +1,88 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/totp"
+)
+
+func TestFinishTotpEnrollment(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Totp = &schema.User_Totp{
+		Secret: testTotpSecret,
+	}
+	u.Update()
+
+	now := time.Now()
+	task := &FinishTotpEnrollmentTask{
+		Beg:  c.DB(),
+		Now:  func() time.Time { return now },
+		Code: totp.Code(testTotpSecret, totp.Step(now)),
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if have, want := len(task.RecoveryCode), totpRecoveryCodes; have != want {
+		t.Error("have", have, "want", want)
+	}
+	u.Refresh()
+	if !u.User.TotpEnrolled() {
+		t.Error("expected enrollment", u.User.Totp)
+	}
+	if have, want := u.User.Totp.LastStep, totp.Step(now); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(u.User.Totp.RecoveryCodeHash) != totpRecoveryCodes ||
+		!bytes.Equal(u.User.Totp.RecoveryCodeHash[0], secretTokenHash(task.RecoveryCode[0])) {
+		t.Error("bad recovery codes", u.User.Totp.RecoveryCodeHash)
+	}
+}
+
+func TestFinishTotpEnrollment_BadCode(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Totp = &schema.User_Totp{
+		Secret: testTotpSecret,
+	}
+	u.Update()
+
+	task := &FinishTotpEnrollmentTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Code: "bogus",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.InvalidArgument(nil, "bad totp code")
+	compareStatus(t, sts, expected)
+
+	u.Refresh()
+	if u.User.TotpEnrolled() {
+		t.Error("enrollment should not be confirmed")
+	}
+}
+
+func TestFinishTotpEnrollment_NotStarted(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &FinishTotpEnrollmentTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Code: "123456",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.FailedPrecondition(nil, "no pending two-factor enrollment")
+	compareStatus(t, sts, expected)
+}
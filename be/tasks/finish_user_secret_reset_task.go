@@ -0,0 +1,98 @@
+package tasks
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &FinishUserSecretResetTask{}
+
+// FinishUserSecretResetTask sets a new secret for a user, using the reset token sent by
+// StartUserSecretResetTask.  The reset token may only be used once.  All existing tokens of the
+// user are revoked.
+type FinishUserSecretResetTask struct {
+	// Deps
+	Beg          tab.JobBeginner
+	Now          func() time.Time
+	HashPassword func([]byte) ([]byte, error)
+
+	// Inputs
+	Ident      string
+	ResetToken string
+	NewSecret  string
+
+	// Results
+	User *schema.User
+}
+
+func (t *FinishUserSecretResetTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, _, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ident, sts := normalizeUserIdent(t.Ident, conf)
+	if sts != nil {
+		return sts
+	}
+	if t.ResetToken == "" {
+		return status.InvalidArgument(nil, "missing reset token")
+	}
+	if sts := validateSecret(t.NewSecret); sts != nil {
+		return sts
+	}
+
+	keyident := schema.UserUniqueIdent(ident)
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersIdent{&keyident},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "bad reset token")
+	}
+	user := users[0]
+	pr := user.PasswordReset
+	if pr == nil {
+		return status.Unauthenticated(nil, "bad reset token")
+	}
+	if subtle.ConstantTimeCompare(secretTokenHash(t.ResetToken), pr.TokenHash) != 1 {
+		return status.Unauthenticated(nil, "bad reset token")
+	}
+	if pr.ExpireTs == nil || !now.Before(schema.ToTime(pr.ExpireTs)) {
+		return status.Unauthenticated(nil, "reset token expired")
+	}
+
+	hashed, err := hashPassword(t.HashPassword, conf, []byte(t.NewSecret))
+	if err != nil {
+		return status.Internal(err, "can't generate password")
+	}
+	user.Secret = hashed
+	user.PasswordReset = nil
+	user.UserToken = nil
+	user.SetModifiedTime(now)
+
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.User = user
+	return nil
+}
@@ -0,0 +1,117 @@
+package tasks
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func (u *TestUser) setResetToken(token string, expire time.Time) {
+	tokenHash := sha256.Sum256([]byte(token))
+	u.User.PasswordReset = &schema.User_PasswordReset{
+		TokenHash: tokenHash[:],
+		CreatedTs: schema.ToTspb(time.Now()),
+		ExpireTs:  schema.ToTspb(expire),
+	}
+	u.Update()
+}
+
+func TestFinishUserSecretReset(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.setResetToken("token", time.Now().Add(time.Hour))
+
+	task := &FinishUserSecretResetTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		HashPassword: minCostHashPassword,
+		Ident:        u.User.Ident,
+		ResetToken:   "token",
+		NewSecret:    "newsecret",
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if err := bcrypt.CompareHashAndPassword(u.User.Secret, []byte("newsecret")); err != nil {
+		t.Error("secret not updated", err)
+	}
+	if u.User.PasswordReset != nil {
+		t.Error("reset should be cleared", u.User.PasswordReset)
+	}
+	if len(u.User.UserToken) != 0 {
+		t.Error("tokens should be revoked", u.User.UserToken)
+	}
+
+	// Reset tokens are single use.
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "bad reset token")
+	compareStatus(t, sts, expected)
+}
+
+func TestFinishUserSecretReset_WrongToken(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.setResetToken("token", time.Now().Add(time.Hour))
+
+	task := &FinishUserSecretResetTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Ident:      u.User.Ident,
+		ResetToken: "nekot",
+		NewSecret:  "newsecret",
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "bad reset token")
+	compareStatus(t, sts, expected)
+
+	u.Refresh()
+	if u.User.PasswordReset == nil {
+		t.Error("reset should remain")
+	}
+}
+
+func TestFinishUserSecretReset_Expired(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.setResetToken("token", time.Now().Add(-time.Second))
+
+	task := &FinishUserSecretResetTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Ident:      u.User.Ident,
+		ResetToken: "token",
+		NewSecret:  "newsecret",
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "reset token expired")
+	compareStatus(t, sts, expected)
+}
+
+func TestFinishUserSecretReset_UnknownIdent(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &FinishUserSecretResetTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Ident:      "nobody@example.com",
+		ResetToken: "token",
+		NewSecret:  "newsecret",
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "bad reset token")
+	compareStatus(t, sts, expected)
+}
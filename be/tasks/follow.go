@@ -0,0 +1,86 @@
+package tasks
+
+import (
+	"math"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// findUserFollows finds the users that userId follows.
+func findUserFollows(j *tab.Job, userId int64, lock db.Lock) ([]*schema.UserFollow, status.S) {
+	ufs, err := j.FindUserFollows(db.Opts{
+		Prefix: tab.UserFollowsPrimary{UserId: &userId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find user follows")
+	}
+	return ufs, nil
+}
+
+// findTagFollows finds the tags that userId follows.
+func findTagFollows(j *tab.Job, userId int64, lock db.Lock) ([]*schema.TagFollow, status.S) {
+	tfs, err := j.FindTagFollows(db.Opts{
+		Prefix: tab.TagFollowsPrimary{UserId: &userId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find tag follows")
+	}
+	return tfs, nil
+}
+
+// validateCanFollowMore checks that userId is below the max number of follows.  The user is
+// locked, so concurrent follows can't go over the max.
+func validateCanFollowMore(j *tab.Job, userId int64, conf *schema.Configuration) status.S {
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&userId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "can't lookup user")
+	}
+	if conf.MaxFollows == nil {
+		return nil
+	}
+	ufs, sts := findUserFollows(j, userId, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+	tfs, sts := findTagFollows(j, userId, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+	if int64(len(ufs)+len(tfs)) >= conf.MaxFollows.Value {
+		return status.ResourceExhausted(nil, "too many follows")
+	}
+	return nil
+}
+
+// cleanFollowTagName validates and normalizes a tag name the same way as tagging a pic.
+func cleanFollowTagName(rawTagName string, conf *schema.Configuration) (
+	name, uniq string, _ status.S) {
+	var minTagLen, maxTagLen int64
+	if conf.MinTagLength != nil {
+		minTagLen = conf.MinTagLength.Value
+	} else {
+		minTagLen = math.MinInt64
+	}
+	if conf.MaxTagLength != nil {
+		maxTagLen = conf.MaxTagLength.Value
+	} else {
+		maxTagLen = math.MaxInt64
+	}
+	names, sts := cleanTagNames([]string{rawTagName}, minTagLen, maxTagLen)
+	if sts != nil {
+		return "", "", sts
+	}
+	return names[0].name, names[0].uniq, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
 	tab "pixur.org/pixur/be/schema/tables"
@@ -21,6 +23,8 @@ type HardDeletePicTask struct {
 
 	// input
 	PicId int64
+	// Why the pic is being deleted.  It is recorded in the audit log.  Optional.
+	Reason string
 }
 
 func (t *HardDeletePicTask) Run(ctx context.Context) (stscap status.S) {
@@ -38,6 +42,10 @@ func (t *HardDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 	if sts := validateCapability(u, conf, schema.User_PIC_HARD_DELETE); sts != nil {
 		return sts
 	}
+	reason, sts := validateAuditReason(t.Reason)
+	if sts != nil {
+		return sts
+	}
 
 	pics, err := j.FindPics(db.Opts{
 		Prefix: tab.PicsPrimary{&t.PicId},
@@ -51,6 +59,7 @@ func (t *HardDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 		return status.NotFound(nil, "can't lookup pic")
 	}
 	p := pics[0]
+	before := proto.Clone(p)
 
 	nowpb := schema.ToTspb(now)
 
@@ -75,6 +84,14 @@ func (t *HardDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 	if err := j.UpdatePic(p); err != nil {
 		return status.Internal(err, "can't update pic")
 	}
+	al := &schema.AuditLog{
+		Action:      schema.AuditLog_HARD_DELETE_PIC,
+		TargetPicId: p.PicId,
+		Reason:      reason,
+	}
+	if sts := insertAuditLog(j, al, u, before, p, now); sts != nil {
+		return sts
+	}
 
 	if err := j.Commit(); err != nil {
 		return status.Internal(err, "can't commit job")
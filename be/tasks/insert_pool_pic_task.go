@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &InsertPoolPicTask{}
+
+// InsertPoolPicTask adds a pic to a pool.
+type InsertPoolPicTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	PoolId int64
+	PicId  int64
+	// BeforePicId is the pic in the pool to insert in front of.  If 0, the pic is added to the end.
+	BeforePicId int64
+
+	// Results
+	Pool *schema.Pool
+}
+
+func (t *InsertPoolPicTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_POOL_EDIT); sts != nil {
+		return sts
+	}
+	p, sts := lookupPool(j, t.PoolId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&t.PicId},
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't lookup pic")
+	}
+	if len(pics) != 1 {
+		return status.NotFound(nil, "can't find pic")
+	}
+	if pics[0].HardDeleted() {
+		return status.InvalidArgument(nil, "can't add deleted pic")
+	}
+
+	pps, sts := findPoolPics(j, p.PoolId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	if poolPicIndex(pps, t.PicId) != -1 {
+		return status.AlreadyExists(nil, "pic already in pool")
+	}
+	if conf.MaxPoolPics != nil && int64(len(pps)) >= conf.MaxPoolPics.Value {
+		return status.ResourceExhausted(nil, "too many pics in pool")
+	}
+	pps, sts = insertPoolPicBefore(pps, &schema.PoolPic{
+		PoolId: p.PoolId,
+		PicId:  t.PicId,
+	}, t.BeforePicId)
+	if sts != nil {
+		return sts
+	}
+	if sts := writePoolPicPositions(j, pps, now); sts != nil {
+		return sts
+	}
+	if sts := touchPool(j, p, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Pool = p
+	return nil
+}
@@ -0,0 +1,146 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestInsertPoolPic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_POOL_EDIT)
+	u.Update()
+	pool := c.CreatePool("Chapter 1")
+	p1, p2, p3 := c.CreatePic(), c.CreatePic(), c.CreatePic()
+
+	task := &InsertPoolPicTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PoolId: pool.PoolId,
+		PicId:  p1.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	task = &InsertPoolPicTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PoolId: pool.PoolId,
+		PicId:  p3.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	task = &InsertPoolPicTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		PoolId:      pool.PoolId,
+		PicId:       p2.Pic.PicId,
+		BeforePicId: p3.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	ids := c.PoolPicIds(pool)
+	if len(ids) != 3 || ids[0] != p1.Pic.PicId || ids[1] != p2.Pic.PicId || ids[2] != p3.Pic.PicId {
+		t.Error("wrong pics", ids)
+	}
+
+	task.Pool = nil
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.AlreadyExists; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestInsertPoolPic_BeforePicNotInPool(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_POOL_EDIT)
+	u.Update()
+	pool := c.CreatePool("Chapter 1")
+	p1, p2 := c.CreatePic(), c.CreatePic()
+
+	task := &InsertPoolPicTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		PoolId:      pool.PoolId,
+		PicId:       p1.Pic.PicId,
+		BeforePicId: p2.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestInsertPoolPic_TooMany(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_POOL_EDIT)
+	u.Update()
+	pool := c.CreatePool("Chapter 1")
+	c.AddPoolPic(pool, c.CreatePic(), 0)
+
+	conf := schema.GetDefaultConfiguration()
+	conf.MaxPoolPics = &wpb.Int64Value{Value: 1}
+	ctx := CtxFromTestConfig(u.AuthedCtx(c.Ctx), conf)
+
+	task := &InsertPoolPicTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PoolId: pool.PoolId,
+		PicId:  c.CreatePic().Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestInsertPoolPic_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	pool := c.CreatePool("Chapter 1")
+
+	task := &InsertPoolPicTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PoolId: pool.PoolId,
+		PicId:  c.CreatePic().Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing cap"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
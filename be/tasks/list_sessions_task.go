@@ -0,0 +1,54 @@
+package tasks
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &ListSessionsTask{}
+
+// ListSessionsTask finds the auth tokens of the subject user, most recently seen first.
+type ListSessionsTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Results
+	Sessions []*schema.UserToken
+	// CurrentTokenId is the token of the caller, or 0 if the caller didn't use an auth token.
+	CurrentTokenId int64
+}
+
+func (t *ListSessionsTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if _, keyPresent := ApiKeyFromCtx(ctx); keyPresent {
+		return status.PermissionDenied(nil, "can't list sessions with api key")
+	}
+
+	sessions := make([]*schema.UserToken, len(su.UserToken))
+	copy(sessions, su.UserToken)
+	sort.Stable(sort.Reverse(userTokens(sessions)))
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.Sessions = sessions
+	if tok, ok := UserTokenFromCtx(ctx); ok {
+		t.CurrentTokenId = tok.TokenId
+	}
+	return nil
+}
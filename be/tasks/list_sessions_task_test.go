@@ -0,0 +1,74 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func TestListSessions(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	u.User.NextTokenId = 2
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId:    2,
+		CreatedTs:  schema.ToTspb(now.Add(time.Hour)),
+		LastSeenTs: schema.ToTspb(now.Add(time.Hour)),
+		UserAgent:  "phone",
+	})
+	u.Update()
+
+	task := &ListSessionsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if len(task.Sessions) != 2 {
+		t.Fatal("wrong sessions", task.Sessions)
+	}
+	if task.Sessions[0].TokenId != 2 || task.Sessions[0].UserAgent != "phone" {
+		t.Error("expected most recent session first", task.Sessions)
+	}
+	if have, want := task.CurrentTokenId, u.User.UserToken[0].TokenId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestListSessions_MissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &ListSessionsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
+
+func TestListSessions_CantUseApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	u.CreateApiKey("key", schema.User_PIC_INDEX)
+
+	task := &ListSessionsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.PermissionDenied(nil, "can't list sessions with api key")
+	compareStatus(t, sts, expected)
+}
@@ -0,0 +1,110 @@
+package tasks
+
+import (
+	"strconv"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+const (
+	// maxLoginFailures is how many failed logins a subject may have before being locked out.
+	maxLoginFailures = 5
+	// loginLockoutBase is how long the first lockout lasts.  Each following lockout is twice as
+	// long, up to loginLockoutMax.
+	loginLockoutBase = time.Minute
+	loginLockoutMax  = 24 * time.Hour
+	// loginFailureExpiry is how long after the last failure the failures and lockouts are forgotten.
+	loginFailureExpiry = 24 * time.Hour
+)
+
+var errLoginLockedMsg = "too many failed logins, try again later"
+
+func userLoginFailureSubject(userId int64) string {
+	return "user:" + strconv.FormatInt(userId, 10)
+}
+
+func addrLoginFailureSubject(addr string) string {
+	return "addr:" + addr
+}
+
+// findLoginFailure finds the failures of subject, or nil if there are none.
+func findLoginFailure(j *tab.Job, subject string) (*schema.LoginFailure, status.S) {
+	lfs, err := j.FindLoginFailures(db.Opts{
+		Prefix: tab.LoginFailuresSubject{&subject},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find login failures")
+	}
+	if len(lfs) != 1 {
+		return nil, nil
+	}
+	return lfs[0], nil
+}
+
+// recordLoginFailure counts a failed login for subject, locking it out if it has failed too many
+// times.  lf is the existing failures of subject, if any.  It returns true if the subject was just
+// locked out.
+func recordLoginFailure(j *tab.Job, lf *schema.LoginFailure, subject string, now time.Time) (
+	*schema.LoginFailure, bool, status.S) {
+	insert := lf == nil
+	if insert {
+		id, err := j.AllocId()
+		if err != nil {
+			return nil, false, status.Internal(err, "can't allocate id")
+		}
+		lf = &schema.LoginFailure{
+			LoginFailureId: id,
+			Subject:        subject,
+		}
+		lf.SetCreatedTime(now)
+	} else if !lf.Locked(now) && now.Sub(schema.ToTime(lf.ModifiedTs)) >= loginFailureExpiry {
+		lf.Failures = 0
+		lf.Lockouts = 0
+		lf.LockedUntilTs = nil
+	}
+
+	lf.Failures++
+	var locked bool
+	if lf.Failures >= maxLoginFailures {
+		lockout := loginLockoutMax
+		// Avoid overflowing the shift.
+		if lf.Lockouts < 20 {
+			if d := loginLockoutBase << uint(lf.Lockouts); d < lockout {
+				lockout = d
+			}
+		}
+		lf.Failures = 0
+		lf.Lockouts++
+		lf.LockedUntilTs = schema.ToTspb(now.Add(lockout))
+		locked = true
+	}
+	lf.SetModifiedTime(now)
+
+	if insert {
+		if err := j.InsertLoginFailure(lf); err != nil {
+			return nil, false, status.Internal(err, "can't insert login failure")
+		}
+	} else {
+		if err := j.UpdateLoginFailure(lf); err != nil {
+			return nil, false, status.Internal(err, "can't update login failure")
+		}
+	}
+	return lf, locked, nil
+}
+
+// deleteLoginFailure forgets the failures of subject, if any.
+func deleteLoginFailure(j *tab.Job, lf *schema.LoginFailure) status.S {
+	if lf == nil {
+		return nil
+	}
+	if err := j.DeleteLoginFailure(tab.LoginFailuresPrimary{&lf.LoginFailureId}); err != nil {
+		return status.Internal(err, "can't delete login failure")
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func TestRecordLoginFailure_LocksAfterMaxFailures(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	j := c.Job()
+	defer j.Rollback()
+
+	now := time.Now()
+	var lf *schema.LoginFailure
+	for i := 0; i < maxLoginFailures-1; i++ {
+		var locked bool
+		var sts status.S
+		lf, locked, sts = recordLoginFailure(j, lf, "user:1", now)
+		if sts != nil {
+			t.Fatal(sts)
+		}
+		if locked || lf.Locked(now) {
+			t.Fatal("locked too early", i, lf)
+		}
+	}
+	lf, locked, sts := recordLoginFailure(j, lf, "user:1", now)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if !locked || !lf.Locked(now) {
+		t.Fatal("expected lockout", lf)
+	}
+	if have, want := schema.ToTime(lf.LockedUntilTs), now.Add(loginLockoutBase); !have.Equal(want) {
+		t.Error("have", have, "want", want)
+	}
+	if lf.Failures != 0 || lf.Lockouts != 1 {
+		t.Error("bad counts", lf)
+	}
+
+	found, sts := findLoginFailure(j, "user:1")
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if found == nil || found.LoginFailureId != lf.LoginFailureId {
+		t.Error("expected stored failure", found)
+	}
+}
+
+func TestRecordLoginFailure_LockoutDoublesUpToMax(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	j := c.Job()
+	defer j.Rollback()
+
+	now := time.Now()
+	var lf *schema.LoginFailure
+	want := loginLockoutBase
+	// Enough lockouts to pass the max, and to need to avoid overflowing.
+	for lockouts := 1; lockouts <= 25; lockouts++ {
+		for i := 0; i < maxLoginFailures; i++ {
+			var sts status.S
+			if lf, _, sts = recordLoginFailure(j, lf, "user:1", now); sts != nil {
+				t.Fatal(sts)
+			}
+		}
+		if have := schema.ToTime(lf.LockedUntilTs).Sub(now); have != want {
+			t.Error("lockout", lockouts, "have", have, "want", want)
+		}
+		if want *= 2; want > loginLockoutMax {
+			want = loginLockoutMax
+		}
+	}
+	if have, want := schema.ToTime(lf.LockedUntilTs).Sub(schema.ToTime(lf.ModifiedTs)),
+		loginLockoutMax; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRecordLoginFailure_Expires(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	j := c.Job()
+	defer j.Rollback()
+
+	now := time.Now()
+	var lf *schema.LoginFailure
+	for i := 0; i < 2*maxLoginFailures; i++ {
+		var sts status.S
+		if lf, _, sts = recordLoginFailure(j, lf, "user:1", now); sts != nil {
+			t.Fatal(sts)
+		}
+	}
+	if lf.Lockouts != 2 {
+		t.Fatal("expected two lockouts", lf)
+	}
+
+	// Just before expiry, the failures are still counted.
+	now = now.Add(loginFailureExpiry - time.Second)
+	lf, _, sts := recordLoginFailure(j, lf, "user:1", now)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if lf.Failures != 1 || lf.Lockouts != 2 {
+		t.Error("expected failures kept", lf)
+	}
+
+	// After expiry, the failures and lockouts are forgotten, so the next lockout is short again.
+	now = now.Add(loginFailureExpiry)
+	lf, _, sts = recordLoginFailure(j, lf, "user:1", now)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if lf.Failures != 1 || lf.Lockouts != 0 || lf.LockedUntilTs != nil {
+		t.Error("expected failures forgotten", lf)
+	}
+	for i := 1; i < maxLoginFailures; i++ {
+		if lf, _, sts = recordLoginFailure(j, lf, "user:1", now); sts != nil {
+			t.Fatal(sts)
+		}
+	}
+	if have, want := schema.ToTime(lf.LockedUntilTs), now.Add(loginLockoutBase); !have.Equal(want) {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,73 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &LookupCollectionTask{}
+
+// LookupCollectionTask finds a collection and its pics, in order.  Deleted pics are skipped.
+type LookupCollectionTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	CollectionId int64
+
+	// Results
+	Collection     *schema.Collection
+	UnfilteredPics []*schema.Pic
+	Pics           []*schema.Pic
+}
+
+func (t *LookupCollectionTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_INDEX); sts != nil {
+		return sts
+	}
+
+	c, sts := lookupCollection(j, t.CollectionId, su, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+	cps, sts := findCollectionPics(j, c.CollectionId, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+	for _, cp := range cps {
+		pics, err := j.FindPics(db.Opts{
+			Prefix: tab.PicsPrimary{&cp.PicId},
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't lookup pic")
+		}
+		if len(pics) != 1 || pics[0].HardDeleted() {
+			continue
+		}
+		t.UnfilteredPics = append(t.UnfilteredPics, pics[0])
+	}
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.Collection = c
+	t.Pics = filterPics(t.UnfilteredPics, su, conf)
+	return nil
+}
@@ -0,0 +1,69 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestLookupCollection(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	col := u.CreateCollection("Cats", schema.Collection_PRIVATE)
+	p1, p2, deleted := c.CreatePic(), c.CreatePic(), c.CreatePic()
+	nowts := schema.ToTspb(time.Now())
+	deleted.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: nowts,
+		ActualDeletedTs: nowts,
+	}
+	deleted.Update()
+	c.AddCollectionPic(col, p2, 0)
+	c.AddCollectionPic(col, deleted, 1)
+	c.AddCollectionPic(col, p1, 2)
+
+	task := &LookupCollectionTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		CollectionId: col.CollectionId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.Collection.CollectionId != col.CollectionId {
+		t.Error("wrong collection", task.Collection)
+	}
+	if ps := task.Pics; len(ps) != 2 || ps[0].PicId != p2.Pic.PicId || ps[1].PicId != p1.Pic.PicId {
+		t.Error("wrong pics", ps)
+	}
+}
+
+func TestLookupCollection_PrivateOtherUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	owner := c.CreateUser()
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	col := owner.CreateCollection("Cats", schema.Collection_PRIVATE)
+
+	task := &LookupCollectionTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		CollectionId: col.CollectionId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -33,6 +33,17 @@ type LookupPicTask struct {
 	Pic            *schema.Pic
 	PicTags        []*schema.PicTag
 	PicCommentTree *PicCommentTree
+	// Favorite is true if the subject user has favorited the pic.
+	Favorite bool
+	// PoolNavs are the pools the pic is in.
+	PoolNavs []*PoolNav
+}
+
+// PoolNav is a pool along with the neighbors of a pic in the pool.  Deleted neighbors are
+// skipped.  PrevPicId and NextPicId are 0 at the start and end of the pool.
+type PoolNav struct {
+	Pool                 *schema.Pool
+	PrevPicId, NextPicId int64
 }
 
 func (t *LookupPicTask) Run(ctx context.Context) (stscap status.S) {
@@ -85,11 +96,23 @@ func (t *LookupPicTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't find pic comments")
 	}
+	var pf *schema.PicFavorite
+	if u != nil {
+		if pf, sts = findPicFavorite(j, t.PicId, u.UserId, db.LockNone); sts != nil {
+			return sts
+		}
+	}
+	poolNavs, sts := findPoolNavs(j, t.PicId)
+	if sts != nil {
+		return sts
+	}
 	if err := j.Rollback(); err != nil {
 		return status.Internal(err, "can't rollback job")
 	}
 
 	t.UnfilteredPic = pics[0]
+	t.PoolNavs = poolNavs
+	t.Favorite = pf != nil
 	t.Pic = filterPic(t.UnfilteredPic, u, conf)
 	t.PicTags = filterPicTags(picTags, u, conf)
 	filteredPicComments := filterPicComments(picComments, u, conf)
@@ -47,6 +47,47 @@ func TestLookupPicTaskWorkflow(t *testing.T) {
 	}
 }
 
+func TestLookupPicTask_PoolNavs(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	pool := c.CreatePool("Chapter 1")
+	p1, p2, p3, p4 := c.CreatePic(), c.CreatePic(), c.CreatePic(), c.CreatePic()
+	c.AddPoolPic(pool, p1, 0)
+	c.AddPoolPic(pool, p2, 1)
+	c.AddPoolPic(pool, p3, 2)
+	c.AddPoolPic(pool, p4, 3)
+	nowts := schema.ToTspb(time.Now())
+	p3.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: nowts,
+		ActualDeletedTs: nowts,
+	}
+	p3.Update()
+
+	task := &LookupPicTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p2.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.PoolNavs) != 1 {
+		t.Fatal("wrong pool navs", task.PoolNavs)
+	}
+	nav := task.PoolNavs[0]
+	if nav.Pool.PoolId != pool.PoolId {
+		t.Error("wrong pool", nav.Pool)
+	}
+	// The deleted pic is skipped.
+	if nav.PrevPicId != p1.Pic.PicId || nav.NextPicId != p4.Pic.PicId {
+		t.Error("wrong neighbors", nav.PrevPicId, nav.NextPicId)
+	}
+}
+
 func TestLookupPicTask_failsOnMissingCap(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -0,0 +1,73 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &LookupPoolTask{}
+
+// LookupPoolTask finds a pool and its pics, in order.  Deleted pics are skipped.
+type LookupPoolTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	PoolId int64
+
+	// Results
+	Pool           *schema.Pool
+	UnfilteredPics []*schema.Pic
+	Pics           []*schema.Pic
+}
+
+func (t *LookupPoolTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_INDEX); sts != nil {
+		return sts
+	}
+
+	p, sts := lookupPool(j, t.PoolId, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+	pps, sts := findPoolPics(j, p.PoolId, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+	for _, pp := range pps {
+		pics, err := j.FindPics(db.Opts{
+			Prefix: tab.PicsPrimary{&pp.PicId},
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't lookup pic")
+		}
+		if len(pics) != 1 || pics[0].HardDeleted() {
+			continue
+		}
+		t.UnfilteredPics = append(t.UnfilteredPics, pics[0])
+	}
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.Pool = p
+	t.Pics = filterPics(t.UnfilteredPics, su, conf)
+	return nil
+}
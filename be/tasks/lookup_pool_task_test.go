@@ -0,0 +1,67 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestLookupPool(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	pool := c.CreatePool("Chapter 1")
+	p1, p2, p3 := c.CreatePic(), c.CreatePic(), c.CreatePic()
+	c.AddPoolPic(pool, p2, 0)
+	c.AddPoolPic(pool, p3, 1)
+	c.AddPoolPic(pool, p1, 2)
+	nowts := schema.ToTspb(time.Now())
+	p3.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: nowts,
+		ActualDeletedTs: nowts,
+	}
+	p3.Update()
+
+	task := &LookupPoolTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PoolId: pool.PoolId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.Pool.PoolId != pool.PoolId {
+		t.Error("wrong pool", task.Pool)
+	}
+	if len(task.Pics) != 2 || task.Pics[0].PicId != p2.Pic.PicId || task.Pics[1].PicId != p1.Pic.PicId {
+		t.Error("wrong pics", task.Pics)
+	}
+}
+
+func TestLookupPool_Missing(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	task := &LookupPoolTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PoolId: c.Id(),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,108 @@
+package tasks
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &LookupUploadSessionTask{}
+
+// LookupUploadSessionTask finds an upload session of the current user, and how much data has been
+// received so far.
+type LookupUploadSessionTask struct {
+	// Deps
+	PixPath string
+	Beg     tab.JobBeginner
+	Now     func() time.Time
+	// os functions
+	Stat func(name string) (os.FileInfo, error)
+
+	// Inputs
+	UploadSessionId int64
+
+	// Results
+	UploadSession *schema.UploadSession
+	// Offset is the number of bytes received so far.
+	Offset int64
+}
+
+func (t *LookupUploadSessionTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, u, sts := authedReadonlyJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if u == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(u, conf, schema.User_PIC_CREATE); sts != nil {
+		return sts
+	}
+
+	us, sts := findUploadSession(j, t.UploadSessionId, u.UserId, db.LockNone, conf, now)
+	if sts != nil {
+		return sts
+	}
+	fi, err := t.Stat(schema.UploadSessionPath(t.PixPath, us.UploadSessionId))
+	if err != nil {
+		return status.Internal(err, "can't stat upload session file")
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback")
+	}
+
+	t.UploadSession = us
+	t.Offset = fi.Size()
+	return nil
+}
+
+// findUploadSession finds the upload session owned by userId.  Sessions started by other users, or
+// that have expired, are treated as if they don't exist.
+func findUploadSession(j *tab.Job, uploadSessionId, userId int64, lk db.Lock,
+	conf *schema.Configuration, now time.Time) (*schema.UploadSession, status.S) {
+	uss, err := j.FindUploadSessions(db.Opts{
+		Prefix: tab.UploadSessionsPrimary{&uploadSessionId},
+		Lock:   lk,
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find upload sessions")
+	}
+	if len(uss) != 1 || uss[0].UserId != userId {
+		return nil, status.NotFound(nil, "can't find upload session")
+	}
+	us := uss[0]
+	if expiry, sts := uploadSessionExpiry(conf); sts != nil {
+		return nil, sts
+	} else if expiry != nil && !now.Before(us.GetModifiedTime().Add(*expiry)) {
+		return nil, status.NotFound(nil, "upload session expired")
+	}
+	return us, nil
+}
+
+// uploadSessionExpiry returns how long a session may go unmodified, or nil if they never expire.
+func uploadSessionExpiry(conf *schema.Configuration) (*time.Duration, status.S) {
+	if conf.UploadSessionExpiry == nil {
+		return nil, nil
+	}
+	expiry, err := ptypes.Duration(conf.UploadSessionExpiry)
+	if err != nil {
+		return nil, status.Internal(err, "can't parse upload session expiry")
+	}
+	return &expiry, nil
+}
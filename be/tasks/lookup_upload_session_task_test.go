@@ -0,0 +1,67 @@
+package tasks
+
+import (
+	"crypto/md5"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func TestLookupUploadSession(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	us := c.startUploadSession(u, md5Hash[:], time.Now())
+	path := schema.UploadSessionPath(c.TempDir(), us.UploadSessionId)
+	if err := ioutil.WriteFile(path, []byte("dat"), 0660); err != nil {
+		t.Fatal(err)
+	}
+
+	task := &LookupUploadSessionTask{
+		PixPath: c.TempDir(),
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Stat:    os.Stat,
+
+		UploadSessionId: us.UploadSessionId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := task.UploadSession.UploadSessionId, us.UploadSessionId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := task.Offset, int64(3); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLookupUploadSession_NotFound(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	task := &LookupUploadSessionTask{
+		PixPath: c.TempDir(),
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Stat:    os.Stat,
+
+		UploadSessionId: 1234,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.NotFound(nil, "can't find upload session")
+	compareStatus(t, sts, expected)
+}
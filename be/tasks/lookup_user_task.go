@@ -46,11 +46,16 @@ func (t *LookupUserTask) Run(ctx context.Context) (stscap status.S) {
 	case uc.cs.Has(schema.User_USER_READ_ALL):
 	case uc.subjectUserId == ou.UserId && uc.cs.Has(schema.User_USER_READ_SELF):
 	case t.PublicOnly && uc.cs.Has(schema.User_USER_READ_PUBLIC):
-		ou = &schema.User{
+		pub := &schema.User{
 			UserId:    ou.UserId,
 			CreatedTs: ou.CreatedTs,
-			Ident:     ou.Ident,
+			Profile:   ou.Profile,
 		}
+		// Users with a display name don't need their ident to be recognized.
+		if ou.Profile.GetDisplayName() == "" {
+			pub.Ident = ou.Ident
+		}
+		ou = pub
 	default:
 		return status.PermissionDenied(nil, "missing capability")
 	}
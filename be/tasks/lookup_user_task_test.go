@@ -98,6 +98,40 @@ func TestLookupUserPublic(t *testing.T) {
 	}
 }
 
+func TestLookupUserPublicHidesIdentWithDisplayName(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u1 := c.CreateUser()
+	u1.User.Profile = &schema.User_Profile{
+		DisplayName: "Bob",
+		Bio:         "Likes cats.",
+	}
+	u1.Update()
+	u2 := c.CreateUser()
+	u2.User.Capability = append(u2.User.Capability, schema.User_USER_READ_PUBLIC)
+	u2.Update()
+
+	task := &LookupUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u1.User.UserId,
+		PublicOnly:   true,
+	}
+	if sts := new(TaskRunner).Run(u2.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	expected := &schema.User{
+		UserId:    u1.User.UserId,
+		CreatedTs: u1.User.CreatedTs,
+		Profile:   u1.User.Profile,
+	}
+	if !proto.Equal(expected, task.User) {
+		t.Error("Users don't match", expected, task.User)
+	}
+}
+
 func TestLookupUserCantLookupSelf(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
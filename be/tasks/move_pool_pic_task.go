@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &MovePoolPicTask{}
+
+// MovePoolPicTask moves a pic within a pool.
+type MovePoolPicTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	PoolId int64
+	PicId  int64
+	// BeforePicId is the pic in the pool to move in front of.  If 0, the pic is moved to the end.
+	BeforePicId int64
+
+	// Results
+	Pool *schema.Pool
+}
+
+func (t *MovePoolPicTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_POOL_EDIT); sts != nil {
+		return sts
+	}
+	if t.PicId == t.BeforePicId {
+		return status.InvalidArgument(nil, "can't move pic before itself")
+	}
+	p, sts := lookupPool(j, t.PoolId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+
+	pps, sts := findPoolPics(j, p.PoolId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	i := poolPicIndex(pps, t.PicId)
+	if i == -1 {
+		return status.NotFound(nil, "can't find pic in pool")
+	}
+	pp := pps[i]
+	pps = append(pps[:i], pps[i+1:]...)
+	pps, sts = insertPoolPicBefore(pps, pp, t.BeforePicId)
+	if sts != nil {
+		return sts
+	}
+	if sts := writePoolPicPositions(j, pps, now); sts != nil {
+		return sts
+	}
+	if sts := touchPool(j, p, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Pool = p
+	return nil
+}
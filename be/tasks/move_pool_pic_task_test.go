@@ -0,0 +1,79 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestMovePoolPic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_POOL_EDIT)
+	u.Update()
+	pool := c.CreatePool("Chapter 1")
+	p1, p2, p3 := c.CreatePic(), c.CreatePic(), c.CreatePic()
+	c.AddPoolPic(pool, p1, 0)
+	c.AddPoolPic(pool, p2, 1)
+	c.AddPoolPic(pool, p3, 2)
+
+	task := &MovePoolPicTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		PoolId:      pool.PoolId,
+		PicId:       p3.Pic.PicId,
+		BeforePicId: p1.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	ids := c.PoolPicIds(pool)
+	if len(ids) != 3 || ids[0] != p3.Pic.PicId || ids[1] != p1.Pic.PicId || ids[2] != p2.Pic.PicId {
+		t.Error("wrong pics", ids)
+	}
+
+	// Moving to the end.
+	task = &MovePoolPicTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PoolId: pool.PoolId,
+		PicId:  p3.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	ids = c.PoolPicIds(pool)
+	if len(ids) != 3 || ids[0] != p1.Pic.PicId || ids[1] != p2.Pic.PicId || ids[2] != p3.Pic.PicId {
+		t.Error("wrong pics", ids)
+	}
+}
+
+func TestMovePoolPic_NotInPool(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_POOL_EDIT)
+	u.Update()
+	pool := c.CreatePool("Chapter 1")
+	c.AddPoolPic(pool, c.CreatePic(), 0)
+
+	task := &MovePoolPicTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PoolId: pool.PoolId,
+		PicId:  c.CreatePic().Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
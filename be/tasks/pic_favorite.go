@@ -0,0 +1,54 @@
+package tasks
+
+import (
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// findPicFavorite finds the favorite of picId by userId, or nil if there isn't one.
+func findPicFavorite(j *tab.Job, picId, userId int64, lock db.Lock) (*schema.PicFavorite, status.S) {
+	pfs, err := j.FindPicFavorites(db.Opts{
+		Prefix: tab.PicFavoritesPicIdUserId{PicId: &picId, UserId: &userId},
+		Lock:   lock,
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pic favorites")
+	}
+	if len(pfs) != 1 {
+		return nil, nil
+	}
+	return pfs[0], nil
+}
+
+// deletePicFavorite removes a favorite, and updates the favorite count of the pic.
+func deletePicFavorite(j *tab.Job, pf *schema.PicFavorite, now time.Time) status.S {
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&pf.PicId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't lookup pic")
+	}
+	if err := j.DeletePicFavorite(tab.KeyForPicFavorite(pf)); err != nil {
+		return status.Internal(err, "can't delete pic favorite")
+	}
+	// The pic may have been purged, in which case there is nothing to count.
+	if len(pics) != 1 {
+		return nil
+	}
+	p := pics[0]
+	if p.FavoriteCount > 0 {
+		p.FavoriteCount--
+	}
+	p.SetModifiedTime(now)
+	if err := j.UpdatePic(p); err != nil {
+		return status.Internal(err, "can't update pic")
+	}
+	return nil
+}
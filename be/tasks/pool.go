@@ -0,0 +1,176 @@
+package tasks
+
+import (
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+func validatePoolTitle(title string, conf *schema.Configuration) (string, status.S) {
+	maxTitleLen := int64(math.MaxInt64)
+	if conf.MaxPoolTitleLength != nil {
+		maxTitleLen = conf.MaxPoolTitleLength.Value
+	}
+	normal, err := text.DefaultValidateNoNewlineAndNormalize(title, "pool title", 1, maxTitleLen)
+	if err != nil {
+		return "", status.From(err)
+	}
+	return normal, nil
+}
+
+func validatePoolDescription(description string, conf *schema.Configuration) (string, status.S) {
+	maxDescriptionLen := int64(math.MaxInt64)
+	if conf.MaxPoolDescriptionLength != nil {
+		maxDescriptionLen = conf.MaxPoolDescriptionLength.Value
+	}
+	normal, err := text.DefaultValidateAndNormalize(
+		description, "pool description", 0, maxDescriptionLen)
+	if err != nil {
+		return "", status.From(err)
+	}
+	return normal, nil
+}
+
+func lookupPool(j *tab.Job, poolId int64, lock db.Lock) (*schema.Pool, status.S) {
+	ps, err := j.FindPools(db.Opts{
+		Prefix: tab.PoolsPrimary{&poolId},
+		Lock:   lock,
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pools")
+	}
+	if len(ps) != 1 {
+		return nil, status.NotFound(nil, "can't find pool")
+	}
+	return ps[0], nil
+}
+
+// findPoolPics finds the pics in a pool, in order.
+func findPoolPics(j *tab.Job, poolId int64, lock db.Lock) ([]*schema.PoolPic, status.S) {
+	pps, err := j.FindPoolPics(db.Opts{
+		Prefix: tab.PoolPicsPosition{PoolId: &poolId},
+		Lock:   lock,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pool pics")
+	}
+	return pps, nil
+}
+
+// poolPicIndex finds the index of picId in pps, or -1 if it is absent.
+func poolPicIndex(pps []*schema.PoolPic, picId int64) int {
+	for i, pp := range pps {
+		if pp.PicId == picId {
+			return i
+		}
+	}
+	return -1
+}
+
+// insertPoolPicBefore adds pp to pps in front of beforePicId.  If beforePicId is 0, pp is added
+// to the end.
+func insertPoolPicBefore(pps []*schema.PoolPic, pp *schema.PoolPic, beforePicId int64) (
+	[]*schema.PoolPic, status.S) {
+	if beforePicId == 0 {
+		return append(pps, pp), nil
+	}
+	i := poolPicIndex(pps, beforePicId)
+	if i == -1 {
+		return nil, status.InvalidArgument(nil, "before pic not in pool", beforePicId)
+	}
+	pps = append(pps, nil)
+	copy(pps[i+1:], pps[i:])
+	pps[i] = pp
+	return pps, nil
+}
+
+// writePoolPicPositions renumbers pps by their index, updating the ones that moved.  Pool pics
+// that have not been created yet are inserted.
+func writePoolPicPositions(j *tab.Job, pps []*schema.PoolPic, now time.Time) status.S {
+	for i, pp := range pps {
+		if pp.CreatedTs == nil {
+			pp.Position = int64(i)
+			pp.SetCreatedTime(now)
+			pp.SetModifiedTime(now)
+			if err := j.InsertPoolPic(pp); err != nil {
+				return status.Internal(err, "can't insert pool pic")
+			}
+			continue
+		}
+		if pp.Position == int64(i) {
+			continue
+		}
+		pp.Position = int64(i)
+		pp.SetModifiedTime(now)
+		if err := j.UpdatePoolPic(pp); err != nil {
+			return status.Internal(err, "can't update pool pic")
+		}
+	}
+	return nil
+}
+
+// findPoolNavs finds the pools picId is in, and its neighbors in each.
+func findPoolNavs(j *tab.Job, picId int64) ([]*PoolNav, status.S) {
+	picPps, err := j.FindPoolPics(db.Opts{
+		Prefix: tab.PoolPicsPicId{PicId: &picId},
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pool pics")
+	}
+	navs := make([]*PoolNav, 0, len(picPps))
+	for _, picPp := range picPps {
+		p, sts := lookupPool(j, picPp.PoolId, db.LockNone)
+		if sts != nil {
+			return nil, sts
+		}
+		pps, sts := findPoolPics(j, p.PoolId, db.LockNone)
+		if sts != nil {
+			return nil, sts
+		}
+		i := poolPicIndex(pps, picId)
+		nav := &PoolNav{
+			Pool: p,
+		}
+		if nav.PrevPicId, sts = poolNeighbor(j, pps, i, -1); sts != nil {
+			return nil, sts
+		}
+		if nav.NextPicId, sts = poolNeighbor(j, pps, i, 1); sts != nil {
+			return nil, sts
+		}
+		navs = append(navs, nav)
+	}
+	return navs, nil
+}
+
+// poolNeighbor finds the nearest pic to pps[i] in the direction of step that isn't deleted.  It
+// returns 0 if there is none.
+func poolNeighbor(j *tab.Job, pps []*schema.PoolPic, i, step int) (int64, status.S) {
+	for k := i + step; k >= 0 && k < len(pps); k += step {
+		pics, err := j.FindPics(db.Opts{
+			Prefix: tab.PicsPrimary{&pps[k].PicId},
+			Limit:  1,
+		})
+		if err != nil {
+			return 0, status.Internal(err, "can't lookup pic")
+		}
+		if len(pics) == 1 && !pics[0].HardDeleted() {
+			return pics[0].PicId, nil
+		}
+	}
+	return 0, nil
+}
+
+// touchPool marks the pool as modified, such as when its pics change.
+func touchPool(j *tab.Job, p *schema.Pool, now time.Time) status.S {
+	p.SetModifiedTime(now)
+	if err := j.UpdatePool(p); err != nil {
+		return status.Internal(err, "can't update pool")
+	}
+	return nil
+}
@@ -21,6 +21,8 @@ type PurgePicTask struct {
 
 	// input
 	PicId int64
+	// Why the pic is being purged.  It is recorded in the audit log.  Optional.
+	Reason string
 }
 
 func (t *PurgePicTask) Run(ctx context.Context) (stscap status.S) {
@@ -38,6 +40,10 @@ func (t *PurgePicTask) Run(ctx context.Context) (stscap status.S) {
 	if sts := validateCapability(u, conf, schema.User_PIC_PURGE); sts != nil {
 		return sts
 	}
+	reason, sts := validateAuditReason(t.Reason)
+	if sts != nil {
+		return sts
+	}
 
 	pics, err := j.FindPics(db.Opts{
 		Prefix: tab.PicsPrimary{&t.PicId},
@@ -55,6 +61,14 @@ func (t *PurgePicTask) Run(ctx context.Context) (stscap status.S) {
 	if err := j.DeletePic(tab.KeyForPic(p)); err != nil {
 		return status.Internal(err, "can't delete pic")
 	}
+	al := &schema.AuditLog{
+		Action:      schema.AuditLog_PURGE_PIC,
+		TargetPicId: p.PicId,
+		Reason:      reason,
+	}
+	if sts := insertAuditLog(j, al, u, p, nil, now); sts != nil {
+		return sts
+	}
 
 	pis, err := j.FindPicIdents(db.Opts{
 		Prefix: tab.PicIdentsPrimary{PicId: &t.PicId},
@@ -142,6 +156,48 @@ func (t *PurgePicTask) Run(ctx context.Context) (stscap status.S) {
 		}
 	}
 
+	pfs, err := j.FindPicFavorites(db.Opts{
+		Prefix: tab.PicFavoritesPicIdUserId{PicId: &t.PicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic favorites")
+	}
+
+	for _, pf := range pfs {
+		if err := j.DeletePicFavorite(tab.KeyForPicFavorite(pf)); err != nil {
+			return status.Internal(err, "can't delete pic favorite")
+		}
+	}
+
+	cps, err := j.FindCollectionPics(db.Opts{
+		Prefix: tab.CollectionPicsPicId{PicId: &t.PicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find collection pics")
+	}
+
+	for _, cp := range cps {
+		if err := j.DeleteCollectionPic(tab.KeyForCollectionPic(cp)); err != nil {
+			return status.Internal(err, "can't delete collection pic")
+		}
+	}
+
+	pps, err := j.FindPoolPics(db.Opts{
+		Prefix: tab.PoolPicsPicId{PicId: &t.PicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pool pics")
+	}
+
+	for _, pp := range pps {
+		if err := j.DeletePoolPic(tab.KeyForPoolPic(pp)); err != nil {
+			return status.Internal(err, "can't delete pool pic")
+		}
+	}
+
 	if err := j.Commit(); err != nil {
 		return status.Internal(err, "Unable to Commit")
 	}
@@ -17,6 +17,7 @@ func TestPurgeWorkflow(t *testing.T) {
 
 	u := c.CreateUser()
 	u.User.Capability = append(u.User.Capability, schema.User_PIC_PURGE)
+	u.enrollTotp()
 	u.Update()
 
 	p := c.CreatePic()
@@ -41,6 +42,7 @@ func TestPurgeWorkflow(t *testing.T) {
 		Now:     time.Now,
 		Remove:  os.Remove,
 		PicId:   p.Pic.PicId,
+		Reason:  "dupe",
 	}
 
 	ctx := u.AuthedCtx(c.Ctx)
@@ -104,6 +106,12 @@ func TestPurgeWorkflow(t *testing.T) {
 	if !p2.Refresh() {
 		t.Fatal("Expected Other pic to exist", p2)
 	}
+
+	als := c.AuditLogs()
+	if len(als) != 1 || als[0].Action != schema.AuditLog_PURGE_PIC ||
+		als[0].TargetPicId != task.PicId || als[0].Reason != "dupe" || len(als[0].Change) == 0 {
+		t.Error("expected audit log", als)
+	}
 }
 
 func TestPurge_TagsDecremented(t *testing.T) {
@@ -112,6 +120,7 @@ func TestPurge_TagsDecremented(t *testing.T) {
 
 	u := c.CreateUser()
 	u.User.Capability = append(u.User.Capability, schema.User_PIC_PURGE)
+	u.enrollTotp()
 	u.Update()
 
 	p := c.CreatePic()
@@ -146,6 +155,7 @@ func TestPurgeDeleteFails(t *testing.T) {
 
 	u := c.CreateUser()
 	u.User.Capability = append(u.User.Capability, schema.User_PIC_PURGE)
+	u.enrollTotp()
 	u.Update()
 
 	p := c.CreatePic()
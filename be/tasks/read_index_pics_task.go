@@ -221,7 +221,7 @@ func filterPic(p *schema.Pic, su *schema.User, conf *schema.Configuration) *sche
 	var cs *schema.CapSet
 	var subjectUserId int64
 	if su != nil {
-		cs = schema.CapSetOf(su.Capability...)
+		cs = su.CapSet(conf)
 		subjectUserId = su.UserId
 	} else {
 		cs = schema.CapSetOf(conf.AnonymousCapability.Capability...)
@@ -235,7 +235,7 @@ func filterPics(ps []*schema.Pic, su *schema.User, conf *schema.Configuration) [
 	var cs *schema.CapSet
 	var subjectUserId int64
 	if su != nil {
-		cs = schema.CapSetOf(su.Capability...)
+		cs = su.CapSet(conf)
 		subjectUserId = su.UserId
 	} else {
 		cs = schema.CapSetOf(conf.AnonymousCapability.Capability...)
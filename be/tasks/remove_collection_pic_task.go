@@ -0,0 +1,69 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &RemoveCollectionPicTask{}
+
+// RemoveCollectionPicTask removes a pic from a collection.  Only the owner may remove pics.
+type RemoveCollectionPicTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	CollectionId int64
+	PicId        int64
+
+	// Results
+	Collection *schema.Collection
+}
+
+func (t *RemoveCollectionPicTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	c, sts := lookupCollectionForUpdate(j, t.CollectionId, su, conf)
+	if sts != nil {
+		return sts
+	}
+
+	cps, err := j.FindCollectionPics(db.Opts{
+		Prefix: tab.CollectionPicsPrimary{CollectionId: &c.CollectionId, PicId: &t.PicId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find collection pics")
+	}
+	if len(cps) != 1 {
+		return status.NotFound(nil, "can't find pic in collection")
+	}
+	if err := j.DeleteCollectionPic(tab.KeyForCollectionPic(cps[0])); err != nil {
+		return status.Internal(err, "can't delete collection pic")
+	}
+	if sts := touchCollection(j, c, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Collection = c
+	return nil
+}
@@ -0,0 +1,34 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestRemoveCollectionPic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	col := u.CreateCollection("Cats", schema.Collection_PRIVATE)
+	p1, p2 := c.CreatePic(), c.CreatePic()
+	c.AddCollectionPic(col, p1, 0)
+	c.AddCollectionPic(col, p2, 1)
+
+	task := &RemoveCollectionPicTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		CollectionId: col.CollectionId,
+		PicId:        p1.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if ids := c.CollectionPicIds(col); len(ids) != 1 || ids[0] != p2.Pic.PicId {
+		t.Error("wrong pics", ids)
+	}
+}
@@ -0,0 +1,56 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &RemovePicFavoriteTask{}
+
+// RemovePicFavoriteTask unfavorites a pic for the subject user.  Unfavoriting a pic that isn't a
+// favorite does nothing.
+type RemovePicFavoriteTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	PicId int64
+}
+
+func (t *RemovePicFavoriteTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	// No capability is needed, so that users who can no longer favorite pics can still remove them.
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+
+	pf, sts := findPicFavorite(j, t.PicId, su.UserId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	if pf == nil {
+		if err := j.Rollback(); err != nil {
+			return status.Internal(err, "can't rollback job")
+		}
+		return nil
+	}
+	if sts := deletePicFavorite(j, pf, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
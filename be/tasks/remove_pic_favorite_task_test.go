@@ -0,0 +1,65 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+)
+
+func TestRemovePicFavorite(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_FAVORITE_CREATE)
+	u.Update()
+	p := c.CreatePic()
+	u.Favorite(p)
+
+	task := &RemovePicFavoriteTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	p.Refresh()
+	if p.Pic.FavoriteCount != 0 {
+		t.Error("wrong favorite count", p.Pic.FavoriteCount)
+	}
+	j := c.Job()
+	defer j.Rollback()
+	if pf, sts := findPicFavorite(j, p.Pic.PicId, u.User.UserId, db.LockNone); sts != nil || pf != nil {
+		t.Error("expected favorite removed", pf, sts)
+	}
+}
+
+func TestRemovePicFavorite_NotFavorite(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	other := c.CreateUser()
+	other.User.Capability = append(other.User.Capability, schema.User_PIC_FAVORITE_CREATE)
+	other.Update()
+	p := c.CreatePic()
+	other.Favorite(p)
+
+	task := &RemovePicFavoriteTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	p.Refresh()
+	if p.Pic.FavoriteCount != 1 {
+		t.Error("favorite of other user should be kept", p.Pic.FavoriteCount)
+	}
+}
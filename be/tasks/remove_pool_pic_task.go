@@ -0,0 +1,72 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &RemovePoolPicTask{}
+
+// RemovePoolPicTask removes a pic from a pool.
+type RemovePoolPicTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	PoolId int64
+	PicId  int64
+
+	// Results
+	Pool *schema.Pool
+}
+
+func (t *RemovePoolPicTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_PIC_POOL_EDIT); sts != nil {
+		return sts
+	}
+	p, sts := lookupPool(j, t.PoolId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+
+	pps, err := j.FindPoolPics(db.Opts{
+		Prefix: tab.PoolPicsPrimary{PoolId: &p.PoolId, PicId: &t.PicId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pool pics")
+	}
+	if len(pps) != 1 {
+		return status.NotFound(nil, "can't find pic in pool")
+	}
+	if err := j.DeletePoolPic(tab.KeyForPoolPic(pps[0])); err != nil {
+		return status.Internal(err, "can't delete pool pic")
+	}
+	if sts := touchPool(j, p, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Pool = p
+	return nil
+}
@@ -0,0 +1,45 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestRemovePoolPic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_POOL_EDIT)
+	u.Update()
+	pool := c.CreatePool("Chapter 1")
+	p1, p2 := c.CreatePic(), c.CreatePic()
+	c.AddPoolPic(pool, p1, 0)
+	c.AddPoolPic(pool, p2, 1)
+
+	task := &RemovePoolPicTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PoolId: pool.PoolId,
+		PicId:  p1.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if ids := c.PoolPicIds(pool); len(ids) != 1 || ids[0] != p2.Pic.PicId {
+		t.Error("wrong pics", ids)
+	}
+
+	task.Pool = nil
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,68 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &RemoveTagFollowTask{}
+
+// RemoveTagFollowTask unfollows a tag for the subject user.  Unfollowing a tag that isn't
+// followed does nothing.
+type RemoveTagFollowTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	TagName string
+}
+
+func (t *RemoveTagFollowTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	// No capability is needed, so that users who can no longer follow can still unfollow.
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	_, uniq, sts := cleanFollowTagName(t.TagName, conf)
+	if sts != nil {
+		return sts
+	}
+
+	tfs, err := j.FindTagFollows(db.Opts{
+		Prefix: tab.TagFollowsPrimary{UserId: &su.UserId, TagName: &uniq},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find tag follows")
+	}
+	if len(tfs) == 0 {
+		if err := j.Rollback(); err != nil {
+			return status.Internal(err, "can't rollback job")
+		}
+		return nil
+	}
+	if err := j.DeleteTagFollow(tab.KeyForTagFollow(tfs[0])); err != nil {
+		return status.Internal(err, "can't delete tag follow")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestRemoveTagFollow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+	u.FollowTag("Cats")
+	u.FollowTag("Dogs")
+
+	task := &RemoveTagFollowTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		TagName: "cats",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	_, tfs := u.Follows()
+	if len(tfs) != 1 || tfs[0].TagName != "Dogs" {
+		t.Error("expected only dogs", tfs)
+	}
+}
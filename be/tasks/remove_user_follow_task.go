@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &RemoveUserFollowTask{}
+
+// RemoveUserFollowTask unfollows a user for the subject user.  Unfollowing a user that isn't
+// followed does nothing.
+type RemoveUserFollowTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	FolloweeUserId int64
+}
+
+func (t *RemoveUserFollowTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	// No capability is needed, so that users who can no longer follow can still unfollow.
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+
+	ufs, err := j.FindUserFollows(db.Opts{
+		Prefix: tab.UserFollowsPrimary{UserId: &su.UserId, FolloweeUserId: &t.FolloweeUserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find user follows")
+	}
+	if len(ufs) == 0 {
+		if err := j.Rollback(); err != nil {
+			return status.Internal(err, "can't rollback job")
+		}
+		return nil
+	}
+	if err := j.DeleteUserFollow(tab.KeyForUserFollow(ufs[0])); err != nil {
+		return status.Internal(err, "can't delete user follow")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
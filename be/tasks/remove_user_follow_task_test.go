@@ -0,0 +1,36 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestRemoveUserFollow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_FOLLOW_CREATE)
+	u.Update()
+	followee := c.CreateUser()
+	u.FollowUser(followee)
+
+	task := &RemoveUserFollowTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		FolloweeUserId: followee.User.UserId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if ufs, _ := u.Follows(); len(ufs) != 0 {
+		t.Error("expected no follows", ufs)
+	}
+
+	// Unfollowing again does nothing.
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+}
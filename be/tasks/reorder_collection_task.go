@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &ReorderCollectionTask{}
+
+// ReorderCollectionTask changes the order of the pics in a collection.  Pics that aren't listed
+// keep their relative order, after the listed pics.  Only the owner may reorder the collection.
+type ReorderCollectionTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	CollectionId int64
+	// PicIds is the new order of the pics.  Each must already be in the collection.
+	PicIds []int64
+
+	// Results
+	Collection *schema.Collection
+}
+
+func (t *ReorderCollectionTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	c, sts := lookupCollectionForUpdate(j, t.CollectionId, su, conf)
+	if sts != nil {
+		return sts
+	}
+
+	cps, sts := findCollectionPics(j, c.CollectionId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	existing := make(map[int64]*schema.CollectionPic, len(cps))
+	for _, cp := range cps {
+		existing[cp.PicId] = cp
+	}
+	ordered := make([]*schema.CollectionPic, 0, len(cps))
+	listed := make(map[int64]bool, len(t.PicIds))
+	for _, picId := range t.PicIds {
+		cp, present := existing[picId]
+		if !present {
+			return status.InvalidArgument(nil, "pic not in collection", picId)
+		}
+		if listed[picId] {
+			return status.InvalidArgument(nil, "duplicate pic", picId)
+		}
+		listed[picId] = true
+		ordered = append(ordered, cp)
+	}
+	for _, cp := range cps {
+		if !listed[cp.PicId] {
+			ordered = append(ordered, cp)
+		}
+	}
+
+	for i, cp := range ordered {
+		if cp.Position == int64(i) {
+			continue
+		}
+		cp.Position = int64(i)
+		cp.SetModifiedTime(now)
+		if err := j.UpdateCollectionPic(cp); err != nil {
+			return status.Internal(err, "can't update collection pic")
+		}
+	}
+	if sts := touchCollection(j, c, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Collection = c
+	return nil
+}
@@ -0,0 +1,66 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestReorderCollection(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	col := u.CreateCollection("Cats", schema.Collection_PRIVATE)
+	p1, p2, p3 := c.CreatePic(), c.CreatePic(), c.CreatePic()
+	c.AddCollectionPic(col, p1, 0)
+	c.AddCollectionPic(col, p2, 1)
+	c.AddCollectionPic(col, p3, 2)
+
+	task := &ReorderCollectionTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		CollectionId: col.CollectionId,
+		PicIds:       []int64{p3.Pic.PicId},
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	ids := c.CollectionPicIds(col)
+	if len(ids) != 3 || ids[0] != p3.Pic.PicId || ids[1] != p1.Pic.PicId || ids[2] != p2.Pic.PicId {
+		t.Error("wrong order", ids)
+	}
+}
+
+func TestReorderCollection_BadPics(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	col := u.CreateCollection("Cats", schema.Collection_PRIVATE)
+	p1, p2 := c.CreatePic(), c.CreatePic()
+	c.AddCollectionPic(col, p1, 0)
+
+	for _, picIds := range [][]int64{{p2.Pic.PicId}, {p1.Pic.PicId, p1.Pic.PicId}} {
+		task := &ReorderCollectionTask{
+			Beg:          c.DB(),
+			Now:          time.Now,
+			CollectionId: col.CollectionId,
+			PicIds:       picIds,
+		}
+		sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+		if sts == nil {
+			t.Fatal("expected non-nil status")
+		}
+		if have, want := sts.Code(), codes.InvalidArgument; have != want {
+			t.Error("have", have, "want", want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &RevokeSessionTask{}
+
+// RevokeSessionTask deletes one or all of the auth tokens of the subject user, logging out the
+// clients using them.  Unlike UnauthUserTask, the token doesn't need to be the one of the caller.
+type RevokeSessionTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	TokenId int64
+	// All revokes every token, including the one of the caller.
+	All bool
+}
+
+func (t *RevokeSessionTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if _, keyPresent := ApiKeyFromCtx(ctx); keyPresent {
+		return status.PermissionDenied(nil, "can't revoke session with api key")
+	}
+	if !t.All && t.TokenId == 0 {
+		return status.InvalidArgument(nil, "missing session")
+	}
+
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&su.UserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "can't lookup user")
+	}
+	user := users[0]
+
+	if t.All {
+		user.UserToken = nil
+	} else {
+		pos := -1
+		for i, ut := range user.UserToken {
+			if ut.TokenId == t.TokenId {
+				pos = i
+				break
+			}
+		}
+		if pos == -1 {
+			return status.NotFound(nil, "can't find session")
+		}
+		user.UserToken = append(user.UserToken[:pos], user.UserToken[pos+1:]...)
+	}
+	user.SetModifiedTime(now)
+
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
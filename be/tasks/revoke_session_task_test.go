@@ -0,0 +1,119 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func TestRevokeSession(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.NextTokenId = 2
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId: 2,
+	})
+	u.Update()
+
+	task := &RevokeSessionTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		TokenId: 2,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if len(u.User.UserToken) != 1 || u.User.UserToken[0].TokenId != 1 {
+		t.Error("expected only other session revoked", u.User.UserToken)
+	}
+}
+
+func TestRevokeSession_All(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.NextTokenId = 2
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId: 2,
+	})
+	u.Update()
+	ctx := u.AuthedCtx(c.Ctx)
+
+	task := &RevokeSessionTask{
+		Beg: c.DB(),
+		Now: time.Now,
+		All: true,
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if len(u.User.UserToken) != 0 {
+		t.Error("expected all sessions revoked", u.User.UserToken)
+	}
+
+	// The current session is logged out too.
+	sts := new(TaskRunner).Run(ctx, &ListSessionsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	})
+	expected := status.Unauthenticated(nil, "token id has been deleted")
+	compareStatus(t, sts, expected)
+}
+
+func TestRevokeSession_MissingSession(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &RevokeSessionTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		TokenId: 2,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.NotFound(nil, "can't find session")
+	compareStatus(t, sts, expected)
+}
+
+func TestRevokeSession_MissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &RevokeSessionTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		TokenId: 1,
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
+
+func TestRevokeSession_CantUseApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	u.CreateApiKey("key", schema.User_PIC_INDEX)
+
+	task := &RevokeSessionTask{
+		Beg: c.DB(),
+		Now: time.Now,
+		All: true,
+	}
+	sts := new(TaskRunner).Run(CtxFromApiKey(c.Ctx, "key"), task)
+	expected := status.PermissionDenied(nil, "can't revoke session with api key")
+	compareStatus(t, sts, expected)
+}
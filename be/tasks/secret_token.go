@@ -0,0 +1,35 @@
+package tasks
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+
+	"pixur.org/pixur/be/status"
+)
+
+const (
+	// secretTokenSize is the number of random bytes in a secret token, such as a reset token or an
+	// invite code.
+	secretTokenSize = 16
+)
+
+// newSecretToken creates a random token that is given to a user, but only stored hashed.  If rnd
+// is nil, crypto/rand is used.
+func newSecretToken(rnd io.Reader) (string, status.S) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	raw := make([]byte, secretTokenSize)
+	if _, err := io.ReadFull(rnd, raw); err != nil {
+		return "", status.Internal(err, "can't create secret token")
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// secretTokenHash is the stored form of a secret token.
+func secretTokenHash(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
 	tab "pixur.org/pixur/be/schema/tables"
@@ -61,6 +63,7 @@ func (t *SoftDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 		return status.NotFound(nil, "can't lookup pic")
 	}
 	p := pics[0]
+	before := proto.Clone(p)
 
 	if p.DeletionStatus != nil {
 		if p.HardDeleted() {
@@ -86,6 +89,14 @@ func (t *SoftDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 	if err := j.UpdatePic(p); err != nil {
 		return status.Internal(err, "can't update pic")
 	}
+	al := &schema.AuditLog{
+		Action:      schema.AuditLog_SOFT_DELETE_PIC,
+		TargetPicId: p.PicId,
+		Reason:      t.Details,
+	}
+	if sts := insertAuditLog(j, al, u, before, p, now); sts != nil {
+		return sts
+	}
 
 	if err := j.Commit(); err != nil {
 		return status.Internal(err, "can't commit job")
@@ -65,6 +65,12 @@ func TestSoftDeleteWorkflow(t *testing.T) {
 	if p.Pic.DeletionStatus.PendingDeletedTs != nil {
 		t.Fatal("Should not have a pending deleted timestamp", p)
 	}
+
+	als := c.AuditLogs()
+	if len(als) != 1 || als[0].Action != schema.AuditLog_SOFT_DELETE_PIC ||
+		als[0].TargetPicId != p.Pic.PicId || als[0].Reason != "LowQuality" {
+		t.Error("expected audit log", als)
+	}
 }
 
 func TestSoftDelete_OverwritePendingTimestamp(t *testing.T) {
@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/totp"
+)
+
+var _ Task = &StartTotpEnrollmentTask{}
+
+// StartTotpEnrollmentTask creates a pending two-factor secret for the subject user.  The current
+// secret must be provided.  Codes aren't required at login until the enrollment is finished with
+// FinishTotpEnrollmentTask.
+type StartTotpEnrollmentTask struct {
+	// Deps
+	Beg                    tab.JobBeginner
+	Now                    func() time.Time
+	CompareHashAndPassword func(hashed, password []byte) error
+	// Rand is the source of the shared secret.  If nil, crypto/rand is used.
+	Rand io.Reader
+
+	// Inputs
+	Secret string
+
+	// Results
+	// TotpSecret is the shared secret to give to the user's authenticator app.
+	TotpSecret []byte
+	// KeyURI is an otpauth:// URI containing TotpSecret.
+	KeyURI string
+}
+
+func (t *StartTotpEnrollmentTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	if sts := validateSecret(t.Secret); sts != nil {
+		return sts
+	}
+
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&su.UserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "can't lookup user")
+	}
+	user := users[0]
+
+	// TODO: rate limit this.
+	if err := compareHashAndPassword(t.CompareHashAndPassword, user.Secret, []byte(t.Secret)); err != nil {
+		return status.Unauthenticated(err, "can't lookup user")
+	}
+	if user.TotpEnrolled() {
+		return status.AlreadyExists(nil, "already enrolled in two-factor authentication")
+	}
+
+	secret, err := totp.NewSecret(t.Rand)
+	if err != nil {
+		return status.Internal(err, "can't create totp secret")
+	}
+	// Replaces any previous pending enrollment.
+	user.Totp = &schema.User_Totp{
+		Secret:    secret,
+		CreatedTs: schema.ToTspb(now),
+	}
+	user.SetModifiedTime(now)
+
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.TotpSecret = secret
+	t.KeyURI = totp.KeyURI(totpIssuer, user.Ident, secret)
+	return nil
+}
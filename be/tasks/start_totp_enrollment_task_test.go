@@ -0,0 +1,90 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/totp"
+)
+
+func TestStartTotpEnrollment(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &StartTotpEnrollmentTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Rand:   bytes.NewReader(testTotpSecret),
+		Secret: "secret",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !bytes.Equal(task.TotpSecret, testTotpSecret) {
+		t.Error("bad secret", task.TotpSecret)
+	}
+	if have, want := task.KeyURI, totp.KeyURI(totpIssuer, u.User.Ident, testTotpSecret); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	u.Refresh()
+	if u.User.Totp == nil || !bytes.Equal(u.User.Totp.Secret, testTotpSecret) {
+		t.Error("expected pending enrollment", u.User.Totp)
+	}
+	if u.User.TotpEnrolled() {
+		t.Error("enrollment should not be confirmed")
+	}
+}
+
+func TestStartTotpEnrollment_WrongSecret(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &StartTotpEnrollmentTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "bogus",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.Unauthenticated(nil, "can't lookup user")
+	compareStatus(t, sts, expected)
+}
+
+func TestStartTotpEnrollment_AlreadyEnrolled(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.enrollTotp()
+	u.Update()
+
+	task := &StartTotpEnrollmentTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.AlreadyExists(nil, "already enrolled in two-factor authentication")
+	compareStatus(t, sts, expected)
+}
+
+func TestStartTotpEnrollment_MissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &StartTotpEnrollmentTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
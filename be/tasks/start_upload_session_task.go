@@ -0,0 +1,160 @@
+package tasks
+
+import (
+	"context"
+	"crypto/md5"
+	"os"
+	"time"
+
+	any "github.com/golang/protobuf/ptypes/any"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &StartUploadSessionTask{}
+
+// StartUploadSessionTask begins a pic upload that can be sent in multiple parts.  An empty staging
+// file is created for the data, which is filled in by AppendUploadSessionTask.  Sessions can only
+// be used by the user who started them, so anonymous users, who can't be told apart, can't start
+// them.  Each user may only have a limited number of sessions at once.
+type StartUploadSessionTask struct {
+	// Deps
+	PixPath string
+	Beg     tab.JobBeginner
+	Now     func() time.Time
+	// os functions
+	MkdirAll func(path string, perm os.FileMode) error
+	OpenFile func(name string, flag int, perm os.FileMode) (*os.File, error)
+	Remove   func(name string) error
+
+	// Inputs
+	FileURL, FileURLReferrer string
+	FileName                 string
+	// Md5Hash is required, so that the pic can be verified once all parts have been received.
+	Md5Hash []byte
+	Ext     map[string]*any.Any
+
+	// Results
+	CreatedUploadSession *schema.UploadSession
+}
+
+func (t *StartUploadSessionTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if u == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(u, conf, schema.User_PIC_CREATE); sts != nil {
+		return sts
+	}
+	if len(t.Ext) != 0 {
+		if sts := validateCapability(u, conf, schema.User_PIC_EXTENSION_CREATE); sts != nil {
+			return sts
+		}
+	}
+	if len(t.Md5Hash) != md5.Size {
+		return status.InvalidArgument(nil, "bad md5 hash")
+	}
+
+	loc, ref, sts := checkUrls(t.FileURL, t.FileURLReferrer, conf)
+	if sts != nil {
+		return sts
+	}
+	var name string
+	if len(t.FileName) > 0 {
+		minFileNameLen, maxFileNameLen := confFileNameLen(conf)
+		if name, sts = validateAndNormalizeFileName(
+			t.FileName, "filename", minFileNameLen, maxFileNameLen); sts != nil {
+			return sts
+		}
+	}
+
+	// Lock the user, so concurrent starts can't go over the max.
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&u.UserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "can't lookup user")
+	}
+	if conf.MaxUploadSessions != nil {
+		uss, err := j.FindUploadSessions(db.Opts{
+			Prefix: tab.UploadSessionsUserId{UserId: &u.UserId},
+			Lock:   db.LockNone,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find upload sessions")
+		}
+		if int64(len(uss)) >= conf.MaxUploadSessions.Value {
+			return status.ResourceExhausted(nil, "too many upload sessions")
+		}
+	}
+
+	id, err := j.AllocId()
+	if err != nil {
+		return status.Internal(err, "can't allocate id")
+	}
+	us := &schema.UploadSession{
+		UploadSessionId: id,
+		UserId:          u.UserId,
+		Name:            name,
+		Md5Hash:         t.Md5Hash,
+		Ext:             t.Ext,
+	}
+	if loc != nil {
+		us.Url = loc.String()
+	}
+	if ref != nil {
+		us.Referrer = ref.String()
+	}
+	us.SetCreatedTime(now)
+	us.SetModifiedTime(now)
+	if err := j.InsertUploadSession(us); err != nil {
+		return status.Internal(err, "can't insert upload session")
+	}
+
+	if err := t.MkdirAll(schema.UploadSessionDir(t.PixPath), 0770); err != nil {
+		return status.Internal(err, "can't prepare upload session dir")
+	}
+	path := schema.UploadSessionPath(t.PixPath, id)
+	f, err := t.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0660)
+	if err != nil {
+		return status.Internal(err, "can't create upload session file")
+	}
+	destroyFile := true
+	defer func() {
+		if destroyFile {
+			if err := t.Remove(path); err != nil {
+				status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't remove", path))
+			}
+		}
+	}()
+	if err := f.Close(); err != nil {
+		return status.Internal(err, "can't close", path)
+	}
+
+	// Keep the file, even if commit fails, in case it actually succeeded.
+	destroyFile = false
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit")
+	}
+
+	t.CreatedUploadSession = us
+	return nil
+}
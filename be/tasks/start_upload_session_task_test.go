@@ -0,0 +1,210 @@
+package tasks
+
+import (
+	"crypto/md5"
+	"os"
+	"testing"
+	"time"
+
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	"pixur.org/pixur/be/status"
+)
+
+func (c *TestContainer) startUploadSession(u *TestUser, md5Hash []byte, now time.Time) *schema.UploadSession {
+	task := &StartUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      func() time.Time { return now },
+		MkdirAll: os.MkdirAll,
+		OpenFile: os.OpenFile,
+		Remove:   os.Remove,
+
+		Md5Hash: md5Hash,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		c.T.Fatal(sts)
+	}
+	return task.CreatedUploadSession
+}
+
+func TestStartUploadSession(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	now := time.Unix(100, 0)
+	task := &StartUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      func() time.Time { return now },
+		MkdirAll: os.MkdirAll,
+		OpenFile: os.OpenFile,
+		Remove:   os.Remove,
+
+		FileURL:  "http://foo/bar.jpg",
+		FileName: "bar.jpg",
+		Md5Hash:  md5Hash[:],
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	us := task.CreatedUploadSession
+	if us == nil {
+		t.Fatal("no upload session")
+	}
+	if have, want := us.UserId, u.User.UserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := us.Url, "http://foo/bar.jpg"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := us.Name, "bar.jpg"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if !us.GetModifiedTime().Equal(now) {
+		t.Error("bad modified time", us.ModifiedTs)
+	}
+
+	fi, err := os.Stat(schema.UploadSessionPath(c.TempDir(), us.UploadSessionId))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Size() != 0 {
+		t.Error("expected empty file", fi.Size())
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	uss, err := j.FindUploadSessions(db.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uss) != 1 || uss[0].UploadSessionId != us.UploadSessionId {
+		t.Error("bad upload sessions", uss)
+	}
+}
+
+func TestStartUploadSession_MissingUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	md5Hash := md5.Sum([]byte("data"))
+	task := &StartUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      time.Now,
+		MkdirAll: os.MkdirAll,
+		OpenFile: os.OpenFile,
+		Remove:   os.Remove,
+
+		Md5Hash: md5Hash[:],
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
+
+func TestStartUploadSession_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	md5Hash := md5.Sum([]byte("data"))
+	task := &StartUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      time.Now,
+		MkdirAll: os.MkdirAll,
+		OpenFile: os.OpenFile,
+		Remove:   os.Remove,
+
+		Md5Hash: md5Hash[:],
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.PermissionDenied(nil, "missing cap PIC_CREATE")
+	compareStatus(t, sts, expected)
+}
+
+func TestStartUploadSession_BadMd5(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	task := &StartUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      time.Now,
+		MkdirAll: os.MkdirAll,
+		OpenFile: os.OpenFile,
+		Remove:   os.Remove,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.InvalidArgument(nil, "bad md5 hash")
+	compareStatus(t, sts, expected)
+
+	j := c.Job()
+	defer j.Rollback()
+	uss, err := j.FindUploadSessions(db.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uss) != 0 {
+		t.Error("unexpected upload sessions", uss)
+	}
+}
+
+func TestStartUploadSession_TooManySessions(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	other := c.CreateUser()
+	other.User.Capability = append(other.User.Capability, schema.User_PIC_CREATE)
+	other.Update()
+
+	md5Hash := md5.Sum([]byte("data"))
+	// Sessions of other users don't count.
+	c.startUploadSession(other, md5Hash[:], time.Now())
+	c.startUploadSession(u, md5Hash[:], time.Now())
+
+	task := &StartUploadSessionTask{
+		PixPath:  c.TempDir(),
+		Beg:      c.DB(),
+		Now:      time.Now,
+		MkdirAll: os.MkdirAll,
+		OpenFile: os.OpenFile,
+		Remove:   os.Remove,
+
+		Md5Hash: md5Hash[:],
+	}
+	conf := schema.GetDefaultConfiguration()
+	conf.MaxUploadSessions = &wpb.Int64Value{Value: 1}
+	ctx := CtxFromTestConfig(u.AuthedCtx(c.Ctx), conf)
+	sts := new(TaskRunner).Run(ctx, task)
+	expected := status.ResourceExhausted(nil, "too many upload sessions")
+	compareStatus(t, sts, expected)
+
+	j := c.Job()
+	defer j.Rollback()
+	uss, err := j.FindUploadSessions(db.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uss) != 2 {
+		t.Error("expected no new session", uss)
+	}
+}
@@ -0,0 +1,136 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+
+	"pixur.org/pixur/be/notify"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+var _ Task = &StartUserSecretResetTask{}
+
+const (
+	// defaultPasswordResetExpiry is used if the configuration doesn't specify one.  Reset tokens
+	// always expire.
+	defaultPasswordResetExpiry = time.Hour
+)
+
+// StartUserSecretResetTask begins resetting the secret of a user who has forgotten it.  A random,
+// single use reset token is stored (hashed) on the user, and sent to them using the Notifier.  To
+// avoid revealing which idents exist, no error is returned if the ident is unknown or if the
+// token can't be sent.
+type StartUserSecretResetTask struct {
+	// Deps
+	Beg      tab.JobBeginner
+	Now      func() time.Time
+	Notifier notify.Notifier
+	// Rand is the source of reset tokens.  If nil, crypto/rand is used.
+	Rand io.Reader
+
+	// Inputs
+	Ident string
+}
+
+func (t *StartUserSecretResetTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, _, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if t.Notifier == nil {
+		return status.FailedPrecondition(nil, "secret reset not configured")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ident, sts := normalizeUserIdent(t.Ident, conf)
+	if sts != nil {
+		return sts
+	}
+	keyident := schema.UserUniqueIdent(ident)
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersIdent{&keyident},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		// Pretend it worked.
+		return nil
+	}
+	user := users[0]
+
+	resetToken, sts := newSecretToken(t.Rand)
+	if sts != nil {
+		return sts
+	}
+
+	expiry := defaultPasswordResetExpiry
+	if conf.PasswordResetExpiry != nil {
+		if expiry, err = ptypes.Duration(conf.PasswordResetExpiry); err != nil {
+			return status.Internal(err, "can't parse reset expiry")
+		}
+	}
+	expireTime := now.Add(expiry)
+	user.PasswordReset = &schema.User_PasswordReset{
+		TokenHash: secretTokenHash(resetToken),
+		CreatedTs: schema.ToTspb(now),
+		ExpireTs:  schema.ToTspb(expireTime),
+	}
+	user.SetModifiedTime(now)
+
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	n := &notify.Notification{
+		To:      user.Ident,
+		Subject: "Pixur secret reset",
+		Body: fmt.Sprintf("Your secret reset code is:\n\n%s\n\nThe code expires at %s.\n",
+			resetToken, expireTime.UTC().Format(time.RFC1123)),
+	}
+	if err := t.Notifier.Notify(ctx, n); err != nil {
+		// The user can ask for another token, so only the operator needs to know.
+		glog.Warning(status.Internal(err, "can't send reset token").String())
+	}
+	return nil
+}
+
+// normalizeUserIdent validates and normalizes a user provided ident.
+func normalizeUserIdent(ident string, conf *schema.Configuration) (string, status.S) {
+	var minIdentLen, maxIdentLen int64
+	if conf.MinIdentLength != nil {
+		minIdentLen = conf.MinIdentLength.Value
+	} else {
+		minIdentLen = math.MinInt64
+	}
+	if conf.MaxIdentLength != nil {
+		maxIdentLen = conf.MaxIdentLength.Value
+	} else {
+		maxIdentLen = math.MaxInt64
+	}
+	normident, err := text.DefaultValidateNoNewlineAndNormalize(ident, "ident", minIdentLen, maxIdentLen)
+	if err != nil {
+		return "", status.From(err)
+	}
+	return normident, nil
+}
@@ -0,0 +1,120 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/notify"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+type testNotifier struct {
+	sent []*notify.Notification
+	err  error
+}
+
+func (tn *testNotifier) Notify(ctx context.Context, n *notify.Notification) error {
+	if tn.err != nil {
+		return tn.err
+	}
+	tn.sent = append(tn.sent, n)
+	return nil
+}
+
+func TestStartUserSecretReset(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	tn := new(testNotifier)
+	now := time.Now()
+	task := &StartUserSecretResetTask{
+		Beg:      c.DB(),
+		Now:      func() time.Time { return now },
+		Notifier: tn,
+		Rand:     bytes.NewReader(make([]byte, secretTokenSize)),
+		Ident:    u.User.Ident,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	resetToken := base64.RawURLEncoding.EncodeToString(make([]byte, secretTokenSize))
+	if len(tn.sent) != 1 {
+		t.Fatal("expected one notification", tn.sent)
+	}
+	if tn.sent[0].To != u.User.Ident || !strings.Contains(tn.sent[0].Body, resetToken) {
+		t.Error("bad notification", tn.sent[0])
+	}
+
+	u.Refresh()
+	pr := u.User.PasswordReset
+	if pr == nil {
+		t.Fatal("missing reset")
+	}
+	tokenHash := sha256.Sum256([]byte(resetToken))
+	if !bytes.Equal(pr.TokenHash, tokenHash[:]) {
+		t.Error("bad token hash", pr.TokenHash)
+	}
+	if have, want := schema.ToTime(pr.ExpireTs), now.Add(time.Hour); !have.Equal(want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestStartUserSecretReset_UnknownIdent(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	tn := new(testNotifier)
+	task := &StartUserSecretResetTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		Notifier: tn,
+		Ident:    "nobody@example.com",
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(tn.sent) != 0 {
+		t.Error("should not notify", tn.sent)
+	}
+}
+
+func TestStartUserSecretReset_NotifyFailureHidden(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	tn := &testNotifier{err: errors.New("bad smtp")}
+	task := &StartUserSecretResetTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		Notifier: tn,
+		Ident:    u.User.Ident,
+	}
+	// Failing to send must look the same as an unknown ident.
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+}
+
+func TestStartUserSecretReset_NoNotifier(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &StartUserSecretResetTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Ident: "nobody@example.com",
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.FailedPrecondition(nil, "secret reset not configured")
+	compareStatus(t, sts, expected)
+}
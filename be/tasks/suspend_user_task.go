@@ -0,0 +1,128 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+const (
+	maxSuspensionReasonLength = 1024
+)
+
+var _ Task = &SuspendUserTask{}
+
+// SuspendUserTask suspends a user, so that they can only read until the suspension ends.  The
+// user's capabilities are kept, and return when the suspension ends or is lifted.  Suspending an
+// already suspended user replaces the suspension.
+type SuspendUserTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	ObjectUserId int64
+	Reason       string
+	// Duration is how long the suspension lasts.  If 0, it lasts until the user is unsuspended.
+	Duration time.Duration
+
+	// Results
+	User *schema.User
+}
+
+func (t *SuspendUserTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_USER_SUSPEND); sts != nil {
+		return sts
+	}
+	if t.ObjectUserId == schema.AnonymousUserId {
+		return status.InvalidArgument(nil, "missing user id")
+	}
+	if t.ObjectUserId == su.UserId {
+		return status.InvalidArgument(nil, "can't suspend self")
+	}
+	reason, err := text.DefaultValidateAndNormalize(t.Reason, "reason", 1, maxSuspensionReasonLength)
+	if err != nil {
+		return status.From(err)
+	}
+	if t.Duration < 0 {
+		return status.InvalidArgument(nil, "bad duration")
+	}
+
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&t.ObjectUserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.NotFound(nil, "can't lookup user")
+	}
+	user := users[0]
+	before := &schema.User{Suspension: user.Suspension}
+
+	nowts := schema.ToTspb(now)
+	user.Suspension = &schema.User_Suspension{
+		Reason:       reason,
+		IssuerUserId: su.UserId,
+		StartTs:      nowts,
+	}
+	if t.Duration != 0 {
+		user.Suspension.EndTs = schema.ToTspb(now.Add(t.Duration))
+	}
+	user.ModifiedTs = nowts
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+
+	ue := &schema.UserEvent{
+		UserId:     user.UserId,
+		Index:      0,
+		CreatedTs:  nowts,
+		ModifiedTs: nowts,
+		Evt: &schema.UserEvent_Suspend_{
+			Suspend: &schema.UserEvent_Suspend{
+				Reason: reason,
+				EndTs:  user.Suspension.EndTs,
+			},
+		},
+	}
+	if err := j.InsertUserEvent(ue); err != nil {
+		return status.Internal(err, "can't insert user event")
+	}
+	al := &schema.AuditLog{
+		Action:       schema.AuditLog_SUSPEND_USER,
+		TargetUserId: user.UserId,
+		Reason:       reason,
+	}
+	if sts := insertAuditLog(
+		j, al, su, before, &schema.User{Suspension: user.Suspension}, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.User = user
+	return nil
+}
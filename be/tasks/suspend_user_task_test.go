@@ -0,0 +1,149 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func TestSuspendUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	admin := c.CreateUser()
+	admin.User.Capability = append(admin.User.Capability, schema.User_USER_SUSPEND)
+	admin.Update()
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	now := time.Now()
+	task := &SuspendUserTask{
+		Beg:          c.DB(),
+		Now:          func() time.Time { return now },
+		ObjectUserId: u.User.UserId,
+		Reason:       "spam",
+		Duration:     time.Hour,
+	}
+	if sts := new(TaskRunner).Run(admin.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	s := u.User.Suspension
+	if s == nil || s.Reason != "spam" || s.IssuerUserId != admin.User.UserId ||
+		!schema.ToTime(s.EndTs).Equal(now.Add(time.Hour)) {
+		t.Error("bad suspension", s)
+	}
+	if !u.User.Suspended(now) {
+		t.Error("expected user to be suspended")
+	}
+
+	j := c.Job()
+	ues, err := j.FindUserEvents(db.Opts{
+		Prefix: tab.UserEventsPrimary{UserId: &u.User.UserId},
+	})
+	j.Rollback()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ues) != 1 || ues[0].GetSuspend().GetReason() != "spam" {
+		t.Error("expected suspend event", ues)
+	}
+
+	als := c.AuditLogs()
+	if len(als) != 1 || als[0].Action != schema.AuditLog_SUSPEND_USER ||
+		als[0].ActorUserId != admin.User.UserId || als[0].TargetUserId != u.User.UserId ||
+		als[0].Reason != "spam" || len(als[0].Change) != 1 {
+		t.Error("expected audit log", als)
+	}
+}
+
+func TestSuspendUser_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	admin := c.CreateUser()
+	u := c.CreateUser()
+
+	task := &SuspendUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u.User.UserId,
+		Reason:       "spam",
+	}
+	sts := new(TaskRunner).Run(admin.AuthedCtx(c.Ctx), task)
+	expected := status.PermissionDenied(nil, "missing cap USER_SUSPEND")
+	compareStatus(t, sts, expected)
+}
+
+func TestSuspendUser_CantSuspendSelf(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	admin := c.CreateUser()
+	admin.User.Capability = append(admin.User.Capability, schema.User_USER_SUSPEND)
+	admin.Update()
+
+	task := &SuspendUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: admin.User.UserId,
+		Reason:       "spam",
+	}
+	sts := new(TaskRunner).Run(admin.AuthedCtx(c.Ctx), task)
+	expected := status.InvalidArgument(nil, "can't suspend self")
+	compareStatus(t, sts, expected)
+}
+
+func TestSuspendUser_MissingReason(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	admin := c.CreateUser()
+	admin.User.Capability = append(admin.User.Capability, schema.User_USER_SUSPEND)
+	admin.Update()
+	u := c.CreateUser()
+
+	task := &SuspendUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u.User.UserId,
+	}
+	sts := new(TaskRunner).Run(admin.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	u.Refresh()
+	if u.User.Suspension != nil {
+		t.Error("expected no suspension", u.User.Suspension)
+	}
+}
+
+func TestSuspendUser_SuspendedAdminCantSuspend(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	admin := c.CreateUser()
+	admin.User.Capability = append(admin.User.Capability, schema.User_USER_SUSPEND)
+	admin.User.Suspension = &schema.User_Suspension{
+		Reason:  "rogue",
+		StartTs: schema.ToTspb(time.Now().Add(-time.Minute)),
+	}
+	admin.Update()
+	u := c.CreateUser()
+
+	task := &SuspendUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u.User.UserId,
+		Reason:       "spam",
+	}
+	sts := new(TaskRunner).Run(admin.AuthedCtx(c.Ctx), task)
+	expected := status.PermissionDenied(nil, "missing cap USER_SUSPEND")
+	compareStatus(t, sts, expected)
+}
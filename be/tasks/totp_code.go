@@ -0,0 +1,50 @@
+package tasks
+
+import (
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/totp"
+)
+
+const (
+	// totpIssuer is the name authenticator apps show next to codes.
+	totpIssuer = "Pixur"
+	// totpSkew is how many time steps of clock drift are allowed in either direction.
+	totpSkew = 1
+	// totpRecoveryCodes is the number of recovery codes created at enrollment.
+	totpRecoveryCodes = 10
+)
+
+// verifyTotpCode checks code against an enrollment, which may be a current code or an unused
+// recovery code.  On success, tot is updated so the code can't be used again, and the caller must
+// save it.
+func verifyTotpCode(tot *schema.User_Totp, code string, now time.Time) bool {
+	code = normalizeTotpCode(code)
+	if code == "" {
+		return false
+	}
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(tot.Secret, code, now, totpSkew)
+		if !ok || step <= tot.LastStep {
+			return false
+		}
+		tot.LastStep = step
+		return true
+	}
+	hash := secretTokenHash(code)
+	for i, h := range tot.RecoveryCodeHash {
+		if subtle.ConstantTimeCompare(h, hash) == 1 {
+			tot.RecoveryCodeHash = append(tot.RecoveryCodeHash[:i], tot.RecoveryCodeHash[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeTotpCode removes whitespace, which users often include when copying codes.
+func normalizeTotpCode(code string) string {
+	return strings.Join(strings.Fields(code), "")
+}
@@ -0,0 +1,71 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/totp"
+)
+
+var testTotpSecret = []byte("12345678901234567890")
+
+// enrollTotp enrolls the user in two-factor authentication with testTotpSecret, and the given
+// recovery codes.  The caller must call Update.
+func (u *TestUser) enrollTotp(recoveryCodes ...string) {
+	nowts := schema.ToTspb(time.Now())
+	u.User.Totp = &schema.User_Totp{
+		Secret:      testTotpSecret,
+		CreatedTs:   nowts,
+		ConfirmedTs: nowts,
+	}
+	for _, code := range recoveryCodes {
+		u.User.Totp.RecoveryCodeHash = append(u.User.Totp.RecoveryCodeHash, secretTokenHash(code))
+	}
+}
+
+func TestVerifyTotpCode(t *testing.T) {
+	now := time.Now()
+	tot := &schema.User_Totp{
+		Secret: testTotpSecret,
+	}
+	code := totp.Code(testTotpSecret, totp.Step(now))
+	if !verifyTotpCode(tot, code[:3]+" "+code[3:], now) {
+		t.Fatal("expected valid code")
+	}
+	if have, want := tot.LastStep, totp.Step(now); have != want {
+		t.Error("have", have, "want", want)
+	}
+	// Codes can't be replayed.
+	if verifyTotpCode(tot, code, now) {
+		t.Error("expected replayed code to fail")
+	}
+}
+
+func TestVerifyTotpCode_RecoveryCode(t *testing.T) {
+	tot := &schema.User_Totp{
+		Secret:           testTotpSecret,
+		RecoveryCodeHash: [][]byte{secretTokenHash("recovery1"), secretTokenHash("recovery2")},
+	}
+	if !verifyTotpCode(tot, "recovery2", time.Now()) {
+		t.Fatal("expected valid recovery code")
+	}
+	if len(tot.RecoveryCodeHash) != 1 {
+		t.Error("expected recovery code to be consumed", tot.RecoveryCodeHash)
+	}
+	if verifyTotpCode(tot, "recovery2", time.Now()) {
+		t.Error("expected used recovery code to fail")
+	}
+}
+
+func TestVerifyTotpCode_BadCode(t *testing.T) {
+	tot := &schema.User_Totp{
+		Secret: testTotpSecret,
+	}
+	if verifyTotpCode(tot, "", time.Now()) {
+		t.Error("expected empty code to fail")
+	}
+	if verifyTotpCode(tot, "bogus", time.Now()) {
+		t.Error("expected bad code to fail")
+	}
+}
@@ -0,0 +1,85 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &UnlockLoginTask{}
+
+// UnlockLoginTask forgets the failed logins of a user or address, ending any lockout.
+type UnlockLoginTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// UserId and RemoteAddr are what to unlock.  At least one must be set.
+	UserId     int64
+	RemoteAddr string
+}
+
+func (t *UnlockLoginTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	// Unlocking is an admin action, like changing capabilities.
+	if sts := validateCapability(su, conf, schema.User_USER_UPDATE_CAPABILITY); sts != nil {
+		return sts
+	}
+
+	var subjects []string
+	if t.UserId != 0 {
+		subjects = append(subjects, userLoginFailureSubject(t.UserId))
+	}
+	if t.RemoteAddr != "" {
+		subjects = append(subjects, addrLoginFailureSubject(t.RemoteAddr))
+	}
+	if len(subjects) == 0 {
+		return status.InvalidArgument(nil, "missing user or address")
+	}
+	for _, subject := range subjects {
+		lf, sts := findLoginFailure(j, subject)
+		if sts != nil {
+			return sts
+		}
+		if sts := deleteLoginFailure(j, lf); sts != nil {
+			return sts
+		}
+		// The subject is recorded even if there was nothing to forget, so the unlock is still
+		// visible.
+		before := &schema.LoginFailure{Subject: subject}
+		if lf != nil {
+			before = lf
+		}
+		al := &schema.AuditLog{
+			Action: schema.AuditLog_UNLOCK_LOGIN,
+		}
+		if subject == userLoginFailureSubject(t.UserId) {
+			al.TargetUserId = t.UserId
+		}
+		if sts := insertAuditLog(j, al, su, before, nil, now); sts != nil {
+			return sts
+		}
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
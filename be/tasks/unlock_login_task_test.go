@@ -0,0 +1,109 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestUnlockLogin(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	for i := 0; i < maxLoginFailures; i++ {
+		task := &AuthUserTask{
+			Beg:                    c.DB(),
+			Now:                    time.Now,
+			CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+			Ident:                  u.User.Ident,
+			Secret:                 "bogus",
+			RemoteAddr:             "10.0.0.1",
+		}
+		new(TaskRunner).Run(c.Ctx, task)
+	}
+
+	admin := c.CreateUser()
+	admin.User.Capability = append(admin.User.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	admin.Update()
+
+	task := &UnlockLoginTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		UserId:     u.User.UserId,
+		RemoteAddr: "10.0.0.1",
+	}
+	if sts := new(TaskRunner).Run(admin.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	als := c.AuditLogs()
+	if len(als) != 2 {
+		t.Fatal("expected audit logs", als)
+	}
+	for _, al := range als {
+		if al.Action != schema.AuditLog_UNLOCK_LOGIN || al.ActorUserId != admin.User.UserId {
+			t.Error("bad audit log", al)
+		}
+	}
+	if als[0].TargetUserId != u.User.UserId && als[1].TargetUserId != u.User.UserId {
+		t.Error("expected unlocked user", als)
+	}
+
+	login := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    time.Now,
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		Ident:                  u.User.Ident,
+		Secret:                 "secret",
+		RemoteAddr:             "10.0.0.1",
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, login); sts != nil {
+		t.Error(sts)
+	}
+}
+
+func TestUnlockLogin_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	task := &UnlockLoginTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		UserId: u.User.UserId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing cap"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUnlockLogin_MissingSubject(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	u.Update()
+
+	task := &UnlockLoginTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.InvalidArgument {
+		t.Error("expected invalid argument", sts)
+	}
+}
@@ -0,0 +1,99 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &UnsuspendUserTask{}
+
+// UnsuspendUserTask ends the suspension of a user before it would otherwise end.
+type UnsuspendUserTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	ObjectUserId int64
+
+	// Results
+	User *schema.User
+}
+
+func (t *UnsuspendUserTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(su, conf, schema.User_USER_SUSPEND); sts != nil {
+		return sts
+	}
+	if t.ObjectUserId == schema.AnonymousUserId {
+		return status.InvalidArgument(nil, "missing user id")
+	}
+
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&t.ObjectUserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.NotFound(nil, "can't lookup user")
+	}
+	user := users[0]
+	if !user.Suspended(now) {
+		return status.FailedPrecondition(nil, "user not suspended")
+	}
+
+	before := &schema.User{Suspension: user.Suspension}
+	nowts := schema.ToTspb(now)
+	user.Suspension = nil
+	user.ModifiedTs = nowts
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+
+	ue := &schema.UserEvent{
+		UserId:     user.UserId,
+		Index:      0,
+		CreatedTs:  nowts,
+		ModifiedTs: nowts,
+		Evt: &schema.UserEvent_Unsuspend_{
+			Unsuspend: &schema.UserEvent_Unsuspend{},
+		},
+	}
+	if err := j.InsertUserEvent(ue); err != nil {
+		return status.Internal(err, "can't insert user event")
+	}
+	al := &schema.AuditLog{
+		Action:       schema.AuditLog_UNSUSPEND_USER,
+		TargetUserId: user.UserId,
+	}
+	if sts := insertAuditLog(j, al, su, before, &schema.User{}, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.User = user
+	return nil
+}
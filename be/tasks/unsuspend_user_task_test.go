@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func TestUnsuspendUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	admin := c.CreateUser()
+	admin.User.Capability = append(admin.User.Capability, schema.User_USER_SUSPEND)
+	admin.Update()
+	u := c.CreateUser()
+	u.User.Suspension = &schema.User_Suspension{
+		Reason:       "spam",
+		IssuerUserId: admin.User.UserId,
+		StartTs:      schema.ToTspb(time.Now().Add(-time.Minute)),
+	}
+	u.Update()
+
+	task := &UnsuspendUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u.User.UserId,
+	}
+	if sts := new(TaskRunner).Run(admin.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if u.User.Suspension != nil {
+		t.Error("expected suspension to be lifted", u.User.Suspension)
+	}
+
+	j := c.Job()
+	ues, err := j.FindUserEvents(db.Opts{
+		Prefix: tab.UserEventsPrimary{UserId: &u.User.UserId},
+	})
+	j.Rollback()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ues) != 1 || ues[0].GetUnsuspend() == nil {
+		t.Error("expected unsuspend event", ues)
+	}
+}
+
+func TestUnsuspendUser_NotSuspended(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	admin := c.CreateUser()
+	admin.User.Capability = append(admin.User.Capability, schema.User_USER_SUSPEND)
+	admin.Update()
+	u := c.CreateUser()
+
+	task := &UnsuspendUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u.User.UserId,
+	}
+	sts := new(TaskRunner).Run(admin.AuthedCtx(c.Ctx), task)
+	expected := status.FailedPrecondition(nil, "user not suspended")
+	compareStatus(t, sts, expected)
+}
+
+func TestUnsuspendUser_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	admin := c.CreateUser()
+	u := c.CreateUser()
+
+	task := &UnsuspendUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u.User.UserId,
+	}
+	sts := new(TaskRunner).Run(admin.AuthedCtx(c.Ctx), task)
+	expected := status.PermissionDenied(nil, "missing cap USER_SUSPEND")
+	compareStatus(t, sts, expected)
+}
@@ -0,0 +1,71 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &UpdateCollectionTask{}
+
+// UpdateCollectionTask renames a collection, or changes who can see it.  Only the owner may update
+// the collection.
+type UpdateCollectionTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	CollectionId int64
+	Version      int64
+	Name         string
+	// Visibility is who can see the collection.  If unset, the collection is private.
+	Visibility schema.Collection_Visibility
+
+	// Results
+	Collection *schema.Collection
+}
+
+func (t *UpdateCollectionTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	name, sts := validateCollectionName(t.Name, conf)
+	if sts != nil {
+		return sts
+	}
+	vis, sts := validateCollectionVisibility(t.Visibility)
+	if sts != nil {
+		return sts
+	}
+	c, sts := lookupCollectionForUpdate(j, t.CollectionId, su, conf)
+	if sts != nil {
+		return sts
+	}
+	if c.Version() != t.Version {
+		return status.Aborted(nil, "version mismatch")
+	}
+
+	c.Name = name
+	c.Visibility = vis
+	if sts := touchCollection(j, c, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Collection = c
+	return nil
+}
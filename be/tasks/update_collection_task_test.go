@@ -0,0 +1,99 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestUpdateCollection(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	col := u.CreateCollection("Cats", schema.Collection_PRIVATE)
+
+	task := &UpdateCollectionTask{
+		Beg:          c.DB(),
+		Now:          func() time.Time { return time.Now().Add(time.Second) },
+		CollectionId: col.CollectionId,
+		Version:      col.Version(),
+		Name:         "Dogs",
+		Visibility:   schema.Collection_PUBLIC,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.Collection.Name != "Dogs" || task.Collection.Visibility != schema.Collection_PUBLIC {
+		t.Error("wrong collection", task.Collection)
+	}
+	if task.Collection.Version() == col.Version() {
+		t.Error("expected version change")
+	}
+}
+
+func TestUpdateCollection_VersionMismatch(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	col := u.CreateCollection("Cats", schema.Collection_PRIVATE)
+
+	task := &UpdateCollectionTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		CollectionId: col.CollectionId,
+		Version:      col.Version() - 1,
+		Name:         "Dogs",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.Aborted; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateCollection_OtherUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_COLLECTION_CREATE)
+	u.Update()
+	other := c.CreateUser()
+	private := other.CreateCollection("Private", schema.Collection_PRIVATE)
+	public := other.CreateCollection("Public", schema.Collection_PUBLIC)
+
+	cases := []struct {
+		col  *schema.Collection
+		code codes.Code
+	}{
+		{private, codes.NotFound},
+		{public, codes.PermissionDenied},
+	}
+	for _, cs := range cases {
+		task := &UpdateCollectionTask{
+			Beg:          c.DB(),
+			Now:          time.Now,
+			CollectionId: cs.col.CollectionId,
+			Version:      cs.col.Version(),
+			Name:         "Mine",
+		}
+		sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+		if sts == nil {
+			t.Fatal("expected non-nil status")
+		}
+		if have, want := sts.Code(), cs.code; have != want {
+			t.Error("have", have, "want", want)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/passhash"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &UpdateUserSecretTask{}
+
+// UpdateUserSecretTask changes the secret of the subject user.  The current secret must be
+// provided.  All other tokens of the user are revoked, so that other sessions must log in again.
+type UpdateUserSecretTask struct {
+	// Deps
+	Beg                    tab.JobBeginner
+	Now                    func() time.Time
+	CompareHashAndPassword func(hashed, password []byte) error
+	HashPassword           func([]byte) ([]byte, error)
+
+	// Inputs
+	Secret    string
+	NewSecret string
+
+	// Results
+	User *schema.User
+}
+
+func (t *UpdateUserSecretTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "missing user")
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateSecret(t.Secret); sts != nil {
+		return sts
+	}
+	if sts := validateSecret(t.NewSecret); sts != nil {
+		return sts
+	}
+
+	users, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&su.UserId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find users")
+	}
+	if len(users) != 1 {
+		return status.Unauthenticated(nil, "can't lookup user")
+	}
+	user := users[0]
+
+	// Guessing is limited by the UpdateUserSecret rate limit.
+	if err := compareHashAndPassword(t.CompareHashAndPassword, user.Secret, []byte(t.Secret)); err != nil {
+		return status.Unauthenticated(err, "can't lookup user")
+	}
+	hashed, err := hashPassword(t.HashPassword, conf, []byte(t.NewSecret))
+	if err != nil {
+		return status.Internal(err, "can't generate password")
+	}
+	user.Secret = hashed
+	user.PasswordReset = nil
+
+	var kept []*schema.UserToken
+	if tok, present := UserTokenFromCtx(ctx); present {
+		for _, ut := range user.UserToken {
+			if ut.TokenId == tok.TokenId {
+				kept = append(kept, ut)
+			}
+		}
+	}
+	user.UserToken = kept
+	user.SetModifiedTime(now)
+
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.User = user
+	return nil
+}
+
+// validateSecret checks that a user provided secret is present and not too long.
+func validateSecret(secret string) status.S {
+	if secret == "" {
+		return status.InvalidArgument(nil, "missing secret")
+	} else if len(secret) > maxUserSecretLength {
+		return status.InvalidArgument(nil, "secret too long")
+	}
+	return nil
+}
+
+// passwordHashPolicy returns the configured way to hash secrets, or the default if absent.
+func passwordHashPolicy(conf *schema.Configuration) *passhash.Policy {
+	php := conf.GetPasswordHashPolicy()
+	var alg passhash.Algorithm
+	switch php.GetAlgorithm() {
+	case schema.Configuration_PasswordHashPolicy_BCRYPT:
+		alg = passhash.Bcrypt
+	case schema.Configuration_PasswordHashPolicy_ARGON2ID:
+		alg = passhash.Argon2id
+	default:
+		return passhash.DefaultPolicy
+	}
+	return &passhash.Policy{
+		Algorithm:     alg,
+		BcryptCost:    int(php.BcryptCost),
+		Argon2Time:    uint32(php.Argon2Time),
+		Argon2Memory:  uint32(php.Argon2Memory),
+		Argon2Threads: uint8(php.Argon2Threads),
+	}
+}
+
+// hashPassword hashes password with fn if present, or else with the configured policy.
+func hashPassword(
+	fn func([]byte) ([]byte, error), conf *schema.Configuration, password []byte) ([]byte, error) {
+	if fn != nil {
+		return fn(password)
+	}
+	return passwordHashPolicy(conf).Hash(password)
+}
+
+// compareHashAndPassword checks password with fn if present, or else against any known format.
+func compareHashAndPassword(fn func(hashed, password []byte) error, hashed, password []byte) error {
+	if fn != nil {
+		return fn(hashed, password)
+	}
+	return passhash.Compare(hashed, password)
+}
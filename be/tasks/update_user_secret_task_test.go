@@ -0,0 +1,103 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func minCostHashPassword(password []byte) ([]byte, error) {
+	return bcrypt.GenerateFromPassword(password, bcrypt.MinCost)
+}
+
+func TestUpdateUserSecret(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	nowts := schema.ToTspb(time.Now())
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId:    2,
+		CreatedTs:  nowts,
+		LastSeenTs: nowts,
+	})
+	u.User.NextTokenId = 2
+	u.Update()
+
+	task := &UpdateUserSecretTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		HashPassword: minCostHashPassword,
+		Secret:       "secret",
+		NewSecret:    "newsecret",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if err := bcrypt.CompareHashAndPassword(u.User.Secret, []byte("newsecret")); err != nil {
+		t.Error("secret not updated", err)
+	}
+	if len(u.User.UserToken) != 1 || u.User.UserToken[0].TokenId != 1 {
+		t.Error("expected only the current token", u.User.UserToken)
+	}
+}
+
+func TestUpdateUserSecret_WrongSecret(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &UpdateUserSecretTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		HashPassword: minCostHashPassword,
+		Secret:       "wrong",
+		NewSecret:    "newsecret",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.Unauthenticated(nil, "can't lookup user")
+	compareStatus(t, sts, expected)
+
+	u.Refresh()
+	if err := bcrypt.CompareHashAndPassword(u.User.Secret, []byte("secret")); err != nil {
+		t.Error("secret should not change", err)
+	}
+}
+
+func TestUpdateUserSecret_MissingNewSecret(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &UpdateUserSecretTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Secret: "secret",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	expected := status.InvalidArgument(nil, "missing secret")
+	compareStatus(t, sts, expected)
+}
+
+func TestUpdateUserSecret_Anonymous(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &UpdateUserSecretTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		Secret:    "secret",
+		NewSecret: "newsecret",
+	}
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	expected := status.Unauthenticated(nil, "missing user")
+	compareStatus(t, sts, expected)
+}
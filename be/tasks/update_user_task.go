@@ -5,10 +5,18 @@ import (
 	"sort"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
 	tab "pixur.org/pixur/be/schema/tables"
 	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+const (
+	maxDisplayNameLength = 64
+	maxBioLength         = 1024
 )
 
 type UpdateUserTask struct {
@@ -25,6 +33,19 @@ type UpdateUserTask struct {
 	// Capabilities to remove
 	ClearCapability []schema.User_Capability
 
+	// Roles to add.  They must be present in the configuration.
+	SetRole []string
+	// Roles to remove
+	ClearRole []string
+
+	// Profile replaces the profile of the user, if present.  Users may change their own profile
+	// with USER_UPDATE_PROFILE, or others' with USER_UPDATE_CAPABILITY.
+	Profile *schema.User_Profile
+
+	// Reason is why the capabilities or roles are changed.  It is recorded in the audit log.
+	// Optional.
+	Reason string
+
 	// Outputs
 	ObjectUser *schema.User
 }
@@ -51,6 +72,18 @@ func (t *UpdateUserTask) Run(ctx context.Context) (stscap status.S) {
 	if ou.Version() != t.Version {
 		return status.Aborted(nil, "version mismatch")
 	}
+	reason, sts := validateAuditReason(t.Reason)
+	if sts != nil {
+		return sts
+	}
+	// Only the capabilities and roles are audited, so other fields, like the secret, aren't copied
+	// into the log.
+	authBefore := &schema.User{
+		Capability: append([]schema.User_Capability(nil), ou.Capability...),
+		Role:       append([]string(nil), ou.Role...),
+	}
+	sort.Sort(userCaps(authBefore.Capability))
+	sort.Strings(authBefore.Role)
 
 	var changed bool
 	if capchange := len(t.SetCapability) + len(t.ClearCapability); capchange > 0 {
@@ -103,12 +136,93 @@ func (t *UpdateUserTask) Run(ctx context.Context) (stscap status.S) {
 		}
 	}
 
+	if rolechange := len(t.SetRole) + len(t.ClearRole); rolechange > 0 {
+		if sts := validateCapability(su, conf, schema.User_USER_UPDATE_CAPABILITY); sts != nil {
+			return sts
+		}
+		both := make(map[string]struct{}, rolechange)
+		for _, r := range t.SetRole {
+			if _, present := conf.GetRole().GetRole()[r]; !present {
+				return status.InvalidArgument(nil, "unknown role", r)
+			}
+			both[r] = struct{}{}
+		}
+		for _, r := range t.ClearRole {
+			// Roles removed from the configuration may still be cleared.
+			both[r] = struct{}{}
+		}
+		if len(both) != rolechange {
+			return status.InvalidArgument(nil, "role change overlap")
+		}
+		allroles := make(map[string]struct{}, len(ou.Role)+len(t.SetRole))
+		for _, r := range ou.Role {
+			allroles[r] = struct{}{}
+		}
+		for _, r := range t.SetRole {
+			allroles[r] = struct{}{}
+		}
+		for _, r := range t.ClearRole {
+			delete(allroles, r)
+		}
+		newroles := make([]string, 0, len(allroles))
+		for r := range allroles {
+			newroles = append(newroles, r)
+		}
+		sort.Strings(newroles)
+
+		oldroles := append([]string(nil), ou.Role...)
+		sort.Strings(oldroles)
+		if len(newroles) != len(oldroles) {
+			changed = true
+		} else {
+			for i := range newroles {
+				if newroles[i] != oldroles[i] {
+					changed = true
+					break
+				}
+			}
+		}
+		ou.Role = newroles
+	}
+
+	if t.Profile != nil {
+		need := schema.User_USER_UPDATE_CAPABILITY
+		if su != nil && su.UserId == ou.UserId {
+			need = schema.User_USER_UPDATE_PROFILE
+		}
+		if sts := validateCapability(su, conf, need); sts != nil {
+			return sts
+		}
+		profile, sts := validateProfile(j, t.Profile)
+		if sts != nil {
+			return sts
+		}
+		if !proto.Equal(profile, ou.Profile) {
+			ou.Profile = profile
+			changed = true
+		}
+	}
+
 	if changed {
 		ou.ModifiedTs = schema.ToTspb(now)
 
 		if err := j.UpdateUser(ou); err != nil {
 			return status.Internal(err, "can't update user")
 		}
+		authAfter := &schema.User{
+			Capability: ou.Capability,
+			Role:       ou.Role,
+		}
+		if !proto.Equal(authBefore, authAfter) {
+			al := &schema.AuditLog{
+				Action:       schema.AuditLog_UPDATE_USER_CAPABILITY,
+				TargetUserId: ou.UserId,
+				Reason:       reason,
+			}
+			if sts := insertAuditLog(j, al, su, authBefore, authAfter, now); sts != nil {
+				return sts
+			}
+		}
 
 		if err := j.Commit(); err != nil {
 			return status.Internal(err, "can't commit")
@@ -123,6 +237,43 @@ func (t *UpdateUserTask) Run(ctx context.Context) (stscap status.S) {
 	return nil
 }
 
+// validateProfile normalizes the text of a profile, and checks that the avatar pic exists.  An
+// empty profile is returned as nil.
+func validateProfile(j *tab.Job, src *schema.User_Profile) (*schema.User_Profile, status.S) {
+	displayName, err := text.DefaultValidateNoNewlineAndNormalize(
+		src.DisplayName, "display name", 0, maxDisplayNameLength)
+	if err != nil {
+		return nil, status.From(err)
+	}
+	bio, err := text.DefaultValidateAndNormalize(src.Bio, "bio", 0, maxBioLength)
+	if err != nil {
+		return nil, status.From(err)
+	}
+	if src.AvatarPicId != 0 {
+		pics, err := j.FindPics(db.Opts{
+			Prefix: tab.PicsPrimary{&src.AvatarPicId},
+			Limit:  1,
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't find pics")
+		}
+		if len(pics) != 1 {
+			return nil, status.InvalidArgument(nil, "can't find avatar pic")
+		}
+		if pics[0].SoftDeleted() || pics[0].HardDeleted() {
+			return nil, status.InvalidArgument(nil, "avatar pic is deleted")
+		}
+	}
+	if displayName == "" && bio == "" && src.AvatarPicId == 0 {
+		return nil, nil
+	}
+	return &schema.User_Profile{
+		DisplayName: displayName,
+		Bio:         bio,
+		AvatarPicId: src.AvatarPicId,
+	}, nil
+}
+
 type userCaps []schema.User_Capability
 
 func (uc userCaps) Len() int {
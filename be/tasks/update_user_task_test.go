@@ -47,6 +47,13 @@ func TestUpdateUserTaskDifferentUser(t *testing.T) {
 	if !proto.Equal(ou.User, task.ObjectUser) {
 		t.Error("user doesn't match", ou.User, task.ObjectUser)
 	}
+
+	als := c.AuditLogs()
+	if len(als) != 1 || als[0].Action != schema.AuditLog_UPDATE_USER_CAPABILITY ||
+		als[0].ActorUserId != su.User.UserId || als[0].TargetUserId != ou.User.UserId ||
+		len(als[0].Change) != 1 || als[0].Change[0].Field != "capability" {
+		t.Error("expected audit log", als)
+	}
 }
 
 func TestUpdateUserTaskSameUserDefault(t *testing.T) {
@@ -312,3 +319,212 @@ func TestUpdateUserTaskMissingObject(t *testing.T) {
 		t.Error("have", have, "want", want)
 	}
 }
+
+func TestUpdateUserTaskSetRole(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	su := c.CreateUser()
+	su.User.Capability = append(su.User.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	su.Update()
+
+	ou := c.CreateUser()
+	ou.User.Role = []string{"moderator"}
+	ou.Update()
+
+	task := &UpdateUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: ou.User.UserId,
+		Version:      ou.User.Version(),
+		SetRole:      []string{"uploader"},
+		ClearRole:    []string{"moderator"},
+	}
+	if sts := new(TaskRunner).Run(su.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	ou.Refresh()
+	if len(ou.User.Role) != 1 || ou.User.Role[0] != "uploader" {
+		t.Error("role not updated", ou.User.Role)
+	}
+	if !proto.Equal(ou.User, task.ObjectUser) {
+		t.Error("user doesn't match", ou.User, task.ObjectUser)
+	}
+}
+
+func TestUpdateUserTaskUnknownRole(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	u.Update()
+
+	task := &UpdateUserTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Version: u.User.Version(),
+		SetRole: []string{"bogus"},
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "unknown role"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateUserTaskRoleMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &UpdateUserTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Version: u.User.Version(),
+		SetRole: []string{"admin"},
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateUserTaskSetProfile(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_UPDATE_PROFILE)
+	u.Update()
+	p := c.CreatePic()
+
+	task := &UpdateUserTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Version: u.User.Version(),
+		Profile: &schema.User_Profile{
+			DisplayName: "Bob",
+			Bio:         "Likes cats.\nAnd dogs.",
+			AvatarPicId: p.Pic.PicId,
+		},
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	expected := &schema.User_Profile{
+		DisplayName: "Bob",
+		Bio:         "Likes cats.\nAnd dogs.",
+		AvatarPicId: p.Pic.PicId,
+	}
+	if !proto.Equal(u.User.Profile, expected) {
+		t.Error("have", u.User.Profile, "want", expected)
+	}
+
+	// An empty profile clears it.
+	task = &UpdateUserTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Version: u.User.Version(),
+		Profile: &schema.User_Profile{},
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	u.Refresh()
+	if u.User.Profile != nil {
+		t.Error("expected profile to be cleared", u.User.Profile)
+	}
+}
+
+func TestUpdateUserTaskProfileBadAvatar(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_UPDATE_PROFILE)
+	u.Update()
+
+	task := &UpdateUserTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Version: u.User.Version(),
+		Profile: &schema.User_Profile{
+			AvatarPicId: 12345,
+		},
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "can't find avatar pic"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateUserTaskProfileTooLong(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_UPDATE_PROFILE)
+	u.Update()
+
+	task := &UpdateUserTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Version: u.User.Version(),
+		Profile: &schema.User_Profile{
+			DisplayName: strings.Repeat("a", maxDisplayNameLength+1),
+		},
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateUserTaskProfileOtherUserMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	su := c.CreateUser()
+	su.User.Capability = append(su.User.Capability, schema.User_USER_UPDATE_PROFILE)
+	su.Update()
+	ou := c.CreateUser()
+
+	task := &UpdateUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: ou.User.UserId,
+		Version:      ou.User.Version(),
+		Profile: &schema.User_Profile{
+			DisplayName: "Mallory",
+		},
+	}
+	sts := new(TaskRunner).Run(su.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha512"
+	"errors"
 	"hash"
 	"io"
 	"math"
@@ -50,7 +51,11 @@ type UpsertPicTask struct {
 	// Inputs
 	FileURL, FileURLReferrer string
 	File                     readerAtReadSeeker
-	Md5Hash                  []byte
+	// FileStream is an alternative to File for pic data that can only be read once, such as a
+	// client stream.  It is written directly into the temp file, which is kept for retries.  Callers
+	// using it must call CleanUp once the task is done running.  Md5Hash must be set when using it.
+	FileStream io.Reader
+	Md5Hash    []byte
 	// If the name is absent, UpsertPicTask will try to derive a name automatically from the FileURL.
 	FileName string
 
@@ -61,6 +66,23 @@ type UpsertPicTask struct {
 	// Results
 	UnfilteredCreatedPic *schema.Pic
 	CreatedPic           *schema.Pic
+
+	// streamPath is where the data read from FileStream is kept.  streamOwned is true if the file
+	// belongs to the task, rather than to a pic that may have been committed.
+	streamPath  string
+	streamOwned bool
+}
+
+// CleanUp removes the data read from FileStream, if the task still owns it.
+func (t *UpsertPicTask) CleanUp() status.S {
+	if !t.streamOwned {
+		return nil
+	}
+	t.streamOwned = false
+	if err := t.Remove(t.streamPath); err != nil && !os.IsNotExist(err) {
+		return status.Internal(err, "can't remove stream file", t.streamPath)
+	}
+	return nil
 }
 
 func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
@@ -121,15 +143,22 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 	var f *os.File
 	var size int64
 	var fileCleanup func(*status.S)
+	// isStreamFile is true if f is the kept FileStream data, rather than a copy.
+	var isStreamFile bool
 	if t.File != nil {
 		var sts status.S
 		if f, fileCleanup, size, sts = t.prepareLocalFile(ctx, t.File); sts != nil {
 			return sts
 		}
+	} else if t.FileStream != nil {
+		var sts status.S
+		if f, fileCleanup, size, isStreamFile, sts = t.prepareStreamFile(ctx, conf); sts != nil {
+			return sts
+		}
 	} else if loc != nil {
 		var disName *dispositionName
 		var sts status.S
-		f, fileCleanup, size, disName, sts = t.prepareRemoteFile(ctx, loc, ref)
+		f, fileCleanup, size, disName, sts = t.prepareRemoteFile(ctx, loc, ref, conf)
 		if sts != nil {
 			return sts
 		}
@@ -384,6 +413,13 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 		ModifiedTs:    nowts,
 	})
 
+	// Pics that are uploaded again after being deleted keep their placeholder and color ident.
+	if p.Placeholder == nil {
+		if sts := insertPlaceholder(j, p, thumb); sts != nil {
+			return sts
+		}
+	}
+
 	if sts := mergePic(j, p, nowts, pfs, userId, ext); sts != nil {
 		return sts
 	}
@@ -410,9 +446,13 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 		}
 		return sts
 	}
+	if isStreamFile {
+		t.streamPath = newpath
+	}
 	destroyNewFile := true
 	defer func() {
-		if destroyNewFile {
+		// The task still owns the stream file, and will reuse it if retried.
+		if destroyNewFile && !isStreamFile {
 			if err := t.Remove(newpath); err != nil {
 				status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't remove", newpath))
 			}
@@ -487,6 +527,9 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 	// case deleting the files would be corruption.  Better to have occasional bad files in the
 	// directory than data corruption.
 	destroyNewFile = false
+	if isStreamFile {
+		t.streamOwned = false
+	}
 	destroyNewThumbnail = false
 	destroyNewDerived = false
 	if err := j.Commit(); err != nil {
@@ -707,6 +750,28 @@ func insertPerceptualHash(j *tab.Job, picId int64, im imaging.PixurImage) status
 	return nil
 }
 
+// insertPlaceholder sets the placeholder on p, and indexes its dominant color.  The thumbnail is
+// much smaller than the pic, and close enough for a placeholder.
+func insertPlaceholder(j *tab.Job, p *schema.Pic, thumb imaging.PixurImage) status.S {
+	placeholder, sts := thumb.Placeholder()
+	if sts != nil {
+		return sts
+	}
+	p.Placeholder = &schema.Pic_Placeholder{
+		BlurHash:      placeholder.BlurHash,
+		DominantColor: placeholder.DominantColor,
+	}
+	colorIdent := &schema.PicIdent{
+		PicId: p.PicId,
+		Type:  schema.PicIdent_DOMINANT_COLOR,
+		Value: schema.ColorIdentValue(placeholder.DominantColor),
+	}
+	if err := j.InsertPicIdent(colorIdent); err != nil {
+		return status.Internal(err, "can't create dominant color")
+	}
+	return nil
+}
+
 // TODO: test
 func (t *UpsertPicTask) prepareLocalFile(ctx context.Context, r io.ReadSeeker) (
 	_ *os.File, _ func(*status.S), _ int64, stscap status.S) {
@@ -735,6 +800,76 @@ func (t *UpsertPicTask) prepareLocalFile(ctx context.Context, r io.ReadSeeker) (
 	return f, cleanup, size, nil
 }
 
+// prepareStreamFile copies FileStream into a temp file the first time the task runs.  Retries reuse
+// the file directly while the task owns it, or copy it if it became part of a pic.  The returned
+// bool is true if the file is the kept stream file, in which case the cleanup only closes it.
+func (t *UpsertPicTask) prepareStreamFile(ctx context.Context, conf *schema.Configuration) (
+	_ *os.File, _ func(*status.S), _ int64, _ bool, stscap status.S) {
+	if len(t.Md5Hash) == 0 {
+		return nil, nil, 0, false, status.InvalidArgument(nil, "missing md5 hash for streamed pic")
+	}
+	if t.streamPath == "" {
+		var r io.Reader = t.FileStream
+		if conf.MaxUploadStreamSize != nil {
+			// Read one extra byte to detect streams that are too large.
+			r = io.LimitReader(r, conf.MaxUploadStreamSize.Value+1)
+		}
+		var size int64
+		// The file is removed by CleanUp rather than the returned cleanup, so retries can reuse it.
+		f, _, sts := t.prepareFile(func(w io.Writer) status.S {
+			n, err := io.Copy(w, r)
+			if sts, ok := err.(status.S); ok {
+				return sts
+			} else if err != nil {
+				// Like remote files, assume the sender is at fault rather than our system.
+				return status.InvalidArgument(err, "can't copy file")
+			}
+			if conf.MaxUploadStreamSize != nil && n > conf.MaxUploadStreamSize.Value {
+				return status.InvalidArgumentf(nil, "file too large > %d", conf.MaxUploadStreamSize.Value)
+			}
+			size = n
+			return nil
+		})
+		if sts != nil {
+			return nil, nil, 0, false, sts
+		}
+		t.streamPath, t.streamOwned = f.Name(), true
+		return f, closeFileCleanup(f), size, true, nil
+	}
+
+	sf, err := os.Open(t.streamPath)
+	if err != nil {
+		return nil, nil, 0, false, status.Internal(err, "can't open stream file", t.streamPath)
+	}
+	if !t.streamOwned {
+		defer func() {
+			if err := sf.Close(); err != nil {
+				status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't close", sf.Name()))
+			}
+		}()
+		f, cleanup, size, sts := t.prepareLocalFile(ctx, sf)
+		if sts != nil {
+			return nil, nil, 0, false, sts
+		}
+		return f, cleanup, size, false, nil
+	}
+	fi, err := sf.Stat()
+	if err != nil {
+		sts := status.Internal(err, "can't stat stream file", sf.Name())
+		closeFileCleanup(sf)(&sts)
+		return nil, nil, 0, false, sts
+	}
+	return sf, closeFileCleanup(sf), fi.Size(), true, nil
+}
+
+func closeFileCleanup(f *os.File) func(*status.S) {
+	return func(stscap *status.S) {
+		if err := f.Close(); err != nil {
+			status.ReplaceOrSuppress(stscap, status.Internal(err, "can't close tempfile", f.Name()))
+		}
+	}
+}
+
 // TODO: test
 func (t *UpsertPicTask) prepareFile(move func(io.Writer) status.S) (
 	_ *os.File, _ func(*status.S), stscap status.S) {
@@ -839,11 +974,21 @@ func validateAndNormalizeURL(rawurl string, minLen, maxLen int64) (*url.URL, sta
 	return loc, nil
 }
 
-func (t *UpsertPicTask) prepareRemoteFile(ctx context.Context, loc, ref *url.URL) (
+func (t *UpsertPicTask) prepareRemoteFile(
+	ctx context.Context, loc, ref *url.URL, conf *schema.Configuration) (
 	_ *os.File, _ func(*status.S), _ int64, _ *dispositionName, stscap status.S) {
 	if loc == nil {
 		return nil, nil, 0, nil, status.InvalidArgument(nil, "missing URL")
 	}
+	timeout, maxSize, sts := confRemoteFetchLimits(conf)
+	if sts != nil {
+		return nil, nil, 0, nil, sts
+	}
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
 
 	req, err := http.NewRequest(http.MethodGet, loc.String(), nil)
 	if err != nil {
@@ -856,7 +1001,17 @@ func (t *UpsertPicTask) prepareRemoteFile(ctx context.Context, loc, ref *url.URL
 		req.Header.Add("Referer", ref2.String())
 	}
 	req = req.WithContext(ctx)
-	resp, err := t.HTTPClient.Do(req)
+	client := *t.HTTPClient
+	if conf.MaxRemoteFetchRedirects != nil {
+		maxRedirects := conf.MaxRemoteFetchRedirects.Value
+		client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			if int64(len(via)) > maxRedirects {
+				return errTooManyRedirects
+			}
+			return nil
+		}
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, nil, 0, nil, status.InvalidArgument(err, "can't download", loc)
 	}
@@ -871,13 +1026,27 @@ func (t *UpsertPicTask) prepareRemoteFile(ctx context.Context, loc, ref *url.URL
 		return nil, nil, 0, nil,
 			status.InvalidArgumentf(nil, "can't download %s [%d]", loc, resp.StatusCode)
 	}
+	if sts := checkRemoteContentType(resp.Header, conf); sts != nil {
+		return nil, nil, 0, nil, sts
+	}
+	if maxSize != nil && resp.ContentLength > *maxSize {
+		return nil, nil, 0, nil,
+			status.InvalidArgumentf(nil, "remote file too large %d > %d", resp.ContentLength, *maxSize)
+	}
 
+	var body io.Reader = resp.Body
+	if maxSize != nil {
+		// Read one extra byte to detect bodies that are too large.
+		body = io.LimitReader(body, *maxSize+1)
+	}
 	var size int64
 	f, cleanup, sts := t.prepareFile(func(w io.Writer) status.S {
-		if n, err := io.Copy(w, resp.Body); err != nil {
+		if n, err := io.Copy(w, body); err != nil {
 			// This could either be because the remote hung up or a file error on our side.  Assume that
 			// our system is okay, making this an InvalidArgument
 			return status.InvalidArgument(err, "can't copy file", loc)
+		} else if maxSize != nil && n > *maxSize {
+			return status.InvalidArgumentf(nil, "remote file too large > %d", *maxSize)
 		} else {
 			size = n
 			return nil
@@ -889,6 +1058,41 @@ func (t *UpsertPicTask) prepareRemoteFile(ctx context.Context, loc, ref *url.URL
 	return f, cleanup, size, parseContentDisposition(resp.Header), nil
 }
 
+var errTooManyRedirects = errors.New("too many redirects")
+
+func confRemoteFetchLimits(conf *schema.Configuration) (*time.Duration, *int64, status.S) {
+	var timeout *time.Duration
+	if conf.RemoteFetchTimeout != nil {
+		d, err := ptypes.Duration(conf.RemoteFetchTimeout)
+		if err != nil {
+			return nil, nil, status.Internal(err, "can't parse remote fetch timeout")
+		}
+		timeout = &d
+	}
+	var maxSize *int64
+	if conf.MaxRemoteFetchSize != nil {
+		maxSize = &conf.MaxRemoteFetchSize.Value
+	}
+	return timeout, maxSize, nil
+}
+
+func checkRemoteContentType(h http.Header, conf *schema.Configuration) status.S {
+	if conf.RemoteFetchContentType == nil {
+		return nil
+	}
+	rawct := h.Get("Content-Type")
+	ct, _, err := mime.ParseMediaType(rawct)
+	if err != nil {
+		return status.InvalidArgument(err, "can't parse content type", rawct)
+	}
+	for _, allowed := range conf.RemoteFetchContentType.Value {
+		if ct == allowed {
+			return nil
+		}
+	}
+	return status.InvalidArgument(nil, "content type not allowed", ct)
+}
+
 func parseUrlName(loc *url.URL) (string, status.S) {
 	if len(loc.Path) > 0 && loc.Path[len(loc.Path)-1] == '/' {
 		return "", nil
@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha512"
@@ -10,6 +11,7 @@ import (
 	"image/gif"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -21,7 +23,9 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	any "github.com/golang/protobuf/ptypes/any"
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
 
+	"pixur.org/pixur/be/fetch"
 	"pixur.org/pixur/be/imaging"
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
@@ -122,6 +126,7 @@ func TestUpsertPicTask_URL(t *testing.T) {
 			Width:      task.CreatedPic.Thumbnail[0].Width,
 			Height:     task.CreatedPic.Thumbnail[0].Height,
 		}},
+		Placeholder: task.CreatedPic.Placeholder,
 	}
 	if !proto.Equal(expected, task.UnfilteredCreatedPic) {
 		t.Error("not equal", expected, task.UnfilteredCreatedPic)
@@ -865,6 +870,11 @@ func TestUpsertPicTask_NewPic(t *testing.T) {
 			t.Error("have", have, "want", want)
 		}
 	}
+	if p.Placeholder == nil || p.Placeholder.BlurHash == "" {
+		t.Error("missing placeholder", p)
+	} else if have, want := p.Placeholder.DominantColor&0xf0f0f0, uint32(0); have != want {
+		t.Error("have", have, "want", want)
+	}
 	if len(p.Thumbnail) == 0 {
 		t.Error("Mising pic thumbnail(s)", p)
 	}
@@ -895,8 +905,8 @@ func TestUpsertPicTask_NewPic(t *testing.T) {
 	}
 
 	tp := c.WrapPic(p)
-	// three hashes, 1 perceptual
-	if len(tp.Idents()) != 3+1 {
+	// three hashes, 1 perceptual, 1 color
+	if len(tp.Idents()) != 3+1+1 {
 		t.Fatal("Not all idents created")
 	}
 
@@ -912,6 +922,211 @@ func TestUpsertPicTask_NewPic(t *testing.T) {
 	}
 }
 
+func TestUpsertPicTask_StreamNewPic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	var buf bytes.Buffer
+	img := image.NewGray(image.Rect(0, 0, 8, 10))
+	if err := gif.Encode(&buf, img, &gif.Options{}); err != nil {
+		t.Fatal(err)
+	}
+	md5Hash := md5.Sum(buf.Bytes())
+	size := int64(buf.Len())
+
+	task := &UpsertPicTask{
+		Beg:      c.DB(),
+		Now:      func() time.Time { return time.Unix(100, 0) },
+		PixPath:  c.TempDir(),
+		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		MkdirAll: os.MkdirAll,
+		Rename:   os.Rename,
+		Remove:   os.Remove,
+
+		// Hide the other methods of bytes.Buffer
+		FileStream: struct{ io.Reader }{&buf},
+		Md5Hash:    md5Hash[:],
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if sts := task.CleanUp(); sts != nil {
+		t.Fatal(sts)
+	}
+
+	p := task.CreatedPic
+	if p.File.Mime != schema.Pic_File_GIF {
+		t.Error("Mime not set", p.File.Mime)
+	}
+	if have, want := p.File.Size, size; have != want {
+		t.Error("have", have, "want", want)
+	}
+	path, sts := schema.PicFilePath(c.TempDir(), p.PicId, p.File.Mime)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal("Pic not uploaded", err)
+	}
+	if have, want := md5.Sum(data), md5Hash; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertPicTask_StreamMissingMd5(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	task := &UpsertPicTask{
+		Beg:      c.DB(),
+		Now:      func() time.Time { return time.Unix(100, 0) },
+		PixPath:  c.TempDir(),
+		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		MkdirAll: os.MkdirAll,
+		Rename:   os.Rename,
+		Remove:   os.Remove,
+
+		FileStream: strings.NewReader("data"),
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	expected := status.InvalidArgument(nil, "missing md5 hash")
+	compareStatus(t, sts, expected)
+}
+
+func TestUpsertPicTask_StreamMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	md5Hash := md5.Sum([]byte("data"))
+	r := strings.NewReader("data")
+	task := &UpsertPicTask{
+		Beg:     c.DB(),
+		Now:     func() time.Time { return time.Unix(100, 0) },
+		PixPath: c.TempDir(),
+		TempFile: func(dir, prefix string) (*os.File, error) {
+			t.Error("shouldn't create temp file")
+			return c.TempFile(), nil
+		},
+		MkdirAll: os.MkdirAll,
+		Rename:   os.Rename,
+		Remove:   os.Remove,
+
+		FileStream: r,
+		Md5Hash:    md5Hash[:],
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	expected := status.PermissionDenied(nil, "missing cap PIC_CREATE")
+	compareStatus(t, sts, expected)
+	if have, want := r.Len(), len("data"); have != want {
+		t.Error("stream was read", have, want)
+	}
+}
+
+func TestUpsertPicTask_StreamTooLarge(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	var tempFile *os.File
+	md5Hash := md5.Sum([]byte("data"))
+	task := &UpsertPicTask{
+		Beg:     c.DB(),
+		Now:     func() time.Time { return time.Unix(100, 0) },
+		PixPath: c.TempDir(),
+		TempFile: func(dir, prefix string) (*os.File, error) {
+			tempFile = c.TempFile()
+			return tempFile, nil
+		},
+		MkdirAll: os.MkdirAll,
+		Rename:   os.Rename,
+		Remove:   os.Remove,
+
+		FileStream: strings.NewReader("data"),
+		Md5Hash:    md5Hash[:],
+	}
+
+	conf := schema.GetDefaultConfiguration()
+	conf.MaxUploadStreamSize = &wpb.Int64Value{Value: 3}
+	ctx := CtxFromTestConfig(u.AuthedCtx(c.Ctx), conf)
+	sts := new(TaskRunner).Run(ctx, task)
+	expected := status.InvalidArgument(nil, "file too large > 3")
+	compareStatus(t, sts, expected)
+
+	if _, err := os.Stat(tempFile.Name()); !os.IsNotExist(err) {
+		t.Error("expected temp file to be removed", err)
+	}
+}
+
+func TestUpsertPicTask_StreamKeptForRetry(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	var tempFiles []*os.File
+	md5Hash := md5.Sum([]byte("other data"))
+	task := &UpsertPicTask{
+		Beg:     c.DB(),
+		Now:     func() time.Time { return time.Unix(100, 0) },
+		PixPath: c.TempDir(),
+		TempFile: func(dir, prefix string) (*os.File, error) {
+			f := c.TempFile()
+			tempFiles = append(tempFiles, f)
+			return f, nil
+		},
+		MkdirAll: os.MkdirAll,
+		Rename:   os.Rename,
+		Remove:   os.Remove,
+
+		FileStream: strings.NewReader("data"),
+		Md5Hash:    md5Hash[:],
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	// Run twice, as a retry would.  The second run can only see the data if it was kept.
+	for i := 0; i < 2; i++ {
+		sts := task.Run(ctx)
+		expected := status.InvalidArgumentf(nil, "md5 hash mismatch %x != %x",
+			md5Hash, md5.Sum([]byte("data")))
+		compareStatus(t, sts, expected)
+	}
+	if len(tempFiles) != 1 {
+		t.Fatal("expected one temp file", len(tempFiles))
+	}
+	if _, err := os.Stat(tempFiles[0].Name()); err != nil {
+		t.Error("expected temp file to be kept", err)
+	}
+
+	if sts := task.CleanUp(); sts != nil {
+		t.Fatal(sts)
+	}
+	if _, err := os.Stat(tempFiles[0].Name()); !os.IsNotExist(err) {
+		t.Error("expected temp file to be removed", err)
+	}
+}
+
 func TestMerge(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -1623,7 +1838,7 @@ func TestUpsertPicTask_prepareRemoteFile_badAddress(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil)
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, &schema.Configuration{})
 	expected := status.InvalidArgument(nil, "can't download http:")
 	compareStatus(t, sts, expected)
 }
@@ -1649,7 +1864,7 @@ func TestUpsertPicTask_prepareRemoteFile_badStatus(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil)
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, &schema.Configuration{})
 	expected := status.InvalidArgument(nil, "can't download")
 
 	compareStatus(t, sts, expected)
@@ -1685,7 +1900,7 @@ func TestUpsertPicTask_prepareRemoteFile_badTransfer(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil)
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, &schema.Configuration{})
 	expected := status.InvalidArgument(nil, "can't copy file")
 
 	compareStatus(t, sts, expected)
@@ -1723,7 +1938,7 @@ func TestUpsertPicTask_prepareRemoteFile(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	f, cleanup, size, disponame, sts := task.prepareRemoteFile(c.Ctx, loc, ref)
+	f, cleanup, size, disponame, sts := task.prepareRemoteFile(c.Ctx, loc, ref, &schema.Configuration{})
 	if sts != nil {
 		t.Fatal(sts)
 	}
@@ -1743,6 +1958,169 @@ func TestUpsertPicTask_prepareRemoteFile(t *testing.T) {
 	}
 }
 
+func TestUpsertPicTask_prepareRemoteFile_blockedAddress(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("should not connect")
+	}
+	serv := httptest.NewServer(http.HandlerFunc(handler))
+	defer serv.Close()
+	servURL, err := url.Parse(serv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	task := &UpsertPicTask{
+		HTTPClient: fetch.NewClient(&fetch.Dialer{
+			LookupIPAddr: func(ctx context.Context, host string) ([]net.IPAddr, error) {
+				return []net.IPAddr{{IP: net.ParseIP(servURL.Hostname())}}, nil
+			},
+		}),
+		TempFile: func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
+		Remove:   os.Remove,
+		PixPath:  c.TempDir(),
+	}
+	loc, err := url.Parse("http://pics.example:" + servURL.Port() + "/foo.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, schema.GetDefaultConfiguration())
+	expected := status.InvalidArgument(nil, "can't download")
+	compareStatus(t, sts, expected)
+}
+
+func TestUpsertPicTask_prepareRemoteFile_tooManyRedirects(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/again", http.StatusFound)
+	}
+	serv := httptest.NewServer(http.HandlerFunc(handler))
+	defer serv.Close()
+
+	task := &UpsertPicTask{
+		HTTPClient: http.DefaultClient,
+		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
+		Remove:     os.Remove,
+		PixPath:    c.TempDir(),
+	}
+	loc, err := url.Parse(serv.URL + "/foo.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := &schema.Configuration{
+		MaxRemoteFetchRedirects: &wpb.Int64Value{Value: 2},
+	}
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, conf)
+	expected := status.InvalidArgument(nil, "can't download")
+	compareStatus(t, sts, expected)
+	if uerr, ok := sts.Cause().(*url.Error); !ok || uerr.Err != errTooManyRedirects {
+		t.Error("expected too many redirects", sts.Cause())
+	}
+}
+
+func TestUpsertPicTask_prepareRemoteFile_tooLarge(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		// Flush to avoid setting the content length.
+		w.(http.Flusher).Flush()
+		if _, err := w.Write([]byte("toobig")); err != nil {
+			t.Error(err)
+		}
+	}
+	serv := httptest.NewServer(http.HandlerFunc(handler))
+	defer serv.Close()
+
+	task := &UpsertPicTask{
+		HTTPClient: http.DefaultClient,
+		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
+		Remove:     os.Remove,
+		PixPath:    c.TempDir(),
+	}
+	loc, err := url.Parse(serv.URL + "/foo.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := &schema.Configuration{
+		MaxRemoteFetchSize: &wpb.Int64Value{Value: 5},
+	}
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, conf)
+	expected := status.InvalidArgument(nil, "remote file too large")
+	compareStatus(t, sts, expected)
+}
+
+func TestUpsertPicTask_prepareRemoteFile_badContentType(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if _, err := w.Write([]byte("<html></html>")); err != nil {
+			t.Error(err)
+		}
+	}
+	serv := httptest.NewServer(http.HandlerFunc(handler))
+	defer serv.Close()
+
+	task := &UpsertPicTask{
+		HTTPClient: http.DefaultClient,
+		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
+		Remove:     os.Remove,
+		PixPath:    c.TempDir(),
+	}
+	loc, err := url.Parse(serv.URL + "/foo.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := &schema.Configuration{
+		RemoteFetchContentType: &schema.Configuration_StringSet{
+			Value: []string{"image/jpeg"},
+		},
+	}
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, conf)
+	expected := status.InvalidArgument(nil, "content type not allowed text/html")
+	compareStatus(t, sts, expected)
+}
+
+func TestUpsertPicTask_prepareRemoteFile_timeout(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}
+	serv := httptest.NewServer(http.HandlerFunc(handler))
+	defer serv.Close()
+
+	task := &UpsertPicTask{
+		HTTPClient: http.DefaultClient,
+		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
+		Remove:     os.Remove,
+		PixPath:    c.TempDir(),
+	}
+	loc, err := url.Parse(serv.URL + "/foo.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := &schema.Configuration{
+		RemoteFetchTimeout: ptypes.DurationProto(time.Millisecond),
+	}
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, conf)
+	expected := status.InvalidArgument(nil, "can't download")
+	compareStatus(t, sts, expected)
+}
+
 func TestUpsertPicTask_parseUrlName(t *testing.T) {
 	maps := [][2]string{
 		{"http://foo.com", ""},
@@ -0,0 +1,78 @@
+package totp
+
+import (
+	"bytes"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// From RFC 6238, Appendix B, truncated to 6 digits.
+func TestCode(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	cases := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, c := range cases {
+		if have := Code(secret, Step(time.Unix(c.unix, 0))); have != c.want {
+			t.Error(c.unix, "have", have, "want", c.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111109, 0)
+
+	step, ok := Validate(secret, "081804", now, 1)
+	if !ok || step != Step(now) {
+		t.Error("expected valid", step, ok)
+	}
+	// One step later is still in range.
+	if _, ok := Validate(secret, "081804", now.Add(Period), 1); !ok {
+		t.Error("expected valid with skew")
+	}
+	if _, ok := Validate(secret, "081804", now.Add(2*Period), 1); ok {
+		t.Error("expected invalid outside skew")
+	}
+	if _, ok := Validate(secret, "81804", now, 1); ok {
+		t.Error("expected invalid short code")
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	secret, err := NewSecret(bytes.NewReader(make([]byte, SecretSize)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := EncodeSecret(secret), "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if _, err := NewSecret(bytes.NewReader(nil)); err == nil {
+		t.Error("expected error on short read")
+	}
+}
+
+func TestKeyURI(t *testing.T) {
+	uri := KeyURI("Pixur", "a@example.com", []byte("12345678901234567890"))
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Pixur:a@example.com" {
+		t.Error("bad uri", uri)
+	}
+	if have, want := u.Query().Get("secret"), "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := u.Query().Get("issuer"), "Pixur"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -8,9 +8,11 @@ import (
 )
 
 var (
-	authPwtHeaderKey string
-	pixPwtHeaderKey  string
-	httpHeaderKey    string
+	authPwtHeaderKey    string
+	pixPwtHeaderKey     string
+	httpHeaderKey       string
+	apiKeyHeaderKey     string
+	clientAddrHeaderKey string
 )
 
 func init() {
@@ -26,4 +28,6 @@ func init() {
 	authPwtHeaderKey = opts.AuthTokenHeaderKey
 	pixPwtHeaderKey = opts.PixTokenHeaderKey
 	httpHeaderKey = opts.HttpHeaderKey
+	apiKeyHeaderKey = opts.ApiKeyHeaderKey
+	clientAddrHeaderKey = opts.ClientAddrHeaderKey
 }
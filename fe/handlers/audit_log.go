@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+	"sort"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server"
+	ptpl "pixur.org/pixur/fe/tpl"
+)
+
+type auditLogHandler struct {
+	pt  *paths
+	c   api.PixurServiceClient
+	tpl *template.Template
+}
+
+// auditLogFilter is the set of filters on the audit log page.  Empty fields match everything.
+type auditLogFilter struct {
+	ActorUserId  string
+	TargetUserId string
+	TargetPicId  string
+	Action       api.AuditLog_Action
+}
+
+type auditLogData struct {
+	*paneData
+
+	Filter    auditLogFilter
+	Actions   []api.AuditLog_Action
+	AuditLogs []*api.AuditLog
+	Next      string
+}
+
+func (h *auditLogHandler) static(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	f := auditLogFilter{
+		ActorUserId:  r.FormValue(h.pt.pr.ActorUserId()),
+		TargetUserId: r.FormValue(h.pt.pr.TargetUserId()),
+		TargetPicId:  r.FormValue(h.pt.pr.TargetPicId()),
+	}
+	if action := r.FormValue(h.pt.pr.AuditLogAction()); action != "" {
+		val, ok := api.AuditLog_Action_value[action]
+		if !ok {
+			httpError(w, &HTTPErr{
+				Message: "unknown action " + action,
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+		f.Action = api.AuditLog_Action(val)
+	}
+
+	resp, err := h.c.FindAuditLog(ctx, &api.FindAuditLogRequest{
+		ActorUserId:     f.ActorUserId,
+		TargetUserId:    f.TargetUserId,
+		TargetPicId:     f.TargetPicId,
+		Action:          f.Action,
+		StartAuditLogId: r.FormValue(h.pt.pr.StartAuditLogId()),
+	})
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	var actions []api.AuditLog_Action
+	for num := range api.AuditLog_Action_name {
+		if a := api.AuditLog_Action(num); a != api.AuditLog_UNKNOWN {
+			actions = append(actions, a)
+		}
+	}
+	sort.Slice(actions, func(i, k int) bool { return actions[i] < actions[k] })
+
+	data := &auditLogData{
+		paneData:  newPaneData(ctx, "Audit Log", h.pt),
+		Filter:    f,
+		Actions:   actions,
+		AuditLogs: resp.AuditLog,
+		Next:      resp.NextAuditLogId,
+	}
+	if err := h.tpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
+func init() {
+	register(func(s *server.Server) error {
+		h := auditLogHandler{
+			c:   s.Client,
+			pt:  &paths{r: s.HTTPRoot},
+			tpl: parseTpl(ptpl.Base, ptpl.Pane, ptpl.AuditLog),
+		}
+		s.HTTPMux.Handle(h.pt.AuditLog().Path, readWrapper(s)(http.HandlerFunc(h.static)))
+		return nil
+	})
+}
@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+	"strconv"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server"
+	ptpl "pixur.org/pixur/fe/tpl"
+)
+
+type collectionHandler struct {
+	pt             *paths
+	c              api.PixurServiceClient
+	favoritesTpl   *template.Template
+	collectionsTpl *template.Template
+	collectionTpl  *template.Template
+}
+
+type favoritesData struct {
+	*userPaneData
+
+	Pic  []*indexPic
+	Next string
+}
+
+type collectionsData struct {
+	*userPaneData
+
+	Collection []*api.Collection
+	// CanCreate is true if the subject user may create a collection on this page.
+	CanCreate bool
+}
+
+type collectionData struct {
+	*userPaneData
+
+	Collection *api.Collection
+	Pic        []*indexPic
+	// IsOwner is true if the subject user may change the collection.
+	IsOwner bool
+}
+
+func (h *collectionHandler) favorites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	resp, err := h.c.FindPicFavorites(ctx, &api.FindPicFavoritesRequest{
+		StartPicFavoriteId: r.FormValue(h.pt.pr.StartPicFavoriteId()),
+	})
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+
+	var objectUserId string
+	if su := subjectUserOrNilFromCtx(ctx); su != nil {
+		objectUserId = su.UserId
+	}
+	data := &favoritesData{
+		userPaneData: &userPaneData{
+			paneData:     newPaneData(ctx, "Favorites", h.pt),
+			ObjectUserId: objectUserId,
+		},
+		Pic:  newIndexPics(resp.Pic),
+		Next: resp.NextPicFavoriteId,
+	}
+	if err := h.favoritesTpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
+func (h *collectionHandler) collections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	objectUserId := r.FormValue(h.pt.pr.UserId())
+	resp, err := h.c.FindCollections(ctx, &api.FindCollectionsRequest{
+		UserId: objectUserId,
+	})
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+
+	su := subjectUserOrNilFromCtx(ctx)
+	isSelf := su != nil && (objectUserId == "" || objectUserId == su.UserId)
+	if isSelf {
+		objectUserId = su.UserId
+	}
+	data := &collectionsData{
+		userPaneData: &userPaneData{
+			paneData:     newPaneData(ctx, "Collections", h.pt),
+			ObjectUserId: objectUserId,
+		},
+		Collection: resp.Collection,
+		CanCreate:  isSelf && hasCap(ctx, api.Capability_COLLECTION_CREATE),
+	}
+	if err := h.collectionsTpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
+func (h *collectionHandler) collection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	resp, err := h.c.LookupCollection(ctx, &api.LookupCollectionRequest{
+		CollectionId: r.FormValue(h.pt.pr.CollectionId()),
+	})
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+
+	su := subjectUserOrNilFromCtx(ctx)
+	data := &collectionData{
+		userPaneData: &userPaneData{
+			paneData:     newPaneData(ctx, resp.Collection.Name, h.pt),
+			ObjectUserId: resp.Collection.UserId,
+		},
+		Collection: resp.Collection,
+		Pic:        newIndexPics(resp.Pic),
+		IsOwner: su != nil && su.UserId == resp.Collection.UserId &&
+			hasCap(ctx, api.Capability_COLLECTION_CREATE),
+	}
+	if err := h.collectionTpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
+func (h *collectionHandler) create(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.CreateCollectionRequest{
+		Name: r.PostFormValue(pr.CollectionName()),
+		Visibility: api.Collection_Visibility(
+			api.Collection_Visibility_value[r.PostFormValue(pr.CollectionVisibility())]),
+	}
+	res, err := h.c.CreateCollection(r.Context(), req)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Collection(res.Collection.CollectionId).String(), http.StatusSeeOther)
+}
+
+func (h *collectionHandler) update(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	version, err := strconv.ParseInt(r.PostFormValue(pr.Version()), 10, 64)
+	if err != nil {
+		httpError(w, &HTTPErr{
+			Message: "can't parse version",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	req := &api.UpdateCollectionRequest{
+		CollectionId: r.PostFormValue(pr.CollectionId()),
+		Version:      version,
+		Name:         r.PostFormValue(pr.CollectionName()),
+		Visibility: api.Collection_Visibility(
+			api.Collection_Visibility_value[r.PostFormValue(pr.CollectionVisibility())]),
+	}
+	res, err := h.c.UpdateCollection(r.Context(), req)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Collection(res.Collection.CollectionId).String(), http.StatusSeeOther)
+}
+
+func (h *collectionHandler) delete(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	version, err := strconv.ParseInt(r.PostFormValue(pr.Version()), 10, 64)
+	if err != nil {
+		httpError(w, &HTTPErr{
+			Message: "can't parse version",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	req := &api.DeleteCollectionRequest{
+		CollectionId: r.PostFormValue(pr.CollectionId()),
+		Version:      version,
+	}
+	if _, err := h.c.DeleteCollection(r.Context(), req); err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Collections("").String(), http.StatusSeeOther)
+}
+
+func (h *collectionHandler) addPic(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.AddCollectionPicRequest{
+		CollectionId: r.PostFormValue(pr.CollectionId()),
+		PicId:        r.PostFormValue(pr.PicId()),
+	}
+	res, err := h.c.AddCollectionPic(r.Context(), req)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Collection(res.Collection.CollectionId).String(), http.StatusSeeOther)
+}
+
+func (h *collectionHandler) removePic(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.RemoveCollectionPicRequest{
+		CollectionId: r.PostFormValue(pr.CollectionId()),
+		PicId:        r.PostFormValue(pr.PicId()),
+	}
+	res, err := h.c.RemoveCollectionPic(r.Context(), req)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Collection(res.Collection.CollectionId).String(), http.StatusSeeOther)
+}
+
+// movePic swaps a pic with its neighbor in the collection.  The server only knows how to
+// reorder the whole collection, so the current order is looked up first.
+func (h *collectionHandler) movePic(w http.ResponseWriter, r *http.Request) {
+	var pr params
+	ctx := r.Context()
+	collectionId := r.PostFormValue(pr.CollectionId())
+	picId := r.PostFormValue(pr.PicId())
+
+	var delta int
+	switch r.PostFormValue(pr.CollectionMove()) {
+	case "up":
+		delta = -1
+	case "down":
+		delta = 1
+	default:
+		httpError(w, &HTTPErr{
+			Message: "bad move direction",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	resp, err := h.c.LookupCollection(ctx, &api.LookupCollectionRequest{
+		CollectionId: collectionId,
+	})
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	picIds := make([]string, 0, len(resp.Pic))
+	pos := -1
+	for i, p := range resp.Pic {
+		if p.Pic.Id == picId {
+			pos = i
+		}
+		picIds = append(picIds, p.Pic.Id)
+	}
+	if pos == -1 {
+		httpError(w, &HTTPErr{
+			Message: "pic not in collection",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	if other := pos + delta; other >= 0 && other < len(picIds) {
+		picIds[pos], picIds[other] = picIds[other], picIds[pos]
+		if _, err := h.c.ReorderCollection(ctx, &api.ReorderCollectionRequest{
+			CollectionId: collectionId,
+			PicId:        picIds,
+		}); err != nil {
+			httpError(w, err)
+			return
+		}
+	}
+
+	http.Redirect(w, r, h.pt.Collection(collectionId).String(), http.StatusSeeOther)
+}
+
+func init() {
+	register(func(s *server.Server) error {
+		h := collectionHandler{
+			c:              s.Client,
+			pt:             &paths{r: s.HTTPRoot},
+			favoritesTpl:   parseTpl(ptpl.Base, ptpl.Pane, ptpl.Userpane, ptpl.Favorites),
+			collectionsTpl: parseTpl(ptpl.Base, ptpl.Pane, ptpl.Userpane, ptpl.Collections),
+			collectionTpl:  parseTpl(ptpl.Base, ptpl.Pane, ptpl.Userpane, ptpl.Collection),
+		}
+
+		s.HTTPMux.Handle(h.pt.Favorites("").Path, readWrapper(s)(http.HandlerFunc(h.favorites)))
+		s.HTTPMux.Handle(h.pt.Collections("").Path, readWrapper(s)(http.HandlerFunc(h.collections)))
+		s.HTTPMux.Handle(h.pt.Collection("").Path, readWrapper(s)(http.HandlerFunc(h.collection)))
+		s.HTTPMux.Handle(
+			h.pt.CreateCollectionAction().Path, writeWrapper(s)(http.HandlerFunc(h.create)))
+		s.HTTPMux.Handle(
+			h.pt.UpdateCollectionAction().Path, writeWrapper(s)(http.HandlerFunc(h.update)))
+		s.HTTPMux.Handle(
+			h.pt.DeleteCollectionAction().Path, writeWrapper(s)(http.HandlerFunc(h.delete)))
+		s.HTTPMux.Handle(
+			h.pt.AddCollectionPicAction().Path, writeWrapper(s)(http.HandlerFunc(h.addPic)))
+		s.HTTPMux.Handle(
+			h.pt.RemoveCollectionPicAction().Path, writeWrapper(s)(http.HandlerFunc(h.removePic)))
+		s.HTTPMux.Handle(
+			h.pt.MoveCollectionPicAction().Path, writeWrapper(s)(http.HandlerFunc(h.movePic)))
+		return nil
+	})
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"pixur.org/pixur/api"
 	"pixur.org/pixur/fe/server"
@@ -16,6 +17,8 @@ type commentDisplayData struct {
 	PicComment *picComment
 	// CommentText is the initial comment after a failed write
 	CommentText string
+	// Edit is true if PicComment is being edited, rather than replied to.
+	Edit bool
 }
 
 type commentDisplayHandler struct {
@@ -25,7 +28,12 @@ type commentDisplayHandler struct {
 
 func (h *commentDisplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	commentParentId := r.FormValue(h.pt.pr.CommentParentId())
+	// The comment being edited, or else the comment being replied to.
+	commentId := r.FormValue(h.pt.pr.CommentId())
+	edit := commentId != ""
+	if !edit {
+		commentId = r.FormValue(h.pt.pr.CommentParentId())
+	}
 	picId := r.FormValue(h.pt.pr.PicId())
 	req := &api.LookupPicDetailsRequest{
 		PicId: picId,
@@ -37,7 +45,11 @@ func (h *commentDisplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	pd := newPaneData(ctx, "Add Comment", h.pt)
+	title := "Add Comment"
+	if edit {
+		title = "Edit Comment"
+	}
+	pd := newPaneData(ctx, title, h.pt)
 
 	var root *picComment
 	if details.PicCommentTree != nil && len(details.PicCommentTree.Comment) > 0 {
@@ -49,7 +61,8 @@ func (h *commentDisplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 				Paths:      pd.Paths,
 				XsrfToken:  pd.XsrfToken,
 			}
-			if pc.CommentId == commentParentId {
+			if pc.CommentId == commentId {
+				pc.CanEdit, pc.CanDelete = commentPerms(ctx, c)
 				root = pc
 				break
 			}
@@ -69,6 +82,10 @@ func (h *commentDisplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		Pic:         details.Pic,
 		PicComment:  root,
 		CommentText: r.PostFormValue(h.pt.pr.CommentText()),
+		Edit:        edit,
+	}
+	if edit && r.Method != http.MethodPost {
+		data.CommentText = root.Text
 	}
 	if err := commentDisplayTpl.Execute(w, data); err != nil {
 		httpCleanupError(w, err)
@@ -102,6 +119,70 @@ func (h *addPicCommentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	http.Redirect(w, r, h.pt.ViewerComment(res.Comment.PicId, res.Comment.CommentId).String(), http.StatusSeeOther)
 }
 
+type editPicCommentHandler struct {
+	pt      *paths
+	c       api.PixurServiceClient
+	display http.Handler
+}
+
+func (h *editPicCommentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	version, err := strconv.ParseInt(r.PostFormValue(h.pt.pr.Version()), 10, 64)
+	if err != nil {
+		httpError(w, &HTTPErr{
+			Message: "can't parse version",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	req := &api.EditPicCommentRequest{
+		PicId:     r.PostFormValue(h.pt.pr.PicId()),
+		CommentId: r.PostFormValue(h.pt.pr.CommentId()),
+		Version:   version,
+		Text:      r.PostFormValue(h.pt.pr.CommentText()),
+	}
+	ctx := r.Context()
+
+	res, err := h.c.EditPicComment(ctx, req)
+	if err != nil {
+		httpWriteError(w, err)
+		ctx = ctxFromWriteErr(ctx, err)
+		r = r.WithContext(ctx)
+		h.display.ServeHTTP(w, r)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.ViewerComment(res.Comment.PicId, res.Comment.CommentId).String(), http.StatusSeeOther)
+}
+
+type deletePicCommentHandler struct {
+	pt *paths
+	c  api.PixurServiceClient
+}
+
+func (h *deletePicCommentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	version, err := strconv.ParseInt(r.PostFormValue(h.pt.pr.Version()), 10, 64)
+	if err != nil {
+		httpError(w, &HTTPErr{
+			Message: "can't parse version",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	req := &api.DeletePicCommentRequest{
+		PicId:     r.PostFormValue(h.pt.pr.PicId()),
+		CommentId: r.PostFormValue(h.pt.pr.CommentId()),
+		Version:   version,
+	}
+
+	res, err := h.c.DeletePicComment(r.Context(), req)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.ViewerComment(res.Comment.PicId, res.Comment.CommentId).String(), http.StatusSeeOther)
+}
+
 func init() {
 	register(func(s *server.Server) error {
 		pt := &paths{r: s.HTTPRoot}
@@ -119,6 +200,15 @@ func init() {
 			Post: apch,
 		})
 		s.HTTPMux.Handle(pt.Comment().Path, h)
+		s.HTTPMux.Handle(pt.EditPicCommentAction().Path, writeWrapper(s)(&editPicCommentHandler{
+			c:       s.Client,
+			pt:      pt,
+			display: cdh,
+		}))
+		s.HTTPMux.Handle(pt.DeletePicCommentAction().Path, writeWrapper(s)(&deletePicCommentHandler{
+			c:  s.Client,
+			pt: pt,
+		}))
 
 		return nil
 	})
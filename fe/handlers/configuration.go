@@ -38,6 +38,18 @@ func (fc *feConfiguration) denorm() {
 	}
 }
 
+// roleHasCap returns true if any of the roles grant the capability.
+func (fc *feConfiguration) roleHasCap(roles []string, c api.Capability_Cap) bool {
+	for _, r := range roles {
+		for _, rc := range fc.beconf.GetRole().GetRole()[r].GetCapability() {
+			if rc == c {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type configurationFuture struct {
 	val  atomic.Value
 	done chan struct{}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server"
+	ptpl "pixur.org/pixur/fe/tpl"
+)
+
+type feedHandler struct {
+	pt      *paths
+	c       api.PixurServiceClient
+	feedTpl *template.Template
+}
+
+type feedData struct {
+	*userPaneData
+
+	Pic []*indexPic
+
+	NextID, PrevID string
+
+	// FollowedUserId and FollowedTag are what the subject user follows.
+	FollowedUserId []string
+	FollowedTag    []string
+}
+
+func (h *feedHandler) feed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := r.ParseForm(); err != nil {
+		httpReadError(ctx, w, &HTTPErr{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	_, isPrev := r.Form[h.pt.pr.IndexPrev()]
+	resp, err := h.c.FindFeedPics(ctx, &api.FindFeedPicsRequest{
+		StartPicId: r.FormValue(h.pt.pr.IndexPic()),
+		Ascending:  isPrev,
+	})
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+	follows, err := h.c.FindFollows(ctx, &api.FindFollowsRequest{})
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+
+	var prevID string
+	var nextID string
+	if !isPrev {
+		nextID = resp.NextPicId
+		prevID = resp.PrevPicId
+	} else {
+		nextID = resp.PrevPicId
+		prevID = resp.NextPicId
+		for i := 0; i < len(resp.Pic)/2; i++ {
+			resp.Pic[i], resp.Pic[len(resp.Pic)-i-1] = resp.Pic[len(resp.Pic)-i-1], resp.Pic[i]
+		}
+	}
+
+	var objectUserId string
+	if su := subjectUserOrNilFromCtx(ctx); su != nil {
+		objectUserId = su.UserId
+	}
+	data := &feedData{
+		userPaneData: &userPaneData{
+			paneData:     newPaneData(ctx, "Feed", h.pt),
+			ObjectUserId: objectUserId,
+		},
+		Pic:            newIndexPics(resp.Pic),
+		NextID:         nextID,
+		PrevID:         prevID,
+		FollowedUserId: follows.UserId,
+		FollowedTag:    follows.Tag,
+	}
+	if err := h.feedTpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
+// follow follows or unfollows either a user or a tag, and then goes back to where it came from.
+func (h *feedHandler) follow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	nextURL, err := url.Parse(r.PostFormValue(h.pt.pr.Next()))
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	userId := r.PostFormValue(h.pt.pr.UserId())
+	tag := r.PostFormValue(h.pt.pr.Tag())
+	follow := r.PostFormValue(h.pt.pr.Follow()) == h.pt.pr.True()
+
+	switch {
+	case userId != "" && follow:
+		_, err = h.c.AddUserFollow(ctx, &api.AddUserFollowRequest{UserId: userId})
+	case userId != "":
+		_, err = h.c.RemoveUserFollow(ctx, &api.RemoveUserFollowRequest{UserId: userId})
+	case follow:
+		_, err = h.c.AddTagFollow(ctx, &api.AddTagFollowRequest{Tag: tag})
+	default:
+		_, err = h.c.RemoveTagFollow(ctx, &api.RemoveTagFollowRequest{Tag: tag})
+	}
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, nextURL.String(), http.StatusSeeOther)
+}
+
+func init() {
+	register(func(s *server.Server) error {
+		h := feedHandler{
+			c:       s.Client,
+			pt:      &paths{r: s.HTTPRoot},
+			feedTpl: parseTpl(ptpl.Base, ptpl.Pane, ptpl.Userpane, ptpl.Feed),
+		}
+
+		s.HTTPMux.Handle(h.pt.Feed("", false).Path, readWrapper(s)(http.HandlerFunc(h.feed)))
+		s.HTTPMux.Handle(h.pt.FollowAction().Path, newActionHandler(s, http.HandlerFunc(h.follow)))
+		return nil
+	})
+}
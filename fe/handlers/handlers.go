@@ -35,6 +35,8 @@ type paneData struct {
 	SiteName    string
 	XsrfToken   string
 	SubjectUser *api.User
+	// CanReadAuditLog is true if the subject user may see the audit log.
+	CanReadAuditLog bool
 	// Err is a user visible error set after a failed write
 	Err error
 }
@@ -59,10 +61,11 @@ func newPaneData(ctx context.Context, title string, pt *paths) *paneData {
 			Paths: pt,
 			Title: title,
 		},
-		SiteName:    globalSiteName,
-		XsrfToken:   outgoingXsrfTokenOrEmptyFromCtx(ctx),
-		SubjectUser: subjectUserOrNilFromCtx(ctx),
-		Err:         writeErrOrNilFromCtx(ctx),
+		SiteName:        globalSiteName,
+		XsrfToken:       outgoingXsrfTokenOrEmptyFromCtx(ctx),
+		SubjectUser:     subjectUserOrNilFromCtx(ctx),
+		CanReadAuditLog: hasCap(ctx, api.Capability_AUDIT_LOG_READ),
+		Err:             writeErrOrNilFromCtx(ctx),
 	}
 }
 
@@ -71,10 +74,23 @@ var _ grpc.UnaryClientInterceptor = cookieToGRPCAuthInterceptor
 func cookieToGRPCAuthInterceptor(
 	ctx oldctx.Context, method string, req, reply interface{},
 	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(outgoingCtx(ctx), method, req, reply, cc, opts...)
+}
+
+// outgoingCtx adds the auth token and client address of the request to the outgoing metadata.
+// Metadata already added by the caller, such as an api key, is kept.
+func outgoingCtx(ctx context.Context) context.Context {
+	var kv []string
 	if atv, present := authTokenFromCtx(ctx); present {
-		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(authPwtHeaderKey, atv.Token))
+		kv = append(kv, authPwtHeaderKey, atv.Token)
+	}
+	if host, present := remoteHostFromCtx(ctx); present {
+		kv = append(kv, clientAddrHeaderKey, host)
 	}
-	return invoker(ctx, method, req, reply, cc, opts...)
+	if len(kv) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
 }
 
 func RegisterAll(s *server.Server) {
@@ -144,6 +160,9 @@ type readHandler struct {
 // TODO: test
 func (h *readHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	if _, present := remoteHostFromCtx(ctx); !present {
+		ctx = ctxFromRemoteHost(ctx, remoteHost(r))
+	}
 	atv, authTokenPresent := authTokenFromCtx(ctx)
 	if !authTokenPresent {
 		atv, authTokenPresent = authTokenFromReq(r)
@@ -297,6 +316,9 @@ type actionHandler struct {
 // TODO: test
 func (h *actionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	if _, present := remoteHostFromCtx(ctx); !present {
+		ctx = ctxFromRemoteHost(ctx, remoteHost(r))
+	}
 	incomingXsrfToken, ok := incomingXsrfTokenFromCtx(ctx)
 	if !ok {
 		xsrfCookie, xsrfField, err := incomingXsrfTokensFromReq(r, h.pt)
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"fmt"
 	"html/template"
 	"net/http"
 
@@ -11,13 +12,34 @@ import (
 type indexData struct {
 	*paneData
 
-	Pic []*api.PicAndThumbnail
+	Pic []*indexPic
 
 	NextID, PrevID string
 
 	CanUpload bool
 }
 
+type indexPic struct {
+	*api.PicAndThumbnail
+
+	// PlaceholderColor is the CSS color to paint while the thumbnail loads.  May be empty.
+	PlaceholderColor string
+}
+
+func newIndexPics(pics []*api.PicAndThumbnail) []*indexPic {
+	ips := make([]*indexPic, 0, len(pics))
+	for _, p := range pics {
+		ip := &indexPic{
+			PicAndThumbnail: p,
+		}
+		if p.Placeholder != nil {
+			ip.PlaceholderColor = fmt.Sprintf("#%06x", p.Placeholder.DominantColor&0xffffff)
+		}
+		ips = append(ips, ip)
+	}
+	return ips
+}
+
 var indexTpl = parseTpl(ptpl.Base, ptpl.Pane, ptpl.Index)
 
 func parseTpl(tpls ...string) *template.Template {
@@ -80,7 +102,7 @@ func (h *indexHandler) static(w http.ResponseWriter, r *http.Request) {
 
 	data := indexData{
 		paneData:  newPaneData(ctx, "Index", h.pt),
-		Pic:       res.Pic,
+		Pic:       newIndexPics(res.Pic),
 		NextID:    nextID,
 		PrevID:    prevID,
 		CanUpload: canupload,
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+	"net"
 	"net/http"
 	"time"
 
@@ -17,16 +19,23 @@ type loginData struct {
 	*paneData
 
 	Next string
+	// InviteCode prefills the create user form, so invite codes can be shared as links.
+	InviteCode string
+	// OidcProviders are the names of the providers that may be logged in with.
+	OidcProviders []string
 }
 
 type loginDisplayHandler struct {
-	pt *paths
+	pt            *paths
+	oidcProviders []string
 }
 
 func (h *loginDisplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	data := &loginData{
-		paneData: newPaneData(ctx, "Login", h.pt),
+		paneData:      newPaneData(ctx, "Login", h.pt),
+		InviteCode:    r.FormValue(h.pt.pr.InviteCode()),
+		OidcProviders: h.oidcProviders,
 	}
 
 	if err := loginDisplayTpl.Execute(w, data); err != nil {
@@ -44,8 +53,9 @@ type createUserHandler struct {
 
 func (h *createUserHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	req := &api.CreateUserRequest{
-		Ident:  r.PostFormValue(h.pt.pr.Ident()),
-		Secret: r.PostFormValue(h.pt.pr.Secret()),
+		Ident:      r.PostFormValue(h.pt.pr.Ident()),
+		Secret:     r.PostFormValue(h.pt.pr.Secret()),
+		InviteCode: r.PostFormValue(h.pt.pr.InviteCode()),
 	}
 
 	ctx := r.Context()
@@ -169,6 +179,8 @@ func (h *loginActionHandler) login(w http.ResponseWriter, r *http.Request) {
 		Ident:             r.PostFormValue(h.pt.pr.Ident()),
 		Secret:            r.PostFormValue(h.pt.pr.Secret()),
 		PreviousAuthToken: authToken,
+		TotpCode:          r.PostFormValue(h.pt.pr.TotpCode()),
+		UserAgent:         r.UserAgent(),
 	}
 	ctx := r.Context()
 	res, err := h.c.GetRefreshToken(ctx, req)
@@ -190,59 +202,88 @@ func (h *loginActionHandler) logout(w http.ResponseWriter, r *http.Request) {
 
 	// always destroy the cookies.  Incase they become invalid, we don't want to fail to destroy them
 	// early.
-	past := h.now().AddDate(0, 0, -1)
+	clearAuthCookies(w, h.pt, h.now(), h.secure)
+
+	ctx := r.Context()
+	if _, err := h.c.DeleteToken(ctx, &api.DeleteTokenRequest{}); err != nil {
+		httpWriteError(w, err)
+		ctx = ctxFromWriteErr(ctx, err)
+		r = r.WithContext(ctx)
+		h.display.ServeHTTP(w, r)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Logout().String(), http.StatusSeeOther)
+}
+
+// clearAuthCookies expires the cookies set at login, logging the browser out.
+func clearAuthCookies(w http.ResponseWriter, pt *paths, now time.Time, secure bool) {
+	past := now.AddDate(0, 0, -1)
 
 	http.SetCookie(w, &http.Cookie{
 		Name:     authPwtCookieName,
 		Value:    "",
-		Path:     h.pt.Root().EscapedPath(),
+		Path:     pt.Root().EscapedPath(),
 		Expires:  past,
-		Secure:   h.secure,
+		Secure:   secure,
 		HttpOnly: true,
 	})
 	http.SetCookie(w, &http.Cookie{
 		Name:     authSoftCookieName,
 		Value:    "",
-		Path:     h.pt.Root().EscapedPath(),
+		Path:     pt.Root().EscapedPath(),
 		Expires:  past,
-		Secure:   h.secure,
+		Secure:   secure,
 		HttpOnly: true,
 	})
 	http.SetCookie(w, &http.Cookie{
 		Name:     pixPwtCookieName,
 		Value:    "",
-		Path:     h.pt.PixDir().EscapedPath(),
+		Path:     pt.PixDir().EscapedPath(),
 		Expires:  past,
-		Secure:   h.secure,
+		Secure:   secure,
 		HttpOnly: true,
 	})
 	// destroy previous xsrf cookie after logout
 	http.SetCookie(w, &http.Cookie{
-		Name:     h.pt.pr.XsrfCookie(),
+		Name:     pt.pr.XsrfCookie(),
 		Value:    "",
-		Path:     h.pt.Root().EscapedPath(), // Has to be accessible from root, reset from previous
+		Path:     pt.Root().EscapedPath(), // Has to be accessible from root, reset from previous
 		Expires:  past,
-		Secure:   h.secure,
+		Secure:   secure,
 		HttpOnly: true,
 	})
+}
 
-	ctx := r.Context()
-	if _, err := h.c.DeleteToken(ctx, &api.DeleteTokenRequest{}); err != nil {
-		httpWriteError(w, err)
-		ctx = ctxFromWriteErr(ctx, err)
-		r = r.WithContext(ctx)
-		h.display.ServeHTTP(w, r)
-		return
+// remoteHost is the address of the browser, without the port, which changes between requests.
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
 	}
+	return host
+}
 
-	http.Redirect(w, r, h.pt.Logout().String(), http.StatusSeeOther)
+// remoteHostKey is a context key for the address of the browser.
+type remoteHostKey struct{}
+
+// ctxFromRemoteHost creates a new context with the address of the browser.
+func ctxFromRemoteHost(ctx context.Context, host string) context.Context {
+	return context.WithValue(ctx, remoteHostKey{}, host)
+}
+
+// remoteHostFromCtx extracts the address of the browser from a context.
+func remoteHostFromCtx(ctx context.Context) (string, bool) {
+	host, ok := ctx.Value(remoteHostKey{}).(string)
+	return host, ok
 }
 
 func init() {
 	register(func(s *server.Server) error {
 		pt := &paths{r: s.HTTPRoot}
 		ldh := readWrapper(s)(&loginDisplayHandler{
-			pt: pt,
+			pt:            pt,
+			oidcProviders: oidcProviderNames(s.OidcProviders),
 		})
 		lah := &loginActionHandler{
 			c:       s.Client,
@@ -0,0 +1,415 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server"
+	"pixur.org/pixur/fe/server/config"
+)
+
+const (
+	oidcCookieName = "oidc"
+	// oidcStateLifetime is how long the user has to log in with the provider.
+	oidcStateLifetime = 10 * time.Minute
+	oidcRandLength    = 24
+	// maxOidcTokenResponseSize bounds how much of the token endpoint response is read.
+	maxOidcTokenResponseSize = 1 << 16
+)
+
+var b64OidcEnc = base64.RawURLEncoding
+
+// oidcProviderNames returns the names of the providers, in order.
+func oidcProviderNames(providers []*config.OidcProvider) []string {
+	var names []string
+	for _, p := range providers {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func findOidcProvider(providers []*config.OidcProvider, name string) *config.OidcProvider {
+	for _, p := range providers {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// oidcRedirectUri is the absolute url of the callback, which the provider redirects back to.  The
+// host of the request isn't used, since the client chooses it.
+func oidcRedirectUri(externalURL *url.URL, pt *paths) string {
+	u := &url.URL{
+		Scheme: externalURL.Scheme,
+		Host:   externalURL.Host,
+		Path:   pt.OidcCallback().Path,
+	}
+	return u.String()
+}
+
+// oidcState is kept in a cookie while the user logs in with the provider.
+type oidcState struct {
+	Provider, State, Nonce string
+}
+
+func (s *oidcState) encode() string {
+	v := url.Values{}
+	v.Add("p", s.Provider)
+	v.Add("s", s.State)
+	v.Add("n", s.Nonce)
+	return v.Encode()
+}
+
+func decodeOidcState(raw string) (*oidcState, error) {
+	v, err := url.ParseQuery(raw)
+	if err != nil {
+		return nil, err
+	}
+	s := &oidcState{
+		Provider: v.Get("p"),
+		State:    v.Get("s"),
+		Nonce:    v.Get("n"),
+	}
+	if s.Provider == "" || s.State == "" || s.Nonce == "" {
+		return nil, errors.New("incomplete oidc state")
+	}
+	return s, nil
+}
+
+func newOidcRand(random io.Reader) (string, error) {
+	b := make([]byte, oidcRandLength)
+	if _, err := io.ReadFull(random, b); err != nil {
+		return "", err
+	}
+	return b64OidcEnc.EncodeToString(b), nil
+}
+
+type oidcLoginHandler struct {
+	pt          *paths
+	now         func() time.Time
+	random      io.Reader
+	secure      bool
+	externalURL *url.URL
+	providers   []*config.OidcProvider
+}
+
+// ServeHTTP sends the user to the provider to log in.  The state and nonce are remembered in a
+// cookie, so the callback can check the response is for this browser.
+func (h *oidcLoginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	provider := findOidcProvider(h.providers, r.FormValue(h.pt.pr.OidcProvider()))
+	if provider == nil {
+		httpError(w, &HTTPErr{
+			Message: "unknown provider",
+			Code:    http.StatusNotFound,
+		})
+		return
+	}
+	authUrl, err := url.Parse(provider.AuthorizationEndpoint)
+	if err != nil {
+		httpError(w, &HTTPErr{
+			Message: "bad authorization endpoint",
+			Code:    http.StatusInternalServerError,
+			Cause:   err,
+		})
+		return
+	}
+	state, err := newOidcRand(h.random)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	nonce, err := newOidcRand(h.random)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	st := &oidcState{
+		Provider: provider.Name,
+		State:    state,
+		Nonce:    nonce,
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcCookieName,
+		Value:    st.encode(),
+		Path:     h.pt.OidcCallback().EscapedPath(),
+		Expires:  h.now().Add(oidcStateLifetime),
+		Secure:   h.secure,
+		HttpOnly: true,
+		// The provider redirects back with a top level navigation, which Lax allows.
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	v := authUrl.Query()
+	v.Set("response_type", "code")
+	v.Set("client_id", provider.ClientId)
+	v.Set("redirect_uri", oidcRedirectUri(h.externalURL, h.pt))
+	v.Set("scope", strings.Join(append([]string{"openid"}, provider.Scope...), " "))
+	v.Set("state", state)
+	v.Set("nonce", nonce)
+	authUrl.RawQuery = v.Encode()
+	http.Redirect(w, r, authUrl.String(), http.StatusSeeOther)
+}
+
+type oidcCallbackHandler struct {
+	pt          *paths
+	c           api.PixurServiceClient
+	hc          *http.Client
+	now         func() time.Time
+	secure      bool
+	externalURL *url.URL
+	providers   []*config.OidcProvider
+	apiKey      string
+	login       *loginActionHandler
+}
+
+// ServeHTTP finishes logging in with the provider.  The code is exchanged for an id token, and the
+// identity in it is used to log in.  If the user is already logged in, the identity is linked to
+// them instead.
+func (h *oidcCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var st *oidcState
+	if c, err := r.Cookie(oidcCookieName); err == nil {
+		st, _ = decodeOidcState(c.Value)
+	}
+	// The state can only be used once.
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcCookieName,
+		Value:    "",
+		Path:     h.pt.OidcCallback().EscapedPath(),
+		Expires:  h.now().AddDate(0, 0, -1),
+		Secure:   h.secure,
+		HttpOnly: true,
+	})
+	if st == nil || r.FormValue(h.pt.pr.OidcState()) != st.State {
+		h.fail(w, r, &HTTPErr{
+			Message: "login expired, try again",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	if e := r.FormValue(h.pt.pr.OidcError()); e != "" {
+		h.fail(w, r, &HTTPErr{
+			Message: "provider refused login: " + e,
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+	provider := findOidcProvider(h.providers, st.Provider)
+	if provider == nil {
+		h.fail(w, r, &HTTPErr{
+			Message: "unknown provider",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	idToken, err := exchangeOidcCode(
+		ctx, h.hc, provider, r.FormValue(h.pt.pr.OidcCode()), oidcRedirectUri(h.externalURL, h.pt))
+	if err != nil {
+		h.fail(w, r, &HTTPErr{
+			Message: "can't get id token",
+			Code:    http.StatusBadGateway,
+			Cause:   err,
+		})
+		return
+	}
+	claims, err := parseOidcIdToken(idToken, provider, st.Nonce, h.now())
+	if err != nil {
+		h.fail(w, r, &HTTPErr{
+			Message: "bad id token",
+			Code:    http.StatusUnauthorized,
+			Cause:   err,
+		})
+		return
+	}
+
+	var authToken string
+	if c, err := r.Cookie(authPwtCookieName); err == nil {
+		authToken = c.Value
+	}
+	req := &api.GetRefreshTokenRequest{
+		PreviousAuthToken: authToken,
+		UserAgent:         r.UserAgent(),
+		ExternalProvider:  provider.Issuer,
+		ExternalSubject:   claims.Subject,
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, apiKeyHeaderKey, h.apiKey)
+	res, err := h.c.GetRefreshToken(ctx, req)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	if h.login.refresh0(w, res, r) {
+		next := h.pt.Root()
+		if authToken != "" {
+			next = h.pt.UserEdit("")
+		}
+		http.Redirect(w, r, next.String(), http.StatusSeeOther)
+	}
+}
+
+// fail shows the login page with the error.
+func (h *oidcCallbackHandler) fail(w http.ResponseWriter, r *http.Request, err error) {
+	httpWriteError(w, err)
+	r = r.WithContext(ctxFromWriteErr(r.Context(), err))
+	h.login.display.ServeHTTP(w, r)
+}
+
+// exchangeOidcCode trades the authorization code for an id token at the provider's token endpoint.
+func exchangeOidcCode(ctx context.Context, hc *http.Client, provider *config.OidcProvider,
+	code, redirectUri string) (string, error) {
+	if code == "" {
+		return "", errors.New("missing code")
+	}
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	v.Set("redirect_uri", redirectUri)
+	req, err := http.NewRequest(
+		http.MethodPost, provider.TokenEndpoint, strings.NewReader(v.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(provider.ClientId), url.QueryEscape(provider.ClientSecret))
+	resp, err := hc.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxOidcTokenResponseSize))
+	if err != nil {
+		return "", err
+	}
+	var tr struct {
+		IdToken string `json:"id_token"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(data, &tr); err != nil {
+		return "", errors.New("can't parse token response: " + err.Error())
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New("token endpoint failed: " + resp.Status + " " + tr.Error)
+	}
+	if tr.IdToken == "" {
+		return "", errors.New("missing id token")
+	}
+	return tr.IdToken, nil
+}
+
+// oidcClaims are the id token claims used to log in.
+type oidcClaims struct {
+	Issuer   string          `json:"iss"`
+	Subject  string          `json:"sub"`
+	Audience json.RawMessage `json:"aud"`
+	Expiry   int64           `json:"exp"`
+	Nonce    string          `json:"nonce"`
+}
+
+// parseOidcIdToken extracts and validates the claims of an id token.  The signature is not checked,
+// since the token came directly from the token endpoint over TLS, as allowed by OpenID Connect
+// Core 3.1.3.7.
+func parseOidcIdToken(idToken string, provider *config.OidcProvider, nonce string, now time.Time) (
+	*oidcClaims, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed id token")
+	}
+	payload, err := b64OidcEnc.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, errors.New("can't decode id token: " + err.Error())
+	}
+	claims := new(oidcClaims)
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, errors.New("can't parse id token: " + err.Error())
+	}
+	if claims.Issuer != provider.Issuer {
+		return nil, errors.New("wrong issuer " + claims.Issuer)
+	}
+	// The audience is either a single string, or a list of them.
+	var auds []string
+	var aud string
+	if err := json.Unmarshal(claims.Audience, &aud); err == nil {
+		auds = append(auds, aud)
+	} else if err := json.Unmarshal(claims.Audience, &auds); err != nil {
+		return nil, errors.New("can't parse audience: " + err.Error())
+	}
+	var found bool
+	for _, a := range auds {
+		if a == provider.ClientId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.New("wrong audience")
+	}
+	if !now.Before(time.Unix(claims.Expiry, 0)) {
+		return nil, errors.New("id token expired")
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("wrong nonce")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("missing subject")
+	}
+	return claims, nil
+}
+
+func init() {
+	register(func(s *server.Server) error {
+		if len(s.OidcProviders) == 0 {
+			return nil
+		}
+		pt := &paths{r: s.HTTPRoot}
+		ldh := readWrapper(s)(&loginDisplayHandler{
+			pt:            pt,
+			oidcProviders: oidcProviderNames(s.OidcProviders),
+		})
+		olh := &oidcLoginHandler{
+			pt:          pt,
+			now:         s.Now,
+			random:      s.Random,
+			secure:      s.Secure,
+			externalURL: s.ExternalURL,
+			providers:   s.OidcProviders,
+		}
+		// The callback is a GET, so it can't be checked for xsrf.  The state serves the same purpose.
+		och := readWrapper(s)(&oidcCallbackHandler{
+			pt:          pt,
+			c:           s.Client,
+			hc:          &http.Client{Timeout: 30 * time.Second},
+			now:         s.Now,
+			secure:      s.Secure,
+			externalURL: s.ExternalURL,
+			providers:   s.OidcProviders,
+			apiKey:      s.OidcApiKey,
+			login: &loginActionHandler{
+				c:       s.Client,
+				secure:  s.Secure,
+				pt:      pt,
+				now:     s.Now,
+				display: ldh,
+			},
+		})
+
+		s.HTTPMux.Handle(pt.OidcLogin("").Path, &methodHandler{Get: olh})
+		s.HTTPMux.Handle(pt.OidcCallback().Path, compressHtmlHandler(&methodHandler{Get: och}))
+		return nil
+	})
+}
@@ -0,0 +1,327 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server/config"
+)
+
+// oidcTestRedirectUri is the callback of the test handlers, which doesn't depend on the request.
+const oidcTestRedirectUri = "https://pixur.example.com/u/oidcCallback"
+
+// mockOidcProvider is a minimal OpenID Connect provider.  Codes are registered ahead of time with
+// the claims of the id token to issue for them.
+type mockOidcProvider struct {
+	t      *testing.T
+	srv    *httptest.Server
+	claims map[string]map[string]interface{}
+}
+
+func newMockOidcProvider(t *testing.T) *mockOidcProvider {
+	m := &mockOidcProvider{
+		t:      t,
+		claims: make(map[string]map[string]interface{}),
+	}
+	m.srv = httptest.NewServer(http.HandlerFunc(m.token))
+	return m
+}
+
+func (m *mockOidcProvider) Close() {
+	m.srv.Close()
+}
+
+func (m *mockOidcProvider) Provider() *config.OidcProvider {
+	return &config.OidcProvider{
+		Name:                  "corp",
+		Issuer:                m.srv.URL,
+		AuthorizationEndpoint: m.srv.URL + "/authorize",
+		TokenEndpoint:         m.srv.URL + "/token",
+		ClientId:              "pixur",
+		ClientSecret:          "hunter2",
+	}
+}
+
+func (m *mockOidcProvider) token(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/token" || r.Method != http.MethodPost {
+		http.NotFound(w, r)
+		return
+	}
+	if id, secret, ok := r.BasicAuth(); !ok || id != "pixur" || secret != "hunter2" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid_client"}`))
+		return
+	}
+	if r.PostFormValue("grant_type") != "authorization_code" {
+		m.t.Error("bad grant type", r.PostFormValue("grant_type"))
+	}
+	if have, want := r.PostFormValue("redirect_uri"), oidcTestRedirectUri; have != want {
+		m.t.Error("have", have, "want", want)
+	}
+	claims, ok := m.claims[r.PostFormValue("code")]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	enc := base64.RawURLEncoding
+	idToken := enc.EncodeToString([]byte(`{"alg":"RS256"}`)) + "." +
+		enc.EncodeToString(payload) + "." + enc.EncodeToString([]byte("sig"))
+	json.NewEncoder(w).Encode(map[string]string{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+	})
+}
+
+type oidcTestClient struct {
+	api.PixurServiceClient
+	req *api.GetRefreshTokenRequest
+	md  metadata.MD
+}
+
+func (c *oidcTestClient) GetRefreshToken(
+	ctx context.Context, req *api.GetRefreshTokenRequest, opts ...grpc.CallOption) (
+	*api.GetRefreshTokenResponse, error) {
+	c.req = req
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	notAfter, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	return &api.GetRefreshTokenResponse{
+		AuthToken: "authtoken",
+		AuthPayload: &api.PwtPayload{
+			NotAfter: notAfter,
+		},
+	}, nil
+}
+
+func newOidcTestHandlers(provider *config.OidcProvider, c api.PixurServiceClient) (
+	*oidcLoginHandler, *oidcCallbackHandler, *error) {
+	pt := &paths{r: &url.URL{Path: "/"}}
+	var displayErr error
+	display := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		displayErr = writeErrOrNilFromCtx(r.Context())
+	})
+	externalURL := &url.URL{Scheme: "https", Host: "pixur.example.com"}
+	olh := &oidcLoginHandler{
+		pt:          pt,
+		now:         time.Now,
+		random:      bytes.NewReader(make([]byte, 2*oidcRandLength)),
+		externalURL: externalURL,
+		providers:   []*config.OidcProvider{provider},
+	}
+	och := &oidcCallbackHandler{
+		pt:          pt,
+		c:           c,
+		hc:          http.DefaultClient,
+		now:         time.Now,
+		externalURL: externalURL,
+		providers:   []*config.OidcProvider{provider},
+		apiKey:      "key",
+		login: &loginActionHandler{
+			c:       c,
+			pt:      pt,
+			now:     time.Now,
+			display: display,
+		},
+	}
+	return olh, och, &displayErr
+}
+
+// startOidcLogin runs the login handler, and returns the state cookie and the redirect to the
+// provider.
+func startOidcLogin(t *testing.T, olh *oidcLoginHandler) (*http.Cookie, *url.URL) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/u/oidc?provider=corp", nil)
+	r.Host = "evil.example.com"
+	olh.ServeHTTP(w, r)
+	resp := w.Result()
+	if resp.StatusCode != http.StatusSeeOther {
+		t.Fatal("expected redirect", resp.Status)
+	}
+	loc, err := resp.Location()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Cookies()) != 1 || resp.Cookies()[0].Name != oidcCookieName {
+		t.Fatal("expected state cookie", resp.Cookies())
+	}
+	return resp.Cookies()[0], loc
+}
+
+func TestOidcLogin(t *testing.T) {
+	m := newMockOidcProvider(t)
+	defer m.Close()
+	c := &oidcTestClient{}
+	olh, och, displayErr := newOidcTestHandlers(m.Provider(), c)
+
+	cookie, loc := startOidcLogin(t, olh)
+	q := loc.Query()
+	if !strings.HasPrefix(loc.String(), m.srv.URL+"/authorize?") {
+		t.Error("wrong authorization endpoint", loc)
+	}
+	if q.Get("client_id") != "pixur" || q.Get("response_type") != "code" ||
+		q.Get("scope") != "openid" || q.Get("redirect_uri") != oidcTestRedirectUri {
+		t.Error("wrong authorization params", q)
+	}
+	m.claims["thecode"] = map[string]interface{}{
+		"iss":   m.srv.URL,
+		"sub":   "alice",
+		"aud":   "pixur",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": q.Get("nonce"),
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(
+		http.MethodGet, "/u/oidcCallback?code=thecode&state="+url.QueryEscape(q.Get("state")), nil)
+	r.AddCookie(cookie)
+	och.ServeHTTP(w, r)
+
+	if *displayErr != nil {
+		t.Fatal(*displayErr)
+	}
+	if w.Code != http.StatusSeeOther {
+		t.Error("expected redirect", w.Code)
+	}
+	if c.req == nil || c.req.ExternalProvider != m.srv.URL || c.req.ExternalSubject != "alice" {
+		t.Error("wrong request", c.req)
+	}
+	if keys := c.md.Get(apiKeyHeaderKey); len(keys) != 1 || keys[0] != "key" {
+		t.Error("missing api key", c.md)
+	}
+	var found bool
+	for _, ck := range w.Result().Cookies() {
+		if ck.Name == authPwtCookieName && ck.Value == "authtoken" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected auth cookie", w.Result().Cookies())
+	}
+}
+
+func TestOidcLoginFailsOnBadState(t *testing.T) {
+	m := newMockOidcProvider(t)
+	defer m.Close()
+	c := &oidcTestClient{}
+	olh, och, displayErr := newOidcTestHandlers(m.Provider(), c)
+
+	cookie, _ := startOidcLogin(t, olh)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/u/oidcCallback?code=thecode&state=forged", nil)
+	r.AddCookie(cookie)
+	och.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest || *displayErr == nil {
+		t.Error("expected error", w.Code, *displayErr)
+	}
+	if c.req != nil {
+		t.Error("expected no login", c.req)
+	}
+}
+
+func TestOidcLoginFailsOnBadNonce(t *testing.T) {
+	m := newMockOidcProvider(t)
+	defer m.Close()
+	c := &oidcTestClient{}
+	olh, och, displayErr := newOidcTestHandlers(m.Provider(), c)
+
+	cookie, loc := startOidcLogin(t, olh)
+	m.claims["thecode"] = map[string]interface{}{
+		"iss":   m.srv.URL,
+		"sub":   "alice",
+		"aud":   "pixur",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": "replayed",
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet,
+		"/u/oidcCallback?code=thecode&state="+url.QueryEscape(loc.Query().Get("state")), nil)
+	r.AddCookie(cookie)
+	och.ServeHTTP(w, r)
+
+	if w.Code != http.StatusUnauthorized || *displayErr == nil {
+		t.Error("expected error", w.Code, *displayErr)
+	}
+	if c.req != nil {
+		t.Error("expected no login", c.req)
+	}
+}
+
+func TestExchangeOidcCodeFailsOnBadClientSecret(t *testing.T) {
+	m := newMockOidcProvider(t)
+	defer m.Close()
+	provider := m.Provider()
+	provider.ClientSecret = "wrong"
+
+	_, err := exchangeOidcCode(context.Background(), http.DefaultClient, provider, "thecode", "")
+	if err == nil || !strings.Contains(err.Error(), "invalid_client") {
+		t.Error("expected error", err)
+	}
+}
+
+func TestParseOidcIdToken(t *testing.T) {
+	provider := &config.OidcProvider{
+		Issuer:   "https://idp",
+		ClientId: "pixur",
+	}
+	now := time.Now()
+	token := func(claims map[string]interface{}) string {
+		payload, _ := json.Marshal(claims)
+		return "e30." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+	}
+	good := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":   "https://idp",
+			"sub":   "alice",
+			"aud":   []string{"other", "pixur"},
+			"exp":   now.Add(time.Minute).Unix(),
+			"nonce": "n",
+		}
+	}
+
+	claims, err := parseOidcIdToken(token(good()), provider, "n", now)
+	if err != nil || claims.Subject != "alice" {
+		t.Error("expected claims", claims, err)
+	}
+
+	cases := []struct {
+		name, key string
+		val       interface{}
+	}{
+		{"issuer", "iss", "https://evil"},
+		{"audience", "aud", "other"},
+		{"expired", "exp", now.Add(-time.Minute).Unix()},
+		{"nonce", "nonce", "m"},
+		{"subject", "sub", ""},
+	}
+	for _, c := range cases {
+		claims := good()
+		claims[c.key] = c.val
+		if _, err := parseOidcIdToken(token(claims), provider, "n", now); err == nil {
+			t.Error("expected error for", c.name)
+		}
+	}
+	if _, err := parseOidcIdToken("notajwt", provider, "n", now); err == nil {
+		t.Error("expected error for malformed token")
+	}
+}
@@ -31,6 +31,48 @@ func (p params) Secret() string {
 	return "secret"
 }
 
+func (p params) InviteCode() string {
+	return "invite_code"
+}
+
+func (p params) OidcProvider() string {
+	return "provider"
+}
+
+// OidcCode and OidcState are set by the provider when redirecting back, so they are the names the
+// OpenID Connect spec uses.
+func (p params) OidcCode() string {
+	return "code"
+}
+
+func (p params) OidcState() string {
+	return "state"
+}
+
+func (p params) OidcError() string {
+	return "error"
+}
+
+func (p params) NewSecret() string {
+	return "new_secret"
+}
+
+func (p params) TotpCode() string {
+	return "totp_code"
+}
+
+func (p params) SessionId() string {
+	return "session_id"
+}
+
+func (p params) AllSessions() string {
+	return "all_sessions"
+}
+
+func (p params) ResetToken() string {
+	return "reset_token"
+}
+
 func (p params) Logout() string {
 	return "is_logout"
 }
@@ -59,10 +101,94 @@ func (p params) UserEventsAsc() string {
 	return "asc"
 }
 
+func (p params) ActorUserId() string {
+	return "actor_user_id"
+}
+
+func (p params) TargetUserId() string {
+	return "target_user_id"
+}
+
+func (p params) TargetPicId() string {
+	return "target_pic_id"
+}
+
+func (p params) AuditLogAction() string {
+	return "action"
+}
+
+func (p params) StartAuditLogId() string {
+	return "start_audit_log_id"
+}
+
+func (p params) StartPicFavoriteId() string {
+	return "start_pic_favorite_id"
+}
+
+// Favorite is set to True to favorite a pic, or False to unfavorite it.
+func (p params) Favorite() string {
+	return "favorite"
+}
+
+func (p params) CollectionId() string {
+	return "collection_id"
+}
+
+func (p params) CollectionName() string {
+	return "collection_name"
+}
+
+func (p params) CollectionVisibility() string {
+	return "visibility"
+}
+
+// CollectionMove is the direction to move a pic in a collection, either "up" or "down".
+func (p params) CollectionMove() string {
+	return "move"
+}
+
+func (p params) PoolId() string {
+	return "pool_id"
+}
+
+func (p params) StartPoolId() string {
+	return "start_pool_id"
+}
+
+func (p params) PoolTitle() string {
+	return "pool_title"
+}
+
+func (p params) PoolDescription() string {
+	return "pool_description"
+}
+
+// BeforePicId is the pic in a pool to insert or move another pic in front of.
+func (p params) BeforePicId() string {
+	return "before_pic_id"
+}
+
+// Follow is set to True to follow a user or tag, or False to unfollow it.
+func (p params) Follow() string {
+	return "follow"
+}
+
 func (p params) Version() string {
 	return "version"
 }
 
+func (p params) DisplayName() string {
+	return "display_name"
+}
+
+func (p params) Bio() string {
+	return "bio"
+}
+
+func (p params) AvatarPicId() string {
+	return "avatar_pic_id"
+}
+
 func (p params) CommentText() string {
 	return "text"
 }
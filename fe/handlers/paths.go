@@ -63,6 +63,26 @@ func (p *paths) Logout() *url.URL {
 	return p.User().ResolveReference(&url.URL{Path: "logout"})
 }
 
+func (p *paths) OidcLogin(provider string) *url.URL {
+	v := url.Values{}
+	v.Add(p.pr.OidcProvider(), provider)
+	return p.User().ResolveReference(&url.URL{Path: "oidc", RawQuery: v.Encode()})
+}
+
+func (p *paths) OidcCallback() *url.URL {
+	return p.User().ResolveReference(&url.URL{Path: "oidcCallback"})
+}
+
+func (p *paths) ResetSecret(ident string) *url.URL {
+	u := url.URL{Path: "reset"}
+	if ident != "" {
+		v := url.Values{}
+		v.Add(p.pr.Ident(), ident)
+		u.RawQuery = v.Encode()
+	}
+	return p.User().ResolveReference(&u)
+}
+
 func (p *paths) UserEdit(userID string) *url.URL {
 	u := url.URL{Path: "edit"}
 	if userID != "" {
@@ -73,6 +93,20 @@ func (p *paths) UserEdit(userID string) *url.URL {
 	return p.User().ResolveReference(&u)
 }
 
+func (p *paths) UserProfile(userID string) *url.URL {
+	u := url.URL{Path: "profile"}
+	if userID != "" {
+		v := url.Values{}
+		v.Add(p.pr.UserId(), userID)
+		u.RawQuery = v.Encode()
+	}
+	return p.User().ResolveReference(&u)
+}
+
+func (p *paths) UserExport() *url.URL {
+	return p.User().ResolveReference(&url.URL{Path: "export"})
+}
+
 func (p *paths) UserEvents(userID, userEventID string, asc bool) *url.URL {
 	u := url.URL{Path: "activity"}
 	v := url.Values{}
@@ -91,6 +125,105 @@ func (p *paths) UserEvents(userID, userEventID string, asc bool) *url.URL {
 	return p.User().ResolveReference(&u)
 }
 
+func (p *paths) AuditLog() *url.URL {
+	return p.User().ResolveReference(&url.URL{Path: "audit"})
+}
+
+// AuditLogPage is the page of audit log entries matching f, starting with startAuditLogID.
+func (p *paths) AuditLogPage(f auditLogFilter, startAuditLogID string) *url.URL {
+	u := url.URL{Path: "audit"}
+	v := url.Values{}
+	if f.ActorUserId != "" {
+		v.Add(p.pr.ActorUserId(), f.ActorUserId)
+	}
+	if f.TargetUserId != "" {
+		v.Add(p.pr.TargetUserId(), f.TargetUserId)
+	}
+	if f.TargetPicId != "" {
+		v.Add(p.pr.TargetPicId(), f.TargetPicId)
+	}
+	if f.Action != api.AuditLog_UNKNOWN {
+		v.Add(p.pr.AuditLogAction(), f.Action.String())
+	}
+	if startAuditLogID != "" {
+		v.Add(p.pr.StartAuditLogId(), startAuditLogID)
+	}
+	if len(v) != 0 {
+		u.RawQuery = v.Encode()
+	}
+	return p.User().ResolveReference(&u)
+}
+
+func (p *paths) Favorites(startPicFavoriteID string) *url.URL {
+	u := url.URL{Path: "favorites"}
+	if startPicFavoriteID != "" {
+		v := url.Values{}
+		v.Add(p.pr.StartPicFavoriteId(), startPicFavoriteID)
+		u.RawQuery = v.Encode()
+	}
+	return p.User().ResolveReference(&u)
+}
+
+// Feed is the pics from the users and tags the subject user follows.
+func (p *paths) Feed(id string, prev bool) *url.URL {
+	u := url.URL{Path: "feed"}
+	v := url.Values{}
+	if id != "" {
+		v.Set(p.pr.IndexPic(), id)
+	}
+	if prev {
+		v.Set(p.pr.IndexPrev(), "")
+	}
+	if len(v) != 0 {
+		u.RawQuery = v.Encode()
+	}
+	return p.User().ResolveReference(&u)
+}
+
+func (p *paths) FeedPrev(id string) *url.URL {
+	return p.Feed(id, true)
+}
+
+func (p *paths) Collections(userID string) *url.URL {
+	u := url.URL{Path: "collections"}
+	if userID != "" {
+		v := url.Values{}
+		v.Add(p.pr.UserId(), userID)
+		u.RawQuery = v.Encode()
+	}
+	return p.User().ResolveReference(&u)
+}
+
+func (p *paths) Collection(collectionID string) *url.URL {
+	u := url.URL{Path: "collection"}
+	if collectionID != "" {
+		v := url.Values{}
+		v.Add(p.pr.CollectionId(), collectionID)
+		u.RawQuery = v.Encode()
+	}
+	return p.User().ResolveReference(&u)
+}
+
+func (p *paths) Pools(startPoolID string) *url.URL {
+	u := url.URL{Path: "pools"}
+	if startPoolID != "" {
+		v := url.Values{}
+		v.Add(p.pr.StartPoolId(), startPoolID)
+		u.RawQuery = v.Encode()
+	}
+	return p.User().ResolveReference(&u)
+}
+
+func (p *paths) Pool(poolID string) *url.URL {
+	u := url.URL{Path: "pool"}
+	if poolID != "" {
+		v := url.Values{}
+		v.Add(p.pr.PoolId(), poolID)
+		u.RawQuery = v.Encode()
+	}
+	return p.User().ResolveReference(&u)
+}
+
 func (p *paths) ActionDir() *url.URL {
 	return p.Root().ResolveReference(&url.URL{Path: "a/"})
 }
@@ -104,6 +237,14 @@ func (p *paths) CreateUserAction() *url.URL {
 	return p.ActionDir().ResolveReference(&url.URL{Path: "createUser"})
 }
 
+func (p *paths) StartUserSecretResetAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "startSecretReset"})
+}
+
+func (p *paths) FinishUserSecretResetAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "finishSecretReset"})
+}
+
 func (p *paths) PicFile(pf *api.PicFile) *url.URL {
 	return p.pic(pf.Id, pf.Format)
 }
@@ -146,6 +287,71 @@ func (p *paths) CommentReply(picID, commentID string) *url.URL {
 	return p.Comment().ResolveReference(&url.URL{RawQuery: v.Encode()})
 }
 
+func (p *paths) CommentEdit(picID, commentID string) *url.URL {
+	v := url.Values{}
+	v.Add(p.pr.PicId(), picID)
+	v.Add(p.pr.CommentId(), commentID)
+	return p.Comment().ResolveReference(&url.URL{RawQuery: v.Encode()})
+}
+
+func (p *paths) EditPicCommentAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "editPicComment"})
+}
+
+func (p *paths) DeletePicCommentAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "deletePicComment"})
+}
+
+func (p *paths) FavoritePicAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "favoritePic"})
+}
+
+// FollowAction follows or unfollows a user or a tag.
+func (p *paths) FollowAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "follow"})
+}
+
+func (p *paths) CreateCollectionAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "createCollection"})
+}
+
+func (p *paths) UpdateCollectionAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "updateCollection"})
+}
+
+func (p *paths) DeleteCollectionAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "deleteCollection"})
+}
+
+func (p *paths) AddCollectionPicAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "addCollectionPic"})
+}
+
+func (p *paths) RemoveCollectionPicAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "removeCollectionPic"})
+}
+
+// MoveCollectionPicAction moves a pic one place up or down in a collection.
+func (p *paths) MoveCollectionPicAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "moveCollectionPic"})
+}
+
+func (p *paths) CreatePoolAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "createPool"})
+}
+
+func (p *paths) InsertPoolPicAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "insertPoolPic"})
+}
+
+func (p *paths) MovePoolPicAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "movePoolPic"})
+}
+
+func (p *paths) RemovePoolPicAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "removePoolPic"})
+}
+
 func (p *paths) UpsertPicAction() *url.URL {
 	return p.ActionDir().ResolveReference(&url.URL{Path: "upsertPic"})
 }
@@ -157,3 +363,31 @@ func (p *paths) SoftDeletePicAction() *url.URL {
 func (p *paths) UpdateUserAction() *url.URL {
 	return p.ActionDir().ResolveReference(&url.URL{Path: "updateUser"})
 }
+
+func (p *paths) DeleteAccountAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "deleteAccount"})
+}
+
+func (p *paths) UpdateUserProfileAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "updateUserProfile"})
+}
+
+func (p *paths) UpdateUserSecretAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "updateUserSecret"})
+}
+
+func (p *paths) StartTotpEnrollmentAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "startTotpEnrollment"})
+}
+
+func (p *paths) FinishTotpEnrollmentAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "finishTotpEnrollment"})
+}
+
+func (p *paths) DisableTotpAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "disableTotp"})
+}
+
+func (p *paths) RevokeSessionAction() *url.URL {
+	return p.ActionDir().ResolveReference(&url.URL{Path: "revokeSession"})
+}
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server"
+	ptpl "pixur.org/pixur/fe/tpl"
+)
+
+type poolHandler struct {
+	pt       *paths
+	c        api.PixurServiceClient
+	poolsTpl *template.Template
+	poolTpl  *template.Template
+}
+
+type poolsData struct {
+	*paneData
+
+	Pool []*api.Pool
+	Next string
+	// CanEdit is true if the subject user may create pools.
+	CanEdit bool
+}
+
+type poolData struct {
+	*paneData
+
+	Pool *api.Pool
+	Pic  []*poolPic
+	// CanEdit is true if the subject user may change the pics in the pool.
+	CanEdit bool
+}
+
+// poolPic is a pic in a pool, along with where to move it to shift it by one.
+type poolPic struct {
+	*indexPic
+
+	// CanMoveUp and CanMoveDown are false at the start and end of the pool.
+	CanMoveUp, CanMoveDown bool
+	// UpBeforePicId and DownBeforePicId are the pic to move in front of to shift this pic by one.
+	// DownBeforePicId is empty when moving to the end of the pool.
+	UpBeforePicId, DownBeforePicId string
+}
+
+func newPoolPics(pics []*api.PicAndThumbnail) []*poolPic {
+	ips := newIndexPics(pics)
+	pps := make([]*poolPic, 0, len(ips))
+	for i, ip := range ips {
+		pp := &poolPic{
+			indexPic:    ip,
+			CanMoveUp:   i > 0,
+			CanMoveDown: i < len(ips)-1,
+		}
+		if pp.CanMoveUp {
+			pp.UpBeforePicId = ips[i-1].Pic.Id
+		}
+		if i+2 < len(ips) {
+			pp.DownBeforePicId = ips[i+2].Pic.Id
+		}
+		pps = append(pps, pp)
+	}
+	return pps
+}
+
+func (h *poolHandler) pools(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	resp, err := h.c.FindPools(ctx, &api.FindPoolsRequest{
+		StartPoolId: r.FormValue(h.pt.pr.StartPoolId()),
+	})
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+
+	data := &poolsData{
+		paneData: newPaneData(ctx, "Pools", h.pt),
+		Pool:     resp.Pool,
+		Next:     resp.NextPoolId,
+		CanEdit:  hasCap(ctx, api.Capability_PIC_POOL_EDIT),
+	}
+	if err := h.poolsTpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
+func (h *poolHandler) pool(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	resp, err := h.c.LookupPool(ctx, &api.LookupPoolRequest{
+		PoolId: r.FormValue(h.pt.pr.PoolId()),
+	})
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+
+	data := &poolData{
+		paneData: newPaneData(ctx, resp.Pool.Title, h.pt),
+		Pool:     resp.Pool,
+		Pic:      newPoolPics(resp.Pic),
+		CanEdit:  hasCap(ctx, api.Capability_PIC_POOL_EDIT),
+	}
+	if err := h.poolTpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
+func (h *poolHandler) create(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.CreatePoolRequest{
+		Title:       r.PostFormValue(pr.PoolTitle()),
+		Description: r.PostFormValue(pr.PoolDescription()),
+	}
+	res, err := h.c.CreatePool(r.Context(), req)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Pool(res.Pool.PoolId).String(), http.StatusSeeOther)
+}
+
+func (h *poolHandler) insertPic(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.InsertPoolPicRequest{
+		PoolId:      r.PostFormValue(pr.PoolId()),
+		PicId:       r.PostFormValue(pr.PicId()),
+		BeforePicId: r.PostFormValue(pr.BeforePicId()),
+	}
+	if _, err := h.c.InsertPoolPic(r.Context(), req); err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Viewer(req.PicId).String(), http.StatusSeeOther)
+}
+
+func (h *poolHandler) movePic(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.MovePoolPicRequest{
+		PoolId:      r.PostFormValue(pr.PoolId()),
+		PicId:       r.PostFormValue(pr.PicId()),
+		BeforePicId: r.PostFormValue(pr.BeforePicId()),
+	}
+	res, err := h.c.MovePoolPic(r.Context(), req)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Pool(res.Pool.PoolId).String(), http.StatusSeeOther)
+}
+
+func (h *poolHandler) removePic(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.RemovePoolPicRequest{
+		PoolId: r.PostFormValue(pr.PoolId()),
+		PicId:  r.PostFormValue(pr.PicId()),
+	}
+	res, err := h.c.RemovePoolPic(r.Context(), req)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Pool(res.Pool.PoolId).String(), http.StatusSeeOther)
+}
+
+func init() {
+	register(func(s *server.Server) error {
+		h := poolHandler{
+			c:        s.Client,
+			pt:       &paths{r: s.HTTPRoot},
+			poolsTpl: parseTpl(ptpl.Base, ptpl.Pane, ptpl.Pools),
+			poolTpl:  parseTpl(ptpl.Base, ptpl.Pane, ptpl.Pool),
+		}
+
+		s.HTTPMux.Handle(h.pt.Pools("").Path, readWrapper(s)(http.HandlerFunc(h.pools)))
+		s.HTTPMux.Handle(h.pt.Pool("").Path, readWrapper(s)(http.HandlerFunc(h.pool)))
+		s.HTTPMux.Handle(h.pt.CreatePoolAction().Path, writeWrapper(s)(http.HandlerFunc(h.create)))
+		s.HTTPMux.Handle(
+			h.pt.InsertPoolPicAction().Path, writeWrapper(s)(http.HandlerFunc(h.insertPic)))
+		s.HTTPMux.Handle(h.pt.MovePoolPicAction().Path, writeWrapper(s)(http.HandlerFunc(h.movePic)))
+		s.HTTPMux.Handle(
+			h.pt.RemovePoolPicAction().Path, writeWrapper(s)(http.HandlerFunc(h.removePic)))
+		return nil
+	})
+}
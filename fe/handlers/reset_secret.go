@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server"
+	ptpl "pixur.org/pixur/fe/tpl"
+)
+
+var resetSecretDisplayTpl = parseTpl(ptpl.Base, ptpl.Pane, ptpl.ResetSecret)
+
+type resetSecretData struct {
+	*paneData
+
+	Ident string
+	// Sent is set after a reset code has been requested.
+	Sent bool
+}
+
+type resetSecretDisplayHandler struct {
+	pt *paths
+}
+
+func (h *resetSecretDisplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ident := r.FormValue(h.pt.pr.Ident())
+	data := &resetSecretData{
+		paneData: newPaneData(ctx, "Reset Password", h.pt),
+		Ident:    ident,
+		// The start action redirects back here with the ident filled in.
+		Sent: r.Method == http.MethodGet && ident != "",
+	}
+
+	if err := resetSecretDisplayTpl.Execute(w, data); err != nil {
+		httpCleanupError(w, err)
+		return
+	}
+}
+
+type startUserSecretResetHandler struct {
+	pt      *paths
+	c       api.PixurServiceClient
+	display http.Handler
+}
+
+func (h *startUserSecretResetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ident := r.PostFormValue(h.pt.pr.Ident())
+	req := &api.StartUserSecretResetRequest{
+		Ident: ident,
+	}
+
+	ctx := r.Context()
+	if _, err := h.c.StartUserSecretReset(ctx, req); err != nil {
+		httpWriteError(w, err)
+		ctx = ctxFromWriteErr(ctx, err)
+		r = r.WithContext(ctx)
+		h.display.ServeHTTP(w, r)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.ResetSecret(ident).String(), http.StatusSeeOther)
+}
+
+type finishUserSecretResetHandler struct {
+	pt      *paths
+	c       api.PixurServiceClient
+	display http.Handler
+}
+
+func (h *finishUserSecretResetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req := &api.FinishUserSecretResetRequest{
+		Ident:      r.PostFormValue(h.pt.pr.Ident()),
+		ResetToken: r.PostFormValue(h.pt.pr.ResetToken()),
+		NewSecret:  r.PostFormValue(h.pt.pr.NewSecret()),
+	}
+
+	ctx := r.Context()
+	if _, err := h.c.FinishUserSecretReset(ctx, req); err != nil {
+		httpWriteError(w, err)
+		ctx = ctxFromWriteErr(ctx, err)
+		r = r.WithContext(ctx)
+		h.display.ServeHTTP(w, r)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Login().String(), http.StatusSeeOther)
+}
+
+func init() {
+	register(func(s *server.Server) error {
+		pt := &paths{r: s.HTTPRoot}
+		rsdh := readWrapper(s)(&resetSecretDisplayHandler{
+			pt: pt,
+		})
+		susrh := writeWrapper(s)(&startUserSecretResetHandler{
+			c:       s.Client,
+			pt:      pt,
+			display: rsdh,
+		})
+		fusrh := writeWrapper(s)(&finishUserSecretResetHandler{
+			c:       s.Client,
+			pt:      pt,
+			display: rsdh,
+		})
+
+		s.HTTPMux.Handle(pt.ResetSecret("").Path, compressHtmlHandler(&methodHandler{
+			Get: rsdh,
+		}))
+		s.HTTPMux.Handle(pt.StartUserSecretResetAction().Path, compressHtmlHandler(&methodHandler{
+			Post: susrh,
+		}))
+		s.HTTPMux.Handle(pt.FinishUserSecretResetAction().Path, compressHtmlHandler(&methodHandler{
+			Post: fusrh,
+		}))
+		return nil
+	})
+}
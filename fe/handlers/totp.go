@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server"
+	ptpl "pixur.org/pixur/fe/tpl"
+)
+
+type totpData struct {
+	*paneData
+
+	// Secret and KeyUri are set while enrollment is pending.
+	Secret string
+	KeyUri template.URL
+	// RecoveryCode is set once enrollment is finished.
+	RecoveryCode []string
+}
+
+type totpHandler struct {
+	pt      *paths
+	c       api.PixurServiceClient
+	totpTpl *template.Template
+}
+
+func (h *totpHandler) startEnrollment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	res, err := h.c.StartTotpEnrollment(ctx, &api.StartTotpEnrollmentRequest{
+		Secret: r.PostFormValue(h.pt.pr.Secret()),
+	})
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	data := &totpData{
+		paneData: newPaneData(ctx, "Two-Factor Authentication", h.pt),
+		Secret:   res.Secret,
+		// otpauth:// isn't a scheme html/template trusts by default.
+		KeyUri: template.URL(res.KeyUri),
+	}
+	if err := h.totpTpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
+func (h *totpHandler) finishEnrollment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	res, err := h.c.FinishTotpEnrollment(ctx, &api.FinishTotpEnrollmentRequest{
+		Code: r.PostFormValue(h.pt.pr.TotpCode()),
+	})
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	data := &totpData{
+		paneData:     newPaneData(ctx, "Two-Factor Authentication", h.pt),
+		RecoveryCode: res.RecoveryCode,
+	}
+	if err := h.totpTpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
+func (h *totpHandler) disable(w http.ResponseWriter, r *http.Request) {
+	req := &api.DisableTotpRequest{
+		Secret: r.PostFormValue(h.pt.pr.Secret()),
+		Code:   r.PostFormValue(h.pt.pr.TotpCode()),
+	}
+	if _, err := h.c.DisableTotp(r.Context(), req); err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.UserEdit("").String(), http.StatusSeeOther)
+}
+
+func init() {
+	register(func(s *server.Server) error {
+		h := totpHandler{
+			c:       s.Client,
+			pt:      &paths{r: s.HTTPRoot},
+			totpTpl: parseTpl(ptpl.Base, ptpl.Pane, ptpl.Totp),
+		}
+
+		s.HTTPMux.Handle(h.pt.StartTotpEnrollmentAction().Path, compressHtmlHandler(&methodHandler{
+			Post: writeWrapper(s)(http.HandlerFunc(h.startEnrollment)),
+		}))
+		s.HTTPMux.Handle(h.pt.FinishTotpEnrollmentAction().Path, compressHtmlHandler(&methodHandler{
+			Post: writeWrapper(s)(http.HandlerFunc(h.finishEnrollment)),
+		}))
+		s.HTTPMux.Handle(h.pt.DisableTotpAction().Path, compressHtmlHandler(&methodHandler{
+			Post: writeWrapper(s)(http.HandlerFunc(h.disable)),
+		}))
+		return nil
+	})
+}
@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"context"
+	"crypto/md5"
 	"encoding/hex"
-	"io/ioutil"
+	"io"
 	"mime/multipart"
 	"net/http"
 
@@ -46,32 +48,96 @@ func (h *upsertPicHandler) upsert(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
-	var data []byte
+	var pic *api.Pic
 	if filedata != nil {
-		var err error
-		data, err = ioutil.ReadAll(filedata)
-		if err != nil {
-			httpError(w, &HTTPErr{
-				Message: "can't read file: " + err.Error(),
-				Code:    http.StatusInternalServerError,
-			})
+		if md5Hash == nil {
+			mh := md5.New()
+			if _, err := io.Copy(mh, filedata); err != nil {
+				httpError(w, &HTTPErr{
+					Message: "can't read file: " + err.Error(),
+					Code:    http.StatusInternalServerError,
+				})
+				return
+			}
+			if _, err := filedata.Seek(0, io.SeekStart); err != nil {
+				httpError(w, &HTTPErr{
+					Message: "can't seek file: " + err.Error(),
+					Code:    http.StatusInternalServerError,
+				})
+				return
+			}
+			md5Hash = mh.Sum(nil)
+		}
+		var sts error
+		pic, sts = h.upsertStream(ctx, &api.UpsertPicStreamRequest_Metadata{
+			Url:     r.FormValue(h.pt.pr.Url()),
+			Name:    filename,
+			Md5Hash: md5Hash,
+		}, filedata)
+		if sts != nil {
+			httpError(w, sts)
 			return
 		}
+	} else {
+		resp, sts := h.c.UpsertPic(ctx, &api.UpsertPicRequest{
+			Url:     r.FormValue(h.pt.pr.Url()),
+			Name:    filename,
+			Md5Hash: md5Hash,
+		})
+		if sts != nil {
+			httpError(w, sts)
+			return
+		}
+		pic = resp.Pic
 	}
 
-	resp, sts := h.c.UpsertPic(ctx, &api.UpsertPicRequest{
-		Url:     r.FormValue(h.pt.pr.Url()),
-		Name:    filename,
-		Data:    data,
-		Md5Hash: md5Hash,
-	})
+	http.Redirect(w, r, h.pt.Viewer(pic.Id).String(), http.StatusSeeOther)
+}
 
-	if sts != nil {
-		httpError(w, sts)
-		return
-	}
+// upsertChunkSize is the largest chunk of a file sent in a single stream message.
+const upsertChunkSize = 1 << 20
 
-	http.Redirect(w, r, h.pt.Viewer(resp.Pic.Id).String(), http.StatusSeeOther)
+// upsertStream sends the file to the backend in chunks, rather than reading it all into memory.
+func (h *upsertPicHandler) upsertStream(
+	ctx context.Context, md *api.UpsertPicStreamRequest_Metadata, r io.Reader) (*api.Pic, error) {
+	// Streams don't go through the unary interceptor, so add the auth token here.
+	ctx = outgoingCtx(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	upsc, err := h.c.UpsertPicStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// If Send returns io.EOF, the backend has stopped early and the real error comes from
+	// CloseAndRecv.
+	err = upsc.Send(&api.UpsertPicStreamRequest{
+		Part: &api.UpsertPicStreamRequest_Metadata_{Metadata: md},
+	})
+	buf := make([]byte, upsertChunkSize)
+	for err == nil {
+		n, rerr := r.Read(buf)
+		if n > 0 {
+			err = upsc.Send(&api.UpsertPicStreamRequest{
+				Part: &api.UpsertPicStreamRequest_Chunk{Chunk: buf[:n]},
+			})
+		}
+		if rerr == io.EOF {
+			break
+		} else if rerr != nil {
+			return nil, &HTTPErr{
+				Message: "can't read file: " + rerr.Error(),
+				Code:    http.StatusInternalServerError,
+			}
+		}
+	}
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	resp, err := upsc.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Pic, nil
 }
 
 func init() {
@@ -5,6 +5,9 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
 
 	"pixur.org/pixur/api"
 	"pixur.org/pixur/fe/server"
@@ -12,10 +15,14 @@ import (
 )
 
 type userHandler struct {
-	pt            *paths
-	c             api.PixurServiceClient
-	userEditTpl   *template.Template
-	userEventsTpl *template.Template
+	pt             *paths
+	c              api.PixurServiceClient
+	now            func() time.Time
+	secure         bool
+	userEditTpl    *template.Template
+	userEventsTpl  *template.Template
+	userProfileTpl *template.Template
+	oidcProviders  []string
 }
 
 type userEditData struct {
@@ -24,8 +31,16 @@ type userEditData struct {
 	ObjectUser *api.User
 
 	CanEditCap bool
+	// IsSelf is true if the object user is the subject user, who may change their secret.
+	IsSelf bool
+	// CanEditProfile is true if the subject user may change the profile of the object user.
+	CanEditProfile bool
 
 	Cap []capInfo
+	// Session is the logged in sessions of the object user, only set if IsSelf.
+	Session []*api.Session
+	// OidcProviders are the names of providers the object user may link, only set if IsSelf.
+	OidcProviders []string
 }
 
 type userPaneData struct {
@@ -41,6 +56,20 @@ type userEventsData struct {
 	UserEvents []*api.UserEvent
 }
 
+type userProfileData struct {
+	*userPaneData
+
+	UserInfo *api.PublicUserInfo
+	// Avatar is the pic chosen by the user to show next to their name.  May be absent.
+	Avatar *api.Pic
+	// Uploads is the recent uploads of the user.
+	Uploads []*api.UserEvent_UpsertPic
+	// Following is true if the subject user follows this user.
+	Following bool
+	// CanFollow is true if the subject user may follow this user.
+	CanFollow bool
+}
+
 type capInfo struct {
 	Cap         api.Capability_Cap
 	Description string
@@ -90,6 +119,61 @@ func (h *userHandler) userEvents(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *userHandler) profile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	objectUserId := r.FormValue(h.pt.pr.UserId())
+	resp, err := h.c.LookupPublicUserInfo(ctx, &api.LookupPublicUserInfoRequest{
+		UserId: objectUserId,
+	})
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	data := &userProfileData{
+		userPaneData: &userPaneData{
+			paneData:     newPaneData(ctx, "Profile", h.pt),
+			ObjectUserId: objectUserId,
+		},
+		UserInfo: resp.UserInfo,
+	}
+	if avatarPicId := resp.UserInfo.Profile.GetAvatarPicId(); avatarPicId != "" {
+		// discard the error, since the profile is still useful without the avatar
+		if resp, err := h.c.LookupPicDetails(ctx, &api.LookupPicDetailsRequest{
+			PicId: avatarPicId,
+		}); err == nil {
+			data.Avatar = resp.Pic
+		}
+	}
+	// discard the error, since the subject user may not be allowed to see the uploads
+	if resp, err := h.c.FindUserEvents(ctx, &api.FindUserEventsRequest{
+		UserId: resp.UserInfo.UserId,
+	}); err == nil {
+		for _, ue := range resp.UserEvent {
+			if evt := ue.GetUpsertPic(); evt != nil {
+				data.Uploads = append(data.Uploads, evt)
+			}
+		}
+	}
+	su := subjectUserOrNilFromCtx(ctx)
+	if su != nil && su.UserId != data.UserInfo.UserId && hasCap(ctx, api.Capability_FOLLOW_CREATE) {
+		data.CanFollow = true
+		// discard the error, since the profile is still useful without the follow state
+		if resp, err := h.c.FindFollows(ctx, &api.FindFollowsRequest{}); err == nil {
+			for _, userId := range resp.UserId {
+				if userId == data.UserInfo.UserId {
+					data.Following = true
+				}
+			}
+		}
+	}
+
+	if err := h.userProfileTpl.Execute(w, data); err != nil {
+		httpError(w, err)
+		return
+	}
+}
+
 func (h *userHandler) static(w http.ResponseWriter, r *http.Request) {
 	if err := r.ParseForm(); err != nil {
 		httpError(w, &HTTPErr{
@@ -121,13 +205,7 @@ func (h *userHandler) static(w http.ResponseWriter, r *http.Request) {
 		objectUser = resp.User
 	}
 
-	var canedit bool
-	for _, c := range subjectUser.Capability {
-		if c == api.Capability_USER_UPDATE_CAPABILITY {
-			canedit = true
-			break
-		}
-	}
+	canedit := hasCap(ctx, api.Capability_USER_UPDATE_CAPABILITY)
 
 	userCaps := make(map[api.Capability_Cap]bool, len(objectUser.Capability))
 	for _, c := range objectUser.Capability {
@@ -149,14 +227,32 @@ func (h *userHandler) static(w http.ResponseWriter, r *http.Request) {
 		return caps[i].Cap.String() < caps[k].Cap.String()
 	})
 
+	isSelf := objectUser.UserId == subjectUser.UserId
+	caneditprofile := canedit || (isSelf && hasCap(ctx, api.Capability_USER_UPDATE_PROFILE))
+	var sessions []*api.Session
+	var oidcProviders []string
+	if isSelf {
+		resp, err := h.c.ListSessions(ctx, &api.ListSessionsRequest{})
+		if err != nil {
+			httpError(w, err)
+			return
+		}
+		sessions = resp.Session
+		oidcProviders = h.oidcProviders
+	}
+
 	data := userEditData{
 		userPaneData: &userPaneData{
 			paneData:     newPaneData(ctx, "User Edit", h.pt),
 			ObjectUserId: objectUser.UserId,
 		},
-		ObjectUser: objectUser,
-		CanEditCap: canedit,
-		Cap:        caps,
+		ObjectUser:     objectUser,
+		CanEditCap:     canedit,
+		IsSelf:         isSelf,
+		CanEditProfile: caneditprofile,
+		Cap:            caps,
+		Session:        sessions,
+		OidcProviders:  oidcProviders,
 	}
 	if err := h.userEditTpl.Execute(w, data); err != nil {
 		httpError(w, err)
@@ -222,6 +318,124 @@ func (h *userHandler) useredit(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, h.pt.UserEdit(res.User.UserId).String(), http.StatusSeeOther)
 }
 
+func (h *userHandler) updateProfile(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	version, err := strconv.ParseInt(r.PostFormValue(pr.Version()), 10, 64)
+	if err != nil {
+		httpError(w, &HTTPErr{
+			Message: "can't parse version",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	req := &api.UpdateUserRequest{
+		UserId:  r.PostFormValue(pr.UserId()),
+		Version: version,
+		Profile: &api.UpdateUserRequest_ChangeProfile{
+			Profile: &api.UserProfile{
+				DisplayName: r.PostFormValue(pr.DisplayName()),
+				Bio:         r.PostFormValue(pr.Bio()),
+				AvatarPicId: r.PostFormValue(pr.AvatarPicId()),
+			},
+		},
+	}
+	res, err := h.c.UpdateUser(r.Context(), req)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.UserProfile(res.User.UserId).String(), http.StatusSeeOther)
+}
+
+func (h *userHandler) updateSecret(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.UpdateUserSecretRequest{
+		Secret:    r.PostFormValue(pr.Secret()),
+		NewSecret: r.PostFormValue(pr.NewSecret()),
+	}
+	if _, err := h.c.UpdateUserSecret(r.Context(), req); err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.UserEdit("").String(), http.StatusSeeOther)
+}
+
+func (h *userHandler) revokeSession(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.RevokeSessionRequest{
+		All: r.PostFormValue(pr.AllSessions()) != "",
+	}
+	if !req.All {
+		req.SessionId = r.PostFormValue(pr.SessionId())
+	}
+	if _, err := h.c.RevokeSession(r.Context(), req); err != nil {
+		httpError(w, err)
+		return
+	}
+
+	if req.All {
+		// This browser was logged out too.
+		clearAuthCookies(w, h.pt, h.now(), h.secure)
+		http.Redirect(w, r, h.pt.Logout().String(), http.StatusSeeOther)
+		return
+	}
+	http.Redirect(w, r, h.pt.UserEdit("").String(), http.StatusSeeOther)
+}
+
+func (h *userHandler) exportData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	stream, err := h.c.ExportMyData(ctx, &api.ExportMyDataRequest{})
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	// Read the first record before writing, so that errors can still be reported.
+	resp, err := stream.Recv()
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="pixur-export.json"`)
+	// Each record is written as one line of JSON.
+	m := &jsonpb.Marshaler{}
+	for {
+		if err := m.Marshal(w, resp); err != nil {
+			return
+		}
+		if _, err := w.Write([]byte("\n")); err != nil {
+			return
+		}
+		if resp, err = stream.Recv(); err != nil {
+			// The export is incomplete if this is not io.EOF, but the headers are already written.
+			return
+		}
+	}
+}
+
+func (h *userHandler) deleteAccount(w http.ResponseWriter, r *http.Request) {
+	var pr params
+
+	req := &api.DeleteAccountRequest{
+		Secret: r.PostFormValue(pr.Secret()),
+		Code:   r.PostFormValue(pr.TotpCode()),
+	}
+	if _, err := h.c.DeleteAccount(r.Context(), req); err != nil {
+		httpError(w, err)
+		return
+	}
+
+	clearAuthCookies(w, h.pt, h.now(), h.secure)
+	http.Redirect(w, r, h.pt.Root().String(), http.StatusSeeOther)
+}
+
 // TODO: test
 func (h *userHandler) diffcaps(oldyes, oldno, newyes, newno []api.Capability_Cap) (
 	add, remove []api.Capability_Cap, e error) {
@@ -285,16 +499,31 @@ func (h *userHandler) diffcaps(oldyes, oldno, newyes, newno []api.Capability_Cap
 func init() {
 	register(func(s *server.Server) error {
 		h := userHandler{
-			c:             s.Client,
-			pt:            &paths{r: s.HTTPRoot},
-			userEditTpl:   parseTpl(ptpl.Base, ptpl.Pane, ptpl.Userpane, ptpl.UserEdit),
-			userEventsTpl: parseTpl(ptpl.Base, ptpl.Pane, ptpl.Userpane, ptpl.UserEvents),
+			c:              s.Client,
+			pt:             &paths{r: s.HTTPRoot},
+			now:            s.Now,
+			secure:         s.Secure,
+			userEditTpl:    parseTpl(ptpl.Base, ptpl.Pane, ptpl.Userpane, ptpl.UserEdit),
+			userEventsTpl:  parseTpl(ptpl.Base, ptpl.Pane, ptpl.Userpane, ptpl.UserEvents),
+			userProfileTpl: parseTpl(ptpl.Base, ptpl.Pane, ptpl.Userpane, ptpl.UserProfile),
+			oidcProviders:  oidcProviderNames(s.OidcProviders),
 		}
 
 		s.HTTPMux.Handle(h.pt.UserEdit("").Path, readWrapper(s)(http.HandlerFunc(h.static)))
 		s.HTTPMux.Handle(
 			h.pt.UserEvents("", "", false).Path, readWrapper(s)(http.HandlerFunc(h.userEvents)))
+		s.HTTPMux.Handle(
+			h.pt.UserProfile("").Path, readWrapper(s)(http.HandlerFunc(h.profile)))
+		s.HTTPMux.Handle(h.pt.UserExport().Path, readWrapper(s)(http.HandlerFunc(h.exportData)))
 		s.HTTPMux.Handle(h.pt.UpdateUserAction().Path, writeWrapper(s)(http.HandlerFunc(h.useredit)))
+		s.HTTPMux.Handle(
+			h.pt.DeleteAccountAction().Path, writeWrapper(s)(http.HandlerFunc(h.deleteAccount)))
+		s.HTTPMux.Handle(
+			h.pt.UpdateUserProfileAction().Path, writeWrapper(s)(http.HandlerFunc(h.updateProfile)))
+		s.HTTPMux.Handle(
+			h.pt.UpdateUserSecretAction().Path, writeWrapper(s)(http.HandlerFunc(h.updateSecret)))
+		s.HTTPMux.Handle(
+			h.pt.RevokeSessionAction().Path, writeWrapper(s)(http.HandlerFunc(h.revokeSession)))
 		return nil
 	})
 }
@@ -87,12 +87,17 @@ func checkCapInternal(ctx context.Context, c api.Capability_Cap) (has, certain b
 				return true, true
 			}
 		}
-		return false, true
+		if len(su.Role) == 0 {
+			return false, true
+		}
 	}
 	conf, err := globalConfig.Get(ctx)
 	if err != nil {
 		return false, true
 	}
+	if su != nil {
+		return conf.roleHasCap(su.Role, c), true
+	}
 	return conf.anoncap[c], true
 }
 
@@ -116,6 +116,35 @@ func TestHasCap(t *testing.T) {
 	}
 }
 
+func TestHasCap_Role(t *testing.T) {
+	u := &api.User{
+		Role: []string{"uploader"},
+	}
+	sur := &subjectUserResult{
+		User: u,
+		Done: make(chan struct{}),
+	}
+	close(sur.Done)
+	conf := &feConfiguration{
+		beconf: &api.BackendConfiguration{
+			Role: &api.BackendConfiguration_RoleSet{
+				Role: map[string]*api.BackendConfiguration_CapabilitySet{
+					"uploader": {
+						Capability: []api.Capability_Cap{api.Capability_PIC_CREATE},
+					},
+				},
+			},
+		},
+	}
+	ctx := ctxFromTestConfig(ctxFromSubjectUserResult(context.Background(), sur), conf)
+	if !hasCap(ctx, api.Capability_PIC_CREATE) {
+		t.Error("should be true")
+	}
+	if hasCap(ctx, api.Capability_PIC_READ) {
+		t.Error("should be false")
+	}
+}
+
 func TestCtxFromAuthToken(t *testing.T) {
 	atv := authTokenValue{Token: "hi"}
 	ctx := ctxFromAuthToken(context.Background(), atv)
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"sort"
@@ -34,6 +35,26 @@ type picComment struct {
 	XsrfToken     string
 	// CommentText is the initial comment after a failed write
 	CommentText string
+	// CanEdit and CanDelete are true if the subject user may edit or delete the comment.  The
+	// server may still refuse, such as when the edit window has passed.
+	CanEdit, CanDelete bool
+}
+
+// commentPerms finds if the subject user may edit or delete c.
+func commentPerms(ctx context.Context, c *api.PicComment) (canEdit, canDelete bool) {
+	if c.Deleted {
+		return false, false
+	}
+	if hasCap(ctx, api.Capability_PIC_COMMENT_MODERATE) {
+		return true, true
+	}
+	su := subjectUserOrNilFromCtx(ctx)
+	if su == nil || c.UserId == nil || c.UserId.Value != su.UserId {
+		return false, false
+	}
+	canEdit = hasCap(ctx, api.Capability_PIC_COMMENT_UPDATE)
+	canDelete = hasCap(ctx, api.Capability_PIC_COMMENT_DELETE)
+	return canEdit, canDelete
 }
 
 func (pc *picComment) CreatedTime() time.Time {
@@ -57,6 +78,15 @@ type viewerData struct {
 	PicTag         []*api.PicTag
 	Derived        []*api.PicFile
 	DeletionReason []viewerDataDeletionReason
+	// Favorite is true if the subject user has favorited the pic.
+	Favorite    bool
+	CanFavorite bool
+	// Collections is the subject user's own collections, which the pic may be added to.
+	Collections []*api.Collection
+	PoolNav     []*api.LookupPicDetailsResponse_PoolNav
+	CanEditPool bool
+	// CanFollow is true if the subject user may follow the tags of the pic.
+	CanFollow bool
 }
 
 var _ collate.Lister = (*picTagsSortable)(nil)
@@ -134,6 +164,15 @@ func (h *viewerHandler) static(w http.ResponseWriter, r *http.Request) {
 		}
 		pv = resp.Vote
 	}
+	var collections []*api.Collection
+	if u != nil && hasCap(ctx, api.Capability_COLLECTION_CREATE) {
+		resp, err := h.c.FindCollections(ctx, &api.FindCollectionsRequest{})
+		if err != nil {
+			httpReadError(ctx, w, err)
+			return
+		}
+		collections = resp.Collection
+	}
 	pd := newPaneData(ctx, "Viewer", h.pt)
 
 	root := &picComment{
@@ -151,7 +190,11 @@ func (h *viewerHandler) static(w http.ResponseWriter, r *http.Request) {
 			if c.UserId != nil {
 				userId = puis[c.UserId.Value].UserId
 				ident = puis[c.UserId.Value].Ident
+				if displayName := puis[c.UserId.Value].Profile.GetDisplayName(); displayName != "" {
+					ident = displayName
+				}
 			}
+			canEdit, canDelete := commentPerms(ctx, c)
 			m[c.CommentParentId] = append(m[c.CommentParentId], &picComment{
 				PicComment: c,
 				UserId:     userId,
@@ -159,6 +202,8 @@ func (h *viewerHandler) static(w http.ResponseWriter, r *http.Request) {
 				Child:      m[c.CommentId],
 				XsrfToken:  pd.XsrfToken,
 				Paths:      pd.Paths,
+				CanEdit:    canEdit,
+				CanDelete:  canDelete,
 			})
 		}
 		root.Child = m["0"]
@@ -188,12 +233,18 @@ func (h *viewerHandler) static(w http.ResponseWriter, r *http.Request) {
 	collate.New(language.English, collate.Loose).Sort(pts)
 
 	data := viewerData{
-		paneData:   pd,
-		Pic:        details.Pic,
-		Derived:    details.Derived,
-		PicComment: root,
-		PicTag:     ([]*api.PicTag)(pts),
-		PicVote:    pv,
+		paneData:    pd,
+		Pic:         details.Pic,
+		Derived:     details.Derived,
+		PicComment:  root,
+		PicTag:      ([]*api.PicTag)(pts),
+		PicVote:     pv,
+		Favorite:    details.Favorite,
+		CanFavorite: hasCap(ctx, api.Capability_PIC_FAVORITE_CREATE),
+		Collections: collections,
+		PoolNav:     details.PoolNav,
+		CanEditPool: hasCap(ctx, api.Capability_PIC_POOL_EDIT),
+		CanFollow:   hasCap(ctx, api.Capability_FOLLOW_CREATE),
 	}
 	for k, v := range api.DeletionReason_name {
 		if k == int32(api.DeletionReason_UNKNOWN) {
@@ -253,6 +304,28 @@ func (h *viewerHandler) vote(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, nextURL.String(), http.StatusSeeOther)
 }
 
+func (h *viewerHandler) favorite(w http.ResponseWriter, r *http.Request) {
+	picId := r.PostFormValue(h.pt.pr.PicId())
+	ctx := r.Context()
+
+	var err error
+	if r.PostFormValue(h.pt.pr.Favorite()) == h.pt.pr.True() {
+		_, err = h.c.AddPicFavorite(ctx, &api.AddPicFavoriteRequest{
+			PicId: picId,
+		})
+	} else {
+		_, err = h.c.RemovePicFavorite(ctx, &api.RemovePicFavoriteRequest{
+			PicId: picId,
+		})
+	}
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.pt.Viewer(picId).String(), http.StatusSeeOther)
+}
+
 // stored here until there is some sort of admin panel
 func (h *viewerHandler) softdelete(w http.ResponseWriter, r *http.Request) {
 	if r.PostFormValue(h.pt.pr.DeletePicReally()) == "" {
@@ -294,6 +367,8 @@ func init() {
 			pt: &paths{r: s.HTTPRoot},
 		}
 		s.HTTPMux.Handle(h.pt.VoteAction().Path, newActionHandler(s, http.HandlerFunc(h.vote)))
+		s.HTTPMux.Handle(
+			h.pt.FavoritePicAction().Path, newActionHandler(s, http.HandlerFunc(h.favorite)))
 		// static is initialized in root.go
 		s.HTTPMux.Handle(h.pt.SoftDeletePicAction().Path, newActionHandler(s, http.HandlerFunc(h.softdelete)))
 		return nil
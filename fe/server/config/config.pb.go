@@ -32,7 +32,16 @@ type Config struct {
 	// describes the root url to serve from.
 	HttpRoot string `protobuf:"bytes,4,opt,name=http_root,json=httpRoot,proto3" json:"http_root,omitempty"`
 	// The name to show for this site.
-	SiteName             string   `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	SiteName string `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	// OpenID Connect providers users may log in with.
+	OidcProvider []*OidcProvider `protobuf:"bytes,6,rep,name=oidc_provider,json=oidcProvider,proto3" json:"oidc_provider,omitempty"`
+	// Api key used to log in users of the oidc providers.  It must have the USER_AUTH_EXTERNAL
+	// capability.
+	OidcApiKey string `protobuf:"bytes,7,opt,name=oidc_api_key,json=oidcApiKey,proto3" json:"oidc_api_key,omitempty"`
+	// The scheme and host users reach this site at, like "https://pixur.example.com".  Required if
+	// there are oidc providers, since they redirect back to it.  The request host is not used, since
+	// it is chosen by the client.
+	ExternalUrl          string   `protobuf:"bytes,8,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -98,24 +107,152 @@ func (m *Config) GetSiteName() string {
 	return ""
 }
 
+func (m *Config) GetOidcProvider() []*OidcProvider {
+	if m != nil {
+		return m.OidcProvider
+	}
+	return nil
+}
+
+func (m *Config) GetOidcApiKey() string {
+	if m != nil {
+		return m.OidcApiKey
+	}
+	return ""
+}
+
+func (m *Config) GetExternalUrl() string {
+	if m != nil {
+		return m.ExternalUrl
+	}
+	return ""
+}
+
+// OidcProvider describes an OpenID Connect identity provider.  The authorization code flow is
+// used, so the provider must have this server's login callback as a redirect uri.
+type OidcProvider struct {
+	// Short name used in urls and shown to users, like "corp".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The issuer, which must match the "iss" claim of id tokens.
+	Issuer                string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	AuthorizationEndpoint string `protobuf:"bytes,3,opt,name=authorization_endpoint,json=authorizationEndpoint,proto3" json:"authorization_endpoint,omitempty"`
+	// Must be https, since the id token from it is trusted without checking its signature.
+	TokenEndpoint string `protobuf:"bytes,4,opt,name=token_endpoint,json=tokenEndpoint,proto3" json:"token_endpoint,omitempty"`
+	ClientId      string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string `protobuf:"bytes,6,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// Scopes to request in addition to "openid".
+	Scope                []string `protobuf:"bytes,7,rep,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OidcProvider) Reset()         { *m = OidcProvider{} }
+func (m *OidcProvider) String() string { return proto.CompactTextString(m) }
+func (*OidcProvider) ProtoMessage()    {}
+func (*OidcProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{1}
+}
+
+func (m *OidcProvider) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OidcProvider.Unmarshal(m, b)
+}
+func (m *OidcProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OidcProvider.Marshal(b, m, deterministic)
+}
+func (m *OidcProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OidcProvider.Merge(m, src)
+}
+func (m *OidcProvider) XXX_Size() int {
+	return xxx_messageInfo_OidcProvider.Size(m)
+}
+func (m *OidcProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_OidcProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OidcProvider proto.InternalMessageInfo
+
+func (m *OidcProvider) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OidcProvider) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *OidcProvider) GetAuthorizationEndpoint() string {
+	if m != nil {
+		return m.AuthorizationEndpoint
+	}
+	return ""
+}
+
+func (m *OidcProvider) GetTokenEndpoint() string {
+	if m != nil {
+		return m.TokenEndpoint
+	}
+	return ""
+}
+
+func (m *OidcProvider) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *OidcProvider) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+func (m *OidcProvider) GetScope() []string {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "pixur.fe.server.Config")
+	proto.RegisterType((*OidcProvider)(nil), "pixur.fe.server.OidcProvider")
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4) }
 
 var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
-	// 190 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0x4b,
-	0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2f, 0xc8, 0xac, 0x28, 0x2d, 0xd2,
-	0x4b, 0x4b, 0xd5, 0x2b, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0x52, 0x9a, 0xc5, 0xc8, 0xc5, 0xe6, 0x0c,
-	0x56, 0x21, 0x24, 0xcd, 0xc5, 0x99, 0x51, 0x52, 0x52, 0x10, 0x5f, 0x5c, 0x90, 0x9a, 0x2c, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x01, 0x12, 0x08, 0x2e, 0x48, 0x4d, 0x16, 0x92, 0xe5, 0xe2,
-	0x02, 0x6b, 0x85, 0xc8, 0x32, 0x81, 0x65, 0x39, 0xc1, 0x22, 0x60, 0x69, 0x29, 0x2e, 0x8e, 0xcc,
-	0xbc, 0xe2, 0xd4, 0xe4, 0xd2, 0xa2, 0x54, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x38, 0x1f,
-	0x6e, 0x6e, 0x51, 0x7e, 0x7e, 0x89, 0x04, 0x0b, 0xc2, 0xdc, 0xa0, 0xfc, 0xfc, 0x12, 0x90, 0x64,
-	0x71, 0x66, 0x49, 0x6a, 0x7c, 0x5e, 0x62, 0x6e, 0xaa, 0x04, 0x2b, 0x44, 0x12, 0x24, 0xe0, 0x97,
-	0x98, 0x9b, 0xea, 0xa4, 0x19, 0xa5, 0x0e, 0x71, 0x6f, 0x7e, 0x51, 0xba, 0x3e, 0x98, 0xa5, 0x9f,
-	0x96, 0xaa, 0x0f, 0x71, 0xb9, 0x3e, 0xc4, 0x5f, 0xd6, 0x10, 0x2a, 0x89, 0x0d, 0xec, 0x3f, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xa7, 0x6b, 0x0f, 0xef, 0x00, 0x00, 0x00,
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x51, 0x6b, 0xd4, 0x40,
+	0x14, 0x85, 0xd9, 0x6e, 0x9b, 0x6e, 0x6e, 0xb3, 0x0a, 0x83, 0x96, 0xc1, 0x52, 0x88, 0x15, 0x71,
+	0x7d, 0x49, 0x40, 0xf1, 0xc9, 0x27, 0x2b, 0x3e, 0x88, 0xa0, 0x92, 0xe2, 0x8b, 0x2f, 0x43, 0x9c,
+	0xdc, 0x6d, 0x87, 0xa6, 0x73, 0x87, 0x99, 0x49, 0x69, 0xfd, 0x3b, 0xfe, 0x41, 0x7f, 0x82, 0xe4,
+	0x4e, 0x76, 0x5d, 0x7d, 0xca, 0x9c, 0xef, 0x9c, 0xcc, 0x4d, 0x0e, 0x17, 0x0a, 0x4d, 0x76, 0x6d,
+	0x2e, 0x2b, 0xe7, 0x29, 0x92, 0x78, 0xe8, 0xcc, 0xdd, 0xe0, 0xab, 0x35, 0x56, 0x01, 0xfd, 0x2d,
+	0xfa, 0xb3, 0x5f, 0x7b, 0x90, 0xbd, 0xe7, 0x84, 0x38, 0x81, 0xfc, 0x2a, 0x46, 0xa7, 0x82, 0x43,
+	0x2d, 0x67, 0xe5, 0x6c, 0x95, 0x37, 0x8b, 0x11, 0x5c, 0x38, 0xd4, 0xe2, 0x14, 0x80, 0x5f, 0x4d,
+	0xee, 0x1e, 0xbb, 0x39, 0x13, 0xb6, 0x9f, 0xc0, 0xc2, 0xd8, 0x80, 0x7a, 0xf0, 0x28, 0xe7, 0xe5,
+	0x6c, 0xb5, 0x68, 0xb6, 0x7a, 0x7b, 0xaf, 0x27, 0x8a, 0x72, 0xff, 0xef, 0xbd, 0x0d, 0x51, 0x1c,
+	0xcd, 0x60, 0x22, 0x2a, 0xdb, 0xde, 0xa0, 0x3c, 0x48, 0xe6, 0x08, 0x3e, 0xb7, 0x37, 0x28, 0xce,
+	0x61, 0x49, 0xa6, 0xd3, 0xca, 0x79, 0xba, 0x35, 0x1d, 0x7a, 0x99, 0x95, 0xf3, 0xd5, 0xd1, 0xab,
+	0xd3, 0xea, 0xbf, 0xbf, 0xa8, 0xbe, 0x98, 0x4e, 0x7f, 0x9d, 0x42, 0x4d, 0x41, 0x3b, 0x4a, 0x94,
+	0xc0, 0x5a, 0xb5, 0xce, 0xa8, 0x6b, 0xbc, 0x97, 0x87, 0x3c, 0x03, 0x46, 0xf6, 0xce, 0x99, 0x4f,
+	0x78, 0x2f, 0x9e, 0x42, 0x81, 0x77, 0x11, 0xbd, 0x6d, 0x7b, 0x35, 0xf8, 0x5e, 0x2e, 0x38, 0x71,
+	0xb4, 0x61, 0xdf, 0x7c, 0x7f, 0xf6, 0x7b, 0x06, 0xc5, 0xee, 0x0c, 0x21, 0x60, 0x9f, 0xbf, 0x38,
+	0xd5, 0xc4, 0x67, 0x71, 0x0c, 0x99, 0x09, 0x61, 0x40, 0x3f, 0xd5, 0x33, 0x29, 0xf1, 0x06, 0x8e,
+	0xdb, 0x21, 0x5e, 0x91, 0x37, 0x3f, 0xdb, 0x68, 0xc8, 0x2a, 0xb4, 0x9d, 0x23, 0x63, 0x23, 0x37,
+	0x95, 0x37, 0x8f, 0xff, 0x71, 0x3f, 0x4c, 0xa6, 0x78, 0x0e, 0x0f, 0x22, 0x5d, 0xe3, 0x4e, 0x3c,
+	0x75, 0xb7, 0x64, 0xba, 0x8d, 0x9d, 0x40, 0xae, 0x7b, 0x83, 0x36, 0x2a, 0xd3, 0x6d, 0x0a, 0x4c,
+	0xe0, 0x63, 0x27, 0x9e, 0xc1, 0x72, 0x32, 0x03, 0x6a, 0x8f, 0x51, 0x66, 0x1c, 0x28, 0x12, 0xbc,
+	0x60, 0x26, 0x1e, 0xc1, 0x41, 0xd0, 0xe4, 0x50, 0x1e, 0x96, 0xf3, 0x55, 0xde, 0x24, 0x71, 0xfe,
+	0xf2, 0xfb, 0x8b, 0xd4, 0x32, 0xf9, 0xcb, 0x9a, 0x4f, 0xf5, 0x1a, 0xeb, 0xd4, 0x77, 0x9d, 0x76,
+	0xea, 0x6d, 0x7a, 0xfc, 0xc8, 0x78, 0xb7, 0x5e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x21, 0x24,
+	0x9a, 0x94, 0x6b, 0x02, 0x00, 0x00,
 }
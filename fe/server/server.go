@@ -4,10 +4,12 @@ package server // import "pixur.org/pixur/fe/server"
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/carl-mastrangelo/h2c"
@@ -29,6 +31,13 @@ type Server struct {
 	Secure   bool
 	HTTPRoot *url.URL
 	SiteName string
+	// OidcProviders are the OpenID Connect providers users may log in with.
+	OidcProviders []*config.OidcProvider
+	// OidcApiKey is sent when logging in users of the OidcProviders.
+	OidcApiKey string
+	// ExternalURL is the scheme and host users reach the site at.  It is set if there are
+	// OidcProviders.
+	ExternalURL *url.URL
 
 	httpSpec    string
 	lnAddr      net.Addr
@@ -56,6 +65,18 @@ func (s *Server) GetAndSetInterceptor(in grpc.UnaryClientInterceptor) grpc.Unary
 func (s *Server) Init(ctx context.Context, c *config.Config) (errCap error) {
 	s.httpSpec = c.HttpSpec
 	s.SiteName = c.SiteName
+	s.OidcProviders = c.OidcProvider
+	s.OidcApiKey = c.OidcApiKey
+	if len(c.OidcProvider) != 0 {
+		externalURL, err := parseExternalURL(c.ExternalUrl)
+		if err != nil {
+			return err
+		}
+		s.ExternalURL = externalURL
+		if err := validateOidcProviders(c.OidcProvider); err != nil {
+			return err
+		}
+	}
 	if s.Client == nil {
 		channel, err := newPixurChannel(ctx, s.interceptor, c.PixurSpec)
 		if err != nil {
@@ -99,6 +120,33 @@ func (s *Server) Init(ctx context.Context, c *config.Config) (errCap error) {
 	return nil
 }
 
+// parseExternalURL parses the scheme and host users reach the site at.
+func parseExternalURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.New("bad external url " + strconv.Quote(raw))
+	}
+	return &url.URL{Scheme: u.Scheme, Host: u.Host}, nil
+}
+
+// validateOidcProviders checks the providers can be used safely.  Id tokens are trusted because
+// they come directly from the token endpoint, so it must use TLS.
+func validateOidcProviders(providers []*config.OidcProvider) error {
+	for _, p := range providers {
+		u, err := url.Parse(p.TokenEndpoint)
+		if err != nil {
+			return err
+		}
+		if u.Scheme != "https" || u.Host == "" {
+			return errors.New("token endpoint of " + p.Name + " must be https")
+		}
+	}
+	return nil
+}
+
 func newPixurChannel(ctx context.Context, interceptor grpc.UnaryClientInterceptor, spec string) (
 	*grpc.ClientConn, error) {
 	var dos []grpc.DialOption
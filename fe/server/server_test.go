@@ -133,3 +133,46 @@ func TestWorkFlow(t *testing.T) {
 	}
 
 }
+
+func TestParseExternalURL(t *testing.T) {
+	u, err := parseExternalURL("https://pixur.example.com/ignored?q=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := u.String(), "https://pixur.example.com"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	for _, raw := range []string{"", "/foo/bar/", "ftp://pixur.example.com"} {
+		if _, err := parseExternalURL(raw); err == nil {
+			t.Error("expected error for", raw)
+		}
+	}
+}
+
+func TestValidateOidcProviders(t *testing.T) {
+	good := &config.OidcProvider{Name: "corp", TokenEndpoint: "https://corp.example.com/token"}
+	if err := validateOidcProviders([]*config.OidcProvider{good}); err != nil {
+		t.Error(err)
+	}
+	bad := &config.OidcProvider{Name: "corp", TokenEndpoint: "http://corp.example.com/token"}
+	if err := validateOidcProviders([]*config.OidcProvider{good, bad}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestInitRejectsOidcWithoutExternalURL(t *testing.T) {
+	s := new(Server)
+	c := &config.Config{
+		HttpSpec: "localhost:0",
+		OidcProvider: []*config.OidcProvider{{
+			Name:          "corp",
+			TokenEndpoint: "https://corp.example.com/token",
+		}},
+	}
+	if err := s.Init(context.Background(), c); err == nil {
+		t.Error("expected error")
+	}
+	if s.channel != nil {
+		t.Error("channel should not be opened")
+	}
+}
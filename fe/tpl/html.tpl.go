@@ -4,23 +4,43 @@
 package tpl
 
 const (
+	AuditLog = "{{- define \"panestyle\" -}}\n<style>\n  .audit-log td {\n    padding: 2px 6px;\n    vertical-align: top;\n  }\n  .audit-log .change {\n    font-family: monospace;\n    white-space: pre-wrap;\n  }\n  .nav-next {\n    float: right;\n  }\n  .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- end -}}\n{{define \"pane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>Audit Log</h2>\n  <form action=\"{{$pt.AuditLog}}\" method=\"get\">\n    <label>Actor <input type=\"text\" name=\"{{$pr.ActorUserId}}\" value=\"{{.Filter.ActorUserId}}\" /></label>\n    <label>Target User <input type=\"text\" name=\"{{$pr.TargetUserId}}\" value=\"{{.Filter.TargetUserId}}\" /></label>\n    <label>Target Pic <input type=\"text\" name=\"{{$pr.TargetPicId}}\" value=\"{{.Filter.TargetPicId}}\" /></label>\n    <label>Action\n      <select name=\"{{$pr.AuditLogAction}}\">\n        <option value=\"\">Any</option>\n        {{- $action := .Filter.Action -}}\n        {{range .Actions}}\n        <option value=\"{{.}}\"{{if eq . $action}} selected{{end}}>{{.}}</option>\n        {{end}}\n      </select>\n    </label>\n    <input type=\"submit\" value=\"Filter\" />\n  </form>\n  {{if .AuditLogs}}\n    <table class=\"audit-log\">\n    {{range .AuditLogs}}\n      <tr>\n        <td>{{.CreatedTime}}</td>\n        <td>{{.Action}}</td>\n        <td>\n          {{if .ActorUserId}}\n            by <a href=\"{{$pt.UserProfile .ActorUserId}}\">{{.ActorUserId}}</a>\n          {{else}}\n            by the server\n          {{end}}\n          {{if .TargetUserId}}\n            on user <a href=\"{{$pt.UserProfile .TargetUserId}}\">{{.TargetUserId}}</a>\n          {{end}}\n          {{if .TargetCommentId}}\n            on comment\n            <a href=\"{{$pt.ViewerComment .TargetPicId .TargetCommentId}}\">{{.TargetCommentId}}</a>\n          {{else if .TargetPicId}}\n            on pic <a href=\"{{$pt.Viewer .TargetPicId}}\">{{.TargetPicId}}</a>\n          {{end}}\n          {{if .Reason}}: {{.Reason}}{{end}}\n        </td>\n        <td class=\"change\">\n          {{- range .Change -}}\n            {{.Field}}: {{.Before}} &rarr; {{.After}}\n{{end -}}\n        </td>\n      </tr>\n    {{end}}\n    </table>\n  {{else}}\n    <p>No more entries.</p>\n  {{end}}\n  <div class=\"nav\">\n    {{if .Next}}<span class=\"nav-next\"><a href=\"{{$pt.AuditLogPage .Filter .Next}}\">Next</a></span>{{end}}\n  </div>\n</div>\n{{end}}\n"
+
 	Base = "<!doctype html>\n<html>\n  <head>\n    <meta charset=\"utf-8\">\n    <meta name=viewport content=\"width=device-width, initial-scale=1\">\n    <title>{{.Title}}</title>\n    <style>\n      body {\n        background-color: #fefff5;\n        font-family: 'Helvetica', 'Arial', sans-serif;\n        margin: 0;\n      }\n    </style>\n    {{block \"basestyle\" .}}{{end}}\n  </head>\n  <body>\n    {{template \"body\" .}}\n  </body>\n</html>\n"
 
-	Comment = "{{- define \"pane\" -}}\n{{- template \"commentreply\" . -}}\n{{- end -}}\n{{- define \"panestyle\" -}}\n{{template \"commentstyle\"}}\n{{- end -}}\n"
+	Collection = "{{- define \"userpanestyle\" -}}\n<style>\n  ul.thumbnail-list {\n    list-style-type: none;\n    padding: 0;\n  }\n  ul.thumbnail-list li {\n    display: inline-block;\n    margin: 6px;\n    text-align: center;\n    vertical-align: top;\n  }\n  ul.thumbnail-list form {\n    display: inline;\n  }\n  img.thumbnail {\n    width: 192px;\n    height: 192px;\n    border-radius: 8px;\n  }\n</style>\n{{- end -}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n{{- $collection := .Collection -}}\n{{- $isOwner := .IsOwner -}}\n{{- $xsrf := .XsrfToken -}}\n<div>\n  <h2>{{.Collection.Name}}</h2>\n  {{if .Pic}}\n  <ul class=\"thumbnail-list\">\n    {{- range .Pic -}}\n    <li>{{- /**/ -}}\n      <a href=\"{{$pt.Viewer .Pic.Id}}\">{{- /**/ -}}\n        <img {{/**/ -}}\n            class=\"thumbnail\" {{/**/ -}}\n            {{if .PlaceholderColor}}style=\"background-color: {{.PlaceholderColor}}\" {{end}}{{/**/ -}}\n            src=\"{{$pt.PicFileFirst .Thumbnail}}\" />{{- /**/ -}}\n      </a>\n      {{if $isOwner}}\n      <br>\n      <form method=\"post\" action=\"{{$pt.MoveCollectionPicAction}}\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\">\n        <input type=\"hidden\" name=\"{{$pr.CollectionId}}\" value=\"{{$collection.CollectionId}}\">\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\">\n        <input type=\"hidden\" name=\"{{$pr.CollectionMove}}\" value=\"up\">\n        <input type=\"submit\" value=\"&larr;\">\n      </form>\n      <form method=\"post\" action=\"{{$pt.RemoveCollectionPicAction}}\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\">\n        <input type=\"hidden\" name=\"{{$pr.CollectionId}}\" value=\"{{$collection.CollectionId}}\">\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\">\n        <input type=\"submit\" value=\"Remove\">\n      </form>\n      <form method=\"post\" action=\"{{$pt.MoveCollectionPicAction}}\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\">\n        <input type=\"hidden\" name=\"{{$pr.CollectionId}}\" value=\"{{$collection.CollectionId}}\">\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\">\n        <input type=\"hidden\" name=\"{{$pr.CollectionMove}}\" value=\"down\">\n        <input type=\"submit\" value=\"&rarr;\">\n      </form>\n      {{end}}\n    </li>{{- /**/ -}}\n    {{- end -}}\n  </ul>\n  {{else}}\n  <p>This collection is empty.</p>\n  {{end}}\n  {{if .IsOwner}}\n  <h2>Edit Collection</h2>\n  <form method=\"post\" action=\"{{$pt.UpdateCollectionAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <input type=\"hidden\" name=\"{{$pr.CollectionId}}\" value=\"{{.Collection.CollectionId}}\">\n    <input type=\"hidden\" name=\"{{$pr.Version}}\" value=\"{{.Collection.Version}}\">\n    <dl>\n      <dt><label for=\"{{$pr.CollectionName}}\">Name</label></dt>\n      <dd><input type=\"text\" id=\"{{$pr.CollectionName}}\" name=\"{{$pr.CollectionName}}\"\n          value=\"{{.Collection.Name}}\"></dd>\n      <dt><label for=\"{{$pr.CollectionVisibility}}\">Visibility</label></dt>\n      <dd>\n        <select id=\"{{$pr.CollectionVisibility}}\" name=\"{{$pr.CollectionVisibility}}\">\n          {{- $vis := .Collection.Visibility.String -}}\n          <option value=\"PRIVATE\"{{if eq $vis \"PRIVATE\"}} selected{{end}}>Private</option>\n          <option value=\"PUBLIC\"{{if eq $vis \"PUBLIC\"}} selected{{end}}>Public</option>\n        </select>\n      </dd>\n    </dl>\n    <input type=\"submit\" value=\"Update Collection\">\n  </form>\n  <form method=\"post\" action=\"{{$pt.DeleteCollectionAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <input type=\"hidden\" name=\"{{$pr.CollectionId}}\" value=\"{{.Collection.CollectionId}}\">\n    <input type=\"hidden\" name=\"{{$pr.Version}}\" value=\"{{.Collection.Version}}\">\n    <input type=\"submit\" value=\"Delete Collection\">\n  </form>\n  {{end}}\n</div>\n{{end}}\n"
+
+	Collections = "{{- define \"userpanestyle\" -}}\n<style>\n\n</style>\n{{- end -}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>Collections</h2>\n  {{if .Collection}}\n  <ul>\n    {{range .Collection}}\n    <li>\n      <a href=\"{{$pt.Collection .CollectionId}}\">{{.Name}}</a>\n      {{if eq .Visibility.String \"PRIVATE\"}}(private){{end}}\n    </li>\n    {{end}}\n  </ul>\n  {{else}}\n  <p>No collections.</p>\n  {{end}}\n  {{if .CanCreate}}\n  <h2>New Collection</h2>\n  <form method=\"post\" action=\"{{$pt.CreateCollectionAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <dl>\n      <dt><label for=\"{{$pr.CollectionName}}\">Name</label></dt>\n      <dd><input type=\"text\" id=\"{{$pr.CollectionName}}\" name=\"{{$pr.CollectionName}}\"></dd>\n      <dt><label for=\"{{$pr.CollectionVisibility}}\">Visibility</label></dt>\n      <dd>\n        <select id=\"{{$pr.CollectionVisibility}}\" name=\"{{$pr.CollectionVisibility}}\">\n          <option value=\"PRIVATE\">Private</option>\n          <option value=\"PUBLIC\">Public</option>\n        </select>\n      </dd>\n    </dl>\n    <input type=\"submit\" value=\"Create Collection\">\n  </form>\n  {{end}}\n</div>\n{{end}}\n"
+
+	Comment = "{{- define \"pane\" -}}\n{{- if .Edit -}}\n{{- template \"commentedit\" . -}}\n{{- else -}}\n{{- template \"commentreply\" . -}}\n{{- end -}}\n{{- end -}}\n{{- define \"panestyle\" -}}\n{{template \"commentstyle\"}}\n{{- end -}}\n\n{{define \"commentedit\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n{{template \"commenttext\" .PicComment}}\n<form action=\"{{$pt.EditPicCommentAction}}\" method=\"post\">\n  <textarea name=\"{{$pr.CommentText}}\">{{.CommentText}}</textarea>\n  <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n  <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.PicComment.PicId}}\" />\n  <input type=\"hidden\" name=\"{{$pr.CommentId}}\" value=\"{{.PicComment.CommentId}}\" />\n  <input type=\"hidden\" name=\"{{$pr.Version}}\" value=\"{{.PicComment.Version}}\" />\n  <input type=\"submit\" value=\"Save\" />\n</form>\n{{if .PicComment.Revision}}\n<h4>History</h4>\n<ol>\n  {{range .PicComment.Revision}}\n  <li>{{.Text}}</li>\n  {{end}}\n</ol>\n{{end}}\n{{end}}\n"
+
+	CommentReply = "{{define \"commentstyle\"}}\n<style>\n.comment .comment-links {\n  font-size: smaller;\n}\n.comment .comment-links a:link {\n  color: #777;\n  text-decoration: none;\n}\n.comment .comment-links a:visited {\n  color: #777;\n  text-decoration: none;\n}\n.comment .comment-links a:hover {\n  color: #777;\n  text-decoration: underline;\n}\n.comment .comment-links form {\n  display: inline;\n}\n.comment .comment-links input[type=\"submit\"] {\n  background: none;\n  border: none;\n  color: #777;\n  cursor: pointer;\n  font-size: inherit;\n  padding: 0;\n}\n</style>\n{{end}}\n\n{{define \"commentreply\" }}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n{{if .PicComment.CommentId }}\n{{template \"commenttext\" .PicComment}}\n{{end}}\n<form action=\"{{$pt.CommentReply .PicComment.PicId .PicComment.CommentId}}\" method=\"post\">\n  <textarea name=\"{{$pr.CommentText}}\">{{.CommentText}}</textarea>\n  <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n  <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.PicComment.PicId}}\" />\n  <input type=\"hidden\" name=\"{{$pr.CommentParentId}}\" value=\"{{.PicComment.CommentId}}\" />\n  <input type=\"submit\" value=\"Reply\" />\n</form>\n{{end}}\n\n{{define \"commenttext\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n<table class=\"comment\">\n  <tr>\n    <td>▲</td>\n    <td class=\"comment-links\">\n      {{if .UserId}}\n        <a href=\"{{$pt.UserProfile .UserId}}\">{{.Ident}}</a>\n      {{else}}\n        Anonymous\n      {{end}}\n      <a \n          href=\"{{$pt.ViewerComment .PicId .CommentId}}\" \n          id=\"{{($pt.ViewerComment .PicId .CommentId).Fragment}}\">\n        Some time ago\n      </a>\n    </td>\n  </tr>\n  <tr>\n    <td></td>\n    <td>{{.Text}}{{if .EditedTime}} <span class=\"comment-links\">(edited)</span>{{end}}</td>\n  </tr>\n  <tr>\n    <td></td>\n    <td class=\"comment-links\">\n      {{if not .Deleted}}<a href=\"{{$pt.CommentReply .PicId .CommentId}}\">reply</a>{{end}}\n      {{if .CanEdit}}<a href=\"{{$pt.CommentEdit .PicId .CommentId}}\">edit</a>{{end}}\n      {{if .CanDelete}}\n        <form action=\"{{$pt.DeletePicCommentAction}}\" method=\"post\">\n          <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n          <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.PicId}}\" />\n          <input type=\"hidden\" name=\"{{$pr.CommentId}}\" value=\"{{.CommentId}}\" />\n          <input type=\"hidden\" name=\"{{$pr.Version}}\" value=\"{{.Version}}\" />\n          <input type=\"submit\" value=\"delete\" />\n        </form>\n      {{end}}\n    </td>\n  </tr>\n</table>\n{{end}}\n"
+
+	Favorites = "{{- define \"userpanestyle\" -}}\n<style>\n  ul.thumbnail-list {\n    list-style-type: none;\n    padding: 0;\n  }\n  ul.thumbnail-list li {\n    display: inline;\n  }\n  img.thumbnail {\n    width: 192px;\n    height: 192px;\n    margin: 6px;\n    border-radius: 8px;\n  }\n  .nav-next {\n    float: right;\n  }\n  .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- end -}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>Favorites</h2>\n  {{if .Pic}}\n  <ul class=\"thumbnail-list\">\n    {{- range .Pic -}}\n    <li>{{- /**/ -}}\n      <a href=\"{{$pt.Viewer .Pic.Id}}\">{{- /**/ -}}\n        <img {{/**/ -}}\n            class=\"thumbnail\" {{/**/ -}}\n            {{if .PlaceholderColor}}style=\"background-color: {{.PlaceholderColor}}\" {{end}}{{/**/ -}}\n            src=\"{{$pt.PicFileFirst .Thumbnail}}\" />{{- /**/ -}}\n      </a>{{- /**/ -}}\n    </li>{{- /**/ -}}\n    {{- end -}}\n  </ul>\n  {{else}}\n  <p>No favorites yet.</p>\n  {{end}}\n  <div class=\"nav\">\n    {{if .Next}}<span class=\"nav-next\"><a href=\"{{$pt.Favorites .Next}}\">Next</a></span>{{end}}\n  </div>\n</div>\n{{end}}\n"
+
+	Feed = "{{- define \"userpanestyle\" -}}\n<style>\n  ul.thumbnail-list {\n    list-style-type: none;\n    padding: 0;\n  }\n  ul.thumbnail-list li {\n    display: inline;\n  }\n  img.thumbnail {\n    width: 192px;\n    height: 192px;\n    margin: 6px;\n    border-radius: 8px;\n  }\n  .nav-prev {\n    float: left;\n  }\n  .nav-next {\n    float: right;\n  }\n  .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n  form.unfollow {\n    display: inline;\n  }\n</style>\n{{- end -}}\n{{define \"nav\"}}\n  {{- $pt := .Paths -}}\n  <div class=\"nav\">\n    {{if .PrevID}}<span class=\"nav-prev\"><a href=\"{{$pt.FeedPrev .PrevID}}\">Previous</a></span>{{end}}\n    {{if .NextID}}<span class=\"nav-next\"><a href=\"{{$pt.Feed .NextID false}}\">Next</a></span>{{end}}\n  </div>\n{{end}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n{{- $xsrf := .XsrfToken -}}\n{{- $next := $pt.Feed \"\" false -}}\n<div>\n  <h2>Feed</h2>\n  {{- template \"nav\" . -}}\n  {{if .Pic}}\n  <ul class=\"thumbnail-list\">\n    {{- range .Pic -}}\n    <li>{{- /**/ -}}\n      <a href=\"{{$pt.Viewer .Pic.Id}}\">{{- /**/ -}}\n        <img {{/**/ -}}\n            class=\"thumbnail\" {{/**/ -}}\n            {{if .PlaceholderColor}}style=\"background-color: {{.PlaceholderColor}}\" {{end}}{{/**/ -}}\n            src=\"{{$pt.PicFileFirst .Thumbnail}}\" />{{- /**/ -}}\n      </a>{{- /**/ -}}\n    </li>{{- /**/ -}}\n    {{- end -}}\n  </ul>\n  {{else}}\n  <p>No pics here.  Follow users from their profile, or tags below or from a pic.</p>\n  {{end}}\n  {{- template \"nav\" . -}}\n  <h2>Following</h2>\n  <ul>\n    {{range .FollowedUserId}}\n    <li>\n      <a href=\"{{$pt.UserProfile .}}\">User {{.}}</a>\n      <form class=\"unfollow\" action=\"{{$pt.FollowAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$next}}\" />\n        <input type=\"hidden\" name=\"{{$pr.UserId}}\" value=\"{{.}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Follow}}\" value=\"{{$pr.False}}\" />\n        <input type=\"submit\" value=\"Unfollow\" />\n      </form>\n    </li>\n    {{end}}\n    {{range .FollowedTag}}\n    <li>\n      Tag {{.}}\n      <form class=\"unfollow\" action=\"{{$pt.FollowAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$next}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Tag}}\" value=\"{{.}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Follow}}\" value=\"{{$pr.False}}\" />\n        <input type=\"submit\" value=\"Unfollow\" />\n      </form>\n    </li>\n    {{end}}\n  </ul>\n  <form action=\"{{$pt.FollowAction}}\" method=\"post\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\" />\n    <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$next}}\" />\n    <input type=\"hidden\" name=\"{{$pr.Follow}}\" value=\"{{$pr.True}}\" />\n    <input name=\"{{$pr.Tag}}\" placeholder=\"Tag\" />\n    <input type=\"submit\" value=\"Follow Tag\" />\n  </form>\n</div>\n{{end}}\n"
+
+	Index = "{{define \"panestyle\"}}\n<style>\n  .index {\n    text-align: center;\n  }\n\n  .index ul.thumbnail-list {\n    list-style-type: none;\n    padding: 0;\n  }\n  \n  .index ul.thumbnail-list li {\n    display: inline;\n  }\n  \n  .index .thumbnail-cntr {\n    background-color: #FFFFEE;\n    border-style: solid;\n    border-width: 2px;\n    border-color: #2c1fc0;\n    border-radius: 10px;\n    display: inline-block;\n    height: 192px;\n    margin: 6px;\n    padding: 0;\n    text-align: center;\n    width: 192px;\n  }\n  \n  .index .thumbnail-cntr:hover {\n    border-color: #9c99bf;\n  }\n  \n  .index img.thumbnail {\n    width: 192px;\n    height: 192px;\n    border-radius: 8px;\n  }\n\n  .index img.deleted {\n    filter: blur(5px) grayscale(5%);\n    -webkit-filter: blur(5px) grayscale(5%);\n  }\n  \n  .index .nav-home {\n    text-align: center;\n  }\n  .index .nav-prev {\n    float: left;\n  }\n  .index .nav-next {\n    float: right;\n  }\n  .index .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- $pt := .Paths -}}\n{{if .PrevID}}<link rel=\"prev\" href=\"{{$pt.IndexPrev .PrevID}}\">{{end}}\n{{if .NextID}}<link rel=\"next\" href=\"{{$pt.Index .NextID}}\">{{end}}\n{{end}}\n{{define \"nav\"}}\n  {{- $pt := .Paths -}}\n  {{- $pr := $pt.Params -}}\n  <div class=\"nav\">\n    {{if .PrevID}}<span class=\"nav-prev\"><a href=\"{{$pt.IndexPrev .PrevID}}\">Previous</a></span>{{end}}\n    {{if .NextID}}<span class=\"nav-next\"><a href=\"{{$pt.Index .NextID}}\">Next</a></span>{{end}}\n  </div>\n{{end}}\n{{define \"pane\"}}\n<div class=\"index\">\n  {{ $pt := .Paths}}\n  {{- $pr := $pt.Params -}}\n  {{- template \"nav\" . -}}\n  {{if .Pic}}\n  <ul class=\"thumbnail-list\">\n    {{- range .Pic -}}\n    <li>{{- /**/ -}}\n      <div class=\"thumbnail-cntr\">{{- /**/ -}}\n        <a href=\"{{$pt.Viewer .Pic.Id}}\">{{- /**/ -}}\n          <img {{/**/ -}}\n\t          class=\"thumbnail{{if .Pic.PendingDeletion}} deleted{{end}}\" {{/**/ -}}\n\t          {{if .PlaceholderColor}}style=\"background-color: {{.PlaceholderColor}}\" {{end}}{{/**/ -}}\n\t          src=\"{{$pt.PicFileFirst .Thumbnail}}\" />{{- /**/ -}}\n\t      </a>{{- /**/ -}}\n      </div>{{- /**/ -}}\n    </li>{{- /**/ -}}\n    {{- end -}}\n  </ul>\n  {{end}}\n  {{- template \"nav\" . -}}\n</div>\n{{if .CanUpload}}\n<div style=\"margin-bottom: 2em; margin-top: 2em;\">\n  <fieldset>\n    <legend>Pic Upload</legend>\n    <form action=\"{{$pt.UpsertPicAction}}\" method=\"post\" enctype=\"multipart/form-data\">\n      <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n      <dl>\n        <dt style=\"display:inline-block\">File Upload (option 1)</dt>\n        <dd style=\"display:inline-block\"><input type=\"file\" name=\"{{$pr.File}}\" /></dd>\n      </dl>\n      <dl>\n        <dt style=\"display:inline-block\">URL Upload (option 2)</dt>\n        <dd style=\"display:inline-block\"><input placeholder=\"File URL\" name=\"{{$pr.Url}}\" /></dd>\n      </dl>\n      <input type=\"submit\" value=\"Submit\" />\n    </form>\n  </fieldset>\n</div>\n{{end}}\n{{end}}\n"
+
+	Login = "{{define \"panestyle\"}}\n<style>\ntable.create-login {\n  margin: auto;\n  width: 66.67%; width: calc(100%/3*2);\n  background-color: #fcfcf7;\n  border-width: 1px;\n  border-color: #eeeeee;\n  border-style: solid;\n  padding: 1em;\n}\n.create-login th {\n  text-align: left;\n  padding: 1em;\n}\n.create-login td {\n  text-align: left;\n  padding: 1em;\n}\n.create-login label div {\n  line-height: 2em;\n}\n.create-login label input {\n  padding: 0.5em;\n  margin-bottom: 1em;\n  border-radius: 0.25em;\n  border-width: 1px;\n}\n.create-login td.thin-line, .create-login th.thin-line {\n  width: 1px;\n  padding: 0px;\n  margin: 0px;\n  background-color: #eeeeee;\n}\n</style>\n{{end}}\n\n{{define \"pane\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n<table class=\"create-login\">\n  <tr>\n    <th>Create User</th>\n    <th class=\"thin-line\"></th>\n    <th>Login</th>\n  <tr>\n  <tr>\n    <td>\n      <form action=\"{{$pt.CreateUserAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div> \n          <input type=\"text\" name=\"{{$pr.Ident}}\" placeholder=\"An Example Name\" />\n        </label>\n        <label>\n          <div>Password</div>\n          <input type=\"password\" name=\"{{$pr.Secret}}\" />\n        </label>\n        <label>\n          <div>Invite Code (optional)</div>\n          <input type=\"text\" name=\"{{$pr.InviteCode}}\" value=\"{{.InviteCode}}\" autocomplete=\"off\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{.Next}}\" />\n        <div>\n          <input type=\"submit\" value=\"CreateUser\" />\n        </div>\n      </form>\n    </td>\n    <td class=\"thin-line\"></td>\n    <td>\n      <form action=\"{{$pt.LoginAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div> \n          <input type=\"text\" name=\"{{$pr.Ident}}\" placeholder=\"Your User Name\" />\n        </label>\n        <label>\n          <div>Password</div>\n          <input type=\"password\" name=\"{{$pr.Secret}}\" />\n        </label>\n        <label>\n          <div>Two-Factor Code (if enabled)</div>\n          <input type=\"text\" name=\"{{$pr.TotpCode}}\" autocomplete=\"one-time-code\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{.Next}}\" />\n        <div>\n          <input type=\"submit\" value=\"Login\" />\n        </div>\n      </form>\n      <a href=\"{{$pt.ResetSecret \"\"}}\">Forgot password?</a>\n      {{range .OidcProviders}}\n      <div><a href=\"{{$pt.OidcLogin .}}\">Log in with {{.}}</a></div>\n      {{end}}\n    </td>\n  </tr>\n</table>\n{{end}}\n"
+
+	Pane = "{{block \"basestyle\" .}}\n<style>\n  .pane {\n    margin-left: auto;\n    margin-right: auto;\n    max-width: 960px;\n  }\n  .pane-header {\n    margin-top: 6px;\n    position: relative;\n    margin-bottom: 1em;\n  }\n  .pane-header a {\n    text-decoration: none;\n  }\n  .pane-user-box {\n    background-color: white;\n    border: 1px solid black;\n    float: right;\n    padding: 4px;\n    position: absolute;\n    right: 0%;\n  }\n  .pane-header .site-name {\n    text-align: center;\n  }\n  .pane-header .site-name h1 {\n    display: inline;\n  }\n  .pane-header .site-name a:link {\n    color: #000;\n  }\n  .pane-header .site-name a:visited {\n    color: #000;\n  }\n  .pane-header .site-name a:hover {\n    color: #000;\n  }\n  .pane .error {\n    color: red;\n    margin: 1em;\n    text-align: center;\n  }\n  .pane-logged-in-user {\n    text-align: right;\n  }\n  .pane-logged-in-user .dropdown-user-menu {\n    display: none;\n  }\n  .pane-user-box:hover .dropdown-user-menu {\n    display: block;\n    margin-top: 1em;\n  } \n  .pane-user-link:hover {\n    background-color: #f7f7f7;\n  }\n</style>  \n{{block \"panestyle\" .}}{{end}}\n{{end}}\n{{define \"body\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div class=\"pane\">\n  <div class=\"pane-header\">\n    <div class=\"pane-user-box\">\n      {{if .SubjectUser}}\n        <div class=\"pane-logged-in-user\">\n          <div class=\"pane-user-link\">\n            <a href=\"{{$pt.UserEvents \"\" \"\" false }}\">{{.SubjectUser.Ident}}</a>\n          </div>\n          <div class=\"dropdown-user-menu\">\n            <hr>\n            <div><a href=\"{{$pt.Pools \"\"}}\">Pools</a></div>\n            <hr>\n            {{if .CanReadAuditLog}}\n              <div><a href=\"{{$pt.AuditLog}}\">Audit Log</a></div>\n              <hr>\n            {{end}}\n            <form action=\"{{$pt.LoginAction}}\" method=\"post\">\n              <input type=\"hidden\" name=\"{{$pr.Logout}}\" value=\"non-empty string\" />\n              <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n              <input type=\"submit\" value=\"Logout\" />\n            </form>\n          </div>\n        </div>\n      {{else}}\n        <a href=\"{{$pt.Login}}\">Login</a>\n      {{end}}\n    </div>\n    <div class=\"site-name\">\n      <a href=\"{{$pt.Root}}\"><h1>{{.SiteName}}</h1></a>\n    </div>\n  </div>\n  {{if .Err}}\n    {{- template \"error\" . -}}\n  {{end}}\n  {{template \"pane\" .}}\n</div>\n{{end}}\n{{define \"error\"}}\n<div class=\"error\">\n  <strong style=\"\">{{.Err.Error}}{{if .ErrShouldLogin}} - <a href=\"{{.Paths.Login}}\">Login</a>?{{end}}</strong>\n</div>\n{{end}}\n\n"
+
+	Pool = "{{define \"panestyle\"}}\n<style>\n  .pool .description {\n    white-space: pre-wrap;\n  }\n  .pool ul.thumbnail-list {\n    list-style-type: none;\n    padding: 0;\n  }\n  .pool ul.thumbnail-list li {\n    display: inline-block;\n    margin: 6px;\n    text-align: center;\n    vertical-align: top;\n  }\n  .pool ul.thumbnail-list form {\n    display: inline;\n  }\n  .pool img.thumbnail {\n    width: 192px;\n    height: 192px;\n    border-radius: 8px;\n  }\n</style>\n{{end}}\n{{define \"pane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n{{- $pool := .Pool -}}\n{{- $canEdit := .CanEdit -}}\n{{- $xsrf := .XsrfToken -}}\n<div class=\"pool\">\n  <h2>{{.Pool.Title}}</h2>\n  {{if .Pool.Description}}<p class=\"description\">{{.Pool.Description}}</p>{{end}}\n  {{if .Pic}}\n  <ul class=\"thumbnail-list\">\n    {{- range .Pic -}}\n    <li>{{- /**/ -}}\n      <a href=\"{{$pt.Viewer .Pic.Id}}\">{{- /**/ -}}\n        <img {{/**/ -}}\n            class=\"thumbnail\" {{/**/ -}}\n            {{if .PlaceholderColor}}style=\"background-color: {{.PlaceholderColor}}\" {{end}}{{/**/ -}}\n            src=\"{{$pt.PicFileFirst .Thumbnail}}\" />{{- /**/ -}}\n      </a>\n      {{if $canEdit}}\n      <br>\n      {{if .CanMoveUp}}\n      <form method=\"post\" action=\"{{$pt.MovePoolPicAction}}\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\">\n        <input type=\"hidden\" name=\"{{$pr.PoolId}}\" value=\"{{$pool.PoolId}}\">\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\">\n        <input type=\"hidden\" name=\"{{$pr.BeforePicId}}\" value=\"{{.UpBeforePicId}}\">\n        <input type=\"submit\" value=\"&larr;\">\n      </form>\n      {{end}}\n      <form method=\"post\" action=\"{{$pt.RemovePoolPicAction}}\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\">\n        <input type=\"hidden\" name=\"{{$pr.PoolId}}\" value=\"{{$pool.PoolId}}\">\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\">\n        <input type=\"submit\" value=\"Remove\">\n      </form>\n      {{if .CanMoveDown}}\n      <form method=\"post\" action=\"{{$pt.MovePoolPicAction}}\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\">\n        <input type=\"hidden\" name=\"{{$pr.PoolId}}\" value=\"{{$pool.PoolId}}\">\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\">\n        <input type=\"hidden\" name=\"{{$pr.BeforePicId}}\" value=\"{{.DownBeforePicId}}\">\n        <input type=\"submit\" value=\"&rarr;\">\n      </form>\n      {{end}}\n      {{end}}\n    </li>{{- /**/ -}}\n    {{- end -}}\n  </ul>\n  {{else}}\n  <p>This pool is empty.</p>\n  {{end}}\n  {{if .CanEdit}}\n  <p>Pool Id: {{.Pool.PoolId}}</p>\n  {{end}}\n</div>\n{{end}}\n"
 
-	CommentReply = "{{define \"commentstyle\"}}\n<style>\n.comment .comment-links {\n  font-size: smaller;\n}\n.comment .comment-links a:link {\n  color: #777;\n  text-decoration: none;\n}\n.comment .comment-links a:visited {\n  color: #777;\n  text-decoration: none;\n}\n.comment .comment-links a:hover {\n  color: #777;\n  text-decoration: underline;\n}\n</style>\n{{end}}\n\n{{define \"commentreply\" }}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n{{if .PicComment.CommentId }}\n{{template \"commenttext\" .PicComment}}\n{{end}}\n<form action=\"{{$pt.CommentReply .PicComment.PicId .PicComment.CommentId}}\" method=\"post\">\n  <textarea name=\"{{$pr.CommentText}}\">{{.CommentText}}</textarea>\n  <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n  <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.PicComment.PicId}}\" />\n  <input type=\"hidden\" name=\"{{$pr.CommentParentId}}\" value=\"{{.PicComment.CommentId}}\" />\n  <input type=\"submit\" value=\"Reply\" />\n</form>\n{{end}}\n\n{{define \"commenttext\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n<table class=\"comment\">\n  <tr>\n    <td>▲</td>\n    <td class=\"comment-links\">\n      {{if .UserId}}\n        <a href=\"{{$pt.UserEvents .UserId \"\" false}}\">{{.Ident}}</a>\n      {{else}}\n        Anonymous\n      {{end}}\n      <a \n          href=\"{{$pt.ViewerComment .PicId .CommentId}}\" \n          id=\"{{($pt.ViewerComment .PicId .CommentId).Fragment}}\">\n        Some time ago\n      </a>\n    </td>\n  </tr>\n  <tr>\n    <td></td>\n    <td>{{.Text}}</td>\n  </tr>\n  <tr>\n    <td></td>\n    <td class=\"comment-links\"><a href=\"{{$pt.CommentReply .PicId .CommentId}}\">reply</a></td>\n  </tr>\n</table>\n{{end}}\n"
+	Pools = "{{define \"panestyle\"}}\n<style>\n  .pools .nav-next {\n    float: right;\n  }\n  .pools .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{end}}\n{{define \"pane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div class=\"pools\">\n  <h2>Pools</h2>\n  {{if .Pool}}\n  <ul>\n    {{range .Pool}}\n    <li><a href=\"{{$pt.Pool .PoolId}}\">{{.Title}}</a></li>\n    {{end}}\n  </ul>\n  {{else}}\n  <p>No pools.</p>\n  {{end}}\n  <div class=\"nav\">\n    {{if .Next}}<span class=\"nav-next\"><a href=\"{{$pt.Pools .Next}}\">Next</a></span>{{end}}\n  </div>\n  {{if .CanEdit}}\n  <h2>New Pool</h2>\n  <form method=\"post\" action=\"{{$pt.CreatePoolAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <dl>\n      <dt><label for=\"{{$pr.PoolTitle}}\">Title</label></dt>\n      <dd><input type=\"text\" id=\"{{$pr.PoolTitle}}\" name=\"{{$pr.PoolTitle}}\"></dd>\n      <dt><label for=\"{{$pr.PoolDescription}}\">Description</label></dt>\n      <dd><textarea id=\"{{$pr.PoolDescription}}\" name=\"{{$pr.PoolDescription}}\"></textarea></dd>\n    </dl>\n    <input type=\"submit\" value=\"Create Pool\">\n  </form>\n  {{end}}\n</div>\n{{end}}\n"
 
-	Index = "{{define \"panestyle\"}}\n<style>\n  .index {\n    text-align: center;\n  }\n\n  .index ul.thumbnail-list {\n    list-style-type: none;\n    padding: 0;\n  }\n  \n  .index ul.thumbnail-list li {\n    display: inline;\n  }\n  \n  .index .thumbnail-cntr {\n    background-color: #FFFFEE;\n    border-style: solid;\n    border-width: 2px;\n    border-color: #2c1fc0;\n    border-radius: 10px;\n    display: inline-block;\n    height: 192px;\n    margin: 6px;\n    padding: 0;\n    text-align: center;\n    width: 192px;\n  }\n  \n  .index .thumbnail-cntr:hover {\n    border-color: #9c99bf;\n  }\n  \n  .index img.thumbnail {\n    width: 192px;\n    height: 192px;\n    border-radius: 8px;\n  }\n\n  .index img.deleted {\n    filter: blur(5px) grayscale(5%);\n    -webkit-filter: blur(5px) grayscale(5%);\n  }\n  \n  .index .nav-home {\n    text-align: center;\n  }\n  .index .nav-prev {\n    float: left;\n  }\n  .index .nav-next {\n    float: right;\n  }\n  .index .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- $pt := .Paths -}}\n{{if .PrevID}}<link rel=\"prev\" href=\"{{$pt.IndexPrev .PrevID}}\">{{end}}\n{{if .NextID}}<link rel=\"next\" href=\"{{$pt.Index .NextID}}\">{{end}}\n{{end}}\n{{define \"nav\"}}\n  {{- $pt := .Paths -}}\n  {{- $pr := $pt.Params -}}\n  <div class=\"nav\">\n    {{if .PrevID}}<span class=\"nav-prev\"><a href=\"{{$pt.IndexPrev .PrevID}}\">Previous</a></span>{{end}}\n    {{if .NextID}}<span class=\"nav-next\"><a href=\"{{$pt.Index .NextID}}\">Next</a></span>{{end}}\n  </div>\n{{end}}\n{{define \"pane\"}}\n<div class=\"index\">\n  {{ $pt := .Paths}}\n  {{- $pr := $pt.Params -}}\n  {{- template \"nav\" . -}}\n  {{if .Pic}}\n  <ul class=\"thumbnail-list\">\n    {{- range .Pic -}}\n    <li>{{- /**/ -}}\n      <div class=\"thumbnail-cntr\">{{- /**/ -}}\n        <a href=\"{{$pt.Viewer .Pic.Id}}\">{{- /**/ -}}\n          <img {{/**/ -}}\n\t          class=\"thumbnail{{if .Pic.PendingDeletion}} deleted{{end}}\" {{/**/ -}}\n\t          src=\"{{$pt.PicFileFirst .Thumbnail}}\" />{{- /**/ -}}\n\t      </a>{{- /**/ -}}\n      </div>{{- /**/ -}}\n    </li>{{- /**/ -}}\n    {{- end -}}\n  </ul>\n  {{end}}\n  {{- template \"nav\" . -}}\n</div>\n{{if .CanUpload}}\n<div style=\"margin-bottom: 2em; margin-top: 2em;\">\n  <fieldset>\n    <legend>Pic Upload</legend>\n    <form action=\"{{$pt.UpsertPicAction}}\" method=\"post\" enctype=\"multipart/form-data\">\n      <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n      <dl>\n        <dt style=\"display:inline-block\">File Upload (option 1)</dt>\n        <dd style=\"display:inline-block\"><input type=\"file\" name=\"{{$pr.File}}\" /></dd>\n      </dl>\n      <dl>\n        <dt style=\"display:inline-block\">URL Upload (option 2)</dt>\n        <dd style=\"display:inline-block\"><input placeholder=\"File URL\" name=\"{{$pr.Url}}\" /></dd>\n      </dl>\n      <input type=\"submit\" value=\"Submit\" />\n    </form>\n  </fieldset>\n</div>\n{{end}}\n{{end}}\n"
+	ResetSecret = "{{define \"panestyle\"}}\n<style>\ntable.reset-secret {\n  margin: auto;\n  width: 66.67%; width: calc(100%/3*2);\n  background-color: #fcfcf7;\n  border-width: 1px;\n  border-color: #eeeeee;\n  border-style: solid;\n  padding: 1em;\n}\n.reset-secret th {\n  text-align: left;\n  padding: 1em;\n}\n.reset-secret td {\n  text-align: left;\n  padding: 1em;\n}\n.reset-secret label div {\n  line-height: 2em;\n}\n.reset-secret label input {\n  padding: 0.5em;\n  margin-bottom: 1em;\n  border-radius: 0.25em;\n  border-width: 1px;\n}\n.reset-secret td.thin-line, .reset-secret th.thin-line {\n  width: 1px;\n  padding: 0px;\n  margin: 0px;\n  background-color: #eeeeee;\n}\n</style>\n{{end}}\n\n{{define \"pane\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n<table class=\"reset-secret\">\n  <tr>\n    <th>Send Reset Code</th>\n    <th class=\"thin-line\"></th>\n    <th>Set New Password</th>\n  <tr>\n  <tr>\n    <td>\n      <form action=\"{{$pt.StartUserSecretResetAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div>\n          <input type=\"text\" name=\"{{$pr.Ident}}\" value=\"{{.Ident}}\" placeholder=\"Your User Name\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <div>\n          <input type=\"submit\" value=\"Send Code\" />\n        </div>\n      </form>\n    </td>\n    <td class=\"thin-line\"></td>\n    <td>\n      {{if .Sent}}\n        <p>If the user exists, a reset code has been sent.</p>\n      {{end}}\n      <form action=\"{{$pt.FinishUserSecretResetAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div>\n          <input type=\"text\" name=\"{{$pr.Ident}}\" value=\"{{.Ident}}\" placeholder=\"Your User Name\" />\n        </label>\n        <label>\n          <div>Reset Code</div>\n          <input type=\"text\" name=\"{{$pr.ResetToken}}\" autocomplete=\"off\" />\n        </label>\n        <label>\n          <div>New Password</div>\n          <input type=\"password\" name=\"{{$pr.NewSecret}}\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <div>\n          <input type=\"submit\" value=\"Set Password\" />\n        </div>\n      </form>\n    </td>\n  </tr>\n</table>\n{{end}}\n"
 
-	Login = "{{define \"panestyle\"}}\n<style>\ntable.create-login {\n  margin: auto;\n  width: 66.67%; width: calc(100%/3*2);\n  background-color: #fcfcf7;\n  border-width: 1px;\n  border-color: #eeeeee;\n  border-style: solid;\n  padding: 1em;\n}\n.create-login th {\n  text-align: left;\n  padding: 1em;\n}\n.create-login td {\n  text-align: left;\n  padding: 1em;\n}\n.create-login label div {\n  line-height: 2em;\n}\n.create-login label input {\n  padding: 0.5em;\n  margin-bottom: 1em;\n  border-radius: 0.25em;\n  border-width: 1px;\n}\n.create-login td.thin-line, .create-login th.thin-line {\n  width: 1px;\n  padding: 0px;\n  margin: 0px;\n  background-color: #eeeeee;\n}\n</style>\n{{end}}\n\n{{define \"pane\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n<table class=\"create-login\">\n  <tr>\n    <th>Create User</th>\n    <th class=\"thin-line\"></th>\n    <th>Login</th>\n  <tr>\n  <tr>\n    <td>\n      <form action=\"{{$pt.CreateUserAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div> \n          <input type=\"text\" name=\"{{$pr.Ident}}\" placeholder=\"An Example Name\" />\n        </label>\n        <label>\n          <div>Password</div>\n          <input type=\"password\" name=\"{{$pr.Secret}}\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{.Next}}\" />\n        <div>\n          <input type=\"submit\" value=\"CreateUser\" />\n        </div>\n      </form>\n    </td>\n    <td class=\"thin-line\"></td>\n    <td>\n      <form action=\"{{$pt.LoginAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div> \n          <input type=\"text\" name=\"{{$pr.Ident}}\" placeholder=\"Your User Name\" />\n        </label>\n        <label>\n          <div>Password</div>\n          <input type=\"password\" name=\"{{$pr.Secret}}\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{.Next}}\" />\n        <div>\n          <input type=\"submit\" value=\"Login\" />\n        </div>\n      </form>\n    </td>\n  </tr>\n</table>\n{{end}}\n"
+	Totp = "{{define \"panestyle\"}}\n<style>\ndiv.totp {\n  margin: auto;\n  width: 66.67%; width: calc(100%/3*2);\n  background-color: #fcfcf7;\n  border-width: 1px;\n  border-color: #eeeeee;\n  border-style: solid;\n  padding: 1em;\n}\n.totp code {\n  font-size: 1.25em;\n  word-break: break-all;\n}\n.totp label div {\n  line-height: 2em;\n}\n.totp label input {\n  padding: 0.5em;\n  margin-bottom: 1em;\n  border-radius: 0.25em;\n  border-width: 1px;\n}\n</style>\n{{end}}\n\n{{define \"pane\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n<div class=\"totp\">\n  <h2>Two-Factor Authentication</h2>\n  {{if .RecoveryCode}}\n    <p>\n      Two-factor authentication is enabled.  Save these recovery codes somewhere safe.  Each can\n      be used once in place of a code if you lose your authenticator.  They will not be shown\n      again.\n    </p>\n    <ul>\n      {{range .RecoveryCode}}\n      <li><code>{{.}}</code></li>\n      {{end}}\n    </ul>\n    <a href=\"{{$pt.UserEdit \"\"}}\">Done</a>\n  {{else}}\n    <p>Add this key to your authenticator app, then enter the code it shows.</p>\n    <p><code>{{.Secret}}</code></p>\n    <p><a href=\"{{.KeyUri}}\">{{.KeyUri}}</a></p>\n    <form action=\"{{$pt.FinishTotpEnrollmentAction}}\" method=\"post\">\n      <label>\n        <div>Code</div>\n        <input type=\"text\" name=\"{{$pr.TotpCode}}\" autocomplete=\"one-time-code\" />\n      </label>\n      <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n      <div>\n        <input type=\"submit\" value=\"Enable\" />\n      </div>\n    </form>\n  {{end}}\n</div>\n{{end}}\n"
 
-	Pane = "{{block \"basestyle\" .}}\n<style>\n  .pane {\n    margin-left: auto;\n    margin-right: auto;\n    max-width: 960px;\n  }\n  .pane-header {\n    margin-top: 6px;\n    position: relative;\n    margin-bottom: 1em;\n  }\n  .pane-header a {\n    text-decoration: none;\n  }\n  .pane-user-box {\n    background-color: white;\n    border: 1px solid black;\n    float: right;\n    padding: 4px;\n    position: absolute;\n    right: 0%;\n  }\n  .pane-header .site-name {\n    text-align: center;\n  }\n  .pane-header .site-name h1 {\n    display: inline;\n  }\n  .pane-header .site-name a:link {\n    color: #000;\n  }\n  .pane-header .site-name a:visited {\n    color: #000;\n  }\n  .pane-header .site-name a:hover {\n    color: #000;\n  }\n  .pane .error {\n    color: red;\n    margin: 1em;\n    text-align: center;\n  }\n  .pane-logged-in-user {\n    text-align: right;\n  }\n  .pane-logged-in-user .dropdown-user-menu {\n    display: none;\n  }\n  .pane-user-box:hover .dropdown-user-menu {\n    display: block;\n    margin-top: 1em;\n  } \n  .pane-user-link:hover {\n    background-color: #f7f7f7;\n  }\n</style>  \n{{block \"panestyle\" .}}{{end}}\n{{end}}\n{{define \"body\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div class=\"pane\">\n  <div class=\"pane-header\">\n    <div class=\"pane-user-box\">\n      {{if .SubjectUser}}\n        <div class=\"pane-logged-in-user\">\n          <div class=\"pane-user-link\">\n            <a href=\"{{$pt.UserEvents \"\" \"\" false }}\">{{.SubjectUser.Ident}}</a>\n          </div>\n          <div class=\"dropdown-user-menu\">\n            <hr>\n            <form action=\"{{$pt.LoginAction}}\" method=\"post\">\n              <input type=\"hidden\" name=\"{{$pr.Logout}}\" value=\"non-empty string\" />\n              <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n              <input type=\"submit\" value=\"Logout\" />\n            </form>\n          </div>\n        </div>\n      {{else}}\n        <a href=\"{{$pt.Login}}\">Login</a>\n      {{end}}\n    </div>\n    <div class=\"site-name\">\n      <a href=\"{{$pt.Root}}\"><h1>{{.SiteName}}</h1></a>\n    </div>\n  </div>\n  {{if .Err}}\n    {{- template \"error\" . -}}\n  {{end}}\n  {{template \"pane\" .}}\n</div>\n{{end}}\n{{define \"error\"}}\n<div class=\"error\">\n  <strong style=\"\">{{.Err.Error}}{{if .ErrShouldLogin}} - <a href=\"{{.Paths.Login}}\">Login</a>?{{end}}</strong>\n</div>\n{{end}}\n\n"
+	UserEdit = "{{- define \"userpanestyle\" -}}\n<style>\n\n</style>\n{{- end -}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>User Edit</h2>\n  <form method=\"post\" action=\"{{$pt.UpdateUserAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <input type=\"hidden\" name=\"{{$pr.UserId}}\" value=\"{{.ObjectUser.UserId}}\">\n    <input type=\"hidden\" name=\"{{$pr.Version}}\" value=\"{{.ObjectUser.Version}}\">\n  <dl>\n    <dt>Ident</dt>\n    <dd>{{ .ObjectUser.Ident }}</dd>\n    <dt>Created</dt>\n    <dd>{{ .ObjectUser.CreatedTime }}</dd>\n    {{if .ObjectUser.Role}}\n    <dt>Roles</dt>\n    <dd>{{range $i, $r := .ObjectUser.Role}}{{if $i}}, {{end}}{{$r}}{{end}}</dd>\n    {{end}}\n    <fieldset>\n      <legend>Capabilities</legend>\n      <div style=\"display: table\">\n      {{- $canedit := .CanEditCap -}}\n      {{ range .Cap }}\n        <input \n            type=\"hidden\" \n            name=\"{{.Cap | $pr.OldUserCapability}}\" \n            value=\"{{if .Has}}{{$pr.True}}{{else}}{{$pr.False}}{{end}}\"\n            {{if not $canedit}}disabled{{end}}>\n        <div style=\"display:table-row\">\n          <div style=\"display:table-cell\">{{.Cap}}</div>\n          <div style=\"display:table-cell\">\n            <label for=\"{{.Cap | $pr.NewUserCapability}}-yes\">Yes<label>\n            <input \n                type=\"radio\"\n                id=\"{{.Cap | $pr.NewUserCapability}}-yes\" \n                name=\"{{.Cap | $pr.NewUserCapability}}\" \n                value=\"{{$pr.True}}\"\n                {{if not $canedit}}disabled{{end}}\n                {{if .Has}}checked{{end}}>\n          </div>\n          <div style=\"display:table-cell\">\n            <label for=\"{{.Cap | $pr.NewUserCapability}}-no\">No<label>\n              <input \n                  type=\"radio\"\n                  id=\"{{.Cap | $pr.NewUserCapability}}-no\" \n                  name=\"{{.Cap | $pr.NewUserCapability}}\" \n                  value=\"{{$pr.False}}\"\n                  {{if not $canedit}}disabled{{end}}\n                  {{if not .Has}}checked{{end}}>\n          </div>\n        </div>\n      {{ end }}\n      </div>\n    </fieldset>\n  </dl>\n  <input type=\"submit\" {{if not $canedit}}disabled{{end}}>\n  </form>\n  {{if .CanEditProfile}}\n  <h2>Profile</h2>\n  <form method=\"post\" action=\"{{$pt.UpdateUserProfileAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <input type=\"hidden\" name=\"{{$pr.UserId}}\" value=\"{{.ObjectUser.UserId}}\">\n    <input type=\"hidden\" name=\"{{$pr.Version}}\" value=\"{{.ObjectUser.Version}}\">\n    <dl>\n      <dt><label for=\"{{$pr.DisplayName}}\">Display Name</label></dt>\n      <dd><input type=\"text\" id=\"{{$pr.DisplayName}}\" name=\"{{$pr.DisplayName}}\"\n          value=\"{{.ObjectUser.Profile.GetDisplayName}}\"></dd>\n      <dt><label for=\"{{$pr.Bio}}\">Bio</label></dt>\n      <dd><textarea id=\"{{$pr.Bio}}\" name=\"{{$pr.Bio}}\">{{.ObjectUser.Profile.GetBio}}</textarea></dd>\n      <dt><label for=\"{{$pr.AvatarPicId}}\">Avatar Pic Id</label></dt>\n      <dd><input type=\"text\" id=\"{{$pr.AvatarPicId}}\" name=\"{{$pr.AvatarPicId}}\"\n          value=\"{{.ObjectUser.Profile.GetAvatarPicId}}\"></dd>\n    </dl>\n    <input type=\"submit\" value=\"Update Profile\">\n  </form>\n  {{end}}\n  {{if .IsSelf}}\n  <h2>Change Password</h2>\n  <form method=\"post\" action=\"{{$pt.UpdateUserSecretAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <dl>\n      <dt><label for=\"{{$pr.Secret}}\">Current Password</label></dt>\n      <dd><input type=\"password\" id=\"{{$pr.Secret}}\" name=\"{{$pr.Secret}}\"></dd>\n      <dt><label for=\"{{$pr.NewSecret}}\">New Password</label></dt>\n      <dd><input type=\"password\" id=\"{{$pr.NewSecret}}\" name=\"{{$pr.NewSecret}}\"></dd>\n    </dl>\n    <input type=\"submit\" value=\"Change Password\">\n  </form>\n  <h2>Two-Factor Authentication</h2>\n  {{if .ObjectUser.TotpEnrolled}}\n  <form method=\"post\" action=\"{{$pt.DisableTotpAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <dl>\n      <dt><label for=\"disable-{{$pr.Secret}}\">Current Password</label></dt>\n      <dd><input type=\"password\" id=\"disable-{{$pr.Secret}}\" name=\"{{$pr.Secret}}\"></dd>\n      <dt><label for=\"disable-{{$pr.TotpCode}}\">Two-Factor or Recovery Code</label></dt>\n      <dd><input type=\"text\" id=\"disable-{{$pr.TotpCode}}\" name=\"{{$pr.TotpCode}}\" autocomplete=\"off\"></dd>\n    </dl>\n    <input type=\"submit\" value=\"Disable Two-Factor Authentication\">\n  </form>\n  {{else}}\n  <form method=\"post\" action=\"{{$pt.StartTotpEnrollmentAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <dl>\n      <dt><label for=\"enable-{{$pr.Secret}}\">Current Password</label></dt>\n      <dd><input type=\"password\" id=\"enable-{{$pr.Secret}}\" name=\"{{$pr.Secret}}\"></dd>\n    </dl>\n    <input type=\"submit\" value=\"Enable Two-Factor Authentication\">\n  </form>\n  {{end}}\n  {{if .OidcProviders}}\n  <h2>Linked Logins</h2>\n  <p>Link an account from another site, so you can log in with it instead of your password.</p>\n  <ul>\n    {{range .OidcProviders}}\n    <li><a href=\"{{$pt.OidcLogin .}}\">Link {{.}}</a></li>\n    {{end}}\n  </ul>\n  {{end}}\n  <h2>Sessions</h2>\n  <table>\n    <tr>\n      <th>Last Seen</th>\n      <th>Created</th>\n      <th>Address</th>\n      <th>Browser</th>\n      <th></th>\n    </tr>\n    {{range .Session}}\n    <tr>\n      <td>{{.LastSeenTime}}</td>\n      <td>{{.CreatedTime}}</td>\n      <td>{{.RemoteAddr}}</td>\n      <td>{{.UserAgent}}</td>\n      <td>\n        {{if .Current}}\n        This session\n        {{else}}\n        <form method=\"post\" action=\"{{$pt.RevokeSessionAction}}\">\n          <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$.XsrfToken}}\">\n          <input type=\"hidden\" name=\"{{$pr.SessionId}}\" value=\"{{.SessionId}}\">\n          <input type=\"submit\" value=\"Log Out\">\n        </form>\n        {{end}}\n      </td>\n    </tr>\n    {{end}}\n  </table>\n  <form method=\"post\" action=\"{{$pt.RevokeSessionAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <input type=\"hidden\" name=\"{{$pr.AllSessions}}\" value=\"{{$pr.True}}\">\n    <input type=\"submit\" value=\"Log Out Everywhere\">\n  </form>\n  <h2>Your Data</h2>\n  <p><a href=\"{{$pt.UserExport}}\">Export My Data</a></p>\n  <form method=\"post\" action=\"{{$pt.DeleteAccountAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <p>\n      Deleting your account can't be undone.  Your uploads, tags, comments, and votes are kept,\n      but are no longer linked to you.\n    </p>\n    <dl>\n      <dt><label for=\"delete-{{$pr.Secret}}\">Current Password</label></dt>\n      <dd><input type=\"password\" id=\"delete-{{$pr.Secret}}\" name=\"{{$pr.Secret}}\"></dd>\n      {{if .ObjectUser.TotpEnrolled}}\n      <dt><label for=\"delete-{{$pr.TotpCode}}\">Two-Factor or Recovery Code</label></dt>\n      <dd><input type=\"text\" id=\"delete-{{$pr.TotpCode}}\" name=\"{{$pr.TotpCode}}\" autocomplete=\"off\"></dd>\n      {{end}}\n    </dl>\n    <input type=\"submit\" value=\"Delete Account\">\n  </form>\n  {{end}}\n</div>\n{{end}}\n"
 
-	UserEdit = "{{- define \"userpanestyle\" -}}\n<style>\n\n</style>\n{{- end -}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>User Edit</h2>\n  <form method=\"post\" action=\"{{$pt.UpdateUserAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <input type=\"hidden\" name=\"{{$pr.UserId}}\" value=\"{{.ObjectUser.UserId}}\">\n    <input type=\"hidden\" name=\"{{$pr.Version}}\" value=\"{{.ObjectUser.Version}}\">\n  <dl>\n    <dt>Ident</dt>\n    <dd>{{ .ObjectUser.Ident }}</dd>\n    <dt>Created</dt>\n    <dd>{{ .ObjectUser.CreatedTime }}</dd>\n    <fieldset>\n      <legend>Capabilities</legend>\n      <div style=\"display: table\">\n      {{- $canedit := .CanEditCap -}}\n      {{ range .Cap }}\n        <input \n            type=\"hidden\" \n            name=\"{{.Cap | $pr.OldUserCapability}}\" \n            value=\"{{if .Has}}{{$pr.True}}{{else}}{{$pr.False}}{{end}}\"\n            {{if not $canedit}}disabled{{end}}>\n        <div style=\"display:table-row\">\n          <div style=\"display:table-cell\">{{.Cap}}</div>\n          <div style=\"display:table-cell\">\n            <label for=\"{{.Cap | $pr.NewUserCapability}}-yes\">Yes<label>\n            <input \n                type=\"radio\"\n                id=\"{{.Cap | $pr.NewUserCapability}}-yes\" \n                name=\"{{.Cap | $pr.NewUserCapability}}\" \n                value=\"{{$pr.True}}\"\n                {{if not $canedit}}disabled{{end}}\n                {{if .Has}}checked{{end}}>\n          </div>\n          <div style=\"display:table-cell\">\n            <label for=\"{{.Cap | $pr.NewUserCapability}}-no\">No<label>\n              <input \n                  type=\"radio\"\n                  id=\"{{.Cap | $pr.NewUserCapability}}-no\" \n                  name=\"{{.Cap | $pr.NewUserCapability}}\" \n                  value=\"{{$pr.False}}\"\n                  {{if not $canedit}}disabled{{end}}\n                  {{if not .Has}}checked{{end}}>\n          </div>\n        </div>\n      {{ end }}\n      </div>\n    </fieldset>\n  </dl>\n  <input type=\"submit\" {{if not $canedit}}disabled{{end}}>\n  </form>\n</div>\n{{end}}\n"
+	UserEvents = "{{- define \"userpanestyle\" -}}\n<style>\n  .nav-prev {\n    float: left;\n  }\n  .nav-next {\n    float: right;\n  }\n  .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- end -}}\n{{define \"nav\"}}\n  {{- $pt := .Paths -}}\n  {{- $pr := $pt.Params -}}\n  <div class=\"nav\">\n    {{if .Prev}}<span class=\"nav-prev\"><a href=\"{{$pt.UserEvents .ObjectUserId .Prev true}}\">Previous</a></span>{{end}}\n    {{if .Next}}<span class=\"nav-next\"><a href=\"{{$pt.UserEvents .ObjectUserId .Next false}}\">Next</a></span>{{end}}\n  </div>\n{{end}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>Recent Activity</h2>\n  {{- template \"nav\" . -}}\n  {{if .UserEvents}}\n    <table>\n    {{range .UserEvents}}\n      <tr>\n        <td>{{.CreatedTime}}</td>\n        <td>\n          {{$evt := .GetOutgoingUpsertPicVote}}{{if $evt}}\n            This user upvoted <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetIncomingUpsertPicVote}}{{if $evt}}\n            User {{$evt.SubjectUserId}} upvoted \n            <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetOutgoingPicComment}}{{if $evt}}\n            This user commented on \n            <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentId}}\">{{$evt.CommentId}}</a>\n          {{end}}\n          {{$evt := .GetIncomingPicComment}}{{if $evt}}\n            Some user commented on \n            {{if $evt.CommentParentId}}\n              <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentParentId}}\"\n                  >{{$evt.CommentParentId}}</a>\n            {{else}}\n              <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentId}}\">{{$evt.PicId}}</a>\n            {{end}}\n          {{end}}\n          {{$evt := .GetUpsertPic}}{{if $evt}}\n            This user uploaded pic <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetLoginLockout}}{{if $evt}}\n            Logins were locked until {{$evt.LockedUntilTime}} after too many failed attempts\n            {{- if $evt.RemoteAddr}}, most recently from {{$evt.RemoteAddr}}{{end}}\n          {{end}}\n          {{$evt := .GetSuspend}}{{if $evt}}\n            This user was suspended\n            {{- if $evt.EndTime}} until {{$evt.EndTime}}{{end}}: {{$evt.Reason}}\n          {{end}}\n          {{if .GetUnsuspend}}\n            This user's suspension was lifted\n          {{end}}\n          {{$evt := .GetIncomingFollow}}{{if $evt}}\n            {{if $evt.SubjectUserId}}\n              User <a href=\"{{$pt.UserProfile $evt.SubjectUserId}}\">{{$evt.SubjectUserId}}</a>\n            {{else}}\n              Some user\n            {{end}}\n            started following this user\n          {{end}}\n        </td>\n      </tr>\n    {{end}}\n    </table>\n  {{else}}\n    <p>No more events.</p>\n  {{end}}\n  {{- template \"nav\" . -}}\n</div>\n{{end}}\n"
 
-	UserEvents = "{{- define \"userpanestyle\" -}}\n<style>\n  .nav-prev {\n    float: left;\n  }\n  .nav-next {\n    float: right;\n  }\n  .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- end -}}\n{{define \"nav\"}}\n  {{- $pt := .Paths -}}\n  {{- $pr := $pt.Params -}}\n  <div class=\"nav\">\n    {{if .Prev}}<span class=\"nav-prev\"><a href=\"{{$pt.UserEvents .ObjectUserId .Prev true}}\">Previous</a></span>{{end}}\n    {{if .Next}}<span class=\"nav-next\"><a href=\"{{$pt.UserEvents .ObjectUserId .Next false}}\">Next</a></span>{{end}}\n  </div>\n{{end}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>Recent Activity</h2>\n  {{- template \"nav\" . -}}\n  {{if .UserEvents}}\n    <table>\n    {{range .UserEvents}}\n      <tr>\n        <td>{{.CreatedTime}}</td>\n        <td>\n          {{$evt := .GetOutgoingUpsertPicVote}}{{if $evt}}\n            This user upvoted <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetIncomingUpsertPicVote}}{{if $evt}}\n            User {{$evt.SubjectUserId}} upvoted \n            <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetOutgoingPicComment}}{{if $evt}}\n            This user commented on \n            <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentId}}\">{{$evt.CommentId}}</a>\n          {{end}}\n          {{$evt := .GetIncomingPicComment}}{{if $evt}}\n            Some user commented on \n            {{if $evt.CommentParentId}}\n              <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentParentId}}\"\n                  >{{$evt.CommentParentId}}</a>\n            {{else}}\n              <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentId}}\">{{$evt.PicId}}</a>\n            {{end}}\n          {{end}}\n          {{$evt := .GetUpsertPic}}{{if $evt}}\n            This user uploaded pic <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n        </td>\n      </tr>\n    {{end}}\n    </table>\n  {{else}}\n    <p>No more events.</p>\n  {{end}}\n  {{- template \"nav\" . -}}\n</div>\n{{end}}\n"
+	UserProfile = "{{- define \"userpanestyle\" -}}\n<style>\n  .avatar {\n    max-height: 8em;\n    max-width: 8em;\n  }\n  .bio {\n    white-space: pre-wrap;\n  }\n  form.follow {\n    display: inline;\n  }\n</style>\n{{- end -}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  {{if .Avatar}}\n  <a href=\"{{$pt.Viewer .Avatar.Id}}\"><img class=\"avatar\" src=\"{{$pt.PicFile .Avatar.File}}\" /></a>\n  {{end}}\n  <h2>{{if .UserInfo.Profile.GetDisplayName}}{{.UserInfo.Profile.DisplayName}}{{else}}{{.UserInfo.Ident}}{{end}}</h2>\n  {{if .CanFollow}}\n  <form class=\"follow\" action=\"{{$pt.FollowAction}}\" method=\"post\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n    <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$pt.UserProfile .UserInfo.UserId}}\" />\n    <input type=\"hidden\" name=\"{{$pr.UserId}}\" value=\"{{.UserInfo.UserId}}\" />\n    {{if .Following}}\n    <input type=\"hidden\" name=\"{{$pr.Follow}}\" value=\"{{$pr.False}}\" />\n    <input type=\"submit\" value=\"Unfollow\" />\n    {{else}}\n    <input type=\"hidden\" name=\"{{$pr.Follow}}\" value=\"{{$pr.True}}\" />\n    <input type=\"submit\" value=\"Follow\" />\n    {{end}}\n  </form>\n  {{end}}\n  <dl>\n    <dt>Joined</dt>\n    <dd>{{.UserInfo.CreatedTime}}</dd>\n    {{if .UserInfo.Profile.GetBio}}\n    <dt>About</dt>\n    <dd class=\"bio\">{{.UserInfo.Profile.Bio}}</dd>\n    {{end}}\n  </dl>\n  <h2>Uploads</h2>\n  {{if .Uploads}}\n  <ul>\n    {{range .Uploads}}\n    <li><a href=\"{{$pt.Viewer .PicId}}\">{{.PicId}}</a></li>\n    {{end}}\n  </ul>\n  {{else}}\n  <p>No recent uploads.</p>\n  {{end}}\n</div>\n{{end}}\n"
 
-	Userpane = "{{block \"panestyle\" .}}\n<style>\n.row {\n  margin-left: auto;\n  margin-right: auto;\n}\n\n.row:after {\n  clear: both;\n  content: \"\";\n  display: table;\n}\n\n.row .col {\n  float: left;\n  min-height: 1px;\n}\n\n.row .col.s1 {\n  width: 8.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s2 {\n  width: 16.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s3 {\n  width: 25%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s4 {\n  width: 33.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s5 {\n  width: 41.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s6 {\n  width: 50%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s7 {\n  width: 58.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s8 {\n  width: 66.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s9 {\n  width: 75%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s10 {\n  width: 83.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s11 {\n  width: 91.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s12 {\n  width: 100%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.user-side-nav {\n  width: 25%;\n  left: auto;\n  right: auto;\n}\n.user-pane {\n  width: 75%;\n  left: auto;\n  right: auto;\n}\n</style>\n{{block \"userpanestyle\" .}}{{end}}\n{{end}}\n{{define \"pane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div class=\"row\">\n  <div class=\"col s2\">\n    <ul>\n      <li><a href=\"{{$pt.UserEvents .ObjectUserId \"\" false }}\">Activity</a></li>\n      <li><a href=\"{{$pt.UserEdit .ObjectUserId}}\">Account</a></li>\n    </ul>\n  </div>{{- /**/ -}}\n  <div class=\"col s8\">\n    {{template \"userpane\" .}}\n  </div>\n</div>\n{{end}}\n"
+	Userpane = "{{block \"panestyle\" .}}\n<style>\n.row {\n  margin-left: auto;\n  margin-right: auto;\n}\n\n.row:after {\n  clear: both;\n  content: \"\";\n  display: table;\n}\n\n.row .col {\n  float: left;\n  min-height: 1px;\n}\n\n.row .col.s1 {\n  width: 8.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s2 {\n  width: 16.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s3 {\n  width: 25%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s4 {\n  width: 33.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s5 {\n  width: 41.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s6 {\n  width: 50%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s7 {\n  width: 58.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s8 {\n  width: 66.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s9 {\n  width: 75%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s10 {\n  width: 83.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s11 {\n  width: 91.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s12 {\n  width: 100%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.user-side-nav {\n  width: 25%;\n  left: auto;\n  right: auto;\n}\n.user-pane {\n  width: 75%;\n  left: auto;\n  right: auto;\n}\n</style>\n{{block \"userpanestyle\" .}}{{end}}\n{{end}}\n{{define \"pane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div class=\"row\">\n  <div class=\"col s2\">\n    <ul>\n      <li><a href=\"{{$pt.UserProfile .ObjectUserId}}\">Profile</a></li>\n      <li><a href=\"{{$pt.UserEvents .ObjectUserId \"\" false }}\">Activity</a></li>\n      <li><a href=\"{{$pt.Collections .ObjectUserId}}\">Collections</a></li>\n      <li><a href=\"{{$pt.Favorites \"\"}}\">Favorites</a></li>\n      <li><a href=\"{{$pt.Feed \"\" false}}\">Feed</a></li>\n      <li><a href=\"{{$pt.UserEdit .ObjectUserId}}\">Account</a></li>\n    </ul>\n  </div>{{- /**/ -}}\n  <div class=\"col s8\">\n    {{template \"userpane\" .}}\n  </div>\n</div>\n{{end}}\n"
 
-	Viewer = "{{- define \"panestyle\" -}}\n<style>\n  .viewer .thepic, .viewer .thevideo {\n    height: auto;\n    max-width: 100%;\n    width: auto;\n  }\n  .viewer .votebutton {\n    padding: 0 .5cm 0 .5cm;\n    border: none;\n    margin: 10px;\n  }\n  .viewer .pic {\n    text-align: center;\n    max-height: 768px;\n  }\n  \n  .viewer .pic img {\n    max-height: inherit;\n  }\n  \n  .viewer .pic video {\n    max-height: inherit;\n  }\n\n  .actions {\n    float: right;\n    display: inline;\n  }\n  \n  .votebar {\n    float: left;\n  }\n  .actionbar:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n  \n  .votebar form {\n    display: inline;\n  }\n  .votebar .up {\n    color: white;\n    background-color: hsl(120, 75%, 50%);\n  }\n  .votebar .down {\n    color: black;\n    background-color: hsl(0, 75%, 50%);\n  }\n  .votebar .neutral {\n    color: white;\n    background-color: hsl(0, 0%, 50%);\n  }\n  .votebar .up.unpicked {\n    color: white;\n    background-color: hsl(120, 25%, 75%);\n  }\n  .votebar .down.unpicked {\n    color: black;\n    background-color: hsl(0, 25%, 75%);\n  }\n  .votebar .neutral.unpicked {\n    color: white;\n    background-color: hsl(0, 0%, 75%);;\n  }\n  .votebutton.unvoted {\n    cursor: pointer;\n  }\n</style>\n{{template \"commentstyle\"}}\n{{- end -}}\n{{define \"pane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div class=\"viewer\">\n  <div class=\"pic\">\n    {{if eq .Pic.File.Format.String `WEBM`}}\n        <video class=\"thevideo\" loop muted autoplay controls>\n          <source src=\"{{$pt.PicFile .Pic.File}}\" type=\"{{$pr.Mime .Pic.File.Format}}\" />\n          {{range .Derived}}\n            <source src=\"{{$pt.PicFile .}}\" type=\"{{$pr.Mime .Format}}\" />\n          {{end}}\n          Your browser does not support the video tag.\n        </video>\n    </video>\n    {{else if eq .Pic.File.Format.String `MP4`}}\n        <video class=\"thevideo\" loop muted autoplay controls>\n          <source src=\"{{$pt.PicFile .Pic.File}}\" type=\"{{$pr.Mime .Pic.File.Format}}\" />\n          {{range .Derived}}\n            <source src=\"{{$pt.PicFile .}}\" type=\"{{$pr.Mime .Format}}\" />\n          {{end}}\n          Your browser does not support the video tag.\n        </video>\n    {{else}}\n    <img class=\"thepic\" src=\"{{$pt.PicFile .Pic.File}}\" />\n    {{end}}\n  </div>\n  <div class=\"actionbar\">\n    <div class=\"votebar\">\n      <form action=\"{{$pt.VoteAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Vote}}\" value=\"DOWN\" />\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$pt.Viewer .Pic.Id}}\" />\n        <input \n            type=\"submit\" \n            value=\"▼\" \n            {{if .PicVote}}\n              class=\"votebutton down\n                {{- if ne .PicVote.Vote.String `DOWN`}} unpicked{{end}}\"\n              disabled\n            {{else}}\n              class=\"votebutton down unvoted\"\n            {{end}}\n        />\n      </form>\n      <form action=\"{{$pt.VoteAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Vote}}\" value=\"NEUTRAL\" />\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$pt.Viewer .Pic.Id}}\" />\n        <input\n            type=\"submit\" \n            value=\"meh\" \n            {{if .PicVote}}\n              class=\"votebutton neutral\n                {{- if ne .PicVote.Vote.String `NEUTRAL`}} unpicked{{end}}\"\n              disabled\n            {{else}}\n              class=\"votebutton neutral unvoted\"\n            {{end}}\n        />\n      </form>\n      <form action=\"{{$pt.VoteAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Vote}}\" value=\"UP\" />\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$pt.Viewer .Pic.Id}}\" />\n        <input \n            type=\"submit\" \n            value=\"▲\"\n            {{if .PicVote}}\n              class=\"votebutton up\n                {{- if ne .PicVote.Vote.String `UP`}} unpicked{{end}}\"\n              disabled\n            {{else}}\n              class=\"votebutton up unvoted\"\n            {{end}}\n        />\n      </form>\n    </div>\n    <div class=\"actions\">\n      <a href=\"{{$pt.PicFile .Pic.File}}\">View Full</a>\n    </div>\n  </div>\n  <!-- {{ .Pic }} -->\n  <br>\n  {{template \"commentreply\" .PicComment}}\n  {{template \"comment\" .PicComment.Child}}\n  \n  {{if .PicTag}}\n  <h4>Tags</h4>\n  <ul>\n    {{range .PicTag}}\n      <li>{{.Name}}</li>\n    {{end}}\n  </ul>\n  {{end}}\n  \n  {{if .Pic.Source}}\n  <h4>Sources</h4>\n  <dl>\n    {{range .Pic.Source}}\n      <dt>Name: {{if .Name}}{{.Name}}{{else}}-{{end}}</dt>\n      <dd>URL: \n        {{if .Url}}\n          {{if .Referrer}}<a href=\"{{.Referrer}}\" rel=\"nofollow\" target=\"_blank\">{{.Url}}</a>\n          {{else}}\n          {{.Url}}\n          {{end}}\n        {{else}}\n        -\n        {{end}}\n      </dd>\n    {{end}}\n  </dl>\n  {{end}}\n  \n  <hr>\n    {{if .Pic.PendingDeletion }}\n    <div>This pic is pending deletion</div>\n    {{else}}\n    <form action=\"{{$pt.SoftDeletePicAction}}\" method=\"post\">\n      <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n      <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n      <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$pt.Viewer .Pic.Id}}\" />\n      <input type=\"details\" name=\"{{$pr.DeletePicDetails}}\" placeholder=\"Details why this pic is deleted\" /><br />\n      <select name=\"{{$pr.DeletePicReason}}\">\n        {{ range .DeletionReason }}\n          <option value=\"{{.Value}}\" {{/*1 is NONE*/}}{{if eq .Value 1}}selected=\"selected\"{{end}}>{{.Name}}</option>\n        {{end}}\n      </select>\n      <br/>\n      <input type=\"submit\" value=\"Delete\"/>\n      <label>\n        <input type=\"checkbox\" name=\"{{$pr.DeletePicReally}}\" value=\"non-empty text\" />\n        Really?\n      </label>\n    </form>\n    {{end}}\n<div>\n{{end}}\n{{define \"comment\"}}\n  <ul>\n\t    {{- range . -}}\n\t    {{- $pt := .Paths -}}\n\t    {{- $pr := $pt.Params -}}\n\t    <li>\n        {{template \"commenttext\" .}}\n\t      {{if .Child}}{{template \"comment\" .Child}}{{end}}\n\t    </li>\n\t    {{- end -}}\n  </ul>\n{{end}}\n"
+	Viewer = "{{- define \"panestyle\" -}}\n<style>\n  .viewer .thepic, .viewer .thevideo {\n    height: auto;\n    max-width: 100%;\n    width: auto;\n  }\n  .viewer .votebutton {\n    padding: 0 .5cm 0 .5cm;\n    border: none;\n    margin: 10px;\n  }\n  .viewer .pic {\n    text-align: center;\n    max-height: 768px;\n  }\n  \n  .viewer .pic img {\n    max-height: inherit;\n  }\n  \n  .viewer .pic video {\n    max-height: inherit;\n  }\n\n  .actions {\n    float: right;\n    display: inline;\n  }\n  .actions form {\n    display: inline;\n  }\n  form.follow {\n    display: inline;\n  }\n\n  .poolnav {\n    text-align: center;\n  }\n  \n  .votebar {\n    float: left;\n  }\n  .actionbar:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n  \n  .votebar form {\n    display: inline;\n  }\n  .votebar .up {\n    color: white;\n    background-color: hsl(120, 75%, 50%);\n  }\n  .votebar .down {\n    color: black;\n    background-color: hsl(0, 75%, 50%);\n  }\n  .votebar .neutral {\n    color: white;\n    background-color: hsl(0, 0%, 50%);\n  }\n  .votebar .up.unpicked {\n    color: white;\n    background-color: hsl(120, 25%, 75%);\n  }\n  .votebar .down.unpicked {\n    color: black;\n    background-color: hsl(0, 25%, 75%);\n  }\n  .votebar .neutral.unpicked {\n    color: white;\n    background-color: hsl(0, 0%, 75%);;\n  }\n  .votebutton.unvoted {\n    cursor: pointer;\n  }\n</style>\n{{template \"commentstyle\"}}\n{{- end -}}\n{{define \"pane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div class=\"viewer\">\n  <div class=\"pic\">\n    {{if eq .Pic.File.Format.String `WEBM`}}\n        <video class=\"thevideo\" loop muted autoplay controls>\n          <source src=\"{{$pt.PicFile .Pic.File}}\" type=\"{{$pr.Mime .Pic.File.Format}}\" />\n          {{range .Derived}}\n            <source src=\"{{$pt.PicFile .}}\" type=\"{{$pr.Mime .Format}}\" />\n          {{end}}\n          Your browser does not support the video tag.\n        </video>\n    </video>\n    {{else if eq .Pic.File.Format.String `MP4`}}\n        <video class=\"thevideo\" loop muted autoplay controls>\n          <source src=\"{{$pt.PicFile .Pic.File}}\" type=\"{{$pr.Mime .Pic.File.Format}}\" />\n          {{range .Derived}}\n            <source src=\"{{$pt.PicFile .}}\" type=\"{{$pr.Mime .Format}}\" />\n          {{end}}\n          Your browser does not support the video tag.\n        </video>\n    {{else}}\n    <img class=\"thepic\" src=\"{{$pt.PicFile .Pic.File}}\" />\n    {{end}}\n  </div>\n  <div class=\"actionbar\">\n    <div class=\"votebar\">\n      <form action=\"{{$pt.VoteAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Vote}}\" value=\"DOWN\" />\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$pt.Viewer .Pic.Id}}\" />\n        <input \n            type=\"submit\" \n            value=\"▼\" \n            {{if .PicVote}}\n              class=\"votebutton down\n                {{- if ne .PicVote.Vote.String `DOWN`}} unpicked{{end}}\"\n              disabled\n            {{else}}\n              class=\"votebutton down unvoted\"\n            {{end}}\n        />\n      </form>\n      <form action=\"{{$pt.VoteAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Vote}}\" value=\"NEUTRAL\" />\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$pt.Viewer .Pic.Id}}\" />\n        <input\n            type=\"submit\" \n            value=\"meh\" \n            {{if .PicVote}}\n              class=\"votebutton neutral\n                {{- if ne .PicVote.Vote.String `NEUTRAL`}} unpicked{{end}}\"\n              disabled\n            {{else}}\n              class=\"votebutton neutral unvoted\"\n            {{end}}\n        />\n      </form>\n      <form action=\"{{$pt.VoteAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Vote}}\" value=\"UP\" />\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$pt.Viewer .Pic.Id}}\" />\n        <input \n            type=\"submit\" \n            value=\"▲\"\n            {{if .PicVote}}\n              class=\"votebutton up\n                {{- if ne .PicVote.Vote.String `UP`}} unpicked{{end}}\"\n              disabled\n            {{else}}\n              class=\"votebutton up unvoted\"\n            {{end}}\n        />\n      </form>\n    </div>\n    <div class=\"actions\">\n      {{if .CanFavorite}}\n      <form action=\"{{$pt.FavoritePicAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n        {{if .Favorite}}\n        <input type=\"hidden\" name=\"{{$pr.Favorite}}\" value=\"{{$pr.False}}\" />\n        <input type=\"submit\" value=\"Unfavorite ({{.Pic.FavoriteCount}})\" />\n        {{else}}\n        <input type=\"hidden\" name=\"{{$pr.Favorite}}\" value=\"{{$pr.True}}\" />\n        <input type=\"submit\" value=\"Favorite ({{.Pic.FavoriteCount}})\" />\n        {{end}}\n      </form>\n      {{end}}\n      {{if .Collections}}\n      <form action=\"{{$pt.AddCollectionPicAction}}\" method=\"post\">\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n        <select name=\"{{$pr.CollectionId}}\">\n          {{range .Collections}}\n          <option value=\"{{.CollectionId}}\">{{.Name}}</option>\n          {{end}}\n        </select>\n        <input type=\"submit\" value=\"Add to Collection\" />\n      </form>\n      {{end}}\n      <a href=\"{{$pt.PicFile .Pic.File}}\">View Full</a>\n    </div>\n  </div>\n  {{range .PoolNav}}\n  <div class=\"poolnav\">\n    {{if .PrevPicId}}<a href=\"{{$pt.Viewer .PrevPicId}}\">&laquo; Prev</a>{{end}}\n    <a href=\"{{$pt.Pool .Pool.PoolId}}\">{{.Pool.Title}}</a>\n    {{if .NextPicId}}<a href=\"{{$pt.Viewer .NextPicId}}\">Next &raquo;</a>{{end}}\n  </div>\n  {{end}}\n  {{if .CanEditPool}}\n  <form class=\"poolnav\" action=\"{{$pt.InsertPoolPicAction}}\" method=\"post\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n    <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n    <input type=\"text\" name=\"{{$pr.PoolId}}\" placeholder=\"Pool Id\" />\n    <input type=\"submit\" value=\"Add to Pool\" />\n  </form>\n  {{end}}\n  <!-- {{ .Pic }} -->\n  <br>\n  {{template \"commentreply\" .PicComment}}\n  {{template \"comment\" .PicComment.Child}}\n  \n  {{if .PicTag}}\n  <h4>Tags</h4>\n  <ul>\n    {{$canFollow := .CanFollow}}\n    {{$xsrf := .XsrfToken}}\n    {{$next := $pt.Viewer .Pic.Id}}\n    {{range .PicTag}}\n      <li>{{.Name}}\n        {{if $canFollow}}\n        <form class=\"follow\" action=\"{{$pt.FollowAction}}\" method=\"post\">\n          <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{$xsrf}}\" />\n          <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$next}}\" />\n          <input type=\"hidden\" name=\"{{$pr.Tag}}\" value=\"{{.Name}}\" />\n          <input type=\"hidden\" name=\"{{$pr.Follow}}\" value=\"{{$pr.True}}\" />\n          <input type=\"submit\" value=\"Follow\" />\n        </form>\n        {{end}}\n      </li>\n    {{end}}\n  </ul>\n  {{end}}\n  \n  {{if .Pic.Source}}\n  <h4>Sources</h4>\n  <dl>\n    {{range .Pic.Source}}\n      <dt>Name: {{if .Name}}{{.Name}}{{else}}-{{end}}</dt>\n      <dd>URL: \n        {{if .Url}}\n          {{if .Referrer}}<a href=\"{{.Referrer}}\" rel=\"nofollow\" target=\"_blank\">{{.Url}}</a>\n          {{else}}\n          {{.Url}}\n          {{end}}\n        {{else}}\n        -\n        {{end}}\n      </dd>\n    {{end}}\n  </dl>\n  {{end}}\n  \n  <hr>\n    {{if .Pic.PendingDeletion }}\n    <div>This pic is pending deletion</div>\n    {{else}}\n    <form action=\"{{$pt.SoftDeletePicAction}}\" method=\"post\">\n      <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n      <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.Pic.Id}}\" />\n      <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{$pt.Viewer .Pic.Id}}\" />\n      <input type=\"details\" name=\"{{$pr.DeletePicDetails}}\" placeholder=\"Details why this pic is deleted\" /><br />\n      <select name=\"{{$pr.DeletePicReason}}\">\n        {{ range .DeletionReason }}\n          <option value=\"{{.Value}}\" {{/*1 is NONE*/}}{{if eq .Value 1}}selected=\"selected\"{{end}}>{{.Name}}</option>\n        {{end}}\n      </select>\n      <br/>\n      <input type=\"submit\" value=\"Delete\"/>\n      <label>\n        <input type=\"checkbox\" name=\"{{$pr.DeletePicReally}}\" value=\"non-empty text\" />\n        Really?\n      </label>\n    </form>\n    {{end}}\n<div>\n{{end}}\n{{define \"comment\"}}\n  <ul>\n\t    {{- range . -}}\n\t    {{- $pt := .Paths -}}\n\t    {{- $pr := $pt.Params -}}\n\t    <li>\n        {{template \"commenttext\" .}}\n\t      {{if .Child}}{{template \"comment\" .Child}}{{end}}\n\t    </li>\n\t    {{- end -}}\n  </ul>\n{{end}}\n"
 )
@@ -27,6 +27,7 @@ const (
 	authPwtHeaderKey = "pixur-auth-token"
 	pixPwtHeaderKey  = "pixur-pix-token"
 	httpHeaderKey    = "pixur-http-header-bin"
+	apiKeyHeaderKey  = "pixur-api-key"
 )
 
 // cc is optional
@@ -37,6 +38,10 @@ func Auth(ctx context.Context, cc **grpc.ClientConn) (context.Context, status.S)
 	}
 
 	md := make(metadata.MD, 2)
+	if conf.ApiKey != "" {
+		md.Set(apiKeyHeaderKey, conf.ApiKey)
+		return metadata.NewOutgoingContext(ctx, md), nil
+	}
 	if conf.AuthToken != "" {
 		md.Set(authPwtHeaderKey, conf.AuthToken)
 	}
@@ -125,6 +130,10 @@ func updateConfig(ctx context.Context, cc **grpc.ClientConn, conf *config.Config
 			}()
 		}
 	}
+	// Api keys don't expire the same way as tokens, so there is nothing to refresh.
+	if conf.ApiKey != "" {
+		return nil
+	}
 	var (
 		useToken bool
 		useCreds bool = true
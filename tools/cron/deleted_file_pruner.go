@@ -62,6 +62,7 @@ func perPic(p *schema.Pic, db sdb.DB, pixPath string) error {
 		Beg:     db,
 		PixPath: pixPath,
 		PicId:   p.PicId,
+		Reason:  "pending deletion time passed",
 	}
 	runner := new(tasks.TaskRunner)
 	// TODO: use real userid
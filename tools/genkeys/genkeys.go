@@ -1,57 +1,160 @@
 package main // import "pixur.org/pixur/tools/genkeys"
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+
+	"github.com/golang/protobuf/proto"
+
+	"pixur.org/pixur/be/server/config"
 )
 
-func run() error {
-	priv, err := rsa.GenerateMultiPrimeKey(rand.Reader, 3, 2048)
-	if err != nil {
-		return err
+var (
+	pwtKeySetPath = flag.String("pwt_key_set", "",
+		"If set, add a new signing key to the pwt key set at this path instead of generating session keys.")
+	maxPwtKeys = flag.Int("max_pwt_keys", 3,
+		"The number of pwt keys to keep in the key set.  The oldest keys are removed first.")
+	keyType = flag.String("key_type", "",
+		"The type of key to generate: one of hmac, rsa, ecdsa, or ed25519.  Session keys default to "+
+			"rsa, and pwt key sets default to hmac.  Only ecdsa and ed25519 session keys sign tokens.")
+)
+
+// generateKey makes a new private key of the given type.
+func generateKey(keyType string) (crypto.Signer, error) {
+	switch keyType {
+	case "rsa":
+		return rsa.GenerateMultiPrimeKey(rand.Reader, 3, 2048)
+	case "ecdsa":
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "ed25519":
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		return priv, err
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", keyType)
 	}
+}
 
-	privf, err := os.OpenFile("priv.key", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+// encodeKey PEM encodes the private and public halves of key.  RSA private keys use PKCS1 and
+// others use PKCS8.
+func encodeKey(key crypto.Signer) (privPem, pubPem []byte, _ error) {
+	privblock := &pem.Block{}
+	if rsaKey, ok := key.(*rsa.PrivateKey); ok {
+		privblock.Type = "RSA PRIVATE KEY"
+		privblock.Bytes = x509.MarshalPKCS1PrivateKey(rsaKey)
+	} else {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		privblock.Type = "PRIVATE KEY"
+		privblock.Bytes = der
+	}
+
+	pub, err := x509.MarshalPKIXPublicKey(key.Public())
 	if err != nil {
+		return nil, nil, err
+	}
+	pubblock := &pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: pub,
+	}
+	return pem.EncodeToMemory(privblock), pem.EncodeToMemory(pubblock), nil
+}
+
+// rotatePwtKeySet adds a new key to the key set at path, creating it if needed, and makes it the
+// signing key.  The previous keys are kept so that tokens signed by them can still be verified.
+func rotatePwtKeySet(path string, maxKeys int, keyType string) error {
+	var ks config.PwtKeySet
+	data, err := ioutil.ReadFile(path)
+	if err == nil {
+		if err := proto.UnmarshalText(string(data), &ks); err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
 		return err
 	}
-	defer privf.Close()
 
-	privblock := &pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(priv),
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	key := &config.PwtKey{
+		KeyId: hex.EncodeToString(id),
+	}
+	switch keyType {
+	case "", "hmac":
+		secret := make([]byte, 64)
+		if _, err := rand.Read(secret); err != nil {
+			return err
+		}
+		key.Secret = secret
+	case "ecdsa", "ed25519":
+		priv, err := generateKey(keyType)
+		if err != nil {
+			return err
+		}
+		privPem, pubPem, err := encodeKey(priv)
+		if err != nil {
+			return err
+		}
+		key.PrivateKey, key.PublicKey = string(privPem), string(pubPem)
+	default:
+		return fmt.Errorf("unsupported pwt key type %q", keyType)
+	}
+	ks.Key = append(ks.Key, key)
+	ks.SigningKeyId = key.KeyId
+	if maxKeys > 0 && len(ks.Key) > maxKeys {
+		ks.Key = ks.Key[len(ks.Key)-maxKeys:]
 	}
 
-	if err := pem.Encode(privf, privblock); err != nil {
-		os.Remove(privf.Name())
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(proto.MarshalTextString(&ks)), 0600); err != nil {
 		return err
 	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	log.Println("added pwt signing key", key.KeyId, "to", path)
+	return nil
+}
 
-	pub, err := x509.MarshalPKIXPublicKey(priv.Public())
+func run() error {
+	if *pwtKeySetPath != "" {
+		return rotatePwtKeySet(*pwtKeySetPath, *maxPwtKeys, *keyType)
+	}
+	kt := *keyType
+	if kt == "" {
+		kt = "rsa"
+	}
+	priv, err := generateKey(kt)
 	if err != nil {
-		os.Remove(privf.Name())
 		return err
 	}
-
-	pubf, err := os.OpenFile("pub.key", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	privPem, pubPem, err := encodeKey(priv)
 	if err != nil {
-		os.Remove(privf.Name())
 		return err
 	}
-	defer pubf.Close()
 
-	pubblock := &pem.Block{
-		Type:  "PUBLIC KEY",
-		Bytes: pub,
+	if err := ioutil.WriteFile("priv.key", privPem, 0600); err != nil {
+		os.Remove("priv.key")
+		return err
 	}
-
-	if err := pem.Encode(pubf, pubblock); err != nil {
-		os.Remove(privf.Name())
-		os.Remove(pubf.Name())
+	if err := ioutil.WriteFile("pub.key", pubPem, 0600); err != nil {
+		os.Remove("priv.key")
+		os.Remove("pub.key")
 		return err
 	}
 
@@ -59,6 +162,7 @@ func run() error {
 }
 
 func main() {
+	flag.Parse()
 	if err := run(); err != nil {
 		log.Println(err)
 		os.Exit(1)